@@ -54,47 +54,67 @@ func init() {
 }
 
 var fileDescriptor_cf5ca5e0c737570d = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x3f, 0x6f, 0x13, 0x3f,
-	0x18, 0xc7, 0xcf, 0xcb, 0x6f, 0xb0, 0x7e, 0xfc, 0x91, 0x8b, 0x90, 0xe8, 0x60, 0x10, 0xec, 0x17,
-	0xb5, 0x48, 0x45, 0xb4, 0x40, 0x9b, 0xb6, 0x21, 0x95, 0x68, 0x11, 0x5c, 0x11, 0x48, 0x2c, 0xc8,
-	0xbd, 0x3c, 0x4d, 0x4e, 0xbd, 0xc4, 0x87, 0xed, 0xa4, 0x74, 0x82, 0x11, 0x09, 0x09, 0xc1, 0x84,
-	0x84, 0xc4, 0xc4, 0xc2, 0xc0, 0x6b, 0x40, 0x62, 0x63, 0xcc, 0xd8, 0x91, 0x5c, 0x96, 0x8e, 0x7d,
-	0x09, 0x28, 0x5c, 0xec, 0x5c, 0x93, 0x34, 0xf8, 0x2e, 0xdd, 0x72, 0x92, 0x3f, 0xdf, 0xe7, 0xf3,
-	0xe4, 0x7c, 0x8f, 0x8d, 0xe7, 0x14, 0xd4, 0x23, 0x2e, 0x58, 0x58, 0x90, 0x20, 0x5a, 0x20, 0x0a,
-	0x2c, 0x0a, 0x0a, 0xac, 0x52, 0x0f, 0x1a, 0xbd, 0xe7, 0xc0, 0x87, 0x42, 0x6b, 0xae, 0xd0, 0xff,
-	0xe9, 0x46, 0x82, 0x2b, 0x4e, 0x6e, 0x68, 0xc4, 0x4d, 0x10, 0x97, 0x45, 0x81, 0x9b, 0x46, 0xdc,
-	0xd6, 0xdc, 0xec, 0xa2, 0x4d, 0xae, 0x80, 0x97, 0x4d, 0x90, 0xea, 0x85, 0x00, 0x19, 0xf1, 0x86,
-	0xec, 0x17, 0x98, 0x3f, 0x9a, 0xc1, 0xff, 0x17, 0x7b, 0x4b, 0xb7, 0x93, 0xa5, 0xe4, 0x3b, 0xc2,
-	0x57, 0xd6, 0x41, 0xfa, 0x22, 0xd8, 0x81, 0x67, 0x5c, 0xec, 0xed, 0x86, 0x7c, 0xbf, 0xf4, 0x0a,
-	0xfc, 0xa6, 0x0a, 0x78, 0x83, 0x94, 0x5c, 0x0b, 0x21, 0xf7, 0x54, 0xde, 0x4b, 0x24, 0x66, 0xef,
-	0x4f, 0x1b, 0x93, 0xf4, 0x70, 0xdd, 0x21, 0x9f, 0x11, 0x9e, 0xd1, 0xeb, 0x36, 0x02, 0xa9, 0xb8,
-	0x38, 0xd8, 0xe0, 0x52, 0x91, 0xe5, 0x4c, 0x15, 0x52, 0xa4, 0x56, 0x5c, 0xc9, 0x1f, 0x60, 0xe4,
-	0x5e, 0x63, 0xbc, 0x16, 0x72, 0x09, 0xdb, 0x35, 0x26, 0x2a, 0x64, 0xc1, 0x2a, 0x71, 0x00, 0x68,
-	0x93, 0x5b, 0x99, 0xb9, 0xb4, 0x80, 0x07, 0x75, 0xde, 0x82, 0x27, 0x4c, 0xee, 0x59, 0x0a, 0x0c,
-	0x80, 0x6c, 0x02, 0x69, 0xce, 0x08, 0xfc, 0x44, 0xf8, 0x5a, 0x19, 0xd4, 0xe8, 0x1b, 0x64, 0xfb,
-	0xfd, 0xbf, 0xec, 0xe9, 0x3c, 0xd9, 0xb4, 0xca, 0xff, 0x57, 0x8c, 0xb6, 0xdd, 0x3a, 0xa3, 0x34,
-	0xd3, 0xc3, 0x57, 0x84, 0x2f, 0x97, 0x41, 0x79, 0x10, 0x85, 0x81, 0xcf, 0x7a, 0x0b, 0xb7, 0x40,
-	0x4a, 0x56, 0x05, 0x49, 0x56, 0x6d, 0x6b, 0x8d, 0x81, 0xb5, 0xef, 0xda, 0x54, 0x19, 0xc6, 0xf2,
-	0x07, 0xc2, 0x57, 0xcb, 0xa0, 0x1e, 0xb2, 0x3a, 0xc8, 0x88, 0xf9, 0x30, 0x4e, 0xf7, 0x81, 0x6d,
-	0xa9, 0x49, 0x29, 0xda, 0x7b, 0xf3, 0x6c, 0xc2, 0x4c, 0x03, 0xbd, 0xc1, 0x53, 0x06, 0xb5, 0xbe,
-	0xf9, 0x78, 0x9c, 0x7a, 0xc9, 0xb6, 0xda, 0x78, 0x3e, 0xdb, 0xe0, 0x99, 0x10, 0x63, 0x74, 0xdf,
-	0x22, 0x7c, 0xce, 0x03, 0x16, 0x45, 0xe1, 0x41, 0xa9, 0x05, 0x0d, 0x25, 0xc9, 0x6d, 0xcb, 0xcf,
-	0x24, 0xc5, 0x68, 0xad, 0xc5, 0x3c, 0xa8, 0x51, 0xf9, 0x84, 0x30, 0x29, 0x56, 0x2a, 0xdb, 0xc0,
-	0x84, 0x5f, 0x2b, 0x2a, 0x25, 0x82, 0x9d, 0xa6, 0x02, 0x72, 0xcf, 0x2a, 0x74, 0x14, 0xd4, 0x52,
-	0xcb, 0xb9, 0x79, 0x63, 0xf6, 0x1e, 0xe1, 0x0b, 0x7a, 0x44, 0xae, 0x85, 0x4d, 0xa9, 0x40, 0x90,
-	0xa5, 0x4c, 0x83, 0xb5, 0x4f, 0x69, 0xa7, 0x3b, 0xf9, 0x60, 0x23, 0xf4, 0x0e, 0xe1, 0xf3, 0xc9,
-	0xdb, 0x35, 0x3b, 0x6b, 0x31, 0xc3, 0x96, 0x18, 0xde, 0x4e, 0x4b, 0xb9, 0x58, 0x63, 0xf3, 0x11,
-	0xe1, 0x8b, 0x8f, 0x9a, 0xa2, 0x0a, 0x69, 0x1f, 0xbb, 0x16, 0x87, 0x31, 0x6d, 0x74, 0x37, 0x27,
-	0x7d, 0xc2, 0x69, 0x0b, 0x72, 0x39, 0x0d, 0x63, 0xd9, 0x9c, 0x46, 0x69, 0xe3, 0xf4, 0x05, 0xe1,
-	0x4b, 0x1e, 0xec, 0x0a, 0x90, 0x35, 0x3d, 0xb4, 0x7b, 0xe7, 0x8c, 0x24, 0x2b, 0x96, 0xdf, 0xcd,
-	0x28, 0xaa, 0xdd, 0x8a, 0x53, 0x24, 0x9c, 0x38, 0x21, 0x3c, 0x90, 0xd0, 0xa8, 0xa4, 0x66, 0x46,
-	0x62, 0xb8, 0x6a, 0x99, 0x3f, 0x0e, 0xce, 0x76, 0x42, 0x9c, 0x96, 0xa1, 0x2d, 0x57, 0xc3, 0x76,
-	0x87, 0x3a, 0x87, 0x1d, 0xea, 0x1c, 0x77, 0x28, 0x7a, 0x13, 0x53, 0xf4, 0x2d, 0xa6, 0xe8, 0x57,
-	0x4c, 0x51, 0x3b, 0xa6, 0xe8, 0x77, 0x4c, 0xd1, 0x51, 0x4c, 0x9d, 0xe3, 0x98, 0xa2, 0x0f, 0x5d,
-	0xea, 0xb4, 0xbb, 0xd4, 0x39, 0xec, 0x52, 0xe7, 0xf9, 0x42, 0x95, 0x0f, 0xca, 0x07, 0x7c, 0xc2,
-	0x15, 0x73, 0x29, 0xfd, 0xbc, 0xf3, 0xdf, 0xdf, 0xfb, 0xe5, 0xcd, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xe1, 0xd9, 0x39, 0x56, 0xf5, 0x0a, 0x00, 0x00,
+	// 946 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0x3d, 0x6f, 0x23, 0x45,
+	0x18, 0xc7, 0x3d, 0x0d, 0xc5, 0x88, 0xd7, 0xe5, 0x45, 0x70, 0xc5, 0x82, 0xa0, 0xa0, 0xb3, 0xc9,
+	0x21, 0x1d, 0x22, 0x39, 0x2e, 0xb1, 0x1d, 0xe3, 0x13, 0x67, 0xc3, 0x61, 0x73, 0x9c, 0x44, 0x83,
+	0x26, 0xeb, 0x27, 0xf6, 0xe8, 0xd6, 0xbb, 0xcb, 0xcc, 0xd8, 0xc1, 0x15, 0x94, 0x48, 0x48, 0x08,
+	0x24, 0x24, 0x24, 0x24, 0x24, 0x24, 0x1a, 0x90, 0xf8, 0x0a, 0x20, 0xd1, 0x51, 0xa6, 0x4c, 0x49,
+	0x9c, 0x86, 0x32, 0x1f, 0xe1, 0xb4, 0x59, 0xcf, 0x64, 0x67, 0x5f, 0x9c, 0x99, 0x75, 0xba, 0x58,
+	0xde, 0xdf, 0x7f, 0x7e, 0xf3, 0xf6, 0xe4, 0xf1, 0xe2, 0x2d, 0x01, 0xd3, 0x28, 0x64, 0xc4, 0x6f,
+	0x70, 0x60, 0x73, 0x60, 0x0d, 0x12, 0xd1, 0x06, 0x19, 0x4d, 0x69, 0x10, 0x7f, 0xa6, 0x1e, 0x34,
+	0xe6, 0x5b, 0x8d, 0xd5, 0x9f, 0xf5, 0x88, 0x85, 0x22, 0x74, 0xde, 0x90, 0x48, 0x3d, 0x41, 0xea,
+	0x24, 0xa2, 0xf5, 0x34, 0x52, 0x9f, 0x6f, 0xdd, 0xd8, 0x36, 0xc9, 0x65, 0xf0, 0xc5, 0x0c, 0xb8,
+	0xf8, 0x9c, 0x01, 0x8f, 0xc2, 0x80, 0xaf, 0x06, 0xb8, 0x79, 0xfe, 0x26, 0x7e, 0xb2, 0x19, 0x3f,
+	0x3a, 0x4c, 0x1e, 0x75, 0xfe, 0x44, 0xf8, 0x95, 0x7d, 0xe0, 0x1e, 0xa3, 0x07, 0xf0, 0x30, 0x64,
+	0x8f, 0x0e, 0xfd, 0xf0, 0xa8, 0xf3, 0x25, 0x78, 0x33, 0x41, 0xc3, 0xc0, 0xe9, 0xd4, 0x0d, 0x84,
+	0xea, 0xa5, 0xfc, 0x20, 0x91, 0xb8, 0xf1, 0xfe, 0xa6, 0x31, 0xc9, 0x1c, 0x5e, 0xaf, 0x39, 0x3f,
+	0x23, 0xfc, 0xbc, 0x7c, 0xee, 0x2e, 0xe5, 0x22, 0x64, 0x8b, 0xbb, 0x21, 0x17, 0xce, 0xae, 0xd5,
+	0x08, 0x29, 0x52, 0x2a, 0xee, 0x55, 0x0f, 0x50, 0x72, 0x5f, 0x61, 0xdc, 0xf6, 0x43, 0x0e, 0xc3,
+	0x09, 0x61, 0x23, 0xe7, 0x96, 0x51, 0xe2, 0x25, 0x20, 0x4d, 0xde, 0xb1, 0xe6, 0xd2, 0x02, 0x03,
+	0x98, 0x86, 0x73, 0xf8, 0x84, 0xf0, 0x47, 0x86, 0x02, 0x97, 0x80, 0x9d, 0x40, 0x9a, 0x53, 0x02,
+	0xff, 0x20, 0xfc, 0x5a, 0x17, 0x44, 0x7e, 0x07, 0xc9, 0xd1, 0x6a, 0xc9, 0x3e, 0xbd, 0xe9, 0xf4,
+	0x8c, 0xf2, 0xaf, 0x8a, 0x91, 0xb6, 0xfd, 0x6b, 0x4a, 0x53, 0x73, 0xf8, 0x0d, 0xe1, 0x97, 0xba,
+	0x20, 0x06, 0x10, 0xf9, 0xd4, 0x23, 0xf1, 0x83, 0x7d, 0xe0, 0x9c, 0x8c, 0x81, 0x3b, 0x2d, 0xd3,
+	0xb1, 0x0a, 0x60, 0xe9, 0xdb, 0xde, 0x28, 0x43, 0x59, 0xfe, 0x8d, 0xf0, 0xab, 0x5d, 0x10, 0x1f,
+	0x92, 0x29, 0xf0, 0x88, 0x78, 0x50, 0xa4, 0x7b, 0xcf, 0x74, 0xa8, 0x75, 0x29, 0xd2, 0xbb, 0x77,
+	0x3d, 0x61, 0x6a, 0x02, 0x71, 0xe1, 0xe9, 0x82, 0xd8, 0xef, 0x7d, 0x5c, 0xa4, 0xde, 0x31, 0x1d,
+	0xad, 0x98, 0xb7, 0x2b, 0x3c, 0x6b, 0x62, 0x94, 0xee, 0x37, 0x08, 0x3f, 0x35, 0x00, 0x12, 0x45,
+	0xfe, 0xa2, 0x33, 0x87, 0x40, 0x70, 0xe7, 0x5d, 0xc3, 0x6b, 0x92, 0x62, 0xa4, 0xd6, 0x76, 0x15,
+	0x54, 0xa9, 0xfc, 0x84, 0xb0, 0xd3, 0x1c, 0x8d, 0x86, 0x40, 0x98, 0x37, 0x69, 0x0a, 0xc1, 0xe8,
+	0xc1, 0x4c, 0x80, 0x73, 0xc7, 0x28, 0x34, 0x0f, 0x4a, 0xa9, 0xdd, 0xca, 0xbc, 0x32, 0xfb, 0x0e,
+	0xe1, 0x67, 0x64, 0x89, 0x6c, 0xfb, 0x33, 0x2e, 0x80, 0x39, 0x3b, 0x56, 0x85, 0x75, 0x45, 0x49,
+	0xa7, 0xdb, 0xd5, 0x60, 0x25, 0xf4, 0x2d, 0xc2, 0x4f, 0x27, 0xbb, 0xab, 0x4e, 0xd6, 0xb6, 0xc5,
+	0x91, 0xc8, 0x1e, 0xa7, 0x9d, 0x4a, 0xac, 0xb2, 0xf9, 0x01, 0xe1, 0x67, 0xef, 0xcf, 0xd8, 0x18,
+	0xd2, 0x3e, 0x66, 0x53, 0xcc, 0x62, 0xd2, 0xe8, 0xbd, 0x8a, 0xb4, 0xe6, 0xd4, 0x87, 0x4a, 0x4e,
+	0x7d, 0xd8, 0xc4, 0xa9, 0x0f, 0xa5, 0x4e, 0xbf, 0x20, 0xfc, 0xc2, 0x00, 0x0e, 0x19, 0xf0, 0x89,
+	0x2c, 0xda, 0xf1, 0xff, 0x19, 0xee, 0xec, 0x19, 0xde, 0x9b, 0x3c, 0x2a, 0xdd, 0x9a, 0x1b, 0x24,
+	0x28, 0xbf, 0x3f, 0x10, 0x7e, 0x79, 0x00, 0x5c, 0x84, 0xac, 0xa0, 0x65, 0xda, 0x37, 0x1c, 0xa1,
+	0x18, 0x97, 0x9e, 0x9d, 0x0d, 0x53, 0x32, 0x57, 0xd2, 0x07, 0x01, 0xaa, 0x2e, 0x1b, 0x5f, 0x49,
+	0x8d, 0xb2, 0xbd, 0x92, 0x19, 0x58, 0x13, 0x7a, 0x10, 0x8d, 0x88, 0xbd, 0x50, 0x86, 0xb2, 0x13,
+	0xca, 0xc1, 0xda, 0x6e, 0xca, 0x0a, 0xa2, 0xbe, 0x6f, 0xfa, 0x94, 0x70, 0xe0, 0x86, 0xbb, 0x59,
+	0x86, 0xdb, 0xed, 0x66, 0x79, 0x8a, 0xd6, 0x9b, 0x0c, 0x05, 0x61, 0xf9, 0x66, 0xc6, 0xb0, 0x37,
+	0x29, 0x86, 0xed, 0x7a, 0x93, 0xb2, 0x0c, 0xad, 0x0b, 0x1c, 0xd2, 0x71, 0x40, 0xfc, 0x87, 0x54,
+	0x4c, 0x4a, 0x7c, 0xcd, 0xfa, 0x89, 0xab, 0x62, 0xec, 0xba, 0xc0, 0xab, 0xd3, 0xb4, 0x95, 0xbe,
+	0x4f, 0x66, 0x1c, 0xaa, 0xae, 0x74, 0x31, 0x6c, 0xb7, 0xd2, 0x65, 0x19, 0xda, 0xd9, 0x7d, 0x10,
+	0x44, 0xc5, 0x9e, 0x66, 0x67, 0xb7, 0x0c, 0xb7, 0x3b, 0xbb, 0xe5, 0x29, 0x5a, 0x07, 0x75, 0x31,
+	0xa1, 0xa6, 0x27, 0xe8, 0x9c, 0x8a, 0x85, 0x61, 0x07, 0xa5, 0x31, 0x76, 0x1d, 0x54, 0x06, 0xd5,
+	0x6b, 0x50, 0x10, 0xa5, 0xbf, 0x35, 0xad, 0x41, 0x41, 0x54, 0xa4, 0x73, 0xbb, 0x1a, 0x9c, 0xe9,
+	0x2e, 0x39, 0x08, 0xcb, 0xb5, 0xd1, 0x18, 0xdb, 0xee, 0x52, 0x43, 0x95, 0xca, 0xaf, 0x08, 0xbf,
+	0x98, 0x14, 0x4b, 0xf9, 0xe5, 0x47, 0x51, 0xbc, 0x93, 0xdc, 0x69, 0x5a, 0x14, 0xda, 0x0c, 0x2b,
+	0xd5, 0x5a, 0x9b, 0x44, 0x28, 0xc5, 0xbf, 0x10, 0x76, 0x87, 0x82, 0x01, 0x99, 0xe6, 0xce, 0xdb,
+	0xea, 0x07, 0x9d, 0xf3, 0x81, 0x61, 0x25, 0x5b, 0x17, 0x22, 0xa5, 0xef, 0x5d, 0x4b, 0x96, 0xb4,
+	0x7f, 0x0b, 0x5d, 0xd4, 0x96, 0x78, 0xf9, 0x83, 0x51, 0xea, 0x37, 0x47, 0xd2, 0xe1, 0xb4, 0x8c,
+	0xf7, 0x2e, 0x0f, 0xdb, 0xd5, 0x96, 0xb2, 0x0c, 0xad, 0x33, 0x8c, 0x5b, 0xd9, 0x45, 0x40, 0xa6,
+	0xd4, 0x6b, 0x87, 0xc1, 0x21, 0x1d, 0x1b, 0x76, 0x86, 0x59, 0xcc, 0xae, 0x33, 0xcc, 0xd3, 0xda,
+	0xeb, 0x9f, 0xe4, 0x74, 0xe8, 0x5a, 0xbb, 0x16, 0xe7, 0xaa, 0xd0, 0x6c, 0xaf, 0x7a, 0x80, 0x92,
+	0xfb, 0x11, 0xe1, 0xe7, 0x7a, 0x94, 0x67, 0x56, 0xcc, 0x6c, 0xce, 0x39, 0x4e, 0x8a, 0xdd, 0xa9,
+	0x8a, 0x4b, 0xad, 0x96, 0x7f, 0x7c, 0xea, 0xd6, 0x4e, 0x4e, 0xdd, 0xda, 0xf9, 0xa9, 0x8b, 0xbe,
+	0x5e, 0xba, 0xe8, 0xf7, 0xa5, 0x8b, 0xfe, 0x5d, 0xba, 0xe8, 0x78, 0xe9, 0xa2, 0xff, 0x96, 0x2e,
+	0xfa, 0x7f, 0xe9, 0xd6, 0xce, 0x97, 0x2e, 0xfa, 0xfe, 0xcc, 0xad, 0x1d, 0x9f, 0xb9, 0xb5, 0x93,
+	0x33, 0xb7, 0xf6, 0xd9, 0xad, 0x71, 0x78, 0x39, 0x32, 0x0d, 0xd7, 0xbc, 0x6a, 0xdc, 0x49, 0x7f,
+	0x3e, 0x78, 0xe2, 0xe2, 0x3d, 0xe3, 0xdb, 0x8f, 0x07, 0x00, 0x5f, 0x79, 0xfa, 0xf6, 0xfd, 0x14,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -140,8 +160,55 @@ type AdminServiceClient interface {
 	MergeDLQMessages(ctx context.Context, in *MergeDLQMessagesRequest, opts ...grpc.CallOption) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	// RestoreWorkflowExecution restores a closed workflow execution from the history archival of its namespace.
+	// The execution is written in closed state, it can then be queried, described or reset.
+	RestoreWorkflowExecution(ctx context.Context, in *RestoreWorkflowExecutionRequest, opts ...grpc.CallOption) (*RestoreWorkflowExecutionResponse, error)
+	// DeleteNamespace marks a namespace as deleted, which blocks new traffic to it, and starts a system workflow
+	// which terminates and deletes its workflow executions, purges its visibility records and task queues and
+	// finally removes the namespace metadata.
+	DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error)
+	// UpdateNamespace applies the update of the public UpdateNamespace API together with the namespace options
+	// it doesn't carry, the new name and the default deadline rules. A rename keeps the previous name as an alias
+	// resolving to the namespace for the requested retention. Updates of global namespaces are replicated to the
+	// other clusters.
+	UpdateNamespace(ctx context.Context, in *UpdateNamespaceRequest, opts ...grpc.CallOption) (*UpdateNamespaceResponse, error)
+	// DescribeNamespaceAliases returns the unexpired aliases of a namespace.
+	DescribeNamespaceAliases(ctx context.Context, in *DescribeNamespaceAliasesRequest, opts ...grpc.CallOption) (*DescribeNamespaceAliasesResponse, error)
+	// StartWorkflowExecution starts a workflow like the public StartWorkflowExecution API, together with the
+	// workflow options the public API doesn't carry, the deadline rules of the run.
+	StartWorkflowExecution(ctx context.Context, in *StartWorkflowExecutionRequest, opts ...grpc.CallOption) (*StartWorkflowExecutionResponse, error)
+	// SignalWithStartWorkflowExecution signals or starts a workflow like the public SignalWithStartWorkflowExecution
+	// API, together with the workflow options the public API doesn't carry, the deadline rules of the run.
+	SignalWithStartWorkflowExecution(ctx context.Context, in *SignalWithStartWorkflowExecutionRequest, opts ...grpc.CallOption) (*SignalWithStartWorkflowExecutionResponse, error)
+	// PauseWorkflowExecution stops dispatching the workflow and activity tasks of a running workflow execution.
+	// Signals and fired timers are still recorded, they are delivered to the workflow once it is unpaused.
+	PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes dispatching the workflow and activity tasks of a paused workflow execution.
+	UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
+	// PauseActivity stops dispatching the tasks of a pending activity, an attempt already running on a worker
+	// is not interrupted but is not retried while the activity is paused. Activity timeouts keep running.
+	PauseActivity(ctx context.Context, in *PauseActivityRequest, opts ...grpc.CallOption) (*PauseActivityResponse, error)
+	// UnpauseActivity resumes dispatching the tasks of a paused activity.
+	UnpauseActivity(ctx context.Context, in *UnpauseActivityRequest, opts ...grpc.CallOption) (*UnpauseActivityResponse, error)
+	// ResetActivity resets the attempt counter and the retry backoff of a pending activity which is not running
+	// on a worker, the activity is dispatched again right away unless it is paused.
+	ResetActivity(ctx context.Context, in *ResetActivityRequest, opts ...grpc.CallOption) (*ResetActivityResponse, error)
+	// UpdateActivityOptions updates the retry policy and the timeouts of a pending activity in place,
+	// options which are not set are left unchanged.
+	UpdateActivityOptions(ctx context.Context, in *UpdateActivityOptionsRequest, opts ...grpc.CallOption) (*UpdateActivityOptionsResponse, error)
+	// StreamWorkflowExecutionHistory streams the history events of a workflow execution as they are committed.
+	// The stream ends once all the events of a closed workflow execution have been sent.
+	StreamWorkflowExecutionHistory(ctx context.Context, in *StreamWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (AdminService_StreamWorkflowExecutionHistoryClient, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
+	// GetDynamicConfig returns the effective value of a dynamic config key for the given filters.
+	GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error)
+	// UpdateDynamicConfig updates the value of a dynamic config key which applies without filters, the values of the key
+	// with filters are kept. Only the dynamic config file of the frontend host serving the request is updated, the
+	// change has to be made on every host, e.g. by distributing the file, to apply to the whole cluster.
+	UpdateDynamicConfig(ctx context.Context, in *UpdateDynamicConfigRequest, opts ...grpc.CallOption) (*UpdateDynamicConfigResponse, error)
+	// ListDynamicConfig returns all dynamic config keys with their effective values for the given filters.
+	ListDynamicConfig(ctx context.Context, in *ListDynamicConfigRequest, opts ...grpc.CallOption) (*ListDynamicConfigResponse, error)
 }
 
 type adminServiceClient struct {
@@ -287,6 +354,146 @@ func (c *adminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *Refre
 	return out, nil
 }
 
+func (c *adminServiceClient) RestoreWorkflowExecution(ctx context.Context, in *RestoreWorkflowExecutionRequest, opts ...grpc.CallOption) (*RestoreWorkflowExecutionResponse, error) {
+	out := new(RestoreWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RestoreWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error) {
+	out := new(DeleteNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateNamespace(ctx context.Context, in *UpdateNamespaceRequest, opts ...grpc.CallOption) (*UpdateNamespaceResponse, error) {
+	out := new(UpdateNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeNamespaceAliases(ctx context.Context, in *DescribeNamespaceAliasesRequest, opts ...grpc.CallOption) (*DescribeNamespaceAliasesResponse, error) {
+	out := new(DescribeNamespaceAliasesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeNamespaceAliases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartWorkflowExecution(ctx context.Context, in *StartWorkflowExecutionRequest, opts ...grpc.CallOption) (*StartWorkflowExecutionResponse, error) {
+	out := new(StartWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/StartWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SignalWithStartWorkflowExecution(ctx context.Context, in *SignalWithStartWorkflowExecutionRequest, opts ...grpc.CallOption) (*SignalWithStartWorkflowExecutionResponse, error) {
+	out := new(SignalWithStartWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/SignalWithStartWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	out := new(PauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/PauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	out := new(UnpauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UnpauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PauseActivity(ctx context.Context, in *PauseActivityRequest, opts ...grpc.CallOption) (*PauseActivityResponse, error) {
+	out := new(PauseActivityResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/PauseActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnpauseActivity(ctx context.Context, in *UnpauseActivityRequest, opts ...grpc.CallOption) (*UnpauseActivityResponse, error) {
+	out := new(UnpauseActivityResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UnpauseActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResetActivity(ctx context.Context, in *ResetActivityRequest, opts ...grpc.CallOption) (*ResetActivityResponse, error) {
+	out := new(ResetActivityResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ResetActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateActivityOptions(ctx context.Context, in *UpdateActivityOptionsRequest, opts ...grpc.CallOption) (*UpdateActivityOptionsResponse, error) {
+	out := new(UpdateActivityOptionsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateActivityOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StreamWorkflowExecutionHistory(ctx context.Context, in *StreamWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (AdminService_StreamWorkflowExecutionHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminService_serviceDesc.Streams[0], "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowExecutionHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceStreamWorkflowExecutionHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminService_StreamWorkflowExecutionHistoryClient interface {
+	Recv() (*StreamWorkflowExecutionHistoryResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceStreamWorkflowExecutionHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceStreamWorkflowExecutionHistoryClient) Recv() (*StreamWorkflowExecutionHistoryResponse, error) {
+	m := new(StreamWorkflowExecutionHistoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *adminServiceClient) ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error) {
 	out := new(ResendReplicationTasksResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks", in, out, opts...)
@@ -296,6 +503,33 @@ func (c *adminServiceClient) ResendReplicationTasks(ctx context.Context, in *Res
 	return out, nil
 }
 
+func (c *adminServiceClient) GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error) {
+	out := new(GetDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateDynamicConfig(ctx context.Context, in *UpdateDynamicConfigRequest, opts ...grpc.CallOption) (*UpdateDynamicConfigResponse, error) {
+	out := new(UpdateDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDynamicConfig(ctx context.Context, in *ListDynamicConfigRequest, opts ...grpc.CallOption) (*ListDynamicConfigResponse, error) {
+	out := new(ListDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 type AdminServiceServer interface {
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
@@ -329,8 +563,55 @@ type AdminServiceServer interface {
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	// RestoreWorkflowExecution restores a closed workflow execution from the history archival of its namespace.
+	// The execution is written in closed state, it can then be queried, described or reset.
+	RestoreWorkflowExecution(context.Context, *RestoreWorkflowExecutionRequest) (*RestoreWorkflowExecutionResponse, error)
+	// DeleteNamespace marks a namespace as deleted, which blocks new traffic to it, and starts a system workflow
+	// which terminates and deletes its workflow executions, purges its visibility records and task queues and
+	// finally removes the namespace metadata.
+	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error)
+	// UpdateNamespace applies the update of the public UpdateNamespace API together with the namespace options
+	// it doesn't carry, the new name and the default deadline rules. A rename keeps the previous name as an alias
+	// resolving to the namespace for the requested retention. Updates of global namespaces are replicated to the
+	// other clusters.
+	UpdateNamespace(context.Context, *UpdateNamespaceRequest) (*UpdateNamespaceResponse, error)
+	// DescribeNamespaceAliases returns the unexpired aliases of a namespace.
+	DescribeNamespaceAliases(context.Context, *DescribeNamespaceAliasesRequest) (*DescribeNamespaceAliasesResponse, error)
+	// StartWorkflowExecution starts a workflow like the public StartWorkflowExecution API, together with the
+	// workflow options the public API doesn't carry, the deadline rules of the run.
+	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest) (*StartWorkflowExecutionResponse, error)
+	// SignalWithStartWorkflowExecution signals or starts a workflow like the public SignalWithStartWorkflowExecution
+	// API, together with the workflow options the public API doesn't carry, the deadline rules of the run.
+	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest) (*SignalWithStartWorkflowExecutionResponse, error)
+	// PauseWorkflowExecution stops dispatching the workflow and activity tasks of a running workflow execution.
+	// Signals and fired timers are still recorded, they are delivered to the workflow once it is unpaused.
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes dispatching the workflow and activity tasks of a paused workflow execution.
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	// PauseActivity stops dispatching the tasks of a pending activity, an attempt already running on a worker
+	// is not interrupted but is not retried while the activity is paused. Activity timeouts keep running.
+	PauseActivity(context.Context, *PauseActivityRequest) (*PauseActivityResponse, error)
+	// UnpauseActivity resumes dispatching the tasks of a paused activity.
+	UnpauseActivity(context.Context, *UnpauseActivityRequest) (*UnpauseActivityResponse, error)
+	// ResetActivity resets the attempt counter and the retry backoff of a pending activity which is not running
+	// on a worker, the activity is dispatched again right away unless it is paused.
+	ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error)
+	// UpdateActivityOptions updates the retry policy and the timeouts of a pending activity in place,
+	// options which are not set are left unchanged.
+	UpdateActivityOptions(context.Context, *UpdateActivityOptionsRequest) (*UpdateActivityOptionsResponse, error)
+	// StreamWorkflowExecutionHistory streams the history events of a workflow execution as they are committed.
+	// The stream ends once all the events of a closed workflow execution have been sent.
+	StreamWorkflowExecutionHistory(*StreamWorkflowExecutionHistoryRequest, AdminService_StreamWorkflowExecutionHistoryServer) error
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
+	// GetDynamicConfig returns the effective value of a dynamic config key for the given filters.
+	GetDynamicConfig(context.Context, *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error)
+	// UpdateDynamicConfig updates the value of a dynamic config key which applies without filters, the values of the key
+	// with filters are kept. Only the dynamic config file of the frontend host serving the request is updated, the
+	// change has to be made on every host, e.g. by distributing the file, to apply to the whole cluster.
+	UpdateDynamicConfig(context.Context, *UpdateDynamicConfigRequest) (*UpdateDynamicConfigResponse, error)
+	// ListDynamicConfig returns all dynamic config keys with their effective values for the given filters.
+	ListDynamicConfig(context.Context, *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error)
 }
 
 // UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
@@ -382,9 +663,57 @@ func (*UnimplementedAdminServiceServer) MergeDLQMessages(ctx context.Context, re
 func (*UnimplementedAdminServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) RestoreWorkflowExecution(ctx context.Context, req *RestoreWorkflowExecutionRequest) (*RestoreWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteNamespace(ctx context.Context, req *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNamespace not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateNamespace(ctx context.Context, req *UpdateNamespaceRequest) (*UpdateNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespace not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeNamespaceAliases(ctx context.Context, req *DescribeNamespaceAliasesRequest) (*DescribeNamespaceAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeNamespaceAliases not implemented")
+}
+func (*UnimplementedAdminServiceServer) StartWorkflowExecution(ctx context.Context, req *StartWorkflowExecutionRequest) (*StartWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) SignalWithStartWorkflowExecution(ctx context.Context, req *SignalWithStartWorkflowExecutionRequest) (*SignalWithStartWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalWithStartWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) PauseWorkflowExecution(ctx context.Context, req *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) UnpauseWorkflowExecution(ctx context.Context, req *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) PauseActivity(ctx context.Context, req *PauseActivityRequest) (*PauseActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseActivity not implemented")
+}
+func (*UnimplementedAdminServiceServer) UnpauseActivity(ctx context.Context, req *UnpauseActivityRequest) (*UnpauseActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseActivity not implemented")
+}
+func (*UnimplementedAdminServiceServer) ResetActivity(ctx context.Context, req *ResetActivityRequest) (*ResetActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetActivity not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateActivityOptions(ctx context.Context, req *UpdateActivityOptionsRequest) (*UpdateActivityOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateActivityOptions not implemented")
+}
+func (*UnimplementedAdminServiceServer) StreamWorkflowExecutionHistory(req *StreamWorkflowExecutionHistoryRequest, srv AdminService_StreamWorkflowExecutionHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWorkflowExecutionHistory not implemented")
+}
 func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Context, req *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResendReplicationTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) GetDynamicConfig(ctx context.Context, req *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateDynamicConfig(ctx context.Context, req *UpdateDynamicConfigRequest) (*UpdateDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListDynamicConfig(ctx context.Context, req *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDynamicConfig not implemented")
+}
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
 	s.RegisterService(&_AdminService_serviceDesc, srv)
@@ -660,6 +989,243 @@ func _AdminService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_RestoreWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RestoreWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreWorkflowExecution(ctx, req.(*RestoreWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteNamespace(ctx, req.(*DeleteNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateNamespace(ctx, req.(*UpdateNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeNamespaceAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeNamespaceAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeNamespaceAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeNamespaceAliases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeNamespaceAliases(ctx, req.(*DescribeNamespaceAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StartWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartWorkflowExecution(ctx, req.(*StartWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SignalWithStartWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalWithStartWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SignalWithStartWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/SignalWithStartWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SignalWithStartWorkflowExecution(ctx, req.(*SignalWithStartWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseWorkflowExecution(ctx, req.(*PauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnpauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnpauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UnpauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnpauseWorkflowExecution(ctx, req.(*UnpauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PauseActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PauseActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseActivity(ctx, req.(*PauseActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnpauseActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnpauseActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UnpauseActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnpauseActivity(ctx, req.(*UnpauseActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResetActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResetActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ResetActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResetActivity(ctx, req.(*ResetActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateActivityOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivityOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateActivityOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateActivityOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateActivityOptions(ctx, req.(*UpdateActivityOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StreamWorkflowExecutionHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamWorkflowExecutionHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).StreamWorkflowExecutionHistory(m, &adminServiceStreamWorkflowExecutionHistoryServer{stream})
+}
+
+type AdminService_StreamWorkflowExecutionHistoryServer interface {
+	Send(*StreamWorkflowExecutionHistoryResponse) error
+	grpc.ServerStream
+}
+
+type adminServiceStreamWorkflowExecutionHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceStreamWorkflowExecutionHistoryServer) Send(m *StreamWorkflowExecutionHistoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _AdminService_ResendReplicationTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResendReplicationTasksRequest)
 	if err := dec(in); err != nil {
@@ -678,6 +1244,60 @@ func _AdminService_ResendReplicationTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, req.(*GetDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateDynamicConfig(ctx, req.(*UpdateDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDynamicConfig(ctx, req.(*ListDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.adminservice.v1.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -742,11 +1362,77 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshWorkflowTasks",
 			Handler:    _AdminService_RefreshWorkflowTasks_Handler,
 		},
+		{
+			MethodName: "RestoreWorkflowExecution",
+			Handler:    _AdminService_RestoreWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "DeleteNamespace",
+			Handler:    _AdminService_DeleteNamespace_Handler,
+		},
+		{
+			MethodName: "UpdateNamespace",
+			Handler:    _AdminService_UpdateNamespace_Handler,
+		},
+		{
+			MethodName: "DescribeNamespaceAliases",
+			Handler:    _AdminService_DescribeNamespaceAliases_Handler,
+		},
+		{
+			MethodName: "StartWorkflowExecution",
+			Handler:    _AdminService_StartWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "SignalWithStartWorkflowExecution",
+			Handler:    _AdminService_SignalWithStartWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "PauseWorkflowExecution",
+			Handler:    _AdminService_PauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UnpauseWorkflowExecution",
+			Handler:    _AdminService_UnpauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "PauseActivity",
+			Handler:    _AdminService_PauseActivity_Handler,
+		},
+		{
+			MethodName: "UnpauseActivity",
+			Handler:    _AdminService_UnpauseActivity_Handler,
+		},
+		{
+			MethodName: "ResetActivity",
+			Handler:    _AdminService_ResetActivity_Handler,
+		},
+		{
+			MethodName: "UpdateActivityOptions",
+			Handler:    _AdminService_UpdateActivityOptions_Handler,
+		},
 		{
 			MethodName: "ResendReplicationTasks",
 			Handler:    _AdminService_ResendReplicationTasks_Handler,
 		},
+		{
+			MethodName: "GetDynamicConfig",
+			Handler:    _AdminService_GetDynamicConfig_Handler,
+		},
+		{
+			MethodName: "UpdateDynamicConfig",
+			Handler:    _AdminService_UpdateDynamicConfig_Handler,
+		},
+		{
+			MethodName: "ListDynamicConfig",
+			Handler:    _AdminService_ListDynamicConfig_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamWorkflowExecutionHistory",
+			Handler:       _AdminService_StreamWorkflowExecutionHistory_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/adminservice/v1/service.proto",
 }
@@ -35,6 +35,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	adminservice "go.temporal.io/server/api/adminservice/v1"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // MockAdminServiceClient is a mock of AdminServiceClient interface.
@@ -360,6 +361,266 @@ func (mr *MockAdminServiceClientMockRecorder) RefreshWorkflowTasks(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).RefreshWorkflowTasks), varargs...)
 }
 
+// RestoreWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) RestoreWorkflowExecution(ctx context.Context, in *adminservice.RestoreWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.RestoreWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.RestoreWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreWorkflowExecution indicates an expected call of RestoreWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) RestoreWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).RestoreWorkflowExecution), varargs...)
+}
+
+// DeleteNamespace mocks base method.
+func (m *MockAdminServiceClient) DeleteNamespace(ctx context.Context, in *adminservice.DeleteNamespaceRequest, opts ...grpc.CallOption) (*adminservice.DeleteNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNamespace", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNamespace indicates an expected call of DeleteNamespace.
+func (mr *MockAdminServiceClientMockRecorder) DeleteNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespace", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteNamespace), varargs...)
+}
+
+// UpdateNamespace mocks base method.
+func (m *MockAdminServiceClient) UpdateNamespace(ctx context.Context, in *adminservice.UpdateNamespaceRequest, opts ...grpc.CallOption) (*adminservice.UpdateNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNamespace", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNamespace indicates an expected call of UpdateNamespace.
+func (mr *MockAdminServiceClientMockRecorder) UpdateNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespace", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateNamespace), varargs...)
+}
+
+// DescribeNamespaceAliases mocks base method.
+func (m *MockAdminServiceClient) DescribeNamespaceAliases(ctx context.Context, in *adminservice.DescribeNamespaceAliasesRequest, opts ...grpc.CallOption) (*adminservice.DescribeNamespaceAliasesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeNamespaceAliases", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespaceAliasesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaceAliases indicates an expected call of DescribeNamespaceAliases.
+func (mr *MockAdminServiceClientMockRecorder) DescribeNamespaceAliases(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceAliases", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeNamespaceAliases), varargs...)
+}
+
+// StartWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) StartWorkflowExecution(ctx context.Context, in *adminservice.StartWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.StartWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartWorkflowExecution indicates an expected call of StartWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) StartWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).StartWorkflowExecution), varargs...)
+}
+
+// SignalWithStartWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) SignalWithStartWorkflowExecution(ctx context.Context, in *adminservice.SignalWithStartWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.SignalWithStartWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SignalWithStartWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.SignalWithStartWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignalWithStartWorkflowExecution indicates an expected call of SignalWithStartWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) SignalWithStartWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalWithStartWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).SignalWithStartWorkflowExecution), varargs...)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) PauseWorkflowExecution(ctx context.Context, in *adminservice.PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) PauseWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).PauseWorkflowExecution), varargs...)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *adminservice.UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) UnpauseWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).UnpauseWorkflowExecution), varargs...)
+}
+
+// PauseActivity mocks base method.
+func (m *MockAdminServiceClient) PauseActivity(ctx context.Context, in *adminservice.PauseActivityRequest, opts ...grpc.CallOption) (*adminservice.PauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseActivity", varargs...)
+	ret0, _ := ret[0].(*adminservice.PauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockAdminServiceClientMockRecorder) PauseActivity(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockAdminServiceClient)(nil).PauseActivity), varargs...)
+}
+
+// UnpauseActivity mocks base method.
+func (m *MockAdminServiceClient) UnpauseActivity(ctx context.Context, in *adminservice.UnpauseActivityRequest, opts ...grpc.CallOption) (*adminservice.UnpauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseActivity", varargs...)
+	ret0, _ := ret[0].(*adminservice.UnpauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockAdminServiceClientMockRecorder) UnpauseActivity(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockAdminServiceClient)(nil).UnpauseActivity), varargs...)
+}
+
+// ResetActivity mocks base method.
+func (m *MockAdminServiceClient) ResetActivity(ctx context.Context, in *adminservice.ResetActivityRequest, opts ...grpc.CallOption) (*adminservice.ResetActivityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetActivity", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResetActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetActivity indicates an expected call of ResetActivity.
+func (mr *MockAdminServiceClientMockRecorder) ResetActivity(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivity", reflect.TypeOf((*MockAdminServiceClient)(nil).ResetActivity), varargs...)
+}
+
+// UpdateActivityOptions mocks base method.
+func (m *MockAdminServiceClient) UpdateActivityOptions(ctx context.Context, in *adminservice.UpdateActivityOptionsRequest, opts ...grpc.CallOption) (*adminservice.UpdateActivityOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateActivityOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockAdminServiceClientMockRecorder) UpdateActivityOptions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateActivityOptions), varargs...)
+}
+
+// StreamWorkflowExecutionHistory mocks base method.
+func (m *MockAdminServiceClient) StreamWorkflowExecutionHistory(ctx context.Context, in *adminservice.StreamWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowExecutionHistoryClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamWorkflowExecutionHistory", varargs...)
+	ret0, _ := ret[0].(adminservice.AdminService_StreamWorkflowExecutionHistoryClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamWorkflowExecutionHistory indicates an expected call of StreamWorkflowExecutionHistory.
+func (mr *MockAdminServiceClientMockRecorder) StreamWorkflowExecutionHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowExecutionHistory", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamWorkflowExecutionHistory), varargs...)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockAdminServiceClient) ResendReplicationTasks(ctx context.Context, in *adminservice.ResendReplicationTasksRequest, opts ...grpc.CallOption) (*adminservice.ResendReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -380,6 +641,189 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// GetDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) GetDynamicConfig(ctx context.Context, in *adminservice.GetDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) GetDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDynamicConfig), varargs...)
+}
+
+// UpdateDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) UpdateDynamicConfig(ctx context.Context, in *adminservice.UpdateDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.UpdateDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDynamicConfig indicates an expected call of UpdateDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) UpdateDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateDynamicConfig), varargs...)
+}
+
+// ListDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) ListDynamicConfig(ctx context.Context, in *adminservice.ListDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.ListDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfig indicates an expected call of ListDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) ListDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDynamicConfig), varargs...)
+}
+
+// MockAdminService_StreamWorkflowExecutionHistoryClient is a mock of AdminService_StreamWorkflowExecutionHistoryClient interface.
+type MockAdminService_StreamWorkflowExecutionHistoryClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder
+}
+
+// MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder is the mock recorder for MockAdminService_StreamWorkflowExecutionHistoryClient.
+type MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder struct {
+	mock *MockAdminService_StreamWorkflowExecutionHistoryClient
+}
+
+// NewMockAdminService_StreamWorkflowExecutionHistoryClient creates a new mock instance.
+func NewMockAdminService_StreamWorkflowExecutionHistoryClient(ctrl *gomock.Controller) *MockAdminService_StreamWorkflowExecutionHistoryClient {
+	mock := &MockAdminService_StreamWorkflowExecutionHistoryClient{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) EXPECT() *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder {
+	return m.recorder
+}
+
+// Recv mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) Recv() (*adminservice.StreamWorkflowExecutionHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*adminservice.StreamWorkflowExecutionHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).Recv))
+}
+
+// Header mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).Header))
+}
+
+// Trailer mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).Trailer))
+}
+
+// CloseSend mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).Context))
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamWorkflowExecutionHistoryClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamWorkflowExecutionHistoryClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).RecvMsg), m)
+}
+
 // MockAdminServiceServer is a mock of AdminServiceServer interface.
 type MockAdminServiceServer struct {
 	ctrl     *gomock.Controller
@@ -628,6 +1072,200 @@ func (mr *MockAdminServiceServerMockRecorder) RefreshWorkflowTasks(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).RefreshWorkflowTasks), arg0, arg1)
 }
 
+// RestoreWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) RestoreWorkflowExecution(arg0 context.Context, arg1 *adminservice.RestoreWorkflowExecutionRequest) (*adminservice.RestoreWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RestoreWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreWorkflowExecution indicates an expected call of RestoreWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) RestoreWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).RestoreWorkflowExecution), arg0, arg1)
+}
+
+// DeleteNamespace mocks base method.
+func (m *MockAdminServiceServer) DeleteNamespace(arg0 context.Context, arg1 *adminservice.DeleteNamespaceRequest) (*adminservice.DeleteNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNamespace indicates an expected call of DeleteNamespace.
+func (mr *MockAdminServiceServerMockRecorder) DeleteNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespace", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteNamespace), arg0, arg1)
+}
+
+// UpdateNamespace mocks base method.
+func (m *MockAdminServiceServer) UpdateNamespace(arg0 context.Context, arg1 *adminservice.UpdateNamespaceRequest) (*adminservice.UpdateNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNamespace indicates an expected call of UpdateNamespace.
+func (mr *MockAdminServiceServerMockRecorder) UpdateNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespace", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateNamespace), arg0, arg1)
+}
+
+// DescribeNamespaceAliases mocks base method.
+func (m *MockAdminServiceServer) DescribeNamespaceAliases(arg0 context.Context, arg1 *adminservice.DescribeNamespaceAliasesRequest) (*adminservice.DescribeNamespaceAliasesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNamespaceAliases", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespaceAliasesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaceAliases indicates an expected call of DescribeNamespaceAliases.
+func (mr *MockAdminServiceServerMockRecorder) DescribeNamespaceAliases(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceAliases", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeNamespaceAliases), arg0, arg1)
+}
+
+// StartWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) StartWorkflowExecution(arg0 context.Context, arg1 *adminservice.StartWorkflowExecutionRequest) (*adminservice.StartWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartWorkflowExecution indicates an expected call of StartWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) StartWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).StartWorkflowExecution), arg0, arg1)
+}
+
+// SignalWithStartWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) SignalWithStartWorkflowExecution(arg0 context.Context, arg1 *adminservice.SignalWithStartWorkflowExecutionRequest) (*adminservice.SignalWithStartWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignalWithStartWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SignalWithStartWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignalWithStartWorkflowExecution indicates an expected call of SignalWithStartWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) SignalWithStartWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalWithStartWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).SignalWithStartWorkflowExecution), arg0, arg1)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) PauseWorkflowExecution(arg0 context.Context, arg1 *adminservice.PauseWorkflowExecutionRequest) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) PauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).PauseWorkflowExecution), arg0, arg1)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) UnpauseWorkflowExecution(arg0 context.Context, arg1 *adminservice.UnpauseWorkflowExecutionRequest) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) UnpauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).UnpauseWorkflowExecution), arg0, arg1)
+}
+
+// PauseActivity mocks base method.
+func (m *MockAdminServiceServer) PauseActivity(arg0 context.Context, arg1 *adminservice.PauseActivityRequest) (*adminservice.PauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockAdminServiceServerMockRecorder) PauseActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockAdminServiceServer)(nil).PauseActivity), arg0, arg1)
+}
+
+// UnpauseActivity mocks base method.
+func (m *MockAdminServiceServer) UnpauseActivity(arg0 context.Context, arg1 *adminservice.UnpauseActivityRequest) (*adminservice.UnpauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UnpauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockAdminServiceServerMockRecorder) UnpauseActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockAdminServiceServer)(nil).UnpauseActivity), arg0, arg1)
+}
+
+// ResetActivity mocks base method.
+func (m *MockAdminServiceServer) ResetActivity(arg0 context.Context, arg1 *adminservice.ResetActivityRequest) (*adminservice.ResetActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivity", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ResetActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetActivity indicates an expected call of ResetActivity.
+func (mr *MockAdminServiceServerMockRecorder) ResetActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivity", reflect.TypeOf((*MockAdminServiceServer)(nil).ResetActivity), arg0, arg1)
+}
+
+// UpdateActivityOptions mocks base method.
+func (m *MockAdminServiceServer) UpdateActivityOptions(arg0 context.Context, arg1 *adminservice.UpdateActivityOptionsRequest) (*adminservice.UpdateActivityOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateActivityOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockAdminServiceServerMockRecorder) UpdateActivityOptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateActivityOptions), arg0, arg1)
+}
+
+// StreamWorkflowExecutionHistory mocks base method.
+func (m *MockAdminServiceServer) StreamWorkflowExecutionHistory(arg0 *adminservice.StreamWorkflowExecutionHistoryRequest, arg1 adminservice.AdminService_StreamWorkflowExecutionHistoryServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamWorkflowExecutionHistory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamWorkflowExecutionHistory indicates an expected call of StreamWorkflowExecutionHistory.
+func (mr *MockAdminServiceServerMockRecorder) StreamWorkflowExecutionHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowExecutionHistory", reflect.TypeOf((*MockAdminServiceServer)(nil).StreamWorkflowExecutionHistory), arg0, arg1)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockAdminServiceServer) ResendReplicationTasks(arg0 context.Context, arg1 *adminservice.ResendReplicationTasksRequest) (*adminservice.ResendReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -642,3 +1280,167 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
+
+// GetDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) GetDynamicConfig(arg0 context.Context, arg1 *adminservice.GetDynamicConfigRequest) (*adminservice.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) GetDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDynamicConfig), arg0, arg1)
+}
+
+// UpdateDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) UpdateDynamicConfig(arg0 context.Context, arg1 *adminservice.UpdateDynamicConfigRequest) (*adminservice.UpdateDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDynamicConfig indicates an expected call of UpdateDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) UpdateDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateDynamicConfig), arg0, arg1)
+}
+
+// ListDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) ListDynamicConfig(arg0 context.Context, arg1 *adminservice.ListDynamicConfigRequest) (*adminservice.ListDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfig indicates an expected call of ListDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) ListDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDynamicConfig), arg0, arg1)
+}
+
+// MockAdminService_StreamWorkflowExecutionHistoryServer is a mock of AdminService_StreamWorkflowExecutionHistoryServer interface.
+type MockAdminService_StreamWorkflowExecutionHistoryServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder
+}
+
+// MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder is the mock recorder for MockAdminService_StreamWorkflowExecutionHistoryServer.
+type MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder struct {
+	mock *MockAdminService_StreamWorkflowExecutionHistoryServer
+}
+
+// NewMockAdminService_StreamWorkflowExecutionHistoryServer creates a new mock instance.
+func NewMockAdminService_StreamWorkflowExecutionHistoryServer(ctrl *gomock.Controller) *MockAdminService_StreamWorkflowExecutionHistoryServer {
+	mock := &MockAdminService_StreamWorkflowExecutionHistoryServer{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) EXPECT() *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) Send(arg0 *adminservice.StreamWorkflowExecutionHistoryResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).Send), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).SetHeader), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).SendHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).SetTrailer), arg0)
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).Context))
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamWorkflowExecutionHistoryServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamWorkflowExecutionHistoryServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).RecvMsg), m)
+}
@@ -69,6 +69,8 @@ type HistoryBlobHeader struct {
 	FirstEventId         int64  `protobuf:"varint,8,opt,name=first_event_id,json=firstEventId,proto3" json:"first_event_id,omitempty"`
 	LastEventId          int64  `protobuf:"varint,9,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
 	EventCount           int64  `protobuf:"varint,10,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	// Checksum of the blob body, see archiver.HistoryChecksum, empty for blobs archived without checksum.
+	Checksum string `protobuf:"bytes,11,opt,name=checksum,proto3" json:"checksum,omitempty"`
 }
 
 func (m *HistoryBlobHeader) Reset()      { *m = HistoryBlobHeader{} }
@@ -173,6 +175,13 @@ func (m *HistoryBlobHeader) GetEventCount() int64 {
 	return 0
 }
 
+func (m *HistoryBlobHeader) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
 type HistoryBlob struct {
 	Header *HistoryBlobHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Body   []*v1.History      `protobuf:"bytes,2,rep,name=body,proto3" json:"body,omitempty"`
@@ -364,11 +373,233 @@ func (m *ArchiveVisibilityRequest) GetHistoryArchivalUri() string {
 	return ""
 }
 
+type WorkflowBundleHeader struct {
+	// Version of the bundle format, see archiver.WorkflowBundleFormatVersion.
+	FormatVersion        int32                       `protobuf:"varint,1,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`
+	Namespace            string                      `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NamespaceId          string                      `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId           string                      `protobuf:"bytes,4,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId                string                      `protobuf:"bytes,5,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	WorkflowTypeName     string                      `protobuf:"bytes,6,opt,name=workflow_type_name,json=workflowTypeName,proto3" json:"workflow_type_name,omitempty"`
+	Status               v11.WorkflowExecutionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
+	StartTime            *time.Time                  `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	CloseTime            *time.Time                  `protobuf:"bytes,9,opt,name=close_time,json=closeTime,proto3,stdtime" json:"close_time,omitempty"`
+	CloseFailoverVersion int64                       `protobuf:"varint,10,opt,name=close_failover_version,json=closeFailoverVersion,proto3" json:"close_failover_version,omitempty"`
+	EventCount           int64                       `protobuf:"varint,11,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	// Name of the cluster the bundle was created in.
+	SourceCluster string `protobuf:"bytes,12,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	// Checksum of the bundled history, see archiver.HistoryChecksum.
+	Checksum string `protobuf:"bytes,13,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *WorkflowBundleHeader) Reset()      { *m = WorkflowBundleHeader{} }
+func (*WorkflowBundleHeader) ProtoMessage() {}
+func (*WorkflowBundleHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ad6e64b6a1a2278, []int{3}
+}
+func (m *WorkflowBundleHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowBundleHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowBundleHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowBundleHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowBundleHeader.Merge(m, src)
+}
+func (m *WorkflowBundleHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowBundleHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowBundleHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowBundleHeader proto.InternalMessageInfo
+
+func (m *WorkflowBundleHeader) GetFormatVersion() int32 {
+	if m != nil {
+		return m.FormatVersion
+	}
+	return 0
+}
+
+func (m *WorkflowBundleHeader) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowBundleHeader) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *WorkflowBundleHeader) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *WorkflowBundleHeader) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *WorkflowBundleHeader) GetWorkflowTypeName() string {
+	if m != nil {
+		return m.WorkflowTypeName
+	}
+	return ""
+}
+
+func (m *WorkflowBundleHeader) GetStatus() v11.WorkflowExecutionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return v11.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
+}
+
+func (m *WorkflowBundleHeader) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *WorkflowBundleHeader) GetCloseTime() *time.Time {
+	if m != nil {
+		return m.CloseTime
+	}
+	return nil
+}
+
+func (m *WorkflowBundleHeader) GetCloseFailoverVersion() int64 {
+	if m != nil {
+		return m.CloseFailoverVersion
+	}
+	return 0
+}
+
+func (m *WorkflowBundleHeader) GetEventCount() int64 {
+	if m != nil {
+		return m.EventCount
+	}
+	return 0
+}
+
+func (m *WorkflowBundleHeader) GetSourceCluster() string {
+	if m != nil {
+		return m.SourceCluster
+	}
+	return ""
+}
+
+func (m *WorkflowBundleHeader) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+// WorkflowBundle holds everything known about a closed workflow in a single self-describing artifact.
+type WorkflowBundle struct {
+	Header *WorkflowBundleHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// Final mutable state of the workflow, JSON encoded in the same format as
+	// the database_mutable_state returned by the admin DescribeWorkflowExecution API.
+	MutableState     string                `protobuf:"bytes,2,opt,name=mutable_state,json=mutableState,proto3" json:"mutable_state,omitempty"`
+	Memo             *v12.Memo             `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	SearchAttributes *v12.SearchAttributes `protobuf:"bytes,4,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	History          []*v1.History         `protobuf:"bytes,5,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (m *WorkflowBundle) Reset()      { *m = WorkflowBundle{} }
+func (*WorkflowBundle) ProtoMessage() {}
+func (*WorkflowBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ad6e64b6a1a2278, []int{4}
+}
+func (m *WorkflowBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowBundle.Merge(m, src)
+}
+func (m *WorkflowBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowBundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowBundle proto.InternalMessageInfo
+
+func (m *WorkflowBundle) GetHeader() *WorkflowBundleHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *WorkflowBundle) GetMutableState() string {
+	if m != nil {
+		return m.MutableState
+	}
+	return ""
+}
+
+func (m *WorkflowBundle) GetMemo() *v12.Memo {
+	if m != nil {
+		return m.Memo
+	}
+	return nil
+}
+
+func (m *WorkflowBundle) GetSearchAttributes() *v12.SearchAttributes {
+	if m != nil {
+		return m.SearchAttributes
+	}
+	return nil
+}
+
+func (m *WorkflowBundle) GetHistory() []*v1.History {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*HistoryBlobHeader)(nil), "temporal.server.api.archiver.v1.HistoryBlobHeader")
 	proto.RegisterType((*HistoryBlob)(nil), "temporal.server.api.archiver.v1.HistoryBlob")
 	proto.RegisterType((*ArchiveVisibilityRequest)(nil), "temporal.server.api.archiver.v1.ArchiveVisibilityRequest")
 	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.archiver.v1.ArchiveVisibilityRequest.SearchAttributesEntry")
+	proto.RegisterType((*WorkflowBundleHeader)(nil), "temporal.server.api.archiver.v1.WorkflowBundleHeader")
+	proto.RegisterType((*WorkflowBundle)(nil), "temporal.server.api.archiver.v1.WorkflowBundle")
 }
 
 func init() {
@@ -376,58 +607,71 @@ func init() {
 }
 
 var fileDescriptor_7ad6e64b6a1a2278 = []byte{
-	// 816 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0xa9, 0x13, 0xbf, 0x4d, 0xa2, 0x74, 0x48, 0x60, 0x65, 0x55, 0x6b, 0x37, 0x6a,
-	0xa5, 0x1c, 0x60, 0xdd, 0x9a, 0x1e, 0x10, 0x1c, 0xaa, 0xa4, 0x4a, 0x69, 0x50, 0x01, 0x69, 0x5b,
-	0x8a, 0xc4, 0x65, 0x35, 0xf6, 0xbe, 0x38, 0xa3, 0xee, 0xee, 0x2c, 0x33, 0xb3, 0x2e, 0x96, 0x38,
-	0xf0, 0x0f, 0xe8, 0x6f, 0xe0, 0xc4, 0x4f, 0xe1, 0x98, 0x63, 0x6f, 0x10, 0xe7, 0xc2, 0xb1, 0x67,
-	0x4e, 0x68, 0xde, 0xee, 0x3a, 0x75, 0x4c, 0xd5, 0x8a, 0xdb, 0xcc, 0xf7, 0x7d, 0xef, 0x9b, 0xe7,
-	0x79, 0xdf, 0x78, 0xe1, 0x13, 0x83, 0x69, 0x2e, 0x15, 0x4f, 0xfa, 0x1a, 0xd5, 0x04, 0x55, 0x9f,
-	0xe7, 0xa2, 0xcf, 0xd5, 0xe8, 0x54, 0xd8, 0xcd, 0xe4, 0x6e, 0x3f, 0x45, 0xad, 0xf9, 0x18, 0x83,
-	0x5c, 0x49, 0x23, 0x59, 0xb7, 0x96, 0x07, 0xa5, 0x3c, 0xe0, 0xb9, 0x08, 0x6a, 0x79, 0x30, 0xb9,
-	0xdb, 0xe9, 0x8e, 0xa5, 0x1c, 0x27, 0xd8, 0x27, 0xf9, 0xb0, 0x38, 0xe9, 0x1b, 0x91, 0xa2, 0x36,
-	0x3c, 0xcd, 0x4b, 0x87, 0xce, 0xcd, 0x18, 0x73, 0xcc, 0x62, 0xcc, 0x46, 0x02, 0x75, 0x7f, 0x2c,
-	0xc7, 0x92, 0x70, 0x5a, 0x55, 0x92, 0x5b, 0xf3, 0x9e, 0x6c, 0x33, 0x23, 0x99, 0xa6, 0x32, 0x5b,
-	0x6a, 0xa5, 0x73, 0x7b, 0x41, 0x75, 0x2a, 0xb4, 0x91, 0x6a, 0xba, 0x2c, 0x5b, 0x34, 0xc3, 0xac,
-	0x48, 0xb5, 0x15, 0xbd, 0x90, 0xea, 0xf9, 0x49, 0x22, 0x5f, 0x94, 0xaa, 0xbd, 0x7f, 0x9a, 0x70,
-	0xfd, 0x51, 0x69, 0x71, 0x98, 0xc8, 0xe1, 0x23, 0xe4, 0x31, 0x2a, 0x76, 0x03, 0xda, 0x19, 0x4f,
-	0x51, 0xe7, 0x7c, 0x84, 0x9e, 0xd3, 0x73, 0xf6, 0xdb, 0xe1, 0x25, 0xc0, 0x6e, 0xc2, 0xc6, 0x7c,
-	0x13, 0x89, 0xd8, 0x6b, 0x92, 0xc0, 0x9d, 0x63, 0xc7, 0x31, 0xeb, 0x82, 0x5b, 0x1f, 0x64, 0x15,
-	0x2b, 0xa4, 0x80, 0x1a, 0x3a, 0x8e, 0xd9, 0x2e, 0xb4, 0x54, 0x91, 0x59, 0x6e, 0x95, 0xb8, 0x6b,
-	0xaa, 0xc8, 0x8e, 0x63, 0xf6, 0x11, 0xac, 0x09, 0x1d, 0x25, 0x5c, 0x1b, 0xef, 0x5a, 0xcf, 0xd9,
-	0x5f, 0x0f, 0x5b, 0x42, 0x3f, 0xe6, 0xda, 0xb0, 0x7b, 0xf0, 0xe1, 0x89, 0x50, 0xda, 0x44, 0x27,
-	0x5c, 0x24, 0x72, 0x82, 0x2a, 0x9a, 0xa0, 0xd2, 0x42, 0x66, 0x5e, 0xab, 0xe7, 0xec, 0xaf, 0x84,
-	0x3b, 0xc4, 0x3e, 0xac, 0xc8, 0x67, 0x25, 0xc7, 0x06, 0xb0, 0x6b, 0xbd, 0x96, 0x8b, 0xd6, 0xa8,
-	0xe8, 0x03, 0x4b, 0x5e, 0xad, 0xb9, 0x05, 0x5b, 0xe5, 0x49, 0x38, 0xc1, 0xcc, 0xd8, 0x0e, 0xd7,
-	0x49, 0xbc, 0x41, 0xe8, 0x91, 0x05, 0x8f, 0x63, 0xb6, 0x07, 0x9b, 0xe4, 0x3c, 0x17, 0xb5, 0x49,
-	0xe4, 0x5a, 0xb0, 0xd6, 0x74, 0xc1, 0x2d, 0xe9, 0x91, 0x2c, 0x32, 0xe3, 0x01, 0x29, 0x80, 0xa0,
-	0x07, 0x16, 0xd9, 0xfb, 0xd5, 0x01, 0xf7, 0x8d, 0xcb, 0x67, 0x5f, 0x41, 0xeb, 0x94, 0x06, 0x40,
-	0x77, 0xee, 0x0e, 0x06, 0xc1, 0x3b, 0x52, 0x17, 0x2c, 0x8d, 0x2e, 0xac, 0x1c, 0xd8, 0x3d, 0x58,
-	0x1d, 0xca, 0x78, 0xea, 0x35, 0x7b, 0x2b, 0xfb, 0xee, 0xa0, 0x77, 0xe9, 0x64, 0x2d, 0xaa, 0xd0,
-	0xbc, 0xe1, 0x10, 0x92, 0x7a, 0xef, 0xb7, 0x16, 0x78, 0x07, 0xa5, 0xff, 0x33, 0xa1, 0xc5, 0x50,
-	0x24, 0xc2, 0x4c, 0x43, 0xfc, 0xb1, 0x40, 0x6d, 0x96, 0xe6, 0xee, 0x2c, 0xcf, 0x7d, 0x21, 0x38,
-	0xcd, 0xab, 0xc1, 0xf9, 0xbf, 0xa9, 0xf8, 0x18, 0xd8, 0xbc, 0xce, 0x4c, 0x73, 0x8c, 0xac, 0x25,
-	0x05, 0xa4, 0x1d, 0x6e, 0xd7, 0xcc, 0xd3, 0x69, 0x8e, 0xdf, 0xf0, 0x14, 0xd9, 0x7d, 0x00, 0x6d,
-	0xb8, 0x32, 0x91, 0x7d, 0x81, 0x14, 0x0f, 0x77, 0xd0, 0x09, 0xca, 0xe7, 0x19, 0xd4, 0xcf, 0x33,
-	0x78, 0x5a, 0x3f, 0xcf, 0xc3, 0xd5, 0x97, 0x7f, 0x76, 0x9d, 0xb0, 0x4d, 0x35, 0x16, 0x65, 0x5f,
-	0xc2, 0x16, 0xfe, 0x84, 0xa3, 0xc2, 0x08, 0x99, 0x95, 0x26, 0x6b, 0xef, 0x69, 0xb2, 0x39, 0xaf,
-	0x23, 0xa3, 0xfb, 0x00, 0xa3, 0x44, 0x6a, 0x2c, 0x4d, 0xd6, 0xdf, 0xb7, 0x13, 0xaa, 0x21, 0x83,
-	0x87, 0xd0, 0xd2, 0x86, 0x9b, 0x42, 0x53, 0xbc, 0xb6, 0x06, 0xc1, 0xe2, 0x18, 0xe9, 0x51, 0xdb,
-	0x21, 0x7e, 0x5f, 0xdd, 0xc1, 0x51, 0x7d, 0xfc, 0x13, 0xaa, 0x0a, 0xab, 0x6a, 0x76, 0x1b, 0xb6,
-	0xaa, 0x91, 0x47, 0x09, 0x66, 0x63, 0x73, 0x5a, 0x85, 0x71, 0xb3, 0x42, 0x1f, 0x13, 0xc8, 0xee,
-	0xc0, 0x6a, 0x8a, 0xa9, 0xf4, 0x5c, 0xea, 0xf4, 0xc6, 0xe2, 0x61, 0xe5, 0xdf, 0x91, 0x3d, 0xed,
-	0x6b, 0x4c, 0x65, 0x48, 0x4a, 0xf6, 0x33, 0x5c, 0xd7, 0x68, 0x03, 0x19, 0x71, 0x63, 0x94, 0x18,
-	0x16, 0x06, 0xb5, 0xb7, 0x41, 0x91, 0xfb, 0xf6, 0x9d, 0xe1, 0x7d, 0x5b, 0xd0, 0x82, 0x27, 0x64,
-	0x79, 0x30, 0x77, 0x3c, 0xca, 0x8c, 0x9a, 0x86, 0xdb, 0xfa, 0x0a, 0xcc, 0xee, 0xc0, 0x4e, 0xfd,
-	0xb3, 0x4a, 0x5f, 0x9e, 0x44, 0x85, 0x12, 0xde, 0x26, 0x25, 0x83, 0x55, 0xdc, 0x41, 0x45, 0x7d,
-	0xa7, 0x44, 0xe7, 0x01, 0xec, 0xfe, 0xa7, 0x39, 0xdb, 0x86, 0x95, 0xe7, 0x38, 0xad, 0x22, 0x6d,
-	0x97, 0x6c, 0x07, 0xae, 0x4d, 0x78, 0x52, 0xd4, 0x31, 0x2e, 0x37, 0x9f, 0x37, 0x3f, 0x73, 0x0e,
-	0xe3, 0xb3, 0x73, 0xbf, 0xf1, 0xea, 0xdc, 0x6f, 0xbc, 0x3e, 0xf7, 0x9d, 0x5f, 0x66, 0xbe, 0xf3,
-	0xfb, 0xcc, 0x77, 0xfe, 0x98, 0xf9, 0xce, 0xd9, 0xcc, 0x77, 0xfe, 0x9a, 0xf9, 0xce, 0xdf, 0x33,
-	0xbf, 0xf1, 0x7a, 0xe6, 0x3b, 0x2f, 0x2f, 0xfc, 0xc6, 0xd9, 0x85, 0xdf, 0x78, 0x75, 0xe1, 0x37,
-	0x7e, 0x08, 0xc6, 0xf2, 0xf2, 0x46, 0x84, 0x7c, 0xcb, 0x67, 0xe7, 0x8b, 0x7a, 0x3d, 0x6c, 0x51,
-	0x40, 0x3e, 0xfd, 0x37, 0x00, 0x00, 0xff, 0xff, 0x51, 0xa7, 0x35, 0x6c, 0xa9, 0x06, 0x00, 0x00,
+	// 1020 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
+	0x14, 0x15, 0xad, 0x87, 0xad, 0x4b, 0x4b, 0x48, 0xa6, 0x76, 0x4a, 0x08, 0x01, 0xed, 0xb8, 0x31,
+	0xe0, 0x45, 0x4b, 0x25, 0xaa, 0x0b, 0x14, 0xe9, 0x22, 0xb0, 0x0d, 0xa7, 0x71, 0x91, 0xb4, 0x00,
+	0xf3, 0x28, 0xd0, 0x0d, 0x31, 0x22, 0xaf, 0xe5, 0x81, 0x49, 0x8e, 0x3a, 0x33, 0x54, 0x2a, 0xa0,
+	0x8b, 0xfe, 0x41, 0xb3, 0xea, 0x07, 0x74, 0xd5, 0x4f, 0x49, 0x77, 0x5e, 0x66, 0xd7, 0x5a, 0xde,
+	0x74, 0x99, 0x4f, 0x28, 0x38, 0x24, 0x65, 0x4b, 0xb2, 0x23, 0xa7, 0xdd, 0x91, 0xe7, 0x9e, 0x7b,
+	0x66, 0xc4, 0x7b, 0xce, 0x8c, 0xe0, 0x33, 0x85, 0x51, 0x9f, 0x0b, 0x1a, 0xb6, 0x25, 0x8a, 0x01,
+	0x8a, 0x36, 0xed, 0xb3, 0x36, 0x15, 0xfe, 0x11, 0x4b, 0x5f, 0x06, 0xf7, 0xdb, 0x11, 0x4a, 0x49,
+	0x7b, 0xe8, 0xf4, 0x05, 0x57, 0x9c, 0xac, 0x15, 0x74, 0x27, 0xa3, 0x3b, 0xb4, 0xcf, 0x9c, 0x82,
+	0xee, 0x0c, 0xee, 0xb7, 0xd6, 0x7a, 0x9c, 0xf7, 0x42, 0x6c, 0x6b, 0x7a, 0x37, 0x39, 0x6c, 0x2b,
+	0x16, 0xa1, 0x54, 0x34, 0xea, 0x67, 0x0a, 0xad, 0x3b, 0x01, 0xf6, 0x31, 0x0e, 0x30, 0xf6, 0x19,
+	0xca, 0x76, 0x8f, 0xf7, 0xb8, 0xc6, 0xf5, 0x53, 0x4e, 0xb9, 0x3b, 0xde, 0x53, 0xba, 0x19, 0x9f,
+	0x47, 0x11, 0x8f, 0x67, 0xb6, 0xd2, 0xda, 0x9c, 0x60, 0x1d, 0x31, 0xa9, 0xb8, 0x18, 0xce, 0xd2,
+	0x26, 0xc5, 0x30, 0x4e, 0x22, 0x99, 0x92, 0x5e, 0x71, 0x71, 0x7c, 0x18, 0xf2, 0x57, 0x19, 0x6b,
+	0xe3, 0xb7, 0x32, 0xdc, 0x7c, 0x9c, 0x49, 0xec, 0x86, 0xbc, 0xfb, 0x18, 0x69, 0x80, 0x82, 0xdc,
+	0x86, 0x7a, 0x4c, 0x23, 0x94, 0x7d, 0xea, 0xa3, 0x65, 0xac, 0x1b, 0x5b, 0x75, 0xf7, 0x1c, 0x20,
+	0x77, 0x60, 0x79, 0xfc, 0xe2, 0xb1, 0xc0, 0x5a, 0xd0, 0x04, 0x73, 0x8c, 0x1d, 0x04, 0x64, 0x0d,
+	0xcc, 0x62, 0xa1, 0x94, 0x51, 0xd6, 0x0c, 0x28, 0xa0, 0x83, 0x80, 0xac, 0x42, 0x4d, 0x24, 0x71,
+	0x5a, 0xab, 0xe8, 0x5a, 0x55, 0x24, 0xf1, 0x41, 0x40, 0x3e, 0x86, 0x45, 0x26, 0xbd, 0x90, 0x4a,
+	0x65, 0x55, 0xd7, 0x8d, 0xad, 0x25, 0xb7, 0xc6, 0xe4, 0x13, 0x2a, 0x15, 0xd9, 0x86, 0x5b, 0x87,
+	0x4c, 0x48, 0xe5, 0x1d, 0x52, 0x16, 0xf2, 0x01, 0x0a, 0x6f, 0x80, 0x42, 0x32, 0x1e, 0x5b, 0xb5,
+	0x75, 0x63, 0xab, 0xec, 0xae, 0xe8, 0xea, 0xa3, 0xbc, 0xf8, 0x32, 0xab, 0x91, 0x0e, 0xac, 0x86,
+	0xf4, 0xb2, 0xa6, 0x45, 0xdd, 0xf4, 0x51, 0x48, 0x67, 0x7b, 0xee, 0x42, 0x33, 0x5b, 0x09, 0x07,
+	0x18, 0xab, 0x74, 0x87, 0x4b, 0x9a, 0xbc, 0xac, 0xd1, 0xfd, 0x14, 0x3c, 0x08, 0xc8, 0x06, 0x34,
+	0x42, 0x7a, 0x91, 0x54, 0xd7, 0x24, 0x33, 0xa4, 0xe7, 0x9c, 0x35, 0x30, 0xb3, 0xb2, 0xcf, 0x93,
+	0x58, 0x59, 0xa0, 0x19, 0xa0, 0xa1, 0xbd, 0x14, 0x21, 0x2d, 0x58, 0xf2, 0x8f, 0xd0, 0x3f, 0x96,
+	0x49, 0x64, 0x99, 0xfa, 0x33, 0x8c, 0xdf, 0x37, 0x7e, 0x35, 0xc0, 0xbc, 0x30, 0x18, 0xf2, 0x0d,
+	0xd4, 0x8e, 0xf4, 0x70, 0xf4, 0x3c, 0xcc, 0x4e, 0xc7, 0x99, 0xe3, 0x48, 0x67, 0x66, 0xac, 0x6e,
+	0xae, 0x40, 0xb6, 0xa1, 0xd2, 0xe5, 0xc1, 0xd0, 0x5a, 0x58, 0x2f, 0x6f, 0x99, 0x9d, 0xf5, 0x73,
+	0xa5, 0x54, 0x22, 0x37, 0xd4, 0x05, 0x05, 0x57, 0xb3, 0x37, 0x7e, 0xaf, 0x81, 0xb5, 0x93, 0xe9,
+	0xbf, 0x64, 0x92, 0x75, 0x59, 0xc8, 0xd4, 0xd0, 0xc5, 0x1f, 0x13, 0x94, 0x6a, 0xc6, 0x13, 0xc6,
+	0xac, 0x27, 0x26, 0x4c, 0xb5, 0x30, 0x6d, 0xaa, 0xff, 0xea, 0x98, 0x4f, 0x81, 0x8c, 0xfb, 0xd4,
+	0xb0, 0x8f, 0x5e, 0x2a, 0xa9, 0xcd, 0x53, 0x77, 0x6f, 0x14, 0x95, 0xe7, 0xc3, 0x3e, 0x7e, 0x4b,
+	0x23, 0x24, 0x0f, 0x01, 0xa4, 0xa2, 0x42, 0x79, 0x69, 0x3a, 0xb5, 0x75, 0xcc, 0x4e, 0xcb, 0xc9,
+	0xa2, 0xeb, 0x14, 0xd1, 0x75, 0x9e, 0x17, 0xd1, 0xdd, 0xad, 0xbc, 0xfe, 0x6b, 0xcd, 0x70, 0xeb,
+	0xba, 0x27, 0x45, 0xc9, 0xd7, 0xd0, 0xc4, 0x9f, 0xd0, 0x4f, 0x14, 0xe3, 0x71, 0x26, 0xb2, 0x78,
+	0x4d, 0x91, 0xc6, 0xb8, 0x4f, 0x0b, 0x3d, 0x04, 0xf0, 0x43, 0x2e, 0x31, 0x13, 0x59, 0xba, 0xee,
+	0x4e, 0x74, 0x8f, 0x16, 0x78, 0x04, 0x35, 0xa9, 0xa8, 0x4a, 0xa4, 0xb6, 0x5e, 0xb3, 0xe3, 0x4c,
+	0x8e, 0x51, 0x07, 0x3e, 0x1d, 0xe2, 0xf7, 0xf9, 0x37, 0xd8, 0x2f, 0x96, 0x7f, 0xa6, 0xbb, 0xdc,
+	0xbc, 0x9b, 0x6c, 0x42, 0x33, 0x1f, 0xb9, 0x17, 0x62, 0xdc, 0x53, 0x47, 0xb9, 0x51, 0x1b, 0x39,
+	0xfa, 0x44, 0x83, 0xe4, 0x1e, 0x54, 0x22, 0x8c, 0xb8, 0xf6, 0xa9, 0xd9, 0xb9, 0x3d, 0xb9, 0x58,
+	0x76, 0x54, 0xa5, 0xab, 0x3d, 0xc5, 0x88, 0xbb, 0x9a, 0x49, 0x7e, 0x86, 0x9b, 0x12, 0x53, 0x43,
+	0x7a, 0x54, 0x29, 0xc1, 0xba, 0x89, 0x42, 0x69, 0x2d, 0x6b, 0xcb, 0x7d, 0x37, 0xd7, 0xbc, 0x57,
+	0x19, 0xcd, 0x79, 0xa6, 0x25, 0x77, 0xc6, 0x8a, 0xfb, 0xb1, 0x12, 0x43, 0xf7, 0x86, 0x9c, 0x82,
+	0xc9, 0x3d, 0x58, 0x29, 0x7e, 0x56, 0xa6, 0x4b, 0x43, 0x2f, 0x11, 0xcc, 0x6a, 0x68, 0x67, 0x90,
+	0xbc, 0xb6, 0x93, 0x97, 0x5e, 0x08, 0xd6, 0xda, 0x83, 0xd5, 0x4b, 0xc5, 0xc9, 0x0d, 0x28, 0x1f,
+	0xe3, 0x30, 0xb7, 0x74, 0xfa, 0x48, 0x56, 0xa0, 0x3a, 0xa0, 0x61, 0x52, 0xd8, 0x38, 0x7b, 0x79,
+	0xb0, 0xf0, 0xa5, 0xb1, 0xf1, 0xa6, 0x02, 0x2b, 0xc5, 0x17, 0xdf, 0x4d, 0xe2, 0x20, 0xc4, 0xfc,
+	0x48, 0xdd, 0x84, 0xe6, 0x21, 0x17, 0x11, 0x55, 0xe3, 0x33, 0x28, 0xd5, 0xab, 0xba, 0x8d, 0x0c,
+	0x2d, 0x4e, 0x9f, 0xf7, 0x87, 0x64, 0x3a, 0x65, 0xe5, 0xb9, 0x27, 0x6f, 0xe5, 0x3d, 0x39, 0xaa,
+	0xce, 0xcf, 0x51, 0xed, 0x8a, 0x1c, 0x9d, 0x9b, 0x6f, 0xf1, 0x7f, 0x99, 0x6f, 0x32, 0x8f, 0x4b,
+	0x1f, 0x9e, 0xc7, 0xc9, 0x18, 0xd5, 0x3f, 0x3c, 0x46, 0xdb, 0x70, 0x2b, 0x13, 0x98, 0xb9, 0x23,
+	0xb2, 0x18, 0xac, 0xe8, 0xea, 0xf4, 0x25, 0x31, 0x75, 0xb4, 0x9b, 0x33, 0x47, 0xfb, 0x26, 0x34,
+	0x25, 0x4f, 0x84, 0x8f, 0x9e, 0x1f, 0x26, 0x52, 0xa1, 0xb0, 0x96, 0xf5, 0xa7, 0x6c, 0x64, 0xe8,
+	0x5e, 0x06, 0x4e, 0xdc, 0x00, 0x8d, 0xa9, 0x1b, 0xe0, 0xcf, 0x05, 0x68, 0x4e, 0x5a, 0x89, 0x3c,
+	0x9d, 0xba, 0x04, 0xbe, 0x98, 0x9b, 0xa3, 0xcb, 0xbc, 0x38, 0xbe, 0x07, 0x3e, 0x81, 0x46, 0x94,
+	0x28, 0xda, 0x0d, 0xd1, 0x4b, 0xe7, 0x51, 0x18, 0x6e, 0x39, 0x07, 0xd3, 0x59, 0xe1, 0x38, 0xf8,
+	0xe5, 0x6b, 0x07, 0xff, 0xc5, 0x65, 0xc1, 0xaf, 0xe8, 0xf6, 0xad, 0xab, 0xda, 0xa7, 0x93, 0x77,
+	0x49, 0xa2, 0x1f, 0xc0, 0x62, 0x9e, 0x5a, 0xab, 0x7a, 0xcd, 0x8b, 0xab, 0x68, 0xd8, 0x0d, 0x4e,
+	0x4e, 0xed, 0xd2, 0xdb, 0x53, 0xbb, 0xf4, 0xee, 0xd4, 0x36, 0x7e, 0x19, 0xd9, 0xc6, 0x1f, 0x23,
+	0xdb, 0x78, 0x33, 0xb2, 0x8d, 0x93, 0x91, 0x6d, 0xfc, 0x3d, 0xb2, 0x8d, 0x7f, 0x46, 0x76, 0xe9,
+	0xdd, 0xc8, 0x36, 0x5e, 0x9f, 0xd9, 0xa5, 0x93, 0x33, 0xbb, 0xf4, 0xf6, 0xcc, 0x2e, 0xfd, 0xe0,
+	0xf4, 0xf8, 0xf9, 0x12, 0x8c, 0x5f, 0xf1, 0x4f, 0xf1, 0xab, 0xe2, 0xb9, 0x5b, 0xd3, 0x86, 0xfb,
+	0xfc, 0xdf, 0x01, 0x00, 0x78, 0x6c, 0x90, 0x9d, 0x5c, 0x0a, 0x00, 0x00,
 }
 
 func (this *HistoryBlobHeader) Equal(that interface{}) bool {
@@ -479,6 +723,9 @@ func (this *HistoryBlobHeader) Equal(that interface{}) bool {
 	if this.EventCount != that1.EventCount {
 		return false
 	}
+	if this.Checksum != that1.Checksum {
+		return false
+	}
 	return true
 }
 func (this *HistoryBlob) Equal(that interface{}) bool {
@@ -590,11 +837,120 @@ func (this *ArchiveVisibilityRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *WorkflowBundleHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WorkflowBundleHeader)
+	if !ok {
+		that2, ok := that.(WorkflowBundleHeader)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FormatVersion != that1.FormatVersion {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if this.WorkflowTypeName != that1.WorkflowTypeName {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if that1.StartTime == nil {
+		if this.StartTime != nil {
+			return false
+		}
+	} else if !this.StartTime.Equal(*that1.StartTime) {
+		return false
+	}
+	if that1.CloseTime == nil {
+		if this.CloseTime != nil {
+			return false
+		}
+	} else if !this.CloseTime.Equal(*that1.CloseTime) {
+		return false
+	}
+	if this.CloseFailoverVersion != that1.CloseFailoverVersion {
+		return false
+	}
+	if this.EventCount != that1.EventCount {
+		return false
+	}
+	if this.SourceCluster != that1.SourceCluster {
+		return false
+	}
+	if this.Checksum != that1.Checksum {
+		return false
+	}
+	return true
+}
+func (this *WorkflowBundle) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WorkflowBundle)
+	if !ok {
+		that2, ok := that.(WorkflowBundle)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Header.Equal(that1.Header) {
+		return false
+	}
+	if this.MutableState != that1.MutableState {
+		return false
+	}
+	if !this.Memo.Equal(that1.Memo) {
+		return false
+	}
+	if !this.SearchAttributes.Equal(that1.SearchAttributes) {
+		return false
+	}
+	if len(this.History) != len(that1.History) {
+		return false
+	}
+	for i := range this.History {
+		if !this.History[i].Equal(that1.History[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *HistoryBlobHeader) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 14)
+	s := make([]string, 0, 15)
 	s = append(s, "&archiver.HistoryBlobHeader{")
 	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
@@ -606,6 +962,7 @@ func (this *HistoryBlobHeader) GoString() string {
 	s = append(s, "FirstEventId: "+fmt.Sprintf("%#v", this.FirstEventId)+",\n")
 	s = append(s, "LastEventId: "+fmt.Sprintf("%#v", this.LastEventId)+",\n")
 	s = append(s, "EventCount: "+fmt.Sprintf("%#v", this.EventCount)+",\n")
+	s = append(s, "Checksum: "+fmt.Sprintf("%#v", this.Checksum)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -660,18 +1017,62 @@ func (this *ArchiveVisibilityRequest) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func valueToGoStringMessage(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *WorkflowBundleHeader) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func (m *HistoryBlobHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 17)
+	s = append(s, "&archiver.WorkflowBundleHeader{")
+	s = append(s, "FormatVersion: "+fmt.Sprintf("%#v", this.FormatVersion)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "WorkflowTypeName: "+fmt.Sprintf("%#v", this.WorkflowTypeName)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "StartTime: "+fmt.Sprintf("%#v", this.StartTime)+",\n")
+	s = append(s, "CloseTime: "+fmt.Sprintf("%#v", this.CloseTime)+",\n")
+	s = append(s, "CloseFailoverVersion: "+fmt.Sprintf("%#v", this.CloseFailoverVersion)+",\n")
+	s = append(s, "EventCount: "+fmt.Sprintf("%#v", this.EventCount)+",\n")
+	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
+	s = append(s, "Checksum: "+fmt.Sprintf("%#v", this.Checksum)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WorkflowBundle) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&archiver.WorkflowBundle{")
+	if this.Header != nil {
+		s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	}
+	s = append(s, "MutableState: "+fmt.Sprintf("%#v", this.MutableState)+",\n")
+	if this.Memo != nil {
+		s = append(s, "Memo: "+fmt.Sprintf("%#v", this.Memo)+",\n")
+	}
+	if this.SearchAttributes != nil {
+		s = append(s, "SearchAttributes: "+fmt.Sprintf("%#v", this.SearchAttributes)+",\n")
+	}
+	if this.History != nil {
+		s = append(s, "History: "+fmt.Sprintf("%#v", this.History)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMessage(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *HistoryBlobHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -688,6 +1089,13 @@ func (m *HistoryBlobHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.EventCount != 0 {
 		i = encodeVarintMessage(dAtA, i, uint64(m.EventCount))
 		i--
@@ -939,6 +1347,198 @@ func (m *ArchiveVisibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowBundleHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowBundleHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowBundleHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.EventCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.EventCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CloseFailoverVersion != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.CloseFailoverVersion))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CloseTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintMessage(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.StartTime != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintMessage(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Status != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.WorkflowTypeName) > 0 {
+		i -= len(m.WorkflowTypeName)
+		copy(dAtA[i:], m.WorkflowTypeName)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowTypeName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FormatVersion != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.FormatVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.SearchAttributes != nil {
+		{
+			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Memo != nil {
+		{
+			size, err := m.Memo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MutableState) > 0 {
+		i -= len(m.MutableState)
+		copy(dAtA[i:], m.MutableState)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.MutableState)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -990,6 +1590,10 @@ func (m *HistoryBlobHeader) Size() (n int) {
 	if m.EventCount != 0 {
 		n += 1 + sovMessage(uint64(m.EventCount))
 	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -1075,58 +1679,147 @@ func (m *ArchiveVisibilityRequest) Size() (n int) {
 	return n
 }
 
-func sovMessage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMessage(x uint64) (n int) {
-	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *HistoryBlobHeader) String() string {
-	if this == nil {
-		return "nil"
+func (m *WorkflowBundleHeader) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&HistoryBlobHeader{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`IsLast:` + fmt.Sprintf("%v", this.IsLast) + `,`,
-		`FirstFailoverVersion:` + fmt.Sprintf("%v", this.FirstFailoverVersion) + `,`,
-		`LastFailoverVersion:` + fmt.Sprintf("%v", this.LastFailoverVersion) + `,`,
-		`FirstEventId:` + fmt.Sprintf("%v", this.FirstEventId) + `,`,
-		`LastEventId:` + fmt.Sprintf("%v", this.LastEventId) + `,`,
-		`EventCount:` + fmt.Sprintf("%v", this.EventCount) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *HistoryBlob) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.FormatVersion != 0 {
+		n += 1 + sovMessage(uint64(m.FormatVersion))
 	}
-	repeatedStringForBody := "[]*History{"
-	for _, f := range this.Body {
-		repeatedStringForBody += strings.Replace(fmt.Sprintf("%v", f), "History", "v1.History", 1) + ","
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
 	}
-	repeatedStringForBody += "}"
-	s := strings.Join([]string{`&HistoryBlob{`,
-		`Header:` + strings.Replace(this.Header.String(), "HistoryBlobHeader", "HistoryBlobHeader", 1) + `,`,
-		`Body:` + repeatedStringForBody + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArchiveVisibilityRequest) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
 	}
-	keysForSearchAttributes := make([]string, 0, len(this.SearchAttributes))
-	for k, _ := range this.SearchAttributes {
-		keysForSearchAttributes = append(keysForSearchAttributes, k)
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSearchAttributes)
-	mapStringForSearchAttributes := "map[string]string{"
-	for _, k := range keysForSearchAttributes {
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowTypeName)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMessage(uint64(m.Status))
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CloseTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CloseFailoverVersion != 0 {
+		n += 1 + sovMessage(uint64(m.CloseFailoverVersion))
+	}
+	if m.EventCount != 0 {
+		n += 1 + sovMessage(uint64(m.EventCount))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkflowBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.MutableState)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Memo != nil {
+		l = m.Memo.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.SearchAttributes != nil {
+		l = m.SearchAttributes.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *HistoryBlobHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryBlobHeader{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`IsLast:` + fmt.Sprintf("%v", this.IsLast) + `,`,
+		`FirstFailoverVersion:` + fmt.Sprintf("%v", this.FirstFailoverVersion) + `,`,
+		`LastFailoverVersion:` + fmt.Sprintf("%v", this.LastFailoverVersion) + `,`,
+		`FirstEventId:` + fmt.Sprintf("%v", this.FirstEventId) + `,`,
+		`LastEventId:` + fmt.Sprintf("%v", this.LastEventId) + `,`,
+		`EventCount:` + fmt.Sprintf("%v", this.EventCount) + `,`,
+		`Checksum:` + fmt.Sprintf("%v", this.Checksum) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HistoryBlob) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForBody := "[]*History{"
+	for _, f := range this.Body {
+		repeatedStringForBody += strings.Replace(fmt.Sprintf("%v", f), "History", "v1.History", 1) + ","
+	}
+	repeatedStringForBody += "}"
+	s := strings.Join([]string{`&HistoryBlob{`,
+		`Header:` + strings.Replace(this.Header.String(), "HistoryBlobHeader", "HistoryBlobHeader", 1) + `,`,
+		`Body:` + repeatedStringForBody + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArchiveVisibilityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSearchAttributes := make([]string, 0, len(this.SearchAttributes))
+	for k, _ := range this.SearchAttributes {
+		keysForSearchAttributes = append(keysForSearchAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSearchAttributes)
+	mapStringForSearchAttributes := "map[string]string{"
+	for _, k := range keysForSearchAttributes {
 		mapStringForSearchAttributes += fmt.Sprintf("%v: %v,", k, this.SearchAttributes[k])
 	}
 	mapStringForSearchAttributes += "}"
@@ -1148,6 +1841,47 @@ func (this *ArchiveVisibilityRequest) String() string {
 	}, "")
 	return s
 }
+func (this *WorkflowBundleHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowBundleHeader{`,
+		`FormatVersion:` + fmt.Sprintf("%v", this.FormatVersion) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`WorkflowTypeName:` + fmt.Sprintf("%v", this.WorkflowTypeName) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`CloseTime:` + strings.Replace(fmt.Sprintf("%v", this.CloseTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`CloseFailoverVersion:` + fmt.Sprintf("%v", this.CloseFailoverVersion) + `,`,
+		`EventCount:` + fmt.Sprintf("%v", this.EventCount) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`Checksum:` + fmt.Sprintf("%v", this.Checksum) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowBundle) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHistory := "[]*History{"
+	for _, f := range this.History {
+		repeatedStringForHistory += strings.Replace(fmt.Sprintf("%v", f), "History", "v1.History", 1) + ","
+	}
+	repeatedStringForHistory += "}"
+	s := strings.Join([]string{`&WorkflowBundle{`,
+		`Header:` + strings.Replace(this.Header.String(), "WorkflowBundleHeader", "WorkflowBundleHeader", 1) + `,`,
+		`MutableState:` + fmt.Sprintf("%v", this.MutableState) + `,`,
+		`Memo:` + strings.Replace(fmt.Sprintf("%v", this.Memo), "Memo", "v12.Memo", 1) + `,`,
+		`SearchAttributes:` + strings.Replace(fmt.Sprintf("%v", this.SearchAttributes), "SearchAttributes", "v12.SearchAttributes", 1) + `,`,
+		`History:` + repeatedStringForHistory + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1428,6 +2162,38 @@ func (m *HistoryBlobHeader) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -2129,6 +2895,658 @@ func (m *ArchiveVisibilityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkflowBundleHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowBundleHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowBundleHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FormatVersion", wireType)
+			}
+			m.FormatVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FormatVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTypeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= v11.WorkflowExecutionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CloseTime == nil {
+				m.CloseTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CloseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseFailoverVersion", wireType)
+			}
+			m.CloseFailoverVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseFailoverVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventCount", wireType)
+			}
+			m.EventCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &WorkflowBundleHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MutableState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MutableState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memo == nil {
+				m.Memo = &v12.Memo{}
+			}
+			if err := m.Memo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttributes == nil {
+				m.SearchAttributes = &v12.SearchAttributes{}
+			}
+			if err := m.SearchAttributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, &v1.History{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,648 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/dynamicconfig/v1/message.proto
+
+package dynamicconfig
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DynamicConfigEntry struct {
+	Name           string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ValueType      string   `protobuf:"bytes,2,opt,name=value_type,json=valueType,proto3" json:"value_type,omitempty"`
+	AllowedFilters []string `protobuf:"bytes,3,rep,name=allowed_filters,json=allowedFilters,proto3" json:"allowed_filters,omitempty"`
+	// Json encoded default value as registered by the services of the queried host, empty if unknown.
+	DefaultValue string `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// Json encoded effective value for the requested filters.
+	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *DynamicConfigEntry) Reset()      { *m = DynamicConfigEntry{} }
+func (*DynamicConfigEntry) ProtoMessage() {}
+func (*DynamicConfigEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e554b757c29a720, []int{0}
+}
+func (m *DynamicConfigEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicConfigEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicConfigEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicConfigEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicConfigEntry.Merge(m, src)
+}
+func (m *DynamicConfigEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicConfigEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicConfigEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicConfigEntry proto.InternalMessageInfo
+
+func (m *DynamicConfigEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DynamicConfigEntry) GetValueType() string {
+	if m != nil {
+		return m.ValueType
+	}
+	return ""
+}
+
+func (m *DynamicConfigEntry) GetAllowedFilters() []string {
+	if m != nil {
+		return m.AllowedFilters
+	}
+	return nil
+}
+
+func (m *DynamicConfigEntry) GetDefaultValue() string {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return ""
+}
+
+func (m *DynamicConfigEntry) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*DynamicConfigEntry)(nil), "temporal.server.api.dynamicconfig.v1.DynamicConfigEntry")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/dynamicconfig/v1/message.proto", fileDescriptor_0e554b757c29a720)
+}
+
+var fileDescriptor_0e554b757c29a720 = []byte{
+	// 288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0xd0, 0x3f, 0x4b, 0x03, 0x31,
+	0x18, 0x06, 0xf0, 0xc4, 0xb6, 0x42, 0x83, 0x7f, 0x20, 0x38, 0xdc, 0xe2, 0x4b, 0x51, 0xc1, 0x4e,
+	0x39, 0xaa, 0x8b, 0xe0, 0xe6, 0xbf, 0x0f, 0x50, 0xc4, 0xc1, 0xa5, 0xc4, 0xf6, 0x6d, 0x09, 0xe4,
+	0x2e, 0x21, 0x97, 0x9e, 0xdc, 0xe6, 0x47, 0xf0, 0x63, 0x08, 0x7e, 0x11, 0xc7, 0x8e, 0x1d, 0x6d,
+	0xba, 0x38, 0xf6, 0x23, 0x88, 0xb9, 0x2a, 0x74, 0x71, 0x4b, 0x7e, 0x0f, 0xcf, 0x3b, 0x3c, 0xec,
+	0xcc, 0x63, 0x66, 0x8d, 0x93, 0x3a, 0x2d, 0xd0, 0x95, 0xe8, 0x52, 0x69, 0x55, 0x3a, 0xaa, 0x72,
+	0x99, 0xa9, 0xe1, 0xd0, 0xe4, 0x63, 0x35, 0x49, 0xcb, 0x5e, 0x9a, 0x61, 0x51, 0xc8, 0x09, 0x0a,
+	0xeb, 0x8c, 0x37, 0xfc, 0xe4, 0xb7, 0x23, 0xea, 0x8e, 0x90, 0x56, 0x89, 0x8d, 0x8e, 0x28, 0x7b,
+	0x47, 0xef, 0x94, 0xf1, 0x9b, 0x1a, 0xaf, 0x23, 0xde, 0xe6, 0xde, 0x55, 0x9c, 0xb3, 0x66, 0x2e,
+	0x33, 0x4c, 0x68, 0x87, 0x76, 0xdb, 0xfd, 0xf8, 0xe6, 0x87, 0x8c, 0x95, 0x52, 0x4f, 0x71, 0xe0,
+	0x2b, 0x8b, 0xc9, 0x56, 0x4c, 0xda, 0x51, 0xee, 0x2b, 0x8b, 0xfc, 0x94, 0xed, 0x4b, 0xad, 0xcd,
+	0x33, 0x8e, 0x06, 0x63, 0xa5, 0x3d, 0xba, 0x22, 0x69, 0x74, 0x1a, 0xdd, 0x76, 0x7f, 0x6f, 0xcd,
+	0x77, 0xb5, 0xf2, 0x63, 0xb6, 0x3b, 0xc2, 0xb1, 0x9c, 0x6a, 0x3f, 0x88, 0xed, 0xa4, 0x19, 0x4f,
+	0xed, 0xac, 0xf1, 0xe1, 0xc7, 0xf8, 0x01, 0x6b, 0xd5, 0x61, 0x2b, 0x86, 0xf5, 0xe7, 0x2a, 0x9f,
+	0x2d, 0x80, 0xcc, 0x17, 0x40, 0x56, 0x0b, 0xa0, 0x2f, 0x01, 0xe8, 0x5b, 0x00, 0xfa, 0x11, 0x80,
+	0xce, 0x02, 0xd0, 0xcf, 0x00, 0xf4, 0x2b, 0x00, 0x59, 0x05, 0xa0, 0xaf, 0x4b, 0x20, 0xb3, 0x25,
+	0x90, 0xf9, 0x12, 0xc8, 0xe3, 0xc5, 0xc4, 0x88, 0xbf, 0x31, 0x94, 0xf9, 0x6f, 0xc3, 0xcb, 0x0d,
+	0x78, 0xda, 0x8e, 0x53, 0x9e, 0x7f, 0x0f, 0x00, 0x4c, 0xd7, 0xd1, 0xeb, 0x80, 0x01, 0x00, 0x00,
+}
+
+func (this *DynamicConfigEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicConfigEntry)
+	if !ok {
+		that2, ok := that.(DynamicConfigEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.ValueType != that1.ValueType {
+		return false
+	}
+	if len(this.AllowedFilters) != len(that1.AllowedFilters) {
+		return false
+	}
+	for i := range this.AllowedFilters {
+		if this.AllowedFilters[i] != that1.AllowedFilters[i] {
+			return false
+		}
+	}
+	if this.DefaultValue != that1.DefaultValue {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
+}
+func (this *DynamicConfigEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&dynamicconfig.DynamicConfigEntry{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "ValueType: "+fmt.Sprintf("%#v", this.ValueType)+",\n")
+	s = append(s, "AllowedFilters: "+fmt.Sprintf("%#v", this.AllowedFilters)+",\n")
+	s = append(s, "DefaultValue: "+fmt.Sprintf("%#v", this.DefaultValue)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMessage(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DynamicConfigEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicConfigEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicConfigEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DefaultValue) > 0 {
+		i -= len(m.DefaultValue)
+		copy(dAtA[i:], m.DefaultValue)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.DefaultValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AllowedFilters) > 0 {
+		for iNdEx := len(m.AllowedFilters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedFilters[iNdEx])
+			copy(dAtA[i:], m.AllowedFilters[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.AllowedFilters[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValueType) > 0 {
+		i -= len(m.ValueType)
+		copy(dAtA[i:], m.ValueType)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ValueType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DynamicConfigEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.ValueType)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.AllowedFilters) > 0 {
+		for _, s := range m.AllowedFilters {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	l = len(m.DefaultValue)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DynamicConfigEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DynamicConfigEntry{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ValueType:` + fmt.Sprintf("%v", this.ValueType) + `,`,
+		`AllowedFilters:` + fmt.Sprintf("%v", this.AllowedFilters) + `,`,
+		`DefaultValue:` + fmt.Sprintf("%v", this.DefaultValue) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMessage(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DynamicConfigEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicConfigEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicConfigEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedFilters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedFilters = append(m.AllowedFilters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMessage(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMessage
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMessage
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMessage
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMessage        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMessage          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMessage = fmt.Errorf("proto: unexpected end of group")
+)
@@ -91,9 +91,37 @@ func (ChecksumFlavor) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_4a3bfa9c01eff6e4, []int{1}
 }
 
+type QueryConsistencyLevel int32
+
+const (
+	QUERY_CONSISTENCY_LEVEL_UNSPECIFIED QueryConsistencyLevel = 0
+	// Query is dispatched to the worker right away, the answer might not reflect the events received since the last workflow task.
+	QUERY_CONSISTENCY_LEVEL_EVENTUAL QueryConsistencyLevel = 1
+	// Query is answered only after the events received before it, e.g. signals, are processed by the workflow.
+	// This is how every query was answered before the consistency level existed, unspecified means strong.
+	QUERY_CONSISTENCY_LEVEL_STRONG QueryConsistencyLevel = 2
+)
+
+var QueryConsistencyLevel_name = map[int32]string{
+	0: "Unspecified",
+	1: "Eventual",
+	2: "Strong",
+}
+
+var QueryConsistencyLevel_value = map[string]int32{
+	"Unspecified": 0,
+	"Eventual":    1,
+	"Strong":      2,
+}
+
+func (QueryConsistencyLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4a3bfa9c01eff6e4, []int{2}
+}
+
 func init() {
 	proto.RegisterEnum("temporal.server.api.enums.v1.DeadLetterQueueType", DeadLetterQueueType_name, DeadLetterQueueType_value)
 	proto.RegisterEnum("temporal.server.api.enums.v1.ChecksumFlavor", ChecksumFlavor_name, ChecksumFlavor_value)
+	proto.RegisterEnum("temporal.server.api.enums.v1.QueryConsistencyLevel", QueryConsistencyLevel_name, QueryConsistencyLevel_value)
 }
 
 func init() {
@@ -101,28 +129,32 @@ func init() {
 }
 
 var fileDescriptor_4a3bfa9c01eff6e4 = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0xd0, 0x31, 0x4b, 0xf3, 0x40,
-	0x1c, 0xc7, 0xf1, 0xdc, 0x33, 0x3c, 0xc3, 0x0d, 0x12, 0xe2, 0xa8, 0x9c, 0x22, 0x22, 0x5a, 0x30,
-	0xa1, 0x76, 0x74, 0x4a, 0x2f, 0xff, 0x62, 0x30, 0x4d, 0xd2, 0x6b, 0x52, 0xa8, 0x83, 0x47, 0x6c,
-	0x0f, 0x2d, 0x36, 0xbd, 0x90, 0x26, 0x01, 0x37, 0x77, 0x17, 0x5f, 0x86, 0x2f, 0xc5, 0xb1, 0x63,
-	0x47, 0x7b, 0x5d, 0x1c, 0xfb, 0x12, 0x84, 0x8a, 0x0e, 0x45, 0xdd, 0x7e, 0xc3, 0x67, 0xf8, 0xf1,
-	0xc5, 0x27, 0x85, 0x48, 0x33, 0x99, 0x27, 0x63, 0x6b, 0x2a, 0xf2, 0x4a, 0xe4, 0x56, 0x92, 0x8d,
-	0x2c, 0x31, 0x29, 0xd3, 0xa9, 0x55, 0xd5, 0xad, 0x81, 0x4c, 0x53, 0x39, 0x31, 0xb3, 0x5c, 0x16,
-	0xd2, 0xd8, 0xfd, 0xa2, 0xe6, 0x27, 0x35, 0x93, 0x6c, 0x64, 0xae, 0xa9, 0x59, 0xd5, 0x6b, 0x4f,
-	0x08, 0x6f, 0x3b, 0x22, 0x19, 0x7a, 0xa2, 0x28, 0x44, 0xde, 0x29, 0x45, 0x29, 0xa2, 0x87, 0x4c,
-	0x18, 0x47, 0xf8, 0xc0, 0x01, 0xdb, 0xe1, 0x1e, 0x44, 0x11, 0x30, 0xde, 0x89, 0x21, 0x06, 0x1e,
-	0xf5, 0x43, 0xe0, 0xb1, 0xdf, 0x0d, 0x81, 0xba, 0x2d, 0x17, 0x1c, 0x5d, 0xfb, 0xc3, 0x31, 0x08,
-	0x3d, 0x97, 0xda, 0x91, 0x1b, 0xf8, 0x3a, 0x32, 0x0e, 0xf1, 0xfe, 0x2f, 0xce, 0xb7, 0xdb, 0xd0,
-	0x0d, 0x6d, 0x0a, 0xfa, 0xbf, 0xda, 0x10, 0x6f, 0xd1, 0x3b, 0x31, 0xb8, 0x9f, 0x96, 0x69, 0x6b,
-	0x9c, 0x54, 0x32, 0x37, 0xf6, 0xf0, 0x0e, 0xbd, 0x00, 0x7a, 0xd9, 0x8d, 0xdb, 0xbc, 0xe5, 0xd9,
-	0xbd, 0x80, 0x6d, 0x1c, 0xa8, 0xe3, 0xd3, 0x4d, 0xe0, 0x02, 0x00, 0xa7, 0x8c, 0x36, 0xce, 0x78,
-	0xd0, 0x03, 0xc6, 0x43, 0x16, 0x44, 0x41, 0x83, 0x37, 0x5d, 0xdf, 0x66, 0x7d, 0x1d, 0x35, 0xaf,
-	0x67, 0x0b, 0xa2, 0xcd, 0x17, 0x44, 0x5b, 0x2d, 0x08, 0x7a, 0x54, 0x04, 0xbd, 0x28, 0x82, 0x5e,
-	0x15, 0x41, 0x33, 0x45, 0xd0, 0x9b, 0x22, 0xe8, 0x5d, 0x11, 0x6d, 0xa5, 0x08, 0x7a, 0x5e, 0x12,
-	0x6d, 0xb6, 0x24, 0xda, 0x7c, 0x49, 0xb4, 0xab, 0xe3, 0x5b, 0x69, 0x7e, 0xa7, 0x1c, 0xc9, 0x9f,
-	0xc2, 0x9f, 0xaf, 0xc7, 0xcd, 0xff, 0x75, 0xf8, 0xc6, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18,
-	0x8a, 0xe1, 0xa4, 0xa5, 0x01, 0x00, 0x00,
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0xd2, 0xbd, 0x8e, 0xd3, 0x40,
+	0x10, 0xc0, 0x71, 0xef, 0x15, 0x14, 0x5b, 0x20, 0xcb, 0x88, 0x0a, 0xb4, 0x9c, 0x8e, 0x13, 0x1f,
+	0x91, 0xb0, 0x15, 0x52, 0x52, 0xf9, 0x36, 0x13, 0xb0, 0xd8, 0x5b, 0x3b, 0xeb, 0x75, 0x24, 0x53,
+	0xb0, 0x32, 0xb9, 0x15, 0x58, 0xc4, 0x5e, 0xcb, 0x5f, 0x52, 0x3a, 0x6a, 0x68, 0x78, 0x0c, 0x1e,
+	0x85, 0x32, 0xe5, 0x95, 0xc4, 0x69, 0x28, 0xef, 0x11, 0x90, 0x82, 0xa0, 0x88, 0x08, 0xdd, 0x14,
+	0x3f, 0x69, 0x46, 0x9a, 0x3f, 0x7e, 0xda, 0xea, 0xa2, 0x32, 0x75, 0xb6, 0xf2, 0x1a, 0x5d, 0xf7,
+	0xba, 0xf6, 0xb2, 0x2a, 0xf7, 0x74, 0xd9, 0x15, 0x8d, 0xd7, 0x8f, 0xbd, 0xa5, 0x29, 0x0a, 0x53,
+	0xba, 0x55, 0x6d, 0x5a, 0xe3, 0xdc, 0xff, 0x43, 0xdd, 0xdf, 0xd4, 0xcd, 0xaa, 0xdc, 0xdd, 0x53,
+	0xb7, 0x1f, 0x8f, 0xbe, 0x20, 0x7c, 0x67, 0xaa, 0xb3, 0x2b, 0xa6, 0xdb, 0x56, 0xd7, 0xf3, 0x4e,
+	0x77, 0x5a, 0xae, 0x2b, 0xed, 0x3c, 0xc2, 0x67, 0x53, 0xf0, 0xa7, 0x8a, 0x81, 0x94, 0x20, 0xd4,
+	0x3c, 0x81, 0x04, 0x94, 0x4c, 0x23, 0x50, 0x09, 0x8f, 0x23, 0xa0, 0xc1, 0x2c, 0x80, 0xa9, 0x6d,
+	0xfd, 0xc7, 0x09, 0x88, 0x58, 0x40, 0x7d, 0x19, 0x84, 0xdc, 0x46, 0xce, 0x39, 0x3e, 0x3d, 0xe2,
+	0xb8, 0x7f, 0x09, 0x71, 0xe4, 0x53, 0xb0, 0x4f, 0x46, 0x57, 0xf8, 0x36, 0xfd, 0xa0, 0x97, 0x1f,
+	0x9b, 0xae, 0x98, 0xad, 0xb2, 0xde, 0xd4, 0xce, 0x03, 0x7c, 0x8f, 0xbe, 0x02, 0xfa, 0x3a, 0x4e,
+	0x2e, 0xd5, 0x8c, 0xf9, 0x8b, 0x50, 0x1c, 0x1c, 0x30, 0xc6, 0xcf, 0x0e, 0x41, 0x00, 0x00, 0x8a,
+	0x0a, 0x3a, 0x79, 0xae, 0xc2, 0x05, 0x08, 0x15, 0x89, 0x50, 0x86, 0x13, 0x75, 0x11, 0x70, 0x5f,
+	0xa4, 0x36, 0x1a, 0x7d, 0x46, 0xf8, 0xee, 0xbc, 0xd3, 0xf5, 0x9a, 0x9a, 0xb2, 0xc9, 0x9b, 0x56,
+	0x97, 0xcb, 0x35, 0xd3, 0xbd, 0x5e, 0x39, 0x8f, 0xf1, 0xc3, 0x79, 0x02, 0x22, 0x55, 0x34, 0xe4,
+	0x71, 0x10, 0x4b, 0xe0, 0x34, 0x55, 0x0c, 0x16, 0xc0, 0x0e, 0xb6, 0x9e, 0xe3, 0xd3, 0x63, 0x10,
+	0x16, 0xc0, 0x65, 0xe2, 0x33, 0x1b, 0x39, 0x67, 0x98, 0x1c, 0x53, 0xb1, 0x14, 0x21, 0x7f, 0x69,
+	0x9f, 0x5c, 0xbc, 0xdd, 0x6c, 0x89, 0x75, 0xbd, 0x25, 0xd6, 0xcd, 0x96, 0xa0, 0x4f, 0x03, 0x41,
+	0xdf, 0x06, 0x82, 0xbe, 0x0f, 0x04, 0x6d, 0x06, 0x82, 0x7e, 0x0c, 0x04, 0xfd, 0x1c, 0x88, 0x75,
+	0x33, 0x10, 0xf4, 0x75, 0x47, 0xac, 0xcd, 0x8e, 0x58, 0xd7, 0x3b, 0x62, 0xbd, 0x79, 0xf2, 0xde,
+	0xb8, 0x7f, 0xff, 0x9a, 0x9b, 0x7f, 0x55, 0xf0, 0x62, 0x3f, 0xbc, 0xbb, 0xb5, 0xaf, 0x60, 0xf2,
+	0x6b, 0x00, 0xdc, 0xc4, 0x69, 0x00, 0x32, 0x02, 0x00, 0x00,
 }
 
 func (x DeadLetterQueueType) String() string {
@@ -139,3 +171,10 @@ func (x ChecksumFlavor) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x QueryConsistencyLevel) String() string {
+	s, ok := QueryConsistencyLevel_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
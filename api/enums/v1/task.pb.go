@@ -125,6 +125,7 @@ const (
 	TASK_TYPE_DELETE_HISTORY_EVENT                       TaskType = 16
 	TASK_TYPE_ACTIVITY_RETRY_TIMER                       TaskType = 17
 	TASK_TYPE_WORKFLOW_BACKOFF_TIMER                     TaskType = 18
+	TASK_TYPE_WORKFLOW_DEADLINE_TIMER                    TaskType = 19
 )
 
 var TaskType_name = map[int32]string{
@@ -147,6 +148,7 @@ var TaskType_name = map[int32]string{
 	16: "DeleteHistoryEvent",
 	17: "ActivityRetryTimer",
 	18: "WorkflowBackoffTimer",
+	19: "WorkflowDeadlineTimer",
 }
 
 var TaskType_value = map[string]int32{
@@ -169,6 +171,7 @@ var TaskType_value = map[string]int32{
 	"DeleteHistoryEvent":                     16,
 	"ActivityRetryTimer":                     17,
 	"WorkflowBackoffTimer":                   18,
+	"WorkflowDeadlineTimer":                  19,
 }
 
 func (TaskType) EnumDescriptor() ([]byte, []int) {
@@ -186,43 +189,44 @@ func init() {
 }
 
 var fileDescriptor_36a3d3674ca3cfa6 = []byte{
-	// 574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xed, 0x96, 0x96, 0xb2, 0x14, 0x58, 0x96, 0x3f, 0xa5, 0x85, 0x2e, 0xb4, 0x05, 0x5a,
-	0x45, 0xc8, 0x51, 0x05, 0x07, 0x24, 0x4e, 0xce, 0x7a, 0x92, 0x58, 0x31, 0x76, 0xb4, 0xbb, 0x6e,
-	0x09, 0x87, 0x5a, 0x06, 0x59, 0x55, 0x54, 0x8a, 0x23, 0x27, 0x8d, 0xd4, 0x1b, 0xbc, 0x01, 0x8f,
-	0xc1, 0xa3, 0x70, 0xcc, 0xb1, 0x47, 0xe2, 0x1c, 0xe0, 0xd8, 0x47, 0x40, 0x71, 0x13, 0xdb, 0x29,
-	0xee, 0x6d, 0xa5, 0xef, 0xb7, 0xdf, 0xcc, 0xac, 0xbe, 0x1d, 0xb4, 0xdd, 0x0b, 0x8e, 0x3b, 0x61,
-	0xe4, 0x7f, 0x29, 0x77, 0x83, 0xa8, 0x1f, 0x44, 0x65, 0xbf, 0xd3, 0x2e, 0x07, 0x5f, 0x4f, 0x8e,
-	0xbb, 0xe5, 0xfe, 0x6e, 0xb9, 0xe7, 0x77, 0x8f, 0xb4, 0x4e, 0x14, 0xf6, 0x42, 0xf2, 0x64, 0x0a,
-	0x6a, 0x17, 0xa0, 0xe6, 0x77, 0xda, 0x5a, 0x02, 0x6a, 0xfd, 0xdd, 0xd2, 0x01, 0x42, 0xd2, 0xef,
-	0x1e, 0x89, 0xf0, 0x24, 0xfa, 0x1c, 0x90, 0xc7, 0x68, 0x45, 0xea, 0xa2, 0xe1, 0x09, 0xc7, 0xe5,
-	0x0c, 0x3c, 0xd7, 0x16, 0x4d, 0x60, 0x66, 0xd5, 0x04, 0x03, 0x2b, 0x64, 0x05, 0xdd, 0xcb, 0x8b,
-	0x75, 0x53, 0x48, 0x87, 0xb7, 0xb0, 0x4a, 0xd6, 0xd0, 0xc3, 0xbc, 0x60, 0x54, 0xbc, 0x8a, 0xce,
-	0x1a, 0x96, 0x53, 0xc3, 0x73, 0xa5, 0xef, 0x2a, 0x5a, 0x1e, 0x17, 0x60, 0x7e, 0x2f, 0x38, 0x0c,
-	0xa3, 0x53, 0xb2, 0x8e, 0x56, 0x13, 0x98, 0xe9, 0x12, 0x6a, 0x0e, 0x6f, 0x5d, 0x2a, 0x32, 0xf5,
-	0x4a, 0x65, 0xc9, 0x75, 0x5b, 0x54, 0x81, 0x63, 0x35, 0x6d, 0x20, 0xd3, 0xcc, 0xf7, 0xc0, 0xf1,
-	0xdc, 0xff, 0x9e, 0x1c, 0x9a, 0x96, 0xc9, 0x74, 0x69, 0x3a, 0x36, 0x9e, 0x2f, 0xfd, 0x59, 0x40,
-	0x4b, 0xe3, 0x1e, 0xe4, 0x69, 0x27, 0x20, 0xab, 0xe8, 0x41, 0xc2, 0xca, 0x56, 0xf3, 0xf2, 0x80,
-	0x1b, 0x68, 0x3d, 0x93, 0x72, 0x16, 0xb9, 0x51, 0xb7, 0xd1, 0x56, 0x31, 0x22, 0x5a, 0x36, 0xf3,
-	0x74, 0x26, 0xcd, 0x3d, 0x53, 0xb6, 0xf0, 0x1c, 0x79, 0x8e, 0x9e, 0x65, 0xe0, 0x74, 0x06, 0x6f,
-	0xdf, 0xe1, 0x8d, 0xaa, 0xe5, 0xec, 0x7b, 0x63, 0x0d, 0xcf, 0x5f, 0x41, 0x4d, 0x6d, 0x2e, 0xa8,
-	0x6b, 0xe4, 0x25, 0xda, 0x2c, 0xa0, 0x98, 0xe5, 0x08, 0xf0, 0xe0, 0x03, 0x30, 0x37, 0x99, 0x73,
-	0x61, 0xb6, 0xb9, 0x8c, 0xd3, 0x6d, 0x06, 0x56, 0x0e, 0x5c, 0x24, 0xaf, 0xd0, 0x4e, 0x01, 0x28,
-	0xa4, 0xce, 0xa5, 0xc7, 0xea, 0xa6, 0x65, 0xe4, 0xe8, 0xeb, 0x57, 0xd8, 0x0a, 0xb3, 0x66, 0xeb,
-	0x79, 0xdb, 0x25, 0xa2, 0xa1, 0x52, 0x01, 0xc8, 0x81, 0x39, 0xdc, 0xc8, 0x46, 0x4f, 0xca, 0x80,
-	0x81, 0x6f, 0x90, 0x17, 0x68, 0xa3, 0x90, 0x17, 0x20, 0x53, 0x1c, 0x23, 0xf2, 0x16, 0xbd, 0x29,
-	0xc0, 0xdc, 0xa6, 0x00, 0x2e, 0x73, 0xb6, 0xa0, 0x73, 0x56, 0xf7, 0x74, 0x29, 0xb9, 0x59, 0x71,
-	0x25, 0x08, 0x7c, 0x93, 0x6c, 0xa1, 0xa7, 0xd9, 0xcd, 0x99, 0xb7, 0x4f, 0xa2, 0xe3, 0xb8, 0x12,
-	0x2f, 0x13, 0x8a, 0xd6, 0x32, 0x28, 0x7b, 0xfa, 0x89, 0x7e, 0x8b, 0x3c, 0x42, 0xf7, 0x73, 0x81,
-	0x11, 0xc0, 0x27, 0xb1, 0xbb, 0x4d, 0x36, 0x11, 0x2d, 0xb0, 0xe7, 0xae, 0x9d, 0xde, 0xbe, 0x33,
-	0xcb, 0x18, 0x60, 0x81, 0x4c, 0x7f, 0x8e, 0x07, 0x7b, 0x60, 0x4b, 0x8c, 0x67, 0x99, 0xb4, 0x03,
-	0x0e, 0x32, 0x8d, 0xf8, 0xdd, 0xd9, 0xa4, 0xa4, 0xb5, 0xc6, 0xff, 0xcc, 0xa9, 0x56, 0x27, 0x14,
-	0xa9, 0x1c, 0x0c, 0x86, 0x54, 0x39, 0x1b, 0x52, 0xe5, 0x7c, 0x48, 0xd5, 0x6f, 0x31, 0x55, 0x7f,
-	0xc6, 0x54, 0xfd, 0x15, 0x53, 0x75, 0x10, 0x53, 0xf5, 0x77, 0x4c, 0xd5, 0xbf, 0x31, 0x55, 0xce,
-	0x63, 0xaa, 0xfe, 0x18, 0x51, 0x65, 0x30, 0xa2, 0xca, 0xd9, 0x88, 0x2a, 0x1f, 0x77, 0x0e, 0x43,
-	0x2d, 0x5d, 0x12, 0xed, 0xb0, 0x68, 0xa1, 0xbc, 0x4b, 0x0e, 0x9f, 0x16, 0x93, 0x95, 0xf2, 0xfa,
-	0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x18, 0x0d, 0x76, 0x7d, 0x04, 0x00, 0x00,
+	// 580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4f, 0x4f, 0xd4, 0x40,
+	0x18, 0xc6, 0x5b, 0x50, 0xc4, 0x11, 0x75, 0x1c, 0x54, 0x04, 0x65, 0x14, 0x50, 0x21, 0x1b, 0xd3,
+	0x0d, 0xd1, 0x83, 0x89, 0xa7, 0xd9, 0xe9, 0xbb, 0x30, 0xa1, 0xb6, 0x9b, 0x99, 0x29, 0xb8, 0x1e,
+	0x68, 0x56, 0xd3, 0x10, 0x82, 0xd8, 0x4d, 0x77, 0x21, 0xe1, 0xa6, 0x5f, 0xc0, 0xf8, 0x31, 0xfc,
+	0x28, 0x1e, 0x39, 0x72, 0x94, 0x72, 0xf1, 0xc8, 0x47, 0x30, 0x5b, 0x76, 0xfb, 0x07, 0xcb, 0xad,
+	0xc9, 0xf3, 0xeb, 0xf3, 0xfe, 0xc9, 0x33, 0x2f, 0x5a, 0xee, 0x87, 0xfb, 0xdd, 0x28, 0xee, 0x7c,
+	0xa9, 0xf7, 0xc2, 0xf8, 0x30, 0x8c, 0xeb, 0x9d, 0xee, 0x6e, 0x3d, 0xfc, 0x7a, 0xb0, 0xdf, 0xab,
+	0x1f, 0xae, 0xd6, 0xfb, 0x9d, 0xde, 0x9e, 0xd5, 0x8d, 0xa3, 0x7e, 0x44, 0x9e, 0x8c, 0x40, 0xeb,
+	0x02, 0xb4, 0x3a, 0xdd, 0x5d, 0x2b, 0x05, 0xad, 0xc3, 0xd5, 0xda, 0x36, 0x42, 0xba, 0xd3, 0xdb,
+	0x53, 0xd1, 0x41, 0xfc, 0x39, 0x24, 0x8f, 0xd1, 0x8c, 0x66, 0x6a, 0x23, 0x50, 0x9e, 0x2f, 0x39,
+	0x04, 0xbe, 0xab, 0x5a, 0xc0, 0x45, 0x53, 0x80, 0x8d, 0x0d, 0x32, 0x83, 0xa6, 0x8b, 0xe2, 0xba,
+	0x50, 0xda, 0x93, 0x6d, 0x6c, 0x92, 0x39, 0xf4, 0xb0, 0x28, 0xd8, 0x8d, 0xa0, 0xc1, 0xf8, 0x86,
+	0xe3, 0xad, 0xe1, 0xb1, 0xda, 0x77, 0x13, 0x4d, 0x0d, 0x0a, 0xf0, 0x4e, 0x3f, 0xdc, 0x89, 0xe2,
+	0x23, 0x32, 0x8f, 0x66, 0x53, 0x98, 0x33, 0x0d, 0x6b, 0x9e, 0x6c, 0x5f, 0x2a, 0x32, 0xf2, 0xca,
+	0x64, 0x2d, 0x99, 0xab, 0x9a, 0x20, 0xb1, 0x99, 0x35, 0x90, 0x6b, 0xe2, 0x3d, 0x48, 0x3c, 0xf6,
+	0xbf, 0xa7, 0x84, 0x96, 0x23, 0x38, 0xd3, 0xc2, 0x73, 0xf1, 0x78, 0xed, 0xc7, 0x04, 0x9a, 0x1c,
+	0xf4, 0xa0, 0x8f, 0xba, 0x21, 0x99, 0x45, 0x0f, 0x52, 0x56, 0xb7, 0x5b, 0x97, 0x07, 0x5c, 0x40,
+	0xf3, 0xb9, 0x54, 0xb0, 0x28, 0x8c, 0xba, 0x8c, 0x96, 0xaa, 0x11, 0xd5, 0x76, 0x79, 0xc0, 0xb8,
+	0x16, 0x9b, 0x42, 0xb7, 0xf1, 0x18, 0x79, 0x8e, 0x9e, 0xe5, 0xe0, 0x68, 0x86, 0x60, 0xcb, 0x93,
+	0x1b, 0x4d, 0xc7, 0xdb, 0x0a, 0x06, 0x1a, 0x1e, 0xbf, 0x82, 0x1a, 0xd9, 0x5c, 0x50, 0xd7, 0xc8,
+	0x4b, 0xb4, 0x58, 0x41, 0x71, 0xc7, 0x53, 0x10, 0xc0, 0x07, 0xe0, 0x7e, 0x3a, 0xe7, 0xf5, 0x72,
+	0x73, 0x39, 0xc7, 0x5c, 0x0e, 0x4e, 0x01, 0x9c, 0x20, 0xaf, 0xd0, 0x4a, 0x05, 0xa8, 0x34, 0x93,
+	0x3a, 0xe0, 0xeb, 0xc2, 0xb1, 0x0b, 0xf4, 0x8d, 0x2b, 0x6c, 0x95, 0x58, 0x73, 0x59, 0xd1, 0x76,
+	0x92, 0x58, 0xa8, 0x56, 0x01, 0x4a, 0xe0, 0x9e, 0xb4, 0xf3, 0xd1, 0xd3, 0x32, 0x60, 0xe3, 0x9b,
+	0xe4, 0x05, 0x5a, 0xa8, 0xe4, 0x15, 0xe8, 0x0c, 0xc7, 0x88, 0xbc, 0x45, 0x6f, 0x2a, 0x30, 0xbf,
+	0xa5, 0x40, 0xea, 0x82, 0x2d, 0x30, 0xc9, 0xd7, 0x03, 0xa6, 0xb5, 0x14, 0x0d, 0x5f, 0x83, 0xc2,
+	0xb7, 0xc8, 0x12, 0x7a, 0x9a, 0xff, 0x59, 0xda, 0x7d, 0x1a, 0x1d, 0xcf, 0xd7, 0x78, 0x8a, 0x50,
+	0x34, 0x97, 0x43, 0xf9, 0xea, 0x87, 0xfa, 0x6d, 0xf2, 0x08, 0xdd, 0x2f, 0x04, 0x46, 0x81, 0x1c,
+	0xc6, 0xee, 0x0e, 0x59, 0x44, 0xb4, 0xc2, 0x5e, 0xfa, 0x6e, 0xf6, 0xf7, 0xdd, 0x32, 0x63, 0x83,
+	0x03, 0x3a, 0x7b, 0x39, 0x01, 0x6c, 0x82, 0xab, 0x31, 0x2e, 0x33, 0x59, 0x07, 0x12, 0x74, 0x16,
+	0xf1, 0x7b, 0xe5, 0xa4, 0x64, 0xb5, 0x06, 0xef, 0xcc, 0x6b, 0x36, 0x87, 0x14, 0x29, 0x6f, 0x34,
+	0xa3, 0x6c, 0x60, 0xb6, 0x23, 0x5c, 0x18, 0x62, 0xd3, 0x8d, 0xed, 0xe3, 0x53, 0x6a, 0x9c, 0x9c,
+	0x52, 0xe3, 0xfc, 0x94, 0x9a, 0xdf, 0x12, 0x6a, 0xfe, 0x4a, 0xa8, 0xf9, 0x3b, 0xa1, 0xe6, 0x71,
+	0x42, 0xcd, 0x3f, 0x09, 0x35, 0xff, 0x26, 0xd4, 0x38, 0x4f, 0xa8, 0xf9, 0xf3, 0x8c, 0x1a, 0xc7,
+	0x67, 0xd4, 0x38, 0x39, 0xa3, 0xc6, 0xc7, 0x95, 0x9d, 0xc8, 0xca, 0x6e, 0xc9, 0x6e, 0x54, 0x75,
+	0x77, 0xde, 0xa5, 0x1f, 0x9f, 0x26, 0xd2, 0xcb, 0xf3, 0xfa, 0xdf, 0x00, 0x76, 0x62, 0x94, 0xc0,
+	0xa4, 0x04, 0x00, 0x00,
 }
 
 func (x TaskSource) String() string {
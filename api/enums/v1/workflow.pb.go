@@ -106,9 +106,40 @@ func (WorkflowBackoffType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_004b7fefe981a755, []int{1}
 }
 
+type DeadlineAction int32
+
+const (
+	DEADLINE_ACTION_UNSPECIFIED DeadlineAction = 0
+	DEADLINE_ACTION_TERMINATE   DeadlineAction = 1
+	DEADLINE_ACTION_CANCEL      DeadlineAction = 2
+	DEADLINE_ACTION_SIGNAL      DeadlineAction = 3
+	DEADLINE_ACTION_FLAG        DeadlineAction = 4
+)
+
+var DeadlineAction_name = map[int32]string{
+	0: "Unspecified",
+	1: "Terminate",
+	2: "Cancel",
+	3: "Signal",
+	4: "Flag",
+}
+
+var DeadlineAction_value = map[string]int32{
+	"Unspecified": 0,
+	"Terminate":   1,
+	"Cancel":      2,
+	"Signal":      3,
+	"Flag":        4,
+}
+
+func (DeadlineAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_004b7fefe981a755, []int{2}
+}
+
 func init() {
 	proto.RegisterEnum("temporal.server.api.enums.v1.WorkflowExecutionState", WorkflowExecutionState_name, WorkflowExecutionState_value)
 	proto.RegisterEnum("temporal.server.api.enums.v1.WorkflowBackoffType", WorkflowBackoffType_name, WorkflowBackoffType_value)
+	proto.RegisterEnum("temporal.server.api.enums.v1.DeadlineAction", DeadlineAction_name, DeadlineAction_value)
 }
 
 func init() {
@@ -116,30 +147,35 @@ func init() {
 }
 
 var fileDescriptor_004b7fefe981a755 = []byte{
-	// 366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2e, 0x49, 0xcd, 0x2d,
-	0xc8, 0x2f, 0x4a, 0xcc, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0x2c, 0xc8, 0xd4,
-	0x4f, 0xcd, 0x2b, 0xcd, 0x2d, 0xd6, 0x2f, 0x33, 0xd4, 0x2f, 0xcf, 0x2f, 0xca, 0x4e, 0xcb, 0xc9,
-	0x2f, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0x29, 0xd6, 0x83, 0x28, 0xd6, 0x4b,
-	0x2c, 0xc8, 0xd4, 0x03, 0x2b, 0xd6, 0x2b, 0x33, 0xd4, 0x5a, 0xc6, 0xc4, 0x25, 0x16, 0x0e, 0xd5,
-	0xe0, 0x5a, 0x91, 0x9a, 0x5c, 0x5a, 0x92, 0x99, 0x9f, 0x17, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xa4,
-	0xc1, 0xa5, 0x12, 0xee, 0x1f, 0xe4, 0xed, 0xe6, 0xe3, 0x1f, 0x1e, 0xef, 0x1a, 0xe1, 0xea, 0x1c,
-	0x1a, 0xe2, 0xe9, 0xef, 0x17, 0x1f, 0x1c, 0xe2, 0x18, 0xe2, 0x1a, 0x1f, 0xea, 0x17, 0x1c, 0xe0,
-	0xea, 0xec, 0xe9, 0xe6, 0xe9, 0xea, 0x22, 0xc0, 0x20, 0xa4, 0xc2, 0xa5, 0x80, 0x53, 0xa5, 0x73,
-	0x90, 0xab, 0x63, 0x88, 0xab, 0x8b, 0x00, 0x23, 0x5e, 0x55, 0x41, 0xa1, 0x7e, 0x7e, 0x9e, 0x7e,
-	0xee, 0x02, 0x4c, 0x42, 0x6a, 0x5c, 0x4a, 0xb8, 0xcd, 0xf2, 0xf7, 0x0d, 0xf0, 0x71, 0x05, 0x99,
-	0xc6, 0x2c, 0xa4, 0xcc, 0x25, 0x8f, 0x53, 0x5d, 0x94, 0xbf, 0xaf, 0x93, 0xa7, 0xab, 0x00, 0x8b,
-	0x90, 0x22, 0x97, 0x2c, 0x4e, 0x45, 0x61, 0xfe, 0x9e, 0x2e, 0x02, 0xac, 0x04, 0xec, 0x0b, 0x0a,
-	0x0a, 0x0d, 0x00, 0xd9, 0xc7, 0xa6, 0x55, 0xcb, 0x25, 0x0c, 0x0b, 0x27, 0xa7, 0xc4, 0xe4, 0xec,
-	0xfc, 0xb4, 0xb4, 0x90, 0xca, 0x82, 0x54, 0x21, 0x55, 0x2e, 0x45, 0xb8, 0x76, 0x27, 0x47, 0x67,
-	0x6f, 0x7f, 0x37, 0xb7, 0xf8, 0x90, 0xc8, 0x00, 0xf4, 0x10, 0x92, 0xe7, 0x92, 0xc6, 0xae, 0x2c,
-	0xc8, 0x35, 0x24, 0x28, 0x52, 0x80, 0x51, 0x48, 0x8e, 0x4b, 0x0a, 0xbb, 0x02, 0xe7, 0x20, 0x7f,
-	0x3f, 0x01, 0x26, 0xa7, 0xb8, 0x0b, 0x0f, 0xe5, 0x18, 0x6e, 0x3c, 0x94, 0x63, 0xf8, 0xf0, 0x50,
-	0x8e, 0xb1, 0xe1, 0x91, 0x1c, 0xe3, 0x8a, 0x47, 0x72, 0x8c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x8b, 0x47, 0x72, 0x0c, 0x1f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x1a, 0xe9, 0xf9,
-	0x7a, 0xf0, 0xe8, 0xcf, 0xcc, 0xc7, 0x96, 0x5c, 0xac, 0xc1, 0x8c, 0x24, 0x36, 0x70, 0x62, 0x31,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x0f, 0x05, 0x23, 0x5b, 0x02, 0x00, 0x00,
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0xe3, 0x6e, 0xec, 0xe0, 0x03, 0xb2, 0x0c, 0x9a, 0x60, 0x30, 0x8f, 0xc1, 0x40, 0x53,
+	0x91, 0x12, 0x4d, 0x1c, 0x39, 0xb9, 0x89, 0x53, 0x59, 0x4b, 0xed, 0xca, 0x75, 0x29, 0xdb, 0x81,
+	0x28, 0x14, 0x17, 0x45, 0xeb, 0xea, 0x28, 0xcb, 0x3a, 0x38, 0x20, 0xf1, 0x08, 0x3c, 0x03, 0x07,
+	0xc4, 0xa3, 0x70, 0xec, 0x71, 0x47, 0x9a, 0x5e, 0x38, 0xee, 0x11, 0x50, 0x0b, 0xab, 0x44, 0xd5,
+	0xb2, 0x9b, 0xa5, 0xef, 0xf7, 0xff, 0x3e, 0xeb, 0xff, 0xff, 0xe0, 0xf3, 0xc2, 0x9c, 0x66, 0x36,
+	0x4f, 0xfa, 0xde, 0x99, 0xc9, 0x87, 0x26, 0xf7, 0x92, 0x2c, 0xf5, 0xcc, 0xe0, 0xfc, 0xf4, 0xcc,
+	0x1b, 0x1e, 0x78, 0x17, 0x36, 0x3f, 0xe9, 0xf5, 0xed, 0x85, 0x9b, 0xe5, 0xb6, 0xb0, 0xf8, 0xe1,
+	0x35, 0xec, 0xfe, 0x81, 0xdd, 0x24, 0x4b, 0xdd, 0x19, 0xec, 0x0e, 0x0f, 0xaa, 0xdf, 0x2a, 0x70,
+	0xb3, 0xf3, 0x77, 0x80, 0x7d, 0x30, 0xdd, 0xf3, 0x22, 0xb5, 0x83, 0x56, 0x91, 0x14, 0x06, 0xef,
+	0xc3, 0xbd, 0x8e, 0x54, 0x87, 0x61, 0x24, 0x3b, 0x31, 0x7b, 0xcd, 0xfc, 0xb6, 0xe6, 0x52, 0xc4,
+	0x2d, 0x4d, 0x35, 0x8b, 0xdb, 0xa2, 0xd5, 0x64, 0x3e, 0x0f, 0x39, 0x0b, 0x90, 0x83, 0xf7, 0xe0,
+	0xa3, 0x95, 0xa4, 0xaf, 0x18, 0xd5, 0x2c, 0x40, 0xe0, 0xbf, 0x94, 0x6a, 0x0b, 0xc1, 0x45, 0x1d,
+	0x55, 0xf0, 0x33, 0xf8, 0x78, 0xb5, 0x97, 0x6c, 0x34, 0x23, 0x36, 0x75, 0x5b, 0xc3, 0x4f, 0xe0,
+	0xce, 0x4a, 0xee, 0x58, 0x36, 0x6a, 0x9c, 0xa1, 0x75, 0xbc, 0x0b, 0xb7, 0x57, 0x42, 0xaf, 0x24,
+	0x0f, 0xd0, 0xad, 0x1b, 0xf2, 0x94, 0x6a, 0x37, 0xa7, 0x79, 0x1b, 0xd5, 0x4f, 0xf0, 0xce, 0xf5,
+	0x9e, 0x6a, 0x49, 0xf7, 0xc4, 0xf6, 0x7a, 0xfa, 0x63, 0x66, 0xf0, 0x53, 0xb8, 0x3b, 0x1f, 0xaf,
+	0x51, 0xff, 0x50, 0x86, 0x61, 0xac, 0x8f, 0x9a, 0x8b, 0x1b, 0xda, 0x81, 0x0f, 0x96, 0x63, 0x8a,
+	0x69, 0x75, 0x84, 0x00, 0x26, 0x70, 0x6b, 0x39, 0xe0, 0x2b, 0x29, 0x50, 0xa5, 0xfa, 0x15, 0xc0,
+	0xdb, 0x81, 0x49, 0xde, 0xf5, 0xd3, 0x81, 0xa1, 0xdd, 0xe9, 0x91, 0xa6, 0x9e, 0x01, 0xa3, 0x41,
+	0xc4, 0x05, 0x8b, 0xa9, 0x3f, 0xfb, 0xf6, 0xbf, 0xa1, 0xdb, 0xf0, 0xfe, 0x22, 0xa0, 0x99, 0x6a,
+	0x70, 0x41, 0x35, 0x43, 0x00, 0x6f, 0xc1, 0xcd, 0x45, 0xd9, 0xa7, 0xc2, 0x67, 0x11, 0xaa, 0x2c,
+	0xd3, 0x5a, 0xbc, 0x2e, 0x68, 0x84, 0xd6, 0xf0, 0x3d, 0x78, 0x77, 0x51, 0x0b, 0x23, 0x5a, 0x47,
+	0xeb, 0xb5, 0x37, 0xa3, 0x31, 0x71, 0x2e, 0xc7, 0xc4, 0xb9, 0x1a, 0x13, 0xf0, 0xb9, 0x24, 0xe0,
+	0x7b, 0x49, 0xc0, 0x8f, 0x92, 0x80, 0x51, 0x49, 0xc0, 0xcf, 0x92, 0x80, 0x5f, 0x25, 0x71, 0xae,
+	0x4a, 0x02, 0xbe, 0x4c, 0x88, 0x33, 0x9a, 0x10, 0xe7, 0x72, 0x42, 0x9c, 0xe3, 0xfd, 0xf7, 0xd6,
+	0x9d, 0x77, 0x34, 0xb5, 0xcb, 0x3a, 0xfd, 0x72, 0xf6, 0x78, 0xbb, 0x31, 0x6b, 0xf4, 0x8b, 0xdf,
+	0x03, 0x00, 0xa6, 0x17, 0xe6, 0xa2, 0x00, 0x03, 0x00, 0x00,
 }
 
 func (x WorkflowExecutionState) String() string {
@@ -156,3 +192,10 @@ func (x WorkflowBackoffType) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x DeadlineAction) String() string {
+	s, ok := DeadlineAction_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
@@ -85,6 +85,41 @@ func (m *TaskAlreadyStartedFailure) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TaskAlreadyStartedFailure proto.InternalMessageInfo
 
+type FailedPreconditionFailure struct {
+}
+
+func (m *FailedPreconditionFailure) Reset()      { *m = FailedPreconditionFailure{} }
+func (*FailedPreconditionFailure) ProtoMessage() {}
+func (*FailedPreconditionFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73580c2e9c4cb332, []int{1}
+}
+func (m *FailedPreconditionFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedPreconditionFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedPreconditionFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedPreconditionFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedPreconditionFailure.Merge(m, src)
+}
+func (m *FailedPreconditionFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedPreconditionFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedPreconditionFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedPreconditionFailure proto.InternalMessageInfo
+
 type CurrentBranchChangedFailure struct {
 	CurrentBranchToken []byte `protobuf:"bytes,1,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
 	RequestBranchToken []byte `protobuf:"bytes,2,opt,name=request_branch_token,json=requestBranchToken,proto3" json:"request_branch_token,omitempty"`
@@ -93,7 +128,7 @@ type CurrentBranchChangedFailure struct {
 func (m *CurrentBranchChangedFailure) Reset()      { *m = CurrentBranchChangedFailure{} }
 func (*CurrentBranchChangedFailure) ProtoMessage() {}
 func (*CurrentBranchChangedFailure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73580c2e9c4cb332, []int{1}
+	return fileDescriptor_73580c2e9c4cb332, []int{2}
 }
 func (m *CurrentBranchChangedFailure) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -144,7 +179,7 @@ type ShardOwnershipLostFailure struct {
 func (m *ShardOwnershipLostFailure) Reset()      { *m = ShardOwnershipLostFailure{} }
 func (*ShardOwnershipLostFailure) ProtoMessage() {}
 func (*ShardOwnershipLostFailure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73580c2e9c4cb332, []int{2}
+	return fileDescriptor_73580c2e9c4cb332, []int{3}
 }
 func (m *ShardOwnershipLostFailure) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -197,7 +232,7 @@ type RetryTaskFailure struct {
 func (m *RetryTaskFailure) Reset()      { *m = RetryTaskFailure{} }
 func (*RetryTaskFailure) ProtoMessage() {}
 func (*RetryTaskFailure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73580c2e9c4cb332, []int{3}
+	return fileDescriptor_73580c2e9c4cb332, []int{4}
 }
 func (m *RetryTaskFailure) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -267,7 +302,7 @@ type RetryTaskV2Failure struct {
 func (m *RetryTaskV2Failure) Reset()      { *m = RetryTaskV2Failure{} }
 func (*RetryTaskV2Failure) ProtoMessage() {}
 func (*RetryTaskV2Failure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73580c2e9c4cb332, []int{4}
+	return fileDescriptor_73580c2e9c4cb332, []int{5}
 }
 func (m *RetryTaskV2Failure) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,6 +382,7 @@ func (m *RetryTaskV2Failure) GetEndEventVersion() int64 {
 
 func init() {
 	proto.RegisterType((*TaskAlreadyStartedFailure)(nil), "temporal.server.api.errordetails.v1.TaskAlreadyStartedFailure")
+	proto.RegisterType((*FailedPreconditionFailure)(nil), "temporal.server.api.errordetails.v1.FailedPreconditionFailure")
 	proto.RegisterType((*CurrentBranchChangedFailure)(nil), "temporal.server.api.errordetails.v1.CurrentBranchChangedFailure")
 	proto.RegisterType((*ShardOwnershipLostFailure)(nil), "temporal.server.api.errordetails.v1.ShardOwnershipLostFailure")
 	proto.RegisterType((*RetryTaskFailure)(nil), "temporal.server.api.errordetails.v1.RetryTaskFailure")
@@ -358,38 +394,39 @@ func init() {
 }
 
 var fileDescriptor_73580c2e9c4cb332 = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xbd, 0x6e, 0x13, 0x41,
-	0x14, 0x85, 0x77, 0x12, 0x62, 0x94, 0x6b, 0xf3, 0x93, 0x05, 0x24, 0x47, 0x11, 0x83, 0xb3, 0x50,
-	0x44, 0x14, 0x6b, 0x0c, 0x12, 0x0d, 0x15, 0x89, 0x40, 0x58, 0x42, 0x42, 0x72, 0xa2, 0x14, 0x48,
-	0xc8, 0x9a, 0x78, 0x2e, 0xf6, 0xca, 0xeb, 0x99, 0xe5, 0xce, 0xd8, 0x26, 0x1d, 0xbc, 0x01, 0x88,
-	0x97, 0xe0, 0x51, 0x28, 0x5d, 0xa6, 0xc4, 0xeb, 0x86, 0x32, 0x8f, 0x80, 0x66, 0xec, 0xb5, 0xad,
-	0x14, 0x94, 0x94, 0x7b, 0xce, 0x77, 0xce, 0x1e, 0xed, 0xea, 0x42, 0xc3, 0xe2, 0x20, 0xd3, 0x24,
-	0xd2, 0xba, 0x41, 0x1a, 0x21, 0xd5, 0x45, 0x96, 0xd4, 0x91, 0x48, 0x93, 0x44, 0x2b, 0x92, 0xd4,
-	0xd4, 0x47, 0x8d, 0xfa, 0x00, 0x8d, 0x11, 0x5d, 0x8c, 0x33, 0xd2, 0x56, 0x87, 0x0f, 0x8b, 0x48,
-	0x3c, 0x8f, 0xc4, 0x22, 0x4b, 0xe2, 0xf5, 0x48, 0x3c, 0x6a, 0x44, 0x7b, 0xb0, 0x7b, 0x22, 0x4c,
-	0xff, 0x65, 0x4a, 0x28, 0xe4, 0xf9, 0xb1, 0x15, 0x64, 0x51, 0xbe, 0x16, 0x49, 0x3a, 0x24, 0x8c,
-	0xbe, 0x32, 0xd8, 0x3b, 0x1a, 0x12, 0xa1, 0xb2, 0x87, 0x24, 0x54, 0xa7, 0x77, 0xd4, 0x13, 0xaa,
-	0xbb, 0xf4, 0xc3, 0x27, 0x70, 0xb7, 0x33, 0xb7, 0xdb, 0x67, 0xde, 0x6f, 0x5b, 0xdd, 0x47, 0x55,
-	0x65, 0x35, 0x76, 0x50, 0x69, 0x85, 0x9d, 0xf5, 0xe8, 0x89, 0x73, 0x5c, 0x82, 0xf0, 0xd3, 0x10,
-	0xcd, 0x95, 0xc4, 0xc6, 0x3c, 0xb1, 0xf0, 0xd6, 0x12, 0xd1, 0x07, 0xd8, 0x3d, 0xee, 0x09, 0x92,
-	0xef, 0xc6, 0x0a, 0xc9, 0xf4, 0x92, 0xec, 0xad, 0x36, 0xb6, 0x18, 0x70, 0x1f, 0x40, 0x3b, 0xbd,
-	0xdd, 0xd3, 0xc6, 0xfa, 0xd7, 0x6e, 0xb7, 0xb6, 0xbd, 0xf2, 0x46, 0x1b, 0x1b, 0xee, 0x43, 0xa5,
-	0xd8, 0xe7, 0x81, 0x0d, 0x0f, 0x94, 0x17, 0x9a, 0x43, 0xa2, 0xef, 0x0c, 0x6e, 0xb7, 0xd0, 0xd2,
-	0xb9, 0xfb, 0x0a, 0x45, 0xed, 0x3e, 0x54, 0x94, 0x18, 0xa0, 0xc9, 0x44, 0x07, 0xdb, 0x89, 0x5c,
-	0x14, 0x97, 0x97, 0x5a, 0x53, 0x86, 0x0f, 0xa0, 0x3c, 0xd6, 0xd4, 0xff, 0x98, 0xea, 0xb1, 0x23,
-	0xe6, 0xcd, 0x50, 0x48, 0x4d, 0x19, 0xde, 0x83, 0x12, 0x0d, 0x95, 0xf3, 0x36, 0xbd, 0xb7, 0x45,
-	0x43, 0xd5, 0x94, 0x61, 0x04, 0x37, 0x14, 0x7e, 0xb6, 0x6d, 0x1c, 0xb9, 0x55, 0x89, 0xac, 0x5e,
-	0xab, 0xb1, 0x83, 0xcd, 0x56, 0xd9, 0x89, 0xaf, 0x9c, 0xd6, 0x94, 0xd1, 0x8f, 0x0d, 0x08, 0x97,
-	0x9b, 0x4e, 0x9f, 0xfe, 0x87, 0x55, 0x8f, 0xe0, 0xa6, 0x71, 0xbf, 0xfe, 0xea, 0xac, 0x8a, 0x57,
-	0x17, 0xbb, 0xc2, 0x18, 0xee, 0xac, 0x53, 0x23, 0x24, 0x93, 0x68, 0x55, 0xdd, 0xf2, 0xe8, 0xce,
-	0x0a, 0x3d, 0x9d, 0x1b, 0x61, 0x0d, 0x2a, 0xa8, 0xe4, 0xaa, 0xb3, 0xe4, 0x41, 0x40, 0x25, 0x8b,
-	0xc6, 0xc7, 0xb0, 0xb3, 0x22, 0x8a, 0xbe, 0xeb, 0x1e, 0xbb, 0x55, 0x60, 0x8b, 0xb6, 0xc3, 0x74,
-	0x32, 0xe5, 0xc1, 0xc5, 0x94, 0x07, 0x97, 0x53, 0xce, 0xbe, 0xe4, 0x9c, 0xfd, 0xcc, 0x39, 0xfb,
-	0x95, 0x73, 0x36, 0xc9, 0x39, 0xfb, 0x9d, 0x73, 0xf6, 0x27, 0xe7, 0xc1, 0x65, 0xce, 0xd9, 0xb7,
-	0x19, 0x0f, 0x26, 0x33, 0x1e, 0x5c, 0xcc, 0x78, 0xf0, 0xfe, 0x79, 0x57, 0xc7, 0xcb, 0x3b, 0x48,
-	0xf4, 0x3f, 0xae, 0xe7, 0xc5, 0xfa, 0xf3, 0x59, 0xc9, 0xdf, 0xd0, 0xb3, 0xbf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x58, 0x67, 0x0b, 0x77, 0x78, 0x03, 0x00, 0x00,
+	// 500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x4f, 0x6f, 0x12, 0x41,
+	0x18, 0xc6, 0x77, 0xa8, 0xc5, 0x74, 0xc0, 0x3f, 0x1d, 0x35, 0xa1, 0x69, 0x1c, 0x29, 0x7a, 0x68,
+	0x3c, 0x2c, 0xa2, 0x89, 0x17, 0x4f, 0xb6, 0xd1, 0x48, 0x62, 0xa2, 0xa1, 0x4d, 0x0f, 0x26, 0x86,
+	0x4c, 0x99, 0x57, 0x98, 0xb0, 0xcc, 0xac, 0xef, 0x0c, 0x60, 0x6f, 0xfa, 0x0d, 0x34, 0x7e, 0x09,
+	0x3f, 0x8a, 0x47, 0x8e, 0x3d, 0xca, 0x72, 0xf1, 0xd8, 0x8f, 0x60, 0x66, 0x60, 0x81, 0xf4, 0xe0,
+	0xb1, 0x37, 0x78, 0x9e, 0xdf, 0xf3, 0xec, 0x93, 0xcd, 0xbe, 0xb4, 0xe1, 0x60, 0x90, 0x1a, 0x14,
+	0x49, 0xdd, 0x02, 0x8e, 0x00, 0xeb, 0x22, 0x55, 0x75, 0x40, 0x34, 0x28, 0xc1, 0x09, 0x95, 0xd8,
+	0xfa, 0xa8, 0x51, 0x1f, 0x80, 0xb5, 0xa2, 0x0b, 0x71, 0x8a, 0xc6, 0x19, 0xf6, 0x30, 0x8f, 0xc4,
+	0xf3, 0x48, 0x2c, 0x52, 0x15, 0xaf, 0x47, 0xe2, 0x51, 0xa3, 0xb6, 0x4b, 0x77, 0x8e, 0x85, 0xed,
+	0xbf, 0x4c, 0x10, 0x84, 0x3c, 0x3b, 0x72, 0x02, 0x1d, 0xc8, 0xd7, 0x42, 0x25, 0x43, 0x04, 0x6f,
+	0xfa, 0x9f, 0x20, 0xdf, 0x23, 0x74, 0x8c, 0x96, 0xca, 0x29, 0xa3, 0x73, 0xf3, 0x1b, 0xa1, 0xbb,
+	0x87, 0x43, 0x44, 0xd0, 0xee, 0x00, 0x85, 0xee, 0xf4, 0x0e, 0x7b, 0x42, 0x77, 0x97, 0x61, 0xf6,
+	0x84, 0xde, 0xed, 0xcc, 0xed, 0xf6, 0x69, 0xf0, 0xdb, 0xce, 0xf4, 0x41, 0x57, 0x48, 0x95, 0xec,
+	0x97, 0x5b, 0xac, 0xb3, 0x1e, 0x3d, 0xf6, 0x8e, 0x4f, 0x20, 0x7c, 0x1e, 0x82, 0xbd, 0x94, 0x28,
+	0xcc, 0x13, 0x0b, 0x6f, 0x2d, 0x51, 0xfb, 0x48, 0x77, 0x8e, 0x7a, 0x02, 0xe5, 0xbb, 0xb1, 0x06,
+	0xb4, 0x3d, 0x95, 0xbe, 0x35, 0xd6, 0xe5, 0x03, 0xee, 0x53, 0x6a, 0xbc, 0xde, 0xee, 0x19, 0xeb,
+	0xc2, 0x63, 0xb7, 0x5a, 0x5b, 0x41, 0x79, 0x63, 0xac, 0x63, 0x7b, 0xb4, 0x9c, 0xef, 0x0b, 0x40,
+	0x21, 0x00, 0xa5, 0x85, 0xe6, 0x91, 0xda, 0x0f, 0x42, 0x6f, 0xb7, 0xc0, 0xe1, 0x99, 0x7f, 0x45,
+	0x79, 0xed, 0x1e, 0x2d, 0x6b, 0x31, 0x00, 0x9b, 0x8a, 0x0e, 0xb4, 0x95, 0x5c, 0x14, 0x97, 0x96,
+	0x5a, 0x53, 0xb2, 0x07, 0xb4, 0x34, 0x36, 0xd8, 0xff, 0x94, 0x98, 0xb1, 0x27, 0xe6, 0xcd, 0x34,
+	0x97, 0x9a, 0x92, 0xdd, 0xa3, 0x45, 0x1c, 0x6a, 0xef, 0x6d, 0x04, 0x6f, 0x13, 0x87, 0xba, 0x29,
+	0x59, 0x8d, 0xde, 0xd0, 0xf0, 0xc5, 0xb5, 0x61, 0xe4, 0x57, 0x29, 0x59, 0xb9, 0x56, 0x25, 0xfb,
+	0x1b, 0xad, 0x92, 0x17, 0x5f, 0x79, 0xad, 0x29, 0x6b, 0x3f, 0x0b, 0x94, 0x2d, 0x37, 0x9d, 0x3c,
+	0xbd, 0x82, 0x55, 0x8f, 0xe8, 0x4d, 0xeb, 0xbf, 0x8b, 0xcb, 0xb3, 0xca, 0x41, 0x5d, 0xec, 0x62,
+	0x31, 0xbd, 0xb3, 0x4e, 0x8d, 0x00, 0xad, 0x32, 0xba, 0xb2, 0x19, 0xd0, 0xed, 0x15, 0x7a, 0x32,
+	0x37, 0x58, 0x95, 0x96, 0x41, 0xcb, 0x55, 0x67, 0x31, 0x80, 0x14, 0xb4, 0xcc, 0x1b, 0x1f, 0xd3,
+	0xed, 0x15, 0x91, 0xf7, 0x5d, 0x0f, 0xd8, 0xad, 0x1c, 0x5b, 0xb4, 0x1d, 0x24, 0x93, 0x29, 0x8f,
+	0xce, 0xa7, 0x3c, 0xba, 0x98, 0x72, 0xf2, 0x35, 0xe3, 0xe4, 0x57, 0xc6, 0xc9, 0xef, 0x8c, 0x93,
+	0x49, 0xc6, 0xc9, 0x9f, 0x8c, 0x93, 0xbf, 0x19, 0x8f, 0x2e, 0x32, 0x4e, 0xbe, 0xcf, 0x78, 0x34,
+	0x99, 0xf1, 0xe8, 0x7c, 0xc6, 0xa3, 0x0f, 0xcf, 0xbb, 0x26, 0x5e, 0x1e, 0x89, 0x32, 0xff, 0x39,
+	0xad, 0x17, 0xeb, 0xff, 0x4f, 0x8b, 0xe1, 0xc0, 0x9e, 0xfd, 0x1b, 0x00, 0x84, 0xd4, 0x23, 0x8a,
+	0x95, 0x03, 0x00, 0x00,
 }
 
 func (this *TaskAlreadyStartedFailure) Equal(that interface{}) bool {
@@ -413,6 +450,27 @@ func (this *TaskAlreadyStartedFailure) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *FailedPreconditionFailure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FailedPreconditionFailure)
+	if !ok {
+		that2, ok := that.(FailedPreconditionFailure)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *CurrentBranchChangedFailure) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -551,6 +609,15 @@ func (this *TaskAlreadyStartedFailure) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *FailedPreconditionFailure) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&errordetails.FailedPreconditionFailure{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *CurrentBranchChangedFailure) GoString() string {
 	if this == nil {
 		return "nil"
@@ -633,6 +700,29 @@ func (m *TaskAlreadyStartedFailure) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *FailedPreconditionFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedPreconditionFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedPreconditionFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *CurrentBranchChangedFailure) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -840,6 +930,15 @@ func (m *TaskAlreadyStartedFailure) Size() (n int) {
 	return n
 }
 
+func (m *FailedPreconditionFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *CurrentBranchChangedFailure) Size() (n int) {
 	if m == nil {
 		return 0
@@ -946,6 +1045,15 @@ func (this *TaskAlreadyStartedFailure) String() string {
 	}, "")
 	return s
 }
+func (this *FailedPreconditionFailure) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FailedPreconditionFailure{`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CurrentBranchChangedFailure) String() string {
 	if this == nil {
 		return "nil"
@@ -1058,6 +1166,59 @@ func (m *TaskAlreadyStartedFailure) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FailedPreconditionFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedPreconditionFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedPreconditionFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CurrentBranchChangedFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -80,6 +80,8 @@ type StartWorkflowExecutionRequest struct {
 	ContinuedFailure                *v13.Failure                      `protobuf:"bytes,7,opt,name=continued_failure,json=continuedFailure,proto3" json:"continued_failure,omitempty"`
 	LastCompletionResult            *v14.Payloads                     `protobuf:"bytes,8,opt,name=last_completion_result,json=lastCompletionResult,proto3" json:"last_completion_result,omitempty"`
 	FirstWorkflowTaskBackoff        *time.Duration                    `protobuf:"bytes,9,opt,name=first_workflow_task_backoff,json=firstWorkflowTaskBackoff,proto3,stdduration" json:"first_workflow_task_backoff,omitempty"`
+	// The deadline rules of the run, the default rules of the namespace are used if not set.
+	DeadlineRules *v11.DeadlineRules `protobuf:"bytes,10,opt,name=deadline_rules,json=deadlineRules,proto3" json:"deadline_rules,omitempty"`
 }
 
 func (m *StartWorkflowExecutionRequest) Reset()      { *m = StartWorkflowExecutionRequest{} }
@@ -177,6 +179,13 @@ func (m *StartWorkflowExecutionRequest) GetFirstWorkflowTaskBackoff() *time.Dura
 	return nil
 }
 
+func (m *StartWorkflowExecutionRequest) GetDeadlineRules() *v11.DeadlineRules {
+	if m != nil {
+		return m.DeadlineRules
+	}
+	return nil
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 }
@@ -304,6 +313,8 @@ type GetMutableStateResponse struct {
 	WorkflowStatus                        v12.WorkflowExecutionStatus `protobuf:"varint,16,opt,name=workflow_status,json=workflowStatus,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"workflow_status,omitempty"`
 	VersionHistories                      *v17.VersionHistories       `protobuf:"bytes,17,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
 	IsStickyTaskQueueEnabled              bool                        `protobuf:"varint,18,opt,name=is_sticky_task_queue_enabled,json=isStickyTaskQueueEnabled,proto3" json:"is_sticky_task_queue_enabled,omitempty"`
+	// The deadline rules of the run, the clusters resending the history of the run replicate them with its first events.
+	DeadlineRules *v11.DeadlineRules `protobuf:"bytes,19,opt,name=deadline_rules,json=deadlineRules,proto3" json:"deadline_rules,omitempty"`
 }
 
 func (m *GetMutableStateResponse) Reset()      { *m = GetMutableStateResponse{} }
@@ -436,6 +447,13 @@ func (m *GetMutableStateResponse) GetIsStickyTaskQueueEnabled() bool {
 	return false
 }
 
+func (m *GetMutableStateResponse) GetDeadlineRules() *v11.DeadlineRules {
+	if m != nil {
+		return m.DeadlineRules
+	}
+	return nil
+}
+
 type PollMutableStateRequest struct {
 	NamespaceId         string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	Execution           *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
@@ -821,6 +839,8 @@ type RecordWorkflowTaskStartedResponse struct {
 	ScheduledTime              *time.Time                     `protobuf:"bytes,12,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
 	StartedTime                *time.Time                     `protobuf:"bytes,13,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
 	Queries                    map[string]*v18.WorkflowQuery  `protobuf:"bytes,14,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	HistorySizeBytes           int64                          `protobuf:"varint,15,opt,name=history_size_bytes,json=historySizeBytes,proto3" json:"history_size_bytes,omitempty"`
+	SuggestContinueAsNew       bool                           `protobuf:"varint,16,opt,name=suggest_continue_as_new,json=suggestContinueAsNew,proto3" json:"suggest_continue_as_new,omitempty"`
 }
 
 func (m *RecordWorkflowTaskStartedResponse) Reset()      { *m = RecordWorkflowTaskStartedResponse{} }
@@ -953,6 +973,20 @@ func (m *RecordWorkflowTaskStartedResponse) GetQueries() map[string]*v18.Workflo
 	return nil
 }
 
+func (m *RecordWorkflowTaskStartedResponse) GetHistorySizeBytes() int64 {
+	if m != nil {
+		return m.HistorySizeBytes
+	}
+	return 0
+}
+
+func (m *RecordWorkflowTaskStartedResponse) GetSuggestContinueAsNew() bool {
+	if m != nil {
+		return m.SuggestContinueAsNew
+	}
+	return false
+}
+
 type RecordActivityTaskStartedRequest struct {
 	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -1767,6 +1801,8 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	// (-- api-linter: core::0140::prepositions=disabled
 	//     aip.dev/not-precedent: "with" is needed here. --)
 	SignalWithStartRequest *v1.SignalWithStartWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=signal_with_start_request,json=signalWithStartRequest,proto3" json:"signal_with_start_request,omitempty"`
+	// The deadline rules of the run, the default rules of the namespace are used if not set.
+	DeadlineRules *v11.DeadlineRules `protobuf:"bytes,3,opt,name=deadline_rules,json=deadlineRules,proto3" json:"deadline_rules,omitempty"`
 }
 
 func (m *SignalWithStartWorkflowExecutionRequest) Reset() {
@@ -1817,6 +1853,13 @@ func (m *SignalWithStartWorkflowExecutionRequest) GetSignalWithStartRequest() *v
 	return nil
 }
 
+func (m *SignalWithStartWorkflowExecutionRequest) GetDeadlineRules() *v11.DeadlineRules {
+	if m != nil {
+		return m.DeadlineRules
+	}
+	return nil
+}
+
 type SignalWithStartWorkflowExecutionResponse struct {
 	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 }
@@ -2583,6 +2626,8 @@ type ReplicateEventsV2Request struct {
 	Events              *v14.DataBlob             `protobuf:"bytes,4,opt,name=events,proto3" json:"events,omitempty"`
 	// New run events does not need version history since there is no prior events.
 	NewRunEvents *v14.DataBlob `protobuf:"bytes,5,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
+	// The deadline rules of the run, they are applied when the events start the run. New runs keep the rules of the run.
+	DeadlineRules *v11.DeadlineRules `protobuf:"bytes,6,opt,name=deadline_rules,json=deadlineRules,proto3" json:"deadline_rules,omitempty"`
 }
 
 func (m *ReplicateEventsV2Request) Reset()      { *m = ReplicateEventsV2Request{} }
@@ -2652,6 +2697,13 @@ func (m *ReplicateEventsV2Request) GetNewRunEvents() *v14.DataBlob {
 	return nil
 }
 
+func (m *ReplicateEventsV2Request) GetDeadlineRules() *v11.DeadlineRules {
+	if m != nil {
+		return m.DeadlineRules
+	}
+	return nil
+}
+
 type ReplicateEventsV2Response struct {
 }
 
@@ -2796,6 +2848,20 @@ type SyncActivityRequest struct {
 	LastFailure        *v13.Failure        `protobuf:"bytes,12,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
 	LastWorkerIdentity string              `protobuf:"bytes,13,opt,name=last_worker_identity,json=lastWorkerIdentity,proto3" json:"last_worker_identity,omitempty"`
 	VersionHistory     *v17.VersionHistory `protobuf:"bytes,14,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	Paused             bool                `protobuf:"varint,15,opt,name=paused,proto3" json:"paused,omitempty"`
+	Stamp              int32               `protobuf:"varint,16,opt,name=stamp,proto3" json:"stamp,omitempty"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
+	ScheduleToCloseTimeout *time.Duration `protobuf:"bytes,17,opt,name=schedule_to_close_timeout,json=scheduleToCloseTimeout,proto3,stdduration" json:"schedule_to_close_timeout,omitempty"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
+	ScheduleToStartTimeout *time.Duration `protobuf:"bytes,18,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3,stdduration" json:"schedule_to_start_timeout,omitempty"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
+	StartToCloseTimeout *time.Duration   `protobuf:"bytes,19,opt,name=start_to_close_timeout,json=startToCloseTimeout,proto3,stdduration" json:"start_to_close_timeout,omitempty"`
+	HeartbeatTimeout    *time.Duration   `protobuf:"bytes,20,opt,name=heartbeat_timeout,json=heartbeatTimeout,proto3,stdduration" json:"heartbeat_timeout,omitempty"`
+	RetryPolicy         *v14.RetryPolicy `protobuf:"bytes,21,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	RetryExpirationTime *time.Time       `protobuf:"bytes,22,opt,name=retry_expiration_time,json=retryExpirationTime,proto3,stdtime" json:"retry_expiration_time,omitempty"`
 }
 
 func (m *SyncActivityRequest) Reset()      { *m = SyncActivityRequest{} }
@@ -2928,6 +2994,62 @@ func (m *SyncActivityRequest) GetVersionHistory() *v17.VersionHistory {
 	return nil
 }
 
+func (m *SyncActivityRequest) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *SyncActivityRequest) GetStamp() int32 {
+	if m != nil {
+		return m.Stamp
+	}
+	return 0
+}
+
+func (m *SyncActivityRequest) GetScheduleToCloseTimeout() *time.Duration {
+	if m != nil {
+		return m.ScheduleToCloseTimeout
+	}
+	return nil
+}
+
+func (m *SyncActivityRequest) GetScheduleToStartTimeout() *time.Duration {
+	if m != nil {
+		return m.ScheduleToStartTimeout
+	}
+	return nil
+}
+
+func (m *SyncActivityRequest) GetStartToCloseTimeout() *time.Duration {
+	if m != nil {
+		return m.StartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *SyncActivityRequest) GetHeartbeatTimeout() *time.Duration {
+	if m != nil {
+		return m.HeartbeatTimeout
+	}
+	return nil
+}
+
+func (m *SyncActivityRequest) GetRetryPolicy() *v14.RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *SyncActivityRequest) GetRetryExpirationTime() *time.Time {
+	if m != nil {
+		return m.RetryExpirationTime
+	}
+	return nil
+}
+
 type SyncActivityResponse struct {
 }
 
@@ -3586,8 +3708,9 @@ func (m *GetDLQReplicationMessagesResponse) GetReplicationTasks() []*v112.Replic
 }
 
 type QueryWorkflowRequest struct {
-	NamespaceId string                   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Request     *v1.QueryWorkflowRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	NamespaceId           string                    `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Request               *v1.QueryWorkflowRequest  `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	QueryConsistencyLevel v16.QueryConsistencyLevel `protobuf:"varint,3,opt,name=query_consistency_level,json=queryConsistencyLevel,proto3,enum=temporal.server.api.enums.v1.QueryConsistencyLevel" json:"query_consistency_level,omitempty"`
 }
 
 func (m *QueryWorkflowRequest) Reset()      { *m = QueryWorkflowRequest{} }
@@ -3636,6 +3759,13 @@ func (m *QueryWorkflowRequest) GetRequest() *v1.QueryWorkflowRequest {
 	return nil
 }
 
+func (m *QueryWorkflowRequest) GetQueryConsistencyLevel() v16.QueryConsistencyLevel {
+	if m != nil {
+		return m.QueryConsistencyLevel
+	}
+	return v16.QUERY_CONSISTENCY_LEVEL_UNSPECIFIED
+}
+
 type QueryWorkflowResponse struct {
 	Response *v1.QueryWorkflowResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
@@ -4221,445 +4351,1074 @@ func (m *RefreshWorkflowTasksResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RefreshWorkflowTasksResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionRequest")
-	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionResponse")
-	proto.RegisterType((*GetMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.GetMutableStateRequest")
-	proto.RegisterType((*GetMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.GetMutableStateResponse")
-	proto.RegisterType((*PollMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.PollMutableStateRequest")
-	proto.RegisterType((*PollMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.PollMutableStateResponse")
-	proto.RegisterType((*ResetStickyTaskQueueRequest)(nil), "temporal.server.api.historyservice.v1.ResetStickyTaskQueueRequest")
-	proto.RegisterType((*ResetStickyTaskQueueResponse)(nil), "temporal.server.api.historyservice.v1.ResetStickyTaskQueueResponse")
-	proto.RegisterType((*RecordWorkflowTaskStartedRequest)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedRequest")
-	proto.RegisterType((*RecordWorkflowTaskStartedResponse)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedResponse")
-	proto.RegisterMapType((map[string]*v18.WorkflowQuery)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedResponse.QueriesEntry")
-	proto.RegisterType((*RecordActivityTaskStartedRequest)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskStartedRequest")
-	proto.RegisterType((*RecordActivityTaskStartedResponse)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskStartedResponse")
-	proto.RegisterType((*RespondWorkflowTaskCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskCompletedRequest")
-	proto.RegisterType((*RespondWorkflowTaskCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskCompletedResponse")
-	proto.RegisterType((*RespondWorkflowTaskFailedRequest)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskFailedRequest")
-	proto.RegisterType((*RespondWorkflowTaskFailedResponse)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskFailedResponse")
-	proto.RegisterType((*RecordActivityTaskHeartbeatRequest)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskHeartbeatRequest")
-	proto.RegisterType((*RecordActivityTaskHeartbeatResponse)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskHeartbeatResponse")
-	proto.RegisterType((*RespondActivityTaskCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCompletedRequest")
-	proto.RegisterType((*RespondActivityTaskCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCompletedResponse")
-	proto.RegisterType((*RespondActivityTaskFailedRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskFailedRequest")
-	proto.RegisterType((*RespondActivityTaskFailedResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskFailedResponse")
-	proto.RegisterType((*RespondActivityTaskCanceledRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCanceledRequest")
-	proto.RegisterType((*RespondActivityTaskCanceledResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCanceledResponse")
-	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.SignalWorkflowExecutionRequest")
-	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWorkflowExecutionResponse")
-	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionRequest")
-	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionResponse")
-	proto.RegisterType((*RemoveSignalMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateRequest")
-	proto.RegisterType((*RemoveSignalMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateResponse")
-	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionRequest")
-	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionResponse")
-	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionRequest")
-	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionResponse")
-	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionRequest")
-	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionResponse")
-	proto.RegisterType((*ScheduleWorkflowTaskRequest)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskRequest")
-	proto.RegisterType((*ScheduleWorkflowTaskResponse)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskResponse")
-	proto.RegisterType((*RecordChildExecutionCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RecordChildExecutionCompletedRequest")
-	proto.RegisterType((*RecordChildExecutionCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RecordChildExecutionCompletedResponse")
-	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionRequest")
-	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionResponse")
-	proto.RegisterType((*ReplicateEventsV2Request)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Request")
-	proto.RegisterType((*ReplicateEventsV2Response)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Response")
-	proto.RegisterType((*SyncShardStatusRequest)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusRequest")
-	proto.RegisterType((*SyncShardStatusResponse)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusResponse")
-	proto.RegisterType((*SyncActivityRequest)(nil), "temporal.server.api.historyservice.v1.SyncActivityRequest")
-	proto.RegisterType((*SyncActivityResponse)(nil), "temporal.server.api.historyservice.v1.SyncActivityResponse")
-	proto.RegisterType((*DescribeMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.DescribeMutableStateRequest")
-	proto.RegisterType((*DescribeMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.DescribeMutableStateResponse")
-	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryHostRequest")
-	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryHostResponse")
-	proto.RegisterType((*CloseShardRequest)(nil), "temporal.server.api.historyservice.v1.CloseShardRequest")
-	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.historyservice.v1.CloseShardResponse")
-	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.historyservice.v1.RemoveTaskRequest")
-	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.historyservice.v1.RemoveTaskResponse")
-	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesRequest")
-	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse")
-	proto.RegisterMapType((map[int32]*v112.ReplicationMessages)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
-	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetDLQReplicationMessagesRequest")
-	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetDLQReplicationMessagesResponse")
-	proto.RegisterType((*QueryWorkflowRequest)(nil), "temporal.server.api.historyservice.v1.QueryWorkflowRequest")
-	proto.RegisterType((*QueryWorkflowResponse)(nil), "temporal.server.api.historyservice.v1.QueryWorkflowResponse")
-	proto.RegisterType((*ReapplyEventsRequest)(nil), "temporal.server.api.historyservice.v1.ReapplyEventsRequest")
-	proto.RegisterType((*ReapplyEventsResponse)(nil), "temporal.server.api.historyservice.v1.ReapplyEventsResponse")
-	proto.RegisterType((*GetDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetDLQMessagesRequest")
-	proto.RegisterType((*GetDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetDLQMessagesResponse")
-	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.PurgeDLQMessagesRequest")
-	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.PurgeDLQMessagesResponse")
-	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesRequest")
-	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesResponse")
-	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksRequest")
-	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksResponse")
+type RestoreWorkflowExecutionRequest struct {
+	NamespaceId string                                `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Request     *v113.RestoreWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("temporal/server/api/historyservice/v1/request_response.proto", fileDescriptor_b8c78c1d460a3711)
+func (m *RestoreWorkflowExecutionRequest) Reset()      { *m = RestoreWorkflowExecutionRequest{} }
+func (*RestoreWorkflowExecutionRequest) ProtoMessage() {}
+func (*RestoreWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{72}
+}
+func (m *RestoreWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *RestoreWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreWorkflowExecutionRequest.DiscardUnknown(m)
 }
 
-var fileDescriptor_b8c78c1d460a3711 = []byte{
-	// 3684 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x49, 0x70, 0x1b, 0xc7,
-	0xd5, 0xd6, 0x10, 0x5c, 0x80, 0x07, 0x10, 0x04, 0x86, 0x1b, 0x48, 0x4a, 0x10, 0x39, 0xda, 0xe8,
-	0x45, 0xa0, 0x16, 0xff, 0x96, 0xad, 0xff, 0xb7, 0xff, 0x88, 0xd4, 0x06, 0x95, 0x25, 0xd3, 0x43,
-	0x46, 0x72, 0x79, 0x1b, 0x0f, 0x31, 0x4d, 0x72, 0x42, 0x60, 0x06, 0x9a, 0x1e, 0x90, 0x82, 0x73,
-	0xc8, 0x56, 0x39, 0x38, 0xa9, 0x4a, 0xa9, 0x2a, 0x97, 0x54, 0xc5, 0xc9, 0x21, 0x97, 0xf8, 0x92,
-	0xf2, 0x21, 0x87, 0x94, 0x53, 0x95, 0x6b, 0x2a, 0xb7, 0xb8, 0x72, 0x89, 0x2b, 0x39, 0x24, 0x96,
-	0x2f, 0x49, 0x25, 0x07, 0x1f, 0x72, 0x4f, 0xaa, 0xb7, 0xc1, 0x6c, 0xd8, 0x48, 0x29, 0x76, 0x1c,
-	0xdf, 0x30, 0xdd, 0xef, 0xbd, 0xee, 0xf7, 0xfa, 0xbd, 0xaf, 0xbb, 0x5f, 0x3f, 0xc0, 0xff, 0xb9,
-	0xa8, 0x56, 0xb7, 0x1d, 0xbd, 0xba, 0x84, 0x91, 0xb3, 0x8b, 0x9c, 0x25, 0xbd, 0x6e, 0x2e, 0x6d,
-	0x9b, 0xd8, 0xb5, 0x9d, 0x26, 0x69, 0x31, 0x2b, 0x68, 0x69, 0xf7, 0xec, 0x92, 0x83, 0xee, 0x36,
-	0x10, 0x76, 0x35, 0x07, 0xe1, 0xba, 0x6d, 0x61, 0x54, 0xaa, 0x3b, 0xb6, 0x6b, 0xcb, 0x27, 0x04,
-	0x77, 0x89, 0x71, 0x97, 0xf4, 0xba, 0x59, 0x0a, 0x72, 0x97, 0x76, 0xcf, 0xce, 0x16, 0xb7, 0x6c,
-	0x7b, 0xab, 0x8a, 0x96, 0x28, 0xd3, 0x46, 0x63, 0x73, 0xc9, 0x68, 0x38, 0xba, 0x6b, 0xda, 0x16,
-	0x13, 0x33, 0x7b, 0x34, 0xdc, 0xef, 0x9a, 0x35, 0x84, 0x5d, 0xbd, 0x56, 0xe7, 0x04, 0x0b, 0x06,
-	0xaa, 0x23, 0xcb, 0x40, 0x56, 0xc5, 0x44, 0x78, 0x69, 0xcb, 0xde, 0xb2, 0x69, 0x3b, 0xfd, 0xc5,
-	0x49, 0x8e, 0x7b, 0x8a, 0x10, 0x0d, 0x2a, 0x76, 0xad, 0x66, 0x5b, 0x64, 0xe6, 0x35, 0x84, 0xb1,
-	0xbe, 0xc5, 0x27, 0x3c, 0x7b, 0x22, 0x40, 0xc5, 0x67, 0x1a, 0x25, 0x3b, 0x15, 0x20, 0x73, 0x75,
-	0xbc, 0x73, 0xb7, 0x81, 0x1a, 0x28, 0x4a, 0x18, 0x1c, 0x15, 0x59, 0x8d, 0x1a, 0x26, 0x44, 0x7b,
-	0xb6, 0xb3, 0xb3, 0x59, 0xb5, 0xf7, 0x38, 0xd5, 0xc9, 0x00, 0x95, 0xe8, 0x8c, 0x4a, 0x3b, 0x16,
-	0xa0, 0xbb, 0xdb, 0x40, 0x71, 0x73, 0x0b, 0xaa, 0xb0, 0xa9, 0x9b, 0xd5, 0x86, 0x13, 0x33, 0xb3,
-	0x27, 0x3b, 0x2c, 0x6c, 0x94, 0xfa, 0xb1, 0x38, 0x6a, 0x4f, 0x1d, 0x66, 0x4d, 0x4e, 0xfa, 0x44,
-	0x47, 0xd2, 0x90, 0xe6, 0xa7, 0x3a, 0x12, 0x13, 0xc3, 0x72, 0xc2, 0xd3, 0x71, 0x84, 0xed, 0x2d,
-	0x55, 0x8a, 0x23, 0xb7, 0xf4, 0x1a, 0xc2, 0x75, 0xbd, 0x12, 0x63, 0x8d, 0x33, 0x71, 0xf4, 0x0e,
-	0xaa, 0x57, 0xcd, 0x0a, 0x75, 0xc4, 0x28, 0xc7, 0xd3, 0xb1, 0x6b, 0xd6, 0x35, 0x24, 0x66, 0x2f,
-	0xc6, 0x8d, 0xa4, 0x1b, 0x35, 0xd3, 0xea, 0xca, 0xab, 0x7c, 0x77, 0x18, 0x8e, 0xac, 0xb9, 0xba,
-	0xe3, 0xde, 0xe1, 0xc3, 0x5d, 0xb9, 0x87, 0x2a, 0x0d, 0x32, 0x3f, 0x95, 0x31, 0xc8, 0x0b, 0x90,
-	0xf1, 0xb4, 0xd4, 0x4c, 0xa3, 0x20, 0xcd, 0x4b, 0x8b, 0x29, 0x35, 0xed, 0xb5, 0x95, 0x0d, 0xb9,
-	0x02, 0xa3, 0x98, 0xc8, 0xd0, 0xf8, 0x20, 0x85, 0x81, 0x79, 0x69, 0x31, 0x7d, 0xee, 0x79, 0xcf,
-	0x64, 0x34, 0x48, 0x43, 0x0a, 0x95, 0x76, 0xcf, 0x96, 0x3a, 0x8e, 0xac, 0x66, 0xa8, 0x50, 0x31,
-	0x8f, 0x6d, 0x98, 0xac, 0xeb, 0x0e, 0xb2, 0x5c, 0x0d, 0x09, 0x42, 0xcd, 0xb4, 0x36, 0xed, 0x42,
-	0x82, 0x0e, 0xf6, 0x54, 0x29, 0x0e, 0x18, 0x3c, 0xdf, 0xd8, 0x3d, 0x5b, 0x5a, 0xa5, 0xdc, 0xde,
-	0x28, 0x65, 0x6b, 0xd3, 0x56, 0xc7, 0xeb, 0xd1, 0x46, 0xb9, 0x00, 0x23, 0xba, 0x4b, 0xa4, 0xb9,
-	0x85, 0xc1, 0x79, 0x69, 0x71, 0x48, 0x15, 0x9f, 0x72, 0x0d, 0x14, 0x21, 0xd1, 0x37, 0x0b, 0x74,
-	0xaf, 0x6e, 0x32, 0x70, 0xd1, 0x08, 0x8a, 0x14, 0x86, 0xe8, 0x84, 0x66, 0x4b, 0x0c, 0x62, 0x4a,
-	0x02, 0x62, 0x4a, 0xeb, 0x02, 0x62, 0x96, 0x07, 0xef, 0xff, 0xe9, 0xa8, 0xa4, 0x1e, 0xdd, 0x0b,
-	0x6b, 0x7e, 0xc5, 0x93, 0x44, 0x68, 0xe5, 0x6d, 0x98, 0xa9, 0xd8, 0x96, 0x6b, 0x5a, 0x0d, 0xa4,
-	0xe9, 0x58, 0xb3, 0xd0, 0x9e, 0x66, 0x5a, 0xa6, 0x6b, 0xea, 0xae, 0xed, 0x14, 0x86, 0xe7, 0xa5,
-	0xc5, 0xec, 0xb9, 0xd3, 0x41, 0x1b, 0x53, 0x3f, 0x27, 0xca, 0xae, 0x70, 0xbe, 0x4b, 0xf8, 0x16,
-	0xda, 0x2b, 0x0b, 0x26, 0x75, 0xaa, 0x12, 0xdb, 0x2e, 0xdf, 0x84, 0xbc, 0xe8, 0x31, 0x34, 0x1e,
-	0xe0, 0x85, 0x11, 0xaa, 0xc7, 0x7c, 0x70, 0x04, 0xde, 0x49, 0xc6, 0xb8, 0xca, 0x7e, 0xaa, 0x39,
-	0x8f, 0x95, 0xb7, 0xc8, 0xb7, 0x61, 0xaa, 0xaa, 0x63, 0x57, 0xab, 0xd8, 0xb5, 0x7a, 0x15, 0x51,
-	0xcb, 0x38, 0x08, 0x37, 0xaa, 0x6e, 0x21, 0x19, 0x27, 0x93, 0x07, 0x3b, 0x5d, 0xa3, 0x66, 0xd5,
-	0xd6, 0x0d, 0xac, 0x4e, 0x10, 0xfe, 0x15, 0x8f, 0x5d, 0xa5, 0xdc, 0xf2, 0x1b, 0x30, 0xb7, 0x69,
-	0x3a, 0xd8, 0xd5, 0xbc, 0x55, 0x20, 0xf1, 0xac, 0x6d, 0xe8, 0x95, 0x1d, 0x7b, 0x73, 0xb3, 0x90,
-	0xa2, 0xc2, 0x67, 0x22, 0x86, 0xbf, 0xcc, 0xb1, 0x7f, 0x79, 0xf0, 0x07, 0xc4, 0xee, 0x05, 0x2a,
-	0x43, 0xb8, 0xdd, 0xba, 0x8e, 0x77, 0x96, 0x99, 0x00, 0xe5, 0x02, 0x14, 0xdb, 0xb9, 0x24, 0x8b,
-	0x1a, 0x79, 0x12, 0x86, 0x9d, 0x86, 0xd5, 0x8a, 0x83, 0x21, 0xa7, 0x61, 0x95, 0x0d, 0xe5, 0x6f,
-	0x12, 0x4c, 0x5d, 0x43, 0xee, 0xcd, 0x86, 0xab, 0x6f, 0x54, 0xd1, 0x9a, 0xab, 0xbb, 0xa8, 0x8f,
-	0xf8, 0xb9, 0x06, 0x29, 0xcf, 0x9b, 0x78, 0xec, 0x3c, 0xd6, 0xce, 0x42, 0xd1, 0xa9, 0xb5, 0x78,
-	0xe5, 0xf3, 0x30, 0x85, 0xee, 0xd5, 0x51, 0xc5, 0x45, 0x86, 0x66, 0xa1, 0x7b, 0xae, 0x86, 0x76,
-	0x49, 0xc0, 0x98, 0x06, 0x0d, 0x92, 0x84, 0x3a, 0x2e, 0x7a, 0x6f, 0xa1, 0x7b, 0xee, 0x15, 0xd2,
-	0x57, 0x36, 0xe4, 0x33, 0x30, 0x51, 0x69, 0x38, 0x34, 0xb2, 0x36, 0x1c, 0xdd, 0xaa, 0x6c, 0x6b,
-	0xae, 0xbd, 0x83, 0x2c, 0xea, 0xfb, 0x19, 0x55, 0xe6, 0x7d, 0xcb, 0xb4, 0x6b, 0x9d, 0xf4, 0x28,
-	0x3f, 0x4e, 0xc2, 0x74, 0x44, 0x5b, 0x6e, 0xa0, 0x80, 0x2e, 0xd2, 0x01, 0x74, 0x29, 0xc3, 0x68,
-	0x6b, 0x95, 0x9b, 0x75, 0xc4, 0x0d, 0x73, 0xbc, 0x9b, 0xb0, 0xf5, 0x66, 0x1d, 0xa9, 0x99, 0x3d,
-	0xdf, 0x97, 0xac, 0xc0, 0x68, 0x9c, 0x35, 0xd2, 0x96, 0xcf, 0x0a, 0xcf, 0xc2, 0x4c, 0xdd, 0x41,
-	0xbb, 0xa6, 0xdd, 0xc0, 0x1a, 0xc5, 0x1d, 0x64, 0xb4, 0xe8, 0x07, 0x29, 0xfd, 0x94, 0x20, 0x58,
-	0x63, 0xfd, 0x82, 0xf5, 0x34, 0x8c, 0x53, 0x6f, 0x67, 0xae, 0xe9, 0x31, 0x0d, 0x51, 0xa6, 0x1c,
-	0xe9, 0xba, 0x4a, 0x7a, 0x04, 0xf9, 0x0a, 0x00, 0xf5, 0x5a, 0xba, 0xbf, 0xd3, 0x30, 0x8e, 0x68,
-	0xe5, 0x6d, 0xff, 0x44, 0x31, 0xe2, 0xa0, 0x2f, 0x91, 0x0f, 0x35, 0xe5, 0x8a, 0x9f, 0xf2, 0x2a,
-	0xe4, 0xb1, 0x6b, 0x56, 0x76, 0x9a, 0x9a, 0x4f, 0xd6, 0x48, 0x1f, 0xb2, 0xc6, 0x18, 0xbb, 0xd7,
-	0x20, 0x7f, 0x15, 0x9e, 0x88, 0x48, 0xd4, 0x70, 0x65, 0x1b, 0x19, 0x8d, 0x2a, 0xd2, 0x5c, 0x9b,
-	0x59, 0x85, 0x22, 0x9c, 0xdd, 0x70, 0x0b, 0xe9, 0xde, 0x62, 0xed, 0x44, 0x68, 0x98, 0x35, 0x2e,
-	0x70, 0xdd, 0xa6, 0x46, 0x5c, 0x67, 0xd2, 0xe4, 0x12, 0x8c, 0x33, 0xbb, 0x91, 0xc3, 0x02, 0xd2,
-	0x76, 0x91, 0x83, 0x89, 0xff, 0x64, 0x28, 0xfc, 0xe6, 0x69, 0xd7, 0x1a, 0xe9, 0xb9, 0xcd, 0x3a,
-	0xda, 0xfa, 0xec, 0x68, 0x3b, 0x9f, 0x95, 0x5f, 0x85, 0xac, 0xe7, 0x4e, 0x98, 0x78, 0x6c, 0x61,
-	0x8c, 0x02, 0x68, 0xfc, 0xbe, 0xe1, 0xe1, 0x68, 0xc4, 0x45, 0x99, 0xb7, 0x7b, 0xae, 0x49, 0x3f,
-	0xe5, 0x3b, 0x30, 0x16, 0x10, 0xde, 0xc0, 0x85, 0x1c, 0x95, 0x5e, 0x6a, 0x03, 0xcf, 0xb1, 0x62,
-	0x1b, 0x58, 0xcd, 0xfa, 0xe5, 0x36, 0xb0, 0xfc, 0x3a, 0xe4, 0xb9, 0x2d, 0x34, 0x76, 0x90, 0x32,
-	0x11, 0x2e, 0xe4, 0xa9, 0xe9, 0xcf, 0x94, 0x3a, 0x9c, 0x84, 0xc9, 0x18, 0xdc, 0x56, 0xd7, 0x05,
-	0x9f, 0x9a, 0xdb, 0x0d, 0xb5, 0xc8, 0xcf, 0xc3, 0x61, 0x93, 0xb8, 0x7b, 0x78, 0xd9, 0x91, 0x45,
-	0x02, 0xdb, 0x28, 0xc8, 0xf3, 0xd2, 0x62, 0x52, 0x2d, 0x98, 0x78, 0x2d, 0xb8, 0x8a, 0x57, 0x58,
-	0xff, 0x8d, 0xc1, 0x64, 0x32, 0x97, 0xba, 0x31, 0x98, 0x4c, 0xe5, 0xe0, 0xc6, 0x60, 0x12, 0x72,
-	0xe9, 0x1b, 0x83, 0xc9, 0x6c, 0x6e, 0x4c, 0xf9, 0xbb, 0x04, 0xd3, 0xab, 0x76, 0xb5, 0xfa, 0x5f,
-	0x82, 0x87, 0xef, 0x8d, 0x40, 0x21, 0xaa, 0xee, 0x17, 0x80, 0xf8, 0x05, 0x20, 0xee, 0x1b, 0x10,
-	0xdb, 0x39, 0x61, 0xa6, 0x2d, 0xc0, 0xc5, 0x42, 0x45, 0xf6, 0xa1, 0x41, 0xc5, 0x7f, 0x24, 0x7e,
-	0xc6, 0x02, 0xd4, 0x68, 0x2e, 0xab, 0xbc, 0x2d, 0xc1, 0x9c, 0x8a, 0x30, 0x72, 0x43, 0xc0, 0xf6,
-	0x29, 0x80, 0x94, 0x52, 0x84, 0xc3, 0xf1, 0x53, 0x61, 0x00, 0xa2, 0xfc, 0x61, 0x00, 0xe6, 0x55,
-	0x54, 0xb1, 0x1d, 0xc3, 0x7f, 0x64, 0xe5, 0x21, 0xd7, 0xc7, 0x84, 0x5f, 0x06, 0x39, 0x7a, 0x79,
-	0xe9, 0x7f, 0xe6, 0xf9, 0xc8, 0xad, 0x45, 0x3e, 0x0a, 0x69, 0x2f, 0x2e, 0x3c, 0x30, 0x01, 0xd1,
-	0x54, 0x36, 0xe4, 0x69, 0x18, 0xa1, 0x31, 0xe4, 0x21, 0xc7, 0x30, 0xf9, 0x2c, 0x1b, 0xf2, 0x11,
-	0x00, 0x71, 0x31, 0xe5, 0x00, 0x91, 0x52, 0x53, 0xbc, 0xa5, 0x6c, 0xc8, 0x6f, 0x42, 0xa6, 0x6e,
-	0x57, 0xab, 0xde, 0xbd, 0x92, 0x61, 0xc3, 0x73, 0x5d, 0xef, 0x95, 0x04, 0x8c, 0xfd, 0xc6, 0xf2,
-	0xaf, 0xad, 0x9a, 0x26, 0x22, 0xf9, 0x87, 0xf2, 0xcf, 0x11, 0x58, 0xe8, 0x60, 0x5c, 0x8e, 0xe1,
-	0x11, 0xe8, 0x95, 0xf6, 0x0d, 0xbd, 0x1d, 0x61, 0x75, 0xa0, 0x23, 0xac, 0x3e, 0x09, 0xb2, 0xb0,
-	0xa9, 0x11, 0x86, 0xee, 0x9c, 0xd7, 0x23, 0xa8, 0x17, 0x21, 0xd7, 0x06, 0xb6, 0xb3, 0x38, 0x28,
-	0x37, 0xb2, 0x1b, 0x0c, 0x45, 0x77, 0x03, 0xdf, 0x9d, 0x78, 0x38, 0x78, 0x27, 0x7e, 0x06, 0x0a,
-	0x1c, 0x26, 0x7d, 0x37, 0x62, 0x7e, 0x7e, 0x18, 0xa1, 0xe7, 0x87, 0x29, 0xd6, 0xdf, 0xba, 0xe5,
-	0xb2, 0x5e, 0x79, 0xcb, 0xe7, 0x90, 0xcc, 0x3d, 0xc8, 0x75, 0x9e, 0xdd, 0x10, 0x9f, 0xed, 0x06,
-	0x59, 0xeb, 0x8e, 0x6e, 0x61, 0x13, 0x59, 0x81, 0x7b, 0x1c, 0xbd, 0xd3, 0xe7, 0xf6, 0x42, 0x2d,
-	0xf2, 0x16, 0x1c, 0x89, 0xb9, 0xb6, 0xfb, 0xf6, 0x89, 0x54, 0x1f, 0xfb, 0xc4, 0x6c, 0xc4, 0xff,
-	0x5b, 0x5b, 0x46, 0x9b, 0x63, 0x2c, 0xb4, 0x3b, 0xc6, 0x2e, 0x40, 0x26, 0x80, 0xee, 0x69, 0x8a,
-	0xee, 0xe9, 0x0d, 0x1f, 0xac, 0x5f, 0x83, 0x6c, 0x6b, 0xd1, 0x69, 0x7a, 0x21, 0xd3, 0x63, 0x7a,
-	0x61, 0xd4, 0xe3, 0xa3, 0xc9, 0x84, 0x15, 0xc8, 0x08, 0x7f, 0xa0, 0x62, 0x46, 0x7b, 0x14, 0x93,
-	0xe6, 0x5c, 0x54, 0x88, 0x0d, 0x23, 0x77, 0x1b, 0x88, 0x6f, 0x2d, 0x89, 0xc5, 0xf4, 0xb9, 0x2f,
-	0x97, 0x7a, 0xca, 0xc7, 0x96, 0xba, 0xc6, 0x58, 0xe9, 0x25, 0x26, 0xf7, 0x8a, 0xe5, 0x3a, 0x4d,
-	0x55, 0x8c, 0x32, 0xfb, 0x26, 0x64, 0xfc, 0x1d, 0x72, 0x0e, 0x12, 0x3b, 0xa8, 0xc9, 0xe1, 0x8d,
-	0xfc, 0x94, 0x2f, 0xc2, 0xd0, 0xae, 0x5e, 0x6d, 0xb4, 0x39, 0x0e, 0xd1, 0x8c, 0xa6, 0x3f, 0x24,
-	0x89, 0xb4, 0xa6, 0xca, 0x58, 0x2e, 0x0e, 0x3c, 0x23, 0xf9, 0xe0, 0xf5, 0x52, 0xc5, 0x35, 0x77,
-	0x4d, 0xb7, 0xf9, 0x05, 0xbc, 0xf6, 0x00, 0xaf, 0x7e, 0x63, 0xb5, 0x87, 0xd7, 0x6f, 0x0e, 0x0a,
-	0x78, 0x8d, 0x35, 0x2e, 0x87, 0xd7, 0x5b, 0x30, 0x16, 0x02, 0x36, 0x0e, 0xb0, 0x27, 0x82, 0x53,
-	0xf1, 0x85, 0x3f, 0x3b, 0x98, 0x34, 0x29, 0x3c, 0xa9, 0xd9, 0x20, 0xf8, 0x45, 0x5c, 0x7d, 0x60,
-	0x3f, 0xae, 0xee, 0x43, 0xbc, 0x44, 0x10, 0xf1, 0x10, 0x14, 0xc5, 0xd9, 0x8c, 0x37, 0x69, 0xa1,
-	0x10, 0x1d, 0xec, 0x71, 0xc0, 0x39, 0x2e, 0xe7, 0x12, 0x13, 0xb3, 0x16, 0x08, 0xd8, 0x9b, 0x90,
-	0xdf, 0x46, 0xba, 0xe3, 0x6e, 0x20, 0xdd, 0xd5, 0x0c, 0xe4, 0xea, 0x66, 0x15, 0xf3, 0xdc, 0x62,
-	0xf7, 0xfc, 0x59, 0xce, 0x63, 0xbd, 0xcc, 0x38, 0xa3, 0x7b, 0xd8, 0xf0, 0xbe, 0xf7, 0xb0, 0xd3,
-	0x3e, 0x57, 0xf7, 0x42, 0x80, 0x82, 0x7d, 0xaa, 0xe5, 0xbf, 0xb7, 0x44, 0x87, 0xf2, 0xbe, 0x04,
-	0xc7, 0xd8, 0x5a, 0x07, 0x00, 0x80, 0x67, 0xf7, 0xfa, 0x0a, 0x32, 0x1b, 0x72, 0x3c, 0xa7, 0x88,
-	0x42, 0xc9, 0xe6, 0xcb, 0x5d, 0xbd, 0xb6, 0x87, 0x29, 0xa8, 0x63, 0x42, 0xba, 0x70, 0xe0, 0x1f,
-	0x4a, 0x70, 0xbc, 0x33, 0x23, 0xf7, 0x61, 0xdc, 0xda, 0x6e, 0x45, 0x8a, 0x9d, 0x3b, 0xf1, 0xf5,
-	0x87, 0x05, 0x91, 0xe4, 0x8a, 0x12, 0x68, 0x50, 0xde, 0x93, 0x08, 0x76, 0x45, 0x66, 0x77, 0x55,
-	0x37, 0xab, 0x7d, 0x99, 0x75, 0x1b, 0xb2, 0x9b, 0x94, 0x27, 0x64, 0xd4, 0x4b, 0xfb, 0x31, 0x6a,
-	0x60, 0x74, 0x75, 0x74, 0xd3, 0xff, 0xa9, 0x1c, 0x23, 0x78, 0xd0, 0x96, 0x85, 0xab, 0xf5, 0xbe,
-	0x04, 0x4a, 0x14, 0x35, 0xae, 0x0b, 0x8f, 0xee, 0x43, 0xb1, 0xba, 0x3f, 0x86, 0x82, 0xba, 0xad,
-	0xf4, 0xa0, 0x5b, 0xb7, 0x29, 0xf8, 0xc2, 0x4c, 0x28, 0xb8, 0x4a, 0x7c, 0xbd, 0x03, 0x1f, 0x77,
-	0x97, 0xc7, 0x20, 0x57, 0xd1, 0xad, 0x0a, 0xf2, 0xc0, 0x17, 0xb1, 0xf9, 0x27, 0xd5, 0x31, 0xd6,
-	0xae, 0x8a, 0x66, 0x7f, 0xf8, 0xf8, 0x65, 0x7e, 0x4a, 0xe1, 0xd3, 0x69, 0x0a, 0xd1, 0xf0, 0x39,
-	0xe9, 0x45, 0x4f, 0x1b, 0xbe, 0xa8, 0x23, 0xfb, 0x09, 0xff, 0xfd, 0x8e, 0xdc, 0x76, 0xf4, 0xf6,
-	0x8e, 0x1c, 0xc7, 0xc2, 0xd5, 0xfa, 0x39, 0x75, 0xe4, 0xa8, 0xfe, 0x74, 0x85, 0xfb, 0x52, 0xec,
-	0x2b, 0x90, 0x0d, 0xfa, 0x4b, 0x1f, 0x5e, 0xdc, 0x6d, 0x7c, 0x75, 0x34, 0xe0, 0x72, 0xca, 0x89,
-	0x78, 0x7f, 0xf3, 0x98, 0xb8, 0x72, 0xbf, 0x1e, 0x80, 0xe2, 0x9a, 0xb9, 0x65, 0xe9, 0xd5, 0x83,
-	0xbc, 0x1d, 0x6e, 0x42, 0x16, 0x53, 0x21, 0x21, 0xc5, 0xfe, 0xbf, 0xfb, 0xe3, 0x61, 0xc7, 0xb1,
-	0xd5, 0x51, 0x26, 0x56, 0x4c, 0xc5, 0x84, 0x39, 0x74, 0xcf, 0x45, 0x0e, 0x19, 0x29, 0xe6, 0x9c,
-	0x96, 0xe8, 0xf7, 0x9c, 0x36, 0x23, 0xa4, 0x45, 0xba, 0xc8, 0x2d, 0xa0, 0xb2, 0x6d, 0x56, 0x8d,
-	0xd6, 0x38, 0xb6, 0x55, 0x6d, 0xd2, 0x43, 0x41, 0x52, 0xcd, 0xd3, 0x2e, 0xc1, 0xf4, 0xa2, 0x55,
-	0x6d, 0x2a, 0x0b, 0x70, 0xb4, 0xad, 0x2e, 0xdc, 0xd6, 0xbf, 0x93, 0xe0, 0x14, 0xa7, 0x31, 0xdd,
-	0xed, 0x03, 0x3f, 0xd8, 0x7e, 0x4b, 0x82, 0x19, 0x6e, 0xf5, 0x3d, 0xd3, 0xdd, 0xd6, 0xe2, 0x5e,
-	0x6f, 0xaf, 0xf7, 0xba, 0x00, 0xdd, 0x26, 0xa4, 0x4e, 0xe1, 0x20, 0xa1, 0xf0, 0xb3, 0x4b, 0xb0,
-	0xd8, 0x5d, 0x44, 0xe7, 0x77, 0xb7, 0x5f, 0x49, 0x70, 0x54, 0x45, 0x35, 0x7b, 0x17, 0x31, 0x49,
-	0xfb, 0x4c, 0x38, 0x3f, 0xba, 0xb3, 0x7b, 0xf0, 0x04, 0x9e, 0x08, 0x9d, 0xc0, 0x15, 0x85, 0xc0,
-	0x5e, 0xbb, 0xe9, 0xf3, 0xb5, 0xff, 0x85, 0x04, 0x0b, 0xeb, 0xc8, 0xa9, 0x99, 0x96, 0xee, 0xa2,
-	0x83, 0xac, 0xba, 0x0d, 0x79, 0x57, 0xc8, 0x09, 0x2d, 0xf6, 0x72, 0xd7, 0xc5, 0xee, 0x3a, 0x03,
-	0x35, 0xe7, 0x09, 0x17, 0x0b, 0x7c, 0x1c, 0x94, 0x4e, 0x6c, 0x5c, 0xbf, 0x9f, 0x4a, 0x70, 0x84,
-	0x26, 0xc0, 0x0e, 0x58, 0x82, 0xe0, 0x10, 0x19, 0x7d, 0x97, 0x20, 0x74, 0x1c, 0x59, 0xcd, 0x50,
-	0xa1, 0x42, 0x9f, 0x0b, 0x50, 0x6c, 0x47, 0xde, 0xd9, 0x4d, 0xbf, 0x9f, 0x80, 0x13, 0x5c, 0x08,
-	0x83, 0xd1, 0x83, 0xa8, 0x5a, 0x6b, 0xb3, 0x15, 0x5c, 0xed, 0x41, 0xd7, 0x1e, 0xa6, 0x10, 0xda,
-	0x0d, 0xe4, 0xe7, 0x7c, 0xc0, 0xc9, 0xab, 0x0f, 0xa2, 0xe9, 0xa7, 0x82, 0x20, 0x29, 0x0b, 0x0a,
-	0x91, 0x38, 0xea, 0x82, 0xbb, 0x83, 0x8f, 0x1e, 0x77, 0x87, 0xda, 0xe1, 0xee, 0x22, 0x9c, 0xec,
-	0x66, 0x11, 0xee, 0xa2, 0xbf, 0x95, 0x60, 0x4e, 0x5c, 0xce, 0xfc, 0xe7, 0xd6, 0xcf, 0x04, 0xc4,
-	0x9c, 0x87, 0x29, 0x13, 0x6b, 0x31, 0x75, 0x11, 0x74, 0x6d, 0x92, 0xea, 0xb8, 0x89, 0xaf, 0x86,
-	0x0b, 0x1e, 0x94, 0x22, 0x1c, 0x8e, 0x57, 0x88, 0x6b, 0xfc, 0x8f, 0x01, 0x72, 0x72, 0x23, 0xe7,
-	0xd8, 0x15, 0x62, 0x37, 0x6f, 0xb4, 0xfd, 0x9c, 0x3a, 0x1f, 0x9d, 0xea, 0x0b, 0x90, 0x69, 0xb9,
-	0x64, 0xeb, 0x19, 0xcb, 0x6b, 0x2b, 0x1b, 0xf2, 0x2b, 0x30, 0x2e, 0x0e, 0xa5, 0xc6, 0x41, 0xfc,
-	0x4e, 0xf6, 0xa4, 0xb4, 0x86, 0x5f, 0xf5, 0x8e, 0xd3, 0x34, 0xe9, 0x49, 0x13, 0x17, 0x43, 0xfd,
-	0x24, 0x2e, 0xc6, 0x5a, 0xec, 0xb4, 0x41, 0x39, 0x45, 0x70, 0xa2, 0xa3, 0xd5, 0xf9, 0xfa, 0xfc,
-	0x44, 0x82, 0xf9, 0xcb, 0x08, 0x57, 0x1c, 0x73, 0xe3, 0x40, 0x7b, 0xc2, 0xab, 0x30, 0xd2, 0xef,
-	0x49, 0xb9, 0xdb, 0xb0, 0xaa, 0x90, 0xa8, 0xbc, 0x9b, 0x80, 0x85, 0x0e, 0xd4, 0x1c, 0x33, 0x5f,
-	0x83, 0x5c, 0x2b, 0x29, 0x5b, 0xb1, 0xad, 0x4d, 0x73, 0x8b, 0xdf, 0x9c, 0xcf, 0xc6, 0xcf, 0x25,
-	0x76, 0x81, 0x56, 0x28, 0xa3, 0x3a, 0x86, 0x82, 0x0d, 0xf2, 0x16, 0x4c, 0xc7, 0xe4, 0x7e, 0x69,
-	0xa6, 0x99, 0x29, 0xbc, 0xd4, 0xc7, 0x20, 0x34, 0xbf, 0x3c, 0xb9, 0x17, 0xd7, 0x2c, 0xbf, 0x06,
-	0x72, 0x1d, 0x59, 0x86, 0x69, 0x6d, 0x69, 0x3a, 0x3b, 0x36, 0x9b, 0x08, 0x17, 0x12, 0x34, 0x4b,
-	0x7a, 0xba, 0xfd, 0x18, 0xab, 0x8c, 0x47, 0x9c, 0xb4, 0xe9, 0x08, 0xf9, 0x7a, 0xa0, 0xd1, 0x44,
-	0x58, 0x7e, 0x03, 0x72, 0x42, 0x3a, 0x05, 0x32, 0x87, 0x3e, 0x48, 0x13, 0xd9, 0xe7, 0xbb, 0xca,
-	0x0e, 0xfa, 0x12, 0x1d, 0x61, 0xac, 0xee, 0xeb, 0x72, 0x90, 0xa5, 0x7c, 0x23, 0x01, 0x05, 0x95,
-	0x17, 0x27, 0x22, 0xea, 0x8b, 0xf8, 0xf6, 0xb9, 0xcf, 0x44, 0x8c, 0x6f, 0xc2, 0x64, 0xf0, 0x5d,
-	0xb3, 0xa9, 0x99, 0x2e, 0xaa, 0x09, 0xd3, 0x9e, 0xeb, 0xeb, 0x6d, 0xb3, 0x59, 0x76, 0x51, 0x4d,
-	0x1d, 0xdf, 0x8d, 0xb4, 0x61, 0xf9, 0x19, 0x18, 0xa6, 0x11, 0x8c, 0x39, 0x36, 0xb4, 0xcd, 0xb1,
-	0x5d, 0xd6, 0x5d, 0x7d, 0xb9, 0x6a, 0x6f, 0xa8, 0x9c, 0x5e, 0xbe, 0x0a, 0x59, 0x0b, 0xed, 0x69,
-	0x64, 0xe3, 0xe7, 0x12, 0x86, 0x7a, 0x94, 0x90, 0xb1, 0xd0, 0x9e, 0xda, 0x60, 0xb1, 0x8f, 0x95,
-	0x39, 0x98, 0x89, 0x59, 0x02, 0x1e, 0xf0, 0x3f, 0x92, 0x60, 0x6a, 0xad, 0x69, 0x55, 0xd6, 0xb6,
-	0x75, 0xc7, 0xe0, 0xaf, 0x9d, 0x7c, 0x79, 0x4e, 0x40, 0x16, 0xdb, 0x0d, 0xa7, 0x82, 0xb4, 0x4a,
-	0xb5, 0x81, 0x5d, 0xe4, 0xf0, 0x05, 0x1a, 0x65, 0xad, 0x2b, 0xac, 0x51, 0x9e, 0x81, 0x24, 0x26,
-	0xcc, 0xad, 0x87, 0xa6, 0x11, 0xfa, 0x5d, 0x36, 0xe4, 0x4b, 0x90, 0x66, 0xcf, 0xae, 0x2c, 0x7d,
-	0x99, 0xe8, 0x31, 0x7d, 0x09, 0x8c, 0x89, 0x34, 0x2b, 0x33, 0x30, 0x1d, 0x99, 0x9e, 0xb8, 0xbc,
-	0x0c, 0xc1, 0x38, 0xe9, 0x13, 0x3e, 0xde, 0x87, 0x5b, 0x1d, 0x85, 0xb4, 0xe7, 0x56, 0x7c, 0xda,
-	0x29, 0x15, 0x44, 0x53, 0xd9, 0xf0, 0x1d, 0xb8, 0x12, 0xbe, 0x03, 0x97, 0x5c, 0x80, 0x11, 0xf1,
-	0xf8, 0xc2, 0x32, 0xe2, 0xe2, 0x93, 0x0c, 0xda, 0x4a, 0xd6, 0xb6, 0xde, 0xba, 0xbc, 0x36, 0xfa,
-	0xb2, 0x1b, 0x7e, 0x72, 0x19, 0xde, 0xdf, 0x93, 0xcb, 0x11, 0x00, 0x91, 0x13, 0x34, 0xd9, 0x63,
-	0x58, 0x42, 0x4d, 0xf1, 0x16, 0x5a, 0xf7, 0x10, 0x4c, 0x53, 0x27, 0xf7, 0x93, 0xa6, 0x5e, 0xe5,
-	0xb5, 0x16, 0xad, 0x34, 0x17, 0x95, 0x95, 0xea, 0x51, 0x56, 0x9e, 0x30, 0x7b, 0xe9, 0x29, 0x2a,
-	0xf1, 0x22, 0x8c, 0x88, 0x6c, 0x33, 0xf4, 0x98, 0x6d, 0x16, 0x0c, 0xfe, 0xa4, 0x79, 0x3a, 0x98,
-	0x34, 0x5f, 0x81, 0x0c, 0xab, 0x09, 0xe1, 0xc5, 0xa5, 0x99, 0x1e, 0x8b, 0x4b, 0xd3, 0xb4, 0x5c,
-	0x84, 0xd7, 0x95, 0x9e, 0x01, 0x5a, 0x17, 0x4a, 0x8f, 0x39, 0xc8, 0xd1, 0x4c, 0x03, 0x59, 0xae,
-	0xe9, 0x36, 0xe9, 0x5b, 0x56, 0x4a, 0x95, 0x49, 0xdf, 0x1d, 0xda, 0x55, 0xe6, 0x3d, 0xf2, 0x1d,
-	0x18, 0x0b, 0xa1, 0x07, 0xaf, 0x89, 0x28, 0xf5, 0x87, 0x1b, 0x6a, 0x36, 0x88, 0x19, 0xca, 0x14,
-	0x4c, 0x04, 0x7d, 0x9a, 0x3b, 0xfb, 0xdb, 0x12, 0xcc, 0x89, 0x3d, 0xef, 0x53, 0x2e, 0x7f, 0x52,
-	0x7e, 0x29, 0xc1, 0xe1, 0xf8, 0xb9, 0xf0, 0xad, 0x97, 0x9c, 0x98, 0xf5, 0xca, 0x36, 0xd2, 0x6a,
-	0xac, 0x97, 0x57, 0x76, 0xb0, 0x39, 0xe5, 0x69, 0x97, 0x9f, 0x4f, 0x7e, 0x0a, 0xa6, 0x0c, 0xdd,
-	0xd5, 0x37, 0x74, 0x1c, 0x66, 0x61, 0x91, 0x39, 0x21, 0x7a, 0x03, 0x5c, 0xd3, 0x30, 0xe2, 0x3a,
-	0x08, 0xb5, 0x82, 0x74, 0x98, 0x7c, 0x96, 0x0d, 0x79, 0x0e, 0x52, 0xfc, 0xf9, 0x93, 0xbf, 0x5c,
-	0xa5, 0xd4, 0x24, 0x6b, 0x28, 0x1b, 0xca, 0xef, 0x25, 0x98, 0x15, 0x93, 0xe7, 0x46, 0xbf, 0x6e,
-	0x63, 0x7f, 0xf2, 0x77, 0xdb, 0xc6, 0xae, 0xa6, 0x1b, 0x86, 0x83, 0x30, 0x16, 0x76, 0x24, 0x6d,
-	0x97, 0x58, 0x53, 0x04, 0xf0, 0x86, 0x5a, 0x80, 0x17, 0x5e, 0x85, 0x44, 0xaf, 0x3b, 0xda, 0xe0,
-	0xc1, 0x77, 0x34, 0xe5, 0xfe, 0x40, 0xcb, 0x45, 0x02, 0x9a, 0xf1, 0x55, 0x39, 0x06, 0xa3, 0x74,
-	0x9e, 0x58, 0xb3, 0x1a, 0xb5, 0x0d, 0x0e, 0xe7, 0x43, 0x6a, 0x86, 0x35, 0xde, 0xa2, 0x6d, 0xc4,
-	0x76, 0x42, 0x39, 0x5c, 0x18, 0x98, 0x4f, 0x2c, 0x0e, 0xa9, 0x49, 0xae, 0x1d, 0x96, 0x5f, 0x87,
-	0xb1, 0x96, 0x7a, 0x74, 0x19, 0x3b, 0x56, 0xc9, 0x7b, 0xb4, 0x44, 0x05, 0xef, 0xdd, 0x66, 0x85,
-	0xf0, 0xd1, 0xd3, 0x42, 0xd6, 0x0a, 0xb4, 0xc9, 0x4f, 0xc3, 0x34, 0x1b, 0xbb, 0x62, 0x5b, 0xae,
-	0x63, 0x57, 0xab, 0xc8, 0x11, 0x65, 0x3b, 0x6c, 0x15, 0x27, 0x69, 0xf7, 0x8a, 0xd7, 0xcb, 0xab,
-	0x19, 0x09, 0x3a, 0xf0, 0xe5, 0x62, 0x6f, 0x91, 0xe2, 0x53, 0x29, 0x41, 0x7e, 0xa5, 0x6a, 0x63,
-	0x44, 0xb7, 0x0f, 0xb1, 0xc4, 0xfe, 0xf5, 0x93, 0x02, 0xeb, 0xa7, 0x4c, 0x80, 0xec, 0xa7, 0x17,
-	0x95, 0x32, 0x12, 0xe4, 0x59, 0x3a, 0xc5, 0x7f, 0x39, 0x6b, 0x2f, 0x46, 0xbe, 0x0a, 0x49, 0xb2,
-	0xd9, 0x6e, 0x11, 0x58, 0x18, 0xa0, 0x05, 0x47, 0x8f, 0x77, 0x2e, 0x67, 0x62, 0x89, 0x50, 0xc6,
-	0xa1, 0x7a, 0xbc, 0xfe, 0x07, 0xd8, 0x44, 0xe0, 0x01, 0xb6, 0x0c, 0x63, 0xbb, 0x26, 0x36, 0x37,
-	0xcc, 0xaa, 0xe9, 0x36, 0xfb, 0x7b, 0x1b, 0xcc, 0xb6, 0x18, 0xe9, 0x06, 0x3b, 0x01, 0xb2, 0x5f,
-	0x37, 0xae, 0xf2, 0x7d, 0x09, 0x8e, 0x5c, 0x43, 0xae, 0xda, 0xfa, 0x5f, 0xc9, 0x4d, 0xf6, 0x9f,
-	0x12, 0xef, 0x74, 0xf0, 0x02, 0x0c, 0xd3, 0xe2, 0x02, 0x12, 0x22, 0x89, 0xb6, 0x2e, 0xe0, 0xfb,
-	0x63, 0x0a, 0xcb, 0x14, 0x78, 0x9f, 0xb4, 0x0c, 0x41, 0xe5, 0x32, 0x48, 0xe0, 0xf0, 0x43, 0x06,
-	0x7d, 0xf9, 0xe3, 0x71, 0x9f, 0xe6, 0x6d, 0xc4, 0x77, 0x94, 0x77, 0x06, 0xa0, 0xd8, 0x6e, 0x4a,
-	0xdc, 0xc3, 0xbf, 0x06, 0x59, 0xb6, 0x24, 0xfc, 0x0f, 0x30, 0x62, 0x6e, 0x2f, 0xf7, 0xf8, 0x54,
-	0xd6, 0x59, 0x7c, 0x89, 0x7a, 0x85, 0x68, 0x65, 0x05, 0x05, 0x2c, 0xa2, 0x44, 0xdb, 0x6c, 0x13,
-	0xe4, 0x28, 0x91, 0xbf, 0xb8, 0x60, 0x88, 0x15, 0x17, 0xdc, 0x0c, 0x16, 0x17, 0x5c, 0xe8, 0xd3,
-	0x76, 0xde, 0xcc, 0x7c, 0xf5, 0x06, 0x6f, 0xc1, 0xfc, 0x35, 0xe4, 0x5e, 0x7e, 0xe1, 0xa5, 0x0e,
-	0x6b, 0x76, 0x9b, 0x57, 0x44, 0x92, 0x6b, 0x8a, 0xb0, 0x4d, 0xbf, 0x63, 0x7b, 0xf5, 0x30, 0xb4,
-	0x48, 0x92, 0xfc, 0xc2, 0xca, 0xb7, 0x25, 0x58, 0xe8, 0x30, 0x38, 0x5f, 0x9d, 0x37, 0x21, 0xef,
-	0x13, 0x4b, 0x53, 0x09, 0x62, 0x12, 0xe7, 0xf7, 0x31, 0x09, 0x35, 0xe7, 0x04, 0x1b, 0xb0, 0xf2,
-	0x1d, 0x09, 0x26, 0x68, 0x21, 0x86, 0xc0, 0xcb, 0x3e, 0x76, 0xc7, 0x17, 0xc3, 0x37, 0xd6, 0xff,
-	0xe9, 0x7a, 0x63, 0x8d, 0x1b, 0xaa, 0x75, 0x4b, 0xdd, 0x81, 0xc9, 0x10, 0x01, 0xb7, 0x83, 0x0a,
-	0xc9, 0xd0, 0x53, 0xee, 0xd3, 0xfd, 0x0e, 0xc5, 0x1f, 0x6e, 0x3d, 0x39, 0xca, 0xf7, 0x24, 0x98,
-	0x50, 0x91, 0x5e, 0xaf, 0x57, 0x59, 0x0a, 0x00, 0xf7, 0xa1, 0xf9, 0x5a, 0x58, 0xf3, 0xf8, 0x22,
-	0x29, 0xff, 0x3f, 0xbf, 0xd8, 0x72, 0x44, 0x87, 0x6b, 0x69, 0x3f, 0x0d, 0x93, 0x21, 0x02, 0x3e,
-	0xd3, 0x9f, 0x0d, 0xc0, 0x24, 0xf3, 0x95, 0xb0, 0x77, 0x5e, 0x81, 0x41, 0xaf, 0x08, 0x2e, 0xeb,
-	0xbf, 0xa4, 0xc7, 0x21, 0xe6, 0x65, 0xa4, 0x1b, 0x2f, 0x20, 0xd7, 0x45, 0x0e, 0xad, 0x12, 0xa1,
-	0xd5, 0x04, 0x94, 0xbd, 0xd3, 0xf6, 0x1c, 0xbd, 0xd1, 0x24, 0xe2, 0x6e, 0x34, 0x17, 0xa0, 0x60,
-	0x5a, 0x84, 0xc2, 0xdc, 0x45, 0x1a, 0xb2, 0x3c, 0x38, 0x69, 0x15, 0xc2, 0x4c, 0x7a, 0xfd, 0x57,
-	0x2c, 0x11, 0xec, 0x65, 0x43, 0x7e, 0x1c, 0xf2, 0x35, 0xfd, 0x9e, 0x59, 0x6b, 0xd4, 0xb4, 0x3a,
-	0xa1, 0xc7, 0xe6, 0x5b, 0xec, 0x6f, 0x5b, 0x43, 0xea, 0x18, 0xef, 0x58, 0xd5, 0xb7, 0xd0, 0x9a,
-	0xf9, 0x16, 0x92, 0x4f, 0xc2, 0x18, 0xad, 0x8e, 0xa3, 0x84, 0xac, 0x4c, 0x6b, 0x98, 0x96, 0x69,
-	0xd1, 0xa2, 0x39, 0x42, 0xc6, 0x8a, 0xc0, 0xff, 0xca, 0xfe, 0x02, 0x14, 0xb0, 0x17, 0x77, 0xa4,
-	0x87, 0x64, 0xb0, 0xd8, 0xb8, 0x1c, 0x78, 0x88, 0x71, 0x19, 0xa7, 0x6b, 0x22, 0x4e, 0xd7, 0x3f,
-	0x4a, 0x30, 0xbd, 0xda, 0x70, 0xb6, 0xd0, 0xe7, 0xd1, 0x3b, 0x94, 0x59, 0x28, 0x44, 0x95, 0x13,
-	0x0f, 0xd5, 0x03, 0x30, 0x7d, 0x13, 0x7d, 0x4e, 0x35, 0x7f, 0x24, 0x71, 0xb1, 0x0c, 0x85, 0xa8,
-	0xc1, 0x78, 0x60, 0xc4, 0xc8, 0x90, 0xe2, 0x64, 0xbc, 0x43, 0xcb, 0xb5, 0x37, 0x1d, 0x84, 0xb7,
-	0xfd, 0xd9, 0xea, 0x7e, 0xc0, 0xf3, 0x95, 0x30, 0x78, 0x7e, 0xa9, 0x47, 0xf0, 0x6c, 0x3b, 0x6a,
-	0x0b, 0x43, 0x69, 0x05, 0x77, 0x1c, 0x1d, 0x53, 0x73, 0xb9, 0xfe, 0xc1, 0x47, 0xc5, 0x43, 0x1f,
-	0x7e, 0x54, 0x3c, 0xf4, 0xc9, 0x47, 0x45, 0xe9, 0xeb, 0x0f, 0x8a, 0xd2, 0xbb, 0x0f, 0x8a, 0xd2,
-	0x6f, 0x1e, 0x14, 0xa5, 0x0f, 0x1e, 0x14, 0xa5, 0x3f, 0x3f, 0x28, 0x4a, 0x7f, 0x79, 0x50, 0x3c,
-	0xf4, 0xc9, 0x83, 0xa2, 0x74, 0xff, 0xe3, 0xe2, 0xa1, 0x0f, 0x3e, 0x2e, 0x1e, 0xfa, 0xf0, 0xe3,
-	0xe2, 0xa1, 0x57, 0x2e, 0x6e, 0xd9, 0xad, 0x29, 0x9a, 0x76, 0xc7, 0x7f, 0xcb, 0xff, 0x6f, 0xb0,
-	0x65, 0x63, 0x98, 0x1e, 0x2b, 0xcf, 0xff, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x09, 0x46, 0x36, 0xec,
-	0x6c, 0x3f, 0x00, 0x00,
+var xxx_messageInfo_RestoreWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *RestoreWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
 }
 
-func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *RestoreWorkflowExecutionRequest) GetRequest() *v113.RestoreWorkflowExecutionRequest {
+	if m != nil {
+		return m.Request
 	}
+	return nil
+}
 
-	that1, ok := that.(*StartWorkflowExecutionRequest)
-	if !ok {
-		that2, ok := that.(StartWorkflowExecutionRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type RestoreWorkflowExecutionResponse struct {
+}
+
+func (m *RestoreWorkflowExecutionResponse) Reset()      { *m = RestoreWorkflowExecutionResponse{} }
+func (*RestoreWorkflowExecutionResponse) ProtoMessage() {}
+func (*RestoreWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{73}
+}
+func (m *RestoreWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if !this.StartRequest.Equal(that1.StartRequest) {
-		return false
+}
+func (m *RestoreWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *RestoreWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreWorkflowExecutionResponse proto.InternalMessageInfo
+
+type PauseWorkflowExecutionRequest struct {
+	NamespaceId string                              `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Request     *v113.PauseWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *PauseWorkflowExecutionRequest) Reset()      { *m = PauseWorkflowExecutionRequest{} }
+func (*PauseWorkflowExecutionRequest) ProtoMessage() {}
+func (*PauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{74}
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.ParentExecutionInfo.Equal(that1.ParentExecutionInfo) {
-		return false
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *PauseWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if this.Attempt != that1.Attempt {
-		return false
+	return ""
+}
+
+func (m *PauseWorkflowExecutionRequest) GetRequest() *v113.PauseWorkflowExecutionRequest {
+	if m != nil {
+		return m.Request
 	}
-	if that1.WorkflowExecutionExpirationTime == nil {
-		if this.WorkflowExecutionExpirationTime != nil {
-			return false
+	return nil
+}
+
+type PauseWorkflowExecutionResponse struct {
+}
+
+func (m *PauseWorkflowExecutionResponse) Reset()      { *m = PauseWorkflowExecutionResponse{} }
+func (*PauseWorkflowExecutionResponse) ProtoMessage() {}
+func (*PauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{75}
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.WorkflowExecutionExpirationTime.Equal(*that1.WorkflowExecutionExpirationTime) {
-		return false
+		return b[:n], nil
 	}
-	if this.ContinueAsNewInitiator != that1.ContinueAsNewInitiator {
-		return false
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseWorkflowExecutionResponse proto.InternalMessageInfo
+
+type UnpauseWorkflowExecutionRequest struct {
+	NamespaceId string                                `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Request     *v113.UnpauseWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Reset()      { *m = UnpauseWorkflowExecutionRequest{} }
+func (*UnpauseWorkflowExecutionRequest) ProtoMessage() {}
+func (*UnpauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{76}
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.ContinuedFailure.Equal(that1.ContinuedFailure) {
-		return false
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *UnpauseWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if !this.LastCompletionResult.Equal(that1.LastCompletionResult) {
-		return false
+	return ""
+}
+
+func (m *UnpauseWorkflowExecutionRequest) GetRequest() *v113.UnpauseWorkflowExecutionRequest {
+	if m != nil {
+		return m.Request
 	}
-	if this.FirstWorkflowTaskBackoff != nil && that1.FirstWorkflowTaskBackoff != nil {
-		if *this.FirstWorkflowTaskBackoff != *that1.FirstWorkflowTaskBackoff {
-			return false
+	return nil
+}
+
+type UnpauseWorkflowExecutionResponse struct {
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Reset()      { *m = UnpauseWorkflowExecutionResponse{} }
+func (*UnpauseWorkflowExecutionResponse) ProtoMessage() {}
+func (*UnpauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{77}
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.FirstWorkflowTaskBackoff != nil {
-		return false
-	} else if that1.FirstWorkflowTaskBackoff != nil {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *StartWorkflowExecutionResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*StartWorkflowExecutionResponse)
-	if !ok {
-		that2, ok := that.(StartWorkflowExecutionResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_UnpauseWorkflowExecutionResponse proto.InternalMessageInfo
+
+type PauseActivityRequest struct {
+	NamespaceId string                     `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Request     *v113.PauseActivityRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *PauseActivityRequest) Reset()      { *m = PauseActivityRequest{} }
+func (*PauseActivityRequest) ProtoMessage() {}
+func (*PauseActivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{78}
+}
+func (m *PauseActivityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseActivityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *PauseActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseActivityRequest.Merge(m, src)
+}
+func (m *PauseActivityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseActivityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseActivityRequest proto.InternalMessageInfo
+
+func (m *PauseActivityRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if this.RunId != that1.RunId {
-		return false
+	return ""
+}
+
+func (m *PauseActivityRequest) GetRequest() *v113.PauseActivityRequest {
+	if m != nil {
+		return m.Request
 	}
-	return true
+	return nil
 }
-func (this *GetMutableStateRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+type PauseActivityResponse struct {
+}
+
+func (m *PauseActivityResponse) Reset()      { *m = PauseActivityResponse{} }
+func (*PauseActivityResponse) ProtoMessage() {}
+func (*PauseActivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{79}
+}
+func (m *PauseActivityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseActivityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *PauseActivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseActivityResponse.Merge(m, src)
+}
+func (m *PauseActivityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseActivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseActivityResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*GetMutableStateRequest)
-	if !ok {
-		that2, ok := that.(GetMutableStateRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_PauseActivityResponse proto.InternalMessageInfo
+
+type UnpauseActivityRequest struct {
+	NamespaceId string                       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Request     *v113.UnpauseActivityRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *UnpauseActivityRequest) Reset()      { *m = UnpauseActivityRequest{} }
+func (*UnpauseActivityRequest) ProtoMessage() {}
+func (*UnpauseActivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{80}
+}
+func (m *UnpauseActivityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseActivityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *UnpauseActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseActivityRequest.Merge(m, src)
+}
+func (m *UnpauseActivityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseActivityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseActivityRequest proto.InternalMessageInfo
+
+func (m *UnpauseActivityRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
+	return ""
+}
+
+func (m *UnpauseActivityRequest) GetRequest() *v113.UnpauseActivityRequest {
+	if m != nil {
+		return m.Request
 	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
+	return nil
+}
+
+type UnpauseActivityResponse struct {
+}
+
+func (m *UnpauseActivityResponse) Reset()      { *m = UnpauseActivityResponse{} }
+func (*UnpauseActivityResponse) ProtoMessage() {}
+func (*UnpauseActivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{81}
+}
+func (m *UnpauseActivityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseActivityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.ExpectedNextEventId != that1.ExpectedNextEventId {
-		return false
+}
+func (m *UnpauseActivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseActivityResponse.Merge(m, src)
+}
+func (m *UnpauseActivityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseActivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseActivityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseActivityResponse proto.InternalMessageInfo
+
+type ResetActivityRequest struct {
+	NamespaceId string                     `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Request     *v113.ResetActivityRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *ResetActivityRequest) Reset()      { *m = ResetActivityRequest{} }
+func (*ResetActivityRequest) ProtoMessage() {}
+func (*ResetActivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{82}
+}
+func (m *ResetActivityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetActivityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.CurrentBranchToken, that1.CurrentBranchToken) {
-		return false
+}
+func (m *ResetActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetActivityRequest.Merge(m, src)
+}
+func (m *ResetActivityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetActivityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetActivityRequest proto.InternalMessageInfo
+
+func (m *ResetActivityRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	return true
+	return ""
 }
-func (this *GetMutableStateResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ResetActivityRequest) GetRequest() *v113.ResetActivityRequest {
+	if m != nil {
+		return m.Request
 	}
+	return nil
+}
 
-	that1, ok := that.(*GetMutableStateResponse)
+type ResetActivityResponse struct {
+}
+
+func (m *ResetActivityResponse) Reset()      { *m = ResetActivityResponse{} }
+func (*ResetActivityResponse) ProtoMessage() {}
+func (*ResetActivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{83}
+}
+func (m *ResetActivityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetActivityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResetActivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetActivityResponse.Merge(m, src)
+}
+func (m *ResetActivityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetActivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetActivityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetActivityResponse proto.InternalMessageInfo
+
+type UpdateActivityOptionsRequest struct {
+	NamespaceId string                             `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Request     *v113.UpdateActivityOptionsRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *UpdateActivityOptionsRequest) Reset()      { *m = UpdateActivityOptionsRequest{} }
+func (*UpdateActivityOptionsRequest) ProtoMessage() {}
+func (*UpdateActivityOptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{84}
+}
+func (m *UpdateActivityOptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateActivityOptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateActivityOptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateActivityOptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateActivityOptionsRequest.Merge(m, src)
+}
+func (m *UpdateActivityOptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateActivityOptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateActivityOptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateActivityOptionsRequest proto.InternalMessageInfo
+
+func (m *UpdateActivityOptionsRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *UpdateActivityOptionsRequest) GetRequest() *v113.UpdateActivityOptionsRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+type UpdateActivityOptionsResponse struct {
+}
+
+func (m *UpdateActivityOptionsResponse) Reset()      { *m = UpdateActivityOptionsResponse{} }
+func (*UpdateActivityOptionsResponse) ProtoMessage() {}
+func (*UpdateActivityOptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{85}
+}
+func (m *UpdateActivityOptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateActivityOptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateActivityOptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateActivityOptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateActivityOptionsResponse.Merge(m, src)
+}
+func (m *UpdateActivityOptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateActivityOptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateActivityOptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateActivityOptionsResponse proto.InternalMessageInfo
+
+type StreamWorkflowExecutionHistoryRequest struct {
+	NamespaceId string                                      `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Request     *v113.StreamWorkflowExecutionHistoryRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *StreamWorkflowExecutionHistoryRequest) Reset()      { *m = StreamWorkflowExecutionHistoryRequest{} }
+func (*StreamWorkflowExecutionHistoryRequest) ProtoMessage() {}
+func (*StreamWorkflowExecutionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{86}
+}
+func (m *StreamWorkflowExecutionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamWorkflowExecutionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamWorkflowExecutionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamWorkflowExecutionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamWorkflowExecutionHistoryRequest.Merge(m, src)
+}
+func (m *StreamWorkflowExecutionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamWorkflowExecutionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamWorkflowExecutionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamWorkflowExecutionHistoryRequest proto.InternalMessageInfo
+
+func (m *StreamWorkflowExecutionHistoryRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *StreamWorkflowExecutionHistoryRequest) GetRequest() *v113.StreamWorkflowExecutionHistoryRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+type StreamWorkflowExecutionHistoryResponse struct {
+	Response *v113.StreamWorkflowExecutionHistoryResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (m *StreamWorkflowExecutionHistoryResponse) Reset() {
+	*m = StreamWorkflowExecutionHistoryResponse{}
+}
+func (*StreamWorkflowExecutionHistoryResponse) ProtoMessage() {}
+func (*StreamWorkflowExecutionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{87}
+}
+func (m *StreamWorkflowExecutionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamWorkflowExecutionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamWorkflowExecutionHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamWorkflowExecutionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamWorkflowExecutionHistoryResponse.Merge(m, src)
+}
+func (m *StreamWorkflowExecutionHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamWorkflowExecutionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamWorkflowExecutionHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamWorkflowExecutionHistoryResponse proto.InternalMessageInfo
+
+func (m *StreamWorkflowExecutionHistoryResponse) GetResponse() *v113.StreamWorkflowExecutionHistoryResponse {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionRequest")
+	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionResponse")
+	proto.RegisterType((*GetMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.GetMutableStateRequest")
+	proto.RegisterType((*GetMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.GetMutableStateResponse")
+	proto.RegisterType((*PollMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.PollMutableStateRequest")
+	proto.RegisterType((*PollMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.PollMutableStateResponse")
+	proto.RegisterType((*ResetStickyTaskQueueRequest)(nil), "temporal.server.api.historyservice.v1.ResetStickyTaskQueueRequest")
+	proto.RegisterType((*ResetStickyTaskQueueResponse)(nil), "temporal.server.api.historyservice.v1.ResetStickyTaskQueueResponse")
+	proto.RegisterType((*RecordWorkflowTaskStartedRequest)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedRequest")
+	proto.RegisterType((*RecordWorkflowTaskStartedResponse)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedResponse")
+	proto.RegisterMapType((map[string]*v18.WorkflowQuery)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedResponse.QueriesEntry")
+	proto.RegisterType((*RecordActivityTaskStartedRequest)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskStartedRequest")
+	proto.RegisterType((*RecordActivityTaskStartedResponse)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskStartedResponse")
+	proto.RegisterType((*RespondWorkflowTaskCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskCompletedRequest")
+	proto.RegisterType((*RespondWorkflowTaskCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskCompletedResponse")
+	proto.RegisterType((*RespondWorkflowTaskFailedRequest)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskFailedRequest")
+	proto.RegisterType((*RespondWorkflowTaskFailedResponse)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskFailedResponse")
+	proto.RegisterType((*RecordActivityTaskHeartbeatRequest)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskHeartbeatRequest")
+	proto.RegisterType((*RecordActivityTaskHeartbeatResponse)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskHeartbeatResponse")
+	proto.RegisterType((*RespondActivityTaskCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCompletedRequest")
+	proto.RegisterType((*RespondActivityTaskCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCompletedResponse")
+	proto.RegisterType((*RespondActivityTaskFailedRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskFailedRequest")
+	proto.RegisterType((*RespondActivityTaskFailedResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskFailedResponse")
+	proto.RegisterType((*RespondActivityTaskCanceledRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCanceledRequest")
+	proto.RegisterType((*RespondActivityTaskCanceledResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCanceledResponse")
+	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.SignalWorkflowExecutionRequest")
+	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWorkflowExecutionResponse")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionRequest")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionResponse")
+	proto.RegisterType((*RemoveSignalMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateRequest")
+	proto.RegisterType((*RemoveSignalMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateResponse")
+	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionRequest")
+	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionResponse")
+	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionRequest")
+	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionResponse")
+	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionRequest")
+	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionResponse")
+	proto.RegisterType((*ScheduleWorkflowTaskRequest)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskRequest")
+	proto.RegisterType((*ScheduleWorkflowTaskResponse)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskResponse")
+	proto.RegisterType((*RecordChildExecutionCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RecordChildExecutionCompletedRequest")
+	proto.RegisterType((*RecordChildExecutionCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RecordChildExecutionCompletedResponse")
+	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionRequest")
+	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionResponse")
+	proto.RegisterType((*ReplicateEventsV2Request)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Request")
+	proto.RegisterType((*ReplicateEventsV2Response)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Response")
+	proto.RegisterType((*SyncShardStatusRequest)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusRequest")
+	proto.RegisterType((*SyncShardStatusResponse)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusResponse")
+	proto.RegisterType((*SyncActivityRequest)(nil), "temporal.server.api.historyservice.v1.SyncActivityRequest")
+	proto.RegisterType((*SyncActivityResponse)(nil), "temporal.server.api.historyservice.v1.SyncActivityResponse")
+	proto.RegisterType((*DescribeMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.DescribeMutableStateRequest")
+	proto.RegisterType((*DescribeMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.DescribeMutableStateResponse")
+	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryHostRequest")
+	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryHostResponse")
+	proto.RegisterType((*CloseShardRequest)(nil), "temporal.server.api.historyservice.v1.CloseShardRequest")
+	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.historyservice.v1.CloseShardResponse")
+	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.historyservice.v1.RemoveTaskRequest")
+	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.historyservice.v1.RemoveTaskResponse")
+	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesRequest")
+	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse")
+	proto.RegisterMapType((map[int32]*v112.ReplicationMessages)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
+	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetDLQReplicationMessagesRequest")
+	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetDLQReplicationMessagesResponse")
+	proto.RegisterType((*QueryWorkflowRequest)(nil), "temporal.server.api.historyservice.v1.QueryWorkflowRequest")
+	proto.RegisterType((*QueryWorkflowResponse)(nil), "temporal.server.api.historyservice.v1.QueryWorkflowResponse")
+	proto.RegisterType((*ReapplyEventsRequest)(nil), "temporal.server.api.historyservice.v1.ReapplyEventsRequest")
+	proto.RegisterType((*ReapplyEventsResponse)(nil), "temporal.server.api.historyservice.v1.ReapplyEventsResponse")
+	proto.RegisterType((*GetDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetDLQMessagesRequest")
+	proto.RegisterType((*GetDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetDLQMessagesResponse")
+	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.PurgeDLQMessagesRequest")
+	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.PurgeDLQMessagesResponse")
+	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesRequest")
+	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesResponse")
+	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksRequest")
+	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*RestoreWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.RestoreWorkflowExecutionRequest")
+	proto.RegisterType((*RestoreWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.RestoreWorkflowExecutionResponse")
+	proto.RegisterType((*PauseWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.PauseWorkflowExecutionRequest")
+	proto.RegisterType((*PauseWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.PauseWorkflowExecutionResponse")
+	proto.RegisterType((*UnpauseWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.UnpauseWorkflowExecutionRequest")
+	proto.RegisterType((*UnpauseWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.UnpauseWorkflowExecutionResponse")
+	proto.RegisterType((*PauseActivityRequest)(nil), "temporal.server.api.historyservice.v1.PauseActivityRequest")
+	proto.RegisterType((*PauseActivityResponse)(nil), "temporal.server.api.historyservice.v1.PauseActivityResponse")
+	proto.RegisterType((*UnpauseActivityRequest)(nil), "temporal.server.api.historyservice.v1.UnpauseActivityRequest")
+	proto.RegisterType((*UnpauseActivityResponse)(nil), "temporal.server.api.historyservice.v1.UnpauseActivityResponse")
+	proto.RegisterType((*ResetActivityRequest)(nil), "temporal.server.api.historyservice.v1.ResetActivityRequest")
+	proto.RegisterType((*ResetActivityResponse)(nil), "temporal.server.api.historyservice.v1.ResetActivityResponse")
+	proto.RegisterType((*UpdateActivityOptionsRequest)(nil), "temporal.server.api.historyservice.v1.UpdateActivityOptionsRequest")
+	proto.RegisterType((*UpdateActivityOptionsResponse)(nil), "temporal.server.api.historyservice.v1.UpdateActivityOptionsResponse")
+	proto.RegisterType((*StreamWorkflowExecutionHistoryRequest)(nil), "temporal.server.api.historyservice.v1.StreamWorkflowExecutionHistoryRequest")
+	proto.RegisterType((*StreamWorkflowExecutionHistoryResponse)(nil), "temporal.server.api.historyservice.v1.StreamWorkflowExecutionHistoryResponse")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/historyservice/v1/request_response.proto", fileDescriptor_b8c78c1d460a3711)
+}
+
+var fileDescriptor_b8c78c1d460a3711 = []byte{
+	// 4162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4d, 0x70, 0x24, 0x47,
+	0x56, 0x9e, 0x52, 0xeb, 0xa7, 0xfb, 0xa9, 0xd5, 0xea, 0x2e, 0xfd, 0xb5, 0x34, 0x9e, 0x1e, 0xa9,
+	0xe6, 0xc7, 0xf2, 0xee, 0x4e, 0xcb, 0x33, 0xb3, 0x6b, 0x7b, 0x67, 0xd9, 0x85, 0x91, 0xe6, 0xaf,
+	0x87, 0x99, 0xb1, 0x5c, 0xd2, 0xd8, 0x1b, 0x5e, 0xe3, 0x72, 0xa9, 0x2b, 0xd5, 0x2a, 0xd4, 0x5d,
+	0xd5, 0xae, 0xac, 0x96, 0xa6, 0xcd, 0x01, 0x02, 0x82, 0x03, 0x17, 0xc2, 0xc0, 0x85, 0x08, 0x16,
+	0x82, 0x20, 0x88, 0x60, 0x39, 0x10, 0x4b, 0x04, 0x07, 0x62, 0x89, 0xe0, 0x4a, 0x70, 0xc3, 0x37,
+	0x1c, 0x70, 0x00, 0x8f, 0x39, 0x40, 0xc0, 0x61, 0x0f, 0x10, 0x44, 0x70, 0x22, 0xf2, 0xaf, 0xfe,
+	0xab, 0xba, 0x5b, 0x92, 0xd7, 0x8b, 0xf1, 0x4d, 0x95, 0xf9, 0xde, 0xcb, 0xf7, 0x5e, 0xbe, 0xfc,
+	0x32, 0xf3, 0xe5, 0x6b, 0xc1, 0xcf, 0xb8, 0xa8, 0xd3, 0xb5, 0x1d, 0xbd, 0xbd, 0x81, 0x91, 0x73,
+	0x84, 0x9c, 0x0d, 0xbd, 0x6b, 0x6e, 0x1c, 0x98, 0xd8, 0xb5, 0x9d, 0x3e, 0x69, 0x31, 0x9b, 0x68,
+	0xe3, 0xe8, 0xfa, 0x86, 0x83, 0xde, 0xef, 0x21, 0xec, 0x6a, 0x0e, 0xc2, 0x5d, 0xdb, 0xc2, 0xa8,
+	0xde, 0x75, 0x6c, 0xd7, 0x96, 0xaf, 0x08, 0xee, 0x3a, 0xe3, 0xae, 0xeb, 0x5d, 0xb3, 0x1e, 0xe6,
+	0xae, 0x1f, 0x5d, 0x5f, 0xa9, 0xb5, 0x6c, 0xbb, 0xd5, 0x46, 0x1b, 0x94, 0x69, 0xaf, 0xb7, 0xbf,
+	0x61, 0xf4, 0x1c, 0xdd, 0x35, 0x6d, 0x8b, 0x89, 0x59, 0xb9, 0x18, 0xed, 0x77, 0xcd, 0x0e, 0xc2,
+	0xae, 0xde, 0xe9, 0x72, 0x82, 0x35, 0x03, 0x75, 0x91, 0x65, 0x20, 0xab, 0x69, 0x22, 0xbc, 0xd1,
+	0xb2, 0x5b, 0x36, 0x6d, 0xa7, 0x7f, 0x71, 0x92, 0xcb, 0x9e, 0x21, 0xc4, 0x82, 0xa6, 0xdd, 0xe9,
+	0xd8, 0x16, 0xd1, 0xbc, 0x83, 0x30, 0xd6, 0x5b, 0x5c, 0xe1, 0x95, 0x2b, 0x21, 0x2a, 0xae, 0x69,
+	0x9c, 0xec, 0xc5, 0x10, 0x99, 0xab, 0xe3, 0xc3, 0xf7, 0x7b, 0xa8, 0x87, 0xe2, 0x84, 0xe1, 0x51,
+	0x91, 0xd5, 0xeb, 0x60, 0x42, 0x74, 0x6c, 0x3b, 0x87, 0xfb, 0x6d, 0xfb, 0x98, 0x53, 0x5d, 0x0d,
+	0x51, 0x89, 0xce, 0xb8, 0xb4, 0x4b, 0x21, 0xba, 0xf7, 0x7b, 0xc8, 0xe9, 0x0f, 0x32, 0x61, 0x5f,
+	0x37, 0xdb, 0x3d, 0x27, 0x41, 0xb3, 0xaf, 0x65, 0x4c, 0x6c, 0x9c, 0xfa, 0xa5, 0x24, 0x6a, 0xcf,
+	0x1c, 0xe6, 0x4d, 0x4e, 0xfa, 0xd5, 0x4c, 0xd2, 0x88, 0xe5, 0x2f, 0x66, 0x12, 0x13, 0xc7, 0x72,
+	0xc2, 0x6b, 0x49, 0x84, 0xe9, 0x9e, 0xaa, 0x27, 0x91, 0x5b, 0x7a, 0x07, 0xe1, 0xae, 0xde, 0x4c,
+	0xf0, 0xc6, 0xcb, 0x49, 0xf4, 0x0e, 0xea, 0xb6, 0xcd, 0x26, 0x0d, 0xc4, 0x38, 0xc7, 0x2b, 0x89,
+	0x73, 0x36, 0x70, 0x49, 0xac, 0xdc, 0x4a, 0x1a, 0x49, 0x37, 0x3a, 0xa6, 0x35, 0x90, 0x57, 0x79,
+	0x3e, 0x09, 0x17, 0x76, 0x5c, 0xdd, 0x71, 0xdf, 0xe2, 0xc3, 0xdd, 0x7d, 0x86, 0x9a, 0x3d, 0xa2,
+	0x9f, 0xca, 0x18, 0xe4, 0x35, 0x28, 0x7a, 0x56, 0x6a, 0xa6, 0x51, 0x95, 0x56, 0xa5, 0xf5, 0x82,
+	0x3a, 0xed, 0xb5, 0x35, 0x0c, 0xb9, 0x09, 0x33, 0x98, 0xc8, 0xd0, 0xf8, 0x20, 0xd5, 0xb1, 0x55,
+	0x69, 0x7d, 0xfa, 0xc6, 0x77, 0x3c, 0x97, 0xd1, 0x45, 0x1a, 0x31, 0xa8, 0x7e, 0x74, 0xbd, 0x9e,
+	0x39, 0xb2, 0x5a, 0xa4, 0x42, 0x85, 0x1e, 0x07, 0xb0, 0xd0, 0xd5, 0x1d, 0x64, 0xb9, 0x1a, 0x12,
+	0x84, 0x9a, 0x69, 0xed, 0xdb, 0xd5, 0x1c, 0x1d, 0xec, 0xeb, 0xf5, 0x24, 0x60, 0xf0, 0x62, 0xe3,
+	0xe8, 0x7a, 0x7d, 0x9b, 0x72, 0x7b, 0xa3, 0x34, 0xac, 0x7d, 0x5b, 0x9d, 0xeb, 0xc6, 0x1b, 0xe5,
+	0x2a, 0x4c, 0xe9, 0x2e, 0x91, 0xe6, 0x56, 0xc7, 0x57, 0xa5, 0xf5, 0x09, 0x55, 0x7c, 0xca, 0x1d,
+	0x50, 0x84, 0xc4, 0x80, 0x16, 0xe8, 0x59, 0xd7, 0x64, 0xe0, 0xa2, 0x11, 0x14, 0xa9, 0x4e, 0x50,
+	0x85, 0x56, 0xea, 0x0c, 0x62, 0xea, 0x02, 0x62, 0xea, 0xbb, 0x02, 0x62, 0x36, 0xc7, 0x3f, 0xfc,
+	0xa7, 0x8b, 0x92, 0x7a, 0xf1, 0x38, 0x6a, 0xf9, 0x5d, 0x4f, 0x12, 0xa1, 0x95, 0x0f, 0x60, 0xb9,
+	0x69, 0x5b, 0xae, 0x69, 0xf5, 0x90, 0xa6, 0x63, 0xcd, 0x42, 0xc7, 0x9a, 0x69, 0x99, 0xae, 0xa9,
+	0xbb, 0xb6, 0x53, 0x9d, 0x5c, 0x95, 0xd6, 0x4b, 0x37, 0xae, 0x85, 0x7d, 0x4c, 0xe3, 0x9c, 0x18,
+	0xbb, 0xc5, 0xf9, 0x6e, 0xe3, 0x27, 0xe8, 0xb8, 0x21, 0x98, 0xd4, 0xc5, 0x66, 0x62, 0xbb, 0xfc,
+	0x18, 0x2a, 0xa2, 0xc7, 0xd0, 0xf8, 0x02, 0xaf, 0x4e, 0x51, 0x3b, 0x56, 0xc3, 0x23, 0xf0, 0x4e,
+	0x32, 0xc6, 0x3d, 0xf6, 0xa7, 0x5a, 0xf6, 0x58, 0x79, 0x8b, 0xfc, 0x26, 0x2c, 0xb6, 0x75, 0xec,
+	0x6a, 0x4d, 0xbb, 0xd3, 0x6d, 0x23, 0xea, 0x19, 0x07, 0xe1, 0x5e, 0xdb, 0xad, 0xe6, 0x93, 0x64,
+	0xf2, 0xc5, 0x4e, 0xe7, 0xa8, 0xdf, 0xb6, 0x75, 0x03, 0xab, 0xf3, 0x84, 0x7f, 0xcb, 0x63, 0x57,
+	0x29, 0xb7, 0xfc, 0x2e, 0x9c, 0xdf, 0x37, 0x1d, 0xec, 0x6a, 0xde, 0x2c, 0x90, 0xf5, 0xac, 0xed,
+	0xe9, 0xcd, 0x43, 0x7b, 0x7f, 0xbf, 0x5a, 0xa0, 0xc2, 0x97, 0x63, 0x8e, 0xbf, 0xc3, 0xb1, 0x7f,
+	0x73, 0xfc, 0x77, 0x89, 0xdf, 0xab, 0x54, 0x86, 0x08, 0xbb, 0x5d, 0x1d, 0x1f, 0x6e, 0x32, 0x01,
+	0xf2, 0x53, 0x28, 0x19, 0x48, 0x37, 0xda, 0xa6, 0x85, 0x34, 0xa7, 0xd7, 0x46, 0xb8, 0x0a, 0x54,
+	0x64, 0x7d, 0x60, 0x70, 0xdd, 0xe1, 0x6c, 0x2a, 0xe1, 0x52, 0x67, 0x8c, 0xe0, 0xa7, 0xf2, 0x2a,
+	0xd4, 0xd2, 0x22, 0x9d, 0x2d, 0x46, 0x79, 0x01, 0x26, 0x9d, 0x9e, 0xe5, 0x2f, 0xaf, 0x09, 0xa7,
+	0x67, 0x35, 0x0c, 0xe5, 0xdf, 0x25, 0x58, 0xbc, 0x8f, 0xdc, 0xc7, 0x3d, 0x57, 0xdf, 0x6b, 0xa3,
+	0x1d, 0x57, 0x77, 0xd1, 0x08, 0xcb, 0xf2, 0x3e, 0x14, 0xbc, 0x20, 0xe5, 0x4b, 0xf2, 0xa5, 0x34,
+	0xc7, 0xc7, 0x55, 0xf3, 0x79, 0xe5, 0x9b, 0xb0, 0x88, 0x9e, 0x75, 0x51, 0xd3, 0x45, 0x86, 0x66,
+	0xa1, 0x67, 0xae, 0x86, 0x8e, 0xc8, 0x3a, 0x34, 0x0d, 0xba, 0xf6, 0x72, 0xea, 0x9c, 0xe8, 0x7d,
+	0x82, 0x9e, 0xb9, 0x77, 0x49, 0x5f, 0xc3, 0x90, 0x5f, 0x86, 0xf9, 0x66, 0xcf, 0xa1, 0x0b, 0x76,
+	0xcf, 0xd1, 0xad, 0xe6, 0x81, 0xe6, 0xda, 0x87, 0xc8, 0xa2, 0x4b, 0xaa, 0xa8, 0xca, 0xbc, 0x6f,
+	0x93, 0x76, 0xed, 0x92, 0x1e, 0xe5, 0xbf, 0xf2, 0xb0, 0x14, 0xb3, 0x96, 0x3b, 0x28, 0x64, 0x8b,
+	0x74, 0x0a, 0x5b, 0x1a, 0x30, 0xe3, 0x07, 0x4f, 0xbf, 0x8b, 0xb8, 0x63, 0x2e, 0x0f, 0x12, 0xb6,
+	0xdb, 0xef, 0x22, 0xb5, 0x78, 0x1c, 0xf8, 0x92, 0x15, 0x98, 0x49, 0xf2, 0xc6, 0xb4, 0x15, 0xf0,
+	0xc2, 0x37, 0x61, 0xb9, 0xeb, 0xa0, 0x23, 0xd3, 0xee, 0x61, 0x8d, 0xc2, 0x19, 0x32, 0x7c, 0xfa,
+	0x71, 0x4a, 0xbf, 0x28, 0x08, 0x76, 0x58, 0xbf, 0x60, 0xbd, 0x06, 0x73, 0x74, 0x11, 0xb1, 0x88,
+	0xf7, 0x98, 0x26, 0x28, 0x53, 0x99, 0x74, 0xdd, 0x23, 0x3d, 0x82, 0x7c, 0x0b, 0x80, 0x2e, 0x06,
+	0x7a, 0x6c, 0xa8, 0x4e, 0x26, 0x59, 0xe5, 0x9d, 0x2a, 0x88, 0x61, 0x24, 0xee, 0xdf, 0x20, 0x1f,
+	0x6a, 0xc1, 0x15, 0x7f, 0xca, 0xdb, 0x50, 0xc1, 0xae, 0xd9, 0x3c, 0xec, 0x6b, 0x01, 0x59, 0x53,
+	0x23, 0xc8, 0x9a, 0x65, 0xec, 0x5e, 0x83, 0xfc, 0x4b, 0xf0, 0xd5, 0x98, 0x44, 0x0d, 0x37, 0x0f,
+	0x90, 0xd1, 0x6b, 0x23, 0xcd, 0xb5, 0x99, 0x57, 0x28, 0x70, 0xda, 0x3d, 0xb7, 0x3a, 0x3d, 0xdc,
+	0x12, 0xbe, 0x12, 0x19, 0x66, 0x87, 0x0b, 0xdc, 0xb5, 0xa9, 0x13, 0x77, 0x99, 0x34, 0xb9, 0x0e,
+	0x73, 0xcc, 0x6f, 0xd8, 0xb5, 0x1d, 0xa4, 0x1d, 0x21, 0x07, 0x93, 0xf8, 0x29, 0x52, 0x54, 0xaf,
+	0xd0, 0xae, 0x1d, 0xd2, 0xf3, 0x26, 0xeb, 0x48, 0x8d, 0xd9, 0x99, 0xb4, 0x98, 0x95, 0xbf, 0x07,
+	0x25, 0x2f, 0x9c, 0x30, 0x89, 0xd8, 0xea, 0x2c, 0xc5, 0xe5, 0xe4, 0xed, 0xc8, 0x83, 0xe7, 0x58,
+	0x88, 0xb2, 0x68, 0xf7, 0x42, 0x93, 0x7e, 0xca, 0x6f, 0xc1, 0x6c, 0x48, 0x78, 0x0f, 0x57, 0xcb,
+	0x54, 0x7a, 0x3d, 0x05, 0xf5, 0x13, 0xc5, 0xf6, 0xb0, 0x5a, 0x0a, 0xca, 0xed, 0x61, 0xf9, 0x17,
+	0xa0, 0xc2, 0x7d, 0xa1, 0xb1, 0xf3, 0x99, 0x89, 0x70, 0xb5, 0x42, 0x5d, 0xff, 0x72, 0x3d, 0xe3,
+	0x80, 0x4d, 0xc6, 0xe0, 0xbe, 0x7a, 0x20, 0xf8, 0xd4, 0xf2, 0x51, 0xa4, 0x45, 0xfe, 0x0e, 0xbc,
+	0x60, 0x62, 0x8d, 0x4d, 0x51, 0x70, 0xda, 0x91, 0x45, 0x16, 0xb6, 0x51, 0x95, 0x57, 0xa5, 0xf5,
+	0xbc, 0x5a, 0x35, 0xf1, 0x4e, 0x78, 0x16, 0xef, 0xb2, 0xfe, 0x04, 0x18, 0x9e, 0x3b, 0x03, 0x18,
+	0x7e, 0x38, 0x9e, 0xcf, 0x97, 0x0b, 0x0f, 0xc7, 0xf3, 0x85, 0x32, 0x3c, 0x1c, 0xcf, 0x43, 0x79,
+	0xfa, 0xe1, 0x78, 0xbe, 0x54, 0x9e, 0x55, 0xfe, 0x43, 0x82, 0xa5, 0x6d, 0xbb, 0xdd, 0xfe, 0x7f,
+	0x02, 0xb3, 0x3f, 0x9c, 0x82, 0x6a, 0xdc, 0xdc, 0x2f, 0x71, 0xf6, 0x4b, 0x9c, 0x3d, 0x31, 0xce,
+	0xa6, 0x05, 0x61, 0x31, 0x15, 0x37, 0x13, 0x11, 0xa8, 0x74, 0x66, 0x08, 0xf4, 0x7f, 0x12, 0x96,
+	0x13, 0x01, 0x6a, 0xa6, 0x5c, 0x52, 0x7e, 0x43, 0x82, 0xf3, 0x2a, 0xc2, 0xc8, 0x8d, 0xe0, 0xe5,
+	0xe7, 0x00, 0x52, 0x4a, 0x0d, 0x5e, 0x48, 0x56, 0x85, 0x01, 0x88, 0xf2, 0x0f, 0x63, 0xb0, 0xaa,
+	0xa2, 0xa6, 0xed, 0x18, 0xc1, 0x03, 0x36, 0x5f, 0x72, 0x23, 0x28, 0xfc, 0x5d, 0x90, 0xe3, 0x57,
+	0xad, 0xd1, 0x35, 0xaf, 0xc4, 0xee, 0x58, 0xf2, 0x45, 0x98, 0xf6, 0xd6, 0x85, 0x07, 0x26, 0x20,
+	0x9a, 0x1a, 0x86, 0xbc, 0x04, 0x53, 0x74, 0x0d, 0x79, 0xc8, 0x31, 0x49, 0x3e, 0x1b, 0x86, 0x7c,
+	0x01, 0x40, 0x5c, 0xa3, 0x39, 0x40, 0x14, 0xd4, 0x02, 0x6f, 0x69, 0x18, 0xf2, 0x7b, 0x50, 0xec,
+	0xda, 0xed, 0xb6, 0x77, 0x0b, 0x66, 0xd8, 0xf0, 0xed, 0x81, 0xb7, 0x60, 0x02, 0xc6, 0x41, 0x67,
+	0x05, 0xe7, 0x56, 0x9d, 0x26, 0x22, 0xf9, 0x87, 0xf2, 0x2f, 0x79, 0x58, 0xcb, 0x70, 0x2e, 0xc7,
+	0xf0, 0x18, 0xf4, 0x4a, 0x27, 0x86, 0xde, 0x4c, 0x58, 0x1d, 0xcb, 0x84, 0xd5, 0xaf, 0x81, 0x2c,
+	0x7c, 0x6a, 0x44, 0xa1, 0xbb, 0xec, 0xf5, 0x08, 0xea, 0x75, 0x28, 0xa7, 0xc0, 0x76, 0x09, 0x87,
+	0xe5, 0xc6, 0x76, 0x83, 0x89, 0xf8, 0x6e, 0x10, 0xb8, 0xc1, 0x4f, 0x86, 0x6f, 0xf0, 0xaf, 0x41,
+	0x95, 0xc3, 0x64, 0xe0, 0xfe, 0xce, 0x8f, 0x25, 0x53, 0xf4, 0x58, 0xb2, 0xc8, 0xfa, 0xfd, 0x3b,
+	0x39, 0xeb, 0x95, 0x5b, 0x81, 0x80, 0x64, 0xe1, 0x41, 0x92, 0x0f, 0xec, 0x3e, 0xfb, 0xcd, 0x41,
+	0x90, 0xb5, 0xeb, 0xe8, 0x16, 0x36, 0x91, 0x15, 0xba, 0x75, 0xd2, 0x0c, 0x44, 0xf9, 0x38, 0xd2,
+	0x22, 0xb7, 0xe0, 0x42, 0x42, 0x92, 0x21, 0xb0, 0x4f, 0x14, 0x46, 0xd8, 0x27, 0x56, 0x62, 0xf1,
+	0xef, 0xf5, 0xa5, 0x9d, 0x8e, 0x21, 0xed, 0x74, 0xbc, 0x06, 0xc5, 0x10, 0xba, 0x4f, 0x53, 0x74,
+	0x9f, 0xde, 0x0b, 0xc0, 0xfa, 0x7d, 0x28, 0xf9, 0x93, 0x4e, 0x93, 0x21, 0xc5, 0x21, 0x93, 0x21,
+	0x33, 0x1e, 0x1f, 0xe9, 0x91, 0xb7, 0xa0, 0x28, 0xe2, 0x81, 0x8a, 0x99, 0x19, 0x52, 0xcc, 0x34,
+	0xe7, 0xa2, 0x42, 0x6c, 0x98, 0x22, 0x19, 0x4d, 0xb6, 0xb5, 0xe4, 0xd6, 0xa7, 0x6f, 0x3c, 0xad,
+	0x0f, 0x95, 0x3d, 0xae, 0x0f, 0x5c, 0x63, 0xf5, 0x37, 0x98, 0xdc, 0xbb, 0x96, 0xeb, 0xf4, 0x55,
+	0x31, 0x0a, 0x89, 0x79, 0x2e, 0x4c, 0xc3, 0xe6, 0x07, 0x48, 0xdb, 0xeb, 0xbb, 0x08, 0xd3, 0xad,
+	0x27, 0xa7, 0x96, 0x79, 0xcf, 0x8e, 0xf9, 0x01, 0xda, 0x24, 0xed, 0xf2, 0x37, 0x60, 0x09, 0xf7,
+	0x5a, 0x2d, 0x44, 0x13, 0x25, 0xa1, 0x34, 0x0f, 0xdd, 0x4f, 0xf2, 0xea, 0x3c, 0xef, 0x0e, 0x25,
+	0x73, 0x56, 0xde, 0x83, 0x62, 0x70, 0x74, 0xb9, 0x0c, 0xb9, 0x43, 0xd4, 0xe7, 0x18, 0x4a, 0xfe,
+	0x94, 0x6f, 0xc1, 0xc4, 0x91, 0xde, 0xee, 0xa5, 0x9c, 0xb9, 0x68, 0x92, 0x37, 0xb8, 0xee, 0x89,
+	0xb4, 0xbe, 0xca, 0x58, 0x6e, 0x8d, 0xbd, 0x26, 0x05, 0x30, 0xfc, 0x76, 0xd3, 0x35, 0x8f, 0x4c,
+	0xb7, 0xff, 0x25, 0x86, 0x0f, 0x81, 0xe1, 0x41, 0x67, 0xa5, 0x63, 0xf8, 0xaf, 0x8e, 0x0b, 0x0c,
+	0x4f, 0x74, 0x2e, 0xc7, 0xf0, 0x27, 0x30, 0x1b, 0x41, 0x4f, 0x8e, 0xe2, 0x57, 0xc2, 0xaa, 0x04,
+	0x30, 0x86, 0x9d, 0x7e, 0xfa, 0x14, 0x03, 0xd5, 0x52, 0x18, 0x61, 0x63, 0xeb, 0x69, 0xec, 0x24,
+	0xeb, 0x29, 0x00, 0xab, 0xb9, 0x30, 0xac, 0x22, 0xa8, 0x89, 0x03, 0x20, 0x6f, 0xd2, 0x22, 0x38,
+	0x30, 0x3e, 0xe4, 0x80, 0xe7, 0xb9, 0x9c, 0xdb, 0x4c, 0xcc, 0x4e, 0x08, 0x15, 0x1e, 0x43, 0xe5,
+	0x00, 0xe9, 0x8e, 0xbb, 0x87, 0x74, 0x57, 0x33, 0x90, 0xab, 0x9b, 0x6d, 0x5c, 0x9d, 0x18, 0x32,
+	0xa5, 0x58, 0xf6, 0x58, 0xef, 0x30, 0xce, 0xf8, 0x46, 0x39, 0x79, 0xe2, 0x8d, 0xf2, 0x5a, 0x20,
+	0xd4, 0xbd, 0x25, 0x40, 0x77, 0x94, 0x82, 0x1f, 0xbf, 0x4f, 0x44, 0x87, 0xf2, 0x23, 0x09, 0x2e,
+	0xb1, 0xb9, 0x0e, 0xa1, 0x0c, 0x4f, 0x78, 0x8e, 0xb4, 0xc8, 0x6c, 0x28, 0xf3, 0x34, 0x2b, 0x8a,
+	0xe4, 0xdf, 0xef, 0x0c, 0x8c, 0xda, 0x21, 0x54, 0x50, 0x67, 0x85, 0x74, 0x11, 0xc0, 0xbf, 0x27,
+	0xc1, 0xe5, 0x6c, 0x46, 0x1e, 0xc3, 0xd8, 0xdf, 0xd3, 0xc5, 0xab, 0x03, 0x0f, 0xe2, 0x07, 0x67,
+	0x85, 0xc3, 0xe4, 0x1e, 0x14, 0x6a, 0x50, 0x7e, 0x28, 0xc1, 0x2a, 0xfb, 0x08, 0xf1, 0x91, 0xcc,
+	0xf4, 0x48, 0x6e, 0x3d, 0x80, 0xd2, 0x3e, 0xe5, 0x89, 0x38, 0xf5, 0xf6, 0x49, 0x9c, 0x1a, 0x1a,
+	0x5d, 0x9d, 0xd9, 0x0f, 0x7e, 0x2a, 0x97, 0x60, 0x2d, 0x83, 0x85, 0x9b, 0xf5, 0x23, 0x09, 0x94,
+	0x38, 0x6a, 0x3c, 0x10, 0x11, 0x3d, 0x82, 0x61, 0xdd, 0xe0, 0x1a, 0x0a, 0xdb, 0xb6, 0x35, 0x84,
+	0x6d, 0x83, 0x54, 0x08, 0x2c, 0x33, 0x61, 0xe0, 0x36, 0x5c, 0xca, 0xe4, 0xe3, 0xe1, 0xf2, 0x12,
+	0x94, 0x9b, 0xba, 0xd5, 0x44, 0x1e, 0xf8, 0x22, 0xa6, 0x7f, 0x5e, 0x9d, 0x65, 0xed, 0xaa, 0x68,
+	0x0e, 0x2e, 0x9f, 0xa0, 0xcc, 0xcf, 0x69, 0xf9, 0x64, 0xa9, 0x10, 0x5f, 0x3e, 0x57, 0xe1, 0x72,
+	0x36, 0x5f, 0x3c, 0x90, 0x83, 0x84, 0x3f, 0xf9, 0x40, 0x4e, 0x1d, 0x3d, 0x3d, 0x90, 0x93, 0x58,
+	0xb8, 0x59, 0x7f, 0x41, 0x03, 0x39, 0x6e, 0x3f, 0x9d, 0xe1, 0x91, 0x0c, 0xfb, 0x45, 0x28, 0x85,
+	0xe3, 0x65, 0x84, 0x28, 0x1e, 0x34, 0xbe, 0x3a, 0x13, 0x0a, 0x39, 0xe5, 0x4a, 0x72, 0xbc, 0x79,
+	0x4c, 0xdc, 0xb8, 0xbf, 0x19, 0x83, 0xda, 0x8e, 0xd9, 0xb2, 0xf4, 0xf6, 0x69, 0x9e, 0x53, 0xf7,
+	0xa1, 0x84, 0xa9, 0x90, 0x88, 0x61, 0x3f, 0x3b, 0xf8, 0x3d, 0x35, 0x73, 0x6c, 0x75, 0x86, 0x89,
+	0x15, 0xaa, 0x98, 0x70, 0x1e, 0x3d, 0x73, 0x91, 0x43, 0x46, 0x4a, 0x38, 0xa7, 0xe5, 0x46, 0x3d,
+	0xa7, 0x2d, 0x0b, 0x69, 0xb1, 0x2e, 0x72, 0xd5, 0x68, 0x1e, 0x98, 0x6d, 0xc3, 0x1f, 0xc7, 0xb6,
+	0xda, 0x7d, 0x7a, 0x28, 0xc8, 0xab, 0x15, 0xda, 0x25, 0x98, 0x5e, 0xb7, 0xda, 0x7d, 0x65, 0x0d,
+	0x2e, 0xa6, 0xda, 0xc2, 0x7d, 0xfd, 0xa7, 0x63, 0xf0, 0x22, 0xa7, 0x31, 0xdd, 0x83, 0x53, 0xbf,
+	0x61, 0xff, 0x9a, 0x04, 0xcb, 0xdc, 0xeb, 0xc7, 0xa6, 0x7b, 0xa0, 0x25, 0x3d, 0x68, 0x3f, 0x18,
+	0x76, 0x02, 0x06, 0x29, 0xa4, 0x2e, 0xe2, 0x30, 0xa1, 0x50, 0x34, 0x9e, 0xf9, 0xce, 0x9d, 0xc5,
+	0x03, 0xe4, 0x6d, 0x58, 0x1f, 0xac, 0x59, 0xf6, 0x53, 0xe4, 0x5f, 0x4b, 0x70, 0x51, 0x45, 0x1d,
+	0xfb, 0x08, 0x31, 0x49, 0x27, 0x4c, 0x96, 0x7f, 0x76, 0x57, 0x82, 0xf0, 0xc1, 0x3e, 0x17, 0x39,
+	0xd8, 0x2b, 0x0a, 0xac, 0xa6, 0xab, 0xcf, 0x43, 0xea, 0x2f, 0x25, 0x58, 0xdb, 0x45, 0x4e, 0xc7,
+	0xb4, 0x74, 0x17, 0x9d, 0x26, 0x98, 0x6c, 0xa8, 0xb8, 0x42, 0x4e, 0x24, 0x86, 0x36, 0x07, 0xc6,
+	0xd0, 0x40, 0x0d, 0xd4, 0xb2, 0x27, 0x5c, 0xe0, 0xd3, 0x65, 0x50, 0xb2, 0xd8, 0xb8, 0x7d, 0x7f,
+	0x22, 0xc1, 0x05, 0x9a, 0xbc, 0x3b, 0x65, 0xb1, 0x87, 0x43, 0x64, 0x8c, 0x5c, 0xec, 0x91, 0x39,
+	0xb2, 0x5a, 0xa4, 0x42, 0x85, 0x3d, 0xaf, 0x42, 0x2d, 0x8d, 0x3c, 0x3b, 0x4c, 0x7f, 0x27, 0x07,
+	0x57, 0xb8, 0x10, 0x86, 0xce, 0xa7, 0x31, 0xb5, 0x93, 0xb2, 0xc3, 0xdc, 0x1b, 0xc2, 0xd6, 0x21,
+	0x54, 0x88, 0x6c, 0x32, 0xf2, 0xb7, 0x03, 0x78, 0xcc, 0xeb, 0x3c, 0xe2, 0xa9, 0xb3, 0xaa, 0x20,
+	0x69, 0x08, 0x0a, 0x91, 0xf4, 0x1a, 0x00, 0xe7, 0xe3, 0x9f, 0x3d, 0x9c, 0x4f, 0xa4, 0xc1, 0xf9,
+	0x3a, 0x5c, 0x1d, 0xe4, 0x11, 0x1e, 0xa2, 0x7f, 0x27, 0xc1, 0x79, 0x71, 0xe7, 0x0b, 0x1e, 0x87,
+	0x7f, 0x2a, 0x20, 0xe6, 0x26, 0x2c, 0x9a, 0x58, 0x4b, 0xa8, 0x40, 0xa1, 0x73, 0x93, 0x57, 0xe7,
+	0x4c, 0x7c, 0x2f, 0x5a, 0x5a, 0x42, 0x12, 0xe6, 0xc9, 0x06, 0x71, 0x8b, 0xff, 0x73, 0x0c, 0x2e,
+	0xb3, 0xe3, 0xf1, 0x16, 0xf1, 0x9b, 0x37, 0xda, 0x49, 0x0e, 0xb3, 0x9f, 0x9d, 0xe9, 0x6b, 0x50,
+	0xf4, 0x43, 0xd2, 0x7f, 0x82, 0xf3, 0xda, 0x1a, 0x86, 0xfc, 0x36, 0xcc, 0x89, 0xb3, 0xae, 0x71,
+	0x9a, 0xb8, 0x93, 0x3d, 0x29, 0xfe, 0xf0, 0xdb, 0xde, 0x29, 0x9d, 0x26, 0x6c, 0x69, 0x3e, 0x64,
+	0x62, 0x94, 0x7c, 0xc8, 0xac, 0xcf, 0x4e, 0x1b, 0x94, 0x17, 0xe1, 0xca, 0x00, 0xaf, 0xf3, 0xf9,
+	0xf9, 0x23, 0x09, 0x56, 0xef, 0x20, 0xdc, 0x74, 0xcc, 0xbd, 0x53, 0xed, 0x09, 0xdf, 0x83, 0xa9,
+	0x51, 0x0f, 0xe0, 0x83, 0x86, 0x55, 0x85, 0x44, 0xe5, 0x07, 0x39, 0x58, 0xcb, 0xa0, 0xe6, 0x98,
+	0xf9, 0x0e, 0x94, 0xfd, 0x84, 0x72, 0xd3, 0xb6, 0xf6, 0xcd, 0x16, 0xbf, 0x90, 0x5f, 0x4f, 0xd6,
+	0x25, 0x71, 0x82, 0xb6, 0x28, 0xa3, 0x3a, 0x8b, 0xc2, 0x0d, 0x72, 0x0b, 0x96, 0x12, 0xf2, 0xd6,
+	0x34, 0x4b, 0xce, 0x0c, 0xde, 0x18, 0x61, 0x10, 0x9a, 0x1b, 0x5f, 0x38, 0x4e, 0x6a, 0x96, 0xdf,
+	0x01, 0xb9, 0x8b, 0x2c, 0xc3, 0xb4, 0x5a, 0x9a, 0xce, 0x4e, 0xe3, 0x26, 0x3d, 0x28, 0x91, 0x0c,
+	0xef, 0xb5, 0xf4, 0x31, 0xb6, 0x19, 0x8f, 0x38, 0xc0, 0xd3, 0x11, 0x2a, 0xdd, 0x50, 0xa3, 0x89,
+	0xb0, 0xfc, 0x2e, 0x94, 0x85, 0x74, 0x0a, 0x64, 0x0e, 0x7d, 0x4c, 0x27, 0xb2, 0x6f, 0x0e, 0x94,
+	0x1d, 0x8e, 0x25, 0x3a, 0xc2, 0x6c, 0x37, 0xd0, 0xe5, 0x20, 0x4b, 0xf9, 0x38, 0x07, 0x55, 0x95,
+	0x97, 0x81, 0x22, 0x1a, 0x8b, 0xf8, 0xcd, 0x1b, 0x3f, 0x15, 0x6b, 0x7c, 0x1f, 0x16, 0xc2, 0x6f,
+	0xb2, 0x7d, 0xcd, 0x74, 0x51, 0x47, 0xb8, 0xf6, 0xc6, 0x48, 0xef, 0xb2, 0xfd, 0x86, 0x8b, 0x3a,
+	0xea, 0xdc, 0x51, 0xac, 0x0d, 0xcb, 0xaf, 0xc1, 0x24, 0x5d, 0xc1, 0xb8, 0x3a, 0x9e, 0x9d, 0xba,
+	0xbb, 0xa3, 0xbb, 0xfa, 0x66, 0xdb, 0xde, 0x53, 0x39, 0xbd, 0x7c, 0x0f, 0x4a, 0xa4, 0x08, 0x92,
+	0x6c, 0xfc, 0x5c, 0xc2, 0xc4, 0x90, 0x12, 0x8a, 0x16, 0x3a, 0x56, 0x7b, 0x6c, 0xed, 0xe3, 0x84,
+	0x63, 0xf6, 0xe4, 0x59, 0x1c, 0xb3, 0xcf, 0xc3, 0x72, 0xc2, 0xcc, 0x72, 0x1c, 0xf9, 0x7d, 0x09,
+	0x16, 0x77, 0xfa, 0x56, 0x73, 0xe7, 0x40, 0x77, 0x0c, 0xfe, 0x00, 0xcc, 0x67, 0xfd, 0x0a, 0x94,
+	0xb0, 0xdd, 0x73, 0x9a, 0x48, 0x6b, 0xb6, 0x7b, 0xd8, 0x45, 0x0e, 0x9f, 0xf7, 0x19, 0xd6, 0xba,
+	0xc5, 0x1a, 0xe5, 0x65, 0xc8, 0x63, 0xc2, 0xec, 0xbf, 0xbd, 0x4d, 0xd1, 0xef, 0x86, 0x21, 0xdf,
+	0x86, 0x69, 0xf6, 0x12, 0xcd, 0x92, 0xad, 0xb9, 0x21, 0x93, 0xad, 0xc0, 0x98, 0x48, 0xb3, 0xb2,
+	0x0c, 0x4b, 0x31, 0xf5, 0xb8, 0xea, 0xff, 0x53, 0x80, 0x39, 0xd2, 0x27, 0x96, 0xce, 0x08, 0xd1,
+	0x7a, 0x11, 0xa6, 0xbd, 0x68, 0xe5, 0x6a, 0x17, 0x54, 0x10, 0x4d, 0x0d, 0x23, 0x70, 0x8e, 0xcb,
+	0x05, 0xce, 0x71, 0x24, 0xd5, 0x2c, 0xde, 0xa3, 0x58, 0xfe, 0x5e, 0x7c, 0x92, 0x41, 0xfd, 0xd4,
+	0xb2, 0xff, 0xfc, 0xe7, 0xb5, 0xd1, 0xc7, 0xee, 0xe8, 0x2b, 0xd4, 0xe4, 0xc9, 0x5e, 0xa1, 0x2e,
+	0x00, 0x88, 0x0c, 0xa6, 0xc9, 0xde, 0x07, 0x73, 0x6a, 0x81, 0xb7, 0x34, 0x8c, 0x58, 0x52, 0x3d,
+	0x7f, 0x92, 0xa4, 0xfa, 0x36, 0x2f, 0x3f, 0xf1, 0x93, 0x72, 0x54, 0x56, 0x61, 0x48, 0x59, 0x15,
+	0xc2, 0xec, 0x25, 0xd3, 0xa8, 0xc4, 0x5b, 0x30, 0x25, 0x72, 0xe3, 0x30, 0x64, 0x6e, 0x5c, 0x30,
+	0x04, 0x53, 0xfc, 0xd3, 0xe1, 0x14, 0xff, 0x16, 0x14, 0xa9, 0x9e, 0xa2, 0x3a, 0xb8, 0x38, 0x64,
+	0x75, 0xf0, 0x34, 0xad, 0xa0, 0x61, 0x1f, 0xa4, 0x50, 0x84, 0x0a, 0x21, 0x01, 0x80, 0x1c, 0xcd,
+	0x34, 0x90, 0xe5, 0x9a, 0x6e, 0x9f, 0x3e, 0xef, 0x15, 0x54, 0x99, 0xf4, 0xbd, 0x45, 0xbb, 0x1a,
+	0xbc, 0x87, 0x14, 0x5b, 0x44, 0x40, 0xa9, 0x5a, 0xca, 0x58, 0xab, 0xa9, 0x70, 0xa4, 0x96, 0xc2,
+	0x50, 0x24, 0x2f, 0xc2, 0x64, 0x57, 0xef, 0x61, 0x64, 0xd0, 0xf7, 0xb9, 0xbc, 0xca, 0xbf, 0xe4,
+	0x79, 0x98, 0xa0, 0xfe, 0xa5, 0x6f, 0x70, 0x13, 0x2a, 0xfb, 0x90, 0xdf, 0x86, 0xe5, 0x60, 0x31,
+	0x4d, 0xb3, 0x6d, 0x63, 0xe4, 0x15, 0xd3, 0x54, 0x86, 0x2b, 0xa6, 0x59, 0xc4, 0x5e, 0xf5, 0xcc,
+	0x16, 0xe1, 0x17, 0xd5, 0x33, 0x11, 0xd9, 0xe1, 0x42, 0x1d, 0x79, 0x64, 0xd9, 0xa1, 0xca, 0x9c,
+	0x5d, 0x58, 0xe4, 0xf2, 0xa2, 0x4a, 0xcf, 0x0d, 0x27, 0x78, 0x8e, 0xb2, 0x47, 0x34, 0x7e, 0x14,
+	0xcc, 0x21, 0x0b, 0x81, 0xf3, 0xc3, 0x09, 0xf4, 0xf3, 0xc3, 0x42, 0xda, 0x3d, 0x28, 0x3a, 0xc8,
+	0x75, 0xfa, 0x5a, 0xd7, 0x6e, 0x9b, 0xcd, 0x7e, 0x75, 0x81, 0x0a, 0xba, 0x94, 0x16, 0xb4, 0x2a,
+	0xa1, 0xdd, 0xa6, 0xa4, 0xea, 0xb4, 0xe3, 0x7f, 0xc8, 0xbb, 0xb0, 0xc0, 0xe4, 0x44, 0x0b, 0xf2,
+	0x17, 0x87, 0x5c, 0x4b, 0x73, 0x94, 0x3d, 0x5c, 0x84, 0xaf, 0x2c, 0xc2, 0x7c, 0x18, 0xfb, 0x38,
+	0x28, 0x92, 0xa2, 0x1c, 0x71, 0xe4, 0xfa, 0x9c, 0x2b, 0x07, 0x95, 0xbf, 0x92, 0xe0, 0x85, 0x64,
+	0x5d, 0xf8, 0xc9, 0x8f, 0x5c, 0xd8, 0xf4, 0xe6, 0x01, 0xd2, 0x3a, 0xac, 0x97, 0x17, 0x45, 0x31,
+	0x9d, 0x2a, 0xb4, 0x2b, 0xc8, 0x27, 0x7f, 0x1d, 0x16, 0x0d, 0xdd, 0xd5, 0xf7, 0x74, 0x1c, 0x65,
+	0x61, 0x08, 0x3e, 0x2f, 0x7a, 0x43, 0x5c, 0xe4, 0xd1, 0xd5, 0x41, 0xc8, 0x07, 0xf3, 0x49, 0xf2,
+	0xd9, 0x30, 0xe4, 0xf3, 0x50, 0xe0, 0x95, 0x03, 0xfc, 0x3d, 0xb6, 0xa0, 0xe6, 0x59, 0x43, 0xc3,
+	0x50, 0xfe, 0x5e, 0x82, 0x15, 0xa1, 0x3c, 0x5f, 0x9c, 0x0f, 0x6c, 0x1c, 0x7c, 0xd2, 0x38, 0xb0,
+	0xb1, 0xab, 0xe9, 0x86, 0xe1, 0x20, 0x8c, 0x85, 0x1f, 0x49, 0xdb, 0x6d, 0xd6, 0x14, 0xdb, 0x18,
+	0x27, 0xfc, 0x8d, 0x31, 0x3a, 0x0b, 0xb9, 0x61, 0x0f, 0x54, 0xe3, 0xa7, 0x3f, 0x50, 0x29, 0x1f,
+	0x8e, 0xc1, 0xf9, 0x44, 0xcb, 0xf8, 0xac, 0x5c, 0x82, 0x19, 0xaa, 0x27, 0xd6, 0xac, 0x5e, 0x67,
+	0x8f, 0x6f, 0xfb, 0x13, 0x6a, 0x91, 0x35, 0x3e, 0xa1, 0x6d, 0xc4, 0x77, 0xc2, 0x38, 0x5c, 0x1d,
+	0x5b, 0xcd, 0xad, 0x4f, 0xa8, 0x79, 0x6e, 0x1d, 0x29, 0xe4, 0x9d, 0xf5, 0xcd, 0xa3, 0xd3, 0x98,
+	0xf9, 0x73, 0x18, 0x8f, 0x96, 0x98, 0xe0, 0xbd, 0x46, 0x6e, 0x11, 0x3e, 0x7a, 0x58, 0x2d, 0x59,
+	0xa1, 0x36, 0xf9, 0x15, 0x58, 0x62, 0x63, 0x37, 0x6d, 0xcb, 0x75, 0xec, 0x76, 0x1b, 0x39, 0xa2,
+	0xe2, 0x8d, 0xcd, 0xe2, 0x02, 0xed, 0xde, 0xf2, 0x7a, 0x79, 0x7d, 0x31, 0xd9, 0x45, 0xf8, 0x74,
+	0xb1, 0x17, 0x76, 0xf1, 0xa9, 0xd4, 0xa1, 0x42, 0x91, 0x84, 0x1e, 0x33, 0xc4, 0x14, 0x07, 0xe7,
+	0x4f, 0x0a, 0xcd, 0x9f, 0x32, 0x0f, 0x72, 0x90, 0x5e, 0x14, 0x99, 0x49, 0x50, 0x61, 0xd9, 0xbc,
+	0x60, 0x6e, 0x20, 0x5d, 0x8c, 0x7c, 0x0f, 0xf2, 0x4d, 0xdd, 0x45, 0x2d, 0xb2, 0x7d, 0x8c, 0xd1,
+	0x5a, 0xbd, 0xaf, 0x64, 0x57, 0x02, 0xb2, 0xf4, 0x3e, 0xe3, 0x50, 0x3d, 0xde, 0x60, 0x59, 0x41,
+	0x2e, 0x54, 0x56, 0xd0, 0x80, 0xd9, 0x23, 0x13, 0x9b, 0x7b, 0x66, 0xdb, 0x74, 0xfb, 0xa3, 0xbd,
+	0x78, 0x97, 0x7c, 0x46, 0x0a, 0x38, 0xf3, 0x20, 0x07, 0x6d, 0xe3, 0x26, 0x7f, 0x28, 0xc1, 0x85,
+	0xfb, 0xc8, 0x55, 0xfd, 0x1f, 0x90, 0x3d, 0x66, 0x3f, 0x1e, 0xf3, 0x4e, 0x91, 0x8f, 0x60, 0x92,
+	0xd6, 0xe5, 0x90, 0x25, 0x92, 0x4b, 0x0d, 0x81, 0xc0, 0x2f, 0xd0, 0x18, 0x98, 0x7a, 0x9f, 0xb4,
+	0x82, 0x47, 0xe5, 0x32, 0xc8, 0xc2, 0xe1, 0x87, 0x51, 0xfa, 0x9e, 0xcd, 0xd7, 0xfd, 0x34, 0x6f,
+	0x23, 0xb1, 0xa3, 0x7c, 0x7f, 0x0c, 0x6a, 0x69, 0x2a, 0xf1, 0x08, 0xff, 0x65, 0x28, 0xb1, 0x29,
+	0xe1, 0xbf, 0x74, 0x13, 0xba, 0x7d, 0x77, 0xc8, 0x07, 0xe0, 0x6c, 0xf1, 0x75, 0x1a, 0x15, 0xa2,
+	0x95, 0xd5, 0xe2, 0xcc, 0xe0, 0x60, 0xdb, 0x4a, 0x1f, 0xe4, 0x38, 0x51, 0xb0, 0x64, 0x66, 0x82,
+	0x95, 0xcc, 0x3c, 0x0e, 0x97, 0xcc, 0xbc, 0x3a, 0xa2, 0xef, 0x3c, 0xcd, 0x02, 0x55, 0x34, 0x1f,
+	0xc0, 0xea, 0x7d, 0xe4, 0xde, 0x79, 0xf4, 0x46, 0xc6, 0x9c, 0xbd, 0xc9, 0x8b, 0x89, 0xc9, 0x2d,
+	0x59, 0xf8, 0x66, 0xd4, 0xb1, 0xbd, 0x52, 0xb2, 0x82, 0xcb, 0xff, 0xc2, 0xca, 0xaf, 0x4b, 0xb0,
+	0x96, 0x31, 0x38, 0x9f, 0x9d, 0xf7, 0xa0, 0x12, 0x10, 0x4b, 0x33, 0x59, 0x42, 0x89, 0x9b, 0x27,
+	0x50, 0x42, 0x2d, 0x3b, 0xe1, 0x06, 0xac, 0xfc, 0xb7, 0x04, 0xf3, 0xb4, 0xbc, 0x48, 0xe0, 0xe5,
+	0x08, 0xbb, 0xe3, 0xeb, 0xd1, 0x84, 0xc9, 0x37, 0x06, 0x26, 0x4c, 0x92, 0x86, 0xf2, 0x92, 0x24,
+	0xf2, 0x21, 0x2c, 0xd1, 0xda, 0x27, 0x82, 0x66, 0xd8, 0xc4, 0x2e, 0xb2, 0x9a, 0x7d, 0xad, 0x8d,
+	0x8e, 0x50, 0x9b, 0x2e, 0xe6, 0xd2, 0x8d, 0x9b, 0xd9, 0x98, 0x40, 0xa5, 0x6f, 0xf9, 0xbc, 0x8f,
+	0x08, 0xab, 0xba, 0xf0, 0x7e, 0x52, 0xb3, 0x72, 0x08, 0x0b, 0x11, 0x6d, 0xb8, 0xd3, 0x55, 0xc8,
+	0x47, 0xaa, 0x21, 0x5e, 0x19, 0xd5, 0x2e, 0xc6, 0xad, 0x7a, 0x72, 0x94, 0xdf, 0x94, 0x60, 0x5e,
+	0x45, 0x7a, 0xb7, 0xdb, 0x66, 0xe9, 0x2e, 0x3c, 0x82, 0x9b, 0x77, 0xa2, 0x6e, 0x4e, 0x2e, 0x66,
+	0x0c, 0xfe, 0x9e, 0x94, 0xcd, 0x7d, 0x7c, 0x38, 0x3f, 0x1f, 0xb5, 0x04, 0x0b, 0x11, 0x02, 0xae,
+	0xe9, 0x9f, 0x8d, 0xc1, 0x02, 0x0b, 0xcc, 0xe8, 0x52, 0xb8, 0x0b, 0xe3, 0x5e, 0xb1, 0x6a, 0x29,
+	0x98, 0x90, 0x4a, 0x9a, 0x0a, 0x72, 0x0d, 0x7f, 0x84, 0x5c, 0x17, 0x39, 0xb4, 0xd0, 0x8a, 0x16,
+	0xe4, 0x50, 0xf6, 0xac, 0xb3, 0x40, 0xfc, 0x9a, 0x9d, 0x4b, 0xba, 0x66, 0xbf, 0x0a, 0x55, 0xd3,
+	0x22, 0x14, 0xe6, 0x11, 0xd2, 0x90, 0xe5, 0x61, 0x97, 0x5f, 0x4b, 0xb6, 0xe0, 0xf5, 0xdf, 0xb5,
+	0x04, 0xb2, 0x34, 0x0c, 0xf9, 0x2b, 0x50, 0xe9, 0xe8, 0xcf, 0xcc, 0x4e, 0xaf, 0xa3, 0x75, 0x09,
+	0x3d, 0x29, 0x01, 0xa4, 0xfb, 0xdf, 0x84, 0x3a, 0xcb, 0x3b, 0xb6, 0xf5, 0x16, 0x22, 0x05, 0x80,
+	0xf2, 0x55, 0x98, 0xa5, 0x55, 0xac, 0x94, 0x90, 0x95, 0x53, 0x4e, 0xd2, 0x72, 0x4a, 0x5a, 0xdc,
+	0x4a, 0xc8, 0xd8, 0x8f, 0x35, 0xfe, 0x8d, 0xfd, 0x02, 0x30, 0xe4, 0x2f, 0x1e, 0x48, 0x67, 0xe4,
+	0xb0, 0x44, 0x10, 0x18, 0x3b, 0x43, 0x10, 0x48, 0xb2, 0x35, 0x97, 0x64, 0xeb, 0x3f, 0x92, 0xdf,
+	0xe1, 0xf4, 0x9c, 0x16, 0xfa, 0x22, 0x46, 0x87, 0xb2, 0x02, 0xd5, 0xb8, 0x71, 0xa2, 0xd6, 0x63,
+	0x0c, 0x96, 0x1e, 0xa3, 0x2f, 0xa8, 0xe5, 0x9f, 0xc9, 0xba, 0xd8, 0x84, 0xea, 0x63, 0x94, 0xec,
+	0xcd, 0x24, 0x19, 0x52, 0x92, 0x8c, 0xef, 0xd3, 0x9f, 0x55, 0xec, 0x3b, 0x08, 0x1f, 0x04, 0x5f,
+	0x66, 0x46, 0x01, 0xcf, 0xb7, 0xa3, 0xe0, 0xf9, 0x73, 0x43, 0x82, 0x67, 0xea, 0xa8, 0x3e, 0x86,
+	0xd2, 0x5f, 0x5a, 0x24, 0xd1, 0xf1, 0xa0, 0xf9, 0x63, 0xfa, 0x22, 0x4f, 0x6b, 0xb7, 0x4f, 0xf3,
+	0x2e, 0xf1, 0x2e, 0x4c, 0xa5, 0xd6, 0x3d, 0x65, 0x9a, 0x90, 0x39, 0xb2, 0x6f, 0x06, 0x7d, 0x78,
+	0x4f, 0xa3, 0xe5, 0xa6, 0xfc, 0xa1, 0x04, 0x17, 0xb6, 0xf5, 0x1e, 0x3e, 0x95, 0x21, 0xef, 0xc0,
+	0x54, 0xea, 0x53, 0x7b, 0x86, 0x21, 0x99, 0xe3, 0xfa, 0x66, 0xac, 0x42, 0x2d, 0x8d, 0x32, 0x30,
+	0x1f, 0x4f, 0xad, 0xee, 0x69, 0xcd, 0x38, 0xe1, 0x7c, 0x0c, 0x18, 0x39, 0x34, 0x1f, 0xe9, 0xb4,
+	0x81, 0xf3, 0x04, 0xb5, 0xf6, 0x04, 0x19, 0xdf, 0x13, 0x9e, 0x27, 0x92, 0x86, 0x0b, 0x9d, 0x27,
+	0x22, 0x04, 0x5c, 0xd3, 0xdf, 0x96, 0x60, 0x91, 0x9b, 0x73, 0x02, 0x5d, 0x9f, 0x46, 0x75, 0xfd,
+	0xd6, 0x28, 0xbe, 0x4e, 0xd5, 0x76, 0x19, 0x96, 0x62, 0x24, 0xa1, 0x93, 0x1a, 0x46, 0xee, 0x4f,
+	0xce, 0xb3, 0x49, 0xc3, 0x45, 0x4e, 0x6a, 0x21, 0x02, 0xae, 0xe9, 0x1f, 0x48, 0xf0, 0xc2, 0xd3,
+	0xae, 0xa1, 0xbb, 0x9e, 0x11, 0xaf, 0x77, 0x49, 0x90, 0xe0, 0x33, 0x7a, 0xf3, 0xcc, 0xf2, 0x6f,
+	0xc6, 0xb0, 0xbe, 0xe6, 0x17, 0xe1, 0x42, 0x0a, 0x21, 0xb7, 0xe0, 0xcf, 0x25, 0xb8, 0xb2, 0xe3,
+	0x3a, 0x48, 0xef, 0xc4, 0x22, 0x5d, 0x24, 0x85, 0x87, 0x37, 0xc5, 0x88, 0x9a, 0xf2, 0x70, 0x28,
+	0x53, 0x86, 0x1a, 0xdf, 0xb7, 0xe9, 0xb7, 0x24, 0xb8, 0x3a, 0x88, 0x85, 0xef, 0x72, 0xad, 0xd8,
+	0x3d, 0xe2, 0xe7, 0xcf, 0x44, 0xa3, 0xe8, 0xe5, 0x62, 0xb3, 0xfb, 0xd1, 0x27, 0xb5, 0x73, 0x1f,
+	0x7f, 0x52, 0x3b, 0xf7, 0xe3, 0x4f, 0x6a, 0xd2, 0xaf, 0x3c, 0xaf, 0x49, 0x3f, 0x78, 0x5e, 0x93,
+	0xfe, 0xf6, 0x79, 0x4d, 0xfa, 0xe8, 0x79, 0x4d, 0xfa, 0xe7, 0xe7, 0x35, 0xe9, 0x5f, 0x9f, 0xd7,
+	0xce, 0xfd, 0xf8, 0x79, 0x4d, 0xfa, 0xf0, 0xd3, 0xda, 0xb9, 0x8f, 0x3e, 0xad, 0x9d, 0xfb, 0xf8,
+	0xd3, 0xda, 0xb9, 0xb7, 0x6f, 0xb5, 0x6c, 0x5f, 0x1d, 0xd3, 0xce, 0xfc, 0x5f, 0x4f, 0xdf, 0x0a,
+	0xb7, 0xec, 0x4d, 0xd2, 0x5c, 0xc9, 0xcd, 0xff, 0x1d, 0x00, 0xc0, 0x70, 0xae, 0xe6, 0x2a, 0x4a,
+	0x00, 0x00,
+}
+
+func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartWorkflowExecutionRequest)
 	if !ok {
-		that2, ok := that.(GetMutableStateResponse)
+		that2, ok := that.(StartWorkflowExecutionRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4671,34 +5430,152 @@ func (this *GetMutableStateResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Execution.Equal(that1.Execution) {
+	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.WorkflowType.Equal(that1.WorkflowType) {
+	if !this.StartRequest.Equal(that1.StartRequest) {
 		return false
 	}
-	if this.NextEventId != that1.NextEventId {
+	if !this.ParentExecutionInfo.Equal(that1.ParentExecutionInfo) {
 		return false
 	}
-	if this.PreviousStartedEventId != that1.PreviousStartedEventId {
+	if this.Attempt != that1.Attempt {
 		return false
 	}
-	if this.LastFirstEventId != that1.LastFirstEventId {
+	if that1.WorkflowExecutionExpirationTime == nil {
+		if this.WorkflowExecutionExpirationTime != nil {
+			return false
+		}
+	} else if !this.WorkflowExecutionExpirationTime.Equal(*that1.WorkflowExecutionExpirationTime) {
 		return false
 	}
-	if !this.TaskQueue.Equal(that1.TaskQueue) {
+	if this.ContinueAsNewInitiator != that1.ContinueAsNewInitiator {
 		return false
 	}
-	if !this.StickyTaskQueue.Equal(that1.StickyTaskQueue) {
+	if !this.ContinuedFailure.Equal(that1.ContinuedFailure) {
 		return false
 	}
-	if this.StickyTaskQueueScheduleToStartTimeout != nil && that1.StickyTaskQueueScheduleToStartTimeout != nil {
-		if *this.StickyTaskQueueScheduleToStartTimeout != *that1.StickyTaskQueueScheduleToStartTimeout {
+	if !this.LastCompletionResult.Equal(that1.LastCompletionResult) {
+		return false
+	}
+	if this.FirstWorkflowTaskBackoff != nil && that1.FirstWorkflowTaskBackoff != nil {
+		if *this.FirstWorkflowTaskBackoff != *that1.FirstWorkflowTaskBackoff {
 			return false
 		}
-	} else if this.StickyTaskQueueScheduleToStartTimeout != nil {
+	} else if this.FirstWorkflowTaskBackoff != nil {
 		return false
-	} else if that1.StickyTaskQueueScheduleToStartTimeout != nil {
+	} else if that1.FirstWorkflowTaskBackoff != nil {
+		return false
+	}
+	if !this.DeadlineRules.Equal(that1.DeadlineRules) {
+		return false
+	}
+	return true
+}
+func (this *StartWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(StartWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	return true
+}
+func (this *GetMutableStateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetMutableStateRequest)
+	if !ok {
+		that2, ok := that.(GetMutableStateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.ExpectedNextEventId != that1.ExpectedNextEventId {
+		return false
+	}
+	if !bytes.Equal(this.CurrentBranchToken, that1.CurrentBranchToken) {
+		return false
+	}
+	return true
+}
+func (this *GetMutableStateResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetMutableStateResponse)
+	if !ok {
+		that2, ok := that.(GetMutableStateResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if !this.WorkflowType.Equal(that1.WorkflowType) {
+		return false
+	}
+	if this.NextEventId != that1.NextEventId {
+		return false
+	}
+	if this.PreviousStartedEventId != that1.PreviousStartedEventId {
+		return false
+	}
+	if this.LastFirstEventId != that1.LastFirstEventId {
+		return false
+	}
+	if !this.TaskQueue.Equal(that1.TaskQueue) {
+		return false
+	}
+	if !this.StickyTaskQueue.Equal(that1.StickyTaskQueue) {
+		return false
+	}
+	if this.StickyTaskQueueScheduleToStartTimeout != nil && that1.StickyTaskQueueScheduleToStartTimeout != nil {
+		if *this.StickyTaskQueueScheduleToStartTimeout != *that1.StickyTaskQueueScheduleToStartTimeout {
+			return false
+		}
+	} else if this.StickyTaskQueueScheduleToStartTimeout != nil {
+		return false
+	} else if that1.StickyTaskQueueScheduleToStartTimeout != nil {
 		return false
 	}
 	if this.EventStoreVersion != that1.EventStoreVersion {
@@ -4719,6 +5596,9 @@ func (this *GetMutableStateResponse) Equal(that interface{}) bool {
 	if this.IsStickyTaskQueueEnabled != that1.IsStickyTaskQueueEnabled {
 		return false
 	}
+	if !this.DeadlineRules.Equal(that1.DeadlineRules) {
+		return false
+	}
 	return true
 }
 func (this *PollMutableStateRequest) Equal(that interface{}) bool {
@@ -4978,6 +5858,12 @@ func (this *RecordWorkflowTaskStartedResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.HistorySizeBytes != that1.HistorySizeBytes {
+		return false
+	}
+	if this.SuggestContinueAsNew != that1.SuggestContinueAsNew {
+		return false
+	}
 	return true
 }
 func (this *RecordActivityTaskStartedRequest) Equal(that interface{}) bool {
@@ -5442,6 +6328,9 @@ func (this *SignalWithStartWorkflowExecutionRequest) Equal(that interface{}) boo
 	if !this.SignalWithStartRequest.Equal(that1.SignalWithStartRequest) {
 		return false
 	}
+	if !this.DeadlineRules.Equal(that1.DeadlineRules) {
+		return false
+	}
 	return true
 }
 func (this *SignalWithStartWorkflowExecutionResponse) Equal(that interface{}) bool {
@@ -5892,6 +6781,9 @@ func (this *ReplicateEventsV2Request) Equal(that interface{}) bool {
 	if !this.NewRunEvents.Equal(that1.NewRunEvents) {
 		return false
 	}
+	if !this.DeadlineRules.Equal(that1.DeadlineRules) {
+		return false
+	}
 	return true
 }
 func (this *ReplicateEventsV2Response) Equal(that interface{}) bool {
@@ -6043,6 +6935,58 @@ func (this *SyncActivityRequest) Equal(that interface{}) bool {
 	if !this.VersionHistory.Equal(that1.VersionHistory) {
 		return false
 	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if this.Stamp != that1.Stamp {
+		return false
+	}
+	if this.ScheduleToCloseTimeout != nil && that1.ScheduleToCloseTimeout != nil {
+		if *this.ScheduleToCloseTimeout != *that1.ScheduleToCloseTimeout {
+			return false
+		}
+	} else if this.ScheduleToCloseTimeout != nil {
+		return false
+	} else if that1.ScheduleToCloseTimeout != nil {
+		return false
+	}
+	if this.ScheduleToStartTimeout != nil && that1.ScheduleToStartTimeout != nil {
+		if *this.ScheduleToStartTimeout != *that1.ScheduleToStartTimeout {
+			return false
+		}
+	} else if this.ScheduleToStartTimeout != nil {
+		return false
+	} else if that1.ScheduleToStartTimeout != nil {
+		return false
+	}
+	if this.StartToCloseTimeout != nil && that1.StartToCloseTimeout != nil {
+		if *this.StartToCloseTimeout != *that1.StartToCloseTimeout {
+			return false
+		}
+	} else if this.StartToCloseTimeout != nil {
+		return false
+	} else if that1.StartToCloseTimeout != nil {
+		return false
+	}
+	if this.HeartbeatTimeout != nil && that1.HeartbeatTimeout != nil {
+		if *this.HeartbeatTimeout != *that1.HeartbeatTimeout {
+			return false
+		}
+	} else if this.HeartbeatTimeout != nil {
+		return false
+	} else if that1.HeartbeatTimeout != nil {
+		return false
+	}
+	if !this.RetryPolicy.Equal(that1.RetryPolicy) {
+		return false
+	}
+	if that1.RetryExpirationTime == nil {
+		if this.RetryExpirationTime != nil {
+			return false
+		}
+	} else if !this.RetryExpirationTime.Equal(*that1.RetryExpirationTime) {
+		return false
+	}
 	return true
 }
 func (this *SyncActivityResponse) Equal(that interface{}) bool {
@@ -6447,6 +7391,9 @@ func (this *QueryWorkflowRequest) Equal(that interface{}) bool {
 	if !this.Request.Equal(that1.Request) {
 		return false
 	}
+	if this.QueryConsistencyLevel != that1.QueryConsistencyLevel {
+		return false
+	}
 	return true
 }
 func (this *QueryWorkflowResponse) Equal(that interface{}) bool {
@@ -6760,612 +7707,1010 @@ func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *StartWorkflowExecutionRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *RestoreWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 13)
-	s = append(s, "&historyservice.StartWorkflowExecutionRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.StartRequest != nil {
-		s = append(s, "StartRequest: "+fmt.Sprintf("%#v", this.StartRequest)+",\n")
+
+	that1, ok := that.(*RestoreWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(RestoreWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.ParentExecutionInfo != nil {
-		s = append(s, "ParentExecutionInfo: "+fmt.Sprintf("%#v", this.ParentExecutionInfo)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
-	s = append(s, "WorkflowExecutionExpirationTime: "+fmt.Sprintf("%#v", this.WorkflowExecutionExpirationTime)+",\n")
-	s = append(s, "ContinueAsNewInitiator: "+fmt.Sprintf("%#v", this.ContinueAsNewInitiator)+",\n")
-	if this.ContinuedFailure != nil {
-		s = append(s, "ContinuedFailure: "+fmt.Sprintf("%#v", this.ContinuedFailure)+",\n")
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	if this.LastCompletionResult != nil {
-		s = append(s, "LastCompletionResult: "+fmt.Sprintf("%#v", this.LastCompletionResult)+",\n")
+	if !this.Request.Equal(that1.Request) {
+		return false
 	}
-	s = append(s, "FirstWorkflowTaskBackoff: "+fmt.Sprintf("%#v", this.FirstWorkflowTaskBackoff)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *StartWorkflowExecutionResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *RestoreWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&historyservice.StartWorkflowExecutionResponse{")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetMutableStateRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*RestoreWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(RestoreWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&historyservice.GetMutableStateRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "ExpectedNextEventId: "+fmt.Sprintf("%#v", this.ExpectedNextEventId)+",\n")
-	s = append(s, "CurrentBranchToken: "+fmt.Sprintf("%#v", this.CurrentBranchToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *GetMutableStateResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 18)
-	s = append(s, "&historyservice.GetMutableStateResponse{")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+func (this *PauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.WorkflowType != nil {
-		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+
+	that1, ok := that.(*PauseWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(PauseWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
-	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
-	s = append(s, "LastFirstEventId: "+fmt.Sprintf("%#v", this.LastFirstEventId)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.StickyTaskQueue != nil {
-		s = append(s, "StickyTaskQueue: "+fmt.Sprintf("%#v", this.StickyTaskQueue)+",\n")
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	s = append(s, "StickyTaskQueueScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.StickyTaskQueueScheduleToStartTimeout)+",\n")
-	s = append(s, "EventStoreVersion: "+fmt.Sprintf("%#v", this.EventStoreVersion)+",\n")
-	s = append(s, "CurrentBranchToken: "+fmt.Sprintf("%#v", this.CurrentBranchToken)+",\n")
-	s = append(s, "WorkflowState: "+fmt.Sprintf("%#v", this.WorkflowState)+",\n")
-	s = append(s, "WorkflowStatus: "+fmt.Sprintf("%#v", this.WorkflowStatus)+",\n")
-	if this.VersionHistories != nil {
-		s = append(s, "VersionHistories: "+fmt.Sprintf("%#v", this.VersionHistories)+",\n")
+	if !this.Request.Equal(that1.Request) {
+		return false
 	}
-	s = append(s, "IsStickyTaskQueueEnabled: "+fmt.Sprintf("%#v", this.IsStickyTaskQueueEnabled)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *PollMutableStateRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&historyservice.PollMutableStateRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+
+	that1, ok := that.(*PauseWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(PauseWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "ExpectedNextEventId: "+fmt.Sprintf("%#v", this.ExpectedNextEventId)+",\n")
-	s = append(s, "CurrentBranchToken: "+fmt.Sprintf("%#v", this.CurrentBranchToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PollMutableStateResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 16)
-	s = append(s, "&historyservice.PollMutableStateResponse{")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	return true
+}
+func (this *UnpauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.WorkflowType != nil {
-		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+
+	that1, ok := that.(*UnpauseWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(UnpauseWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
-	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
-	s = append(s, "LastFirstEventId: "+fmt.Sprintf("%#v", this.LastFirstEventId)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.StickyTaskQueue != nil {
-		s = append(s, "StickyTaskQueue: "+fmt.Sprintf("%#v", this.StickyTaskQueue)+",\n")
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	s = append(s, "StickyTaskQueueScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.StickyTaskQueueScheduleToStartTimeout)+",\n")
-	s = append(s, "CurrentBranchToken: "+fmt.Sprintf("%#v", this.CurrentBranchToken)+",\n")
-	if this.VersionHistories != nil {
-		s = append(s, "VersionHistories: "+fmt.Sprintf("%#v", this.VersionHistories)+",\n")
+	if !this.Request.Equal(that1.Request) {
+		return false
 	}
-	s = append(s, "WorkflowState: "+fmt.Sprintf("%#v", this.WorkflowState)+",\n")
-	s = append(s, "WorkflowStatus: "+fmt.Sprintf("%#v", this.WorkflowStatus)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ResetStickyTaskQueueRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UnpauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.ResetStickyTaskQueueRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+
+	that1, ok := that.(*UnpauseWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(UnpauseWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ResetStickyTaskQueueResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.ResetStickyTaskQueueResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RecordWorkflowTaskStartedRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PauseActivityRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&historyservice.RecordWorkflowTaskStartedRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+
+	that1, ok := that.(*PauseActivityRequest)
+	if !ok {
+		that2, ok := that.(PauseActivityRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
-	if this.PollRequest != nil {
-		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RecordWorkflowTaskStartedResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	s := make([]string, 0, 18)
-	s = append(s, "&historyservice.RecordWorkflowTaskStartedResponse{")
-	if this.WorkflowType != nil {
-		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	if !this.Request.Equal(that1.Request) {
+		return false
 	}
-	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
-	s = append(s, "ScheduledEventId: "+fmt.Sprintf("%#v", this.ScheduledEventId)+",\n")
-	s = append(s, "StartedEventId: "+fmt.Sprintf("%#v", this.StartedEventId)+",\n")
-	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
-	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
-	s = append(s, "StickyExecutionEnabled: "+fmt.Sprintf("%#v", this.StickyExecutionEnabled)+",\n")
-	if this.WorkflowTaskInfo != nil {
-		s = append(s, "WorkflowTaskInfo: "+fmt.Sprintf("%#v", this.WorkflowTaskInfo)+",\n")
+	return true
+}
+func (this *PauseActivityResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.WorkflowExecutionTaskQueue != nil {
-		s = append(s, "WorkflowExecutionTaskQueue: "+fmt.Sprintf("%#v", this.WorkflowExecutionTaskQueue)+",\n")
+
+	that1, ok := that.(*PauseActivityResponse)
+	if !ok {
+		that2, ok := that.(PauseActivityResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "EventStoreVersion: "+fmt.Sprintf("%#v", this.EventStoreVersion)+",\n")
-	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
-	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
-	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
-	keysForQueries := make([]string, 0, len(this.Queries))
-	for k, _ := range this.Queries {
-		keysForQueries = append(keysForQueries, k)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
-	mapStringForQueries := "map[string]*v18.WorkflowQuery{"
-	for _, k := range keysForQueries {
-		mapStringForQueries += fmt.Sprintf("%#v: %#v,", k, this.Queries[k])
+	return true
+}
+func (this *UnpauseActivityRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	mapStringForQueries += "}"
-	if this.Queries != nil {
-		s = append(s, "Queries: "+mapStringForQueries+",\n")
+
+	that1, ok := that.(*UnpauseActivityRequest)
+	if !ok {
+		that2, ok := that.(UnpauseActivityRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RecordActivityTaskStartedRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&historyservice.RecordActivityTaskStartedRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
-	if this.PollRequest != nil {
-		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
+	if !this.Request.Equal(that1.Request) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RecordActivityTaskStartedResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UnpauseActivityResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 11)
-	s = append(s, "&historyservice.RecordActivityTaskStartedResponse{")
-	if this.ScheduledEvent != nil {
-		s = append(s, "ScheduledEvent: "+fmt.Sprintf("%#v", this.ScheduledEvent)+",\n")
+
+	that1, ok := that.(*UnpauseActivityResponse)
+	if !ok {
+		that2, ok := that.(UnpauseActivityResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
-	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
-	s = append(s, "CurrentAttemptScheduledTime: "+fmt.Sprintf("%#v", this.CurrentAttemptScheduledTime)+",\n")
-	if this.HeartbeatDetails != nil {
-		s = append(s, "HeartbeatDetails: "+fmt.Sprintf("%#v", this.HeartbeatDetails)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.WorkflowType != nil {
-		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	return true
+}
+func (this *ResetActivityRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s = append(s, "WorkflowNamespace: "+fmt.Sprintf("%#v", this.WorkflowNamespace)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+	that1, ok := that.(*ResetActivityRequest)
+	if !ok {
+		that2, ok := that.(ResetActivityRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.Request.Equal(that1.Request) {
+		return false
+	}
+	return true
 }
-func (this *RespondWorkflowTaskCompletedRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ResetActivityResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.RespondWorkflowTaskCompletedRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.CompleteRequest != nil {
-		s = append(s, "CompleteRequest: "+fmt.Sprintf("%#v", this.CompleteRequest)+",\n")
+
+	that1, ok := that.(*ResetActivityResponse)
+	if !ok {
+		that2, ok := that.(ResetActivityResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
-func (this *RespondWorkflowTaskCompletedResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UpdateActivityOptionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&historyservice.RespondWorkflowTaskCompletedResponse{")
-	if this.StartedResponse != nil {
-		s = append(s, "StartedResponse: "+fmt.Sprintf("%#v", this.StartedResponse)+",\n")
+
+	that1, ok := that.(*UpdateActivityOptionsRequest)
+	if !ok {
+		that2, ok := that.(UpdateActivityOptionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.Request.Equal(that1.Request) {
+		return false
+	}
+	return true
 }
-func (this *RespondWorkflowTaskFailedRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UpdateActivityOptionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.RespondWorkflowTaskFailedRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.FailedRequest != nil {
-		s = append(s, "FailedRequest: "+fmt.Sprintf("%#v", this.FailedRequest)+",\n")
+
+	that1, ok := that.(*UpdateActivityOptionsResponse)
+	if !ok {
+		that2, ok := that.(UpdateActivityOptionsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
-func (this *RespondWorkflowTaskFailedResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *StreamWorkflowExecutionHistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.RespondWorkflowTaskFailedResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+	that1, ok := that.(*StreamWorkflowExecutionHistoryRequest)
+	if !ok {
+		that2, ok := that.(StreamWorkflowExecutionHistoryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.Request.Equal(that1.Request) {
+		return false
+	}
+	return true
 }
-func (this *RecordActivityTaskHeartbeatRequest) GoString() string {
+func (this *StreamWorkflowExecutionHistoryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StreamWorkflowExecutionHistoryResponse)
+	if !ok {
+		that2, ok := that.(StreamWorkflowExecutionHistoryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Response.Equal(that1.Response) {
+		return false
+	}
+	return true
+}
+func (this *StartWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.RecordActivityTaskHeartbeatRequest{")
+	s := make([]string, 0, 14)
+	s = append(s, "&historyservice.StartWorkflowExecutionRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.HeartbeatRequest != nil {
-		s = append(s, "HeartbeatRequest: "+fmt.Sprintf("%#v", this.HeartbeatRequest)+",\n")
+	if this.StartRequest != nil {
+		s = append(s, "StartRequest: "+fmt.Sprintf("%#v", this.StartRequest)+",\n")
+	}
+	if this.ParentExecutionInfo != nil {
+		s = append(s, "ParentExecutionInfo: "+fmt.Sprintf("%#v", this.ParentExecutionInfo)+",\n")
+	}
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "WorkflowExecutionExpirationTime: "+fmt.Sprintf("%#v", this.WorkflowExecutionExpirationTime)+",\n")
+	s = append(s, "ContinueAsNewInitiator: "+fmt.Sprintf("%#v", this.ContinueAsNewInitiator)+",\n")
+	if this.ContinuedFailure != nil {
+		s = append(s, "ContinuedFailure: "+fmt.Sprintf("%#v", this.ContinuedFailure)+",\n")
+	}
+	if this.LastCompletionResult != nil {
+		s = append(s, "LastCompletionResult: "+fmt.Sprintf("%#v", this.LastCompletionResult)+",\n")
+	}
+	s = append(s, "FirstWorkflowTaskBackoff: "+fmt.Sprintf("%#v", this.FirstWorkflowTaskBackoff)+",\n")
+	if this.DeadlineRules != nil {
+		s = append(s, "DeadlineRules: "+fmt.Sprintf("%#v", this.DeadlineRules)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RecordActivityTaskHeartbeatResponse) GoString() string {
+func (this *StartWorkflowExecutionResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 5)
-	s = append(s, "&historyservice.RecordActivityTaskHeartbeatResponse{")
-	s = append(s, "CancelRequested: "+fmt.Sprintf("%#v", this.CancelRequested)+",\n")
+	s = append(s, "&historyservice.StartWorkflowExecutionResponse{")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RespondActivityTaskCompletedRequest) GoString() string {
+func (this *GetMutableStateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.RespondActivityTaskCompletedRequest{")
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.GetMutableStateRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.CompleteRequest != nil {
-		s = append(s, "CompleteRequest: "+fmt.Sprintf("%#v", this.CompleteRequest)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
+	s = append(s, "ExpectedNextEventId: "+fmt.Sprintf("%#v", this.ExpectedNextEventId)+",\n")
+	s = append(s, "CurrentBranchToken: "+fmt.Sprintf("%#v", this.CurrentBranchToken)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RespondActivityTaskCompletedResponse) GoString() string {
+func (this *GetMutableStateResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.RespondActivityTaskCompletedResponse{")
+	s := make([]string, 0, 19)
+	s = append(s, "&historyservice.GetMutableStateResponse{")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	if this.WorkflowType != nil {
+		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	}
+	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
+	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
+	s = append(s, "LastFirstEventId: "+fmt.Sprintf("%#v", this.LastFirstEventId)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	}
+	if this.StickyTaskQueue != nil {
+		s = append(s, "StickyTaskQueue: "+fmt.Sprintf("%#v", this.StickyTaskQueue)+",\n")
+	}
+	s = append(s, "StickyTaskQueueScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.StickyTaskQueueScheduleToStartTimeout)+",\n")
+	s = append(s, "EventStoreVersion: "+fmt.Sprintf("%#v", this.EventStoreVersion)+",\n")
+	s = append(s, "CurrentBranchToken: "+fmt.Sprintf("%#v", this.CurrentBranchToken)+",\n")
+	s = append(s, "WorkflowState: "+fmt.Sprintf("%#v", this.WorkflowState)+",\n")
+	s = append(s, "WorkflowStatus: "+fmt.Sprintf("%#v", this.WorkflowStatus)+",\n")
+	if this.VersionHistories != nil {
+		s = append(s, "VersionHistories: "+fmt.Sprintf("%#v", this.VersionHistories)+",\n")
+	}
+	s = append(s, "IsStickyTaskQueueEnabled: "+fmt.Sprintf("%#v", this.IsStickyTaskQueueEnabled)+",\n")
+	if this.DeadlineRules != nil {
+		s = append(s, "DeadlineRules: "+fmt.Sprintf("%#v", this.DeadlineRules)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RespondActivityTaskFailedRequest) GoString() string {
+func (this *PollMutableStateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.RespondActivityTaskFailedRequest{")
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.PollMutableStateRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.FailedRequest != nil {
-		s = append(s, "FailedRequest: "+fmt.Sprintf("%#v", this.FailedRequest)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
+	s = append(s, "ExpectedNextEventId: "+fmt.Sprintf("%#v", this.ExpectedNextEventId)+",\n")
+	s = append(s, "CurrentBranchToken: "+fmt.Sprintf("%#v", this.CurrentBranchToken)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RespondActivityTaskFailedResponse) GoString() string {
+func (this *PollMutableStateResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.RespondActivityTaskFailedResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RespondActivityTaskCanceledRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	s := make([]string, 0, 16)
+	s = append(s, "&historyservice.PollMutableStateResponse{")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	if this.WorkflowType != nil {
+		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	}
+	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
+	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
+	s = append(s, "LastFirstEventId: "+fmt.Sprintf("%#v", this.LastFirstEventId)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	}
+	if this.StickyTaskQueue != nil {
+		s = append(s, "StickyTaskQueue: "+fmt.Sprintf("%#v", this.StickyTaskQueue)+",\n")
+	}
+	s = append(s, "StickyTaskQueueScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.StickyTaskQueueScheduleToStartTimeout)+",\n")
+	s = append(s, "CurrentBranchToken: "+fmt.Sprintf("%#v", this.CurrentBranchToken)+",\n")
+	if this.VersionHistories != nil {
+		s = append(s, "VersionHistories: "+fmt.Sprintf("%#v", this.VersionHistories)+",\n")
+	}
+	s = append(s, "WorkflowState: "+fmt.Sprintf("%#v", this.WorkflowState)+",\n")
+	s = append(s, "WorkflowStatus: "+fmt.Sprintf("%#v", this.WorkflowStatus)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResetStickyTaskQueueRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
 	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.RespondActivityTaskCanceledRequest{")
+	s = append(s, "&historyservice.ResetStickyTaskQueueRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.CancelRequest != nil {
-		s = append(s, "CancelRequest: "+fmt.Sprintf("%#v", this.CancelRequest)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RespondActivityTaskCanceledResponse) GoString() string {
+func (this *ResetStickyTaskQueueResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.RespondActivityTaskCanceledResponse{")
+	s = append(s, "&historyservice.ResetStickyTaskQueueResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *SignalWorkflowExecutionRequest) GoString() string {
+func (this *RecordWorkflowTaskStartedRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&historyservice.SignalWorkflowExecutionRequest{")
+	s := make([]string, 0, 10)
+	s = append(s, "&historyservice.RecordWorkflowTaskStartedRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.SignalRequest != nil {
-		s = append(s, "SignalRequest: "+fmt.Sprintf("%#v", this.SignalRequest)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
 	}
-	if this.ExternalWorkflowExecution != nil {
-		s = append(s, "ExternalWorkflowExecution: "+fmt.Sprintf("%#v", this.ExternalWorkflowExecution)+",\n")
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	if this.PollRequest != nil {
+		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
 	}
-	s = append(s, "ChildWorkflowOnly: "+fmt.Sprintf("%#v", this.ChildWorkflowOnly)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *SignalWorkflowExecutionResponse) GoString() string {
+func (this *RecordWorkflowTaskStartedResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.SignalWorkflowExecutionResponse{")
+	s := make([]string, 0, 20)
+	s = append(s, "&historyservice.RecordWorkflowTaskStartedResponse{")
+	if this.WorkflowType != nil {
+		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	}
+	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
+	s = append(s, "ScheduledEventId: "+fmt.Sprintf("%#v", this.ScheduledEventId)+",\n")
+	s = append(s, "StartedEventId: "+fmt.Sprintf("%#v", this.StartedEventId)+",\n")
+	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "StickyExecutionEnabled: "+fmt.Sprintf("%#v", this.StickyExecutionEnabled)+",\n")
+	if this.WorkflowTaskInfo != nil {
+		s = append(s, "WorkflowTaskInfo: "+fmt.Sprintf("%#v", this.WorkflowTaskInfo)+",\n")
+	}
+	if this.WorkflowExecutionTaskQueue != nil {
+		s = append(s, "WorkflowExecutionTaskQueue: "+fmt.Sprintf("%#v", this.WorkflowExecutionTaskQueue)+",\n")
+	}
+	s = append(s, "EventStoreVersion: "+fmt.Sprintf("%#v", this.EventStoreVersion)+",\n")
+	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
+	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
+	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
+	keysForQueries := make([]string, 0, len(this.Queries))
+	for k, _ := range this.Queries {
+		keysForQueries = append(keysForQueries, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
+	mapStringForQueries := "map[string]*v18.WorkflowQuery{"
+	for _, k := range keysForQueries {
+		mapStringForQueries += fmt.Sprintf("%#v: %#v,", k, this.Queries[k])
+	}
+	mapStringForQueries += "}"
+	if this.Queries != nil {
+		s = append(s, "Queries: "+mapStringForQueries+",\n")
+	}
+	s = append(s, "HistorySizeBytes: "+fmt.Sprintf("%#v", this.HistorySizeBytes)+",\n")
+	s = append(s, "SuggestContinueAsNew: "+fmt.Sprintf("%#v", this.SuggestContinueAsNew)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *SignalWithStartWorkflowExecutionRequest) GoString() string {
+func (this *RecordActivityTaskStartedRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.SignalWithStartWorkflowExecutionRequest{")
+	s := make([]string, 0, 10)
+	s = append(s, "&historyservice.RecordActivityTaskStartedRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.SignalWithStartRequest != nil {
-		s = append(s, "SignalWithStartRequest: "+fmt.Sprintf("%#v", this.SignalWithStartRequest)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	if this.PollRequest != nil {
+		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *SignalWithStartWorkflowExecutionResponse) GoString() string {
+func (this *RecordActivityTaskStartedResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&historyservice.SignalWithStartWorkflowExecutionResponse{")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s := make([]string, 0, 11)
+	s = append(s, "&historyservice.RecordActivityTaskStartedResponse{")
+	if this.ScheduledEvent != nil {
+		s = append(s, "ScheduledEvent: "+fmt.Sprintf("%#v", this.ScheduledEvent)+",\n")
+	}
+	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "CurrentAttemptScheduledTime: "+fmt.Sprintf("%#v", this.CurrentAttemptScheduledTime)+",\n")
+	if this.HeartbeatDetails != nil {
+		s = append(s, "HeartbeatDetails: "+fmt.Sprintf("%#v", this.HeartbeatDetails)+",\n")
+	}
+	if this.WorkflowType != nil {
+		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	}
+	s = append(s, "WorkflowNamespace: "+fmt.Sprintf("%#v", this.WorkflowNamespace)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RemoveSignalMutableStateRequest) GoString() string {
+func (this *RespondWorkflowTaskCompletedRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&historyservice.RemoveSignalMutableStateRequest{")
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.RespondWorkflowTaskCompletedRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	if this.CompleteRequest != nil {
+		s = append(s, "CompleteRequest: "+fmt.Sprintf("%#v", this.CompleteRequest)+",\n")
 	}
-	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RemoveSignalMutableStateResponse) GoString() string {
+func (this *RespondWorkflowTaskCompletedResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.RemoveSignalMutableStateResponse{")
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.RespondWorkflowTaskCompletedResponse{")
+	if this.StartedResponse != nil {
+		s = append(s, "StartedResponse: "+fmt.Sprintf("%#v", this.StartedResponse)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *TerminateWorkflowExecutionRequest) GoString() string {
+func (this *RespondWorkflowTaskFailedRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.TerminateWorkflowExecutionRequest{")
+	s = append(s, "&historyservice.RespondWorkflowTaskFailedRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.TerminateRequest != nil {
-		s = append(s, "TerminateRequest: "+fmt.Sprintf("%#v", this.TerminateRequest)+",\n")
+	if this.FailedRequest != nil {
+		s = append(s, "FailedRequest: "+fmt.Sprintf("%#v", this.FailedRequest)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *TerminateWorkflowExecutionResponse) GoString() string {
+func (this *RespondWorkflowTaskFailedResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.TerminateWorkflowExecutionResponse{")
+	s = append(s, "&historyservice.RespondWorkflowTaskFailedResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *ResetWorkflowExecutionRequest) GoString() string {
+func (this *RecordActivityTaskHeartbeatRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.ResetWorkflowExecutionRequest{")
+	s = append(s, "&historyservice.RecordActivityTaskHeartbeatRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.ResetRequest != nil {
-		s = append(s, "ResetRequest: "+fmt.Sprintf("%#v", this.ResetRequest)+",\n")
+	if this.HeartbeatRequest != nil {
+		s = append(s, "HeartbeatRequest: "+fmt.Sprintf("%#v", this.HeartbeatRequest)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *ResetWorkflowExecutionResponse) GoString() string {
+func (this *RecordActivityTaskHeartbeatResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 5)
-	s = append(s, "&historyservice.ResetWorkflowExecutionResponse{")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "&historyservice.RecordActivityTaskHeartbeatResponse{")
+	s = append(s, "CancelRequested: "+fmt.Sprintf("%#v", this.CancelRequested)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RequestCancelWorkflowExecutionRequest) GoString() string {
+func (this *RespondActivityTaskCompletedRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&historyservice.RequestCancelWorkflowExecutionRequest{")
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.RespondActivityTaskCompletedRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.CancelRequest != nil {
-		s = append(s, "CancelRequest: "+fmt.Sprintf("%#v", this.CancelRequest)+",\n")
-	}
-	s = append(s, "ExternalInitiatedEventId: "+fmt.Sprintf("%#v", this.ExternalInitiatedEventId)+",\n")
-	if this.ExternalWorkflowExecution != nil {
-		s = append(s, "ExternalWorkflowExecution: "+fmt.Sprintf("%#v", this.ExternalWorkflowExecution)+",\n")
+	if this.CompleteRequest != nil {
+		s = append(s, "CompleteRequest: "+fmt.Sprintf("%#v", this.CompleteRequest)+",\n")
 	}
-	s = append(s, "ChildWorkflowOnly: "+fmt.Sprintf("%#v", this.ChildWorkflowOnly)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RequestCancelWorkflowExecutionResponse) GoString() string {
+func (this *RespondActivityTaskCompletedResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.RequestCancelWorkflowExecutionResponse{")
+	s = append(s, "&historyservice.RespondActivityTaskCompletedResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *ScheduleWorkflowTaskRequest) GoString() string {
+func (this *RespondActivityTaskFailedRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&historyservice.ScheduleWorkflowTaskRequest{")
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.RespondActivityTaskFailedRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	if this.FailedRequest != nil {
+		s = append(s, "FailedRequest: "+fmt.Sprintf("%#v", this.FailedRequest)+",\n")
 	}
-	s = append(s, "IsFirstWorkflowTask: "+fmt.Sprintf("%#v", this.IsFirstWorkflowTask)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *ScheduleWorkflowTaskResponse) GoString() string {
+func (this *RespondActivityTaskFailedResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.ScheduleWorkflowTaskResponse{")
+	s = append(s, "&historyservice.RespondActivityTaskFailedResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RecordChildExecutionCompletedRequest) GoString() string {
+func (this *RespondActivityTaskCanceledRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&historyservice.RecordChildExecutionCompletedRequest{")
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.RespondActivityTaskCanceledRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
-	}
-	s = append(s, "InitiatedId: "+fmt.Sprintf("%#v", this.InitiatedId)+",\n")
-	if this.CompletedExecution != nil {
-		s = append(s, "CompletedExecution: "+fmt.Sprintf("%#v", this.CompletedExecution)+",\n")
-	}
-	if this.CompletionEvent != nil {
-		s = append(s, "CompletionEvent: "+fmt.Sprintf("%#v", this.CompletionEvent)+",\n")
+	if this.CancelRequest != nil {
+		s = append(s, "CancelRequest: "+fmt.Sprintf("%#v", this.CancelRequest)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RecordChildExecutionCompletedResponse) GoString() string {
+func (this *RespondActivityTaskCanceledResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.RecordChildExecutionCompletedResponse{")
+	s = append(s, "&historyservice.RespondActivityTaskCanceledResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *DescribeWorkflowExecutionRequest) GoString() string {
+func (this *SignalWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.DescribeWorkflowExecutionRequest{")
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.SignalWorkflowExecutionRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Request != nil {
-		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	if this.SignalRequest != nil {
+		s = append(s, "SignalRequest: "+fmt.Sprintf("%#v", this.SignalRequest)+",\n")
+	}
+	if this.ExternalWorkflowExecution != nil {
+		s = append(s, "ExternalWorkflowExecution: "+fmt.Sprintf("%#v", this.ExternalWorkflowExecution)+",\n")
 	}
+	s = append(s, "ChildWorkflowOnly: "+fmt.Sprintf("%#v", this.ChildWorkflowOnly)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *DescribeWorkflowExecutionResponse) GoString() string {
+func (this *SignalWorkflowExecutionResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&historyservice.DescribeWorkflowExecutionResponse{")
-	if this.ExecutionConfig != nil {
-		s = append(s, "ExecutionConfig: "+fmt.Sprintf("%#v", this.ExecutionConfig)+",\n")
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.SignalWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SignalWithStartWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.SignalWithStartWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.SignalWithStartRequest != nil {
+		s = append(s, "SignalWithStartRequest: "+fmt.Sprintf("%#v", this.SignalWithStartRequest)+",\n")
+	}
+	if this.DeadlineRules != nil {
+		s = append(s, "DeadlineRules: "+fmt.Sprintf("%#v", this.DeadlineRules)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SignalWithStartWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.SignalWithStartWorkflowExecutionResponse{")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RemoveSignalMutableStateRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.RemoveSignalMutableStateRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RemoveSignalMutableStateResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.RemoveSignalMutableStateResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TerminateWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.TerminateWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.TerminateRequest != nil {
+		s = append(s, "TerminateRequest: "+fmt.Sprintf("%#v", this.TerminateRequest)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TerminateWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.TerminateWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResetWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.ResetWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.ResetRequest != nil {
+		s = append(s, "ResetRequest: "+fmt.Sprintf("%#v", this.ResetRequest)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResetWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.ResetWorkflowExecutionResponse{")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RequestCancelWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&historyservice.RequestCancelWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.CancelRequest != nil {
+		s = append(s, "CancelRequest: "+fmt.Sprintf("%#v", this.CancelRequest)+",\n")
+	}
+	s = append(s, "ExternalInitiatedEventId: "+fmt.Sprintf("%#v", this.ExternalInitiatedEventId)+",\n")
+	if this.ExternalWorkflowExecution != nil {
+		s = append(s, "ExternalWorkflowExecution: "+fmt.Sprintf("%#v", this.ExternalWorkflowExecution)+",\n")
+	}
+	s = append(s, "ChildWorkflowOnly: "+fmt.Sprintf("%#v", this.ChildWorkflowOnly)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RequestCancelWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.RequestCancelWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduleWorkflowTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.ScheduleWorkflowTaskRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "IsFirstWorkflowTask: "+fmt.Sprintf("%#v", this.IsFirstWorkflowTask)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduleWorkflowTaskResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.ScheduleWorkflowTaskResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RecordChildExecutionCompletedRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&historyservice.RecordChildExecutionCompletedRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "InitiatedId: "+fmt.Sprintf("%#v", this.InitiatedId)+",\n")
+	if this.CompletedExecution != nil {
+		s = append(s, "CompletedExecution: "+fmt.Sprintf("%#v", this.CompletedExecution)+",\n")
+	}
+	if this.CompletionEvent != nil {
+		s = append(s, "CompletionEvent: "+fmt.Sprintf("%#v", this.CompletionEvent)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RecordChildExecutionCompletedResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.RecordChildExecutionCompletedResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.DescribeWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.DescribeWorkflowExecutionResponse{")
+	if this.ExecutionConfig != nil {
+		s = append(s, "ExecutionConfig: "+fmt.Sprintf("%#v", this.ExecutionConfig)+",\n")
 	}
 	if this.WorkflowExecutionInfo != nil {
 		s = append(s, "WorkflowExecutionInfo: "+fmt.Sprintf("%#v", this.WorkflowExecutionInfo)+",\n")
@@ -7383,7 +8728,7 @@ func (this *ReplicateEventsV2Request) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 10)
 	s = append(s, "&historyservice.ReplicateEventsV2Request{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.WorkflowExecution != nil {
@@ -7398,6 +8743,9 @@ func (this *ReplicateEventsV2Request) GoString() string {
 	if this.NewRunEvents != nil {
 		s = append(s, "NewRunEvents: "+fmt.Sprintf("%#v", this.NewRunEvents)+",\n")
 	}
+	if this.DeadlineRules != nil {
+		s = append(s, "DeadlineRules: "+fmt.Sprintf("%#v", this.DeadlineRules)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -7435,7 +8783,7 @@ func (this *SyncActivityRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 18)
+	s := make([]string, 0, 26)
 	s = append(s, "&historyservice.SyncActivityRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -7457,6 +8805,16 @@ func (this *SyncActivityRequest) GoString() string {
 	if this.VersionHistory != nil {
 		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
 	}
+	s = append(s, "Paused: "+fmt.Sprintf("%#v", this.Paused)+",\n")
+	s = append(s, "Stamp: "+fmt.Sprintf("%#v", this.Stamp)+",\n")
+	s = append(s, "ScheduleToCloseTimeout: "+fmt.Sprintf("%#v", this.ScheduleToCloseTimeout)+",\n")
+	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
+	s = append(s, "StartToCloseTimeout: "+fmt.Sprintf("%#v", this.StartToCloseTimeout)+",\n")
+	s = append(s, "HeartbeatTimeout: "+fmt.Sprintf("%#v", this.HeartbeatTimeout)+",\n")
+	if this.RetryPolicy != nil {
+		s = append(s, "RetryPolicy: "+fmt.Sprintf("%#v", this.RetryPolicy)+",\n")
+	}
+	s = append(s, "RetryExpirationTime: "+fmt.Sprintf("%#v", this.RetryExpirationTime)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -7630,12 +8988,13 @@ func (this *QueryWorkflowRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&historyservice.QueryWorkflowRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.Request != nil {
 		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
 	}
+	s = append(s, "QueryConsistencyLevel: "+fmt.Sprintf("%#v", this.QueryConsistencyLevel)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -7771,60 +9130,251 @@ func (this *RefreshWorkflowTasksResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func valueToGoStringRequestResponse(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *RestoreWorkflowExecutionRequest) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.RestoreWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
 	}
-	return dAtA[:n], nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *StartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *RestoreWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.RestoreWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FirstWorkflowTaskBackoff != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.FirstWorkflowTaskBackoff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x4a
+func (this *PauseWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.LastCompletionResult != nil {
-		{
-			size, err := m.LastCompletionResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.PauseWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
 	}
-	if m.ContinuedFailure != nil {
-		{
-			size, err := m.ContinuedFailure.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PauseWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.PauseWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnpauseWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.UnpauseWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnpauseWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.UnpauseWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PauseActivityRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.PauseActivityRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PauseActivityResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.PauseActivityResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnpauseActivityRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.UnpauseActivityRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnpauseActivityResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.UnpauseActivityResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResetActivityRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.ResetActivityRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResetActivityResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.ResetActivityResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateActivityOptionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.UpdateActivityOptionsRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateActivityOptionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.UpdateActivityOptionsResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StreamWorkflowExecutionHistoryRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.StreamWorkflowExecutionHistoryRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StreamWorkflowExecutionHistoryResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.StreamWorkflowExecutionHistoryResponse{")
+	if this.Response != nil {
+		s = append(s, "Response: "+fmt.Sprintf("%#v", this.Response)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRequestResponse(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadlineRules != nil {
+		{
+			size, err := m.DeadlineRules.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.FirstWorkflowTaskBackoff != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.FirstWorkflowTaskBackoff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.LastCompletionResult != nil {
+		{
+			size, err := m.LastCompletionResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ContinuedFailure != nil {
+		{
+			size, err := m.ContinuedFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
 			i -= size
@@ -7839,12 +9389,12 @@ func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		dAtA[i] = 0x30
 	}
 	if m.WorkflowExecutionExpirationTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -7991,6 +9541,20 @@ func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.DeadlineRules != nil {
+		{
+			size, err := m.DeadlineRules.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.IsStickyTaskQueueEnabled {
 		i--
 		if m.IsStickyTaskQueueEnabled {
@@ -8042,12 +9606,12 @@ func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x60
 	}
 	if m.StickyTaskQueueScheduleToStartTimeout != nil {
-		n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyTaskQueueScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout):])
-		if err9 != nil {
-			return 0, err9
+		n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyTaskQueueScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n9
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n9))
+		i -= n11
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -8223,12 +9787,12 @@ func (m *PollMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		dAtA[i] = 0x62
 	}
 	if m.StickyTaskQueueScheduleToStartTimeout != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyTaskQueueScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout):])
-		if err16 != nil {
-			return 0, err16
+		n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyTaskQueueScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout):])
+		if err18 != nil {
+			return 0, err18
 		}
-		i -= n16
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n16))
+		i -= n18
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n18))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -8454,6 +10018,23 @@ func (m *RecordWorkflowTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if m.SuggestContinueAsNew {
+		i--
+		if m.SuggestContinueAsNew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.HistorySizeBytes != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.HistorySizeBytes))
+		i--
+		dAtA[i] = 0x78
+	}
 	if len(m.Queries) > 0 {
 		for k := range m.Queries {
 			v := m.Queries[k]
@@ -8481,22 +10062,22 @@ func (m *RecordWorkflowTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		}
 	}
 	if m.StartedTime != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err25 != nil {
-			return 0, err25
+		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err27 != nil {
+			return 0, err27
 		}
-		i -= n25
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n25))
+		i -= n27
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n27))
 		i--
 		dAtA[i] = 0x6a
 	}
 	if m.ScheduledTime != nil {
-		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err26 != nil {
-			return 0, err26
+		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err28 != nil {
+			return 0, err28
 		}
-		i -= n26
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n26))
+		i -= n28
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n28))
 		i--
 		dAtA[i] = 0x62
 	}
@@ -8709,12 +10290,12 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		dAtA[i] = 0x2a
 	}
 	if m.CurrentAttemptScheduledTime != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CurrentAttemptScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime):])
-		if err34 != nil {
-			return 0, err34
+		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CurrentAttemptScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime):])
+		if err36 != nil {
+			return 0, err36
 		}
-		i -= n34
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n34))
+		i -= n36
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n36))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -8724,12 +10305,12 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		dAtA[i] = 0x18
 	}
 	if m.StartedTime != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err35 != nil {
-			return 0, err35
+		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err37 != nil {
+			return 0, err37
 		}
-		i -= n35
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n35))
+		i -= n37
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n37))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -9267,6 +10848,18 @@ func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []by
 	_ = i
 	var l int
 	_ = l
+	if m.DeadlineRules != nil {
+		{
+			size, err := m.DeadlineRules.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.SignalWithStartRequest != nil {
 		{
 			size, err := m.SignalWithStartRequest.MarshalToSizedBuffer(dAtA[:i])
@@ -9926,6 +11519,18 @@ func (m *ReplicateEventsV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.DeadlineRules != nil {
+		{
+			size, err := m.DeadlineRules.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.NewRunEvents != nil {
 		{
 			size, err := m.NewRunEvents.MarshalToSizedBuffer(dAtA[:i])
@@ -10030,12 +11635,12 @@ func (m *SyncShardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if m.StatusTime != nil {
-		n62, err62 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
-		if err62 != nil {
-			return 0, err62
+		n66, err66 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
+		if err66 != nil {
+			return 0, err66
 		}
-		i -= n62
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n62))
+		i -= n66
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n66))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -10097,28 +11702,21 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.VersionHistory != nil {
-		{
-			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	if m.RetryExpirationTime != nil {
+		n67, err67 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
+		if err67 != nil {
+			return 0, err67
 		}
+		i -= n67
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n67))
 		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.LastWorkerIdentity) > 0 {
-		i -= len(m.LastWorkerIdentity)
-		copy(dAtA[i:], m.LastWorkerIdentity)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.LastWorkerIdentity)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0xb2
 	}
-	if m.LastFailure != nil {
+	if m.RetryPolicy != nil {
 		{
-			size, err := m.LastFailure.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10126,12 +11724,110 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x62
-	}
-	if m.Attempt != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0xaa
+	}
+	if m.HeartbeatTimeout != nil {
+		n69, err69 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
+		if err69 != nil {
+			return 0, err69
+		}
+		i -= n69
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n69))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.StartToCloseTimeout != nil {
+		n70, err70 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		if err70 != nil {
+			return 0, err70
+		}
+		i -= n70
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n70))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.ScheduleToStartTimeout != nil {
+		n71, err71 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err71 != nil {
+			return 0, err71
+		}
+		i -= n71
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n71))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		n72, err72 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
+		if err72 != nil {
+			return 0, err72
+		}
+		i -= n72
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n72))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Stamp != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Stamp))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.VersionHistory != nil {
+		{
+			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.LastWorkerIdentity) > 0 {
+		i -= len(m.LastWorkerIdentity)
+		copy(dAtA[i:], m.LastWorkerIdentity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.LastWorkerIdentity)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.LastFailure != nil {
+		{
+			size, err := m.LastFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x58
 	}
 	if m.Details != nil {
 		{
@@ -10146,22 +11842,22 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.LastHeartbeatTime != nil {
-		n66, err66 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
-		if err66 != nil {
-			return 0, err66
+		n76, err76 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
+		if err76 != nil {
+			return 0, err76
 		}
-		i -= n66
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n66))
+		i -= n76
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n76))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.StartedTime != nil {
-		n67, err67 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err67 != nil {
-			return 0, err67
+		n77, err77 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err77 != nil {
+			return 0, err77
 		}
-		i -= n67
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n67))
+		i -= n77
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n77))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -10171,12 +11867,12 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x38
 	}
 	if m.ScheduledTime != nil {
-		n68, err68 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err68 != nil {
-			return 0, err68
+		n78, err78 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err78 != nil {
+			return 0, err78
 		}
-		i -= n68
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n68))
+		i -= n78
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n78))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -10431,21 +12127,21 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x1a
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA73 := make([]byte, len(m.ShardIds)*10)
-		var j72 int
+		dAtA83 := make([]byte, len(m.ShardIds)*10)
+		var j82 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA73[j72] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA83[j82] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j72++
+				j82++
 			}
-			dAtA73[j72] = uint8(num)
-			j72++
+			dAtA83[j82] = uint8(num)
+			j82++
 		}
-		i -= j72
-		copy(dAtA[i:], dAtA73[:j72])
-		i = encodeVarintRequestResponse(dAtA, i, uint64(j72))
+		i -= j82
+		copy(dAtA[i:], dAtA83[:j82])
+		i = encodeVarintRequestResponse(dAtA, i, uint64(j82))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -10529,12 +12225,12 @@ func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n74, err74 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err74 != nil {
-			return 0, err74
+		n84, err84 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err84 != nil {
+			return 0, err84
 		}
-		i -= n74
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n74))
+		i -= n84
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n84))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -10764,6 +12460,11 @@ func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.QueryConsistencyLevel != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.QueryConsistencyLevel))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Request != nil {
 		{
 			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
@@ -11212,586 +12913,550 @@ func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRequestResponse(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RestoreWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StartWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RestoreWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StartRequest != nil {
-		l = m.StartRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ParentExecutionInfo != nil {
-		l = m.ParentExecutionInfo.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Attempt))
-	}
-	if m.WorkflowExecutionExpirationTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ContinueAsNewInitiator != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ContinueAsNewInitiator))
-	}
-	if m.ContinuedFailure != nil {
-		l = m.ContinuedFailure.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.LastCompletionResult != nil {
-		l = m.LastCompletionResult.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.FirstWorkflowTaskBackoff != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StartWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RestoreWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetMutableStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ExpectedNextEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ExpectedNextEventId))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.CurrentBranchToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetMutableStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.NextEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.NextEventId))
-	}
-	if m.PreviousStartedEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
-	}
-	if m.LastFirstEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.LastFirstEventId))
-	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StickyTaskQueue != nil {
-		l = m.StickyTaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StickyTaskQueueScheduleToStartTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.EventStoreVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EventStoreVersion))
-	}
-	l = len(m.CurrentBranchToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowState != 0 {
-		n += 1 + sovRequestResponse(uint64(m.WorkflowState))
-	}
-	if m.WorkflowStatus != 0 {
-		n += 2 + sovRequestResponse(uint64(m.WorkflowStatus))
-	}
-	if m.VersionHistories != nil {
-		l = m.VersionHistories.Size()
-		n += 2 + l + sovRequestResponse(uint64(l))
-	}
-	if m.IsStickyTaskQueueEnabled {
-		n += 3
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PollMutableStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnpauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ExpectedNextEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ExpectedNextEventId))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.CurrentBranchToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PollMutableStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnpauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.NextEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.NextEventId))
-	}
-	if m.PreviousStartedEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
-	}
-	if m.LastFirstEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.LastFirstEventId))
-	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StickyTaskQueue != nil {
-		l = m.StickyTaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StickyTaskQueueScheduleToStartTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.CurrentBranchToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.VersionHistories != nil {
-		l = m.VersionHistories.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowState != 0 {
-		n += 1 + sovRequestResponse(uint64(m.WorkflowState))
-	}
-	if m.WorkflowStatus != 0 {
-		n += 2 + sovRequestResponse(uint64(m.WorkflowStatus))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ResetStickyTaskQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PauseActivityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseActivityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ResetStickyTaskQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PauseActivityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseActivityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordWorkflowTaskStartedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnpauseActivityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseActivityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.ScheduleId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ScheduleId))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskId))
-	}
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.PollRequest != nil {
-		l = m.PollRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordWorkflowTaskStartedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.PreviousStartedEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
-	}
-	if m.ScheduledEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ScheduledEventId))
-	}
-	if m.StartedEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartedEventId))
-	}
-	if m.NextEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.NextEventId))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Attempt))
-	}
-	if m.StickyExecutionEnabled {
-		n += 2
-	}
-	if m.WorkflowTaskInfo != nil {
-		l = m.WorkflowTaskInfo.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowExecutionTaskQueue != nil {
-		l = m.WorkflowExecutionTaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.EventStoreVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EventStoreVersion))
-	}
-	l = len(m.BranchToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ScheduledTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StartedTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if len(m.Queries) > 0 {
-		for k, v := range m.Queries {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovRequestResponse(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
-		}
+func (m *UnpauseActivityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordActivityTaskStartedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ScheduleId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ScheduleId))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskId))
-	}
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.PollRequest != nil {
-		l = m.PollRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+func (m *UnpauseActivityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordActivityTaskStartedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UnpauseActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ScheduledEvent != nil {
-		l = m.ScheduledEvent.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StartedTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Attempt))
-	}
-	if m.CurrentAttemptScheduledTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.HeartbeatDetails != nil {
-		l = m.HeartbeatDetails.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.WorkflowNamespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondWorkflowTaskCompletedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.CompleteRequest != nil {
-		l = m.CompleteRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (m *ResetActivityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RespondWorkflowTaskCompletedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StartedResponse != nil {
-		l = m.StartedResponse.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+func (m *ResetActivityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondWorkflowTaskFailedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ResetActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.FailedRequest != nil {
-		l = m.FailedRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondWorkflowTaskFailedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResetActivityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordActivityTaskHeartbeatRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.HeartbeatRequest != nil {
-		l = m.HeartbeatRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+func (m *ResetActivityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordActivityTaskHeartbeatResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ResetActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CancelRequested {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskCompletedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateActivityOptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateActivityOptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateActivityOptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.CompleteRequest != nil {
-		l = m.CompleteRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskCompletedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateActivityOptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateActivityOptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateActivityOptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskFailedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StreamWorkflowExecutionHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamWorkflowExecutionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamWorkflowExecutionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.FailedRequest != nil {
-		l = m.FailedRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskFailedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StreamWorkflowExecutionHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskCanceledRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StreamWorkflowExecutionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamWorkflowExecutionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.CancelRequest != nil {
-		l = m.CancelRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskCanceledResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRequestResponse(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	var l int
-	_ = l
-	return n
+	dAtA[offset] = uint8(v)
+	return base
 }
-
-func (m *SignalWorkflowExecutionRequest) Size() (n int) {
+func (m *StartWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11801,47 +13466,44 @@ func (m *SignalWorkflowExecutionRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.SignalRequest != nil {
-		l = m.SignalRequest.Size()
+	if m.StartRequest != nil {
+		l = m.StartRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.ExternalWorkflowExecution != nil {
-		l = m.ExternalWorkflowExecution.Size()
+	if m.ParentExecutionInfo != nil {
+		l = m.ParentExecutionInfo.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.ChildWorkflowOnly {
-		n += 2
+	if m.Attempt != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Attempt))
 	}
-	return n
-}
-
-func (m *SignalWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.WorkflowExecutionExpirationTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ContinueAsNewInitiator != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ContinueAsNewInitiator))
 	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
+	if m.ContinuedFailure != nil {
+		l = m.ContinuedFailure.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.SignalWithStartRequest != nil {
-		l = m.SignalWithStartRequest.Size()
+	if m.LastCompletionResult != nil {
+		l = m.LastCompletionResult.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.FirstWorkflowTaskBackoff != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DeadlineRules != nil {
+		l = m.DeadlineRules.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) Size() (n int) {
+func (m *StartWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11854,7 +13516,7 @@ func (m *SignalWithStartWorkflowExecutionResponse) Size() (n int) {
 	return n
 }
 
-func (m *RemoveSignalMutableStateRequest) Size() (n int) {
+func (m *GetMutableStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11864,83 +13526,83 @@ func (m *RemoveSignalMutableStateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
+	if m.Execution != nil {
+		l = m.Execution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.RequestId)
+	if m.ExpectedNextEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ExpectedNextEventId))
+	}
+	l = len(m.CurrentBranchToken)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *RemoveSignalMutableStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *TerminateWorkflowExecutionRequest) Size() (n int) {
+func (m *GetMutableStateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
+	if m.Execution != nil {
+		l = m.Execution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.TerminateRequest != nil {
-		l = m.TerminateRequest.Size()
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	return n
-}
-
-func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.NextEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.NextEventId))
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *ResetWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PreviousStartedEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
 	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
+	if m.LastFirstEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.LastFirstEventId))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.ResetRequest != nil {
-		l = m.ResetRequest.Size()
+	if m.StickyTaskQueue != nil {
+		l = m.StickyTaskQueue.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	return n
-}
-
-func (m *ResetWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.StickyTaskQueueScheduleToStartTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	var l int
-	_ = l
-	l = len(m.RunId)
+	if m.EventStoreVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EventStoreVersion))
+	}
+	l = len(m.CurrentBranchToken)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.WorkflowState != 0 {
+		n += 1 + sovRequestResponse(uint64(m.WorkflowState))
+	}
+	if m.WorkflowStatus != 0 {
+		n += 2 + sovRequestResponse(uint64(m.WorkflowStatus))
+	}
+	if m.VersionHistories != nil {
+		l = m.VersionHistories.Size()
+		n += 2 + l + sovRequestResponse(uint64(l))
+	}
+	if m.IsStickyTaskQueueEnabled {
+		n += 3
+	}
+	if m.DeadlineRules != nil {
+		l = m.DeadlineRules.Size()
+		n += 2 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) Size() (n int) {
+func (m *PollMutableStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11950,33 +13612,73 @@ func (m *RequestCancelWorkflowExecutionRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.CancelRequest != nil {
-		l = m.CancelRequest.Size()
+	if m.Execution != nil {
+		l = m.Execution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.ExternalInitiatedEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ExternalInitiatedEventId))
+	if m.ExpectedNextEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ExpectedNextEventId))
 	}
-	if m.ExternalWorkflowExecution != nil {
-		l = m.ExternalWorkflowExecution.Size()
+	l = len(m.CurrentBranchToken)
+	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.ChildWorkflowOnly {
-		n += 2
-	}
 	return n
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) Size() (n int) {
+func (m *PollMutableStateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.NextEventId))
+	}
+	if m.PreviousStartedEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
+	}
+	if m.LastFirstEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.LastFirstEventId))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StickyTaskQueue != nil {
+		l = m.StickyTaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StickyTaskQueueScheduleToStartTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.CurrentBranchToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.VersionHistories != nil {
+		l = m.VersionHistories.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowState != 0 {
+		n += 1 + sovRequestResponse(uint64(m.WorkflowState))
+	}
+	if m.WorkflowStatus != 0 {
+		n += 2 + sovRequestResponse(uint64(m.WorkflowStatus))
+	}
 	return n
 }
 
-func (m *ScheduleWorkflowTaskRequest) Size() (n int) {
+func (m *ResetStickyTaskQueueRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11986,17 +13688,14 @@ func (m *ScheduleWorkflowTaskRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
+	if m.Execution != nil {
+		l = m.Execution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.IsFirstWorkflowTask {
-		n += 2
-	}
 	return n
 }
 
-func (m *ScheduleWorkflowTaskResponse) Size() (n int) {
+func (m *ResetStickyTaskQueueResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12005,7 +13704,7 @@ func (m *ScheduleWorkflowTaskResponse) Size() (n int) {
 	return n
 }
 
-func (m *RecordChildExecutionCompletedRequest) Size() (n int) {
+func (m *RecordWorkflowTaskStartedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12019,76 +13718,97 @@ func (m *RecordChildExecutionCompletedRequest) Size() (n int) {
 		l = m.WorkflowExecution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.InitiatedId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InitiatedId))
+	if m.ScheduleId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ScheduleId))
 	}
-	if m.CompletedExecution != nil {
-		l = m.CompletedExecution.Size()
+	if m.TaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.CompletionEvent != nil {
-		l = m.CompletionEvent.Size()
+	if m.PollRequest != nil {
+		l = m.PollRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *RecordChildExecutionCompletedResponse) Size() (n int) {
+func (m *RecordWorkflowTaskStartedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
+	if m.PreviousStartedEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
+	}
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ScheduledEventId))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartedEventId))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.NextEventId))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Attempt))
+	}
+	if m.StickyExecutionEnabled {
+		n += 2
+	}
+	if m.WorkflowTaskInfo != nil {
+		l = m.WorkflowTaskInfo.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
+	if m.WorkflowExecutionTaskQueue != nil {
+		l = m.WorkflowExecutionTaskQueue.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	return n
-}
-
-func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.EventStoreVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EventStoreVersion))
 	}
-	var l int
-	_ = l
-	if m.ExecutionConfig != nil {
-		l = m.ExecutionConfig.Size()
+	l = len(m.BranchToken)
+	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.WorkflowExecutionInfo != nil {
-		l = m.WorkflowExecutionInfo.Size()
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if len(m.PendingActivities) > 0 {
-		for _, e := range m.PendingActivities {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	if m.StartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if len(m.PendingChildren) > 0 {
-		for _, e := range m.PendingChildren {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.Queries) > 0 {
+		for k, v := range m.Queries {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
 		}
 	}
+	if m.HistorySizeBytes != 0 {
+		n += 1 + sovRequestResponse(uint64(m.HistorySizeBytes))
+	}
+	if m.SuggestContinueAsNew {
+		n += 3
+	}
 	return n
 }
 
-func (m *ReplicateEventsV2Request) Size() (n int) {
+func (m *RecordActivityTaskStartedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12102,62 +13822,60 @@ func (m *ReplicateEventsV2Request) Size() (n int) {
 		l = m.WorkflowExecution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if len(m.VersionHistoryItems) > 0 {
-		for _, e := range m.VersionHistoryItems {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	if m.ScheduleId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ScheduleId))
 	}
-	if m.Events != nil {
-		l = m.Events.Size()
+	if m.TaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.NewRunEvents != nil {
-		l = m.NewRunEvents.Size()
+	if m.PollRequest != nil {
+		l = m.PollRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *ReplicateEventsV2Response) Size() (n int) {
+func (m *RecordActivityTaskStartedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func (m *SyncShardStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ScheduledEvent != nil {
+		l = m.ScheduledEvent.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	var l int
-	_ = l
-	l = len(m.SourceCluster)
-	if l > 0 {
+	if m.StartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	if m.Attempt != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Attempt))
 	}
-	if m.StatusTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime)
+	if m.CurrentAttemptScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime)
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	return n
-}
-
-func (m *SyncShardStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.HeartbeatDetails != nil {
+		l = m.HeartbeatDetails.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.WorkflowNamespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	var l int
-	_ = l
 	return n
 }
 
-func (m *SyncActivityRequest) Size() (n int) {
+func (m *RespondWorkflowTaskCompletedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12167,67 +13885,27 @@ func (m *SyncActivityRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.WorkflowId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Version != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Version))
-	}
-	if m.ScheduledId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ScheduledId))
-	}
-	if m.ScheduledTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StartedId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartedId))
-	}
-	if m.StartedTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.LastHeartbeatTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Attempt))
-	}
-	if m.LastFailure != nil {
-		l = m.LastFailure.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.LastWorkerIdentity)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.VersionHistory != nil {
-		l = m.VersionHistory.Size()
+	if m.CompleteRequest != nil {
+		l = m.CompleteRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *SyncActivityResponse) Size() (n int) {
+func (m *RespondWorkflowTaskCompletedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.StartedResponse != nil {
+		l = m.StartedResponse.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
-func (m *DescribeMutableStateRequest) Size() (n int) {
+func (m *RespondWorkflowTaskFailedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12237,106 +13915,69 @@ func (m *DescribeMutableStateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
+	if m.FailedRequest != nil {
+		l = m.FailedRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *DescribeMutableStateResponse) Size() (n int) {
+func (m *RespondWorkflowTaskFailedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.CacheMutableState)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.DatabaseMutableState)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.TreeId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.BranchId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
 	return n
 }
 
-func (m *DescribeHistoryHostRequest) Size() (n int) {
+func (m *RecordActivityTaskHeartbeatRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.HostAddress)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
 	l = len(m.NamespaceId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
+	if m.HeartbeatRequest != nil {
+		l = m.HeartbeatRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *DescribeHistoryHostResponse) Size() (n int) {
+func (m *RecordActivityTaskHeartbeatResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ShardsNumber != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardsNumber))
-	}
-	if len(m.ShardIds) > 0 {
-		l = 0
-		for _, e := range m.ShardIds {
-			l += sovRequestResponse(uint64(e))
-		}
-		n += 1 + sovRequestResponse(uint64(l)) + l
-	}
-	if m.NamespaceCache != nil {
-		l = m.NamespaceCache.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.ShardControllerStatus)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.CancelRequested {
+		n += 2
 	}
 	return n
 }
 
-func (m *CloseShardRequest) Size() (n int) {
+func (m *RespondActivityTaskCompletedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.CompleteRequest != nil {
+		l = m.CompleteRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *CloseShardResponse) Size() (n int) {
+func (m *RespondActivityTaskCompletedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12345,29 +13986,24 @@ func (m *CloseShardResponse) Size() (n int) {
 	return n
 }
 
-func (m *RemoveTaskRequest) Size() (n int) {
+func (m *RespondActivityTaskFailedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
-	if m.Category != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Category))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.VisibilityTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
+	if m.FailedRequest != nil {
+		l = m.FailedRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *RemoveTaskResponse) Size() (n int) {
+func (m *RespondActivityTaskFailedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12376,78 +14012,66 @@ func (m *RemoveTaskResponse) Size() (n int) {
 	return n
 }
 
-func (m *GetReplicationMessagesRequest) Size() (n int) {
+func (m *RespondActivityTaskCanceledRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
-	}
-	l = len(m.ClusterName)
+	l = len(m.NamespaceId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.CancelRequest != nil {
+		l = m.CancelRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
-func (m *GetReplicationMessagesResponse) Size() (n int) {
+func (m *RespondActivityTaskCanceledResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ShardMessages) > 0 {
-		for k, v := range m.ShardMessages {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovRequestResponse(uint64(l))
-			}
-			mapEntrySize := 1 + sovRequestResponse(uint64(k)) + l
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
-		}
-	}
 	return n
 }
 
-func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
+func (m *SignalWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.TaskInfos) > 0 {
-		for _, e := range m.TaskInfos {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.SignalRequest != nil {
+		l = m.SignalRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ExternalWorkflowExecution != nil {
+		l = m.ExternalWorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ChildWorkflowOnly {
+		n += 2
 	}
 	return n
 }
 
-func (m *GetDLQReplicationMessagesResponse) Size() (n int) {
+func (m *SignalWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *QueryWorkflowRequest) Size() (n int) {
+func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12457,27 +14081,31 @@ func (m *QueryWorkflowRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
+	if m.SignalWithStartRequest != nil {
+		l = m.SignalWithStartRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DeadlineRules != nil {
+		l = m.DeadlineRules.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryWorkflowResponse) Size() (n int) {
+func (m *SignalWithStartWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Response != nil {
-		l = m.Response.Size()
+	l = len(m.RunId)
+	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *ReapplyEventsRequest) Size() (n int) {
+func (m *RemoveSignalMutableStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12487,14 +14115,18 @@ func (m *ReapplyEventsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	return n
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
 }
 
-func (m *ReapplyEventsResponse) Size() (n int) {
+func (m *RemoveSignalMutableStateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12503,80 +14135,119 @@ func (m *ReapplyEventsResponse) Size() (n int) {
 	return n
 }
 
-func (m *GetDLQMessagesRequest) Size() (n int) {
+func (m *TerminateWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	if m.TerminateRequest != nil {
+		l = m.TerminateRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.SourceCluster)
+	return n
+}
+
+func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ResetWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.InclusiveEndMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	if m.ResetRequest != nil {
+		l = m.ResetRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.MaximumPageSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	return n
+}
+
+func (m *ResetWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.NextPageToken)
+	var l int
+	_ = l
+	l = len(m.RunId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *GetDLQMessagesResponse) Size() (n int) {
+func (m *RequestCancelWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	if m.CancelRequest != nil {
+		l = m.CancelRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
+	if m.ExternalInitiatedEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ExternalInitiatedEventId))
+	}
+	if m.ExternalWorkflowExecution != nil {
+		l = m.ExternalWorkflowExecution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.ChildWorkflowOnly {
+		n += 2
+	}
 	return n
 }
 
-func (m *PurgeDLQMessagesRequest) Size() (n int) {
+func (m *RequestCancelWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	return n
+}
+
+func (m *ScheduleWorkflowTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.SourceCluster)
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.InclusiveEndMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.IsFirstWorkflowTask {
+		n += 2
 	}
 	return n
 }
 
-func (m *PurgeDLQMessagesResponse) Size() (n int) {
+func (m *ScheduleWorkflowTaskResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12585,49 +14256,44 @@ func (m *PurgeDLQMessagesResponse) Size() (n int) {
 	return n
 }
 
-func (m *MergeDLQMessagesRequest) Size() (n int) {
+func (m *RecordChildExecutionCompletedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
-	l = len(m.SourceCluster)
+	l = len(m.NamespaceId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.InclusiveEndMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.MaximumPageSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	if m.InitiatedId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InitiatedId))
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
+	if m.CompletedExecution != nil {
+		l = m.CompletedExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.CompletionEvent != nil {
+		l = m.CompletionEvent.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *MergeDLQMessagesResponse) Size() (n int) {
+func (m *RecordChildExecutionCompletedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
 	return n
 }
 
-func (m *RefreshWorkflowTasksRequest) Size() (n int) {
+func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12644,934 +14310,4024 @@ func (m *RefreshWorkflowTasksRequest) Size() (n int) {
 	return n
 }
 
-func (m *RefreshWorkflowTasksResponse) Size() (n int) {
+func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.ExecutionConfig != nil {
+		l = m.ExecutionConfig.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecutionInfo != nil {
+		l = m.WorkflowExecutionInfo.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.PendingActivities) > 0 {
+		for _, e := range m.PendingActivities {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if len(m.PendingChildren) > 0 {
+		for _, e := range m.PendingChildren {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovRequestResponse(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRequestResponse(x uint64) (n int) {
-	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *StartWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *ReplicateEventsV2Request) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&StartWorkflowExecutionRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`StartRequest:` + strings.Replace(fmt.Sprintf("%v", this.StartRequest), "StartWorkflowExecutionRequest", "v1.StartWorkflowExecutionRequest", 1) + `,`,
-		`ParentExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.ParentExecutionInfo), "ParentExecutionInfo", "v11.ParentExecutionInfo", 1) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`WorkflowExecutionExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`ContinueAsNewInitiator:` + fmt.Sprintf("%v", this.ContinueAsNewInitiator) + `,`,
-		`ContinuedFailure:` + strings.Replace(fmt.Sprintf("%v", this.ContinuedFailure), "Failure", "v13.Failure", 1) + `,`,
-		`LastCompletionResult:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionResult), "Payloads", "v14.Payloads", 1) + `,`,
-		`FirstWorkflowTaskBackoff:` + strings.Replace(fmt.Sprintf("%v", this.FirstWorkflowTaskBackoff), "Duration", "types.Duration", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *StartWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&StartWorkflowExecutionResponse{`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.VersionHistoryItems) > 0 {
+		for _, e := range m.VersionHistoryItems {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if m.Events != nil {
+		l = m.Events.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.NewRunEvents != nil {
+		l = m.NewRunEvents.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DeadlineRules != nil {
+		l = m.DeadlineRules.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
 }
-func (this *GetMutableStateRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReplicateEventsV2Response) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetMutableStateRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`ExpectedNextEventId:` + fmt.Sprintf("%v", this.ExpectedNextEventId) + `,`,
-		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *GetMutableStateResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SyncShardStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetMutableStateResponse{`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
-		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
-		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
-		`LastFirstEventId:` + fmt.Sprintf("%v", this.LastFirstEventId) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
-		`StickyTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
-		`StickyTaskQueueScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueueScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
-		`EventStoreVersion:` + fmt.Sprintf("%v", this.EventStoreVersion) + `,`,
-		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
-		`WorkflowState:` + fmt.Sprintf("%v", this.WorkflowState) + `,`,
-		`WorkflowStatus:` + fmt.Sprintf("%v", this.WorkflowStatus) + `,`,
-		`VersionHistories:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistories), "VersionHistories", "v17.VersionHistories", 1) + `,`,
-		`IsStickyTaskQueueEnabled:` + fmt.Sprintf("%v", this.IsStickyTaskQueueEnabled) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PollMutableStateRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&PollMutableStateRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`ExpectedNextEventId:` + fmt.Sprintf("%v", this.ExpectedNextEventId) + `,`,
-		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PollMutableStateResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
 	}
-	s := strings.Join([]string{`&PollMutableStateResponse{`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
-		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
-		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
-		`LastFirstEventId:` + fmt.Sprintf("%v", this.LastFirstEventId) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
-		`StickyTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
-		`StickyTaskQueueScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueueScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
-		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
-		`VersionHistories:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistories), "VersionHistories", "v17.VersionHistories", 1) + `,`,
-		`WorkflowState:` + fmt.Sprintf("%v", this.WorkflowState) + `,`,
-		`WorkflowStatus:` + fmt.Sprintf("%v", this.WorkflowStatus) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResetStickyTaskQueueRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.StatusTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&ResetStickyTaskQueueRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ResetStickyTaskQueueResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SyncShardStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ResetStickyTaskQueueResponse{`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *RecordWorkflowTaskStartedRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SyncActivityRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&RecordWorkflowTaskStartedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
-		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
-		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
-		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollWorkflowTaskQueueRequest", "v1.PollWorkflowTaskQueueRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RecordWorkflowTaskStartedResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	keysForQueries := make([]string, 0, len(this.Queries))
-	for k, _ := range this.Queries {
-		keysForQueries = append(keysForQueries, k)
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
-	mapStringForQueries := "map[string]*v18.WorkflowQuery{"
-	for _, k := range keysForQueries {
-		mapStringForQueries += fmt.Sprintf("%v: %v,", k, this.Queries[k])
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	mapStringForQueries += "}"
-	s := strings.Join([]string{`&RecordWorkflowTaskStartedResponse{`,
-		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
-		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
-		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
-		`StartedEventId:` + fmt.Sprintf("%v", this.StartedEventId) + `,`,
-		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`StickyExecutionEnabled:` + fmt.Sprintf("%v", this.StickyExecutionEnabled) + `,`,
-		`WorkflowTaskInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowTaskInfo), "TransientWorkflowTaskInfo", "v17.TransientWorkflowTaskInfo", 1) + `,`,
-		`WorkflowExecutionTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionTaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
-		`EventStoreVersion:` + fmt.Sprintf("%v", this.EventStoreVersion) + `,`,
-		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
-		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Queries:` + mapStringForQueries + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RecordActivityTaskStartedRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.Version != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Version))
 	}
-	s := strings.Join([]string{`&RecordActivityTaskStartedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
-		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
-		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
-		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollActivityTaskQueueRequest", "v1.PollActivityTaskQueueRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RecordActivityTaskStartedResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.ScheduledId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ScheduledId))
 	}
-	s := strings.Join([]string{`&RecordActivityTaskStartedResponse{`,
-		`ScheduledEvent:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledEvent), "HistoryEvent", "v19.HistoryEvent", 1) + `,`,
-		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`CurrentAttemptScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.CurrentAttemptScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`HeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatDetails), "Payloads", "v14.Payloads", 1) + `,`,
-		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
-		`WorkflowNamespace:` + fmt.Sprintf("%v", this.WorkflowNamespace) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondWorkflowTaskCompletedRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RespondWorkflowTaskCompletedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`CompleteRequest:` + strings.Replace(fmt.Sprintf("%v", this.CompleteRequest), "RespondWorkflowTaskCompletedRequest", "v1.RespondWorkflowTaskCompletedRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondWorkflowTaskCompletedResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.StartedId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartedId))
 	}
-	s := strings.Join([]string{`&RespondWorkflowTaskCompletedResponse{`,
-		`StartedResponse:` + strings.Replace(this.StartedResponse.String(), "RecordWorkflowTaskStartedResponse", "RecordWorkflowTaskStartedResponse", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondWorkflowTaskFailedRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.StartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RespondWorkflowTaskFailedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`FailedRequest:` + strings.Replace(fmt.Sprintf("%v", this.FailedRequest), "RespondWorkflowTaskFailedRequest", "v1.RespondWorkflowTaskFailedRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondWorkflowTaskFailedResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.LastHeartbeatTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RespondWorkflowTaskFailedResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RecordActivityTaskHeartbeatRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RecordActivityTaskHeartbeatRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`HeartbeatRequest:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatRequest), "RecordActivityTaskHeartbeatRequest", "v1.RecordActivityTaskHeartbeatRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RecordActivityTaskHeartbeatResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Attempt != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Attempt))
 	}
-	s := strings.Join([]string{`&RecordActivityTaskHeartbeatResponse{`,
-		`CancelRequested:` + fmt.Sprintf("%v", this.CancelRequested) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondActivityTaskCompletedRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.LastFailure != nil {
+		l = m.LastFailure.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RespondActivityTaskCompletedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`CompleteRequest:` + strings.Replace(fmt.Sprintf("%v", this.CompleteRequest), "RespondActivityTaskCompletedRequest", "v1.RespondActivityTaskCompletedRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondActivityTaskCompletedResponse) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.LastWorkerIdentity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RespondActivityTaskCompletedResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondActivityTaskFailedRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.VersionHistory != nil {
+		l = m.VersionHistory.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RespondActivityTaskFailedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`FailedRequest:` + strings.Replace(fmt.Sprintf("%v", this.FailedRequest), "RespondActivityTaskFailedRequest", "v1.RespondActivityTaskFailedRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondActivityTaskFailedResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Paused {
+		n += 2
 	}
-	s := strings.Join([]string{`&RespondActivityTaskFailedResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondActivityTaskCanceledRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.Stamp != 0 {
+		n += 2 + sovRequestResponse(uint64(m.Stamp))
 	}
-	s := strings.Join([]string{`&RespondActivityTaskCanceledRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`CancelRequest:` + strings.Replace(fmt.Sprintf("%v", this.CancelRequest), "RespondActivityTaskCanceledRequest", "v1.RespondActivityTaskCanceledRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondActivityTaskCanceledResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.ScheduleToCloseTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout)
+		n += 2 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RespondActivityTaskCanceledResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SignalWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ScheduleToStartTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout)
+		n += 2 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&SignalWorkflowExecutionRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`SignalRequest:` + strings.Replace(fmt.Sprintf("%v", this.SignalRequest), "SignalWorkflowExecutionRequest", "v1.SignalWorkflowExecutionRequest", 1) + `,`,
-		`ExternalWorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.ExternalWorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`ChildWorkflowOnly:` + fmt.Sprintf("%v", this.ChildWorkflowOnly) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SignalWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.StartToCloseTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout)
+		n += 2 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&SignalWorkflowExecutionResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SignalWithStartWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.HeartbeatTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout)
+		n += 2 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&SignalWithStartWorkflowExecutionRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`SignalWithStartRequest:` + strings.Replace(fmt.Sprintf("%v", this.SignalWithStartRequest), "SignalWithStartWorkflowExecutionRequest", "v1.SignalWithStartWorkflowExecutionRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SignalWithStartWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 2 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&SignalWithStartWorkflowExecutionResponse{`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RemoveSignalMutableStateRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.RetryExpirationTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime)
+		n += 2 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RemoveSignalMutableStateRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *RemoveSignalMutableStateResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SyncActivityResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&RemoveSignalMutableStateResponse{`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *TerminateWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeMutableStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&TerminateWorkflowExecutionRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TerminateRequest:` + strings.Replace(fmt.Sprintf("%v", this.TerminateRequest), "TerminateWorkflowExecutionRequest", "v1.TerminateWorkflowExecutionRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *TerminateWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&TerminateWorkflowExecutionResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResetWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&ResetWorkflowExecutionRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`ResetRequest:` + strings.Replace(fmt.Sprintf("%v", this.ResetRequest), "ResetWorkflowExecutionRequest", "v1.ResetWorkflowExecutionRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ResetWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeMutableStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ResetWorkflowExecutionResponse{`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RequestCancelWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.CacheMutableState)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RequestCancelWorkflowExecutionRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`CancelRequest:` + strings.Replace(fmt.Sprintf("%v", this.CancelRequest), "RequestCancelWorkflowExecutionRequest", "v1.RequestCancelWorkflowExecutionRequest", 1) + `,`,
-		`ExternalInitiatedEventId:` + fmt.Sprintf("%v", this.ExternalInitiatedEventId) + `,`,
-		`ExternalWorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.ExternalWorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`ChildWorkflowOnly:` + fmt.Sprintf("%v", this.ChildWorkflowOnly) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RequestCancelWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.DatabaseMutableState)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RequestCancelWorkflowExecutionResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ScheduleWorkflowTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.TreeId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&ScheduleWorkflowTaskRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`IsFirstWorkflowTask:` + fmt.Sprintf("%v", this.IsFirstWorkflowTask) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ScheduleWorkflowTaskResponse) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&ScheduleWorkflowTaskResponse{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *RecordChildExecutionCompletedRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeHistoryHostRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&RecordChildExecutionCompletedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`InitiatedId:` + fmt.Sprintf("%v", this.InitiatedId) + `,`,
-		`CompletedExecution:` + strings.Replace(fmt.Sprintf("%v", this.CompletedExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`CompletionEvent:` + strings.Replace(fmt.Sprintf("%v", this.CompletionEvent), "HistoryEvent", "v19.HistoryEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RecordChildExecutionCompletedResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RecordChildExecutionCompletedResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
 	}
-	s := strings.Join([]string{`&DescribeWorkflowExecutionRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "DescribeWorkflowExecutionRequest", "v1.DescribeWorkflowExecutionRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
 }
-func (this *DescribeWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeHistoryHostResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForPendingActivities := "[]*PendingActivityInfo{"
-	for _, f := range this.PendingActivities {
-		repeatedStringForPendingActivities += strings.Replace(fmt.Sprintf("%v", f), "PendingActivityInfo", "v110.PendingActivityInfo", 1) + ","
+	var l int
+	_ = l
+	if m.ShardsNumber != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardsNumber))
 	}
-	repeatedStringForPendingActivities += "}"
-	repeatedStringForPendingChildren := "[]*PendingChildExecutionInfo{"
-	for _, f := range this.PendingChildren {
-		repeatedStringForPendingChildren += strings.Replace(fmt.Sprintf("%v", f), "PendingChildExecutionInfo", "v110.PendingChildExecutionInfo", 1) + ","
+	if len(m.ShardIds) > 0 {
+		l = 0
+		for _, e := range m.ShardIds {
+			l += sovRequestResponse(uint64(e))
+		}
+		n += 1 + sovRequestResponse(uint64(l)) + l
 	}
-	repeatedStringForPendingChildren += "}"
-	s := strings.Join([]string{`&DescribeWorkflowExecutionResponse{`,
-		`ExecutionConfig:` + strings.Replace(fmt.Sprintf("%v", this.ExecutionConfig), "WorkflowExecutionConfig", "v110.WorkflowExecutionConfig", 1) + `,`,
-		`WorkflowExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionInfo), "WorkflowExecutionInfo", "v110.WorkflowExecutionInfo", 1) + `,`,
-		`PendingActivities:` + repeatedStringForPendingActivities + `,`,
-		`PendingChildren:` + repeatedStringForPendingChildren + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReplicateEventsV2Request) String() string {
-	if this == nil {
-		return "nil"
+	if m.NamespaceCache != nil {
+		l = m.NamespaceCache.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForVersionHistoryItems := "[]*VersionHistoryItem{"
-	for _, f := range this.VersionHistoryItems {
-		repeatedStringForVersionHistoryItems += strings.Replace(fmt.Sprintf("%v", f), "VersionHistoryItem", "v17.VersionHistoryItem", 1) + ","
+	l = len(m.ShardControllerStatus)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForVersionHistoryItems += "}"
-	s := strings.Join([]string{`&ReplicateEventsV2Request{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`VersionHistoryItems:` + repeatedStringForVersionHistoryItems + `,`,
-		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v14.DataBlob", 1) + `,`,
-		`NewRunEvents:` + strings.Replace(fmt.Sprintf("%v", this.NewRunEvents), "DataBlob", "v14.DataBlob", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReplicateEventsV2Response) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&ReplicateEventsV2Response{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *SyncShardStatusRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CloseShardRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&SyncShardStatusRequest{`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`StatusTime:` + strings.Replace(fmt.Sprintf("%v", this.StatusTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SyncShardStatusResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
 	}
-	s := strings.Join([]string{`&SyncShardStatusResponse{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *SyncActivityRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CloseShardResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&SyncActivityRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`ScheduledId:` + fmt.Sprintf("%v", this.ScheduledId) + `,`,
-		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`StartedId:` + fmt.Sprintf("%v", this.StartedId) + `,`,
-		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`LastHeartbeatTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Details:` + strings.Replace(fmt.Sprintf("%v", this.Details), "Payloads", "v14.Payloads", 1) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`LastFailure:` + strings.Replace(fmt.Sprintf("%v", this.LastFailure), "Failure", "v13.Failure", 1) + `,`,
-		`LastWorkerIdentity:` + fmt.Sprintf("%v", this.LastWorkerIdentity) + `,`,
-		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v17.VersionHistory", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *SyncActivityResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RemoveTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&SyncActivityResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeMutableStateRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
 	}
-	s := strings.Join([]string{`&DescribeMutableStateRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeMutableStateResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Category != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Category))
 	}
-	s := strings.Join([]string{`&DescribeMutableStateResponse{`,
-		`CacheMutableState:` + fmt.Sprintf("%v", this.CacheMutableState) + `,`,
-		`DatabaseMutableState:` + fmt.Sprintf("%v", this.DatabaseMutableState) + `,`,
-		`TreeId:` + fmt.Sprintf("%v", this.TreeId) + `,`,
-		`BranchId:` + fmt.Sprintf("%v", this.BranchId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeHistoryHostRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.TaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskId))
 	}
-	s := strings.Join([]string{`&DescribeHistoryHostRequest{`,
-		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeHistoryHostResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.VisibilityTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&DescribeHistoryHostResponse{`,
-		`ShardsNumber:` + fmt.Sprintf("%v", this.ShardsNumber) + `,`,
-		`ShardIds:` + fmt.Sprintf("%v", this.ShardIds) + `,`,
-		`NamespaceCache:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceCache), "NamespaceCacheInfo", "v111.NamespaceCacheInfo", 1) + `,`,
-		`ShardControllerStatus:` + fmt.Sprintf("%v", this.ShardControllerStatus) + `,`,
-		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *CloseShardRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RemoveTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&CloseShardRequest{`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *CloseShardResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&CloseShardResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RemoveTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&RemoveTaskRequest{`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
-		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
-		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RemoveTaskResponse) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RemoveTaskResponse{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GetReplicationMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForTokens := "[]*ReplicationToken{"
-	for _, f := range this.Tokens {
-		repeatedStringForTokens += strings.Replace(fmt.Sprintf("%v", f), "ReplicationToken", "v112.ReplicationToken", 1) + ","
+	var l int
+	_ = l
+	if len(m.ShardMessages) > 0 {
+		for k, v := range m.ShardMessages {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + sovRequestResponse(uint64(k)) + l
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
 	}
-	repeatedStringForTokens += "}"
-	s := strings.Join([]string{`&GetReplicationMessagesRequest{`,
-		`Tokens:` + repeatedStringForTokens + `,`,
-		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GetReplicationMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
-	for k, _ := range this.ShardMessages {
-		keysForShardMessages = append(keysForShardMessages, k)
+
+func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
-	mapStringForShardMessages := "map[int32]*v112.ReplicationMessages{"
-	for _, k := range keysForShardMessages {
-		mapStringForShardMessages += fmt.Sprintf("%v: %v,", k, this.ShardMessages[k])
+	var l int
+	_ = l
+	if len(m.TaskInfos) > 0 {
+		for _, e := range m.TaskInfos {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
 	}
-	mapStringForShardMessages += "}"
-	s := strings.Join([]string{`&GetReplicationMessagesResponse{`,
-		`ShardMessages:` + mapStringForShardMessages + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GetDLQReplicationMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetDLQReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForTaskInfos := "[]*ReplicationTaskInfo{"
-	for _, f := range this.TaskInfos {
-		repeatedStringForTaskInfos += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTaskInfo", "v112.ReplicationTaskInfo", 1) + ","
+	var l int
+	_ = l
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
 	}
-	repeatedStringForTaskInfos += "}"
-	s := strings.Join([]string{`&GetDLQReplicationMessagesRequest{`,
-		`TaskInfos:` + repeatedStringForTaskInfos + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GetDLQReplicationMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *QueryWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
-	for _, f := range this.ReplicationTasks {
-		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v112.ReplicationTask", 1) + ","
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForReplicationTasks += "}"
-	s := strings.Join([]string{`&GetDLQReplicationMessagesResponse{`,
-		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueryWorkflowRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&QueryWorkflowRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "QueryWorkflowRequest", "v1.QueryWorkflowRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueryWorkflowResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.QueryConsistencyLevel != 0 {
+		n += 1 + sovRequestResponse(uint64(m.QueryConsistencyLevel))
 	}
-	s := strings.Join([]string{`&QueryWorkflowResponse{`,
-		`Response:` + strings.Replace(fmt.Sprintf("%v", this.Response), "QueryWorkflowResponse", "v1.QueryWorkflowResponse", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ReapplyEventsRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *QueryWorkflowResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ReapplyEventsRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "ReapplyEventsRequest", "v113.ReapplyEventsRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReapplyEventsResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&ReapplyEventsResponse{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GetDLQMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReapplyEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetDLQMessagesRequest{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
-		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetDLQMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
-	for _, f := range this.ReplicationTasks {
-		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v112.ReplicationTask", 1) + ","
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForReplicationTasks += "}"
-	s := strings.Join([]string{`&GetDLQMessagesResponse{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PurgeDLQMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReapplyEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PurgeDLQMessagesRequest{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *PurgeDLQMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PurgeDLQMessagesResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MergeDLQMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
 	}
-	s := strings.Join([]string{`&MergeDLQMessagesRequest{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
-		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InclusiveEndMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
 }
-func (this *MergeDLQMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MergeDLQMessagesResponse{`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
 }
-func (this *RefreshWorkflowTasksRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PurgeDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&RefreshWorkflowTasksRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "RefreshWorkflowTasksRequest", "v113.RefreshWorkflowTasksRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InclusiveEndMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	}
+	return n
 }
-func (this *RefreshWorkflowTasksResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PurgeDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MergeDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InclusiveEndMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *MergeDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshWorkflowTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshWorkflowTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RestoreWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RestoreWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PauseWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PauseActivityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseActivityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnpauseActivityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UnpauseActivityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ResetActivityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ResetActivityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UpdateActivityOptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateActivityOptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StreamWorkflowExecutionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamWorkflowExecutionHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func sovRequestResponse(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRequestResponse(x uint64) (n int) {
+	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *StartWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`StartRequest:` + strings.Replace(fmt.Sprintf("%v", this.StartRequest), "StartWorkflowExecutionRequest", "v1.StartWorkflowExecutionRequest", 1) + `,`,
+		`ParentExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.ParentExecutionInfo), "ParentExecutionInfo", "v11.ParentExecutionInfo", 1) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`WorkflowExecutionExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ContinueAsNewInitiator:` + fmt.Sprintf("%v", this.ContinueAsNewInitiator) + `,`,
+		`ContinuedFailure:` + strings.Replace(fmt.Sprintf("%v", this.ContinuedFailure), "Failure", "v13.Failure", 1) + `,`,
+		`LastCompletionResult:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionResult), "Payloads", "v14.Payloads", 1) + `,`,
+		`FirstWorkflowTaskBackoff:` + strings.Replace(fmt.Sprintf("%v", this.FirstWorkflowTaskBackoff), "Duration", "types.Duration", 1) + `,`,
+		`DeadlineRules:` + strings.Replace(fmt.Sprintf("%v", this.DeadlineRules), "DeadlineRules", "v11.DeadlineRules", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartWorkflowExecutionResponse{`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetMutableStateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetMutableStateRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`ExpectedNextEventId:` + fmt.Sprintf("%v", this.ExpectedNextEventId) + `,`,
+		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetMutableStateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetMutableStateResponse{`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
+		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
+		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
+		`LastFirstEventId:` + fmt.Sprintf("%v", this.LastFirstEventId) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
+		`StickyTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
+		`StickyTaskQueueScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueueScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
+		`EventStoreVersion:` + fmt.Sprintf("%v", this.EventStoreVersion) + `,`,
+		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
+		`WorkflowState:` + fmt.Sprintf("%v", this.WorkflowState) + `,`,
+		`WorkflowStatus:` + fmt.Sprintf("%v", this.WorkflowStatus) + `,`,
+		`VersionHistories:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistories), "VersionHistories", "v17.VersionHistories", 1) + `,`,
+		`IsStickyTaskQueueEnabled:` + fmt.Sprintf("%v", this.IsStickyTaskQueueEnabled) + `,`,
+		`DeadlineRules:` + strings.Replace(fmt.Sprintf("%v", this.DeadlineRules), "DeadlineRules", "v11.DeadlineRules", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PollMutableStateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PollMutableStateRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`ExpectedNextEventId:` + fmt.Sprintf("%v", this.ExpectedNextEventId) + `,`,
+		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PollMutableStateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PollMutableStateResponse{`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
+		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
+		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
+		`LastFirstEventId:` + fmt.Sprintf("%v", this.LastFirstEventId) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
+		`StickyTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
+		`StickyTaskQueueScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueueScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
+		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
+		`VersionHistories:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistories), "VersionHistories", "v17.VersionHistories", 1) + `,`,
+		`WorkflowState:` + fmt.Sprintf("%v", this.WorkflowState) + `,`,
+		`WorkflowStatus:` + fmt.Sprintf("%v", this.WorkflowStatus) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetStickyTaskQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetStickyTaskQueueRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetStickyTaskQueueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetStickyTaskQueueResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordWorkflowTaskStartedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordWorkflowTaskStartedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollWorkflowTaskQueueRequest", "v1.PollWorkflowTaskQueueRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordWorkflowTaskStartedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForQueries := make([]string, 0, len(this.Queries))
+	for k, _ := range this.Queries {
+		keysForQueries = append(keysForQueries, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
+	mapStringForQueries := "map[string]*v18.WorkflowQuery{"
+	for _, k := range keysForQueries {
+		mapStringForQueries += fmt.Sprintf("%v: %v,", k, this.Queries[k])
+	}
+	mapStringForQueries += "}"
+	s := strings.Join([]string{`&RecordWorkflowTaskStartedResponse{`,
+		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
+		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
+		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
+		`StartedEventId:` + fmt.Sprintf("%v", this.StartedEventId) + `,`,
+		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`StickyExecutionEnabled:` + fmt.Sprintf("%v", this.StickyExecutionEnabled) + `,`,
+		`WorkflowTaskInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowTaskInfo), "TransientWorkflowTaskInfo", "v17.TransientWorkflowTaskInfo", 1) + `,`,
+		`WorkflowExecutionTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionTaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
+		`EventStoreVersion:` + fmt.Sprintf("%v", this.EventStoreVersion) + `,`,
+		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
+		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Queries:` + mapStringForQueries + `,`,
+		`HistorySizeBytes:` + fmt.Sprintf("%v", this.HistorySizeBytes) + `,`,
+		`SuggestContinueAsNew:` + fmt.Sprintf("%v", this.SuggestContinueAsNew) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordActivityTaskStartedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordActivityTaskStartedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollActivityTaskQueueRequest", "v1.PollActivityTaskQueueRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordActivityTaskStartedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordActivityTaskStartedResponse{`,
+		`ScheduledEvent:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledEvent), "HistoryEvent", "v19.HistoryEvent", 1) + `,`,
+		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`CurrentAttemptScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.CurrentAttemptScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`HeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatDetails), "Payloads", "v14.Payloads", 1) + `,`,
+		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
+		`WorkflowNamespace:` + fmt.Sprintf("%v", this.WorkflowNamespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondWorkflowTaskCompletedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondWorkflowTaskCompletedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`CompleteRequest:` + strings.Replace(fmt.Sprintf("%v", this.CompleteRequest), "RespondWorkflowTaskCompletedRequest", "v1.RespondWorkflowTaskCompletedRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondWorkflowTaskCompletedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondWorkflowTaskCompletedResponse{`,
+		`StartedResponse:` + strings.Replace(this.StartedResponse.String(), "RecordWorkflowTaskStartedResponse", "RecordWorkflowTaskStartedResponse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondWorkflowTaskFailedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondWorkflowTaskFailedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`FailedRequest:` + strings.Replace(fmt.Sprintf("%v", this.FailedRequest), "RespondWorkflowTaskFailedRequest", "v1.RespondWorkflowTaskFailedRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondWorkflowTaskFailedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondWorkflowTaskFailedResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordActivityTaskHeartbeatRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordActivityTaskHeartbeatRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`HeartbeatRequest:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatRequest), "RecordActivityTaskHeartbeatRequest", "v1.RecordActivityTaskHeartbeatRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordActivityTaskHeartbeatResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordActivityTaskHeartbeatResponse{`,
+		`CancelRequested:` + fmt.Sprintf("%v", this.CancelRequested) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskCompletedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskCompletedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`CompleteRequest:` + strings.Replace(fmt.Sprintf("%v", this.CompleteRequest), "RespondActivityTaskCompletedRequest", "v1.RespondActivityTaskCompletedRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskCompletedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskCompletedResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskFailedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskFailedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`FailedRequest:` + strings.Replace(fmt.Sprintf("%v", this.FailedRequest), "RespondActivityTaskFailedRequest", "v1.RespondActivityTaskFailedRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskFailedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskFailedResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskCanceledRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskCanceledRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`CancelRequest:` + strings.Replace(fmt.Sprintf("%v", this.CancelRequest), "RespondActivityTaskCanceledRequest", "v1.RespondActivityTaskCanceledRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskCanceledResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskCanceledResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignalWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignalWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`SignalRequest:` + strings.Replace(fmt.Sprintf("%v", this.SignalRequest), "SignalWorkflowExecutionRequest", "v1.SignalWorkflowExecutionRequest", 1) + `,`,
+		`ExternalWorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.ExternalWorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`ChildWorkflowOnly:` + fmt.Sprintf("%v", this.ChildWorkflowOnly) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignalWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignalWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignalWithStartWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignalWithStartWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`SignalWithStartRequest:` + strings.Replace(fmt.Sprintf("%v", this.SignalWithStartRequest), "SignalWithStartWorkflowExecutionRequest", "v1.SignalWithStartWorkflowExecutionRequest", 1) + `,`,
+		`DeadlineRules:` + strings.Replace(fmt.Sprintf("%v", this.DeadlineRules), "DeadlineRules", "v11.DeadlineRules", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignalWithStartWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignalWithStartWorkflowExecutionResponse{`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveSignalMutableStateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveSignalMutableStateRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveSignalMutableStateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveSignalMutableStateResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TerminateWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TerminateWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TerminateRequest:` + strings.Replace(fmt.Sprintf("%v", this.TerminateRequest), "TerminateWorkflowExecutionRequest", "v1.TerminateWorkflowExecutionRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TerminateWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TerminateWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`ResetRequest:` + strings.Replace(fmt.Sprintf("%v", this.ResetRequest), "ResetWorkflowExecutionRequest", "v1.ResetWorkflowExecutionRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetWorkflowExecutionResponse{`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RequestCancelWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RequestCancelWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`CancelRequest:` + strings.Replace(fmt.Sprintf("%v", this.CancelRequest), "RequestCancelWorkflowExecutionRequest", "v1.RequestCancelWorkflowExecutionRequest", 1) + `,`,
+		`ExternalInitiatedEventId:` + fmt.Sprintf("%v", this.ExternalInitiatedEventId) + `,`,
+		`ExternalWorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.ExternalWorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`ChildWorkflowOnly:` + fmt.Sprintf("%v", this.ChildWorkflowOnly) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RequestCancelWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RequestCancelWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleWorkflowTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduleWorkflowTaskRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`IsFirstWorkflowTask:` + fmt.Sprintf("%v", this.IsFirstWorkflowTask) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleWorkflowTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduleWorkflowTaskResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordChildExecutionCompletedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordChildExecutionCompletedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`InitiatedId:` + fmt.Sprintf("%v", this.InitiatedId) + `,`,
+		`CompletedExecution:` + strings.Replace(fmt.Sprintf("%v", this.CompletedExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`CompletionEvent:` + strings.Replace(fmt.Sprintf("%v", this.CompletionEvent), "HistoryEvent", "v19.HistoryEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordChildExecutionCompletedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordChildExecutionCompletedResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "DescribeWorkflowExecutionRequest", "v1.DescribeWorkflowExecutionRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPendingActivities := "[]*PendingActivityInfo{"
+	for _, f := range this.PendingActivities {
+		repeatedStringForPendingActivities += strings.Replace(fmt.Sprintf("%v", f), "PendingActivityInfo", "v110.PendingActivityInfo", 1) + ","
+	}
+	repeatedStringForPendingActivities += "}"
+	repeatedStringForPendingChildren := "[]*PendingChildExecutionInfo{"
+	for _, f := range this.PendingChildren {
+		repeatedStringForPendingChildren += strings.Replace(fmt.Sprintf("%v", f), "PendingChildExecutionInfo", "v110.PendingChildExecutionInfo", 1) + ","
+	}
+	repeatedStringForPendingChildren += "}"
+	s := strings.Join([]string{`&DescribeWorkflowExecutionResponse{`,
+		`ExecutionConfig:` + strings.Replace(fmt.Sprintf("%v", this.ExecutionConfig), "WorkflowExecutionConfig", "v110.WorkflowExecutionConfig", 1) + `,`,
+		`WorkflowExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionInfo), "WorkflowExecutionInfo", "v110.WorkflowExecutionInfo", 1) + `,`,
+		`PendingActivities:` + repeatedStringForPendingActivities + `,`,
+		`PendingChildren:` + repeatedStringForPendingChildren + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicateEventsV2Request) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVersionHistoryItems := "[]*VersionHistoryItem{"
+	for _, f := range this.VersionHistoryItems {
+		repeatedStringForVersionHistoryItems += strings.Replace(fmt.Sprintf("%v", f), "VersionHistoryItem", "v17.VersionHistoryItem", 1) + ","
+	}
+	repeatedStringForVersionHistoryItems += "}"
+	s := strings.Join([]string{`&ReplicateEventsV2Request{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`VersionHistoryItems:` + repeatedStringForVersionHistoryItems + `,`,
+		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v14.DataBlob", 1) + `,`,
+		`NewRunEvents:` + strings.Replace(fmt.Sprintf("%v", this.NewRunEvents), "DataBlob", "v14.DataBlob", 1) + `,`,
+		`DeadlineRules:` + strings.Replace(fmt.Sprintf("%v", this.DeadlineRules), "DeadlineRules", "v11.DeadlineRules", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicateEventsV2Response) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicateEventsV2Response{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncShardStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncShardStatusRequest{`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`StatusTime:` + strings.Replace(fmt.Sprintf("%v", this.StatusTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncShardStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncShardStatusResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncActivityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncActivityRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`ScheduledId:` + fmt.Sprintf("%v", this.ScheduledId) + `,`,
+		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StartedId:` + fmt.Sprintf("%v", this.StartedId) + `,`,
+		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastHeartbeatTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Details:` + strings.Replace(fmt.Sprintf("%v", this.Details), "Payloads", "v14.Payloads", 1) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`LastFailure:` + strings.Replace(fmt.Sprintf("%v", this.LastFailure), "Failure", "v13.Failure", 1) + `,`,
+		`LastWorkerIdentity:` + fmt.Sprintf("%v", this.LastWorkerIdentity) + `,`,
+		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v17.VersionHistory", 1) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`Stamp:` + fmt.Sprintf("%v", this.Stamp) + `,`,
+		`ScheduleToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
+		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
+		`StartToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StartToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
+		`HeartbeatTimeout:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatTimeout), "Duration", "types.Duration", 1) + `,`,
+		`RetryPolicy:` + strings.Replace(fmt.Sprintf("%v", this.RetryPolicy), "RetryPolicy", "v14.RetryPolicy", 1) + `,`,
+		`RetryExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.RetryExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncActivityResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncActivityResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeMutableStateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeMutableStateRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeMutableStateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeMutableStateResponse{`,
+		`CacheMutableState:` + fmt.Sprintf("%v", this.CacheMutableState) + `,`,
+		`DatabaseMutableState:` + fmt.Sprintf("%v", this.DatabaseMutableState) + `,`,
+		`TreeId:` + fmt.Sprintf("%v", this.TreeId) + `,`,
+		`BranchId:` + fmt.Sprintf("%v", this.BranchId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeHistoryHostRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeHistoryHostRequest{`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeHistoryHostResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeHistoryHostResponse{`,
+		`ShardsNumber:` + fmt.Sprintf("%v", this.ShardsNumber) + `,`,
+		`ShardIds:` + fmt.Sprintf("%v", this.ShardIds) + `,`,
+		`NamespaceCache:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceCache), "NamespaceCacheInfo", "v111.NamespaceCacheInfo", 1) + `,`,
+		`ShardControllerStatus:` + fmt.Sprintf("%v", this.ShardControllerStatus) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloseShardRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloseShardRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloseShardResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloseShardResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveTaskRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveTaskResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetReplicationMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTokens := "[]*ReplicationToken{"
+	for _, f := range this.Tokens {
+		repeatedStringForTokens += strings.Replace(fmt.Sprintf("%v", f), "ReplicationToken", "v112.ReplicationToken", 1) + ","
+	}
+	repeatedStringForTokens += "}"
+	s := strings.Join([]string{`&GetReplicationMessagesRequest{`,
+		`Tokens:` + repeatedStringForTokens + `,`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetReplicationMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
+	for k, _ := range this.ShardMessages {
+		keysForShardMessages = append(keysForShardMessages, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
+	mapStringForShardMessages := "map[int32]*v112.ReplicationMessages{"
+	for _, k := range keysForShardMessages {
+		mapStringForShardMessages += fmt.Sprintf("%v: %v,", k, this.ShardMessages[k])
+	}
+	mapStringForShardMessages += "}"
+	s := strings.Join([]string{`&GetReplicationMessagesResponse{`,
+		`ShardMessages:` + mapStringForShardMessages + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQReplicationMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTaskInfos := "[]*ReplicationTaskInfo{"
+	for _, f := range this.TaskInfos {
+		repeatedStringForTaskInfos += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTaskInfo", "v112.ReplicationTaskInfo", 1) + ","
+	}
+	repeatedStringForTaskInfos += "}"
+	s := strings.Join([]string{`&GetDLQReplicationMessagesRequest{`,
+		`TaskInfos:` + repeatedStringForTaskInfos + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQReplicationMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
+	for _, f := range this.ReplicationTasks {
+		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v112.ReplicationTask", 1) + ","
+	}
+	repeatedStringForReplicationTasks += "}"
+	s := strings.Join([]string{`&GetDLQReplicationMessagesResponse{`,
+		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueryWorkflowRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueryWorkflowRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "QueryWorkflowRequest", "v1.QueryWorkflowRequest", 1) + `,`,
+		`QueryConsistencyLevel:` + fmt.Sprintf("%v", this.QueryConsistencyLevel) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueryWorkflowResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueryWorkflowResponse{`,
+		`Response:` + strings.Replace(fmt.Sprintf("%v", this.Response), "QueryWorkflowResponse", "v1.QueryWorkflowResponse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReapplyEventsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReapplyEventsRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "ReapplyEventsRequest", "v113.ReapplyEventsRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReapplyEventsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReapplyEventsResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDLQMessagesRequest{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
+		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
+	for _, f := range this.ReplicationTasks {
+		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v112.ReplicationTask", 1) + ","
+	}
+	repeatedStringForReplicationTasks += "}"
+	s := strings.Join([]string{`&GetDLQMessagesResponse{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PurgeDLQMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PurgeDLQMessagesRequest{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PurgeDLQMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PurgeDLQMessagesResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MergeDLQMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MergeDLQMessagesRequest{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
+		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MergeDLQMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MergeDLQMessagesResponse{`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RefreshWorkflowTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefreshWorkflowTasksRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "RefreshWorkflowTasksRequest", "v113.RefreshWorkflowTasksRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RefreshWorkflowTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefreshWorkflowTasksResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RestoreWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RestoreWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "RestoreWorkflowExecutionRequest", "v113.RestoreWorkflowExecutionRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RestoreWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RestoreWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "PauseWorkflowExecutionRequest", "v113.PauseWorkflowExecutionRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "UnpauseWorkflowExecutionRequest", "v113.UnpauseWorkflowExecutionRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseActivityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseActivityRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "PauseActivityRequest", "v113.PauseActivityRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseActivityResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseActivityResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseActivityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseActivityRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "UnpauseActivityRequest", "v113.UnpauseActivityRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseActivityResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseActivityResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetActivityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetActivityRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "ResetActivityRequest", "v113.ResetActivityRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetActivityResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetActivityResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateActivityOptionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateActivityOptionsRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "UpdateActivityOptionsRequest", "v113.UpdateActivityOptionsRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateActivityOptionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateActivityOptionsResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamWorkflowExecutionHistoryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamWorkflowExecutionHistoryRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "StreamWorkflowExecutionHistoryRequest", "v113.StreamWorkflowExecutionHistoryRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamWorkflowExecutionHistoryResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamWorkflowExecutionHistoryResponse{`,
+		`Response:` + strings.Replace(fmt.Sprintf("%v", this.Response), "StreamWorkflowExecutionHistoryResponse", "v113.StreamWorkflowExecutionHistoryResponse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRequestResponse(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartRequest == nil {
+				m.StartRequest = &v1.StartWorkflowExecutionRequest{}
+			}
+			if err := m.StartRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentExecutionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentExecutionInfo == nil {
+				m.ParentExecutionInfo = &v11.ParentExecutionInfo{}
+			}
+			if err := m.ParentExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecutionExpirationTime == nil {
+				m.WorkflowExecutionExpirationTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowExecutionExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueAsNewInitiator", wireType)
+			}
+			m.ContinueAsNewInitiator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContinueAsNewInitiator |= v12.ContinueAsNewInitiator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuedFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContinuedFailure == nil {
+				m.ContinuedFailure = &v13.Failure{}
+			}
+			if err := m.ContinuedFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompletionResult == nil {
+				m.LastCompletionResult = &v14.Payloads{}
+			}
+			if err := m.LastCompletionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstWorkflowTaskBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FirstWorkflowTaskBackoff == nil {
+				m.FirstWorkflowTaskBackoff = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.FirstWorkflowTaskBackoff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeadlineRules == nil {
+				m.DeadlineRules = &v11.DeadlineRules{}
+			}
+			if err := m.DeadlineRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMutableStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
+			}
+			m.ExpectedNextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedNextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMutableStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v14.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+			}
+			m.PreviousStartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousStartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
+			}
+			m.LastFirstEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFirstEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v15.TaskQueue{}
+			}
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StickyTaskQueue == nil {
+				m.StickyTaskQueue = &v15.TaskQueue{}
+			}
+			if err := m.StickyTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskQueueScheduleToStartTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StickyTaskQueueScheduleToStartTimeout == nil {
+				m.StickyTaskQueueScheduleToStartTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StickyTaskQueueScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+			}
+			m.EventStoreVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventStoreVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
+			}
+			m.WorkflowState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowState |= v16.WorkflowExecutionState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowStatus", wireType)
+			}
+			m.WorkflowStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowStatus |= v12.WorkflowExecutionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionHistories == nil {
+				m.VersionHistories = &v17.VersionHistories{}
+			}
+			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsStickyTaskQueueEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsStickyTaskQueueEnabled = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeadlineRules == nil {
+				m.DeadlineRules = &v11.DeadlineRules{}
+			}
+			if err := m.DeadlineRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollMutableStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
+			}
+			m.ExpectedNextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedNextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollMutableStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v14.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+			}
+			m.PreviousStartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousStartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
+			}
+			m.LastFirstEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFirstEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v15.TaskQueue{}
+			}
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StickyTaskQueue == nil {
+				m.StickyTaskQueue = &v15.TaskQueue{}
+			}
+			if err := m.StickyTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskQueueScheduleToStartTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StickyTaskQueueScheduleToStartTimeout == nil {
+				m.StickyTaskQueueScheduleToStartTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StickyTaskQueueScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionHistories == nil {
+				m.VersionHistories = &v17.VersionHistories{}
+			}
+			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
+			}
+			m.WorkflowState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowState |= v16.WorkflowExecutionState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowStatus", wireType)
+			}
+			m.WorkflowStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowStatus |= v12.WorkflowExecutionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetStickyTaskQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetStickyTaskQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetStickyTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetStickyTaskQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetStickyTaskQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetStickyTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordWorkflowTaskStartedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordWorkflowTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollWorkflowTaskQueueRequest{}
+			}
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&RefreshWorkflowTasksResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringRequestResponse(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13594,17 +18350,110 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordWorkflowTaskStartedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordWorkflowTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v14.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+			}
+			m.PreviousStartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousStartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13614,27 +18463,53 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NextEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StickyExecutionEnabled = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13661,16 +18536,16 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartRequest == nil {
-				m.StartRequest = &v1.StartWorkflowExecutionRequest{}
+			if m.WorkflowTaskInfo == nil {
+				m.WorkflowTaskInfo = &v17.TransientWorkflowTaskInfo{}
 			}
-			if err := m.StartRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowTaskInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentExecutionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskQueue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13697,18 +18572,18 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentExecutionInfo == nil {
-				m.ParentExecutionInfo = &v11.ParentExecutionInfo{}
+			if m.WorkflowExecutionTaskQueue == nil {
+				m.WorkflowExecutionTaskQueue = &v15.TaskQueue{}
 			}
-			if err := m.ParentExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecutionTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
 			}
-			m.Attempt = 0
+			m.EventStoreVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13718,16 +18593,16 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				m.EventStoreVersion |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13737,33 +18612,31 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionExpirationTime == nil {
-				m.WorkflowExecutionExpirationTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowExecutionExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueAsNewInitiator", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
 			}
-			m.ContinueAsNewInitiator = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13773,14 +18646,31 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContinueAsNewInitiator |= v12.ContinueAsNewInitiator(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinuedFailure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13807,16 +18697,16 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContinuedFailure == nil {
-				m.ContinuedFailure = &v13.Failure{}
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
 			}
-			if err := m.ContinuedFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13843,18 +18733,111 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionResult == nil {
-				m.LastCompletionResult = &v14.Payloads{}
+			if m.Queries == nil {
+				m.Queries = make(map[string]*v18.WorkflowQuery)
 			}
-			if err := m.LastCompletionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue *v18.WorkflowQuery
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v18.WorkflowQuery{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstWorkflowTaskBackoff", wireType)
+			m.Queries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySizeBytes", wireType)
 			}
-			var msglen int
+			m.HistorySizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13864,86 +18847,16 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.HistorySizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FirstWorkflowTaskBackoff == nil {
-				m.FirstWorkflowTaskBackoff = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.FirstWorkflowTaskBackoff, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuggestContinueAsNew", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13953,24 +18866,12 @@ func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.SuggestContinueAsNew = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -13995,7 +18896,7 @@ func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14018,10 +18919,10 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14058,7 +18959,7 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14085,18 +18986,18 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v14.WorkflowExecution{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-			m.ExpectedNextEventId = 0
+			m.ScheduleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14106,16 +19007,35 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpectedNextEventId |= int64(b&0x7F) << shift
+				m.ScheduleId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14125,24 +19045,58 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollActivityTaskQueueRequest{}
+			}
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -14169,7 +19123,7 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14192,51 +19146,15 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Execution == nil {
-				m.Execution = &v14.WorkflowExecution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14263,37 +19181,18 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v14.WorkflowType{}
+			if m.ScheduledEvent == nil {
+				m.ScheduledEvent = &v19.HistoryEvent{}
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
-			}
-			m.NextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
 			}
-			m.PreviousStartedEventId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14303,16 +19202,33 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PreviousStartedEventId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			m.LastFirstEventId = 0
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14322,14 +19238,14 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastFirstEventId |= int64(b&0x7F) << shift
+				m.Attempt |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAttemptScheduledTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14356,16 +19272,16 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v15.TaskQueue{}
+			if m.CurrentAttemptScheduledTime == nil {
+				m.CurrentAttemptScheduledTime = new(time.Time)
 			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CurrentAttemptScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14392,16 +19308,16 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StickyTaskQueue == nil {
-				m.StickyTaskQueue = &v15.TaskQueue{}
+			if m.HeartbeatDetails == nil {
+				m.HeartbeatDetails = &v14.Payloads{}
 			}
-			if err := m.StickyTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskQueueScheduleToStartTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14428,18 +19344,18 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StickyTaskQueueScheduleToStartTimeout == nil {
-				m.StickyTaskQueueScheduleToStartTimeout = new(time.Duration)
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v14.WorkflowType{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StickyTaskQueueScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowNamespace", wireType)
 			}
-			m.EventStoreVersion = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14449,16 +19365,82 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventStoreVersion |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondWorkflowTaskCompletedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondWorkflowTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14468,31 +19450,29 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteRequest", wireType)
 			}
-			m.WorkflowState = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14502,33 +19482,84 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowState |= v16.WorkflowExecutionState(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowStatus", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.WorkflowStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkflowStatus |= v12.WorkflowExecutionStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 17:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompleteRequest == nil {
+				m.CompleteRequest = &v1.RespondWorkflowTaskCompletedRequest{}
+			}
+			if err := m.CompleteRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondWorkflowTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondWorkflowTaskCompletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondWorkflowTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedResponse", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14555,33 +19586,13 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistories == nil {
-				m.VersionHistories = &v17.VersionHistories{}
+			if m.StartedResponse == nil {
+				m.StartedResponse = &RecordWorkflowTaskStartedResponse{}
 			}
-			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartedResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsStickyTaskQueueEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsStickyTaskQueueEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -14606,7 +19617,7 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14629,10 +19640,10 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondWorkflowTaskFailedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondWorkflowTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14669,7 +19680,7 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14696,66 +19707,13 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v14.WorkflowExecution{}
+			if m.FailedRequest == nil {
+				m.FailedRequest = &v1.RespondWorkflowTaskFailedRequest{}
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FailedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
-			}
-			m.ExpectedNextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpectedNextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -14780,7 +19738,7 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondWorkflowTaskFailedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14803,146 +19761,70 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondWorkflowTaskFailedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondWorkflowTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Execution == nil {
-				m.Execution = &v14.WorkflowExecution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v14.WorkflowType{}
-			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
-			}
-			m.NextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
-			}
-			m.PreviousStartedEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PreviousStartedEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.LastFirstEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastFirstEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14952,31 +19834,27 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v15.TaskQueue{}
-			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15003,18 +19881,71 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StickyTaskQueue == nil {
-				m.StickyTaskQueue = &v15.TaskQueue{}
+			if m.HeartbeatRequest == nil {
+				m.HeartbeatRequest = &v1.RecordActivityTaskHeartbeatRequest{}
 			}
-			if err := m.StickyTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HeartbeatRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskQueueScheduleToStartTimeout", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequested", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15024,33 +19955,70 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.CancelRequested = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StickyTaskQueueScheduleToStartTimeout == nil {
-				m.StickyTaskQueueScheduleToStartTimeout = new(time.Duration)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StickyTaskQueueScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 12:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15060,29 +20028,27 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15109,51 +20075,66 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistories == nil {
-				m.VersionHistories = &v17.VersionHistories{}
+			if m.CompleteRequest == nil {
+				m.CompleteRequest = &v1.RespondActivityTaskCompletedRequest{}
 			}
-			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CompleteRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.WorkflowState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkflowState |= v16.WorkflowExecutionState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowStatus", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.WorkflowStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkflowStatus |= v12.WorkflowExecutionStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -15178,7 +20159,7 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetStickyTaskQueueRequest) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15201,10 +20182,10 @@ func (m *ResetStickyTaskQueueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetStickyTaskQueueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetStickyTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15241,7 +20222,7 @@ func (m *ResetStickyTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15268,10 +20249,10 @@ func (m *ResetStickyTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v14.WorkflowExecution{}
+			if m.FailedRequest == nil {
+				m.FailedRequest = &v1.RespondActivityTaskFailedRequest{}
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FailedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15299,7 +20280,7 @@ func (m *ResetStickyTaskQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetStickyTaskQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15322,10 +20303,10 @@ func (m *ResetStickyTaskQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetStickyTaskQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetStickyTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15352,7 +20333,7 @@ func (m *ResetStickyTaskQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15375,10 +20356,10 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordWorkflowTaskStartedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordWorkflowTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15415,113 +20396,7 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
-			}
-			m.ScheduleId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
-			}
-			m.TaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15548,10 +20423,10 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollWorkflowTaskQueueRequest{}
+			if m.CancelRequest == nil {
+				m.CancelRequest = &v1.RespondActivityTaskCanceledRequest{}
 			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15579,7 +20454,7 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15602,91 +20477,70 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordWorkflowTaskStartedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordWorkflowTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v14.WorkflowType{}
-			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
-			}
-			m.PreviousStartedEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PreviousStartedEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ScheduledEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduledEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			m.StartedEventId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15696,35 +20550,29 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartedEventId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.NextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Attempt = 0
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalRequest", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15734,34 +20582,31 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.StickyExecutionEnabled = bool(v != 0)
-		case 8:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignalRequest == nil {
+				m.SignalRequest = &v1.SignalWorkflowExecutionRequest{}
+			}
+			if err := m.SignalRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15788,18 +20633,18 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowTaskInfo == nil {
-				m.WorkflowTaskInfo = &v17.TransientWorkflowTaskInfo{}
+			if m.ExternalWorkflowExecution == nil {
+				m.ExternalWorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.WorkflowTaskInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskQueue", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15809,33 +20654,123 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.ChildWorkflowOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionTaskQueue == nil {
-				m.WorkflowExecutionTaskQueue = &v15.TaskQueue{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if err := m.WorkflowExecutionTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.EventStoreVersion = 0
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15845,16 +20780,29 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventStoreVersion |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalWithStartRequest", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15864,29 +20812,31 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.BranchToken == nil {
-				m.BranchToken = []byte{}
+			if m.SignalWithStartRequest == nil {
+				m.SignalWithStartRequest = &v1.SignalWithStartWorkflowExecutionRequest{}
+			}
+			if err := m.SignalWithStartRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineRules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15913,18 +20863,71 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = new(time.Time)
+			if m.DeadlineRules == nil {
+				m.DeadlineRules = &v11.DeadlineRules{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeadlineRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15934,33 +20937,82 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 14:
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15970,120 +21022,91 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Queries == nil {
-				m.Queries = make(map[string]*v18.WorkflowQuery)
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
-			var mapkey string
-			var mapvalue *v18.WorkflowQuery
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v18.WorkflowQuery{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Queries[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16109,7 +21132,7 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16132,10 +21155,63 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16172,7 +21248,7 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminateRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16199,54 +21275,122 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
+			if m.TerminateRequest == nil {
+				m.TerminateRequest = &v1.TerminateWorkflowExecutionRequest{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TerminateRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ScheduleId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.TaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16274,11 +21418,11 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16305,10 +21449,10 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollActivityTaskQueueRequest{}
+			if m.ResetRequest == nil {
+				m.ResetRequest = &v1.ResetWorkflowExecutionRequest{}
 			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResetRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16336,7 +21480,7 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16359,17 +21503,17 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16379,33 +21523,82 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledEvent == nil {
-				m.ScheduledEvent = &v19.HistoryEvent{}
-			}
-			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16415,50 +21608,27 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
-			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAttemptScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16485,18 +21655,18 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentAttemptScheduledTime == nil {
-				m.CurrentAttemptScheduledTime = new(time.Time)
+			if m.CancelRequest == nil {
+				m.CancelRequest = &v1.RequestCancelWorkflowExecutionRequest{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CurrentAttemptScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalInitiatedEventId", wireType)
 			}
-			var msglen int
+			m.ExternalInitiatedEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16506,31 +21676,14 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExternalInitiatedEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.HeartbeatDetails == nil {
-				m.HeartbeatDetails = &v14.Payloads{}
-			}
-			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16557,18 +21710,18 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v14.WorkflowType{}
+			if m.ExternalWorkflowExecution == nil {
+				m.ExternalWorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowNamespace", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16578,24 +21731,65 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.ChildWorkflowOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowNamespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -16620,7 +21814,7 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16643,10 +21837,10 @@ func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondWorkflowTaskCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleWorkflowTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondWorkflowTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleWorkflowTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16683,7 +21877,7 @@ func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompleteRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16710,13 +21904,33 @@ func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompleteRequest == nil {
-				m.CompleteRequest = &v1.RespondWorkflowTaskCompletedRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.CompleteRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFirstWorkflowTask", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFirstWorkflowTask = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -16741,7 +21955,7 @@ func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondWorkflowTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *ScheduleWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16764,48 +21978,12 @@ func (m *RespondWorkflowTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondWorkflowTaskCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleWorkflowTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondWorkflowTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleWorkflowTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedResponse", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StartedResponse == nil {
-				m.StartedResponse = &RecordWorkflowTaskStartedResponse{}
-			}
-			if err := m.StartedResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -16830,7 +22008,7 @@ func (m *RespondWorkflowTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16853,10 +22031,10 @@ func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondWorkflowTaskFailedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondWorkflowTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16893,7 +22071,7 @@ func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16920,10 +22098,101 @@ func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FailedRequest == nil {
-				m.FailedRequest = &v1.RespondWorkflowTaskFailedRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.FailedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
+			}
+			m.InitiatedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompletedExecution == nil {
+				m.CompletedExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.CompletedExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompletionEvent == nil {
+				m.CompletionEvent = &v19.HistoryEvent{}
+			}
+			if err := m.CompletionEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16951,7 +22220,7 @@ func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondWorkflowTaskFailedResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16974,10 +22243,10 @@ func (m *RespondWorkflowTaskFailedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondWorkflowTaskFailedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondWorkflowTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17004,7 +22273,7 @@ func (m *RespondWorkflowTaskFailedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17027,10 +22296,10 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17067,7 +22336,7 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17094,86 +22363,13 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatRequest == nil {
-				m.HeartbeatRequest = &v1.RecordActivityTaskHeartbeatRequest{}
-			}
-			if err := m.HeartbeatRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Request == nil {
+				m.Request = &v1.DescribeWorkflowExecutionRequest{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequested", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.CancelRequested = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -17198,7 +22394,7 @@ func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17221,17 +22417,17 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17241,27 +22437,31 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.ExecutionConfig == nil {
+				m.ExecutionConfig = &v110.WorkflowExecutionConfig{}
+			}
+			if err := m.ExecutionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompleteRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17288,66 +22488,81 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompleteRequest == nil {
-				m.CompleteRequest = &v1.RespondActivityTaskCompletedRequest{}
+			if m.WorkflowExecutionInfo == nil {
+				m.WorkflowExecutionInfo = &v110.WorkflowExecutionInfo{}
 			}
-			if err := m.CompleteRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			m.PendingActivities = append(m.PendingActivities, &v110.PendingActivityInfo{})
+			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingChildren = append(m.PendingChildren, &v110.PendingChildExecutionInfo{})
+			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -17372,7 +22587,7 @@ func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
+func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17395,10 +22610,10 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicateEventsV2Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicateEventsV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17435,7 +22650,7 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17462,124 +22677,88 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FailedRequest == nil {
-				m.FailedRequest = &v1.RespondActivityTaskFailedRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.FailedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItems", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
+			m.VersionHistoryItems = append(m.VersionHistoryItems, &v17.VersionHistoryItem{})
+			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Events == nil {
+				m.Events = &v14.DataBlob{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRunEvents", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17589,27 +22768,31 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.NewRunEvents == nil {
+				m.NewRunEvents = &v14.DataBlob{}
+			}
+			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineRules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17636,10 +22819,10 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CancelRequest == nil {
-				m.CancelRequest = &v1.RespondActivityTaskCanceledRequest{}
+			if m.DeadlineRules == nil {
+				m.DeadlineRules = &v11.DeadlineRules{}
 			}
-			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeadlineRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17667,7 +22850,7 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
+func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17690,10 +22873,10 @@ func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicateEventsV2Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicateEventsV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17720,7 +22903,7 @@ func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17743,15 +22926,15 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncShardStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncShardStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17779,13 +22962,13 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalRequest", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var msglen int
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17795,31 +22978,14 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ShardId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SignalRequest == nil {
-				m.SignalRequest = &v1.SignalWorkflowExecutionRequest{}
-			}
-			if err := m.SignalRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17846,33 +23012,13 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalWorkflowExecution == nil {
-				m.ExternalWorkflowExecution = &v14.WorkflowExecution{}
+			if m.StatusTime == nil {
+				m.StatusTime = new(time.Time)
 			}
-			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StatusTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ChildWorkflowOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -17897,7 +23043,7 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17920,10 +23066,10 @@ func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncShardStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncShardStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17950,7 +23096,7 @@ func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17973,10 +23119,10 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncActivityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18013,9 +23159,9 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalWithStartRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18025,86 +23171,99 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SignalWithStartRequest == nil {
-				m.SignalWithStartRequest = &v1.SignalWithStartWorkflowExecutionRequest{}
-			}
-			if err := m.SignalWithStartRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
+			}
+			m.ScheduledId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18114,82 +23273,52 @@ func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.StartedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18199,27 +23328,31 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18246,18 +23379,18 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
+			if m.LastHeartbeatTime == nil {
+				m.LastHeartbeatTime = new(time.Time)
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18267,135 +23400,33 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Details == nil {
+				m.Details = &v14.Payloads{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			var stringLen uint64
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18405,27 +23436,14 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Attempt |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TerminateRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18452,122 +23470,16 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TerminateRequest == nil {
-				m.TerminateRequest = &v1.TerminateWorkflowExecutionRequest{}
+			if m.LastFailure == nil {
+				m.LastFailure = &v13.Failure{}
 			}
-			if err := m.TerminateRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkerIdentity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18595,11 +23507,11 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.LastWorkerIdentity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18626,71 +23538,57 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResetRequest == nil {
-				m.ResetRequest = &v1.ResetWorkflowExecutionRequest{}
+			if m.VersionHistory == nil {
+				m.VersionHistory = &v17.VersionHistory{}
 			}
-			if err := m.ResetRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Paused = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stamp", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Stamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stamp |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18700,82 +23598,33 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.ScheduleToCloseTimeout == nil {
+				m.ScheduleToCloseTimeout = new(time.Duration)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18785,27 +23634,31 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18832,18 +23685,18 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CancelRequest == nil {
-				m.CancelRequest = &v1.RequestCancelWorkflowExecutionRequest{}
+			if m.StartToCloseTimeout == nil {
+				m.StartToCloseTimeout = new(time.Duration)
 			}
-			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StartToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalInitiatedEventId", wireType)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
 			}
-			m.ExternalInitiatedEventId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18853,14 +23706,31 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalInitiatedEventId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatTimeout == nil {
+				m.HeartbeatTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.HeartbeatTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18887,18 +23757,18 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalWorkflowExecution == nil {
-				m.ExternalWorkflowExecution = &v14.WorkflowExecution{}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &v14.RetryPolicy{}
 			}
-			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryExpirationTime", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18908,12 +23778,28 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ChildWorkflowOnly = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryExpirationTime == nil {
+				m.RetryExpirationTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.RetryExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -18938,7 +23824,7 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18961,10 +23847,10 @@ func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncActivityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18991,7 +23877,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19014,10 +23900,10 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleWorkflowTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeMutableStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleWorkflowTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19054,7 +23940,7 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19081,86 +23967,13 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFirstWorkflowTask", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsFirstWorkflowTask = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ScheduleWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleWorkflowTaskResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleWorkflowTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -19185,7 +23998,7 @@ func (m *ScheduleWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19208,15 +24021,15 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheMutableState", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19244,49 +24057,13 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.CacheMutableState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseMutableState", wireType)
 			}
-			m.InitiatedId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19296,16 +24073,29 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitiatedId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseMutableState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TreeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19315,33 +24105,29 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompletedExecution == nil {
-				m.CompletedExecution = &v14.WorkflowExecution{}
-			}
-			if err := m.CompletedExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TreeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19351,27 +24137,23 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompletionEvent == nil {
-				m.CompletionEvent = &v19.HistoryEvent{}
-			}
-			if err := m.CompletionEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19397,7 +24179,7 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19420,66 +24202,64 @@ func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
@@ -19511,9 +24291,9 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19540,10 +24320,10 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.DescribeWorkflowExecutionRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19571,7 +24351,7 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19594,15 +24374,110 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardsNumber", wireType)
+			}
+			m.ShardsNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardsNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceCache", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19629,18 +24504,18 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutionConfig == nil {
-				m.ExecutionConfig = &v110.WorkflowExecutionConfig{}
+			if m.NamespaceCache == nil {
+				m.NamespaceCache = &v111.NamespaceCacheInfo{}
 			}
-			if err := m.ExecutionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NamespaceCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardControllerStatus", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19650,33 +24525,29 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionInfo == nil {
-				m.WorkflowExecutionInfo = &v110.WorkflowExecutionInfo{}
-			}
-			if err := m.WorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ShardControllerStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19686,31 +24557,82 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingActivities = append(m.PendingActivities, &v110.PendingActivityInfo{})
-			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			var msglen int
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19720,26 +24642,64 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingChildren = append(m.PendingChildren, &v110.PendingChildExecutionInfo{})
-			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -19764,7 +24724,7 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
+func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19787,17 +24747,17 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicateEventsV2Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicateEventsV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var stringLen uint64
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19807,29 +24767,16 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
-			var msglen int
+			m.Category = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19839,67 +24786,16 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Category |= v16.TaskCategory(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItems", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VersionHistoryItems = append(m.VersionHistoryItems, &v17.VersionHistoryItem{})
-			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var msglen int
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19909,31 +24805,14 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Events == nil {
-				m.Events = &v14.DataBlob{}
-			}
-			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRunEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19960,10 +24839,10 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewRunEvents == nil {
-				m.NewRunEvents = &v14.DataBlob{}
+			if m.VisibilityTime == nil {
+				m.VisibilityTime = new(time.Time)
 			}
-			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19991,7 +24870,7 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
+func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20014,10 +24893,10 @@ func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicateEventsV2Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicateEventsV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -20044,7 +24923,7 @@ func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20067,17 +24946,17 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncShardStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncShardStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20087,29 +24966,31 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.Tokens = append(m.Tokens, &v112.ReplicationToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
 			}
-			m.ShardId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20119,14 +25000,80 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20153,12 +25100,91 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatusTime == nil {
-				m.StatusTime = new(time.Time)
+			if m.ShardMessages == nil {
+				m.ShardMessages = make(map[int32]*v112.ReplicationMessages)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StatusTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey int32
+			var mapvalue *v112.ReplicationMessages
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v112.ReplicationMessages{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.ShardMessages[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20184,7 +25210,7 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20207,12 +25233,46 @@ func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncShardStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncShardStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskInfos = append(m.TaskInfos, &v112.ReplicationTaskInfo{})
+			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -20237,7 +25297,7 @@ func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20260,149 +25320,15 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncActivityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WorkflowId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
-			}
-			m.ScheduledId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduledId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20429,73 +25355,69 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.ReplicationTasks = append(m.ReplicationTasks, &v112.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
-			}
-			m.StartedId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartedId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = new(time.Time)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20505,31 +25427,27 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastHeartbeatTime == nil {
-				m.LastHeartbeatTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20556,18 +25474,18 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &v14.Payloads{}
+			if m.Request == nil {
+				m.Request = &v1.QueryWorkflowRequest{}
 			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryConsistencyLevel", wireType)
 			}
-			m.Attempt = 0
+			m.QueryConsistencyLevel = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20577,14 +25495,67 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				m.QueryConsistencyLevel |= v16.QueryConsistencyLevel(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20611,16 +25582,69 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastFailure == nil {
-				m.LastFailure = &v13.Failure{}
+			if m.Response == nil {
+				m.Response = &v1.QueryWorkflowResponse{}
 			}
-			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkerIdentity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20648,11 +25672,11 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastWorkerIdentity = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20679,10 +25703,10 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistory == nil {
-				m.VersionHistory = &v17.VersionHistory{}
+			if m.Request == nil {
+				m.Request = &v113.ReapplyEventsRequest{}
 			}
-			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20710,7 +25734,7 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
+func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20733,10 +25757,10 @@ func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncActivityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -20763,7 +25787,7 @@ func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20786,15 +25810,53 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20822,13 +25884,51 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			}
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20838,26 +25938,24 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v14.WorkflowExecution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -20884,7 +25982,7 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20907,17 +26005,17 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheMutableState", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var stringLen uint64
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20927,29 +26025,16 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CacheMutableState = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseMutableState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20959,29 +26044,31 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatabaseMutableState = string(dAtA[iNdEx:postIndex])
+			m.ReplicationTasks = append(m.ReplicationTasks, &v112.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20991,55 +26078,25 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TreeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
-			m.BranchId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21065,7 +26122,7 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
+func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21088,17 +26145,17 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var stringLen uint64
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21108,24 +26165,11 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
@@ -21147,7 +26191,7 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21175,13 +26219,13 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
 			}
-			var msglen int
+			m.InclusiveEndMessageId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21191,28 +26235,64 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -21237,7 +26317,7 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
+func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21260,112 +26340,36 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardsNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.ShardsNumber = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardsNumber |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ShardIds = append(m.ShardIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRequestResponse
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRequestResponse
+					return ErrIntOverflowRequestResponse
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ShardIds) == 0 {
-					m.ShardIds = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ShardIds = append(m.ShardIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceCache", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var msglen int
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21375,31 +26379,14 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.NamespaceCache == nil {
-				m.NamespaceCache = &v111.NamespaceCacheInfo{}
-			}
-			if err := m.NamespaceCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardControllerStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21427,13 +26414,51 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShardControllerStatus = string(dAtA[iNdEx:postIndex])
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			}
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21443,23 +26468,25 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21485,7 +26512,7 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
+func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21508,17 +26535,17 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			m.ShardId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21528,64 +26555,26 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -21610,7 +26599,7 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21633,17 +26622,17 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			m.ShardId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21653,52 +26642,27 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
-			}
-			m.Category = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Category |= v16.TaskCategory(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.TaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21725,10 +26689,10 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VisibilityTime == nil {
-				m.VisibilityTime = new(time.Time)
+			if m.Request == nil {
+				m.Request = &v113.RefreshWorkflowTasksRequest{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21756,7 +26720,7 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21779,10 +26743,10 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -21809,7 +26773,7 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *RestoreWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21832,17 +26796,17 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21852,31 +26816,29 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, &v112.ReplicationToken{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21886,23 +26848,27 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			if m.Request == nil {
+				m.Request = &v113.RestoreWorkflowExecutionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21928,7 +26894,7 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *RestoreWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21951,127 +26917,12 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ShardMessages == nil {
-				m.ShardMessages = make(map[int32]*v112.ReplicationMessages)
-			}
-			var mapkey int32
-			var mapvalue *v112.ReplicationMessages
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v112.ReplicationMessages{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.ShardMessages[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -22096,7 +26947,7 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *PauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22119,15 +26970,47 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22154,8 +27037,10 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskInfos = append(m.TaskInfos, &v112.ReplicationTaskInfo{})
-			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &v113.PauseWorkflowExecutionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22183,7 +27068,7 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *PauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22197,55 +27082,21 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v112.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -22270,7 +27121,7 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *UnpauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22293,10 +27144,10 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22361,7 +27212,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.QueryWorkflowRequest{}
+				m.Request = &v113.UnpauseWorkflowExecutionRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -22391,7 +27242,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+func (m *UnpauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22414,48 +27265,12 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Response == nil {
-				m.Response = &v1.QueryWorkflowResponse{}
-			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -22480,7 +27295,7 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
+func (m *PauseActivityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22503,10 +27318,10 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseActivityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22571,7 +27386,7 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v113.ReapplyEventsRequest{}
+				m.Request = &v113.PauseActivityRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -22601,7 +27416,7 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
+func (m *PauseActivityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22624,10 +27439,10 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseActivityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -22654,7 +27469,7 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *UnpauseActivityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22677,53 +27492,15 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseActivityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22751,51 +27528,13 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
-			}
-			m.InclusiveEndMessageId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
-			}
-			m.MaximumPageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -22805,24 +27544,26 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			if m.Request == nil {
+				m.Request = &v113.UnpauseActivityRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -22849,7 +27590,7 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *UnpauseActivityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22872,99 +27613,12 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseActivityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v112.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -22989,7 +27643,7 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetActivityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23012,53 +27666,15 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetActivityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23086,13 +27702,13 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			m.InclusiveEndMessageId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -23102,11 +27718,28 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v113.ResetActivityRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -23131,7 +27764,7 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *ResetActivityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23154,10 +27787,10 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetActivityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -23184,7 +27817,7 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateActivityOptionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23207,53 +27840,15 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateActivityOptionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateActivityOptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23281,51 +27876,13 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
-			}
-			m.InclusiveEndMessageId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
-			}
-			m.MaximumPageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -23335,24 +27892,26 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			if m.Request == nil {
+				m.Request = &v113.UpdateActivityOptionsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -23379,7 +27938,7 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateActivityOptionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23402,46 +27961,12 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateActivityOptionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateActivityOptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -23466,7 +27991,7 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *StreamWorkflowExecutionHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23489,10 +28014,10 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamWorkflowExecutionHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamWorkflowExecutionHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -23557,7 +28082,7 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v113.RefreshWorkflowTasksRequest{}
+				m.Request = &v113.StreamWorkflowExecutionHistoryRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -23587,7 +28112,7 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *StreamWorkflowExecutionHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23610,12 +28135,48 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamWorkflowExecutionHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamWorkflowExecutionHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &v113.StreamWorkflowExecutionHistoryResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
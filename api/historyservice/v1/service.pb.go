@@ -54,72 +54,81 @@ func init() {
 }
 
 var fileDescriptor_655983da427ae822 = []byte{
-	// 1034 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x8b, 0x23, 0x45,
-	0x18, 0x87, 0x53, 0x17, 0x0f, 0x85, 0xae, 0xda, 0x8a, 0x1f, 0xa3, 0x36, 0x22, 0x78, 0x4d, 0xd8,
-	0xdd, 0xcb, 0x7e, 0xcc, 0xba, 0xee, 0x64, 0x66, 0x32, 0xb3, 0x3b, 0xa3, 0x4e, 0xb2, 0x28, 0x78,
-	0x91, 0x9a, 0xce, 0xbb, 0x93, 0x62, 0x7a, 0x52, 0x6d, 0x55, 0x25, 0x9a, 0x9b, 0xe0, 0x49, 0x58,
-	0x50, 0x04, 0xc1, 0x93, 0xe0, 0x49, 0x11, 0x04, 0x41, 0x10, 0x04, 0xc1, 0x93, 0xe0, 0x71, 0x8e,
-	0x7b, 0x74, 0x32, 0x17, 0x8f, 0xfb, 0x27, 0x2c, 0x49, 0xa7, 0x6a, 0x52, 0xdd, 0xd5, 0xa1, 0xaa,
-	0x92, 0xdb, 0x6e, 0xa6, 0x7e, 0x4f, 0x3f, 0x5d, 0x5f, 0x6f, 0xa5, 0x82, 0xaf, 0x4a, 0x38, 0xc9,
-	0x18, 0x27, 0x69, 0x43, 0x00, 0x1f, 0x02, 0x6f, 0x90, 0x8c, 0x36, 0x7a, 0x54, 0x48, 0xc6, 0x47,
-	0x93, 0x4f, 0x68, 0x02, 0x8d, 0xe1, 0xe5, 0xc6, 0xec, 0x9f, 0xf5, 0x8c, 0x33, 0xc9, 0xa2, 0xb7,
-	0x55, 0xa8, 0x9e, 0x87, 0xea, 0x24, 0xa3, 0x75, 0x33, 0x54, 0x1f, 0x5e, 0x5e, 0x5b, 0x77, 0x63,
-	0x73, 0xf8, 0x74, 0x00, 0x42, 0x7e, 0xc2, 0x41, 0x64, 0xac, 0x2f, 0x66, 0x0f, 0xb9, 0xf2, 0xb0,
-	0x81, 0x2f, 0xed, 0xe4, 0x8d, 0x3b, 0x79, 0xe3, 0xe8, 0x27, 0x84, 0x5f, 0xea, 0x48, 0xc2, 0xe5,
-	0x47, 0x8c, 0x1f, 0x3f, 0x48, 0xd9, 0x67, 0x5b, 0x9f, 0x43, 0x32, 0x90, 0x94, 0xf5, 0xa3, 0xcd,
-	0xba, 0x93, 0x53, 0xdd, 0x1e, 0x6f, 0xe7, 0x0a, 0x6b, 0x5b, 0x4b, 0x52, 0xf2, 0x17, 0x78, 0xab,
-	0x16, 0x7d, 0x8b, 0xf0, 0xb3, 0x2d, 0x90, 0xfb, 0x03, 0x49, 0x0e, 0x53, 0xe8, 0x48, 0x22, 0x21,
-	0xba, 0xe5, 0x08, 0x2f, 0xe4, 0x94, 0xdb, 0x3b, 0xa1, 0x71, 0x2d, 0xf5, 0x1d, 0xc2, 0xcf, 0x7d,
-	0xc0, 0xd2, 0xd4, 0xb0, 0x72, 0xc5, 0x16, 0x83, 0x4a, 0xeb, 0x76, 0x70, 0x5e, 0x7b, 0xfd, 0x88,
-	0xf0, 0x8b, 0x6d, 0x10, 0x20, 0x3b, 0x92, 0x26, 0xc7, 0xa3, 0xfb, 0x44, 0x1c, 0x1f, 0x0c, 0x60,
-	0x00, 0xd1, 0x86, 0x23, 0xdb, 0x16, 0x56, 0x7e, 0xcd, 0xa5, 0x18, 0xda, 0xf1, 0x37, 0x84, 0x5f,
-	0x6d, 0x43, 0xc2, 0x78, 0x57, 0x0d, 0xfb, 0xa4, 0xd5, 0x74, 0x1e, 0x40, 0x37, 0x6a, 0x39, 0x3f,
-	0xa4, 0x82, 0xa0, 0x6c, 0x77, 0x96, 0x07, 0x59, 0x94, 0xef, 0x24, 0x92, 0x0e, 0xa9, 0x1c, 0x85,
-	0x2b, 0x5b, 0x08, 0x61, 0xca, 0x56, 0x90, 0x56, 0xfe, 0x13, 0xe1, 0xd7, 0xf3, 0xff, 0x1a, 0xef,
-	0xd6, 0x64, 0x27, 0x59, 0x0a, 0x13, 0xeb, 0xbb, 0xee, 0xa3, 0x59, 0x09, 0x51, 0xe2, 0xf7, 0x56,
-	0xc2, 0x2a, 0x74, 0x77, 0xa9, 0xe9, 0x36, 0xa1, 0xa9, 0x57, 0x77, 0x57, 0x10, 0xfc, 0xbb, 0xbb,
-	0x12, 0xa4, 0x95, 0xff, 0x40, 0xf8, 0xb5, 0xf2, 0xb0, 0xec, 0x00, 0xe1, 0xf2, 0x10, 0x88, 0x8c,
-	0x76, 0x83, 0x87, 0x56, 0x33, 0x94, 0xf6, 0xdd, 0x55, 0xa0, 0x6c, 0xf3, 0x64, 0xbe, 0x69, 0xf0,
-	0x3c, 0xb1, 0x42, 0x02, 0xe7, 0x49, 0x05, 0xcb, 0x36, 0x4f, 0xe6, 0x9b, 0x86, 0xcd, 0x93, 0x32,
-	0x21, 0x70, 0x9e, 0xd8, 0x40, 0x85, 0x79, 0x52, 0x7e, 0x3b, 0xd2, 0x4f, 0x60, 0x22, 0xbd, 0xbb,
-	0x44, 0x0f, 0xcd, 0x18, 0xfe, 0xf3, 0x64, 0x01, 0x4a, 0x8b, 0xff, 0x82, 0xf0, 0xcb, 0x1d, 0x7a,
-	0xd4, 0x27, 0x69, 0xf9, 0xc4, 0xe0, 0x5c, 0xeb, 0xed, 0x79, 0x25, 0xbc, 0xbd, 0x2c, 0x46, 0xcb,
-	0xfe, 0x83, 0xf0, 0x9b, 0xb3, 0x56, 0x54, 0xf6, 0x2a, 0xce, 0x39, 0xef, 0xf9, 0x3d, 0xae, 0x12,
-	0xa4, 0xf4, 0xdf, 0x5f, 0x19, 0x4f, 0xbf, 0xc7, 0xaf, 0x08, 0xbf, 0xd2, 0x86, 0x13, 0x36, 0x84,
-	0x3c, 0x64, 0x1c, 0x37, 0xb6, 0x9d, 0xc7, 0xd7, 0x0e, 0x50, 0xde, 0xad, 0xa5, 0x39, 0xda, 0xf7,
-	0x77, 0x84, 0xd7, 0xee, 0x03, 0x3f, 0xa1, 0x7d, 0x22, 0xa1, 0xdc, 0xe3, 0xae, 0x0b, 0xa9, 0x1a,
-	0xa1, 0x9c, 0x77, 0x57, 0x40, 0xd2, 0xd6, 0x93, 0xb3, 0xf0, 0xf4, 0xcc, 0x12, 0x7e, 0x16, 0xb6,
-	0xc7, 0x7d, 0xcf, 0xc2, 0x55, 0x14, 0x6d, 0xfa, 0x37, 0xc2, 0xf1, 0x0c, 0x9a, 0x2f, 0xd1, 0xb2,
-	0xf1, 0x9e, 0xf3, 0xb3, 0x16, 0x61, 0x94, 0xf9, 0xfe, 0x8a, 0x68, 0xc6, 0x01, 0xb5, 0x93, 0xf4,
-	0xa0, 0x3b, 0x48, 0x61, 0xbe, 0xa0, 0x3a, 0x1f, 0x50, 0x6d, 0x61, 0xdf, 0x03, 0xaa, 0x9d, 0xa1,
-	0x1d, 0xff, 0x42, 0xf8, 0x8d, 0xbc, 0x78, 0x36, 0x7b, 0x34, 0xed, 0xea, 0xd7, 0xb8, 0xa8, 0x89,
-	0xf7, 0xbc, 0x4a, 0x70, 0x05, 0x45, 0x59, 0xef, 0xad, 0x06, 0x66, 0x54, 0xc5, 0x4d, 0x10, 0x09,
-	0xa7, 0x87, 0x96, 0x35, 0xe8, 0xba, 0xda, 0x2b, 0x09, 0xbe, 0x55, 0x71, 0x01, 0x48, 0x2b, 0x7f,
-	0x8f, 0xf0, 0xf3, 0x6d, 0xc8, 0x52, 0x9a, 0x10, 0x09, 0x5b, 0x43, 0xe8, 0x4b, 0xf1, 0xe1, 0x95,
-	0xe8, 0xb6, 0x73, 0xc7, 0x14, 0x92, 0x4a, 0xf1, 0xdd, 0x70, 0x80, 0xf1, 0xf5, 0xb3, 0x33, 0xea,
-	0x27, 0x9d, 0x1e, 0xe1, 0xdd, 0xc9, 0x7e, 0x37, 0x10, 0xce, 0x5f, 0x3f, 0x0b, 0x39, 0xdf, 0xaf,
-	0x9f, 0xa5, 0xb8, 0x96, 0xfa, 0x0a, 0xe1, 0xa7, 0x27, 0x7f, 0x55, 0x35, 0x3b, 0xba, 0xe1, 0x81,
-	0x54, 0x21, 0xa5, 0x73, 0x33, 0x28, 0x6b, 0xac, 0x68, 0x35, 0xc6, 0x46, 0x7d, 0xda, 0xf0, 0x9c,
-	0x20, 0xb6, 0xda, 0xd4, 0x5c, 0x8a, 0xa1, 0x1d, 0x7f, 0x40, 0xf8, 0x05, 0xd5, 0x64, 0x76, 0x11,
-	0xb2, 0xc3, 0x84, 0x8c, 0xee, 0x78, 0xe2, 0xe7, 0xb2, 0xca, 0x70, 0x63, 0x19, 0x84, 0x16, 0xfc,
-	0x12, 0x61, 0xdc, 0x4c, 0x99, 0x80, 0xe9, 0x78, 0x47, 0xd7, 0x1c, 0xa1, 0x17, 0x11, 0xa5, 0x73,
-	0x3d, 0x20, 0x69, 0x58, 0xe4, 0x55, 0x7e, 0xba, 0x25, 0x5f, 0xf3, 0x3a, 0x18, 0xcc, 0x6f, 0xc4,
-	0xd7, 0x03, 0x92, 0x46, 0x39, 0x6e, 0x81, 0x54, 0x8b, 0x92, 0xb2, 0xfe, 0x3e, 0x08, 0x41, 0x8e,
-	0x40, 0x38, 0x97, 0x63, 0x7b, 0xdc, 0xb7, 0x1c, 0x57, 0x51, 0x8c, 0x9d, 0xb6, 0x05, 0x72, 0x73,
-	0xef, 0xc0, 0x26, 0xdb, 0x72, 0x7f, 0x8c, 0x9d, 0xe0, 0xbb, 0xd3, 0x2e, 0x00, 0x69, 0xe5, 0x87,
-	0x08, 0x3f, 0x73, 0x30, 0x00, 0x3e, 0x52, 0xdb, 0x71, 0xe4, 0xba, 0xfc, 0x8d, 0x94, 0x52, 0x5b,
-	0x0f, 0x0b, 0x1b, 0x3a, 0x6d, 0x20, 0x59, 0x96, 0x8e, 0xf2, 0xbd, 0xd7, 0x59, 0xc7, 0x48, 0xf9,
-	0xea, 0x14, 0xc2, 0x5a, 0xe7, 0x6b, 0x84, 0x2f, 0xe5, 0xbd, 0xa8, 0x47, 0x71, 0xdd, 0xab, 0xf3,
-	0x8b, 0x43, 0x77, 0x2b, 0x30, 0x6d, 0x5e, 0x34, 0x0e, 0xf8, 0x11, 0xcc, 0x3b, 0x39, 0x5f, 0x34,
-	0x16, 0x82, 0xde, 0x17, 0x8d, 0xa5, 0xbc, 0xe1, 0xb5, 0x0f, 0x81, 0x5e, 0xc5, 0xa0, 0xaf, 0x57,
-	0x39, 0x5f, 0xb8, 0x00, 0x7d, 0xc0, 0x41, 0xf4, 0xe6, 0x4f, 0x77, 0xc2, 0xe3, 0x02, 0xb4, 0x1c,
-	0xf6, 0xbf, 0x00, 0xb5, 0x31, 0x94, 0xe3, 0x46, 0x76, 0x7a, 0x16, 0xd7, 0x1e, 0x9d, 0xc5, 0xb5,
-	0xc7, 0x67, 0x31, 0xfa, 0x62, 0x1c, 0xa3, 0x9f, 0xc7, 0x31, 0xfa, 0x77, 0x1c, 0xa3, 0xd3, 0x71,
-	0x8c, 0xfe, 0x1b, 0xc7, 0xe8, 0xff, 0x71, 0x5c, 0x7b, 0x3c, 0x8e, 0xd1, 0x37, 0xe7, 0x71, 0xed,
-	0xf4, 0x3c, 0xae, 0x3d, 0x3a, 0x8f, 0x6b, 0x1f, 0xdf, 0x38, 0x62, 0x17, 0x8f, 0xa7, 0x6c, 0xe1,
-	0x0f, 0x01, 0x37, 0xcd, 0x4f, 0x0e, 0x9f, 0x9a, 0xfe, 0x0e, 0x70, 0xf5, 0x49, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x3e, 0xd6, 0x58, 0xf3, 0xa3, 0x18, 0x00, 0x00,
+	// 1180 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcf, 0x6b, 0x24, 0x45,
+	0x14, 0xc7, 0xa7, 0x2e, 0x1e, 0x0a, 0x5d, 0xb5, 0xfd, 0x1d, 0xb5, 0x11, 0xc1, 0xeb, 0x8c, 0xbb,
+	0x7b, 0xd9, 0x6c, 0x92, 0x5d, 0x37, 0x93, 0x64, 0x92, 0xdd, 0x8c, 0xbb, 0x99, 0xd9, 0x55, 0xf0,
+	0x22, 0x95, 0x9e, 0xb7, 0x99, 0x26, 0x3d, 0xd3, 0x6d, 0x57, 0xf5, 0xe8, 0xdc, 0x04, 0x4f, 0x82,
+	0xa0, 0x08, 0x82, 0x27, 0x41, 0x10, 0x14, 0x41, 0x10, 0x14, 0x41, 0x10, 0xc4, 0x83, 0xe0, 0x31,
+	0xc7, 0x3d, 0x9a, 0xc9, 0xc5, 0xe3, 0xfe, 0x09, 0xd2, 0xd3, 0x53, 0x95, 0xa9, 0xee, 0xea, 0xd9,
+	0xaa, 0xea, 0xb9, 0xed, 0x26, 0xfd, 0xfd, 0xf4, 0xa7, 0xab, 0xaa, 0xeb, 0xbd, 0xae, 0xe0, 0xcb,
+	0x0c, 0x06, 0x51, 0x18, 0x93, 0xa0, 0x41, 0x21, 0x1e, 0x41, 0xdc, 0x20, 0x91, 0xdf, 0xe8, 0xfb,
+	0x94, 0x85, 0xf1, 0x38, 0xfd, 0x89, 0xef, 0x41, 0x63, 0x74, 0xb1, 0x31, 0xfb, 0x67, 0x3d, 0x8a,
+	0x43, 0x16, 0x3a, 0x6f, 0xf0, 0x50, 0x3d, 0x0b, 0xd5, 0x49, 0xe4, 0xd7, 0xe5, 0x50, 0x7d, 0x74,
+	0x71, 0x65, 0x5d, 0x8f, 0x1d, 0xc3, 0x07, 0x09, 0x50, 0xf6, 0x7e, 0x0c, 0x34, 0x0a, 0x87, 0x74,
+	0x76, 0x93, 0x4b, 0xbf, 0xae, 0xe2, 0x0b, 0xbb, 0xd9, 0xc5, 0xdd, 0xec, 0x62, 0xe7, 0x7b, 0x84,
+	0x9f, 0xef, 0x32, 0x12, 0xb3, 0x77, 0xc3, 0xf8, 0xf8, 0x7e, 0x10, 0x7e, 0xb8, 0xfd, 0x11, 0x78,
+	0x09, 0xf3, 0xc3, 0xa1, 0xb3, 0x55, 0xd7, 0x72, 0xaa, 0xab, 0xe3, 0x9d, 0x4c, 0x61, 0x65, 0xbb,
+	0x22, 0x25, 0x7b, 0x80, 0xd7, 0x6b, 0xce, 0x97, 0x08, 0x3f, 0xd9, 0x02, 0xd6, 0x4e, 0x18, 0x39,
+	0x0c, 0xa0, 0xcb, 0x08, 0x03, 0x67, 0x43, 0x13, 0x9e, 0xcb, 0x71, 0xb7, 0x6b, 0xb6, 0x71, 0x21,
+	0xf5, 0x15, 0xc2, 0x4f, 0xdd, 0x09, 0x83, 0x40, 0xb2, 0xd2, 0xc5, 0xe6, 0x83, 0x5c, 0xeb, 0xba,
+	0x75, 0x5e, 0x78, 0x7d, 0x8b, 0xf0, 0xb3, 0x1d, 0xa0, 0xc0, 0xba, 0xcc, 0xf7, 0x8e, 0xc7, 0x77,
+	0x09, 0x3d, 0x3e, 0x48, 0x20, 0x01, 0x67, 0x53, 0x93, 0xad, 0x0a, 0x73, 0xbf, 0x66, 0x25, 0x86,
+	0x70, 0xfc, 0x19, 0xe1, 0x97, 0x3a, 0xe0, 0x85, 0x71, 0x8f, 0x4f, 0x7b, 0x7a, 0xd5, 0x74, 0x1d,
+	0x40, 0xcf, 0x69, 0x69, 0xdf, 0xa4, 0x84, 0xc0, 0x6d, 0x77, 0xab, 0x83, 0x14, 0xca, 0x37, 0x3c,
+	0xe6, 0x8f, 0x7c, 0x36, 0xb6, 0x57, 0x56, 0x10, 0xec, 0x94, 0x95, 0x20, 0xa1, 0xfc, 0x3b, 0xc2,
+	0xaf, 0x64, 0xff, 0x95, 0x9e, 0xad, 0x19, 0x0e, 0xa2, 0x00, 0x52, 0xeb, 0x9b, 0xfa, 0xb3, 0x59,
+	0x0a, 0xe1, 0xe2, 0xb7, 0x96, 0xc2, 0xca, 0x0d, 0x77, 0xe1, 0xd2, 0x1d, 0xe2, 0x07, 0x46, 0xc3,
+	0x5d, 0x42, 0x30, 0x1f, 0xee, 0x52, 0x90, 0x50, 0xfe, 0x0d, 0xe1, 0x97, 0x8b, 0xd3, 0xb2, 0x0b,
+	0x24, 0x66, 0x87, 0x40, 0x98, 0xb3, 0x67, 0x3d, 0xb5, 0x82, 0xc1, 0xb5, 0x6f, 0x2e, 0x03, 0xa5,
+	0x5a, 0x27, 0xf3, 0x97, 0x5a, 0xaf, 0x13, 0x25, 0xc4, 0x72, 0x9d, 0x94, 0xb0, 0x54, 0xeb, 0x64,
+	0xfe, 0x52, 0xbb, 0x75, 0x52, 0x24, 0x58, 0xae, 0x13, 0x15, 0x28, 0xb7, 0x4e, 0x8a, 0x4f, 0x47,
+	0x86, 0x1e, 0xa4, 0xd2, 0x7b, 0x15, 0x46, 0x68, 0xc6, 0x30, 0x5f, 0x27, 0x0b, 0x50, 0x42, 0xfc,
+	0x47, 0x84, 0x5f, 0xe8, 0xfa, 0x47, 0x43, 0x12, 0x14, 0x3b, 0x06, 0xed, 0x5a, 0xaf, 0xce, 0x73,
+	0xe1, 0x9d, 0xaa, 0x18, 0x21, 0xfb, 0x37, 0xc2, 0xaf, 0xcd, 0xae, 0xf2, 0x59, 0xbf, 0xa4, 0xcf,
+	0x79, 0xdb, 0xec, 0x76, 0xa5, 0x20, 0xae, 0x7f, 0x7b, 0x69, 0x3c, 0xf1, 0x1c, 0x3f, 0x21, 0xfc,
+	0x62, 0x07, 0x06, 0xe1, 0x08, 0xb2, 0x90, 0xd4, 0x6e, 0xec, 0x68, 0xcf, 0xaf, 0x1a, 0xc0, 0xbd,
+	0x5b, 0x95, 0x39, 0xc2, 0xf7, 0x17, 0x84, 0x57, 0xee, 0x42, 0x3c, 0xf0, 0x87, 0x84, 0x41, 0x71,
+	0xc4, 0x75, 0x5f, 0xa4, 0x72, 0x04, 0x77, 0xde, 0x5b, 0x02, 0x49, 0x58, 0xa7, 0xbd, 0xf0, 0xb4,
+	0x67, 0xb1, 0xef, 0x85, 0xd5, 0x71, 0xd3, 0x5e, 0xb8, 0x8c, 0x22, 0x4c, 0xff, 0x44, 0xd8, 0x9d,
+	0x41, 0xb3, 0x57, 0xb4, 0x68, 0xbc, 0xaf, 0x7d, 0xaf, 0x45, 0x18, 0x6e, 0xde, 0x5e, 0x12, 0x4d,
+	0x6a, 0x50, 0xbb, 0x5e, 0x1f, 0x7a, 0x49, 0x00, 0xf3, 0x05, 0x55, 0xbb, 0x41, 0x55, 0x85, 0x4d,
+	0x1b, 0x54, 0x35, 0x43, 0x38, 0xfe, 0x81, 0xf0, 0xab, 0x59, 0xf1, 0x6c, 0xf6, 0xfd, 0xa0, 0x27,
+	0x1e, 0xe3, 0xbc, 0x26, 0xde, 0x32, 0x2a, 0xc1, 0x25, 0x14, 0x6e, 0xbd, 0xbf, 0x1c, 0x98, 0x54,
+	0x15, 0xb7, 0x80, 0x7a, 0xb1, 0x7f, 0xa8, 0x78, 0x07, 0x75, 0xdf, 0xf6, 0x52, 0x82, 0x69, 0x55,
+	0x5c, 0x00, 0x12, 0xca, 0x5f, 0x23, 0xfc, 0x74, 0x07, 0xa2, 0xc0, 0xf7, 0x08, 0x83, 0xed, 0x11,
+	0x0c, 0x19, 0x7d, 0xe7, 0x92, 0x73, 0x5d, 0x7b, 0x60, 0x72, 0x49, 0xae, 0xf8, 0x96, 0x3d, 0x40,
+	0xfa, 0xfc, 0xec, 0x8e, 0x87, 0x5e, 0xb7, 0x4f, 0xe2, 0x5e, 0xba, 0xdf, 0x25, 0x54, 0xfb, 0xf3,
+	0x33, 0x97, 0x33, 0xfd, 0xfc, 0x2c, 0xc4, 0x85, 0xd4, 0xa7, 0x08, 0x3f, 0x9e, 0xfe, 0x96, 0xd7,
+	0x6c, 0xe7, 0xaa, 0x01, 0x92, 0x87, 0xb8, 0xce, 0x9a, 0x55, 0x56, 0x7a, 0xa3, 0xf9, 0x1c, 0x4b,
+	0xf5, 0x69, 0xd3, 0x70, 0x81, 0xa8, 0x6a, 0x53, 0xb3, 0x12, 0x43, 0x38, 0x7e, 0x83, 0xf0, 0x33,
+	0xfc, 0x92, 0xd9, 0x41, 0xc8, 0x6e, 0x48, 0x99, 0x73, 0xc3, 0x10, 0x3f, 0x97, 0xe5, 0x86, 0x9b,
+	0x55, 0x10, 0x42, 0xf0, 0x13, 0x84, 0x71, 0x33, 0x08, 0x29, 0x4c, 0xe7, 0xdb, 0xb9, 0xa2, 0x09,
+	0x3d, 0x8f, 0x70, 0x9d, 0x55, 0x8b, 0xa4, 0x64, 0x91, 0x55, 0xf9, 0xe9, 0x96, 0x7c, 0xc5, 0xa8,
+	0x31, 0x98, 0xdf, 0x88, 0x57, 0x2d, 0x92, 0x52, 0x39, 0x6e, 0x01, 0xe3, 0x2f, 0xa5, 0x1f, 0x0e,
+	0xdb, 0x40, 0x29, 0x39, 0x02, 0xaa, 0x5d, 0x8e, 0xd5, 0x71, 0xd3, 0x72, 0x5c, 0x46, 0x91, 0x76,
+	0xda, 0x16, 0xb0, 0xad, 0xfd, 0x03, 0x95, 0x6c, 0x4b, 0xff, 0x36, 0x6a, 0x82, 0xe9, 0x4e, 0xbb,
+	0x00, 0x24, 0x94, 0x3f, 0x43, 0xf8, 0x89, 0x83, 0x04, 0xe2, 0x31, 0xdf, 0x8e, 0x1d, 0xdd, 0xd7,
+	0x5f, 0x4a, 0x71, 0xb5, 0x75, 0xbb, 0xb0, 0xa4, 0xd3, 0x01, 0x12, 0x45, 0xc1, 0x38, 0xdb, 0x7b,
+	0xb5, 0x75, 0xa4, 0x94, 0xa9, 0x4e, 0x2e, 0x2c, 0x74, 0x3e, 0x47, 0xf8, 0x42, 0x36, 0x8a, 0x62,
+	0x16, 0xd7, 0x8d, 0x06, 0x3f, 0x3f, 0x75, 0x1b, 0x96, 0x69, 0xf9, 0xa0, 0x31, 0x89, 0x8f, 0x60,
+	0xde, 0x49, 0xfb, 0xa0, 0x31, 0x17, 0x34, 0x3e, 0x68, 0x2c, 0xe4, 0x25, 0xaf, 0x36, 0x58, 0x7a,
+	0xb5, 0xa1, 0x9a, 0x57, 0x1b, 0x4a, 0xbd, 0xb2, 0x03, 0xd0, 0xfb, 0x31, 0xd0, 0xfe, 0x7c, 0x77,
+	0x47, 0x0d, 0x0e, 0x40, 0x8b, 0x61, 0xf3, 0x03, 0x50, 0x15, 0x23, 0xf7, 0x55, 0x97, 0x86, 0x14,
+	0xfd, 0x99, 0xfe, 0x57, 0x9d, 0x1a, 0x60, 0xfe, 0x55, 0x57, 0xc6, 0x91, 0x36, 0xe4, 0x3b, 0x24,
+	0xa1, 0x60, 0xff, 0x7d, 0xa4, 0x8e, 0x9b, 0x6e, 0xc8, 0x65, 0x14, 0x69, 0x64, 0xef, 0x0d, 0x23,
+	0xb5, 0xab, 0xee, 0xc8, 0x96, 0x01, 0x4c, 0x47, 0xb6, 0x9c, 0x23, 0x6d, 0x7f, 0xd3, 0x87, 0x12,
+	0x8d, 0xdc, 0x9a, 0xc9, 0x50, 0xe4, 0x3b, 0xb9, 0x75, 0xbb, 0xb0, 0xd4, 0xeb, 0xce, 0xac, 0x85,
+	0xd0, 0x86, 0xd9, 0xd3, 0xe6, 0x95, 0xae, 0xd9, 0xc6, 0x73, 0x25, 0x82, 0x02, 0x33, 0x1e, 0x23,
+	0x29, 0x65, 0x5e, 0x22, 0xa4, 0xb0, 0xd0, 0xf9, 0x0e, 0xe1, 0xe7, 0xee, 0x45, 0x3d, 0xc2, 0x84,
+	0xeb, 0xed, 0x28, 0x9d, 0x55, 0xea, 0xe8, 0xee, 0x0e, 0xca, 0x34, 0xd7, 0xdb, 0xaa, 0x06, 0x11,
+	0x9a, 0x7f, 0x21, 0xec, 0x76, 0x59, 0x0c, 0x64, 0x50, 0x58, 0x7f, 0xb3, 0x0e, 0x54, 0xfb, 0xa4,
+	0x60, 0x31, 0xc6, 0xf4, 0xa4, 0xe0, 0x51, 0x34, 0xfe, 0x04, 0x6f, 0xa2, 0xcd, 0xe8, 0xe4, 0xd4,
+	0xad, 0x3d, 0x38, 0x75, 0x6b, 0x0f, 0x4f, 0x5d, 0xf4, 0xf1, 0xc4, 0x45, 0x3f, 0x4c, 0x5c, 0xf4,
+	0xcf, 0xc4, 0x45, 0x27, 0x13, 0x17, 0xfd, 0x3b, 0x71, 0xd1, 0x7f, 0x13, 0xb7, 0xf6, 0x70, 0xe2,
+	0xa2, 0x2f, 0xce, 0xdc, 0xda, 0xc9, 0x99, 0x5b, 0x7b, 0x70, 0xe6, 0xd6, 0xde, 0xbb, 0x7a, 0x14,
+	0x9e, 0xab, 0xf8, 0xe1, 0xc2, 0x3f, 0x98, 0xae, 0xc9, 0x3f, 0x39, 0x7c, 0x6c, 0xfa, 0xf7, 0xd2,
+	0xcb, 0xff, 0x0f, 0x00, 0xd0, 0x4d, 0xaa, 0x91, 0xcb, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -260,6 +269,22 @@ type HistoryServiceClient interface {
 	MergeDLQMessages(ctx context.Context, in *MergeDLQMessagesRequest, opts ...grpc.CallOption) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	// RestoreWorkflowExecution restores a closed workflow execution from the history archival of its namespace.
+	RestoreWorkflowExecution(ctx context.Context, in *RestoreWorkflowExecutionRequest, opts ...grpc.CallOption) (*RestoreWorkflowExecutionResponse, error)
+	// PauseWorkflowExecution stops dispatching the workflow and activity tasks of a running workflow execution.
+	PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes dispatching the workflow and activity tasks of a paused workflow execution.
+	UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
+	// PauseActivity stops dispatching the tasks of a pending activity.
+	PauseActivity(ctx context.Context, in *PauseActivityRequest, opts ...grpc.CallOption) (*PauseActivityResponse, error)
+	// UnpauseActivity resumes dispatching the tasks of a paused activity.
+	UnpauseActivity(ctx context.Context, in *UnpauseActivityRequest, opts ...grpc.CallOption) (*UnpauseActivityResponse, error)
+	// ResetActivity resets the attempt counter and the retry backoff of a pending activity.
+	ResetActivity(ctx context.Context, in *ResetActivityRequest, opts ...grpc.CallOption) (*ResetActivityResponse, error)
+	// UpdateActivityOptions updates the retry policy and the timeouts of a pending activity.
+	UpdateActivityOptions(ctx context.Context, in *UpdateActivityOptionsRequest, opts ...grpc.CallOption) (*UpdateActivityOptionsResponse, error)
+	// StreamWorkflowExecutionHistory streams the history events of a workflow execution as they are committed.
+	StreamWorkflowExecutionHistory(ctx context.Context, in *StreamWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (HistoryService_StreamWorkflowExecutionHistoryClient, error)
 }
 
 type historyServiceClient struct {
@@ -594,6 +619,101 @@ func (c *historyServiceClient) RefreshWorkflowTasks(ctx context.Context, in *Ref
 	return out, nil
 }
 
+func (c *historyServiceClient) RestoreWorkflowExecution(ctx context.Context, in *RestoreWorkflowExecutionRequest, opts ...grpc.CallOption) (*RestoreWorkflowExecutionResponse, error) {
+	out := new(RestoreWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/RestoreWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	out := new(PauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/PauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	out := new(UnpauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UnpauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) PauseActivity(ctx context.Context, in *PauseActivityRequest, opts ...grpc.CallOption) (*PauseActivityResponse, error) {
+	out := new(PauseActivityResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/PauseActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UnpauseActivity(ctx context.Context, in *UnpauseActivityRequest, opts ...grpc.CallOption) (*UnpauseActivityResponse, error) {
+	out := new(UnpauseActivityResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UnpauseActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ResetActivity(ctx context.Context, in *ResetActivityRequest, opts ...grpc.CallOption) (*ResetActivityResponse, error) {
+	out := new(ResetActivityResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/ResetActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UpdateActivityOptions(ctx context.Context, in *UpdateActivityOptionsRequest, opts ...grpc.CallOption) (*UpdateActivityOptionsResponse, error) {
+	out := new(UpdateActivityOptionsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UpdateActivityOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) StreamWorkflowExecutionHistory(ctx context.Context, in *StreamWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (HistoryService_StreamWorkflowExecutionHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_HistoryService_serviceDesc.Streams[0], "/temporal.server.api.historyservice.v1.HistoryService/StreamWorkflowExecutionHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &historyServiceStreamWorkflowExecutionHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HistoryService_StreamWorkflowExecutionHistoryClient interface {
+	Recv() (*StreamWorkflowExecutionHistoryResponse, error)
+	grpc.ClientStream
+}
+
+type historyServiceStreamWorkflowExecutionHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *historyServiceStreamWorkflowExecutionHistoryClient) Recv() (*StreamWorkflowExecutionHistoryResponse, error) {
+	m := new(StreamWorkflowExecutionHistoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 type HistoryServiceServer interface {
 	// StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
@@ -722,6 +842,22 @@ type HistoryServiceServer interface {
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	// RestoreWorkflowExecution restores a closed workflow execution from the history archival of its namespace.
+	RestoreWorkflowExecution(context.Context, *RestoreWorkflowExecutionRequest) (*RestoreWorkflowExecutionResponse, error)
+	// PauseWorkflowExecution stops dispatching the workflow and activity tasks of a running workflow execution.
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes dispatching the workflow and activity tasks of a paused workflow execution.
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	// PauseActivity stops dispatching the tasks of a pending activity.
+	PauseActivity(context.Context, *PauseActivityRequest) (*PauseActivityResponse, error)
+	// UnpauseActivity resumes dispatching the tasks of a paused activity.
+	UnpauseActivity(context.Context, *UnpauseActivityRequest) (*UnpauseActivityResponse, error)
+	// ResetActivity resets the attempt counter and the retry backoff of a pending activity.
+	ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error)
+	// UpdateActivityOptions updates the retry policy and the timeouts of a pending activity.
+	UpdateActivityOptions(context.Context, *UpdateActivityOptionsRequest) (*UpdateActivityOptionsResponse, error)
+	// StreamWorkflowExecutionHistory streams the history events of a workflow execution as they are committed.
+	StreamWorkflowExecutionHistory(*StreamWorkflowExecutionHistoryRequest, HistoryService_StreamWorkflowExecutionHistoryServer) error
 }
 
 // UnimplementedHistoryServiceServer can be embedded to have forward compatible implementations.
@@ -836,6 +972,30 @@ func (*UnimplementedHistoryServiceServer) MergeDLQMessages(ctx context.Context,
 func (*UnimplementedHistoryServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
 }
+func (*UnimplementedHistoryServiceServer) RestoreWorkflowExecution(ctx context.Context, req *RestoreWorkflowExecutionRequest) (*RestoreWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreWorkflowExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) PauseWorkflowExecution(ctx context.Context, req *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflowExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) UnpauseWorkflowExecution(ctx context.Context, req *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseWorkflowExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) PauseActivity(ctx context.Context, req *PauseActivityRequest) (*PauseActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseActivity not implemented")
+}
+func (*UnimplementedHistoryServiceServer) UnpauseActivity(ctx context.Context, req *UnpauseActivityRequest) (*UnpauseActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseActivity not implemented")
+}
+func (*UnimplementedHistoryServiceServer) ResetActivity(ctx context.Context, req *ResetActivityRequest) (*ResetActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetActivity not implemented")
+}
+func (*UnimplementedHistoryServiceServer) UpdateActivityOptions(ctx context.Context, req *UpdateActivityOptionsRequest) (*UpdateActivityOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateActivityOptions not implemented")
+}
+func (*UnimplementedHistoryServiceServer) StreamWorkflowExecutionHistory(req *StreamWorkflowExecutionHistoryRequest, srv HistoryService_StreamWorkflowExecutionHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWorkflowExecutionHistory not implemented")
+}
 
 func RegisterHistoryServiceServer(s *grpc.Server, srv HistoryServiceServer) {
 	s.RegisterService(&_HistoryService_serviceDesc, srv)
@@ -1489,6 +1649,153 @@ func _HistoryService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_RestoreWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).RestoreWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/RestoreWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).RestoreWorkflowExecution(ctx, req.(*RestoreWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_PauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).PauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/PauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).PauseWorkflowExecution(ctx, req.(*PauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UnpauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UnpauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UnpauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UnpauseWorkflowExecution(ctx, req.(*UnpauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_PauseActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).PauseActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/PauseActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).PauseActivity(ctx, req.(*PauseActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UnpauseActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UnpauseActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UnpauseActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UnpauseActivity(ctx, req.(*UnpauseActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ResetActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ResetActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/ResetActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ResetActivity(ctx, req.(*ResetActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UpdateActivityOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivityOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpdateActivityOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UpdateActivityOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpdateActivityOptions(ctx, req.(*UpdateActivityOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_StreamWorkflowExecutionHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamWorkflowExecutionHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HistoryServiceServer).StreamWorkflowExecutionHistory(m, &historyServiceStreamWorkflowExecutionHistoryServer{stream})
+}
+
+type HistoryService_StreamWorkflowExecutionHistoryServer interface {
+	Send(*StreamWorkflowExecutionHistoryResponse) error
+	grpc.ServerStream
+}
+
+type historyServiceStreamWorkflowExecutionHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *historyServiceStreamWorkflowExecutionHistoryServer) Send(m *StreamWorkflowExecutionHistoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _HistoryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.historyservice.v1.HistoryService",
 	HandlerType: (*HistoryServiceServer)(nil),
@@ -1637,7 +1944,41 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshWorkflowTasks",
 			Handler:    _HistoryService_RefreshWorkflowTasks_Handler,
 		},
+		{
+			MethodName: "RestoreWorkflowExecution",
+			Handler:    _HistoryService_RestoreWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "PauseWorkflowExecution",
+			Handler:    _HistoryService_PauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UnpauseWorkflowExecution",
+			Handler:    _HistoryService_UnpauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "PauseActivity",
+			Handler:    _HistoryService_PauseActivity_Handler,
+		},
+		{
+			MethodName: "UnpauseActivity",
+			Handler:    _HistoryService_UnpauseActivity_Handler,
+		},
+		{
+			MethodName: "ResetActivity",
+			Handler:    _HistoryService_ResetActivity_Handler,
+		},
+		{
+			MethodName: "UpdateActivityOptions",
+			Handler:    _HistoryService_UpdateActivityOptions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamWorkflowExecutionHistory",
+			Handler:       _HistoryService_StreamWorkflowExecutionHistory_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/historyservice/v1/service.proto",
 }
@@ -35,6 +35,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	historyservice "go.temporal.io/server/api/historyservice/v1"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // MockHistoryServiceClient is a mock of HistoryServiceClient interface.
@@ -780,6 +781,289 @@ func (mr *MockHistoryServiceClientMockRecorder) RefreshWorkflowTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockHistoryServiceClient)(nil).RefreshWorkflowTasks), varargs...)
 }
 
+// RestoreWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) RestoreWorkflowExecution(ctx context.Context, in *historyservice.RestoreWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.RestoreWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.RestoreWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreWorkflowExecution indicates an expected call of RestoreWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) RestoreWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).RestoreWorkflowExecution), varargs...)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) PauseWorkflowExecution(ctx context.Context, in *historyservice.PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) PauseWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).PauseWorkflowExecution), varargs...)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *historyservice.UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) UnpauseWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).UnpauseWorkflowExecution), varargs...)
+}
+
+// PauseActivity mocks base method.
+func (m *MockHistoryServiceClient) PauseActivity(ctx context.Context, in *historyservice.PauseActivityRequest, opts ...grpc.CallOption) (*historyservice.PauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseActivity", varargs...)
+	ret0, _ := ret[0].(*historyservice.PauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockHistoryServiceClientMockRecorder) PauseActivity(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockHistoryServiceClient)(nil).PauseActivity), varargs...)
+}
+
+// UnpauseActivity mocks base method.
+func (m *MockHistoryServiceClient) UnpauseActivity(ctx context.Context, in *historyservice.UnpauseActivityRequest, opts ...grpc.CallOption) (*historyservice.UnpauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseActivity", varargs...)
+	ret0, _ := ret[0].(*historyservice.UnpauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockHistoryServiceClientMockRecorder) UnpauseActivity(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockHistoryServiceClient)(nil).UnpauseActivity), varargs...)
+}
+
+// ResetActivity mocks base method.
+func (m *MockHistoryServiceClient) ResetActivity(ctx context.Context, in *historyservice.ResetActivityRequest, opts ...grpc.CallOption) (*historyservice.ResetActivityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetActivity", varargs...)
+	ret0, _ := ret[0].(*historyservice.ResetActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetActivity indicates an expected call of ResetActivity.
+func (mr *MockHistoryServiceClientMockRecorder) ResetActivity(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivity", reflect.TypeOf((*MockHistoryServiceClient)(nil).ResetActivity), varargs...)
+}
+
+// UpdateActivityOptions mocks base method.
+func (m *MockHistoryServiceClient) UpdateActivityOptions(ctx context.Context, in *historyservice.UpdateActivityOptionsRequest, opts ...grpc.CallOption) (*historyservice.UpdateActivityOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", varargs...)
+	ret0, _ := ret[0].(*historyservice.UpdateActivityOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockHistoryServiceClientMockRecorder) UpdateActivityOptions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateActivityOptions), varargs...)
+}
+
+// StreamWorkflowExecutionHistory mocks base method.
+func (m *MockHistoryServiceClient) StreamWorkflowExecutionHistory(ctx context.Context, in *historyservice.StreamWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (historyservice.HistoryService_StreamWorkflowExecutionHistoryClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamWorkflowExecutionHistory", varargs...)
+	ret0, _ := ret[0].(historyservice.HistoryService_StreamWorkflowExecutionHistoryClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamWorkflowExecutionHistory indicates an expected call of StreamWorkflowExecutionHistory.
+func (mr *MockHistoryServiceClientMockRecorder) StreamWorkflowExecutionHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowExecutionHistory", reflect.TypeOf((*MockHistoryServiceClient)(nil).StreamWorkflowExecutionHistory), varargs...)
+}
+
+// MockHistoryService_StreamWorkflowExecutionHistoryClient is a mock of HistoryService_StreamWorkflowExecutionHistoryClient interface.
+type MockHistoryService_StreamWorkflowExecutionHistoryClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryService_StreamWorkflowExecutionHistoryClientMockRecorder
+}
+
+// MockHistoryService_StreamWorkflowExecutionHistoryClientMockRecorder is the mock recorder for MockHistoryService_StreamWorkflowExecutionHistoryClient.
+type MockHistoryService_StreamWorkflowExecutionHistoryClientMockRecorder struct {
+	mock *MockHistoryService_StreamWorkflowExecutionHistoryClient
+}
+
+// NewMockHistoryService_StreamWorkflowExecutionHistoryClient creates a new mock instance.
+func NewMockHistoryService_StreamWorkflowExecutionHistoryClient(ctrl *gomock.Controller) *MockHistoryService_StreamWorkflowExecutionHistoryClient {
+	mock := &MockHistoryService_StreamWorkflowExecutionHistoryClient{ctrl: ctrl}
+	mock.recorder = &MockHistoryService_StreamWorkflowExecutionHistoryClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryService_StreamWorkflowExecutionHistoryClient) EXPECT() *MockHistoryService_StreamWorkflowExecutionHistoryClientMockRecorder {
+	return m.recorder
+}
+
+// Recv mocks base method.
+func (m *MockHistoryService_StreamWorkflowExecutionHistoryClient) Recv() (*historyservice.StreamWorkflowExecutionHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*historyservice.StreamWorkflowExecutionHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockHistoryService_StreamWorkflowExecutionHistoryClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockHistoryService_StreamWorkflowExecutionHistoryClient)(nil).Recv))
+}
+
+// Header mocks base method.
+func (m *MockHistoryService_StreamWorkflowExecutionHistoryClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockHistoryService_StreamWorkflowExecutionHistoryClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockHistoryService_StreamWorkflowExecutionHistoryClient)(nil).Header))
+}
+
+// Trailer mocks base method.
+func (m *MockHistoryService_StreamWorkflowExecutionHistoryClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockHistoryService_StreamWorkflowExecutionHistoryClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockHistoryService_StreamWorkflowExecutionHistoryClient)(nil).Trailer))
+}
+
+// CloseSend mocks base method.
+func (m *MockHistoryService_StreamWorkflowExecutionHistoryClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockHistoryService_StreamWorkflowExecutionHistoryClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockHistoryService_StreamWorkflowExecutionHistoryClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockHistoryService_StreamWorkflowExecutionHistoryClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockHistoryService_StreamWorkflowExecutionHistoryClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockHistoryService_StreamWorkflowExecutionHistoryClient)(nil).Context))
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockHistoryService_StreamWorkflowExecutionHistoryClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockHistoryService_StreamWorkflowExecutionHistoryClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockHistoryService_StreamWorkflowExecutionHistoryClient)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockHistoryService_StreamWorkflowExecutionHistoryClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockHistoryService_StreamWorkflowExecutionHistoryClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockHistoryService_StreamWorkflowExecutionHistoryClient)(nil).RecvMsg), m)
+}
+
 // MockHistoryServiceServer is a mock of HistoryServiceServer interface.
 type MockHistoryServiceServer struct {
 	ctrl     *gomock.Controller
@@ -1342,3 +1626,241 @@ func (mr *MockHistoryServiceServerMockRecorder) RefreshWorkflowTasks(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockHistoryServiceServer)(nil).RefreshWorkflowTasks), arg0, arg1)
 }
+
+// RestoreWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) RestoreWorkflowExecution(arg0 context.Context, arg1 *historyservice.RestoreWorkflowExecutionRequest) (*historyservice.RestoreWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.RestoreWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreWorkflowExecution indicates an expected call of RestoreWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) RestoreWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).RestoreWorkflowExecution), arg0, arg1)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) PauseWorkflowExecution(arg0 context.Context, arg1 *historyservice.PauseWorkflowExecutionRequest) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) PauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).PauseWorkflowExecution), arg0, arg1)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) UnpauseWorkflowExecution(arg0 context.Context, arg1 *historyservice.UnpauseWorkflowExecutionRequest) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) UnpauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).UnpauseWorkflowExecution), arg0, arg1)
+}
+
+// PauseActivity mocks base method.
+func (m *MockHistoryServiceServer) PauseActivity(arg0 context.Context, arg1 *historyservice.PauseActivityRequest) (*historyservice.PauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.PauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockHistoryServiceServerMockRecorder) PauseActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockHistoryServiceServer)(nil).PauseActivity), arg0, arg1)
+}
+
+// UnpauseActivity mocks base method.
+func (m *MockHistoryServiceServer) UnpauseActivity(arg0 context.Context, arg1 *historyservice.UnpauseActivityRequest) (*historyservice.UnpauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UnpauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockHistoryServiceServerMockRecorder) UnpauseActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockHistoryServiceServer)(nil).UnpauseActivity), arg0, arg1)
+}
+
+// ResetActivity mocks base method.
+func (m *MockHistoryServiceServer) ResetActivity(arg0 context.Context, arg1 *historyservice.ResetActivityRequest) (*historyservice.ResetActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivity", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ResetActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetActivity indicates an expected call of ResetActivity.
+func (mr *MockHistoryServiceServerMockRecorder) ResetActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivity", reflect.TypeOf((*MockHistoryServiceServer)(nil).ResetActivity), arg0, arg1)
+}
+
+// UpdateActivityOptions mocks base method.
+func (m *MockHistoryServiceServer) UpdateActivityOptions(arg0 context.Context, arg1 *historyservice.UpdateActivityOptionsRequest) (*historyservice.UpdateActivityOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UpdateActivityOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockHistoryServiceServerMockRecorder) UpdateActivityOptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateActivityOptions), arg0, arg1)
+}
+
+// StreamWorkflowExecutionHistory mocks base method.
+func (m *MockHistoryServiceServer) StreamWorkflowExecutionHistory(arg0 *historyservice.StreamWorkflowExecutionHistoryRequest, arg1 historyservice.HistoryService_StreamWorkflowExecutionHistoryServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamWorkflowExecutionHistory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamWorkflowExecutionHistory indicates an expected call of StreamWorkflowExecutionHistory.
+func (mr *MockHistoryServiceServerMockRecorder) StreamWorkflowExecutionHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowExecutionHistory", reflect.TypeOf((*MockHistoryServiceServer)(nil).StreamWorkflowExecutionHistory), arg0, arg1)
+}
+
+// MockHistoryService_StreamWorkflowExecutionHistoryServer is a mock of HistoryService_StreamWorkflowExecutionHistoryServer interface.
+type MockHistoryService_StreamWorkflowExecutionHistoryServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryService_StreamWorkflowExecutionHistoryServerMockRecorder
+}
+
+// MockHistoryService_StreamWorkflowExecutionHistoryServerMockRecorder is the mock recorder for MockHistoryService_StreamWorkflowExecutionHistoryServer.
+type MockHistoryService_StreamWorkflowExecutionHistoryServerMockRecorder struct {
+	mock *MockHistoryService_StreamWorkflowExecutionHistoryServer
+}
+
+// NewMockHistoryService_StreamWorkflowExecutionHistoryServer creates a new mock instance.
+func NewMockHistoryService_StreamWorkflowExecutionHistoryServer(ctrl *gomock.Controller) *MockHistoryService_StreamWorkflowExecutionHistoryServer {
+	mock := &MockHistoryService_StreamWorkflowExecutionHistoryServer{ctrl: ctrl}
+	mock.recorder = &MockHistoryService_StreamWorkflowExecutionHistoryServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryService_StreamWorkflowExecutionHistoryServer) EXPECT() *MockHistoryService_StreamWorkflowExecutionHistoryServerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockHistoryService_StreamWorkflowExecutionHistoryServer) Send(arg0 *historyservice.StreamWorkflowExecutionHistoryResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockHistoryService_StreamWorkflowExecutionHistoryServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockHistoryService_StreamWorkflowExecutionHistoryServer)(nil).Send), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockHistoryService_StreamWorkflowExecutionHistoryServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockHistoryService_StreamWorkflowExecutionHistoryServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockHistoryService_StreamWorkflowExecutionHistoryServer)(nil).SetHeader), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockHistoryService_StreamWorkflowExecutionHistoryServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockHistoryService_StreamWorkflowExecutionHistoryServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockHistoryService_StreamWorkflowExecutionHistoryServer)(nil).SendHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockHistoryService_StreamWorkflowExecutionHistoryServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockHistoryService_StreamWorkflowExecutionHistoryServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockHistoryService_StreamWorkflowExecutionHistoryServer)(nil).SetTrailer), arg0)
+}
+
+// Context mocks base method.
+func (m *MockHistoryService_StreamWorkflowExecutionHistoryServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockHistoryService_StreamWorkflowExecutionHistoryServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockHistoryService_StreamWorkflowExecutionHistoryServer)(nil).Context))
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockHistoryService_StreamWorkflowExecutionHistoryServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockHistoryService_StreamWorkflowExecutionHistoryServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockHistoryService_StreamWorkflowExecutionHistoryServer)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockHistoryService_StreamWorkflowExecutionHistoryServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockHistoryService_StreamWorkflowExecutionHistoryServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockHistoryService_StreamWorkflowExecutionHistoryServer)(nil).RecvMsg), m)
+}
@@ -148,6 +148,8 @@ type PollWorkflowTaskQueueResponse struct {
 	ScheduledTime              *time.Time                     `protobuf:"bytes,15,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
 	StartedTime                *time.Time                     `protobuf:"bytes,16,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
 	Queries                    map[string]*v12.WorkflowQuery  `protobuf:"bytes,17,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	HistorySizeBytes           int64                          `protobuf:"varint,18,opt,name=history_size_bytes,json=historySizeBytes,proto3" json:"history_size_bytes,omitempty"`
+	SuggestContinueAsNew       bool                           `protobuf:"varint,19,opt,name=suggest_continue_as_new,json=suggestContinueAsNew,proto3" json:"suggest_continue_as_new,omitempty"`
 }
 
 func (m *PollWorkflowTaskQueueResponse) Reset()      { *m = PollWorkflowTaskQueueResponse{} }
@@ -301,6 +303,20 @@ func (m *PollWorkflowTaskQueueResponse) GetQueries() map[string]*v12.WorkflowQue
 	return nil
 }
 
+func (m *PollWorkflowTaskQueueResponse) GetHistorySizeBytes() int64 {
+	if m != nil {
+		return m.HistorySizeBytes
+	}
+	return 0
+}
+
+func (m *PollWorkflowTaskQueueResponse) GetSuggestContinueAsNew() bool {
+	if m != nil {
+		return m.SuggestContinueAsNew
+	}
+	return false
+}
+
 type PollActivityTaskQueueRequest struct {
 	NamespaceId     string                           `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	PollerId        string                           `protobuf:"bytes,2,opt,name=poller_id,json=pollerId,proto3" json:"poller_id,omitempty"`
@@ -1352,116 +1368,119 @@ func init() {
 }
 
 var fileDescriptor_a429a3813476c583 = []byte{
-	// 1733 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0x13, 0xcd,
-	0x1d, 0xcf, 0x3a, 0x89, 0x13, 0xcf, 0x3a, 0x89, 0xb3, 0xb4, 0xc1, 0x09, 0x64, 0x13, 0x0c, 0x85,
-	0x50, 0xd1, 0xb5, 0x48, 0x05, 0x02, 0x5a, 0xd4, 0x86, 0x10, 0x81, 0x55, 0xa0, 0x61, 0x63, 0xd1,
-	0x0a, 0x55, 0x5a, 0x26, 0xbb, 0x13, 0x67, 0x9b, 0xf5, 0x8e, 0xb3, 0x33, 0xeb, 0xe0, 0x9e, 0x2a,
-	0x55, 0xbd, 0x23, 0xf5, 0xd2, 0xaa, 0x5f, 0xa0, 0x3d, 0xf4, 0xd4, 0x7e, 0x88, 0x1e, 0x7a, 0xe0,
-	0xc8, 0xad, 0x25, 0xb9, 0x54, 0x7a, 0x2e, 0x3c, 0xdf, 0xe0, 0xd1, 0xbc, 0xec, 0x7a, 0x77, 0x6d,
-	0x27, 0x4e, 0x88, 0x1e, 0x9e, 0x9b, 0xf7, 0xff, 0x36, 0xff, 0xd7, 0xdf, 0xfc, 0xc7, 0xe0, 0x21,
-	0x45, 0xcd, 0x16, 0x0e, 0xa0, 0x57, 0x25, 0x28, 0x68, 0xa3, 0xa0, 0x0a, 0x5b, 0x6e, 0xb5, 0x09,
-	0xa9, 0xbd, 0xeb, 0xfa, 0x0d, 0x46, 0x72, 0x6d, 0x54, 0x6d, 0xdf, 0xae, 0x06, 0x68, 0x3f, 0x44,
-	0x84, 0x5a, 0x01, 0x22, 0x2d, 0xec, 0x13, 0x64, 0xb4, 0x02, 0x4c, 0xb1, 0x76, 0x3d, 0x52, 0x37,
-	0x84, 0xba, 0x01, 0x5b, 0xae, 0x91, 0x51, 0x37, 0xda, 0xb7, 0x17, 0xf4, 0x06, 0xc6, 0x0d, 0x0f,
-	0x55, 0xb9, 0xd6, 0x76, 0xb8, 0x53, 0x75, 0xc2, 0x00, 0x52, 0x17, 0xfb, 0xc2, 0xce, 0xc2, 0x52,
-	0x96, 0x4f, 0xdd, 0x26, 0x22, 0x14, 0x36, 0x5b, 0x52, 0xe0, 0x8a, 0x83, 0x5a, 0xc8, 0x77, 0x90,
-	0x6f, 0xbb, 0x88, 0x54, 0x1b, 0xb8, 0x81, 0x39, 0x9d, 0xff, 0x92, 0x22, 0xd7, 0xe2, 0x50, 0x58,
-	0x0c, 0x36, 0x6e, 0x36, 0xb1, 0xcf, 0x5c, 0x6f, 0x22, 0x42, 0x60, 0x43, 0x7a, 0xbc, 0x70, 0x3d,
-	0x25, 0x85, 0xfc, 0xb0, 0x49, 0x98, 0x10, 0x85, 0x64, 0xcf, 0xda, 0x0f, 0x51, 0x18, 0xc9, 0xdd,
-	0x48, 0xc9, 0x31, 0x36, 0xe7, 0xf6, 0x1a, 0xbc, 0x9a, 0x12, 0xdc, 0x0f, 0x51, 0xd0, 0xe9, 0x15,
-	0xba, 0xd1, 0x2f, 0xcd, 0xa9, 0xc3, 0xa5, 0xe0, 0xad, 0x7e, 0x82, 0xbb, 0x2e, 0xa1, 0xb8, 0x9f,
-	0xd9, 0xbb, 0xa9, 0xb3, 0x0f, 0x70, 0xb0, 0xb7, 0xe3, 0xe1, 0x83, 0x13, 0xcb, 0x56, 0xf9, 0x4a,
-	0x01, 0x97, 0x37, 0xb1, 0xe7, 0xfd, 0x4a, 0x6a, 0xd4, 0x21, 0xd9, 0x7b, 0xc9, 0xc2, 0x33, 0x85,
-	0xbc, 0x76, 0x05, 0x14, 0x7d, 0xd8, 0x44, 0xa4, 0x05, 0x6d, 0x64, 0xb9, 0x4e, 0x59, 0x59, 0x56,
-	0x56, 0x0a, 0xa6, 0x1a, 0xd3, 0x6a, 0x8e, 0x76, 0x09, 0x14, 0x5a, 0xd8, 0xf3, 0x50, 0xc0, 0xf8,
-	0x39, 0xce, 0x9f, 0x14, 0x84, 0x9a, 0xa3, 0xbd, 0x01, 0x45, 0xf6, 0xdb, 0x92, 0xe7, 0x97, 0x47,
-	0x97, 0x95, 0x15, 0x75, 0xf5, 0xa1, 0x11, 0xb7, 0x0b, 0xeb, 0x93, 0x8c, 0xbf, 0x46, 0xfb, 0xb6,
-	0x71, 0x9c, 0x53, 0xa6, 0xca, 0x4c, 0x46, 0x1e, 0xde, 0x04, 0xa5, 0x1d, 0x1c, 0x1c, 0xc0, 0xc0,
-	0x41, 0x8e, 0x45, 0x70, 0x18, 0xd8, 0xa8, 0x3c, 0xc6, 0xbd, 0x98, 0x89, 0xe9, 0x5b, 0x9c, 0x5c,
-	0xf9, 0x47, 0x01, 0x2c, 0x0e, 0x30, 0x2c, 0xb2, 0xa2, 0x2d, 0x02, 0xc0, 0x1b, 0x80, 0xe2, 0x3d,
-	0xe4, 0xf3, 0x60, 0x8b, 0x66, 0x81, 0x51, 0xea, 0x8c, 0xa0, 0xfd, 0x1a, 0x68, 0x91, 0xaf, 0x16,
-	0x7a, 0x8b, 0xec, 0x90, 0x75, 0x2e, 0x8f, 0x59, 0x5d, 0xbd, 0x99, 0x8e, 0x49, 0xb4, 0x1d, 0x0b,
-	0x25, 0x3a, 0x6d, 0x23, 0x52, 0x30, 0x67, 0x0f, 0xb2, 0x24, 0xad, 0x06, 0xa6, 0x62, 0xcb, 0xb4,
-	0xd3, 0x42, 0x32, 0x51, 0xd7, 0x4e, 0x32, 0x5a, 0xef, 0xb4, 0x90, 0x59, 0x3c, 0x48, 0x7c, 0x69,
-	0xf7, 0xc1, 0x7c, 0x2b, 0x40, 0x6d, 0x17, 0x87, 0xc4, 0x22, 0x14, 0x06, 0x14, 0x39, 0x16, 0x6a,
-	0x23, 0x9f, 0xb2, 0xfa, 0xb0, 0xcc, 0x8c, 0x9a, 0x73, 0x91, 0xc0, 0x96, 0xe0, 0x6f, 0x30, 0x76,
-	0xcd, 0xd1, 0x56, 0x40, 0xa9, 0x47, 0x63, 0x9c, 0x6b, 0x4c, 0x93, 0xb4, 0x64, 0x19, 0x4c, 0x40,
-	0xca, 0x7c, 0xa3, 0xe5, 0xfc, 0xb2, 0xb2, 0x32, 0x6e, 0x46, 0x9f, 0x5a, 0x05, 0x4c, 0xf9, 0xe8,
-	0x2d, 0xed, 0x1a, 0x98, 0xe0, 0x06, 0x54, 0x46, 0x8c, 0xb4, 0x6f, 0x01, 0x6d, 0x1b, 0xda, 0x7b,
-	0x1e, 0x6e, 0x58, 0x36, 0x0e, 0x7d, 0x6a, 0xed, 0xba, 0x3e, 0x2d, 0x4f, 0x72, 0xc1, 0x92, 0xe4,
-	0xac, 0x33, 0xc6, 0x53, 0xd7, 0xa7, 0xda, 0x3d, 0x50, 0x26, 0xd4, 0xb5, 0xf7, 0x3a, 0xdd, 0x9c,
-	0x5b, 0xc8, 0x87, 0xdb, 0x1e, 0x72, 0xca, 0x85, 0x65, 0x65, 0x65, 0xd2, 0x9c, 0x13, 0xfc, 0x38,
-	0x9d, 0x1b, 0x82, 0xab, 0x3d, 0x00, 0xe3, 0x7c, 0x0e, 0xcb, 0xa0, 0x5f, 0x36, 0x39, 0x2b, 0x99,
-	0xcc, 0x97, 0x8c, 0x60, 0x0a, 0x15, 0xad, 0x91, 0xa8, 0x35, 0xef, 0x09, 0xd7, 0xdf, 0xc1, 0x65,
-	0x95, 0x1b, 0xba, 0x6f, 0xf4, 0x83, 0x3b, 0x39, 0x9d, 0xcc, 0x62, 0x3d, 0x80, 0x3e, 0x71, 0x91,
-	0x4f, 0x93, 0xad, 0x56, 0xf3, 0x77, 0xb0, 0x59, 0x3a, 0xc8, 0x50, 0xb4, 0x06, 0x58, 0xec, 0x6d,
-	0x2a, 0xab, 0x8b, 0x43, 0xe5, 0x62, 0x3f, 0xe7, 0x63, 0x20, 0xe2, 0xc7, 0xc5, 0x8d, 0xbc, 0xd0,
-	0xd3, 0x5a, 0x31, 0x4f, 0x33, 0xc0, 0x05, 0x51, 0x14, 0xe6, 0x26, 0xb2, 0xda, 0x28, 0x20, 0xac,
-	0x7d, 0xa7, 0x78, 0xfd, 0x66, 0x39, 0x6b, 0x8b, 0x71, 0x5e, 0x09, 0x06, 0x9b, 0xfd, 0xed, 0x00,
-	0xfa, 0xf6, 0xae, 0x1c, 0x87, 0x69, 0x3e, 0x0e, 0xaa, 0xa0, 0x89, 0x81, 0x78, 0x02, 0xa6, 0x89,
-	0xbd, 0x8b, 0x9c, 0xd0, 0x43, 0x8e, 0xc5, 0xa0, 0xba, 0x3c, 0xc3, 0x9d, 0x5d, 0x30, 0x04, 0x8e,
-	0x1b, 0x11, 0x8e, 0x1b, 0xf5, 0x08, 0xc7, 0x1f, 0x8d, 0xbd, 0xfb, 0xef, 0x92, 0x62, 0x4e, 0xc5,
-	0x7a, 0x8c, 0xa3, 0xad, 0x83, 0x62, 0xd4, 0x79, 0xdc, 0x4c, 0x69, 0x48, 0x33, 0xaa, 0xd4, 0xe2,
-	0x46, 0x3c, 0x30, 0xc1, 0x6a, 0xe7, 0x22, 0x52, 0x9e, 0x5d, 0x1e, 0x5d, 0x51, 0x57, 0x4d, 0x63,
-	0xb8, 0x6b, 0xc9, 0x38, 0x16, 0x15, 0x8c, 0x97, 0xc2, 0xe8, 0x86, 0x4f, 0x83, 0x8e, 0x19, 0x1d,
-	0xb1, 0xf0, 0x06, 0x14, 0x93, 0x0c, 0xad, 0x04, 0x46, 0xf7, 0x50, 0x47, 0x22, 0x24, 0xfb, 0xc9,
-	0xda, 0xaf, 0x0d, 0xbd, 0x10, 0x49, 0x84, 0x18, 0xb2, 0xfd, 0xb8, 0xca, 0x83, 0xdc, 0x3d, 0x25,
-	0x46, 0xe7, 0x35, 0x9b, 0xba, 0x6d, 0x97, 0x76, 0xbe, 0x53, 0xe8, 0x3c, 0xc8, 0xa9, 0x33, 0xa3,
-	0xf3, 0x7f, 0x26, 0x05, 0x3a, 0xf7, 0x31, 0xfc, 0xa5, 0xd1, 0x79, 0x09, 0xa8, 0x50, 0x7a, 0xc5,
-	0xd2, 0x38, 0xca, 0x03, 0x00, 0x11, 0xa9, 0xe6, 0x30, 0xf8, 0x8e, 0x05, 0x38, 0x7c, 0x8f, 0x1d,
-	0x0f, 0xdf, 0x71, 0x8c, 0x1c, 0xbe, 0x61, 0xe2, 0x4b, 0xbb, 0x0b, 0xc6, 0x5d, 0xbf, 0x15, 0x52,
-	0x0e, 0xbc, 0xea, 0xea, 0xf2, 0x20, 0x13, 0x9b, 0xb0, 0xe3, 0x61, 0xe8, 0x10, 0x53, 0x88, 0xf7,
-	0x19, 0xc5, 0xfc, 0xd9, 0x46, 0xf1, 0x35, 0x98, 0x8f, 0x08, 0x16, 0xc5, 0x96, 0xed, 0x61, 0x82,
-	0xb8, 0x41, 0x1c, 0x52, 0x0e, 0xe6, 0xea, 0xea, 0x7c, 0x8f, 0xcd, 0xc7, 0x72, 0x8d, 0x7b, 0x34,
-	0xf6, 0x67, 0x66, 0x72, 0x2e, 0xb2, 0x50, 0xc7, 0xeb, 0x4c, 0xbf, 0x2e, 0xd4, 0x7b, 0xc6, 0x7c,
-	0xf2, 0x2c, 0x63, 0x5e, 0x07, 0x73, 0xfc, 0xb3, 0xd7, 0xbb, 0xc2, 0x70, 0xde, 0x5d, 0xe0, 0xea,
-	0x19, 0xd7, 0x9e, 0x81, 0xd9, 0x5d, 0x04, 0x03, 0xba, 0x8d, 0x20, 0x8d, 0x0d, 0x82, 0xe1, 0x0c,
-	0x96, 0x62, 0xcd, 0xc8, 0x5a, 0xe2, 0x7e, 0x54, 0xd3, 0xf7, 0x23, 0x02, 0xba, 0x1d, 0x06, 0x01,
-	0xc3, 0x61, 0x49, 0xb2, 0x32, 0x75, 0x2b, 0x0e, 0x99, 0x94, 0x4b, 0xd2, 0xce, 0x9a, 0x30, 0xb3,
-	0x95, 0xaa, 0xe2, 0xf3, 0x64, 0x38, 0x0e, 0xa2, 0xd0, 0xf5, 0x08, 0x87, 0xfa, 0x61, 0x5a, 0xaa,
-	0x1b, 0xcf, 0x63, 0xa1, 0xd9, 0xbb, 0x9f, 0x4c, 0x9f, 0x79, 0x3f, 0xf9, 0x51, 0x62, 0x4c, 0x63,
-	0xa4, 0xe2, 0xf7, 0x46, 0xa1, 0x3b, 0x7b, 0x2f, 0x22, 0x86, 0x76, 0x17, 0xe4, 0x77, 0x11, 0x74,
-	0x50, 0x20, 0xef, 0x04, 0x7d, 0xd0, 0x91, 0x4f, 0xb9, 0x94, 0x29, 0xa5, 0x2b, 0xff, 0x1c, 0x05,
-	0x73, 0x6b, 0x8e, 0x93, 0x44, 0xf5, 0x53, 0xc0, 0xe6, 0x13, 0x50, 0xf8, 0x0c, 0x08, 0xe9, 0xea,
-	0x6a, 0xeb, 0x12, 0xb3, 0xc4, 0x55, 0x3e, 0x7a, 0x8a, 0xab, 0x9c, 0x23, 0x9b, 0xb8, 0xb9, 0x97,
-	0x80, 0x1a, 0x8f, 0x64, 0xbc, 0xc4, 0x81, 0x88, 0x54, 0x73, 0xb2, 0x33, 0x2b, 0xc7, 0x43, 0x36,
-	0xf1, 0xf8, 0xa9, 0x67, 0x96, 0xaf, 0x85, 0x51, 0x2b, 0xf7, 0x83, 0xf0, 0x7c, 0x5f, 0x08, 0xd7,
-	0x7e, 0x0e, 0xf2, 0x52, 0x80, 0xe1, 0xc4, 0xf4, 0xea, 0x4a, 0xdf, 0xfb, 0x97, 0x3f, 0x77, 0xa2,
-	0x58, 0x85, 0xa6, 0x29, 0xf5, 0x2a, 0xf3, 0xe0, 0x62, 0x4f, 0xd1, 0x04, 0xfa, 0x57, 0x8e, 0x44,
-	0x41, 0x93, 0xd7, 0xc3, 0x97, 0x28, 0xa8, 0x01, 0x2e, 0x08, 0x5f, 0xad, 0xd4, 0x91, 0xe2, 0x4e,
-	0x98, 0x15, 0xac, 0x17, 0x89, 0x83, 0xd3, 0x0d, 0x30, 0x76, 0x2e, 0x0d, 0x30, 0x7e, 0xba, 0x06,
-	0xc8, 0x9f, 0x7f, 0x03, 0x4c, 0x9c, 0xd4, 0x00, 0x93, 0x9f, 0xd5, 0x00, 0xe9, 0x22, 0xcb, 0x06,
-	0xf8, 0x63, 0x0e, 0x7c, 0x8f, 0xef, 0x48, 0x51, 0x7d, 0x4e, 0x51, 0xfe, 0x74, 0x15, 0x72, 0x67,
-	0xab, 0xc2, 0x6b, 0x30, 0xc5, 0x97, 0xb6, 0xcc, 0xbe, 0x74, 0xe7, 0xc4, 0x7d, 0xa9, 0x9f, 0xd7,
-	0x66, 0x91, 0xdb, 0x3a, 0xc3, 0xa2, 0xf4, 0x77, 0x05, 0x7c, 0x3f, 0x63, 0x51, 0x2e, 0x48, 0xeb,
-	0xa0, 0x18, 0x39, 0x48, 0x42, 0x8f, 0xf2, 0x44, 0x0c, 0x83, 0xf7, 0xaa, 0x74, 0x85, 0x29, 0x69,
-	0xbf, 0x00, 0xd3, 0x91, 0x91, 0xdf, 0x22, 0x9b, 0x22, 0xe7, 0x84, 0xf5, 0x55, 0xac, 0xad, 0x52,
-	0xd6, 0x9c, 0xda, 0x4f, 0x7e, 0x56, 0xfe, 0x94, 0x03, 0xcb, 0xc2, 0x3d, 0x87, 0xcb, 0xb1, 0xbc,
-	0xae, 0xe3, 0x66, 0xcb, 0x43, 0x4c, 0xf8, 0x5b, 0xae, 0xdf, 0x45, 0x30, 0x21, 0x5e, 0x72, 0xd1,
-	0xb8, 0xe6, 0xd9, 0x67, 0xcd, 0xd1, 0x7c, 0x30, 0x6b, 0x47, 0x4e, 0xc5, 0xc5, 0x15, 0xa3, 0xba,
-	0x76, 0x62, 0x71, 0x4f, 0x0a, 0xcf, 0x2c, 0xd9, 0x19, 0x4a, 0xe5, 0x2a, 0xb8, 0x72, 0x8c, 0x96,
-	0x6c, 0xf7, 0xaf, 0x15, 0x70, 0x79, 0x1d, 0xfa, 0x36, 0xf2, 0x7e, 0x19, 0x52, 0x42, 0xa1, 0xef,
-	0xb8, 0x7e, 0x63, 0x33, 0xb1, 0x5b, 0x0f, 0x91, 0xb6, 0x67, 0x60, 0xa6, 0x9b, 0x36, 0x71, 0x71,
-	0xe7, 0xf8, 0x60, 0x66, 0x72, 0x97, 0x9a, 0x48, 0x9e, 0x2c, 0x7e, 0x71, 0x4f, 0xd1, 0xe4, 0xe7,
-	0xf9, 0xdc, 0x65, 0xa9, 0x07, 0xc9, 0x58, 0xfa, 0x41, 0x52, 0x59, 0x02, 0x8b, 0x03, 0x42, 0x96,
-	0x49, 0xf9, 0xab, 0x02, 0xca, 0x8f, 0x11, 0xb1, 0x03, 0x77, 0x1b, 0x9d, 0xe5, 0x39, 0xf4, 0x1b,
-	0x50, 0x74, 0x10, 0xb1, 0xe3, 0x22, 0xe7, 0xb2, 0xef, 0xf9, 0x01, 0x45, 0x1e, 0x74, 0xa6, 0xa9,
-	0x32, 0x73, 0x51, 0x5d, 0xff, 0xa5, 0x80, 0xf9, 0x3e, 0x92, 0x72, 0x3a, 0x7f, 0x06, 0x26, 0x44,
-	0xa0, 0xa4, 0xac, 0xf0, 0xe7, 0xe9, 0x0f, 0x8e, 0xc9, 0xdd, 0xa6, 0x48, 0x89, 0xbf, 0x83, 0xcd,
-	0x48, 0x4b, 0x7b, 0x05, 0x66, 0x13, 0xd5, 0x24, 0x14, 0xd2, 0x90, 0xc8, 0x08, 0x7e, 0x38, 0x4c,
-	0x19, 0xb6, 0xb8, 0x86, 0x39, 0x43, 0xd3, 0x84, 0xca, 0x1f, 0x14, 0xa0, 0x3f, 0x73, 0x09, 0x8d,
-	0x05, 0x37, 0x61, 0x40, 0x5d, 0x76, 0x33, 0x90, 0x28, 0xb5, 0x97, 0x41, 0xa1, 0xbb, 0xab, 0x89,
-	0xbc, 0x76, 0x09, 0xe7, 0x32, 0x9d, 0x95, 0xbf, 0xe4, 0xc0, 0xd2, 0x40, 0x2f, 0x64, 0x0a, 0x7f,
-	0x07, 0xf4, 0xee, 0x3b, 0xab, 0x9b, 0x8a, 0x56, 0x2c, 0x29, 0x33, 0x7b, 0x67, 0x98, 0xc3, 0x63,
-	0xfb, 0xcf, 0x11, 0x85, 0x0e, 0xa4, 0xd0, 0xbc, 0x04, 0xb3, 0x6f, 0xcf, 0xae, 0x0f, 0xec, 0xec,
-	0xf4, 0x1f, 0x42, 0x3d, 0x67, 0xe7, 0x3e, 0xeb, 0xec, 0x83, 0xec, 0xff, 0x0f, 0xdd, 0xb3, 0x1f,
-	0x05, 0xef, 0x3f, 0xea, 0x23, 0x1f, 0x3e, 0xea, 0x23, 0x9f, 0x3e, 0xea, 0xca, 0xef, 0x0f, 0x75,
-	0xe5, 0x6f, 0x87, 0xba, 0xf2, 0xef, 0x43, 0x5d, 0x79, 0x7f, 0xa8, 0x2b, 0xff, 0x3b, 0xd4, 0x95,
-	0xff, 0x1f, 0xea, 0x23, 0x9f, 0x0e, 0x75, 0xe5, 0xdd, 0x91, 0x3e, 0xf2, 0xfe, 0x48, 0x1f, 0xf9,
-	0x70, 0xa4, 0x8f, 0xbc, 0xfe, 0x69, 0x03, 0x77, 0x7d, 0x71, 0xf1, 0xf1, 0xff, 0xec, 0xff, 0x24,
-	0x43, 0xda, 0xce, 0xf3, 0x3d, 0xe1, 0xc7, 0xdf, 0x04, 0x00, 0x00, 0xff, 0xff, 0x04, 0x68, 0xa1,
-	0xe5, 0x1a, 0x18, 0x00, 0x00,
+	// 1787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xdb, 0x6f, 0xdb, 0xd6,
+	0x19, 0x37, 0xe5, 0xab, 0x3e, 0xc9, 0x8e, 0xcd, 0x74, 0x0e, 0xed, 0xc4, 0xb4, 0xa3, 0x76, 0xad,
+	0x3b, 0x74, 0x34, 0xe2, 0x21, 0x41, 0xdb, 0xad, 0xd8, 0x1c, 0x27, 0x68, 0x85, 0xa5, 0x99, 0x43,
+	0x0b, 0xdd, 0x10, 0x0c, 0x60, 0x8f, 0xc8, 0x63, 0x99, 0x33, 0xc5, 0xa3, 0xf0, 0x1c, 0x4a, 0x55,
+	0x9e, 0x06, 0x0c, 0x7b, 0x2f, 0xb6, 0x97, 0x0d, 0xfb, 0x07, 0xb6, 0xe7, 0xed, 0x8f, 0xd8, 0xc3,
+	0x1e, 0xf2, 0xd8, 0xb7, 0x2d, 0xce, 0xcb, 0x80, 0xbd, 0x74, 0xff, 0xc1, 0x70, 0x2e, 0xa4, 0x48,
+	0x4a, 0xb2, 0x65, 0xc7, 0x58, 0xfb, 0x26, 0x7e, 0x97, 0xdf, 0xf9, 0xce, 0x77, 0x27, 0x05, 0x1f,
+	0x31, 0xdc, 0xee, 0x90, 0x08, 0x05, 0x3b, 0x14, 0x47, 0x5d, 0x1c, 0xed, 0xa0, 0x8e, 0xbf, 0xd3,
+	0x46, 0xcc, 0x3d, 0xf6, 0xc3, 0x16, 0x27, 0xf9, 0x2e, 0xde, 0xe9, 0xde, 0xd9, 0x89, 0xf0, 0xb3,
+	0x18, 0x53, 0xe6, 0x44, 0x98, 0x76, 0x48, 0x48, 0xb1, 0xd5, 0x89, 0x08, 0x23, 0xfa, 0xdb, 0x89,
+	0xba, 0x25, 0xd5, 0x2d, 0xd4, 0xf1, 0xad, 0x82, 0xba, 0xd5, 0xbd, 0xb3, 0x6e, 0xb6, 0x08, 0x69,
+	0x05, 0x78, 0x47, 0x68, 0x35, 0xe3, 0xa3, 0x1d, 0x2f, 0x8e, 0x10, 0xf3, 0x49, 0x28, 0x71, 0xd6,
+	0x37, 0x8b, 0x7c, 0xe6, 0xb7, 0x31, 0x65, 0xa8, 0xdd, 0x51, 0x02, 0xb7, 0x3d, 0xdc, 0xc1, 0xa1,
+	0x87, 0x43, 0xd7, 0xc7, 0x74, 0xa7, 0x45, 0x5a, 0x44, 0xd0, 0xc5, 0x2f, 0x25, 0xf2, 0x56, 0x7a,
+	0x15, 0x7e, 0x07, 0x97, 0xb4, 0xdb, 0x24, 0xe4, 0xa6, 0xb7, 0x31, 0xa5, 0xa8, 0xa5, 0x2c, 0x5e,
+	0x7f, 0x3b, 0x27, 0x85, 0xc3, 0xb8, 0x4d, 0xb9, 0x10, 0x43, 0xf4, 0xc4, 0x79, 0x16, 0xe3, 0x38,
+	0x91, 0x7b, 0x27, 0x27, 0xc7, 0xd9, 0x82, 0x3b, 0x0c, 0xf8, 0x66, 0x4e, 0xf0, 0x59, 0x8c, 0xa3,
+	0xfe, 0xb0, 0xd0, 0x3b, 0xa3, 0xdc, 0x9c, 0x3b, 0x5c, 0x09, 0xbe, 0x37, 0x4a, 0xf0, 0xd8, 0xa7,
+	0x8c, 0x8c, 0x82, 0xbd, 0x97, 0x3b, 0xbb, 0x47, 0xa2, 0x93, 0xa3, 0x80, 0xf4, 0xce, 0x0d, 0x5b,
+	0xed, 0x3f, 0x1a, 0xdc, 0x3a, 0x20, 0x41, 0xf0, 0x73, 0xa5, 0xd1, 0x40, 0xf4, 0xe4, 0x09, 0xbf,
+	0x9e, 0x2d, 0xe5, 0xf5, 0xdb, 0x50, 0x0d, 0x51, 0x1b, 0xd3, 0x0e, 0x72, 0xb1, 0xe3, 0x7b, 0x86,
+	0xb6, 0xa5, 0x6d, 0x97, 0xed, 0x4a, 0x4a, 0xab, 0x7b, 0xfa, 0x4d, 0x28, 0x77, 0x48, 0x10, 0xe0,
+	0x88, 0xf3, 0x4b, 0x82, 0xbf, 0x20, 0x09, 0x75, 0x4f, 0xff, 0x1c, 0xaa, 0xfc, 0xb7, 0xa3, 0xce,
+	0x37, 0xa6, 0xb7, 0xb4, 0xed, 0xca, 0xee, 0x47, 0x56, 0x9a, 0x2e, 0x3c, 0x4f, 0x0a, 0xf6, 0x5a,
+	0xdd, 0x3b, 0xd6, 0x59, 0x46, 0xd9, 0x15, 0x0e, 0x99, 0x58, 0xf8, 0x2e, 0x2c, 0x1f, 0x91, 0xa8,
+	0x87, 0x22, 0x0f, 0x7b, 0x0e, 0x25, 0x71, 0xe4, 0x62, 0x63, 0x46, 0x58, 0x71, 0x2d, 0xa5, 0x1f,
+	0x0a, 0x72, 0xed, 0x77, 0x00, 0x1b, 0x63, 0x80, 0xa5, 0x57, 0xf4, 0x0d, 0x00, 0x91, 0x00, 0x8c,
+	0x9c, 0xe0, 0x50, 0x5c, 0xb6, 0x6a, 0x97, 0x39, 0xa5, 0xc1, 0x09, 0xfa, 0x2f, 0x40, 0x4f, 0x6c,
+	0x75, 0xf0, 0x17, 0xd8, 0x8d, 0x79, 0xe6, 0x8a, 0x3b, 0x57, 0x76, 0xdf, 0xcd, 0xdf, 0x49, 0xa6,
+	0x1d, 0xbf, 0x4a, 0x72, 0xda, 0xc3, 0x44, 0xc1, 0x5e, 0xe9, 0x15, 0x49, 0x7a, 0x1d, 0x16, 0x53,
+	0x64, 0xd6, 0xef, 0x60, 0xe5, 0xa8, 0xb7, 0xce, 0x03, 0x6d, 0xf4, 0x3b, 0xd8, 0xae, 0xf6, 0x32,
+	0x4f, 0xfa, 0x07, 0xb0, 0xd6, 0x89, 0x70, 0xd7, 0x27, 0x31, 0x75, 0x28, 0x43, 0x11, 0xc3, 0x9e,
+	0x83, 0xbb, 0x38, 0x64, 0x3c, 0x3e, 0xdc, 0x33, 0xd3, 0xf6, 0x6a, 0x22, 0x70, 0x28, 0xf9, 0x0f,
+	0x39, 0xbb, 0xee, 0xe9, 0xdb, 0xb0, 0x3c, 0xa4, 0x31, 0x2b, 0x34, 0x96, 0x68, 0x5e, 0xd2, 0x80,
+	0x79, 0xc4, 0xb8, 0x6d, 0xcc, 0x98, 0xdb, 0xd2, 0xb6, 0x67, 0xed, 0xe4, 0x51, 0xaf, 0xc1, 0x62,
+	0x88, 0xbf, 0x60, 0x03, 0x80, 0x79, 0x01, 0x50, 0xe1, 0xc4, 0x44, 0xfb, 0x3d, 0xd0, 0x9b, 0xc8,
+	0x3d, 0x09, 0x48, 0xcb, 0x71, 0x49, 0x1c, 0x32, 0xe7, 0xd8, 0x0f, 0x99, 0xb1, 0x20, 0x04, 0x97,
+	0x15, 0x67, 0x9f, 0x33, 0x3e, 0xf1, 0x43, 0xa6, 0xbf, 0x0f, 0x06, 0x65, 0xbe, 0x7b, 0xd2, 0x1f,
+	0xf8, 0xdc, 0xc1, 0x21, 0x6a, 0x06, 0xd8, 0x33, 0xca, 0x5b, 0xda, 0xf6, 0x82, 0xbd, 0x2a, 0xf9,
+	0xa9, 0x3b, 0x1f, 0x4a, 0xae, 0xfe, 0x21, 0xcc, 0x8a, 0x3a, 0x34, 0x60, 0x94, 0x37, 0x05, 0x2b,
+	0xeb, 0xcc, 0x27, 0x9c, 0x60, 0x4b, 0x15, 0xbd, 0x95, 0x89, 0xb5, 0xc8, 0x09, 0x3f, 0x3c, 0x22,
+	0x46, 0x45, 0x00, 0x7d, 0x60, 0x8d, 0x6a, 0x77, 0xaa, 0x3a, 0x39, 0x62, 0x23, 0x42, 0x21, 0xf5,
+	0x71, 0xc8, 0xb2, 0xa9, 0x56, 0x0f, 0x8f, 0x88, 0xbd, 0xdc, 0x2b, 0x50, 0xf4, 0x16, 0x6c, 0x0c,
+	0x27, 0x95, 0x33, 0xe8, 0x43, 0x46, 0x75, 0x94, 0xf1, 0x69, 0x23, 0x12, 0xc7, 0xa5, 0x89, 0xbc,
+	0x3e, 0x94, 0x5a, 0x29, 0x4f, 0xb7, 0xe0, 0xba, 0x0c, 0x0a, 0x37, 0x13, 0x3b, 0x5d, 0x1c, 0x51,
+	0x9e, 0xbe, 0x8b, 0x22, 0x7e, 0x2b, 0x82, 0x75, 0xc8, 0x39, 0x9f, 0x49, 0x06, 0xaf, 0xfd, 0x66,
+	0x84, 0x42, 0xf7, 0x58, 0x95, 0xc3, 0x92, 0x28, 0x87, 0x8a, 0xa4, 0xc9, 0x82, 0xf8, 0x18, 0x96,
+	0xa8, 0x7b, 0x8c, 0xbd, 0x38, 0xc0, 0x9e, 0xc3, 0x5b, 0xb5, 0x71, 0x4d, 0x18, 0xbb, 0x6e, 0xc9,
+	0x3e, 0x6e, 0x25, 0x7d, 0xdc, 0x6a, 0x24, 0x7d, 0xfc, 0xfe, 0xcc, 0x97, 0xff, 0xdc, 0xd4, 0xec,
+	0xc5, 0x54, 0x8f, 0x73, 0xf4, 0x7d, 0xa8, 0x26, 0x99, 0x27, 0x60, 0x96, 0x27, 0x84, 0xa9, 0x28,
+	0x2d, 0x01, 0x12, 0xc0, 0x3c, 0x8f, 0x9d, 0x8f, 0xa9, 0xb1, 0xb2, 0x35, 0xbd, 0x5d, 0xd9, 0xb5,
+	0xad, 0xc9, 0xc6, 0x92, 0x75, 0x66, 0x57, 0xb0, 0x9e, 0x48, 0xd0, 0x87, 0x21, 0x8b, 0xfa, 0x76,
+	0x72, 0x04, 0x4f, 0x62, 0x15, 0x71, 0x87, 0xfa, 0xcf, 0xb1, 0xd3, 0xec, 0x33, 0x4c, 0x0d, 0x5d,
+	0x26, 0xb1, 0xe2, 0x1c, 0xfa, 0xcf, 0xf1, 0x7d, 0x4e, 0xd7, 0xef, 0xc2, 0x0d, 0x1a, 0xb7, 0x5a,
+	0xbc, 0x07, 0xbb, 0x24, 0x64, 0x7e, 0x18, 0x63, 0x07, 0x51, 0x27, 0xc4, 0x3d, 0xe3, 0xba, 0xc8,
+	0xe1, 0x37, 0x14, 0x7b, 0x5f, 0x71, 0xf7, 0xe8, 0x63, 0xdc, 0x5b, 0xff, 0x1c, 0xaa, 0xd9, 0xd3,
+	0xf5, 0x65, 0x98, 0x3e, 0xc1, 0x7d, 0xd5, 0x86, 0xf9, 0x4f, 0x9e, 0xe3, 0x5d, 0x14, 0xc4, 0xd8,
+	0x28, 0x8d, 0x4a, 0x93, 0x71, 0x39, 0x2e, 0x54, 0x3e, 0x2c, 0xbd, 0xaf, 0xa5, 0x23, 0x60, 0xcf,
+	0x65, 0x7e, 0xd7, 0x67, 0xfd, 0x6f, 0xd5, 0x08, 0x18, 0x67, 0xd4, 0xa5, 0x47, 0xc0, 0x3f, 0x16,
+	0x60, 0x63, 0x0c, 0xf0, 0x37, 0x3d, 0x02, 0x36, 0xa1, 0x82, 0x94, 0x55, 0xdc, 0x8d, 0xd3, 0xe2,
+	0x02, 0x90, 0x90, 0xea, 0x1e, 0x9f, 0x11, 0xa9, 0x80, 0x98, 0x11, 0x33, 0x67, 0xcf, 0x88, 0xf4,
+	0x8e, 0x62, 0x46, 0xa0, 0xcc, 0x93, 0x7e, 0x0f, 0x66, 0xfd, 0xb0, 0x13, 0x33, 0xd1, 0xdd, 0x2b,
+	0xbb, 0x5b, 0xe3, 0x20, 0x0e, 0x50, 0x3f, 0x20, 0xc8, 0xa3, 0xb6, 0x14, 0x1f, 0x51, 0xef, 0x73,
+	0x97, 0xab, 0xf7, 0xa7, 0xb0, 0x96, 0x10, 0x1c, 0x46, 0x1c, 0x37, 0x20, 0x14, 0x0b, 0x40, 0x12,
+	0x33, 0x31, 0x31, 0x2a, 0xbb, 0x6b, 0x43, 0x98, 0x0f, 0xd4, 0xae, 0x78, 0x7f, 0xe6, 0x0f, 0x1c,
+	0x72, 0x35, 0x41, 0x68, 0x90, 0x7d, 0xae, 0xdf, 0x90, 0xea, 0x43, 0xbd, 0x64, 0xe1, 0x32, 0xbd,
+	0xa4, 0x01, 0xab, 0xe2, 0x71, 0xd8, 0xba, 0xf2, 0x64, 0xd6, 0x5d, 0x17, 0xea, 0x05, 0xd3, 0x1e,
+	0xc1, 0xca, 0x31, 0x46, 0x11, 0x6b, 0x62, 0xc4, 0x52, 0x40, 0x98, 0x0c, 0x70, 0x39, 0xd5, 0x4c,
+	0xd0, 0x32, 0x43, 0xb8, 0x92, 0x1f, 0xc2, 0x18, 0x4c, 0x37, 0x8e, 0x22, 0xde, 0xec, 0x15, 0xc9,
+	0x29, 0xc4, 0xad, 0x3a, 0xa1, 0x53, 0x6e, 0x2a, 0x9c, 0x3d, 0x09, 0x73, 0x98, 0x8b, 0xe2, 0xa7,
+	0xd9, 0xeb, 0x78, 0x98, 0x21, 0x3f, 0xa0, 0xc6, 0xe2, 0x84, 0x29, 0x35, 0xb8, 0xcf, 0x03, 0xa9,
+	0x39, 0xbc, 0x04, 0x2d, 0x5d, 0x7a, 0x09, 0xfa, 0x7e, 0xa6, 0x4c, 0xd3, 0x4e, 0x25, 0x86, 0x53,
+	0x79, 0x50, 0x7b, 0x8f, 0x13, 0x86, 0x7e, 0x0f, 0xe6, 0x8e, 0x31, 0xf2, 0x70, 0xa4, 0x06, 0x8f,
+	0x39, 0xee, 0xc8, 0x4f, 0x84, 0x94, 0xad, 0xa4, 0x6b, 0x7f, 0x9d, 0x86, 0xd5, 0x3d, 0xcf, 0xcb,
+	0x8e, 0x8e, 0x0b, 0xb4, 0xcd, 0x8f, 0xa1, 0xfc, 0x1a, 0x2d, 0x64, 0xa0, 0xab, 0xef, 0xab, 0x9e,
+	0x25, 0xf7, 0x85, 0xe9, 0x0b, 0xec, 0x0b, 0x65, 0x96, 0xfc, 0xe4, 0xfd, 0x27, 0x2d, 0xc9, 0x74,
+	0x53, 0x84, 0x84, 0x54, 0xf7, 0x8a, 0x35, 0xab, 0xca, 0x43, 0x25, 0xf1, 0xec, 0x85, 0x6b, 0x56,
+	0xec, 0x9e, 0x49, 0x2a, 0x8f, 0x6a, 0xe1, 0x73, 0x23, 0x5b, 0xb8, 0xfe, 0x13, 0x98, 0x53, 0x02,
+	0xbc, 0x4f, 0x2c, 0xed, 0x6e, 0x8f, 0x1c, 0xf2, 0xe2, 0x9d, 0x2a, 0xb9, 0xab, 0xd4, 0xb4, 0x95,
+	0x5e, 0x6d, 0x0d, 0x6e, 0x0c, 0x05, 0x4d, 0x76, 0xff, 0xda, 0x2b, 0x19, 0xd0, 0xec, 0x78, 0xf8,
+	0x26, 0x02, 0x6a, 0xc1, 0x75, 0x69, 0xab, 0x93, 0x3b, 0x52, 0xce, 0x84, 0x15, 0xc9, 0x7a, 0x9c,
+	0x39, 0x38, 0x9f, 0x00, 0x33, 0x57, 0x92, 0x00, 0xb3, 0x17, 0x4b, 0x80, 0xb9, 0xab, 0x4f, 0x80,
+	0xf9, 0xf3, 0x12, 0x60, 0xe1, 0xb5, 0x12, 0x20, 0x1f, 0x64, 0x95, 0x00, 0xbf, 0x2d, 0xc1, 0x1b,
+	0x62, 0x47, 0x4a, 0xe2, 0x73, 0x81, 0xf0, 0xe7, 0xa3, 0x50, 0xba, 0x5c, 0x14, 0x9e, 0xc2, 0xa2,
+	0x58, 0xda, 0x0a, 0xfb, 0xd2, 0xdd, 0x73, 0xf7, 0xa5, 0x51, 0x56, 0xdb, 0x55, 0x81, 0x75, 0x89,
+	0x45, 0xe9, 0x2f, 0x1a, 0x7c, 0xa7, 0x80, 0xa8, 0x16, 0xa4, 0x7d, 0xa8, 0x26, 0x06, 0xd2, 0x38,
+	0x60, 0x86, 0x36, 0x61, 0xbf, 0xaf, 0x28, 0x53, 0xb8, 0x92, 0xfe, 0x53, 0x58, 0x4a, 0x40, 0x7e,
+	0x85, 0x5d, 0x86, 0xbd, 0x73, 0xd6, 0x57, 0xb9, 0xb6, 0x2a, 0x59, 0x7b, 0xf1, 0x59, 0xf6, 0xb1,
+	0xf6, 0xfb, 0x12, 0x6c, 0x49, 0xf3, 0x3c, 0x21, 0xc7, 0xfd, 0xba, 0x4f, 0xda, 0x9d, 0x00, 0x73,
+	0xe1, 0xff, 0x73, 0xfc, 0x6e, 0xc0, 0xbc, 0x00, 0x49, 0xcb, 0x75, 0x8e, 0x3f, 0xd6, 0x3d, 0x3d,
+	0x84, 0x15, 0x37, 0x31, 0x2a, 0x0d, 0xae, 0x2c, 0xd5, 0xbd, 0x73, 0x83, 0x7b, 0xde, 0xf5, 0xec,
+	0x65, 0xb7, 0x40, 0xa9, 0xbd, 0x09, 0xb7, 0xcf, 0xd0, 0x52, 0xe9, 0xfe, 0x5f, 0x0d, 0x6e, 0xed,
+	0xa3, 0xd0, 0xc5, 0xc1, 0xcf, 0x62, 0x46, 0x19, 0x0a, 0x3d, 0x3f, 0x6c, 0x1d, 0x64, 0x76, 0xeb,
+	0x09, 0xdc, 0xf6, 0x08, 0xae, 0x0d, 0xdc, 0x26, 0x07, 0x77, 0x49, 0x14, 0x66, 0xc1, 0x77, 0xb9,
+	0x8a, 0x14, 0xce, 0x12, 0x83, 0x7b, 0x91, 0x65, 0x1f, 0xaf, 0x66, 0x96, 0xe5, 0x5e, 0x48, 0x66,
+	0xf2, 0x2f, 0x24, 0xb5, 0x4d, 0xd8, 0x18, 0x73, 0x65, 0xe5, 0x94, 0x3f, 0x69, 0x60, 0x3c, 0xc0,
+	0xd4, 0x8d, 0xfc, 0x26, 0xbe, 0xcc, 0xeb, 0xd0, 0x2f, 0xa1, 0xea, 0x61, 0xea, 0xa6, 0x41, 0x2e,
+	0x15, 0x3f, 0x1a, 0x8c, 0x09, 0xf2, 0xb8, 0x33, 0xed, 0x0a, 0x87, 0x4b, 0xe2, 0xfa, 0x37, 0x0d,
+	0xd6, 0x46, 0x48, 0xaa, 0xea, 0xfc, 0x31, 0xcc, 0xcb, 0x8b, 0x52, 0x43, 0x13, 0xef, 0xc0, 0xdf,
+	0x3d, 0xc3, 0x77, 0x07, 0xd2, 0x25, 0xfc, 0xbb, 0x44, 0xa2, 0xa5, 0x7f, 0x06, 0x2b, 0x99, 0x68,
+	0x52, 0x86, 0x58, 0x4c, 0xd5, 0x0d, 0xbe, 0x37, 0x49, 0x18, 0x0e, 0x85, 0x86, 0x7d, 0x8d, 0xe5,
+	0x09, 0xb5, 0xdf, 0x68, 0x60, 0x3e, 0xf2, 0x29, 0x4b, 0x05, 0x0f, 0x50, 0xc4, 0x7c, 0x3e, 0x19,
+	0x68, 0xe2, 0xda, 0x5b, 0x50, 0x1e, 0xec, 0x6a, 0xd2, 0xaf, 0x03, 0xc2, 0x95, 0x54, 0x67, 0xed,
+	0x8f, 0x25, 0xd8, 0x1c, 0x6b, 0x85, 0x72, 0xe1, 0x73, 0x30, 0x07, 0xef, 0x59, 0x03, 0x57, 0x74,
+	0x52, 0x49, 0xe5, 0xd9, 0xbb, 0x93, 0x1c, 0x9e, 0xe2, 0x7f, 0x8a, 0x19, 0xf2, 0x10, 0x43, 0xf6,
+	0x4d, 0x54, 0x7c, 0xf7, 0x1c, 0xd8, 0xc0, 0xcf, 0xce, 0x7f, 0x75, 0x1a, 0x3a, 0xbb, 0xf4, 0x5a,
+	0x67, 0xf7, 0x8a, 0x1f, 0x39, 0x06, 0x67, 0xdf, 0x8f, 0x5e, 0xbc, 0x34, 0xa7, 0xbe, 0x7a, 0x69,
+	0x4e, 0x7d, 0xfd, 0xd2, 0xd4, 0x7e, 0x7d, 0x6a, 0x6a, 0x7f, 0x3e, 0x35, 0xb5, 0xbf, 0x9f, 0x9a,
+	0xda, 0x8b, 0x53, 0x53, 0xfb, 0xd7, 0xa9, 0xa9, 0xfd, 0xfb, 0xd4, 0x9c, 0xfa, 0xfa, 0xd4, 0xd4,
+	0xbe, 0x7c, 0x65, 0x4e, 0xbd, 0x78, 0x65, 0x4e, 0x7d, 0xf5, 0xca, 0x9c, 0x7a, 0xfa, 0xa3, 0x16,
+	0x19, 0xd8, 0xe2, 0x93, 0xb3, 0xff, 0x3e, 0xf8, 0x61, 0x81, 0xd4, 0x9c, 0x13, 0x7b, 0xc2, 0x0f,
+	0xfe, 0x37, 0x00, 0x8f, 0x9d, 0x2c, 0x54, 0x7f, 0x18, 0x00, 0x00,
 }
 
 func (this *PollWorkflowTaskQueueRequest) Equal(that interface{}) bool {
@@ -1580,6 +1599,12 @@ func (this *PollWorkflowTaskQueueResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.HistorySizeBytes != that1.HistorySizeBytes {
+		return false
+	}
+	if this.SuggestContinueAsNew != that1.SuggestContinueAsNew {
+		return false
+	}
 	return true
 }
 func (this *PollActivityTaskQueueRequest) Equal(that interface{}) bool {
@@ -2165,7 +2190,7 @@ func (this *PollWorkflowTaskQueueResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 21)
+	s := make([]string, 0, 23)
 	s = append(s, "&matchingservice.PollWorkflowTaskQueueResponse{")
 	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
 	if this.WorkflowExecution != nil {
@@ -2206,6 +2231,8 @@ func (this *PollWorkflowTaskQueueResponse) GoString() string {
 	if this.Queries != nil {
 		s = append(s, "Queries: "+mapStringForQueries+",\n")
 	}
+	s = append(s, "HistorySizeBytes: "+fmt.Sprintf("%#v", this.HistorySizeBytes)+",\n")
+	s = append(s, "SuggestContinueAsNew: "+fmt.Sprintf("%#v", this.SuggestContinueAsNew)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2542,6 +2569,25 @@ func (m *PollWorkflowTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.SuggestContinueAsNew {
+		i--
+		if m.SuggestContinueAsNew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.HistorySizeBytes != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.HistorySizeBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
 	if len(m.Queries) > 0 {
 		for k := range m.Queries {
 			v := m.Queries[k]
@@ -3728,6 +3774,12 @@ func (m *PollWorkflowTaskQueueResponse) Size() (n int) {
 			n += mapEntrySize + 2 + sovRequestResponse(uint64(mapEntrySize))
 		}
 	}
+	if m.HistorySizeBytes != 0 {
+		n += 2 + sovRequestResponse(uint64(m.HistorySizeBytes))
+	}
+	if m.SuggestContinueAsNew {
+		n += 3
+	}
 	return n
 }
 
@@ -4154,6 +4206,8 @@ func (this *PollWorkflowTaskQueueResponse) String() string {
 		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Queries:` + mapStringForQueries + `,`,
+		`HistorySizeBytes:` + fmt.Sprintf("%v", this.HistorySizeBytes) + `,`,
+		`SuggestContinueAsNew:` + fmt.Sprintf("%v", this.SuggestContinueAsNew) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5179,6 +5233,45 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Queries[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySizeBytes", wireType)
+			}
+			m.HistorySizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistorySizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuggestContinueAsNew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuggestContinueAsNew = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -34,14 +34,19 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -104,8 +109,61 @@ func (m *NamespaceCacheInfo) GetItemsInCacheByNameCount() int64 {
 	return 0
 }
 
+// NamespaceAlias is a previous name of a renamed namespace, which keeps resolving to the namespace until it expires.
+type NamespaceAlias struct {
+	Name           string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExpirationTime *time.Time `protobuf:"bytes,2,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+}
+
+func (m *NamespaceAlias) Reset()      { *m = NamespaceAlias{} }
+func (*NamespaceAlias) ProtoMessage() {}
+func (*NamespaceAlias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd829fe44a7a2771, []int{1}
+}
+func (m *NamespaceAlias) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceAlias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceAlias.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceAlias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceAlias.Merge(m, src)
+}
+func (m *NamespaceAlias) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceAlias) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceAlias.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceAlias proto.InternalMessageInfo
+
+func (m *NamespaceAlias) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NamespaceAlias) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*NamespaceCacheInfo)(nil), "temporal.server.api.namespace.v1.NamespaceCacheInfo")
+	proto.RegisterType((*NamespaceAlias)(nil), "temporal.server.api.namespace.v1.NamespaceAlias")
 }
 
 func init() {
@@ -113,23 +171,30 @@ func init() {
 }
 
 var fileDescriptor_bd829fe44a7a2771 = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2b, 0x49, 0xcd, 0x2d,
-	0xc8, 0x2f, 0x4a, 0xcc, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0x2c, 0xc8, 0xd4,
-	0xcf, 0x4b, 0xcc, 0x4d, 0x2d, 0x2e, 0x48, 0x4c, 0x4e, 0xd5, 0x2f, 0x33, 0xd4, 0xcf, 0x4d, 0x2d,
-	0x2e, 0x4e, 0x4c, 0x4f, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x80, 0xa9, 0xd7, 0x83,
-	0xa8, 0xd7, 0x4b, 0x2c, 0xc8, 0xd4, 0x83, 0xab, 0xd7, 0x2b, 0x33, 0x54, 0xea, 0x63, 0xe4, 0x12,
-	0xf2, 0x83, 0x09, 0x38, 0x27, 0x26, 0x67, 0xa4, 0x7a, 0xe6, 0xa5, 0xe5, 0x0b, 0x59, 0x72, 0x49,
-	0x65, 0x96, 0xa4, 0xe6, 0x16, 0xc7, 0x67, 0xe6, 0xc5, 0x27, 0x83, 0x44, 0xe3, 0x93, 0x2a, 0xe3,
-	0x33, 0x53, 0xe2, 0x93, 0xf3, 0x4b, 0xf3, 0x4a, 0x24, 0x18, 0x15, 0x18, 0x35, 0x98, 0x83, 0x44,
-	0xc1, 0x2a, 0x3c, 0xf3, 0xc0, 0xba, 0x9c, 0x2a, 0x3d, 0x53, 0x9c, 0x41, 0x92, 0x42, 0xb6, 0x5c,
-	0x32, 0x98, 0x5a, 0x41, 0x76, 0x42, 0x35, 0x33, 0x81, 0x35, 0x8b, 0xa3, 0x6a, 0x06, 0x39, 0x01,
-	0xac, 0xdd, 0x29, 0xed, 0xc2, 0x43, 0x39, 0x86, 0x1b, 0x0f, 0xe5, 0x18, 0x3e, 0x3c, 0x94, 0x63,
-	0x6c, 0x78, 0x24, 0xc7, 0xb8, 0xe2, 0x91, 0x1c, 0xe3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9,
-	0x31, 0x3e, 0x78, 0x24, 0xc7, 0xf8, 0xe2, 0x91, 0x1c, 0xc3, 0x87, 0x47, 0x72, 0x8c, 0x13, 0x1e,
-	0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x41, 0x7a, 0x3e, 0x3c,
-	0x6c, 0xf4, 0x32, 0xf3, 0x71, 0x05, 0x8f, 0x35, 0x9c, 0x93, 0xc4, 0x06, 0x0e, 0x21, 0x63, 0x40,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x31, 0xe9, 0x71, 0x89, 0x53, 0x01, 0x00, 0x00,
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0xe3, 0x7b, 0xab, 0x2b, 0xdd, 0x5c, 0xa9, 0x57, 0x8a, 0x84, 0x40, 0x11, 0x72, 0x4b,
+	0x27, 0x26, 0x9b, 0xc2, 0x84, 0x10, 0x03, 0xed, 0x94, 0x85, 0xa1, 0x62, 0x62, 0x89, 0xdc, 0xe4,
+	0x34, 0x58, 0x6a, 0x6c, 0x2b, 0x4e, 0x2b, 0xba, 0xf1, 0x04, 0xa8, 0x8f, 0xc1, 0xa3, 0x30, 0x76,
+	0xec, 0x06, 0x75, 0x17, 0xc6, 0x3e, 0x02, 0xb2, 0x43, 0x82, 0x10, 0x62, 0x3b, 0x39, 0xe7, 0xff,
+	0x4e, 0x7e, 0xff, 0xc7, 0x27, 0x25, 0xe4, 0x4a, 0x16, 0x6c, 0x4a, 0x35, 0x14, 0x73, 0x28, 0x28,
+	0x53, 0x9c, 0x0a, 0x96, 0x83, 0x56, 0x2c, 0x01, 0x3a, 0xef, 0xd3, 0x1c, 0xb4, 0x66, 0x19, 0x10,
+	0x55, 0xc8, 0x52, 0x06, 0xdd, 0x5a, 0x4f, 0x2a, 0x3d, 0x61, 0x8a, 0x93, 0x46, 0x4f, 0xe6, 0xfd,
+	0xb0, 0x93, 0x49, 0x99, 0x4d, 0x81, 0x3a, 0xfd, 0x78, 0x36, 0xa1, 0x25, 0xcf, 0x41, 0x97, 0x2c,
+	0x57, 0xd5, 0x8a, 0xf0, 0x28, 0x05, 0x05, 0x22, 0x05, 0x91, 0x70, 0xd0, 0x34, 0x93, 0x99, 0x74,
+	0x7d, 0x57, 0x55, 0x92, 0xde, 0x23, 0xf2, 0x83, 0xeb, 0x7a, 0xe9, 0x90, 0x25, 0x77, 0x10, 0x89,
+	0x89, 0x0c, 0xce, 0xfd, 0x90, 0x97, 0x90, 0xeb, 0x98, 0x8b, 0x38, 0xb1, 0xdd, 0x78, 0xbc, 0x88,
+	0x79, 0x1a, 0x27, 0x72, 0x26, 0xca, 0x03, 0xd4, 0x45, 0xc7, 0xbf, 0x47, 0x7b, 0x4e, 0x11, 0x09,
+	0x47, 0x0d, 0x16, 0x51, 0x3a, 0xb4, 0xc3, 0xe0, 0xd2, 0x3f, 0xfc, 0x8e, 0x5a, 0xdf, 0x1f, 0xf0,
+	0x2f, 0x07, 0xef, 0x7f, 0x85, 0xad, 0x05, 0x87, 0xf7, 0xa4, 0xdf, 0x6e, 0xfc, 0x5c, 0x4d, 0x39,
+	0xd3, 0x41, 0xe0, 0xb7, 0x2c, 0xee, 0xfe, 0xfa, 0x77, 0xe4, 0xea, 0x20, 0xf2, 0xff, 0xc3, 0xbd,
+	0xe2, 0x05, 0x2b, 0xb9, 0x14, 0xb1, 0x7d, 0xb7, 0xdb, 0xfb, 0xef, 0x34, 0x24, 0x55, 0x28, 0xa4,
+	0x0e, 0x85, 0xdc, 0xd4, 0xa1, 0x0c, 0x5a, 0xcb, 0x97, 0x0e, 0x1a, 0xb5, 0x3f, 0x41, 0x3b, 0x1a,
+	0x4c, 0x56, 0x1b, 0xec, 0xad, 0x37, 0xd8, 0xdb, 0x6d, 0x30, 0x7a, 0x30, 0x18, 0x3d, 0x19, 0x8c,
+	0x9e, 0x0d, 0x46, 0x2b, 0x83, 0xd1, 0xab, 0xc1, 0xe8, 0xcd, 0x60, 0x6f, 0x67, 0x30, 0x5a, 0x6e,
+	0xb1, 0xb7, 0xda, 0x62, 0x6f, 0xbd, 0xc5, 0xde, 0xed, 0x49, 0x26, 0x9b, 0x83, 0x12, 0x2e, 0x7f,
+	0xba, 0xe9, 0x45, 0xf3, 0x31, 0xfe, 0xe3, 0x1c, 0x9d, 0xbd, 0x0f, 0x00, 0xae, 0x4c, 0xeb, 0x4a,
+	0x08, 0x02, 0x00, 0x00,
 }
 
 func (this *NamespaceCacheInfo) Equal(that interface{}) bool {
@@ -159,6 +224,37 @@ func (this *NamespaceCacheInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *NamespaceAlias) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NamespaceAlias)
+	if !ok {
+		that2, ok := that.(NamespaceAlias)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if that1.ExpirationTime == nil {
+		if this.ExpirationTime != nil {
+			return false
+		}
+	} else if !this.ExpirationTime.Equal(*that1.ExpirationTime) {
+		return false
+	}
+	return true
+}
 func (this *NamespaceCacheInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -170,6 +266,17 @@ func (this *NamespaceCacheInfo) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *NamespaceAlias) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&namespace.NamespaceAlias{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "ExpirationTime: "+fmt.Sprintf("%#v", this.ExpirationTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -211,6 +318,46 @@ func (m *NamespaceCacheInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NamespaceAlias) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceAlias) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceAlias) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMessage(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -237,6 +384,23 @@ func (m *NamespaceCacheInfo) Size() (n int) {
 	return n
 }
 
+func (m *NamespaceAlias) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -254,6 +418,17 @@ func (this *NamespaceCacheInfo) String() string {
 	}, "")
 	return s
 }
+func (this *NamespaceAlias) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceAlias{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -353,6 +528,127 @@ func (m *NamespaceCacheInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NamespaceAlias) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceAlias: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceAlias: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
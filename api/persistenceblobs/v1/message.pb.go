@@ -47,10 +47,12 @@ import (
 	v14 "go.temporal.io/api/enums/v1"
 	v11 "go.temporal.io/api/failure/v1"
 	v1 "go.temporal.io/api/history/v1"
-	v17 "go.temporal.io/api/namespace/v1"
-	v15 "go.temporal.io/api/workflow/v1"
+	v19 "go.temporal.io/api/namespace/v1"
+	v16 "go.temporal.io/api/workflow/v1"
 	v13 "go.temporal.io/server/api/enums/v1"
-	v16 "go.temporal.io/server/api/history/v1"
+	v17 "go.temporal.io/server/api/history/v1"
+	v18 "go.temporal.io/server/api/namespace/v1"
+	v15 "go.temporal.io/server/api/workflow/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -200,6 +202,8 @@ type ActivityInfo struct {
 	ScheduleId                  int64          `protobuf:"varint,30,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 	LastHeartbeatDetails        *v12.Payloads  `protobuf:"bytes,31,opt,name=last_heartbeat_details,json=lastHeartbeatDetails,proto3" json:"last_heartbeat_details,omitempty"`
 	LastHeartbeatUpdateTime     *time.Time     `protobuf:"bytes,32,opt,name=last_heartbeat_update_time,json=lastHeartbeatUpdateTime,proto3,stdtime" json:"last_heartbeat_update_time,omitempty"`
+	Paused                      bool           `protobuf:"varint,33,opt,name=paused,proto3" json:"paused,omitempty"`
+	Stamp                       int32          `protobuf:"varint,34,opt,name=stamp,proto3" json:"stamp,omitempty"`
 }
 
 func (m *ActivityInfo) Reset()      { *m = ActivityInfo{} }
@@ -458,6 +462,20 @@ func (m *ActivityInfo) GetLastHeartbeatUpdateTime() *time.Time {
 	return nil
 }
 
+func (m *ActivityInfo) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *ActivityInfo) GetStamp() int32 {
+	if m != nil {
+		return m.Stamp
+	}
+	return 0
+}
+
 type ShardInfo struct {
 	ShardId             int32  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	RangeId             int64  `protobuf:"varint,2,opt,name=range_id,json=rangeId,proto3" json:"range_id,omitempty"`
@@ -750,6 +768,7 @@ type TimerTaskInfo struct {
 	EventId             int64                   `protobuf:"varint,9,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	TaskId              int64                   `protobuf:"varint,10,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	VisibilityTime      *time.Time              `protobuf:"bytes,11,opt,name=visibility_time,json=visibilityTime,proto3,stdtime" json:"visibility_time,omitempty"`
+	Stamp               int32                   `protobuf:"varint,12,opt,name=stamp,proto3" json:"stamp,omitempty"`
 }
 
 func (m *TimerTaskInfo) Reset()      { *m = TimerTaskInfo{} }
@@ -861,6 +880,13 @@ func (m *TimerTaskInfo) GetVisibilityTime() *time.Time {
 	return nil
 }
 
+func (m *TimerTaskInfo) GetStamp() int32 {
+	if m != nil {
+		return m.Stamp
+	}
+	return 0
+}
+
 type TransferTaskInfo struct {
 	NamespaceId             string       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId              string       `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
@@ -1268,6 +1294,65 @@ func (m *TimerInfo) GetTimerId() string {
 	return ""
 }
 
+type DeadlineInfo struct {
+	Rule         *v15.DeadlineRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	ExpiryTime   *time.Time        `protobuf:"bytes,2,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
+	TimerCreated bool              `protobuf:"varint,3,opt,name=timer_created,json=timerCreated,proto3" json:"timer_created,omitempty"`
+}
+
+func (m *DeadlineInfo) Reset()      { *m = DeadlineInfo{} }
+func (*DeadlineInfo) ProtoMessage() {}
+func (*DeadlineInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef806e155800e59a, []int{11}
+}
+func (m *DeadlineInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadlineInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeadlineInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeadlineInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadlineInfo.Merge(m, src)
+}
+func (m *DeadlineInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadlineInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadlineInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadlineInfo proto.InternalMessageInfo
+
+func (m *DeadlineInfo) GetRule() *v15.DeadlineRule {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+func (m *DeadlineInfo) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
+func (m *DeadlineInfo) GetTimerCreated() bool {
+	if m != nil {
+		return m.TimerCreated
+	}
+	return false
+}
+
 type TaskInfo struct {
 	NamespaceId string     `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId  string     `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
@@ -1280,7 +1365,7 @@ type TaskInfo struct {
 func (m *TaskInfo) Reset()      { *m = TaskInfo{} }
 func (*TaskInfo) ProtoMessage() {}
 func (*TaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef806e155800e59a, []int{11}
+	return fileDescriptor_ef806e155800e59a, []int{12}
 }
 func (m *TaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1359,7 +1444,7 @@ type AllocatedTaskInfo struct {
 func (m *AllocatedTaskInfo) Reset()      { *m = AllocatedTaskInfo{} }
 func (*AllocatedTaskInfo) ProtoMessage() {}
 func (*AllocatedTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef806e155800e59a, []int{12}
+	return fileDescriptor_ef806e155800e59a, []int{13}
 }
 func (m *AllocatedTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1415,7 +1500,7 @@ type TaskQueueInfo struct {
 func (m *TaskQueueInfo) Reset()      { *m = TaskQueueInfo{} }
 func (*TaskQueueInfo) ProtoMessage() {}
 func (*TaskQueueInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef806e155800e59a, []int{13}
+	return fileDescriptor_ef806e155800e59a, []int{14}
 }
 func (m *TaskQueueInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1506,7 +1591,7 @@ type SignalInfo struct {
 func (m *SignalInfo) Reset()      { *m = SignalInfo{} }
 func (*SignalInfo) ProtoMessage() {}
 func (*SignalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef806e155800e59a, []int{14}
+	return fileDescriptor_ef806e155800e59a, []int{15}
 }
 func (m *SignalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1594,7 +1679,7 @@ type RequestCancelInfo struct {
 func (m *RequestCancelInfo) Reset()      { *m = RequestCancelInfo{} }
 func (*RequestCancelInfo) ProtoMessage() {}
 func (*RequestCancelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef806e155800e59a, []int{15}
+	return fileDescriptor_ef806e155800e59a, []int{16}
 }
 func (m *RequestCancelInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1661,7 +1746,7 @@ type WorkflowExecutionState struct {
 func (m *WorkflowExecutionState) Reset()      { *m = WorkflowExecutionState{} }
 func (*WorkflowExecutionState) ProtoMessage() {}
 func (*WorkflowExecutionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef806e155800e59a, []int{16}
+	return fileDescriptor_ef806e155800e59a, []int{17}
 }
 func (m *WorkflowExecutionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1766,18 +1851,21 @@ type WorkflowExecutionInfo struct {
 	EventBranchToken             []byte                  `protobuf:"bytes,45,opt,name=event_branch_token,json=eventBranchToken,proto3" json:"event_branch_token,omitempty"`
 	SignalCount                  int64                   `protobuf:"varint,46,opt,name=signal_count,json=signalCount,proto3" json:"signal_count,omitempty"`
 	HistorySize                  int64                   `protobuf:"varint,47,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"` // Deprecated: Do not use.
-	AutoResetPoints              *v15.ResetPoints        `protobuf:"bytes,51,opt,name=auto_reset_points,json=autoResetPoints,proto3" json:"auto_reset_points,omitempty"`
+	AutoResetPoints              *v16.ResetPoints        `protobuf:"bytes,51,opt,name=auto_reset_points,json=autoResetPoints,proto3" json:"auto_reset_points,omitempty"`
 	SearchAttributes             map[string]*v12.Payload `protobuf:"bytes,52,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Memo                         map[string]*v12.Payload `protobuf:"bytes,53,rep,name=memo,proto3" json:"memo,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	VersionHistories             *v16.VersionHistories   `protobuf:"bytes,54,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
+	VersionHistories             *v17.VersionHistories   `protobuf:"bytes,54,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
 	FirstExecutionRunId          string                  `protobuf:"bytes,55,opt,name=first_execution_run_id,json=firstExecutionRunId,proto3" json:"first_execution_run_id,omitempty"`
 	ExecutionStats               *ExecutionStats         `protobuf:"bytes,56,opt,name=execution_stats,json=executionStats,proto3" json:"execution_stats,omitempty"`
+	Paused                       bool                    `protobuf:"varint,57,opt,name=paused,proto3" json:"paused,omitempty"`
+	Deadlines                    []*DeadlineInfo         `protobuf:"bytes,58,rep,name=deadlines,proto3" json:"deadlines,omitempty"`
+	DeadlineRules                []*v15.DeadlineRule     `protobuf:"bytes,59,rep,name=deadline_rules,json=deadlineRules,proto3" json:"deadline_rules,omitempty"`
 }
 
 func (m *WorkflowExecutionInfo) Reset()      { *m = WorkflowExecutionInfo{} }
 func (*WorkflowExecutionInfo) ProtoMessage() {}
 func (*WorkflowExecutionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef806e155800e59a, []int{17}
+	return fileDescriptor_ef806e155800e59a, []int{18}
 }
 func (m *WorkflowExecutionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2122,7 +2210,7 @@ func (m *WorkflowExecutionInfo) GetHistorySize() int64 {
 	return 0
 }
 
-func (m *WorkflowExecutionInfo) GetAutoResetPoints() *v15.ResetPoints {
+func (m *WorkflowExecutionInfo) GetAutoResetPoints() *v16.ResetPoints {
 	if m != nil {
 		return m.AutoResetPoints
 	}
@@ -2143,7 +2231,7 @@ func (m *WorkflowExecutionInfo) GetMemo() map[string]*v12.Payload {
 	return nil
 }
 
-func (m *WorkflowExecutionInfo) GetVersionHistories() *v16.VersionHistories {
+func (m *WorkflowExecutionInfo) GetVersionHistories() *v17.VersionHistories {
 	if m != nil {
 		return m.VersionHistories
 	}
@@ -2164,6 +2252,27 @@ func (m *WorkflowExecutionInfo) GetExecutionStats() *ExecutionStats {
 	return nil
 }
 
+func (m *WorkflowExecutionInfo) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *WorkflowExecutionInfo) GetDeadlines() []*DeadlineInfo {
+	if m != nil {
+		return m.Deadlines
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetDeadlineRules() []*v15.DeadlineRule {
+	if m != nil {
+		return m.DeadlineRules
+	}
+	return nil
+}
+
 type Checksum struct {
 	Version int32              `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	Flavor  v13.ChecksumFlavor `protobuf:"varint,2,opt,name=flavor,proto3,enum=temporal.server.api.enums.v1.ChecksumFlavor" json:"flavor,omitempty"`
@@ -2173,7 +2282,7 @@ type Checksum struct {
 func (m *Checksum) Reset()      { *m = Checksum{} }
 func (*Checksum) ProtoMessage() {}
 func (*Checksum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef806e155800e59a, []int{18}
+	return fileDescriptor_ef806e155800e59a, []int{19}
 }
 func (m *Checksum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2241,7 +2350,7 @@ type ChildExecutionInfo struct {
 func (m *ChildExecutionInfo) Reset()      { *m = ChildExecutionInfo{} }
 func (*ChildExecutionInfo) ProtoMessage() {}
 func (*ChildExecutionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef806e155800e59a, []int{19}
+	return fileDescriptor_ef806e155800e59a, []int{20}
 }
 func (m *ChildExecutionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2367,7 +2476,7 @@ type NamespaceDetail struct {
 func (m *NamespaceDetail) Reset()      { *m = NamespaceDetail{} }
 func (*NamespaceDetail) ProtoMessage() {}
 func (*NamespaceDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef806e155800e59a, []int{20}
+	return fileDescriptor_ef806e155800e59a, []int{21}
 }
 func (m *NamespaceDetail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2446,18 +2555,19 @@ func (m *NamespaceDetail) GetFailoverEndTime() *time.Time {
 }
 
 type NamespaceInfo struct {
-	Id          string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	State       v14.NamespaceState `protobuf:"varint,2,opt,name=state,proto3,enum=temporal.api.enums.v1.NamespaceState" json:"state,omitempty"`
-	Name        string             `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description string             `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Owner       string             `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
-	Data        map[string]string  `protobuf:"bytes,6,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Id          string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State       v14.NamespaceState    `protobuf:"varint,2,opt,name=state,proto3,enum=temporal.api.enums.v1.NamespaceState" json:"state,omitempty"`
+	Name        string                `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Owner       string                `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	Data        map[string]string     `protobuf:"bytes,6,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Aliases     []*v18.NamespaceAlias `protobuf:"bytes,7,rep,name=aliases,proto3" json:"aliases,omitempty"`
 }
 
 func (m *NamespaceInfo) Reset()      { *m = NamespaceInfo{} }
 func (*NamespaceInfo) ProtoMessage() {}
 func (*NamespaceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef806e155800e59a, []int{21}
+	return fileDescriptor_ef806e155800e59a, []int{22}
 }
 func (m *NamespaceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2528,6 +2638,13 @@ func (m *NamespaceInfo) GetData() map[string]string {
 	return nil
 }
 
+func (m *NamespaceInfo) GetAliases() []*v18.NamespaceAlias {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
 type NamespaceReplicationConfig struct {
 	ActiveClusterName string   `protobuf:"bytes,1,opt,name=active_cluster_name,json=activeClusterName,proto3" json:"active_cluster_name,omitempty"`
 	Clusters          []string `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
@@ -2536,7 +2653,7 @@ type NamespaceReplicationConfig struct {
 func (m *NamespaceReplicationConfig) Reset()      { *m = NamespaceReplicationConfig{} }
 func (*NamespaceReplicationConfig) ProtoMessage() {}
 func (*NamespaceReplicationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef806e155800e59a, []int{22}
+	return fileDescriptor_ef806e155800e59a, []int{23}
 }
 func (m *NamespaceReplicationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2580,19 +2697,20 @@ func (m *NamespaceReplicationConfig) GetClusters() []string {
 }
 
 type NamespaceConfig struct {
-	Retention               *time.Duration    `protobuf:"bytes,1,opt,name=retention,proto3,stdduration" json:"retention,omitempty"`
-	ArchivalBucket          string            `protobuf:"bytes,2,opt,name=archival_bucket,json=archivalBucket,proto3" json:"archival_bucket,omitempty"`
-	BadBinaries             *v17.BadBinaries  `protobuf:"bytes,3,opt,name=bad_binaries,json=badBinaries,proto3" json:"bad_binaries,omitempty"`
-	HistoryArchivalState    v14.ArchivalState `protobuf:"varint,4,opt,name=history_archival_state,json=historyArchivalState,proto3,enum=temporal.api.enums.v1.ArchivalState" json:"history_archival_state,omitempty"`
-	HistoryArchivalUri      string            `protobuf:"bytes,5,opt,name=history_archival_uri,json=historyArchivalUri,proto3" json:"history_archival_uri,omitempty"`
-	VisibilityArchivalState v14.ArchivalState `protobuf:"varint,6,opt,name=visibility_archival_state,json=visibilityArchivalState,proto3,enum=temporal.api.enums.v1.ArchivalState" json:"visibility_archival_state,omitempty"`
-	VisibilityArchivalUri   string            `protobuf:"bytes,7,opt,name=visibility_archival_uri,json=visibilityArchivalUri,proto3" json:"visibility_archival_uri,omitempty"`
+	Retention               *time.Duration      `protobuf:"bytes,1,opt,name=retention,proto3,stdduration" json:"retention,omitempty"`
+	ArchivalBucket          string              `protobuf:"bytes,2,opt,name=archival_bucket,json=archivalBucket,proto3" json:"archival_bucket,omitempty"`
+	BadBinaries             *v19.BadBinaries    `protobuf:"bytes,3,opt,name=bad_binaries,json=badBinaries,proto3" json:"bad_binaries,omitempty"`
+	HistoryArchivalState    v14.ArchivalState   `protobuf:"varint,4,opt,name=history_archival_state,json=historyArchivalState,proto3,enum=temporal.api.enums.v1.ArchivalState" json:"history_archival_state,omitempty"`
+	HistoryArchivalUri      string              `protobuf:"bytes,5,opt,name=history_archival_uri,json=historyArchivalUri,proto3" json:"history_archival_uri,omitempty"`
+	VisibilityArchivalState v14.ArchivalState   `protobuf:"varint,6,opt,name=visibility_archival_state,json=visibilityArchivalState,proto3,enum=temporal.api.enums.v1.ArchivalState" json:"visibility_archival_state,omitempty"`
+	VisibilityArchivalUri   string              `protobuf:"bytes,7,opt,name=visibility_archival_uri,json=visibilityArchivalUri,proto3" json:"visibility_archival_uri,omitempty"`
+	DeadlineRules           []*v15.DeadlineRule `protobuf:"bytes,8,rep,name=deadline_rules,json=deadlineRules,proto3" json:"deadline_rules,omitempty"`
 }
 
 func (m *NamespaceConfig) Reset()      { *m = NamespaceConfig{} }
 func (*NamespaceConfig) ProtoMessage() {}
 func (*NamespaceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef806e155800e59a, []int{23}
+	return fileDescriptor_ef806e155800e59a, []int{24}
 }
 func (m *NamespaceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2635,7 +2753,7 @@ func (m *NamespaceConfig) GetArchivalBucket() string {
 	return ""
 }
 
-func (m *NamespaceConfig) GetBadBinaries() *v17.BadBinaries {
+func (m *NamespaceConfig) GetBadBinaries() *v19.BadBinaries {
 	if m != nil {
 		return m.BadBinaries
 	}
@@ -2670,6 +2788,13 @@ func (m *NamespaceConfig) GetVisibilityArchivalUri() string {
 	return ""
 }
 
+func (m *NamespaceConfig) GetDeadlineRules() []*v15.DeadlineRule {
+	if m != nil {
+		return m.DeadlineRules
+	}
+	return nil
+}
+
 type ReplicationVersions struct {
 	StartVersion     *types.Int64Value `protobuf:"bytes,1,opt,name=start_version,json=startVersion,proto3" json:"start_version,omitempty"`
 	LastWriteVersion *types.Int64Value `protobuf:"bytes,2,opt,name=last_write_version,json=lastWriteVersion,proto3" json:"last_write_version,omitempty"`
@@ -2678,7 +2803,7 @@ type ReplicationVersions struct {
 func (m *ReplicationVersions) Reset()      { *m = ReplicationVersions{} }
 func (*ReplicationVersions) ProtoMessage() {}
 func (*ReplicationVersions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef806e155800e59a, []int{24}
+	return fileDescriptor_ef806e155800e59a, []int{25}
 }
 func (m *ReplicationVersions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2737,6 +2862,7 @@ func init() {
 	proto.RegisterType((*HistoryBranch)(nil), "temporal.server.api.persistenceblobs.v1.HistoryBranch")
 	proto.RegisterType((*HistoryTreeInfo)(nil), "temporal.server.api.persistenceblobs.v1.HistoryTreeInfo")
 	proto.RegisterType((*TimerInfo)(nil), "temporal.server.api.persistenceblobs.v1.TimerInfo")
+	proto.RegisterType((*DeadlineInfo)(nil), "temporal.server.api.persistenceblobs.v1.DeadlineInfo")
 	proto.RegisterType((*TaskInfo)(nil), "temporal.server.api.persistenceblobs.v1.TaskInfo")
 	proto.RegisterType((*AllocatedTaskInfo)(nil), "temporal.server.api.persistenceblobs.v1.AllocatedTaskInfo")
 	proto.RegisterType((*TaskQueueInfo)(nil), "temporal.server.api.persistenceblobs.v1.TaskQueueInfo")
@@ -2761,256 +2887,267 @@ func init() {
 }
 
 var fileDescriptor_ef806e155800e59a = []byte{
-	// 3982 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x4d, 0x73, 0x1b, 0x47,
-	0x76, 0x02, 0x01, 0x92, 0xc0, 0x03, 0x49, 0x00, 0x4d, 0x8a, 0x1c, 0x82, 0x12, 0x44, 0xc1, 0x92,
-	0x45, 0xdb, 0x5a, 0x50, 0xa4, 0x6c, 0xc9, 0xbb, 0xca, 0x26, 0x4b, 0x52, 0x74, 0x0c, 0x46, 0xa6,
-	0xe5, 0x21, 0x6d, 0x6d, 0x5c, 0x71, 0xcd, 0x0e, 0x66, 0x1a, 0xe4, 0x14, 0x07, 0x33, 0xf0, 0xcc,
-	0x00, 0x34, 0xf7, 0xb4, 0x39, 0x6d, 0xa5, 0xb2, 0x87, 0xad, 0x9c, 0x52, 0x95, 0x4b, 0x3e, 0x2e,
-	0xf9, 0x03, 0xa9, 0x9c, 0x52, 0x95, 0xaa, 0x5c, 0x72, 0xf4, 0x71, 0x0f, 0xa9, 0x4a, 0x2c, 0x5f,
-	0x72, 0x48, 0x6a, 0xf7, 0x27, 0xa4, 0xfa, 0x75, 0xf7, 0x7c, 0x61, 0x48, 0x82, 0xd2, 0x3a, 0x55,
-	0xbe, 0x61, 0xde, 0x57, 0xbf, 0x7e, 0xfd, 0xba, 0xfb, 0x7d, 0x34, 0xe0, 0xbd, 0x80, 0xf6, 0xfa,
-	0xae, 0xa7, 0xdb, 0xeb, 0x3e, 0xf5, 0x86, 0xd4, 0x5b, 0xd7, 0xfb, 0xd6, 0x7a, 0x9f, 0x7a, 0xbe,
-	0xe5, 0x07, 0xd4, 0x31, 0x68, 0xc7, 0x76, 0x3b, 0xfe, 0xfa, 0x70, 0x63, 0xbd, 0x47, 0x7d, 0x5f,
-	0x3f, 0xa2, 0xad, 0xbe, 0xe7, 0x06, 0x2e, 0xb9, 0x27, 0xd9, 0x5a, 0x9c, 0xad, 0xa5, 0xf7, 0xad,
-	0x56, 0x9a, 0xad, 0x35, 0xdc, 0xa8, 0x37, 0x8e, 0x5c, 0xf7, 0xc8, 0xa6, 0xeb, 0xc8, 0xd6, 0x19,
-	0x74, 0xd7, 0xcd, 0x81, 0xa7, 0x07, 0x96, 0xeb, 0x70, 0x41, 0xf5, 0x5b, 0x69, 0x7c, 0x60, 0xf5,
-	0xa8, 0x1f, 0xe8, 0xbd, 0xbe, 0x20, 0x18, 0x11, 0x70, 0xea, 0xe9, 0x7d, 0x36, 0x92, 0xc0, 0xdf,
-	0x36, 0x69, 0x9f, 0x3a, 0x26, 0x75, 0x0c, 0x8b, 0xfa, 0xeb, 0x47, 0xee, 0x91, 0x8b, 0x70, 0xfc,
-	0x25, 0x48, 0xee, 0x84, 0x73, 0x64, 0x93, 0x33, 0xdc, 0x5e, 0xcf, 0x75, 0x46, 0xa6, 0x94, 0xa2,
-	0xa2, 0xce, 0xa0, 0x87, 0xf3, 0x3e, 0x75, 0xbd, 0x93, 0xae, 0xed, 0x9e, 0x0a, 0xaa, 0xbb, 0xd9,
-	0x54, 0x8e, 0xde, 0xa3, 0x7e, 0x5f, 0x37, 0xa4, 0xb0, 0x7b, 0x09, 0xb2, 0x10, 0x3b, 0x3a, 0xea,
-	0x9b, 0xd9, 0xf2, 0x02, 0xdd, 0x3f, 0xd1, 0xbe, 0x1c, 0xd0, 0x01, 0xcd, 0x1c, 0xb7, 0xab, 0x5b,
-	0xf6, 0xc0, 0xcb, 0x10, 0x97, 0x24, 0x3b, 0xb6, 0xfc, 0xc0, 0xf5, 0xce, 0x2e, 0x1b, 0x55, 0x4e,
-	0x71, 0x94, 0xee, 0xad, 0x2c, 0xef, 0x08, 0x95, 0xe4, 0x96, 0x14, 0xa4, 0xef, 0x5c, 0x48, 0x9a,
-	0xb2, 0xe2, 0xbd, 0x0b, 0x89, 0xd9, 0xe4, 0x05, 0xe1, 0xfd, 0x2c, 0xc2, 0xf3, 0xa6, 0xd5, 0x7c,
-	0x08, 0x73, 0xbb, 0x5f, 0x51, 0x63, 0xc0, 0xfc, 0xeb, 0x20, 0xd0, 0x03, 0x9f, 0xdc, 0x86, 0x19,
-	0x41, 0xad, 0xf9, 0xd6, 0xcf, 0xa9, 0x92, 0x5b, 0xcd, 0xad, 0xe5, 0xd5, 0xb2, 0x80, 0x1d, 0x58,
-	0x3f, 0xa7, 0xcd, 0x1e, 0x28, 0xed, 0x5e, 0x6f, 0x10, 0xe8, 0x1d, 0x9b, 0xee, 0xd8, 0x03, 0x3f,
-	0xa0, 0xde, 0x47, 0x34, 0xd0, 0x4d, 0x3d, 0xd0, 0x19, 0xbb, 0xc1, 0x41, 0x1a, 0x5b, 0x43, 0x64,
-	0x2f, 0xa9, 0x65, 0x01, 0xdb, 0xd7, 0x7b, 0x94, 0xb4, 0x60, 0x3e, 0x1c, 0xe1, 0x58, 0xf7, 0x4c,
-	0xcd, 0x70, 0x07, 0x4e, 0xa0, 0x4c, 0xac, 0xe6, 0xd6, 0x26, 0xd5, 0x9a, 0x1c, 0x88, 0x61, 0x76,
-	0x18, 0xa2, 0xf9, 0x37, 0x15, 0x98, 0xd9, 0x32, 0x02, 0x6b, 0x68, 0x05, 0x67, 0x6d, 0xa7, 0xeb,
-	0x12, 0x05, 0xa6, 0x87, 0x6c, 0xe3, 0xb8, 0x8e, 0xd0, 0x4e, 0x7e, 0x92, 0xc7, 0xa0, 0xf8, 0xc6,
-	0x31, 0x35, 0x07, 0x36, 0x35, 0x35, 0x3a, 0xa4, 0x4e, 0xa0, 0x75, 0xf4, 0xc0, 0x38, 0xd6, 0x2c,
-	0x13, 0xe5, 0xe7, 0xd5, 0xeb, 0x21, 0x7e, 0x97, 0xa1, 0xb7, 0x19, 0xb6, 0x6d, 0x92, 0x7d, 0xa8,
-	0xa4, 0x18, 0x95, 0xfc, 0x6a, 0x6e, 0xad, 0xbc, 0x79, 0xb7, 0x15, 0xee, 0x5b, 0xb6, 0x61, 0x85,
-	0x76, 0xad, 0xe1, 0x46, 0xeb, 0x43, 0xfe, 0x13, 0xc5, 0xa8, 0x73, 0x49, 0xb1, 0xe4, 0x8f, 0x21,
-	0x82, 0x68, 0x6c, 0x83, 0x2a, 0x05, 0x14, 0x57, 0x6f, 0xf1, 0xcd, 0xd9, 0x92, 0x9b, 0xb3, 0x75,
-	0x28, 0x77, 0xef, 0x76, 0xe1, 0xd7, 0xff, 0x79, 0x2b, 0xa7, 0xce, 0x86, 0x7c, 0x0c, 0x43, 0x6e,
-	0x02, 0xf8, 0x81, 0xee, 0x05, 0xd4, 0x64, 0x73, 0x98, 0xc4, 0x39, 0x94, 0x04, 0xa4, 0x6d, 0x92,
-	0x3d, 0x98, 0x95, 0x68, 0xae, 0xf5, 0xd4, 0x55, 0xb4, 0x9e, 0x11, 0xbc, 0x5c, 0xe7, 0x1d, 0x90,
-	0xdf, 0x5c, 0xe3, 0xe9, 0x31, 0x35, 0x2e, 0x0b, 0x2e, 0xd4, 0xf7, 0x16, 0x94, 0x75, 0xb1, 0x56,
-	0x4c, 0xe1, 0x22, 0x2e, 0x3f, 0x48, 0x50, 0xdb, 0x64, 0x13, 0xf2, 0xe8, 0x97, 0x03, 0xea, 0x07,
-	0x0c, 0x5f, 0x42, 0x7c, 0x49, 0x40, 0xda, 0x26, 0xf9, 0x1c, 0x96, 0xa5, 0x01, 0xb4, 0xc0, 0xd5,
-	0x50, 0x34, 0xaa, 0xe3, 0x0e, 0x02, 0x05, 0x50, 0xa3, 0xe5, 0x11, 0x8d, 0x9e, 0x8a, 0x13, 0x72,
-	0xbb, 0xf0, 0xd7, 0x4c, 0xa1, 0x45, 0x29, 0xe1, 0xd0, 0x3d, 0x60, 0xfc, 0x87, 0x9c, 0x3d, 0x2d,
-	0xdb, 0xb0, 0x5d, 0x9f, 0x86, 0xb2, 0xcb, 0x57, 0x96, 0xbd, 0xc3, 0xf8, 0xa5, 0xec, 0x43, 0x58,
-	0x14, 0xba, 0xa6, 0x05, 0xcf, 0x8c, 0x27, 0x78, 0x1e, 0xd9, 0x53, 0x52, 0x9f, 0x41, 0xed, 0x98,
-	0xea, 0x5e, 0xd0, 0xa1, 0x7a, 0x64, 0x85, 0xd9, 0xf1, 0x04, 0x56, 0x43, 0x4e, 0x29, 0xed, 0x2d,
-	0xa8, 0x1a, 0xba, 0x63, 0x50, 0x5b, 0x13, 0xf6, 0xa6, 0xa6, 0x32, 0xb7, 0x9a, 0x5b, 0x2b, 0xaa,
-	0x15, 0x0e, 0x57, 0x25, 0x98, 0xbc, 0x0d, 0xb5, 0x24, 0x29, 0x5b, 0xac, 0x0a, 0x7a, 0x5f, 0x92,
-	0xb6, 0x8d, 0xb4, 0x4c, 0x35, 0x4f, 0xc3, 0x23, 0xd8, 0x0f, 0xf4, 0x60, 0xe0, 0x2b, 0x55, 0xdc,
-	0xcd, 0x15, 0x44, 0x1c, 0xea, 0xfe, 0xc9, 0x01, 0x82, 0xd9, 0xd6, 0xd5, 0x03, 0xe6, 0x9b, 0x81,
-	0x52, 0x43, 0x0a, 0xf9, 0xc9, 0xfc, 0x22, 0x3a, 0xc2, 0x15, 0xc2, 0xfd, 0x82, 0x41, 0x3e, 0x61,
-	0x00, 0xa6, 0x7b, 0xb4, 0x0f, 0xa8, 0x13, 0x58, 0xc1, 0x99, 0x32, 0x8f, 0x44, 0x95, 0x70, 0x37,
-	0x70, 0x30, 0x59, 0x83, 0xea, 0xb1, 0xee, 0x6b, 0x1e, 0x0d, 0xbc, 0x33, 0xad, 0xef, 0xda, 0x96,
-	0x71, 0xa6, 0x2c, 0xe0, 0x34, 0xe7, 0x8e, 0x75, 0x5f, 0x65, 0xe0, 0xe7, 0x08, 0x25, 0x9f, 0xc2,
-	0x22, 0xa7, 0xb2, 0x1c, 0x2b, 0xb0, 0x74, 0x5b, 0xb3, 0x9c, 0x80, 0x7a, 0x43, 0xdd, 0x56, 0xae,
-	0x8f, 0x67, 0xe3, 0x05, 0x64, 0x6f, 0x73, 0xee, 0xb6, 0x60, 0x8e, 0xc4, 0xf6, 0xf4, 0xaf, 0xac,
-	0xde, 0xa0, 0x17, 0x89, 0x5d, 0xbc, 0x8a, 0xd8, 0x8f, 0x38, 0x77, 0x28, 0xf6, 0xdd, 0xb4, 0x58,
-	0x61, 0x3a, 0x5f, 0x59, 0x42, 0x53, 0x26, 0xb8, 0xb6, 0x04, 0x8e, 0x1c, 0xc2, 0x75, 0xce, 0x45,
-	0xbf, 0xea, 0x5b, 0x7c, 0x14, 0xbe, 0xbd, 0x95, 0x31, 0xb7, 0xf7, 0x3c, 0xb2, 0xef, 0x86, 0xdc,
-	0xb8, 0xcd, 0x7f, 0x04, 0xcb, 0x5c, 0x6a, 0x47, 0x37, 0x4e, 0xdc, 0x6e, 0x57, 0x33, 0x5c, 0xda,
-	0xed, 0x5a, 0x86, 0xc5, 0xce, 0xa0, 0xe5, 0xd5, 0xdc, 0x5a, 0x4e, 0x5d, 0x42, 0x82, 0x6d, 0x8e,
-	0xdf, 0x89, 0xd0, 0xe4, 0x29, 0xdc, 0xe2, 0xbc, 0x8e, 0xeb, 0xf0, 0x55, 0x62, 0x17, 0x89, 0x46,
-	0x3d, 0xcf, 0xf5, 0xb4, 0xe0, 0xac, 0x4f, 0x7d, 0xa5, 0xbe, 0x9a, 0x5f, 0x2b, 0xa9, 0x2b, 0x88,
-	0xdc, 0x77, 0x1d, 0x55, 0x12, 0xed, 0x32, 0x9a, 0x43, 0x46, 0x42, 0xf6, 0x81, 0x70, 0x29, 0xb6,
-	0xee, 0x07, 0x9a, 0xb8, 0xde, 0x95, 0x15, 0x9c, 0xd4, 0x6a, 0xf2, 0xf8, 0x13, 0x48, 0x76, 0xfc,
-	0x7d, 0xc0, 0x7f, 0xaa, 0x55, 0xe4, 0x7d, 0xa6, 0xfb, 0x81, 0x80, 0x90, 0x27, 0x50, 0x8f, 0xc9,
-	0x63, 0xb7, 0x2f, 0xf5, 0x22, 0x57, 0xbb, 0x81, 0xae, 0xb6, 0x14, 0x72, 0xbd, 0x40, 0x7c, 0xe8,
-	0x72, 0xb7, 0x61, 0x26, 0x8c, 0x58, 0xd8, 0x4e, 0xb9, 0xc9, 0x6f, 0xbd, 0x10, 0xd6, 0x36, 0xd9,
-	0xc1, 0x18, 0x1e, 0x3e, 0x96, 0xa9, 0x34, 0x70, 0x2f, 0x81, 0x04, 0xb5, 0x4d, 0xf2, 0x19, 0x2c,
-	0xe2, 0xd0, 0xd1, 0x86, 0x37, 0x69, 0xa0, 0x5b, 0xb6, 0xaf, 0xdc, 0xca, 0x9a, 0x94, 0x08, 0x25,
-	0x86, 0x1b, 0xad, 0xe7, 0xfa, 0x99, 0xed, 0xea, 0xa6, 0xaf, 0x2e, 0x30, 0xfe, 0x0f, 0x25, 0xfb,
-	0x53, 0xce, 0x4d, 0xbe, 0x80, 0x7a, 0x4a, 0xee, 0xa0, 0x6f, 0xea, 0x01, 0x3f, 0xa0, 0x94, 0xd5,
-	0x31, 0xbd, 0x60, 0x29, 0x21, 0xfb, 0x53, 0x94, 0xc0, 0x68, 0x9a, 0xff, 0x0a, 0x50, 0xc2, 0xcb,
-	0x1a, 0xaf, 0xe6, 0x65, 0x28, 0xf2, 0x3b, 0xdd, 0x32, 0xf1, 0x6e, 0x9e, 0x54, 0xa7, 0xf1, 0xbb,
-	0x6d, 0x32, 0x94, 0xa7, 0x3b, 0x47, 0x34, 0xba, 0x8b, 0xa7, 0xf1, 0xbb, 0x6d, 0x92, 0x05, 0x98,
-	0x74, 0x4f, 0x1d, 0xea, 0xe1, 0x9d, 0x5b, 0x52, 0xf9, 0x07, 0xd9, 0x64, 0x9e, 0xdb, 0xb7, 0x2d,
-	0x83, 0x3b, 0xad, 0x6e, 0x9c, 0x68, 0x36, 0x1d, 0x52, 0x1b, 0xaf, 0xd2, 0x3c, 0xf3, 0xcb, 0x10,
-	0xb9, 0x65, 0x9c, 0x3c, 0x63, 0x28, 0x72, 0x1f, 0x48, 0xe0, 0xe9, 0x8e, 0xdf, 0xa5, 0x5e, 0x8c,
-	0x81, 0x5f, 0x9b, 0x55, 0x89, 0x89, 0x53, 0xfb, 0x81, 0x6b, 0x53, 0x47, 0xf3, 0x2d, 0xc7, 0xa0,
-	0x9a, 0x47, 0x1d, 0x7a, 0x8a, 0x57, 0xe8, 0xa4, 0x5a, 0xe5, 0x98, 0x03, 0x86, 0x50, 0x19, 0x9c,
-	0x6c, 0x41, 0x39, 0x6e, 0xb9, 0x71, 0xaf, 0x47, 0x18, 0x84, 0xc6, 0x22, 0x9f, 0xc0, 0x02, 0x3f,
-	0x2a, 0x43, 0xdd, 0xb8, 0xac, 0xe2, 0x98, 0xb2, 0xf8, 0x41, 0x2b, 0xf5, 0x47, 0x91, 0x4f, 0xa1,
-	0x11, 0xb9, 0x9e, 0xe3, 0x06, 0x56, 0x57, 0x1a, 0x4c, 0xc6, 0x48, 0x25, 0x9c, 0xfd, 0x8d, 0x90,
-	0x6a, 0x3f, 0x46, 0xf4, 0x99, 0x08, 0x9c, 0x7e, 0x95, 0x83, 0xba, 0x8c, 0xdb, 0x32, 0x0c, 0x08,
-	0xab, 0xf9, 0xb5, 0xf2, 0xe6, 0xc7, 0xad, 0x31, 0x73, 0x98, 0x56, 0xe8, 0x10, 0x2d, 0x11, 0x1f,
-	0x1e, 0xa6, 0x4c, 0xbf, 0xeb, 0x04, 0xde, 0x99, 0xba, 0x64, 0x64, 0x63, 0xc9, 0x5f, 0xe4, 0x60,
-	0x29, 0x54, 0x27, 0x69, 0x30, 0xa5, 0x8c, 0xba, 0x3c, 0x7b, 0x0d, 0x5d, 0xe2, 0x36, 0x44, 0x45,
-	0x84, 0x75, 0x17, 0x8c, 0x0c, 0x02, 0xf2, 0x97, 0x39, 0x58, 0x96, 0xba, 0xc4, 0xfd, 0x91, 0x6b,
-	0x33, 0xf3, 0xba, 0x96, 0x51, 0x23, 0x91, 0x19, 0x96, 0x49, 0x63, 0x99, 0x65, 0x96, 0xe3, 0x5a,
-	0x98, 0xf6, 0x97, 0x31, 0xdb, 0xcc, 0xa2, 0x36, 0xfb, 0xaf, 0xa0, 0x4d, 0x6c, 0xa0, 0xa7, 0xf6,
-	0x97, 0xc9, 0x65, 0x5a, 0xf4, 0x32, 0x91, 0xf5, 0x3d, 0xb8, 0x71, 0xd1, 0xf2, 0x92, 0x2a, 0xe4,
-	0x4f, 0xe8, 0x99, 0x48, 0x01, 0xd8, 0x4f, 0xb6, 0xd1, 0x87, 0xba, 0x3d, 0xa0, 0xe2, 0x00, 0xe0,
-	0x1f, 0x3f, 0x9a, 0x78, 0x3f, 0x57, 0x37, 0x60, 0xf9, 0xdc, 0xe5, 0xc9, 0x10, 0xf4, 0x20, 0x2e,
-	0xe8, 0xc2, 0x9d, 0x13, 0x1f, 0x24, 0x52, 0x38, 0xd3, 0xea, 0x57, 0x52, 0xb8, 0x0d, 0x2b, 0x17,
-	0xd8, 0xec, 0x2a, 0xa2, 0x9a, 0xff, 0x50, 0x80, 0xf9, 0x98, 0x2c, 0x16, 0x2e, 0xe1, 0x61, 0x9a,
-	0xbe, 0x55, 0x72, 0x99, 0xb7, 0x8a, 0x4c, 0x14, 0xe5, 0xb9, 0x5a, 0x52, 0x41, 0x82, 0xda, 0x26,
-	0xb9, 0x0e, 0x53, 0xde, 0xc0, 0x61, 0x38, 0x71, 0xb6, 0x7a, 0x03, 0xa7, 0x6d, 0x92, 0x1d, 0xc0,
-	0xd8, 0x0a, 0xaf, 0x5b, 0x3c, 0x4f, 0xe7, 0x36, 0xdf, 0xcc, 0xf4, 0x1a, 0x4c, 0x31, 0x99, 0xab,
-	0x30, 0xad, 0xd8, 0xcd, 0xab, 0x16, 0x03, 0xf1, 0x2b, 0x9e, 0x87, 0x4d, 0x26, 0xf3, 0xb0, 0x3b,
-	0x30, 0xd7, 0xb5, 0x3c, 0x3f, 0x10, 0x39, 0x98, 0x65, 0xe2, 0xa1, 0x9a, 0x57, 0x67, 0x10, 0x8a,
-	0xe9, 0x46, 0xdb, 0x24, 0x4d, 0x98, 0x75, 0xe8, 0x57, 0x31, 0xa2, 0x69, 0x9e, 0x6b, 0x32, 0xa0,
-	0xa4, 0xb9, 0x0d, 0x33, 0x51, 0x22, 0x25, 0x12, 0x8a, 0xbc, 0x1a, 0x5e, 0xa5, 0xec, 0x62, 0x69,
-	0xc1, 0x3c, 0x97, 0xc0, 0x32, 0x1b, 0x9a, 0x38, 0xf6, 0x26, 0xd5, 0x1a, 0xa2, 0x0e, 0x18, 0x46,
-	0x9e, 0x75, 0x7f, 0x00, 0x2b, 0x0e, 0x3d, 0xd5, 0x98, 0x59, 0xb2, 0xf8, 0x00, 0xf9, 0x96, 0x1c,
-	0x7a, 0xaa, 0x0e, 0x9c, 0xdd, 0x11, 0xee, 0xdb, 0x30, 0xd3, 0xf1, 0x74, 0xc7, 0x38, 0xd6, 0x02,
-	0xf7, 0x84, 0x3a, 0x98, 0x37, 0xcc, 0xa8, 0x65, 0x0e, 0x3b, 0x64, 0x20, 0xb2, 0x0e, 0x0b, 0x72,
-	0x80, 0x04, 0xe9, 0x2c, 0x92, 0xd6, 0xb8, 0xe4, 0xed, 0x18, 0xc3, 0x12, 0x4c, 0xe3, 0x6a, 0x84,
-	0x31, 0xf6, 0x14, 0xfb, 0x6c, 0x9b, 0x7b, 0x85, 0xe2, 0x4c, 0x75, 0x76, 0xaf, 0x50, 0x9c, 0xab,
-	0x56, 0x9a, 0x7f, 0x57, 0x80, 0xd9, 0x43, 0x19, 0x4e, 0x7f, 0x2f, 0xfc, 0x63, 0x17, 0x66, 0x44,
-	0xce, 0xc2, 0xe5, 0x4c, 0xa2, 0x9c, 0x66, 0x32, 0x8e, 0x89, 0x04, 0x70, 0x52, 0x94, 0x51, 0x0e,
-	0xa2, 0x0f, 0x42, 0xe1, 0x7a, 0x38, 0x07, 0x19, 0x6e, 0xa2, 0xbc, 0x29, 0x94, 0xb7, 0x71, 0xb1,
-	0x5e, 0x2f, 0x04, 0xab, 0x08, 0x44, 0x51, 0xfc, 0xfc, 0xe9, 0x28, 0x30, 0xee, 0xcd, 0xd3, 0x49,
-	0x6f, 0x66, 0xb9, 0x87, 0x0c, 0xdd, 0x64, 0xf6, 0x52, 0xe4, 0xf9, 0x8d, 0x84, 0x8b, 0x70, 0x9b,
-	0x05, 0x39, 0xa1, 0x37, 0xf3, 0x7b, 0x77, 0x9a, 0x0a, 0x4f, 0x8e, 0x2d, 0x32, 0xc4, 0x17, 0x99,
-	0xb4, 0xa1, 0x32, 0xb4, 0x7c, 0xab, 0x63, 0xd9, 0x2c, 0x69, 0xc6, 0x78, 0xa0, 0x3c, 0x66, 0x3c,
-	0x30, 0x17, 0x31, 0x62, 0x30, 0xf6, 0x1f, 0x05, 0xa8, 0xca, 0xb3, 0xf8, 0x7b, 0xe3, 0x26, 0x2d,
-	0x98, 0x0f, 0x74, 0xef, 0x88, 0x06, 0x5a, 0x42, 0xcd, 0x49, 0x1c, 0xa8, 0xc6, 0x51, 0xfb, 0x31,
-	0x65, 0x59, 0x8c, 0xc7, 0xe9, 0xe3, 0x3a, 0x4f, 0x21, 0x79, 0x95, 0x63, 0x5e, 0x44, 0x9a, 0x37,
-	0x61, 0x56, 0x50, 0x8b, 0x09, 0x4c, 0xf3, 0xe9, 0x73, 0xa0, 0x8a, 0xd3, 0x48, 0xe6, 0x9e, 0xc5,
-	0x74, 0xee, 0xf9, 0x04, 0xea, 0x42, 0x84, 0x71, 0x6c, 0xd9, 0x66, 0x34, 0xac, 0xeb, 0xd8, 0x67,
-	0xb8, 0xcc, 0x45, 0x75, 0x89, 0x53, 0xec, 0x30, 0x02, 0x39, 0xfa, 0xc7, 0x8e, 0x7d, 0x96, 0x8e,
-	0xfb, 0x61, 0x24, 0xee, 0x8f, 0xf9, 0x5d, 0x39, 0xe9, 0x77, 0x31, 0x8f, 0x99, 0xb9, 0xcc, 0x63,
-	0x66, 0x5f, 0xcd, 0x63, 0xc8, 0x3b, 0x50, 0xf3, 0xa8, 0xe1, 0x7a, 0xa6, 0x16, 0x21, 0x44, 0x51,
-	0xa0, 0xca, 0x11, 0x9f, 0x85, 0xf0, 0xe6, 0x00, 0x88, 0xa8, 0x1f, 0xf1, 0xd3, 0x4b, 0x65, 0xf1,
-	0x3b, 0x59, 0x81, 0x92, 0x38, 0xe6, 0x42, 0xe7, 0x2a, 0x72, 0x00, 0x37, 0x7f, 0x87, 0x1e, 0x59,
-	0x8e, 0xe6, 0xb8, 0x66, 0x2c, 0xf4, 0x2f, 0x23, 0x70, 0xdf, 0x35, 0x99, 0x05, 0x1a, 0x50, 0xa6,
-	0x8e, 0x19, 0x52, 0xe4, 0x79, 0x91, 0x8b, 0x3a, 0x26, 0xc7, 0x37, 0xff, 0x36, 0x07, 0xb3, 0x89,
-	0x71, 0xd1, 0x32, 0x1e, 0x8d, 0x79, 0xf3, 0x14, 0xfb, 0x6c, 0x9b, 0x49, 0x5d, 0x26, 0x52, 0xba,
-	0xfc, 0x29, 0x94, 0x74, 0xc7, 0xa0, 0x4c, 0x90, 0xaf, 0xe4, 0x31, 0x54, 0x7a, 0x32, 0x76, 0xa8,
-	0x34, 0x3a, 0x71, 0x35, 0x92, 0xd6, 0xfc, 0x97, 0x1c, 0x54, 0x04, 0xc5, 0x21, 0xd3, 0x84, 0xed,
-	0xbb, 0x17, 0x50, 0x96, 0xba, 0x38, 0x5d, 0x17, 0x15, 0x2d, 0x6f, 0x3e, 0x7a, 0xc5, 0x01, 0x41,
-	0xcc, 0x82, 0x09, 0xfe, 0x31, 0x94, 0xba, 0xae, 0x77, 0xc2, 0x17, 0x7e, 0x62, 0xcc, 0x85, 0x2f,
-	0x32, 0x16, 0x5c, 0x72, 0x02, 0x05, 0x54, 0x88, 0xef, 0x64, 0xfc, 0xdd, 0xfc, 0xb7, 0x1c, 0x94,
-	0xf0, 0x72, 0xb9, 0xa4, 0xc0, 0x9a, 0x2c, 0x47, 0x4e, 0xa4, 0xcb, 0x91, 0x5b, 0x50, 0xc6, 0x32,
-	0x83, 0x70, 0xca, 0xfc, 0xb8, 0x29, 0x12, 0x67, 0x92, 0x05, 0xc4, 0x78, 0x1d, 0x89, 0xe7, 0x7a,
-	0xb8, 0x3d, 0x45, 0x09, 0x69, 0x19, 0x8a, 0x3c, 0x25, 0x08, 0xcf, 0x88, 0x69, 0xfc, 0x6e, 0x9b,
-	0xcd, 0x5f, 0x4d, 0x40, 0xf1, 0xff, 0xe3, 0xd8, 0x4b, 0xed, 0xe9, 0xc2, 0xc8, 0x9e, 0xde, 0x82,
-	0xb2, 0xe1, 0xd1, 0x30, 0x55, 0x9c, 0x1c, 0xd7, 0x0e, 0x9c, 0x09, 0xed, 0x90, 0x32, 0xe5, 0xd4,
-	0xd5, 0x4d, 0xd9, 0xf4, 0xa1, 0xb6, 0x65, 0xdb, 0xae, 0xa1, 0x07, 0xd4, 0x0c, 0xcd, 0xb2, 0x0b,
-	0x05, 0x53, 0x0f, 0x74, 0xe1, 0x8e, 0x1b, 0x63, 0xbb, 0xa3, 0x14, 0xa0, 0x22, 0x7b, 0xfc, 0x6c,
-	0x9a, 0x88, 0x9f, 0x4d, 0xcd, 0xdf, 0x4e, 0xc0, 0xec, 0xa1, 0x3c, 0x3a, 0xc7, 0x5d, 0x08, 0x02,
-	0x05, 0xec, 0x16, 0xf0, 0x15, 0xc0, 0xdf, 0x64, 0x2b, 0x7e, 0xb7, 0xe4, 0xf1, 0x6e, 0xb9, 0x73,
-	0x5e, 0xe8, 0x20, 0xc7, 0x4b, 0xdd, 0x2c, 0xef, 0x43, 0xe1, 0xc4, 0x72, 0x4c, 0x71, 0x33, 0x5d,
-	0xca, 0xfd, 0x27, 0x96, 0x63, 0xaa, 0xc8, 0xc1, 0xce, 0x91, 0x74, 0xf9, 0xa0, 0xa8, 0xcb, 0x8c,
-	0xf0, 0xf5, 0x97, 0x86, 0xec, 0x41, 0x15, 0x8b, 0x32, 0xaf, 0x52, 0x50, 0x98, 0x63, 0x9c, 0xb1,
-	0x0a, 0xcc, 0x2f, 0x27, 0x00, 0x0e, 0xac, 0x23, 0x47, 0xb7, 0x2f, 0xef, 0x8e, 0xf0, 0x42, 0x67,
-	0x70, 0x6e, 0x77, 0x24, 0xc4, 0x27, 0xba, 0x23, 0xc9, 0x9a, 0x7d, 0x3e, 0x5d, 0xb3, 0x97, 0xab,
-	0x57, 0x88, 0xad, 0xde, 0x23, 0x98, 0xb4, 0x9c, 0xfe, 0x20, 0x10, 0xbe, 0x7f, 0x79, 0xf1, 0x8a,
-	0x93, 0x33, 0xed, 0x0d, 0xd7, 0x09, 0x3c, 0xd7, 0x16, 0x37, 0xba, 0xfc, 0x64, 0x6e, 0x14, 0x69,
-	0x1f, 0x25, 0x0b, 0x21, 0xac, 0x6d, 0x36, 0xff, 0x29, 0x07, 0x35, 0x51, 0x97, 0xde, 0xc1, 0x22,
-	0xf5, 0x77, 0x65, 0x90, 0xcc, 0xf2, 0x38, 0xb7, 0xcb, 0x48, 0x79, 0x3c, 0xad, 0x77, 0x61, 0x54,
-	0xef, 0xdf, 0xe6, 0x60, 0x51, 0x06, 0x0d, 0x89, 0x76, 0x1c, 0xc5, 0x91, 0xf8, 0x49, 0x12, 0x1b,
-	0x29, 0x27, 0x46, 0x42, 0x44, 0x34, 0x52, 0x74, 0x5a, 0x4d, 0xc4, 0x4f, 0xab, 0x3d, 0x98, 0x64,
-	0x87, 0xa9, 0xdc, 0x44, 0xef, 0x8e, 0x17, 0x2f, 0x27, 0xf5, 0x50, 0xb9, 0x08, 0xf2, 0x01, 0x4c,
-	0xc5, 0x0e, 0xe6, 0xb9, 0xcd, 0xd6, 0x39, 0x7b, 0x2a, 0x53, 0xca, 0xc0, 0x57, 0x05, 0x77, 0xf3,
-	0xaf, 0x56, 0xe0, 0xfa, 0x08, 0xcd, 0xef, 0xed, 0xd8, 0x6e, 0xc1, 0x7c, 0x5f, 0xf7, 0xd8, 0x72,
-	0x26, 0x44, 0xf1, 0x05, 0xaa, 0x71, 0x54, 0x2a, 0xa2, 0x14, 0xf4, 0x71, 0xb9, 0xdc, 0x9d, 0xab,
-	0x1c, 0x93, 0x8c, 0x28, 0x05, 0xb5, 0xb0, 0x36, 0xbf, 0x85, 0xca, 0x1c, 0xc8, 0x23, 0xca, 0xf4,
-	0xa2, 0x4f, 0x8d, 0x2c, 0x3a, 0xf9, 0x21, 0x2c, 0x1b, 0x6e, 0xaf, 0x6f, 0x53, 0xac, 0xe3, 0xa4,
-	0xbc, 0x8f, 0x3b, 0xf7, 0x62, 0x44, 0x90, 0x70, 0xbf, 0xe7, 0x50, 0x4d, 0xb3, 0x8a, 0x12, 0xe2,
-	0x98, 0x8d, 0xbf, 0x4a, 0x4a, 0x70, 0x2a, 0x02, 0x2e, 0xa5, 0x23, 0xe0, 0xfb, 0x40, 0x42, 0xcb,
-	0xb0, 0xf3, 0x98, 0xf7, 0x76, 0x81, 0x1b, 0x48, 0x62, 0xd8, 0x91, 0x8b, 0x0d, 0xde, 0x2f, 0xa0,
-	0x1e, 0x52, 0x53, 0xb9, 0xb8, 0x57, 0x6d, 0xb4, 0x29, 0xa7, 0x69, 0xf7, 0x90, 0x6d, 0xac, 0x4f,
-	0x60, 0x21, 0x14, 0xcf, 0x56, 0xe0, 0x8a, 0x8d, 0xb6, 0x70, 0x26, 0xea, 0x20, 0x14, 0xd9, 0x81,
-	0x9b, 0x26, 0xed, 0xea, 0x03, 0x3b, 0xe6, 0x01, 0xfc, 0xf2, 0xb9, 0x5a, 0xcf, 0xad, 0x2e, 0xa4,
-	0x48, 0x6f, 0xc1, 0x6c, 0x47, 0x8c, 0xf1, 0x86, 0x68, 0xd5, 0x86, 0x85, 0x86, 0x39, 0x5e, 0x12,
-	0x41, 0xa0, 0xac, 0x2e, 0xbc, 0x03, 0x04, 0xef, 0x05, 0xee, 0x0e, 0xf2, 0x86, 0xad, 0xf1, 0xc6,
-	0x1b, 0xc3, 0xe0, 0x72, 0x1d, 0xf2, 0x34, 0xe0, 0x07, 0x30, 0xcf, 0x9b, 0x1f, 0xc9, 0x52, 0x0b,
-	0xe1, 0xd5, 0x6e, 0x86, 0xfa, 0x20, 0x5e, 0x6e, 0x79, 0x00, 0xd8, 0x20, 0xd0, 0xfa, 0x9e, 0x6b,
-	0x50, 0xdf, 0x0f, 0x5b, 0xc6, 0xf3, 0x48, 0x8f, 0xe3, 0x3e, 0x97, 0x28, 0xee, 0x15, 0x7f, 0x24,
-	0xa2, 0x3d, 0x7e, 0x3f, 0x2d, 0x8c, 0x79, 0x3f, 0xf1, 0x78, 0xf0, 0xdc, 0x6b, 0xee, 0xfa, 0xab,
-	0x5d, 0x73, 0x64, 0x33, 0x56, 0x06, 0x40, 0xc3, 0x48, 0x3b, 0x2e, 0xf2, 0x76, 0xc0, 0x69, 0xcc,
-	0xe6, 0xd2, 0x9c, 0x3f, 0x84, 0xe5, 0x24, 0x4f, 0x3c, 0x6c, 0x5b, 0xe2, 0x7b, 0x2c, 0xce, 0x77,
-	0x10, 0x85, 0x70, 0x8f, 0x41, 0x49, 0xb1, 0x46, 0x71, 0xaf, 0xc2, 0xef, 0x86, 0x04, 0x67, 0x18,
-	0x03, 0x1f, 0xa4, 0xf5, 0x94, 0x3e, 0xb4, 0x3c, 0x66, 0x23, 0xf8, 0x34, 0xc3, 0x79, 0x46, 0x26,
-	0x2f, 0xeb, 0x10, 0x75, 0xac, 0x43, 0x24, 0x78, 0x64, 0x2d, 0x22, 0xbe, 0x0d, 0x13, 0x33, 0xc0,
-	0x65, 0x58, 0x19, 0xb7, 0xf1, 0x93, 0x31, 0x4b, 0x5c, 0x0f, 0x1d, 0x6e, 0x64, 0xdb, 0x56, 0x0c,
-	0x70, 0x63, 0xcc, 0x01, 0x96, 0xb3, 0x16, 0x80, 0x0f, 0x91, 0xd5, 0xb0, 0xbe, 0x99, 0xdd, 0xb0,
-	0xf6, 0xe0, 0x6e, 0x52, 0x1b, 0xd7, 0xb3, 0x8e, 0x2c, 0x47, 0xb7, 0xd3, 0x6a, 0x35, 0xc6, 0x54,
-	0xeb, 0x76, 0x5c, 0xad, 0x8f, 0x85, 0xb0, 0xa4, 0x7a, 0x23, 0x2e, 0x12, 0xbb, 0xa2, 0x6f, 0xe1,
-	0xd9, 0x98, 0x70, 0x91, 0x44, 0xc7, 0x7c, 0x34, 0x7c, 0x58, 0xcd, 0x0e, 0x1f, 0xde, 0x86, 0x9a,
-	0x1f, 0x58, 0xc6, 0xc9, 0x99, 0x16, 0x3b, 0xa0, 0x6f, 0xcb, 0xce, 0x37, 0x43, 0x84, 0xf1, 0x2b,
-	0x39, 0x82, 0x55, 0x41, 0x7b, 0xfe, 0x1b, 0x8a, 0xe6, 0x78, 0x5e, 0x78, 0x83, 0x0b, 0x3a, 0xc8,
-	0x7e, 0x49, 0x11, 0x6b, 0xe3, 0xbf, 0x91, 0x6c, 0xe3, 0x9f, 0xdf, 0x52, 0xbf, 0xf3, 0xdd, 0xb4,
-	0xd4, 0xef, 0x7e, 0x37, 0x2d, 0xf5, 0x37, 0x2f, 0x68, 0xa9, 0x5f, 0xd8, 0xfc, 0xbe, 0x77, 0x71,
-	0xf3, 0xfb, 0xdc, 0x76, 0xfc, 0xda, 0xeb, 0xb4, 0xe3, 0xc7, 0x68, 0xa9, 0xbf, 0x75, 0x79, 0x4b,
-	0x3d, 0xeb, 0xe1, 0xc4, 0xdb, 0x99, 0x0f, 0x27, 0xde, 0x80, 0x59, 0xc3, 0x73, 0x9d, 0xd0, 0xcd,
-	0x94, 0x77, 0xd0, 0x21, 0x67, 0x18, 0x50, 0xba, 0xcc, 0x79, 0x75, 0xf9, 0xfb, 0xe7, 0xd5, 0xe5,
-	0xef, 0x03, 0x11, 0x51, 0x50, 0xbc, 0x68, 0xfe, 0x03, 0x2c, 0x9a, 0x57, 0x11, 0x13, 0xaf, 0x99,
-	0xdf, 0x86, 0x19, 0x1f, 0x93, 0x1e, 0xf1, 0x7c, 0xac, 0x25, 0x1a, 0x03, 0x08, 0xc3, 0x87, 0x63,
-	0xe4, 0x6e, 0xea, 0x29, 0xdb, 0x3a, 0x23, 0xd9, 0x9e, 0x50, 0x72, 0x89, 0xe7, 0x6c, 0xe4, 0x13,
-	0xa8, 0xe9, 0x83, 0xc0, 0xd5, 0x3c, 0xea, 0xd3, 0x40, 0xeb, 0xbb, 0x96, 0x13, 0xf8, 0xca, 0xc3,
-	0xac, 0x70, 0x2a, 0x7c, 0x93, 0x37, 0xdc, 0x68, 0xa9, 0x8c, 0xfa, 0x39, 0x12, 0xab, 0x15, 0xc6,
-	0x1f, 0x03, 0x90, 0x3f, 0xcf, 0x41, 0xcd, 0xa7, 0xba, 0x67, 0x1c, 0x33, 0x8f, 0xf2, 0xac, 0xce,
-	0x20, 0xa0, 0xbe, 0xf2, 0x2e, 0x96, 0x9c, 0x0e, 0xc7, 0x4e, 0xb9, 0x33, 0x03, 0xe4, 0xd6, 0x01,
-	0xca, 0xdd, 0x0a, 0xc5, 0xf2, 0x1e, 0x5d, 0xd5, 0x4f, 0x81, 0xc9, 0x9f, 0x41, 0xa1, 0x47, 0x7b,
-	0xae, 0xf2, 0x1e, 0x8e, 0xfa, 0xe1, 0x6b, 0x8e, 0xfa, 0x11, 0xed, 0xb9, 0x7c, 0x24, 0x94, 0x4a,
-	0xbe, 0x80, 0x9a, 0x58, 0x50, 0x8d, 0xdb, 0xd2, 0xa2, 0xbe, 0xf2, 0x08, 0x8d, 0xf6, 0x20, 0x73,
-	0xa8, 0x58, 0x28, 0x2a, 0x16, 0xfc, 0x43, 0xc9, 0xa7, 0x56, 0x87, 0x29, 0x08, 0x79, 0x08, 0x8b,
-	0x22, 0xaa, 0x09, 0xe3, 0x47, 0x11, 0x6c, 0x3f, 0x46, 0x4f, 0x9b, 0xe7, 0x8d, 0x24, 0x89, 0xe4,
-	0x41, 0xf7, 0xcf, 0xa0, 0x12, 0x91, 0xb3, 0x44, 0xc3, 0x57, 0xde, 0x47, 0x8d, 0x1e, 0x8f, 0x3d,
-	0xf9, 0xe4, 0x63, 0x48, 0x75, 0x8e, 0x26, 0xbe, 0xeb, 0x26, 0x5c, 0xcf, 0x34, 0x7f, 0x46, 0xbb,
-	0xef, 0xbd, 0x64, 0x87, 0xf2, 0xd6, 0x25, 0x09, 0x70, 0xbc, 0xb5, 0xf8, 0x53, 0x28, 0x85, 0xe6,
-	0xfe, 0xbd, 0x4a, 0xde, 0x2b, 0x14, 0x2b, 0xd5, 0xea, 0x5e, 0xa1, 0x58, 0xad, 0xd6, 0xf6, 0x0a,
-	0xc5, 0x07, 0xd5, 0x8d, 0xbd, 0x42, 0x71, 0xa3, 0xba, 0xb9, 0x57, 0x28, 0x6e, 0x56, 0x1f, 0x36,
-	0x7f, 0x91, 0x83, 0xe2, 0xce, 0x31, 0x35, 0x4e, 0xfc, 0x41, 0x2f, 0x9d, 0x35, 0x4f, 0x46, 0x59,
-	0xf3, 0x53, 0x98, 0xea, 0xda, 0xfa, 0xd0, 0xf5, 0x50, 0x81, 0xb9, 0xcd, 0xfb, 0x17, 0x27, 0x94,
-	0x52, 0xe2, 0x07, 0xc8, 0xa3, 0x0a, 0xde, 0xa8, 0x1d, 0x9a, 0xc7, 0x0d, 0xce, 0x3f, 0x9a, 0xff,
-	0x5b, 0x00, 0x82, 0x35, 0xf4, 0x64, 0x52, 0xf8, 0xdd, 0xd4, 0x34, 0x62, 0x11, 0x5d, 0x3e, 0x5d,
-	0xc9, 0xdc, 0x87, 0x4a, 0x4a, 0xae, 0x78, 0xc1, 0x39, 0xee, 0x83, 0xd0, 0xe4, 0xa8, 0xec, 0x30,
-	0x94, 0xc3, 0xc5, 0x73, 0x4c, 0xd1, 0xe4, 0x10, 0xa8, 0x58, 0x92, 0x79, 0x07, 0xe6, 0x24, 0xbd,
-	0x70, 0x7c, 0x5e, 0x0e, 0x91, 0x4f, 0x34, 0x55, 0x91, 0xda, 0xa7, 0x9e, 0x7f, 0x4e, 0xbf, 0xfa,
-	0xf3, 0xcf, 0xcc, 0x4a, 0x43, 0x31, 0xbb, 0xd2, 0x70, 0x03, 0x4a, 0x61, 0x66, 0x2d, 0xb3, 0xc5,
-	0x10, 0x70, 0xc5, 0x6c, 0xf1, 0xa7, 0x61, 0xb2, 0xce, 0xdf, 0x4d, 0x8a, 0x8b, 0xa7, 0x8c, 0xbe,
-	0xb5, 0x76, 0x4e, 0x7d, 0xe1, 0x39, 0x72, 0xe0, 0x5b, 0x49, 0x7e, 0x25, 0xc9, 0xb4, 0x3e, 0x06,
-	0x1a, 0x49, 0xc2, 0x67, 0x46, 0x2b, 0x2f, 0xbf, 0x2c, 0x40, 0x25, 0xac, 0x04, 0xf0, 0x17, 0x53,
-	0x64, 0x4f, 0xd4, 0xc7, 0xaf, 0x5a, 0xb0, 0x8f, 0x2a, 0x0a, 0x58, 0x26, 0x65, 0x32, 0xc8, 0x73,
-	0x98, 0x32, 0x5c, 0xa7, 0x6b, 0x1d, 0x89, 0xcd, 0xfa, 0xfe, 0xd5, 0xa5, 0xed, 0x20, 0xbf, 0x2a,
-	0xe4, 0x10, 0x0f, 0x48, 0xfc, 0xfd, 0x87, 0x90, 0xce, 0x2b, 0xed, 0x3b, 0x57, 0x97, 0x1e, 0x7b,
-	0x77, 0x20, 0x06, 0xaa, 0x79, 0x69, 0x10, 0xb9, 0x0b, 0x73, 0x7c, 0x9c, 0xf0, 0x12, 0xe7, 0x45,
-	0xac, 0x59, 0x0e, 0x95, 0x17, 0xf8, 0x36, 0xdc, 0xec, 0xea, 0x96, 0xed, 0x0e, 0xa9, 0x97, 0xfd,
-	0x12, 0x89, 0x17, 0x52, 0x57, 0x24, 0x51, 0xd6, 0x43, 0xa4, 0xb7, 0xa0, 0x1a, 0xca, 0x90, 0x6c,
-	0xbc, 0x78, 0x52, 0x91, 0x70, 0x49, 0xfa, 0x0c, 0x6a, 0x21, 0x29, 0x75, 0xae, 0xf8, 0x68, 0x39,
-	0x94, 0xb6, 0xeb, 0x60, 0x30, 0xdf, 0xfc, 0xe7, 0x09, 0x98, 0x4d, 0xac, 0x20, 0x99, 0x83, 0x89,
-	0xb0, 0xfe, 0x34, 0x61, 0x99, 0xe4, 0x89, 0xac, 0xa3, 0xf1, 0x63, 0xef, 0xee, 0x39, 0xae, 0x19,
-	0x0a, 0x49, 0x14, 0xce, 0x64, 0x8d, 0x34, 0x1f, 0xab, 0x91, 0xae, 0x42, 0xd9, 0xa4, 0xbe, 0xe1,
-	0x59, 0xfd, 0x40, 0xda, 0xb4, 0xa4, 0xc6, 0x41, 0xd1, 0xc3, 0xb8, 0xc9, 0xf8, 0xc3, 0xb8, 0x43,
-	0x51, 0xc2, 0x9f, 0xc2, 0x9b, 0xfd, 0x27, 0xaf, 0xe6, 0xa0, 0xad, 0xa7, 0x7a, 0xa0, 0x8b, 0x1b,
-	0x9d, 0x49, 0xab, 0x3f, 0x86, 0x52, 0x08, 0xba, 0xec, 0xf9, 0x4a, 0x29, 0xfe, 0x7c, 0xe5, 0x18,
-	0xea, 0xe7, 0xbb, 0x13, 0x3b, 0xf8, 0xf0, 0xf5, 0x37, 0xd5, 0x32, 0xfe, 0x17, 0x50, 0xe3, 0xa8,
-	0x9d, 0xd8, 0xbf, 0x03, 0xea, 0x50, 0x14, 0x84, 0xbe, 0x32, 0x81, 0x31, 0x6b, 0xf8, 0xdd, 0xfc,
-	0x9f, 0x7c, 0x6c, 0xb7, 0x0a, 0xf9, 0x3f, 0x86, 0x92, 0x47, 0x03, 0xea, 0x04, 0xf2, 0x72, 0x18,
-	0x23, 0x19, 0x88, 0x38, 0xc8, 0x3d, 0xa8, 0xb0, 0xfb, 0xdc, 0x1a, 0xea, 0xb6, 0xd6, 0x19, 0x18,
-	0x27, 0x34, 0x10, 0x13, 0x9c, 0x93, 0xe0, 0x6d, 0x84, 0x92, 0x36, 0xcc, 0x74, 0x74, 0x53, 0xeb,
-	0x58, 0x8e, 0x8e, 0xb1, 0x0e, 0xdf, 0x71, 0x6f, 0x26, 0x9d, 0x20, 0xfa, 0x53, 0xcb, 0x70, 0xa3,
-	0xb5, 0xad, 0x9b, 0xdb, 0x82, 0x5a, 0x2d, 0x77, 0xa2, 0x0f, 0xf2, 0x39, 0x2c, 0xca, 0xb8, 0x34,
-	0x1c, 0x9b, 0x7b, 0xd6, 0xc5, 0x8d, 0x8a, 0x2d, 0x41, 0xcc, 0x1d, 0x6b, 0x41, 0xc8, 0x48, 0x40,
-	0xc9, 0x03, 0x58, 0x18, 0x91, 0x3d, 0xf0, 0x2c, 0xe1, 0x40, 0x24, 0xc5, 0xf3, 0xa9, 0x67, 0x91,
-	0x9f, 0xc1, 0x72, 0xac, 0x99, 0x9c, 0x52, 0x68, 0xea, 0x0a, 0x0a, 0x2d, 0x45, 0x62, 0x92, 0x3a,
-	0x3d, 0x82, 0xa5, 0xac, 0x11, 0x98, 0x5a, 0xbc, 0x19, 0x7f, 0x7d, 0x94, 0xf3, 0x53, 0xcf, 0x6a,
-	0xfe, 0x7d, 0x2e, 0xf1, 0x2e, 0x4a, 0xec, 0x7b, 0x9f, 0xfc, 0x24, 0x5d, 0x49, 0xe3, 0xcb, 0xbe,
-	0x32, 0xb2, 0xec, 0x6d, 0x27, 0x78, 0xf4, 0xee, 0x67, 0xcc, 0x51, 0x53, 0x65, 0xb6, 0xb6, 0x28,
-	0xb3, 0x9d, 0x7a, 0x56, 0x10, 0x65, 0x26, 0x13, 0x97, 0x8b, 0xc1, 0x72, 0xd6, 0x0b, 0xc6, 0x25,
-	0x44, 0x6d, 0x07, 0x5f, 0x7f, 0xd3, 0xb8, 0xf6, 0x9b, 0x6f, 0x1a, 0xd7, 0x7e, 0xf7, 0x4d, 0x23,
-	0xf7, 0x8b, 0x97, 0x8d, 0xdc, 0x3f, 0xbe, 0x6c, 0xe4, 0xfe, 0xfd, 0x65, 0x23, 0xf7, 0xf5, 0xcb,
-	0x46, 0xee, 0xbf, 0x5e, 0x36, 0x72, 0xff, 0xfd, 0xb2, 0x71, 0xed, 0x77, 0x2f, 0x1b, 0xb9, 0x5f,
-	0x7f, 0xdb, 0xb8, 0xf6, 0xf5, 0xb7, 0x8d, 0x6b, 0xbf, 0xf9, 0xb6, 0x71, 0xed, 0xf3, 0x3f, 0x3c,
-	0x72, 0x23, 0x9b, 0x5a, 0xee, 0x25, 0x7f, 0x25, 0x7b, 0x92, 0x86, 0x75, 0xa6, 0x50, 0xb9, 0x87,
-	0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x7a, 0x1a, 0x3c, 0x8d, 0x36, 0x00, 0x00,
+	// 4152 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7b, 0x4f, 0x73, 0x1b, 0x47,
+	0x76, 0xb8, 0x40, 0x80, 0x24, 0xf0, 0x00, 0x82, 0x40, 0x93, 0x22, 0x87, 0x94, 0x04, 0x51, 0xb0,
+	0x64, 0xd1, 0xb6, 0x0c, 0x5a, 0x94, 0x2d, 0xd9, 0xd6, 0x6f, 0x7f, 0x59, 0x92, 0xa2, 0x63, 0x30,
+	0x32, 0x2d, 0x0f, 0x69, 0x6b, 0xe3, 0x8a, 0x6b, 0x76, 0x38, 0xd3, 0x24, 0xa7, 0x38, 0x98, 0x81,
+	0x67, 0x1a, 0xa4, 0xb9, 0xa7, 0x4d, 0xe5, 0xb0, 0x95, 0xca, 0x1e, 0xf6, 0x98, 0x63, 0x2a, 0xb9,
+	0xe4, 0x0b, 0xa4, 0x52, 0x39, 0xa4, 0x2a, 0x95, 0x5c, 0x92, 0x9b, 0x2f, 0xa9, 0xda, 0x43, 0xaa,
+	0x12, 0xcb, 0x97, 0x5c, 0x52, 0xbb, 0x1f, 0x21, 0xd5, 0xaf, 0xbb, 0xe7, 0x1f, 0x86, 0x24, 0x28,
+	0xad, 0x53, 0xb5, 0x37, 0xcc, 0xfb, 0xd7, 0xaf, 0x5f, 0xbf, 0x7e, 0xfd, 0xfa, 0xbd, 0x06, 0xbc,
+	0xc7, 0x68, 0xaf, 0xef, 0x07, 0xa6, 0xbb, 0x12, 0xd2, 0xe0, 0x98, 0x06, 0x2b, 0x66, 0xdf, 0x59,
+	0xe9, 0xd3, 0x20, 0x74, 0x42, 0x46, 0x3d, 0x8b, 0xee, 0xb9, 0xfe, 0x5e, 0xb8, 0x72, 0x7c, 0x7f,
+	0xa5, 0x47, 0xc3, 0xd0, 0x3c, 0xa0, 0x9d, 0x7e, 0xe0, 0x33, 0x9f, 0xdc, 0x55, 0x6c, 0x1d, 0xc1,
+	0xd6, 0x31, 0xfb, 0x4e, 0x27, 0xcb, 0xd6, 0x39, 0xbe, 0xbf, 0xd8, 0x3a, 0xf0, 0xfd, 0x03, 0x97,
+	0xae, 0x20, 0xdb, 0xde, 0x60, 0x7f, 0xc5, 0x1e, 0x04, 0x26, 0x73, 0x7c, 0x4f, 0x08, 0x5a, 0xbc,
+	0x99, 0xc5, 0x33, 0xa7, 0x47, 0x43, 0x66, 0xf6, 0xfa, 0x92, 0x60, 0x48, 0xc0, 0x49, 0x60, 0xf6,
+	0xf9, 0x48, 0x12, 0x7f, 0xcb, 0xa6, 0x7d, 0xea, 0xd9, 0xd4, 0xb3, 0x1c, 0x1a, 0xae, 0x1c, 0xf8,
+	0x07, 0x3e, 0xc2, 0xf1, 0x97, 0x24, 0xb9, 0x1d, 0xcd, 0x91, 0x4f, 0xce, 0xf2, 0x7b, 0x3d, 0xdf,
+	0x1b, 0x9a, 0x52, 0x86, 0x8a, 0x7a, 0x83, 0x1e, 0xce, 0xfb, 0xc4, 0x0f, 0x8e, 0xf6, 0x5d, 0xff,
+	0x44, 0x52, 0xdd, 0xc9, 0xa7, 0xf2, 0xcc, 0x1e, 0x0d, 0xfb, 0xa6, 0xa5, 0x84, 0xdd, 0x4d, 0x91,
+	0x45, 0xd8, 0xe1, 0x51, 0x5f, 0xcf, 0x97, 0xc7, 0xcc, 0xf0, 0xc8, 0xf8, 0x7a, 0x40, 0x07, 0x34,
+	0x77, 0xdc, 0x7d, 0xd3, 0x71, 0x07, 0x41, 0x8e, 0xb8, 0x34, 0xd9, 0xa1, 0x13, 0x32, 0x3f, 0x38,
+	0xbd, 0x68, 0x54, 0x35, 0xc5, 0x61, 0xba, 0x37, 0xf2, 0xbc, 0x23, 0x52, 0x52, 0x58, 0x52, 0x92,
+	0xbe, 0x75, 0x2e, 0x69, 0xc6, 0x8a, 0x77, 0xcf, 0x25, 0xe6, 0x93, 0x97, 0x84, 0xf7, 0xf2, 0x08,
+	0xcf, 0x9c, 0x56, 0x27, 0x8f, 0xfa, 0x1c, 0xe3, 0xbf, 0x9d, 0x47, 0x7f, 0xa6, 0x35, 0xda, 0x0f,
+	0xa0, 0xbe, 0xf9, 0x0d, 0xb5, 0x06, 0xdc, 0x7d, 0x77, 0x98, 0xc9, 0x42, 0x72, 0x0b, 0x6a, 0x52,
+	0x19, 0x23, 0x74, 0x7e, 0x46, 0xb5, 0xc2, 0x52, 0x61, 0xb9, 0xa8, 0x57, 0x25, 0x6c, 0xc7, 0xf9,
+	0x19, 0x6d, 0xf7, 0x40, 0xeb, 0xf6, 0x7a, 0x03, 0x66, 0xee, 0xb9, 0x74, 0xc3, 0x1d, 0x84, 0x8c,
+	0x06, 0x9f, 0x50, 0x66, 0xda, 0x26, 0x33, 0x39, 0xbb, 0x25, 0x40, 0x06, 0xd7, 0x12, 0xd9, 0x2b,
+	0x7a, 0x55, 0xc2, 0xb6, 0xcd, 0x1e, 0x25, 0x1d, 0x98, 0x89, 0x46, 0x38, 0x34, 0x03, 0xdb, 0xb0,
+	0xfc, 0x81, 0xc7, 0xb4, 0xb1, 0xa5, 0xc2, 0xf2, 0xb8, 0xde, 0x54, 0x03, 0x71, 0xcc, 0x06, 0x47,
+	0xb4, 0xff, 0x6d, 0x1a, 0x6a, 0x6b, 0x16, 0x73, 0x8e, 0x1d, 0x76, 0xda, 0xf5, 0xf6, 0x7d, 0xa2,
+	0xc1, 0xe4, 0x31, 0xdf, 0x97, 0xbe, 0x27, 0xb5, 0x53, 0x9f, 0xe4, 0x11, 0x68, 0xa1, 0x75, 0x48,
+	0xed, 0x81, 0x4b, 0x6d, 0x83, 0x1e, 0x53, 0x8f, 0x19, 0x7b, 0x26, 0xb3, 0x0e, 0x0d, 0xc7, 0x46,
+	0xf9, 0x45, 0xfd, 0x6a, 0x84, 0xdf, 0xe4, 0xe8, 0x75, 0x8e, 0xed, 0xda, 0x64, 0x1b, 0xa6, 0x33,
+	0x8c, 0x5a, 0x71, 0xa9, 0xb0, 0x5c, 0x5d, 0xbd, 0x13, 0x2d, 0x00, 0xc6, 0x03, 0xa9, 0x5d, 0xe7,
+	0xf8, 0x7e, 0xe7, 0x63, 0xf1, 0x13, 0xc5, 0xe8, 0xf5, 0xb4, 0x58, 0xf2, 0x87, 0x10, 0x43, 0x0c,
+	0xbe, 0xff, 0xb5, 0x12, 0x8a, 0x5b, 0xec, 0x88, 0xbd, 0xdf, 0x51, 0x7b, 0xbf, 0xb3, 0xab, 0x82,
+	0xc3, 0x7a, 0xe9, 0x57, 0xff, 0x79, 0xb3, 0xa0, 0x4f, 0x45, 0x7c, 0x1c, 0x43, 0x6e, 0x00, 0x84,
+	0xcc, 0x0c, 0x18, 0xb5, 0xf9, 0x1c, 0xc6, 0x71, 0x0e, 0x15, 0x09, 0xe9, 0xda, 0x64, 0x0b, 0xa6,
+	0x14, 0x5a, 0x68, 0x3d, 0x71, 0x19, 0xad, 0x6b, 0x92, 0x57, 0xe8, 0xbc, 0x01, 0xea, 0x5b, 0x68,
+	0x3c, 0x39, 0xa2, 0xc6, 0x55, 0xc9, 0x85, 0xfa, 0xde, 0x84, 0xaa, 0x29, 0xd7, 0x8a, 0x2b, 0x5c,
+	0xc6, 0xe5, 0x07, 0x05, 0xea, 0xda, 0x7c, 0x42, 0x01, 0xfd, 0x7a, 0x40, 0x43, 0xc6, 0xf1, 0x15,
+	0xc4, 0x57, 0x24, 0xa4, 0x6b, 0x93, 0x2f, 0x61, 0x41, 0x19, 0xc0, 0x60, 0xbe, 0x81, 0xa2, 0x51,
+	0x1d, 0x7f, 0xc0, 0x34, 0x40, 0x8d, 0x16, 0x86, 0x34, 0x7a, 0x22, 0x03, 0xf0, 0x7a, 0xe9, 0x2f,
+	0xb9, 0x42, 0x73, 0x4a, 0xc2, 0xae, 0xbf, 0xc3, 0xf9, 0x77, 0x05, 0x7b, 0x56, 0xb6, 0xe5, 0xfa,
+	0x21, 0x8d, 0x64, 0x57, 0x2f, 0x2d, 0x7b, 0x83, 0xf3, 0x2b, 0xd9, 0xbb, 0x30, 0x27, 0x75, 0xcd,
+	0x0a, 0xae, 0x8d, 0x26, 0x78, 0x06, 0xd9, 0x33, 0x52, 0x9f, 0x42, 0xf3, 0x90, 0x9a, 0x01, 0xdb,
+	0xa3, 0x66, 0x6c, 0x85, 0xa9, 0xd1, 0x04, 0x36, 0x22, 0x4e, 0x25, 0xed, 0x0d, 0x68, 0x58, 0xa6,
+	0x67, 0x51, 0xd7, 0x90, 0xf6, 0xa6, 0xb6, 0x56, 0x5f, 0x2a, 0x2c, 0x97, 0xf5, 0x69, 0x01, 0xd7,
+	0x15, 0x98, 0xbc, 0x09, 0xcd, 0x34, 0x29, 0x5f, 0xac, 0x69, 0xf4, 0xbe, 0x34, 0x6d, 0x17, 0x69,
+	0xb9, 0x6a, 0x81, 0x81, 0x11, 0x3e, 0x64, 0x26, 0x1b, 0x84, 0x5a, 0x03, 0x77, 0xf3, 0x34, 0x22,
+	0x76, 0xcd, 0xf0, 0x68, 0x07, 0xc1, 0x7c, 0xeb, 0x9a, 0x8c, 0xfb, 0x26, 0xd3, 0x9a, 0x48, 0xa1,
+	0x3e, 0xb9, 0x5f, 0xc4, 0x27, 0x84, 0x46, 0x84, 0x5f, 0x70, 0xc8, 0x67, 0x1c, 0xc0, 0x75, 0x8f,
+	0xf7, 0x01, 0xf5, 0x98, 0xc3, 0x4e, 0xb5, 0x19, 0x24, 0x9a, 0x8e, 0x76, 0x83, 0x00, 0x93, 0x65,
+	0x68, 0x1c, 0x9a, 0xa1, 0x11, 0x50, 0x16, 0x9c, 0x1a, 0x7d, 0xdf, 0x75, 0xac, 0x53, 0x6d, 0x16,
+	0xa7, 0x59, 0x3f, 0x34, 0x43, 0x9d, 0x83, 0x9f, 0x21, 0x94, 0x7c, 0x0e, 0x73, 0x82, 0xca, 0xf1,
+	0x1c, 0xe6, 0x98, 0xae, 0xe1, 0x78, 0x8c, 0x06, 0xc7, 0xa6, 0xab, 0x5d, 0x1d, 0xcd, 0xc6, 0xb3,
+	0xc8, 0xde, 0x15, 0xdc, 0x5d, 0xc9, 0x1c, 0x8b, 0xed, 0x99, 0xdf, 0x38, 0xbd, 0x41, 0x2f, 0x16,
+	0x3b, 0x77, 0x19, 0xb1, 0x9f, 0x08, 0xee, 0x48, 0xec, 0xbb, 0x59, 0xb1, 0xd2, 0x74, 0xa1, 0x36,
+	0x8f, 0xa6, 0x4c, 0x71, 0xad, 0x49, 0x1c, 0xd9, 0x85, 0xab, 0x82, 0x8b, 0x7e, 0xd3, 0x77, 0xc4,
+	0x28, 0x62, 0x7b, 0x6b, 0x23, 0x6e, 0xef, 0x19, 0x64, 0xdf, 0x8c, 0xb8, 0x71, 0x9b, 0x7f, 0x08,
+	0x0b, 0x42, 0xea, 0x9e, 0x69, 0x1d, 0xf9, 0xfb, 0xfb, 0x86, 0xe5, 0xd3, 0xfd, 0x7d, 0xc7, 0x72,
+	0x78, 0x0c, 0x5a, 0x58, 0x2a, 0x2c, 0x17, 0xf4, 0x79, 0x24, 0x58, 0x17, 0xf8, 0x8d, 0x18, 0x4d,
+	0x9e, 0xc0, 0x4d, 0xc1, 0xeb, 0xf9, 0x9e, 0x58, 0x25, 0x7e, 0x90, 0x18, 0x34, 0x08, 0xfc, 0xc0,
+	0x60, 0xa7, 0x7d, 0x1a, 0x6a, 0x8b, 0x4b, 0xc5, 0xe5, 0x8a, 0x7e, 0x0d, 0x91, 0xdb, 0xbe, 0xa7,
+	0x2b, 0xa2, 0x4d, 0x4e, 0xb3, 0xcb, 0x49, 0xc8, 0x36, 0x10, 0x21, 0xc5, 0x35, 0x43, 0x66, 0xc8,
+	0xec, 0x41, 0xbb, 0x86, 0x93, 0x5a, 0x4a, 0x87, 0x3f, 0x89, 0xe4, 0xe1, 0xef, 0x23, 0xf1, 0x53,
+	0x6f, 0x20, 0xef, 0x53, 0x33, 0x64, 0x12, 0x42, 0x1e, 0xc3, 0x62, 0x42, 0x1e, 0x3f, 0x31, 0x69,
+	0x10, 0xbb, 0xda, 0x75, 0x74, 0xb5, 0xf9, 0x88, 0xeb, 0x39, 0xe2, 0x23, 0x97, 0xbb, 0x05, 0xb5,
+	0xe8, 0x4c, 0xe6, 0x3b, 0xe5, 0x86, 0x38, 0xf5, 0x22, 0x58, 0xd7, 0xe6, 0x81, 0x31, 0x0a, 0x3e,
+	0x8e, 0xad, 0xb5, 0x70, 0x2f, 0x81, 0x02, 0x75, 0x6d, 0xf2, 0x05, 0xcc, 0xe1, 0xd0, 0xf1, 0x86,
+	0xb7, 0x29, 0x33, 0x1d, 0x37, 0xd4, 0x6e, 0xe6, 0x4d, 0x4a, 0x66, 0x2a, 0xc7, 0xf7, 0x3b, 0xcf,
+	0xcc, 0x53, 0xd7, 0x37, 0xed, 0x50, 0x9f, 0xe5, 0xfc, 0x1f, 0x2b, 0xf6, 0x27, 0x82, 0x9b, 0x7c,
+	0x05, 0x8b, 0x19, 0xb9, 0x83, 0xbe, 0x6d, 0x32, 0x11, 0xa0, 0xb4, 0xa5, 0x11, 0xbd, 0x60, 0x3e,
+	0x25, 0xfb, 0x73, 0x94, 0x80, 0x9e, 0x30, 0x07, 0x13, 0x7d, 0x73, 0x10, 0x52, 0x5b, 0xbb, 0x85,
+	0x7b, 0x4c, 0x7e, 0x91, 0x59, 0x18, 0x47, 0x7e, 0xad, 0x8d, 0xce, 0x29, 0x3e, 0xda, 0xff, 0x04,
+	0x50, 0xc1, 0xa3, 0x1d, 0x0f, 0xf2, 0x05, 0x28, 0x8b, 0x0c, 0xc0, 0xb1, 0xf1, 0x24, 0x1f, 0xd7,
+	0x27, 0xf1, 0xbb, 0x6b, 0x73, 0x54, 0x60, 0x7a, 0x07, 0x34, 0x3e, 0xb9, 0x27, 0xf1, 0xbb, 0x8b,
+	0x92, 0xfd, 0x13, 0x8f, 0x06, 0x78, 0x42, 0x57, 0x74, 0xf1, 0x41, 0x56, 0xb9, 0x9f, 0xf7, 0x5d,
+	0xc7, 0x12, 0x2e, 0x6e, 0x5a, 0x47, 0x86, 0x4b, 0x8f, 0xa9, 0x8b, 0x07, 0x6f, 0x51, 0x9f, 0x49,
+	0x20, 0xd7, 0xac, 0xa3, 0xa7, 0x1c, 0x45, 0xee, 0x01, 0x61, 0x81, 0xe9, 0x85, 0xfb, 0x34, 0x48,
+	0x30, 0x88, 0x43, 0xb6, 0xa1, 0x30, 0x49, 0xea, 0x90, 0xf9, 0x2e, 0xf5, 0x8c, 0xd0, 0xf1, 0x2c,
+	0x6a, 0x04, 0xd4, 0xa3, 0x27, 0x78, 0xe0, 0x8e, 0xeb, 0x0d, 0x81, 0xd9, 0xe1, 0x08, 0x9d, 0xc3,
+	0xc9, 0x1a, 0x54, 0x93, 0x76, 0x1e, 0xf5, 0x30, 0x85, 0x41, 0x6c, 0xda, 0xcf, 0x60, 0x56, 0x04,
+	0xd6, 0x48, 0x37, 0x21, 0xab, 0x3c, 0xa2, 0x2c, 0x11, 0x96, 0x95, 0xfe, 0x28, 0xf2, 0x09, 0xb4,
+	0x62, 0x47, 0xf5, 0x7c, 0xe6, 0xec, 0x2b, 0x83, 0xa9, 0x8c, 0xaa, 0x82, 0xb3, 0xbf, 0x1e, 0x51,
+	0x6d, 0x27, 0x88, 0xbe, 0x10, 0x34, 0xe4, 0x97, 0x05, 0x58, 0x54, 0x59, 0x5e, 0x8e, 0x01, 0x61,
+	0xa9, 0xb8, 0x5c, 0x5d, 0xfd, 0xb4, 0x33, 0xe2, 0x85, 0xaa, 0x13, 0x39, 0x44, 0x47, 0x66, 0x93,
+	0xbb, 0x19, 0xd3, 0x6f, 0x7a, 0x2c, 0x38, 0xd5, 0xe7, 0xad, 0x7c, 0x2c, 0xf9, 0xf3, 0x02, 0xcc,
+	0x47, 0xea, 0xa4, 0x0d, 0xa6, 0x55, 0x51, 0x97, 0xa7, 0xaf, 0xa0, 0x8b, 0xd3, 0xcb, 0x28, 0x22,
+	0xad, 0x3b, 0x6b, 0xe5, 0x10, 0x90, 0xbf, 0x28, 0xc0, 0x82, 0xd2, 0x25, 0xe9, 0x8f, 0x42, 0x9b,
+	0xda, 0xab, 0x5a, 0x46, 0x8f, 0x45, 0xe6, 0x58, 0x26, 0x8b, 0xe5, 0x96, 0x59, 0x48, 0x6a, 0x61,
+	0xbb, 0x5f, 0x27, 0x6c, 0x33, 0x85, 0xda, 0x6c, 0xbf, 0x84, 0x36, 0x89, 0x81, 0x9e, 0xb8, 0x5f,
+	0xa7, 0x97, 0x69, 0x2e, 0xc8, 0x45, 0x2e, 0x6e, 0xc1, 0xf5, 0xf3, 0x96, 0x97, 0x34, 0xa0, 0x78,
+	0x44, 0x4f, 0xe5, 0x85, 0x81, 0xff, 0xe4, 0x1b, 0xfd, 0xd8, 0x74, 0x07, 0x54, 0x06, 0x00, 0xf1,
+	0xf1, 0xe1, 0xd8, 0xfb, 0x85, 0x45, 0x0b, 0x16, 0xce, 0x5c, 0x9e, 0x1c, 0x41, 0xef, 0x24, 0x05,
+	0x9d, 0xbb, 0x73, 0x92, 0x83, 0xc4, 0x0a, 0xe7, 0x5a, 0xfd, 0x52, 0x0a, 0x77, 0xe1, 0xda, 0x39,
+	0x36, 0xbb, 0x8c, 0xa8, 0xf6, 0xdf, 0x94, 0x60, 0x26, 0x21, 0x8b, 0x27, 0x57, 0x18, 0x4c, 0xb3,
+	0x67, 0x50, 0x21, 0xf7, 0x0c, 0x52, 0x57, 0x41, 0x15, 0x57, 0x2b, 0x3a, 0x28, 0x50, 0xd7, 0x26,
+	0x57, 0x61, 0x22, 0x18, 0x78, 0x1c, 0x27, 0x63, 0x6b, 0x30, 0xf0, 0xba, 0x36, 0xd9, 0x00, 0xcc,
+	0xc4, 0xf0, 0x70, 0xc6, 0x78, 0x5a, 0x5f, 0x7d, 0x3d, 0xd7, 0x6b, 0xf0, 0xbe, 0xcb, 0x5d, 0x85,
+	0x6b, 0xc5, 0xcf, 0x69, 0xbd, 0xcc, 0xe4, 0xaf, 0xe4, 0xad, 0x6d, 0x3c, 0x7d, 0x6b, 0xbb, 0x0d,
+	0xf5, 0x7d, 0x27, 0x08, 0x99, 0xbc, 0xb1, 0x39, 0x36, 0x06, 0xd5, 0xa2, 0x5e, 0x43, 0x28, 0x5e,
+	0x4e, 0xba, 0x36, 0x69, 0xc3, 0x94, 0x47, 0xbf, 0x49, 0x10, 0x4d, 0x8a, 0x9b, 0x29, 0x07, 0x2a,
+	0x9a, 0x5b, 0x50, 0x8b, 0xaf, 0x5d, 0xf2, 0xfa, 0x51, 0xd4, 0xa3, 0x83, 0x97, 0x1f, 0x2c, 0x1d,
+	0x98, 0x11, 0x12, 0x42, 0xe6, 0x07, 0x34, 0x15, 0xf6, 0xc6, 0xf5, 0x26, 0xa2, 0x76, 0x38, 0x46,
+	0xc5, 0xba, 0xff, 0x07, 0xd7, 0x3c, 0x7a, 0x62, 0x70, 0xb3, 0xe4, 0xf1, 0x01, 0xf2, 0xcd, 0x7b,
+	0xf4, 0x44, 0x1f, 0x78, 0x9b, 0x43, 0xdc, 0xb7, 0xa0, 0xb6, 0x17, 0x98, 0x9e, 0x75, 0x68, 0x30,
+	0xff, 0x88, 0x7a, 0x78, 0xcb, 0xa8, 0xe9, 0x55, 0x01, 0xdb, 0xe5, 0x20, 0xb2, 0x02, 0xb3, 0x6a,
+	0x80, 0x14, 0xe9, 0x14, 0x92, 0x36, 0x85, 0xe4, 0xf5, 0x04, 0xc3, 0x3c, 0x4c, 0xe2, 0x6a, 0x44,
+	0x19, 0xf9, 0x04, 0xff, 0xec, 0xda, 0x5b, 0xa5, 0x72, 0xad, 0x31, 0xb5, 0x55, 0x2a, 0xd7, 0x1b,
+	0xd3, 0xed, 0xbf, 0x2f, 0xc1, 0xd4, 0xae, 0x4a, 0xbe, 0x7f, 0x2f, 0xfc, 0x63, 0x13, 0x6a, 0xf2,
+	0x86, 0x23, 0xe4, 0x8c, 0xa3, 0x9c, 0x76, 0x3a, 0xeb, 0x89, 0x05, 0x08, 0x52, 0x94, 0x51, 0x65,
+	0xf1, 0x07, 0xa1, 0x70, 0x35, 0x9a, 0x83, 0x4a, 0x4e, 0x51, 0xde, 0x04, 0xca, 0xbb, 0x7f, 0xbe,
+	0x5e, 0xcf, 0x25, 0xab, 0x4c, 0x5b, 0x51, 0xfc, 0xcc, 0xc9, 0x30, 0x30, 0xe9, 0xcd, 0x93, 0x69,
+	0x6f, 0xe6, 0x37, 0x15, 0x95, 0xe8, 0xa9, 0xbb, 0x4e, 0x59, 0xdc, 0x86, 0x14, 0x5c, 0x26, 0xe7,
+	0x3c, 0xc9, 0x89, 0xbc, 0x59, 0x9c, 0xbb, 0x93, 0x54, 0x7a, 0x72, 0x62, 0x91, 0x21, 0xb9, 0xc8,
+	0xa4, 0x0b, 0xd3, 0xc7, 0x4e, 0xe8, 0xec, 0x39, 0x2e, 0xbf, 0x62, 0x63, 0x3e, 0x50, 0x1d, 0x31,
+	0x1f, 0xa8, 0xc7, 0x8c, 0x1c, 0x15, 0xa7, 0x68, 0xb5, 0x64, 0x8a, 0xf6, 0x1f, 0x25, 0x68, 0xa8,
+	0x08, 0xfd, 0x7b, 0xe3, 0x3c, 0x1d, 0x98, 0x61, 0x66, 0x70, 0x40, 0x99, 0x91, 0x52, 0x73, 0x1c,
+	0x07, 0x6a, 0x0a, 0xd4, 0x76, 0x42, 0x59, 0x9e, 0xf9, 0x09, 0xfa, 0xa4, 0xce, 0x13, 0x48, 0xde,
+	0x10, 0x98, 0xe7, 0xb1, 0xe6, 0x6d, 0x98, 0x92, 0xd4, 0x72, 0x02, 0x93, 0x62, 0xfa, 0x02, 0xa8,
+	0xe3, 0x34, 0xd2, 0xf7, 0xd7, 0x72, 0xf6, 0xfe, 0xfa, 0x18, 0x16, 0xa5, 0x08, 0xeb, 0xd0, 0x71,
+	0xed, 0x78, 0x58, 0xdf, 0x73, 0x4f, 0x71, 0xf1, 0xcb, 0xfa, 0xbc, 0xa0, 0xd8, 0xe0, 0x04, 0x6a,
+	0xf4, 0x4f, 0x3d, 0xf7, 0x34, 0x7b, 0x77, 0x80, 0xa1, 0xbb, 0x43, 0xc2, 0x1b, 0xab, 0x69, 0x6f,
+	0x4c, 0xf8, 0x51, 0xed, 0x22, 0x3f, 0x9a, 0x7a, 0x49, 0x3f, 0x7a, 0x0b, 0x9a, 0x01, 0xb5, 0xfc,
+	0xc0, 0x36, 0x62, 0x84, 0x2c, 0x2c, 0x34, 0x04, 0xe2, 0x8b, 0x08, 0xde, 0x1e, 0x00, 0x91, 0x35,
+	0x28, 0x11, 0xd3, 0x74, 0x9e, 0xd5, 0x93, 0x6b, 0x50, 0x91, 0xc1, 0x2f, 0x72, 0xae, 0xb2, 0x00,
+	0x08, 0xf3, 0xef, 0xd1, 0x03, 0xc7, 0x33, 0x3c, 0xdf, 0x4e, 0x5c, 0x08, 0xaa, 0x08, 0xdc, 0xf6,
+	0x6d, 0x6e, 0x81, 0x16, 0x54, 0xa9, 0x67, 0x47, 0x14, 0x45, 0xa4, 0xa8, 0x50, 0xcf, 0x16, 0xf8,
+	0xf6, 0x5f, 0x15, 0x60, 0x2a, 0x35, 0x2e, 0x5a, 0x26, 0xa0, 0x09, 0x6f, 0x9e, 0xe0, 0x9f, 0x5d,
+	0x3b, 0xad, 0xcb, 0x58, 0x46, 0x97, 0x3f, 0x86, 0x0a, 0x2f, 0x7f, 0x70, 0x41, 0xa1, 0x56, 0xc4,
+	0x04, 0xea, 0xf1, 0xc8, 0x09, 0xd4, 0xf0, 0xc4, 0xf5, 0x58, 0x5a, 0xfb, 0x1f, 0x0b, 0x30, 0x2d,
+	0x29, 0x76, 0xb9, 0x26, 0x7c, 0xdf, 0x3d, 0x87, 0xaa, 0xd2, 0xc5, 0xdb, 0xf7, 0x51, 0xd1, 0xea,
+	0xea, 0xc3, 0x97, 0x1c, 0x10, 0xe4, 0x2c, 0xb8, 0xe0, 0x1f, 0x41, 0x65, 0xdf, 0x0f, 0x8e, 0xc4,
+	0xc2, 0x8f, 0x8d, 0xb8, 0xf0, 0x65, 0xce, 0x82, 0x4b, 0x4e, 0xa0, 0x84, 0x0a, 0x89, 0x9d, 0x8c,
+	0xbf, 0xdb, 0xff, 0x52, 0x80, 0x0a, 0x47, 0x06, 0x17, 0x14, 0x69, 0xd3, 0x25, 0xcd, 0xb1, 0x6c,
+	0x49, 0x73, 0x0d, 0xaa, 0x58, 0xaa, 0x90, 0x4e, 0x59, 0x1c, 0xf5, 0xe2, 0x24, 0x98, 0x54, 0x11,
+	0x32, 0x59, 0x8b, 0x12, 0x37, 0x40, 0x60, 0x71, 0x19, 0x6a, 0x01, 0xca, 0xe2, 0xa2, 0x10, 0xc5,
+	0x88, 0x49, 0xfc, 0xee, 0xda, 0xed, 0x7f, 0x28, 0x40, 0xed, 0x09, 0x35, 0x6d, 0xd7, 0xf1, 0xc4,
+	0x12, 0xac, 0x41, 0x29, 0x18, 0xb8, 0x54, 0xda, 0xfe, 0xed, 0x5c, 0xdb, 0x47, 0xbd, 0x80, 0xe3,
+	0xfb, 0x1d, 0xc5, 0xac, 0x0f, 0x5c, 0xaa, 0x23, 0x6b, 0x76, 0x4a, 0x63, 0x2f, 0x31, 0xa5, 0xd7,
+	0x60, 0x4a, 0x68, 0x6c, 0x05, 0xd4, 0xe4, 0x85, 0xbb, 0x22, 0xee, 0x2f, 0x3c, 0x32, 0x83, 0x0d,
+	0x01, 0x6b, 0xff, 0x72, 0x0c, 0xca, 0xff, 0x17, 0x21, 0x3b, 0x13, 0x8f, 0x4a, 0x43, 0xf1, 0x68,
+	0x0d, 0xaa, 0x42, 0x4f, 0x31, 0xe1, 0xf1, 0x51, 0x27, 0x2c, 0x98, 0x70, 0xc2, 0x19, 0x9b, 0x4d,
+	0x5c, 0xde, 0x66, 0xed, 0x10, 0x9a, 0x6b, 0xae, 0xeb, 0x5b, 0xdc, 0x36, 0x91, 0x59, 0x36, 0xa1,
+	0x64, 0x9b, 0xcc, 0x94, 0xcb, 0x79, 0x7f, 0xe4, 0xad, 0xa4, 0x04, 0xe8, 0xc8, 0x9e, 0x8c, 0xab,
+	0x63, 0xc9, 0xb8, 0xda, 0xfe, 0xcd, 0x18, 0x4c, 0xed, 0xaa, 0xb0, 0x3f, 0xea, 0x42, 0x10, 0x28,
+	0xf1, 0x4f, 0xb9, 0x02, 0xf8, 0x9b, 0xac, 0x25, 0xcf, 0xc5, 0x22, 0x9e, 0x8b, 0xb7, 0xcf, 0x4a,
+	0x86, 0xd4, 0x78, 0x99, 0x53, 0xf1, 0x7d, 0x28, 0x1d, 0x39, 0x9e, 0xad, 0x95, 0x46, 0xe3, 0xfe,
+	0x23, 0xc7, 0xb3, 0x75, 0xe4, 0xe0, 0x31, 0x30, 0x5b, 0x10, 0x29, 0x9b, 0xea, 0x8e, 0xfb, 0xea,
+	0x4b, 0x43, 0xb6, 0xa0, 0x81, 0x45, 0xa9, 0x97, 0x29, 0x91, 0xd4, 0x39, 0x67, 0x5c, 0x81, 0x6a,
+	0xff, 0x62, 0x0c, 0x60, 0xc7, 0x39, 0xf0, 0x4c, 0xf7, 0x82, 0xc0, 0xf3, 0x08, 0x34, 0x51, 0xe8,
+	0x65, 0x67, 0x76, 0x87, 0x22, 0x7c, 0xaa, 0x3b, 0x94, 0xee, 0x59, 0x14, 0xb3, 0x3d, 0x0b, 0xb5,
+	0x7a, 0xa5, 0xc4, 0xea, 0x3d, 0x84, 0x71, 0xc7, 0xeb, 0x0f, 0x98, 0x36, 0x3e, 0x62, 0xf1, 0x4e,
+	0x90, 0x73, 0xed, 0x2d, 0xdf, 0x63, 0x81, 0xef, 0xca, 0x6c, 0x44, 0x7d, 0x72, 0x37, 0x8a, 0xb5,
+	0x8f, 0xaf, 0x3f, 0x11, 0xac, 0x6b, 0xb7, 0xff, 0xae, 0x00, 0x4d, 0x59, 0x97, 0xdf, 0xc0, 0x22,
+	0xfd, 0x0f, 0x65, 0x90, 0xdc, 0xf6, 0x80, 0xb0, 0xcb, 0x50, 0x7b, 0x20, 0xab, 0x77, 0x69, 0x58,
+	0xef, 0xdf, 0x14, 0x60, 0x4e, 0x25, 0x3c, 0xa9, 0x76, 0x24, 0xc5, 0x91, 0x44, 0x24, 0x49, 0x8c,
+	0x54, 0x90, 0x23, 0x21, 0x22, 0x1e, 0x29, 0x8e, 0x56, 0x63, 0xc9, 0x68, 0xb5, 0x85, 0x69, 0x2e,
+	0x53, 0x9b, 0xe8, 0xdd, 0xd1, 0x6e, 0x00, 0x69, 0x3d, 0x74, 0x21, 0x82, 0x7c, 0x04, 0x13, 0x89,
+	0x43, 0xa5, 0xbe, 0xda, 0x39, 0x63, 0x4f, 0xe5, 0x4a, 0x19, 0x84, 0xba, 0xe4, 0x6e, 0xff, 0xfb,
+	0x75, 0xb8, 0x3a, 0x44, 0xf3, 0x3b, 0x0b, 0xdb, 0x1d, 0x98, 0xe9, 0x9b, 0x01, 0x5f, 0xce, 0x94,
+	0x28, 0xb1, 0x40, 0x4d, 0x81, 0xca, 0x64, 0xc3, 0x92, 0x3e, 0x29, 0x57, 0xb8, 0x73, 0x43, 0x60,
+	0xd2, 0xd9, 0xb0, 0xa4, 0x96, 0xd6, 0x16, 0x27, 0x68, 0x55, 0x00, 0x45, 0x36, 0x9c, 0x5d, 0xf4,
+	0x89, 0xa1, 0x45, 0x27, 0x1f, 0xc0, 0x82, 0xe5, 0xf7, 0xfa, 0x2e, 0xc5, 0xca, 0x54, 0xc6, 0xfb,
+	0x84, 0x73, 0xcf, 0xc5, 0x04, 0x29, 0xf7, 0x7b, 0x06, 0x8d, 0x2c, 0xab, 0x56, 0xbe, 0x4c, 0xe3,
+	0x73, 0x3a, 0x23, 0x38, 0x93, 0xbd, 0x57, 0xb2, 0xd9, 0xfb, 0x3d, 0x20, 0x91, 0x65, 0x78, 0x3c,
+	0x16, 0xbd, 0x6d, 0x10, 0x06, 0x52, 0x18, 0x1e, 0x72, 0xb1, 0xc1, 0xfd, 0x15, 0x2c, 0x46, 0xd4,
+	0x54, 0x2d, 0xee, 0x65, 0x1b, 0x8d, 0xda, 0x49, 0xd6, 0x3d, 0x54, 0x1b, 0xef, 0x33, 0x98, 0x8d,
+	0xc4, 0x07, 0x83, 0x58, 0xf0, 0x88, 0x8d, 0xc6, 0x68, 0x26, 0xfa, 0x20, 0x12, 0xb9, 0x07, 0x37,
+	0x6c, 0xba, 0x6f, 0x0e, 0xdc, 0x84, 0x07, 0x88, 0xc3, 0xe7, 0x72, 0x3d, 0xc7, 0x45, 0x29, 0x45,
+	0x79, 0x0b, 0xde, 0xd4, 0xe4, 0x18, 0xaf, 0xc9, 0x56, 0x75, 0x54, 0x3a, 0xa9, 0x8b, 0x22, 0x0f,
+	0x02, 0x55, 0xbd, 0xe4, 0x2d, 0x20, 0x78, 0x2e, 0x08, 0x77, 0x50, 0x27, 0x6c, 0x53, 0x34, 0x1e,
+	0x39, 0x06, 0x97, 0x6b, 0x57, 0x5c, 0x61, 0xde, 0x86, 0x19, 0x24, 0xce, 0x14, 0x8f, 0x88, 0xa8,
+	0xdf, 0x73, 0xd4, 0x47, 0xc9, 0x02, 0xd2, 0x3b, 0x80, 0x0d, 0x12, 0xa3, 0x1f, 0xf8, 0x16, 0x0d,
+	0xc3, 0xa8, 0x65, 0x3e, 0x83, 0xf4, 0x38, 0xee, 0x33, 0x85, 0x12, 0x5e, 0xf1, 0x07, 0x32, 0x53,
+	0x15, 0xe7, 0xd3, 0xec, 0x88, 0xe7, 0x93, 0xc8, 0x65, 0xcf, 0x3c, 0xe6, 0xae, 0xbe, 0xdc, 0x31,
+	0xc7, 0x1b, 0x1c, 0xe9, 0xb5, 0x51, 0x76, 0x9c, 0x13, 0x0d, 0x8e, 0x93, 0x84, 0xcd, 0x95, 0x39,
+	0x3f, 0x80, 0x85, 0x34, 0x4f, 0x32, 0x6d, 0x9b, 0x17, 0x7b, 0x2c, 0xc9, 0xb7, 0x13, 0xa7, 0x70,
+	0x8f, 0x40, 0xcb, 0xb0, 0xc6, 0x39, 0xbb, 0x26, 0xce, 0x86, 0x14, 0x67, 0x94, 0xbf, 0xef, 0x64,
+	0xf5, 0x54, 0x3e, 0xb4, 0x30, 0x62, 0x23, 0xfc, 0x24, 0xc7, 0x79, 0x86, 0x26, 0xaf, 0x2a, 0x2b,
+	0x8b, 0x58, 0xba, 0x48, 0xf1, 0xa8, 0xea, 0x4a, 0x72, 0x1b, 0xa6, 0x66, 0x80, 0xcb, 0x70, 0x6d,
+	0xd4, 0xc6, 0x57, 0xce, 0x2c, 0x71, 0x3d, 0x4c, 0xb8, 0x9e, 0x6f, 0x5b, 0x39, 0xc0, 0xf5, 0x11,
+	0x07, 0x58, 0xc8, 0x5b, 0x00, 0x31, 0x44, 0x5e, 0xc3, 0xfe, 0x46, 0x7e, 0xc3, 0x3e, 0x80, 0x3b,
+	0x69, 0x6d, 0xfc, 0xc0, 0x39, 0x70, 0x3c, 0xd3, 0xcd, 0xaa, 0xd5, 0x1a, 0x51, 0xad, 0x5b, 0x49,
+	0xb5, 0x3e, 0x95, 0xc2, 0xd2, 0xea, 0x0d, 0xb9, 0x48, 0xe2, 0x88, 0xbe, 0x89, 0xb1, 0x31, 0xe5,
+	0x22, 0xa9, 0x17, 0x03, 0xc3, 0xe9, 0xc3, 0x52, 0x7e, 0xfa, 0xf0, 0x26, 0x34, 0x43, 0xe6, 0x58,
+	0x47, 0xa7, 0x46, 0x22, 0x40, 0xdf, 0x52, 0x9d, 0x7f, 0x8e, 0x88, 0xf2, 0x57, 0x72, 0x00, 0x4b,
+	0x92, 0xf6, 0xec, 0x37, 0x24, 0xed, 0xd1, 0xbc, 0xf0, 0xba, 0x10, 0xb4, 0x93, 0xff, 0x92, 0x24,
+	0xf1, 0x8c, 0xe1, 0xb5, 0xf4, 0x33, 0x86, 0xb3, 0x9f, 0x14, 0xdc, 0xfe, 0x61, 0x9e, 0x14, 0xdc,
+	0xf9, 0x61, 0x9e, 0x14, 0xbc, 0x7e, 0xce, 0x93, 0x82, 0x73, 0x9b, 0xff, 0x77, 0xcf, 0x6f, 0xfe,
+	0x9f, 0xf9, 0x1c, 0x61, 0xf9, 0x55, 0x9e, 0x23, 0x8c, 0xf0, 0xa4, 0xe0, 0x8d, 0x8b, 0x9f, 0x14,
+	0xe4, 0x3d, 0x1c, 0x79, 0x33, 0xf7, 0xe1, 0xc8, 0x6b, 0x30, 0x65, 0x05, 0xbe, 0x17, 0xb9, 0x99,
+	0xf6, 0x16, 0x3a, 0x64, 0x8d, 0x03, 0x95, 0xcb, 0x9c, 0xd5, 0x69, 0xb8, 0x77, 0x56, 0xa7, 0xe1,
+	0x1e, 0x10, 0x99, 0x05, 0x25, 0xdb, 0x00, 0x6f, 0x63, 0x1b, 0xa0, 0x81, 0x98, 0x64, 0x17, 0x80,
+	0xb7, 0x3a, 0xf0, 0xd2, 0x23, 0x9f, 0xcf, 0x75, 0x64, 0xab, 0x03, 0x61, 0xf8, 0x70, 0x8e, 0xdc,
+	0xc9, 0x3c, 0xe5, 0x5b, 0xe1, 0x24, 0xeb, 0x63, 0x5a, 0x21, 0xf5, 0x9c, 0x8f, 0x7c, 0x06, 0x4d,
+	0x73, 0xc0, 0x7c, 0x23, 0xa0, 0x21, 0x65, 0x46, 0xdf, 0x77, 0x3c, 0x16, 0x6a, 0x0f, 0xf2, 0xd2,
+	0xa9, 0x64, 0x99, 0x43, 0xe7, 0xd4, 0xcf, 0x90, 0x58, 0x9f, 0xe6, 0xfc, 0x09, 0x00, 0xf9, 0xd3,
+	0x02, 0x34, 0x43, 0x6a, 0x06, 0xd6, 0x21, 0xf7, 0xa8, 0xc0, 0xd9, 0x1b, 0x30, 0x1a, 0x6a, 0xef,
+	0x62, 0xb9, 0x6c, 0x77, 0xe4, 0x2b, 0x77, 0x6e, 0x82, 0xdc, 0xd9, 0x41, 0xb9, 0x6b, 0x91, 0x58,
+	0xd1, 0x75, 0x6c, 0x84, 0x19, 0x30, 0xf9, 0x13, 0x28, 0xf5, 0x68, 0xcf, 0xd7, 0xde, 0xc3, 0x51,
+	0x3f, 0x7e, 0xc5, 0x51, 0x3f, 0xa1, 0x3d, 0x5f, 0x8c, 0x84, 0x52, 0xc9, 0x57, 0xd0, 0x94, 0x0b,
+	0x6a, 0x08, 0x5b, 0x3a, 0x34, 0xd4, 0x1e, 0xa2, 0xd1, 0xde, 0xc9, 0x1d, 0x2a, 0x91, 0x8a, 0xca,
+	0x05, 0xff, 0x58, 0xf1, 0xe9, 0x8d, 0xe3, 0x0c, 0x84, 0x3c, 0x80, 0x39, 0x99, 0xd5, 0x44, 0xf9,
+	0xa3, 0x4c, 0xb6, 0x1f, 0xa1, 0xa7, 0xcd, 0x20, 0x36, 0x52, 0x51, 0x24, 0xdd, 0x3f, 0x85, 0xe9,
+	0x98, 0x3c, 0x64, 0x26, 0x0b, 0xb5, 0xf7, 0x51, 0xa3, 0x47, 0x23, 0x4f, 0x3e, 0xfd, 0x18, 0x54,
+	0xaf, 0xd3, 0xd4, 0x77, 0xe2, 0xb1, 0xc7, 0x07, 0xa9, 0xc7, 0x1e, 0x3b, 0x50, 0xb1, 0x65, 0xd9,
+	0x2b, 0xd4, 0x3e, 0x44, 0x83, 0xbf, 0x37, 0xf2, 0x98, 0xc9, 0x6a, 0x9b, 0x1e, 0xcb, 0x21, 0xbb,
+	0x50, 0x57, 0x1f, 0x06, 0x2f, 0xa3, 0x85, 0xda, 0xe3, 0xa5, 0xe2, 0xe5, 0x4b, 0x70, 0x53, 0x76,
+	0xe2, 0x2b, 0x5c, 0xb4, 0xe1, 0x6a, 0xae, 0x07, 0xe5, 0xf4, 0x60, 0xdf, 0x4b, 0xb7, 0x8d, 0x6f,
+	0x5e, 0x70, 0x87, 0x4f, 0xf6, 0x7b, 0x7f, 0x02, 0x95, 0xc8, 0x63, 0x7e, 0xa7, 0x92, 0xb7, 0x4a,
+	0xe5, 0xe9, 0x46, 0x63, 0xab, 0x54, 0x6e, 0x34, 0x9a, 0x5b, 0xa5, 0xf2, 0x3b, 0x8d, 0xfb, 0x5b,
+	0xa5, 0xf2, 0xfd, 0xc6, 0xea, 0x56, 0xa9, 0xbc, 0xda, 0x78, 0xd0, 0xfe, 0x79, 0x01, 0xca, 0x1b,
+	0x87, 0xd4, 0x3a, 0x0a, 0x07, 0xbd, 0xec, 0xc5, 0x7f, 0x3c, 0xbe, 0xf8, 0x3f, 0x81, 0x89, 0x7d,
+	0xd7, 0x3c, 0xf6, 0x03, 0x54, 0xa0, 0xbe, 0x7a, 0xef, 0xfc, 0x3b, 0xb1, 0x92, 0xf8, 0x11, 0xf2,
+	0xe8, 0x92, 0x37, 0xee, 0x51, 0x17, 0x31, 0x46, 0x89, 0x8f, 0xf6, 0xff, 0x94, 0x80, 0x60, 0x0b,
+	0x23, 0x7d, 0xaf, 0xfd, 0x61, 0xca, 0x32, 0x89, 0xa4, 0xb4, 0x98, 0x2d, 0x24, 0x6f, 0xc3, 0x74,
+	0x46, 0xae, 0x56, 0xca, 0x8b, 0x6a, 0x67, 0xbe, 0xe9, 0x4d, 0x8f, 0xca, 0xe3, 0xb9, 0x1a, 0x2e,
+	0x79, 0x4d, 0x96, 0x3d, 0x26, 0x89, 0x4a, 0xdc, 0x93, 0x6f, 0x43, 0x5d, 0xd1, 0xcb, 0xbd, 0x2b,
+	0x2a, 0x3a, 0xea, 0x95, 0xad, 0x2e, 0xab, 0x13, 0x99, 0x17, 0xbc, 0x93, 0x2f, 0xff, 0x82, 0x37,
+	0xb7, 0x58, 0x52, 0xce, 0x2f, 0x96, 0x5c, 0x87, 0x4a, 0x54, 0x1c, 0x50, 0x17, 0xde, 0x08, 0x70,
+	0xc9, 0x0b, 0xef, 0x4f, 0xa2, 0x7a, 0x83, 0x78, 0xfa, 0x2a, 0xcf, 0xce, 0x2a, 0xfa, 0xd6, 0xf2,
+	0x19, 0x25, 0x92, 0x67, 0xc8, 0x81, 0xcf, 0x5d, 0xc5, 0xa9, 0xaa, 0x2a, 0x13, 0x09, 0xd0, 0x50,
+	0x1d, 0xa1, 0x36, 0x5c, 0x3c, 0xfa, 0x45, 0x09, 0xa6, 0xa3, 0x62, 0x86, 0x78, 0xf4, 0x46, 0xb6,
+	0x64, 0x7b, 0xe2, 0xb2, 0xfd, 0x92, 0xb8, 0x28, 0x82, 0x95, 0x5e, 0x2e, 0x83, 0x3c, 0x83, 0x09,
+	0xcb, 0xf7, 0xf6, 0x9d, 0x03, 0xb9, 0x59, 0xdf, 0xbf, 0xbc, 0xb4, 0x0d, 0xe4, 0xd7, 0xa5, 0x1c,
+	0x12, 0xf0, 0xa7, 0x8b, 0xf1, 0xa3, 0x1c, 0x29, 0x5d, 0x34, 0x3a, 0x36, 0x2e, 0x2f, 0x3d, 0xf1,
+	0x18, 0x44, 0x0e, 0xd4, 0x0c, 0xb2, 0x20, 0x72, 0x07, 0xea, 0x62, 0x9c, 0x28, 0x0f, 0x11, 0x75,
+	0xb8, 0x29, 0x01, 0x55, 0x39, 0xc8, 0x3a, 0xdc, 0xd8, 0x37, 0x1d, 0xd7, 0x3f, 0xa6, 0x41, 0xfe,
+	0xf3, 0x30, 0x51, 0x0b, 0xbe, 0xa6, 0x88, 0xf2, 0x5e, 0x87, 0xbd, 0x01, 0x8d, 0x48, 0x86, 0x62,
+	0x13, 0xf5, 0x9f, 0x69, 0x05, 0x57, 0xa4, 0x4f, 0xa1, 0x19, 0x91, 0xf2, 0xf6, 0xdd, 0xa5, 0xea,
+	0xc0, 0x91, 0xb4, 0x4d, 0x0f, 0xef, 0x23, 0xed, 0x3f, 0x2b, 0xc2, 0x54, 0x6a, 0x05, 0x49, 0x1d,
+	0xc6, 0xa2, 0x12, 0xda, 0x98, 0x63, 0x93, 0xc7, 0xaa, 0x14, 0x28, 0xc2, 0xde, 0x9d, 0x33, 0x5c,
+	0x33, 0x12, 0x92, 0xaa, 0xfd, 0xa9, 0x32, 0x6f, 0x31, 0x51, 0xe6, 0x5d, 0x82, 0xaa, 0x4d, 0x43,
+	0x2b, 0x70, 0xfa, 0x4c, 0xd9, 0xb4, 0xa2, 0x27, 0x41, 0xf1, 0x6b, 0xc5, 0xf1, 0xe4, 0x6b, 0xc5,
+	0x5d, 0xd9, 0x85, 0x98, 0xc0, 0x13, 0xed, 0xc7, 0x2f, 0xe7, 0xa0, 0x9d, 0x27, 0x26, 0x33, 0x65,
+	0x52, 0xc2, 0xa5, 0x91, 0x2d, 0x98, 0x34, 0x5d, 0xc7, 0x0c, 0x69, 0xa8, 0x4d, 0x2e, 0x15, 0xcf,
+	0x4c, 0x45, 0xe2, 0x7f, 0xf6, 0x24, 0x25, 0xae, 0x71, 0x4e, 0x5d, 0x09, 0x58, 0x7c, 0x04, 0x95,
+	0x48, 0xfc, 0x45, 0xef, 0x93, 0x2a, 0xc9, 0xf7, 0x49, 0x87, 0xb0, 0x78, 0xb6, 0x6b, 0xf2, 0x20,
+	0x8a, 0x7f, 0x06, 0xa0, 0x46, 0xce, 0xdf, 0x44, 0x9a, 0x02, 0xb5, 0x91, 0xf8, 0xb3, 0xc8, 0x22,
+	0x94, 0x25, 0x61, 0xa8, 0x8d, 0x61, 0x0a, 0x1f, 0x7d, 0xb7, 0xff, 0x39, 0xb9, 0xf3, 0xa5, 0xfc,
+	0x1f, 0x41, 0x25, 0xa0, 0x8c, 0x7a, 0x4c, 0x1d, 0x34, 0x23, 0xdc, 0x8d, 0x62, 0x0e, 0x72, 0x17,
+	0xa6, 0x79, 0x6e, 0xe0, 0x1c, 0x9b, 0xae, 0xb1, 0x37, 0xb0, 0x8e, 0x28, 0x93, 0x13, 0xac, 0x2b,
+	0xf0, 0x3a, 0x42, 0x49, 0x17, 0x6a, 0x7b, 0xa6, 0x6d, 0xec, 0x39, 0x9e, 0x89, 0xa9, 0x9f, 0xd8,
+	0xbd, 0xaf, 0xa7, 0x1d, 0x2a, 0x65, 0xe8, 0x75, 0xd3, 0x5e, 0x97, 0xd4, 0x7a, 0x75, 0x2f, 0xfe,
+	0x20, 0x5f, 0xc2, 0x9c, 0x4a, 0xd3, 0xa3, 0xb1, 0x85, 0x97, 0x9e, 0xdf, 0xb7, 0x59, 0x93, 0xc4,
+	0xc2, 0x49, 0x67, 0xa5, 0x8c, 0x14, 0x94, 0xd7, 0xbc, 0x86, 0x64, 0x0f, 0x02, 0x47, 0x3a, 0x23,
+	0xc9, 0xf0, 0x7c, 0x1e, 0x38, 0xe4, 0xa7, 0xb0, 0x90, 0x78, 0x17, 0x90, 0x51, 0x68, 0xe2, 0x12,
+	0x0a, 0xcd, 0xc7, 0x62, 0xd2, 0x3a, 0x3d, 0x84, 0xf9, 0xbc, 0x11, 0xb8, 0x5a, 0xe2, 0x5d, 0xc5,
+	0xd5, 0x61, 0x4e, 0xae, 0xd9, 0x70, 0x3e, 0x58, 0x7e, 0xf5, 0x7c, 0xb0, 0xfd, 0xd7, 0x85, 0xd4,
+	0x73, 0x3a, 0x19, 0x99, 0x42, 0xf2, 0xe3, 0x6c, 0xb9, 0x52, 0x38, 0xd3, 0xb5, 0x21, 0x67, 0xea,
+	0x7a, 0xec, 0xe1, 0xbb, 0x5f, 0x70, 0xf7, 0xcf, 0xd4, 0x32, 0xbb, 0xb2, 0x96, 0x79, 0x12, 0x38,
+	0x2c, 0xbe, 0xfe, 0x8d, 0x5d, 0x2c, 0x06, 0x6b, 0x86, 0xcf, 0x39, 0x97, 0x14, 0xb5, 0xce, 0xbe,
+	0xfd, 0xae, 0x75, 0xe5, 0xd7, 0xdf, 0xb5, 0xae, 0xfc, 0xf6, 0xbb, 0x56, 0xe1, 0xe7, 0x2f, 0x5a,
+	0x85, 0xbf, 0x7d, 0xd1, 0x2a, 0xfc, 0xeb, 0x8b, 0x56, 0xe1, 0xdb, 0x17, 0xad, 0xc2, 0x7f, 0xbd,
+	0x68, 0x15, 0xfe, 0xfb, 0x45, 0xeb, 0xca, 0x6f, 0x5f, 0xb4, 0x0a, 0xbf, 0xfa, 0xbe, 0x75, 0xe5,
+	0xdb, 0xef, 0x5b, 0x57, 0x7e, 0xfd, 0x7d, 0xeb, 0xca, 0x97, 0xff, 0xff, 0xc0, 0x8f, 0x4d, 0xe3,
+	0xf8, 0x17, 0xfc, 0x1d, 0xf2, 0x71, 0x16, 0xb6, 0x37, 0x81, 0xca, 0x3d, 0xf8, 0xdf, 0x01, 0x00,
+	0xf3, 0x04, 0x30, 0xa9, 0x51, 0x39, 0x00, 0x00,
 }
 
 func (this *ExecutionStats) Equal(that interface{}) bool {
@@ -3236,6 +3373,12 @@ func (this *ActivityInfo) Equal(that interface{}) bool {
 	} else if !this.LastHeartbeatUpdateTime.Equal(*that1.LastHeartbeatUpdateTime) {
 		return false
 	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if this.Stamp != that1.Stamp {
+		return false
+	}
 	return true
 }
 func (this *ShardInfo) Equal(that interface{}) bool {
@@ -3442,6 +3585,9 @@ func (this *TimerTaskInfo) Equal(that interface{}) bool {
 	} else if !this.VisibilityTime.Equal(*that1.VisibilityTime) {
 		return false
 	}
+	if this.Stamp != that1.Stamp {
+		return false
+	}
 	return true
 }
 func (this *TransferTaskInfo) Equal(that interface{}) bool {
@@ -3650,6 +3796,40 @@ func (this *TimerInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DeadlineInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeadlineInfo)
+	if !ok {
+		that2, ok := that.(DeadlineInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Rule.Equal(that1.Rule) {
+		return false
+	}
+	if that1.ExpiryTime == nil {
+		if this.ExpiryTime != nil {
+			return false
+		}
+	} else if !this.ExpiryTime.Equal(*that1.ExpiryTime) {
+		return false
+	}
+	if this.TimerCreated != that1.TimerCreated {
+		return false
+	}
+	return true
+}
 func (this *TaskInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -4135,6 +4315,25 @@ func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
 	if !this.ExecutionStats.Equal(that1.ExecutionStats) {
 		return false
 	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if len(this.Deadlines) != len(that1.Deadlines) {
+		return false
+	}
+	for i := range this.Deadlines {
+		if !this.Deadlines[i].Equal(that1.Deadlines[i]) {
+			return false
+		}
+	}
+	if len(this.DeadlineRules) != len(that1.DeadlineRules) {
+		return false
+	}
+	for i := range this.DeadlineRules {
+		if !this.DeadlineRules[i].Equal(that1.DeadlineRules[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Checksum) Equal(that interface{}) bool {
@@ -4312,6 +4511,14 @@ func (this *NamespaceInfo) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.Aliases) != len(that1.Aliases) {
+		return false
+	}
+	for i := range this.Aliases {
+		if !this.Aliases[i].Equal(that1.Aliases[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *NamespaceReplicationConfig) Equal(that interface{}) bool {
@@ -4392,6 +4599,14 @@ func (this *NamespaceConfig) Equal(that interface{}) bool {
 	if this.VisibilityArchivalUri != that1.VisibilityArchivalUri {
 		return false
 	}
+	if len(this.DeadlineRules) != len(that1.DeadlineRules) {
+		return false
+	}
+	for i := range this.DeadlineRules {
+		if !this.DeadlineRules[i].Equal(that1.DeadlineRules[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ReplicationVersions) Equal(that interface{}) bool {
@@ -4446,7 +4661,7 @@ func (this *ActivityInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 36)
+	s := make([]string, 0, 38)
 	s = append(s, "&persistenceblobs.ActivityInfo{")
 	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	s = append(s, "ScheduledEventBatchId: "+fmt.Sprintf("%#v", this.ScheduledEventBatchId)+",\n")
@@ -4488,6 +4703,8 @@ func (this *ActivityInfo) GoString() string {
 		s = append(s, "LastHeartbeatDetails: "+fmt.Sprintf("%#v", this.LastHeartbeatDetails)+",\n")
 	}
 	s = append(s, "LastHeartbeatUpdateTime: "+fmt.Sprintf("%#v", this.LastHeartbeatUpdateTime)+",\n")
+	s = append(s, "Paused: "+fmt.Sprintf("%#v", this.Paused)+",\n")
+	s = append(s, "Stamp: "+fmt.Sprintf("%#v", this.Stamp)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4587,7 +4804,7 @@ func (this *TimerTaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 15)
+	s := make([]string, 0, 16)
 	s = append(s, "&persistenceblobs.TimerTaskInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -4600,6 +4817,7 @@ func (this *TimerTaskInfo) GoString() string {
 	s = append(s, "EventId: "+fmt.Sprintf("%#v", this.EventId)+",\n")
 	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
 	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
+	s = append(s, "Stamp: "+fmt.Sprintf("%#v", this.Stamp)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4680,6 +4898,20 @@ func (this *TimerInfo) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *DeadlineInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&persistenceblobs.DeadlineInfo{")
+	if this.Rule != nil {
+		s = append(s, "Rule: "+fmt.Sprintf("%#v", this.Rule)+",\n")
+	}
+	s = append(s, "ExpiryTime: "+fmt.Sprintf("%#v", this.ExpiryTime)+",\n")
+	s = append(s, "TimerCreated: "+fmt.Sprintf("%#v", this.TimerCreated)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *TaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -4772,7 +5004,7 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 55)
+	s := make([]string, 0, 58)
 	s = append(s, "&persistenceblobs.WorkflowExecutionInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -4857,6 +5089,13 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	if this.ExecutionStats != nil {
 		s = append(s, "ExecutionStats: "+fmt.Sprintf("%#v", this.ExecutionStats)+",\n")
 	}
+	s = append(s, "Paused: "+fmt.Sprintf("%#v", this.Paused)+",\n")
+	if this.Deadlines != nil {
+		s = append(s, "Deadlines: "+fmt.Sprintf("%#v", this.Deadlines)+",\n")
+	}
+	if this.DeadlineRules != nil {
+		s = append(s, "DeadlineRules: "+fmt.Sprintf("%#v", this.DeadlineRules)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4923,7 +5162,7 @@ func (this *NamespaceInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 11)
 	s = append(s, "&persistenceblobs.NamespaceInfo{")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
@@ -4943,6 +5182,9 @@ func (this *NamespaceInfo) GoString() string {
 	if this.Data != nil {
 		s = append(s, "Data: "+mapStringForData+",\n")
 	}
+	if this.Aliases != nil {
+		s = append(s, "Aliases: "+fmt.Sprintf("%#v", this.Aliases)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4961,7 +5203,7 @@ func (this *NamespaceConfig) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&persistenceblobs.NamespaceConfig{")
 	s = append(s, "Retention: "+fmt.Sprintf("%#v", this.Retention)+",\n")
 	s = append(s, "ArchivalBucket: "+fmt.Sprintf("%#v", this.ArchivalBucket)+",\n")
@@ -4972,6 +5214,9 @@ func (this *NamespaceConfig) GoString() string {
 	s = append(s, "HistoryArchivalUri: "+fmt.Sprintf("%#v", this.HistoryArchivalUri)+",\n")
 	s = append(s, "VisibilityArchivalState: "+fmt.Sprintf("%#v", this.VisibilityArchivalState)+",\n")
 	s = append(s, "VisibilityArchivalUri: "+fmt.Sprintf("%#v", this.VisibilityArchivalUri)+",\n")
+	if this.DeadlineRules != nil {
+		s = append(s, "DeadlineRules: "+fmt.Sprintf("%#v", this.DeadlineRules)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -5081,6 +5326,25 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Stamp != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Stamp))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.LastHeartbeatUpdateTime != nil {
 		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
 		if err1 != nil {
@@ -5656,6 +5920,11 @@ func (m *TimerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Stamp != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Stamp))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.VisibilityTime != nil {
 		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
 		if err18 != nil {
@@ -6045,7 +6314,7 @@ func (m *TimerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TaskInfo) Marshal() (dAtA []byte, err error) {
+func (m *DeadlineInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6055,16 +6324,26 @@ func (m *TaskInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TaskInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeadlineInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeadlineInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.TimerCreated {
+		i--
+		if m.TimerCreated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ExpiryTime != nil {
 		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
 		if err23 != nil {
@@ -6073,15 +6352,60 @@ func (m *TaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= n23
 		i = encodeVarintMessage(dAtA, i, uint64(n23))
 		i--
+		dAtA[i] = 0x12
+	}
+	if m.Rule != nil {
+		{
+			size, err := m.Rule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTime != nil {
+		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintMessage(dAtA, i, uint64(n25))
+		i--
 		dAtA[i] = 0x32
 	}
 	if m.CreateTime != nil {
-		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateTime):])
-		if err24 != nil {
-			return 0, err24
+		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateTime):])
+		if err26 != nil {
+			return 0, err26
 		}
-		i -= n24
-		i = encodeVarintMessage(dAtA, i, uint64(n24))
+		i -= n26
+		i = encodeVarintMessage(dAtA, i, uint64(n26))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -6175,22 +6499,22 @@ func (m *TaskQueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.LastUpdateTime != nil {
-		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
-		if err26 != nil {
-			return 0, err26
+		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err28 != nil {
+			return 0, err28
 		}
-		i -= n26
-		i = encodeVarintMessage(dAtA, i, uint64(n26))
+		i -= n28
+		i = encodeVarintMessage(dAtA, i, uint64(n28))
 		i--
 		dAtA[i] = 0x3a
 	}
 	if m.ExpiryTime != nil {
-		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
-		if err27 != nil {
-			return 0, err27
+		n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err29 != nil {
+			return 0, err29
 		}
-		i -= n27
-		i = encodeVarintMessage(dAtA, i, uint64(n27))
+		i -= n29
+		i = encodeVarintMessage(dAtA, i, uint64(n29))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -6409,8 +6733,52 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.ExecutionStats != nil {
-		{
+	if len(m.DeadlineRules) > 0 {
+		for iNdEx := len(m.DeadlineRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeadlineRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.Deadlines) > 0 {
+		for iNdEx := len(m.Deadlines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deadlines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.ExecutionStats != nil {
+		{
 			size, err := m.ExecutionStats.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
@@ -6579,12 +6947,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.RetryExpirationTime != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
-		if err34 != nil {
-			return 0, err34
+		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
+		if err36 != nil {
+			return 0, err36
 		}
-		i -= n34
-		i = encodeVarintMessage(dAtA, i, uint64(n34))
+		i -= n36
+		i = encodeVarintMessage(dAtA, i, uint64(n36))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -6606,24 +6974,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.RetryMaximumInterval != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err35 != nil {
-			return 0, err35
+		n37, err37 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err37 != nil {
+			return 0, err37
 		}
-		i -= n35
-		i = encodeVarintMessage(dAtA, i, uint64(n35))
+		i -= n37
+		i = encodeVarintMessage(dAtA, i, uint64(n37))
 		i--
 		dAtA[i] = 0x2
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.RetryInitialInterval != nil {
-		n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err36 != nil {
-			return 0, err36
+		n38, err38 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err38 != nil {
+			return 0, err38
 		}
-		i -= n36
-		i = encodeVarintMessage(dAtA, i, uint64(n36))
+		i -= n38
+		i = encodeVarintMessage(dAtA, i, uint64(n38))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -6637,12 +7005,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x98
 	}
 	if m.StickyScheduleToStartTimeout != nil {
-		n37, err37 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyScheduleToStartTimeout):])
-		if err37 != nil {
-			return 0, err37
+		n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyScheduleToStartTimeout):])
+		if err39 != nil {
+			return 0, err39
 		}
-		i -= n37
-		i = encodeVarintMessage(dAtA, i, uint64(n37))
+		i -= n39
+		i = encodeVarintMessage(dAtA, i, uint64(n39))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -6676,12 +7044,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xfa
 	}
 	if m.WorkflowTaskOriginalScheduledTime != nil {
-		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskOriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskOriginalScheduledTime):])
-		if err38 != nil {
-			return 0, err38
+		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskOriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskOriginalScheduledTime):])
+		if err40 != nil {
+			return 0, err40
 		}
-		i -= n38
-		i = encodeVarintMessage(dAtA, i, uint64(n38))
+		i -= n40
+		i = encodeVarintMessage(dAtA, i, uint64(n40))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -6700,24 +7068,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xe8
 	}
 	if m.WorkflowTaskScheduledTime != nil {
-		n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskScheduledTime):])
-		if err39 != nil {
-			return 0, err39
+		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskScheduledTime):])
+		if err41 != nil {
+			return 0, err41
 		}
-		i -= n39
-		i = encodeVarintMessage(dAtA, i, uint64(n39))
+		i -= n41
+		i = encodeVarintMessage(dAtA, i, uint64(n41))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xe2
 	}
 	if m.WorkflowTaskStartedTime != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskStartedTime):])
-		if err40 != nil {
-			return 0, err40
+		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskStartedTime):])
+		if err42 != nil {
+			return 0, err42
 		}
-		i -= n40
-		i = encodeVarintMessage(dAtA, i, uint64(n40))
+		i -= n42
+		i = encodeVarintMessage(dAtA, i, uint64(n42))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -6731,12 +7099,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xd0
 	}
 	if m.WorkflowTaskTimeout != nil {
-		n41, err41 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
-		if err41 != nil {
-			return 0, err41
+		n43, err43 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
+		if err43 != nil {
+			return 0, err43
 		}
-		i -= n41
-		i = encodeVarintMessage(dAtA, i, uint64(n41))
+		i -= n43
+		i = encodeVarintMessage(dAtA, i, uint64(n43))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -6764,24 +7132,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.LastUpdateTime != nil {
-		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
-		if err42 != nil {
-			return 0, err42
+		n44, err44 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err44 != nil {
+			return 0, err44
 		}
-		i -= n42
-		i = encodeVarintMessage(dAtA, i, uint64(n42))
+		i -= n44
+		i = encodeVarintMessage(dAtA, i, uint64(n44))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.StartTime != nil {
-		n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
-		if err43 != nil {
-			return 0, err43
+		n45, err45 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err45 != nil {
+			return 0, err45
 		}
-		i -= n43
-		i = encodeVarintMessage(dAtA, i, uint64(n43))
+		i -= n45
+		i = encodeVarintMessage(dAtA, i, uint64(n45))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -6814,32 +7182,32 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.DefaultWorkflowTaskTimeout != nil {
-		n44, err44 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DefaultWorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DefaultWorkflowTaskTimeout):])
-		if err44 != nil {
-			return 0, err44
+		n46, err46 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DefaultWorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DefaultWorkflowTaskTimeout):])
+		if err46 != nil {
+			return 0, err46
 		}
-		i -= n44
-		i = encodeVarintMessage(dAtA, i, uint64(n44))
+		i -= n46
+		i = encodeVarintMessage(dAtA, i, uint64(n46))
 		i--
 		dAtA[i] = 0x6a
 	}
 	if m.WorkflowRunTimeout != nil {
-		n45, err45 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
-		if err45 != nil {
-			return 0, err45
+		n47, err47 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
+		if err47 != nil {
+			return 0, err47
 		}
-		i -= n45
-		i = encodeVarintMessage(dAtA, i, uint64(n45))
+		i -= n47
+		i = encodeVarintMessage(dAtA, i, uint64(n47))
 		i--
 		dAtA[i] = 0x62
 	}
 	if m.WorkflowExecutionTimeout != nil {
-		n46, err46 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
-		if err46 != nil {
-			return 0, err46
+		n48, err48 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
+		if err48 != nil {
+			return 0, err48
 		}
-		i -= n46
-		i = encodeVarintMessage(dAtA, i, uint64(n46))
+		i -= n48
+		i = encodeVarintMessage(dAtA, i, uint64(n48))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -7085,12 +7453,12 @@ func (m *NamespaceDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.FailoverEndTime != nil {
-		n50, err50 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FailoverEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FailoverEndTime):])
-		if err50 != nil {
-			return 0, err50
+		n52, err52 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FailoverEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FailoverEndTime):])
+		if err52 != nil {
+			return 0, err52
 		}
-		i -= n50
-		i = encodeVarintMessage(dAtA, i, uint64(n50))
+		i -= n52
+		i = encodeVarintMessage(dAtA, i, uint64(n52))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -7168,6 +7536,20 @@ func (m *NamespaceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Aliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Data) > 0 {
 		for k := range m.Data {
 			v := m.Data[k]
@@ -7282,6 +7664,20 @@ func (m *NamespaceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeadlineRules) > 0 {
+		for iNdEx := len(m.DeadlineRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeadlineRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.VisibilityArchivalUri) > 0 {
 		i -= len(m.VisibilityArchivalUri)
 		copy(dAtA[i:], m.VisibilityArchivalUri)
@@ -7326,12 +7722,12 @@ func (m *NamespaceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.Retention != nil {
-		n55, err55 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Retention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Retention):])
-		if err55 != nil {
-			return 0, err55
+		n57, err57 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Retention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Retention):])
+		if err57 != nil {
+			return 0, err57
 		}
-		i -= n55
-		i = encodeVarintMessage(dAtA, i, uint64(n55))
+		i -= n57
+		i = encodeVarintMessage(dAtA, i, uint64(n57))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -7549,6 +7945,12 @@ func (m *ActivityInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime)
 		n += 2 + l + sovMessage(uint64(l))
 	}
+	if m.Paused {
+		n += 3
+	}
+	if m.Stamp != 0 {
+		n += 2 + sovMessage(uint64(m.Stamp))
+	}
 	return n
 }
 
@@ -7724,6 +8126,9 @@ func (m *TimerTaskInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if m.Stamp != 0 {
+		n += 1 + sovMessage(uint64(m.Stamp))
+	}
 	return n
 }
 
@@ -7875,6 +8280,26 @@ func (m *TimerInfo) Size() (n int) {
 	return n
 }
 
+func (m *DeadlineInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rule != nil {
+		l = m.Rule.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.TimerCreated {
+		n += 2
+	}
+	return n
+}
+
 func (m *TaskInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8248,6 +8673,21 @@ func (m *WorkflowExecutionInfo) Size() (n int) {
 		l = m.ExecutionStats.Size()
 		n += 2 + l + sovMessage(uint64(l))
 	}
+	if m.Paused {
+		n += 3
+	}
+	if len(m.Deadlines) > 0 {
+		for _, e := range m.Deadlines {
+			l = e.Size()
+			n += 2 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.DeadlineRules) > 0 {
+		for _, e := range m.DeadlineRules {
+			l = e.Size()
+			n += 2 + l + sovMessage(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -8389,6 +8829,12 @@ func (m *NamespaceInfo) Size() (n int) {
 			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
 		}
 	}
+	if len(m.Aliases) > 0 {
+		for _, e := range m.Aliases {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -8443,6 +8889,12 @@ func (m *NamespaceConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if len(m.DeadlineRules) > 0 {
+		for _, e := range m.DeadlineRules {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -8527,6 +8979,8 @@ func (this *ActivityInfo) String() string {
 		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
 		`LastHeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatDetails), "Payloads", "v12.Payloads", 1) + `,`,
 		`LastHeartbeatUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`Stamp:` + fmt.Sprintf("%v", this.Stamp) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8631,6 +9085,7 @@ func (this *TimerTaskInfo) String() string {
 		`EventId:` + fmt.Sprintf("%v", this.EventId) + `,`,
 		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
 		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Stamp:` + fmt.Sprintf("%v", this.Stamp) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8713,6 +9168,18 @@ func (this *TimerInfo) String() string {
 	}, "")
 	return s
 }
+func (this *DeadlineInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeadlineInfo{`,
+		`Rule:` + strings.Replace(fmt.Sprintf("%v", this.Rule), "DeadlineRule", "v15.DeadlineRule", 1) + `,`,
+		`ExpiryTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpiryTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`TimerCreated:` + fmt.Sprintf("%v", this.TimerCreated) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *TaskInfo) String() string {
 	if this == nil {
 		return "nil"
@@ -8801,6 +9268,16 @@ func (this *WorkflowExecutionInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForDeadlines := "[]*DeadlineInfo{"
+	for _, f := range this.Deadlines {
+		repeatedStringForDeadlines += strings.Replace(f.String(), "DeadlineInfo", "DeadlineInfo", 1) + ","
+	}
+	repeatedStringForDeadlines += "}"
+	repeatedStringForDeadlineRules := "[]*DeadlineRule{"
+	for _, f := range this.DeadlineRules {
+		repeatedStringForDeadlineRules += strings.Replace(fmt.Sprintf("%v", f), "DeadlineRule", "v15.DeadlineRule", 1) + ","
+	}
+	repeatedStringForDeadlineRules += "}"
 	keysForSearchAttributes := make([]string, 0, len(this.SearchAttributes))
 	for k, _ := range this.SearchAttributes {
 		keysForSearchAttributes = append(keysForSearchAttributes, k)
@@ -8867,12 +9344,15 @@ func (this *WorkflowExecutionInfo) String() string {
 		`EventBranchToken:` + fmt.Sprintf("%v", this.EventBranchToken) + `,`,
 		`SignalCount:` + fmt.Sprintf("%v", this.SignalCount) + `,`,
 		`HistorySize:` + fmt.Sprintf("%v", this.HistorySize) + `,`,
-		`AutoResetPoints:` + strings.Replace(fmt.Sprintf("%v", this.AutoResetPoints), "ResetPoints", "v15.ResetPoints", 1) + `,`,
+		`AutoResetPoints:` + strings.Replace(fmt.Sprintf("%v", this.AutoResetPoints), "ResetPoints", "v16.ResetPoints", 1) + `,`,
 		`SearchAttributes:` + mapStringForSearchAttributes + `,`,
 		`Memo:` + mapStringForMemo + `,`,
-		`VersionHistories:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistories), "VersionHistories", "v16.VersionHistories", 1) + `,`,
+		`VersionHistories:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistories), "VersionHistories", "v17.VersionHistories", 1) + `,`,
 		`FirstExecutionRunId:` + fmt.Sprintf("%v", this.FirstExecutionRunId) + `,`,
 		`ExecutionStats:` + strings.Replace(this.ExecutionStats.String(), "ExecutionStats", "ExecutionStats", 1) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`Deadlines:` + repeatedStringForDeadlines + `,`,
+		`DeadlineRules:` + repeatedStringForDeadlineRules + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8930,6 +9410,11 @@ func (this *NamespaceInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForAliases := "[]*NamespaceAlias{"
+	for _, f := range this.Aliases {
+		repeatedStringForAliases += strings.Replace(fmt.Sprintf("%v", f), "NamespaceAlias", "v18.NamespaceAlias", 1) + ","
+	}
+	repeatedStringForAliases += "}"
 	keysForData := make([]string, 0, len(this.Data))
 	for k, _ := range this.Data {
 		keysForData = append(keysForData, k)
@@ -8947,6 +9432,7 @@ func (this *NamespaceInfo) String() string {
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
 		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
 		`Data:` + mapStringForData + `,`,
+		`Aliases:` + repeatedStringForAliases + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8966,14 +9452,20 @@ func (this *NamespaceConfig) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForDeadlineRules := "[]*DeadlineRule{"
+	for _, f := range this.DeadlineRules {
+		repeatedStringForDeadlineRules += strings.Replace(fmt.Sprintf("%v", f), "DeadlineRule", "v15.DeadlineRule", 1) + ","
+	}
+	repeatedStringForDeadlineRules += "}"
 	s := strings.Join([]string{`&NamespaceConfig{`,
 		`Retention:` + strings.Replace(fmt.Sprintf("%v", this.Retention), "Duration", "types.Duration", 1) + `,`,
 		`ArchivalBucket:` + fmt.Sprintf("%v", this.ArchivalBucket) + `,`,
-		`BadBinaries:` + strings.Replace(fmt.Sprintf("%v", this.BadBinaries), "BadBinaries", "v17.BadBinaries", 1) + `,`,
+		`BadBinaries:` + strings.Replace(fmt.Sprintf("%v", this.BadBinaries), "BadBinaries", "v19.BadBinaries", 1) + `,`,
 		`HistoryArchivalState:` + fmt.Sprintf("%v", this.HistoryArchivalState) + `,`,
 		`HistoryArchivalUri:` + fmt.Sprintf("%v", this.HistoryArchivalUri) + `,`,
 		`VisibilityArchivalState:` + fmt.Sprintf("%v", this.VisibilityArchivalState) + `,`,
 		`VisibilityArchivalUri:` + fmt.Sprintf("%v", this.VisibilityArchivalUri) + `,`,
+		`DeadlineRules:` + repeatedStringForDeadlineRules + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10133,6 +10625,45 @@ func (m *ActivityInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stamp", wireType)
+			}
+			m.Stamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stamp |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -11558,6 +12089,25 @@ func (m *TimerTaskInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stamp", wireType)
+			}
+			m.Stamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stamp |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -12620,7 +13170,7 @@ func (m *TimerInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskInfo) Unmarshal(dAtA []byte) error {
+func (m *DeadlineInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12643,17 +13193,17 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeadlineInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeadlineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -12663,16 +13213,161 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rule == nil {
+				m.Rule = &v15.DeadlineRule{}
+			}
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerCreated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimerCreated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
@@ -15070,7 +15765,7 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.AutoResetPoints == nil {
-				m.AutoResetPoints = &v15.ResetPoints{}
+				m.AutoResetPoints = &v16.ResetPoints{}
 			}
 			if err := m.AutoResetPoints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -15364,7 +16059,7 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.VersionHistories == nil {
-				m.VersionHistories = &v16.VersionHistories{}
+				m.VersionHistories = &v17.VersionHistories{}
 			}
 			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -15438,6 +16133,94 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 57:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadlines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deadlines = append(m.Deadlines, &DeadlineInfo{})
+			if err := m.Deadlines[len(m.Deadlines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadlineRules = append(m.DeadlineRules, &v15.DeadlineRule{})
+			if err := m.DeadlineRules[len(m.DeadlineRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -16524,6 +17307,40 @@ func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Data[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, &v18.NamespaceAlias{})
+			if err := m.Aliases[len(m.Aliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -16792,7 +17609,7 @@ func (m *NamespaceConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.BadBinaries == nil {
-				m.BadBinaries = &v17.BadBinaries{}
+				m.BadBinaries = &v19.BadBinaries{}
 			}
 			if err := m.BadBinaries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -16900,6 +17717,40 @@ func (m *NamespaceConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.VisibilityArchivalUri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadlineRules = append(m.DeadlineRules, &v15.DeadlineRule{})
+			if err := m.DeadlineRules[len(m.DeadlineRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
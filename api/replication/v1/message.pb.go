@@ -40,13 +40,15 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	v14 "go.temporal.io/api/common/v1"
-	v15 "go.temporal.io/api/failure/v1"
-	v13 "go.temporal.io/api/history/v1"
+	v16 "go.temporal.io/api/common/v1"
+	v17 "go.temporal.io/api/failure/v1"
+	v15 "go.temporal.io/api/history/v1"
 	v11 "go.temporal.io/api/namespace/v1"
 	v12 "go.temporal.io/api/replication/v1"
 	v1 "go.temporal.io/server/api/enums/v1"
-	v16 "go.temporal.io/server/api/history/v1"
+	v18 "go.temporal.io/server/api/history/v1"
+	v13 "go.temporal.io/server/api/namespace/v1"
+	v14 "go.temporal.io/server/api/workflow/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -503,6 +505,8 @@ type NamespaceTaskAttributes struct {
 	ReplicationConfig  *v12.NamespaceReplicationConfig `protobuf:"bytes,5,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
 	ConfigVersion      int64                           `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
 	FailoverVersion    int64                           `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
+	Aliases            []*v13.NamespaceAlias           `protobuf:"bytes,8,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	DeadlineRules      []*v14.DeadlineRule             `protobuf:"bytes,9,rep,name=deadline_rules,json=deadlineRules,proto3" json:"deadline_rules,omitempty"`
 }
 
 func (m *NamespaceTaskAttributes) Reset()      { *m = NamespaceTaskAttributes{} }
@@ -586,6 +590,20 @@ func (m *NamespaceTaskAttributes) GetFailoverVersion() int64 {
 	return 0
 }
 
+func (m *NamespaceTaskAttributes) GetAliases() []*v13.NamespaceAlias {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func (m *NamespaceTaskAttributes) GetDeadlineRules() []*v14.DeadlineRule {
+	if m != nil {
+		return m.DeadlineRules
+	}
+	return nil
+}
+
 type HistoryTaskAttributes struct {
 	TargetClusters          []string     `protobuf:"bytes,1,rep,name=target_clusters,json=targetClusters,proto3" json:"target_clusters,omitempty"`
 	NamespaceId             string       `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -594,8 +612,8 @@ type HistoryTaskAttributes struct {
 	FirstEventId            int64        `protobuf:"varint,5,opt,name=first_event_id,json=firstEventId,proto3" json:"first_event_id,omitempty"`
 	NextEventId             int64        `protobuf:"varint,6,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
 	Version                 int64        `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
-	History                 *v13.History `protobuf:"bytes,9,opt,name=history,proto3" json:"history,omitempty"`
-	NewRunHistory           *v13.History `protobuf:"bytes,10,opt,name=new_run_history,json=newRunHistory,proto3" json:"new_run_history,omitempty"`
+	History                 *v15.History `protobuf:"bytes,9,opt,name=history,proto3" json:"history,omitempty"`
+	NewRunHistory           *v15.History `protobuf:"bytes,10,opt,name=new_run_history,json=newRunHistory,proto3" json:"new_run_history,omitempty"`
 	NewRunEventStoreVersion int32        `protobuf:"varint,12,opt,name=new_run_event_store_version,json=newRunEventStoreVersion,proto3" json:"new_run_event_store_version,omitempty"`
 }
 
@@ -680,14 +698,14 @@ func (m *HistoryTaskAttributes) GetVersion() int64 {
 	return 0
 }
 
-func (m *HistoryTaskAttributes) GetHistory() *v13.History {
+func (m *HistoryTaskAttributes) GetHistory() *v15.History {
 	if m != nil {
 		return m.History
 	}
 	return nil
 }
 
-func (m *HistoryTaskAttributes) GetNewRunHistory() *v13.History {
+func (m *HistoryTaskAttributes) GetNewRunHistory() *v15.History {
 	if m != nil {
 		return m.NewRunHistory
 	}
@@ -861,11 +879,25 @@ type SyncActivityTaskAttributes struct {
 	StartedId          int64               `protobuf:"varint,7,opt,name=started_id,json=startedId,proto3" json:"started_id,omitempty"`
 	StartedTime        *time.Time          `protobuf:"bytes,8,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
 	LastHeartbeatTime  *time.Time          `protobuf:"bytes,9,opt,name=last_heartbeat_time,json=lastHeartbeatTime,proto3,stdtime" json:"last_heartbeat_time,omitempty"`
-	Details            *v14.Payloads       `protobuf:"bytes,10,opt,name=details,proto3" json:"details,omitempty"`
+	Details            *v16.Payloads       `protobuf:"bytes,10,opt,name=details,proto3" json:"details,omitempty"`
 	Attempt            int32               `protobuf:"varint,11,opt,name=attempt,proto3" json:"attempt,omitempty"`
-	LastFailure        *v15.Failure        `protobuf:"bytes,12,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	LastFailure        *v17.Failure        `protobuf:"bytes,12,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
 	LastWorkerIdentity string              `protobuf:"bytes,13,opt,name=last_worker_identity,json=lastWorkerIdentity,proto3" json:"last_worker_identity,omitempty"`
-	VersionHistory     *v16.VersionHistory `protobuf:"bytes,14,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	VersionHistory     *v18.VersionHistory `protobuf:"bytes,14,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	Paused             bool                `protobuf:"varint,15,opt,name=paused,proto3" json:"paused,omitempty"`
+	Stamp              int32               `protobuf:"varint,16,opt,name=stamp,proto3" json:"stamp,omitempty"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
+	ScheduleToCloseTimeout *time.Duration `protobuf:"bytes,17,opt,name=schedule_to_close_timeout,json=scheduleToCloseTimeout,proto3,stdduration" json:"schedule_to_close_timeout,omitempty"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
+	ScheduleToStartTimeout *time.Duration `protobuf:"bytes,18,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3,stdduration" json:"schedule_to_start_timeout,omitempty"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
+	StartToCloseTimeout *time.Duration   `protobuf:"bytes,19,opt,name=start_to_close_timeout,json=startToCloseTimeout,proto3,stdduration" json:"start_to_close_timeout,omitempty"`
+	HeartbeatTimeout    *time.Duration   `protobuf:"bytes,20,opt,name=heartbeat_timeout,json=heartbeatTimeout,proto3,stdduration" json:"heartbeat_timeout,omitempty"`
+	RetryPolicy         *v16.RetryPolicy `protobuf:"bytes,21,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	RetryExpirationTime *time.Time       `protobuf:"bytes,22,opt,name=retry_expiration_time,json=retryExpirationTime,proto3,stdtime" json:"retry_expiration_time,omitempty"`
 }
 
 func (m *SyncActivityTaskAttributes) Reset()      { *m = SyncActivityTaskAttributes{} }
@@ -963,7 +995,7 @@ func (m *SyncActivityTaskAttributes) GetLastHeartbeatTime() *time.Time {
 	return nil
 }
 
-func (m *SyncActivityTaskAttributes) GetDetails() *v14.Payloads {
+func (m *SyncActivityTaskAttributes) GetDetails() *v16.Payloads {
 	if m != nil {
 		return m.Details
 	}
@@ -977,7 +1009,7 @@ func (m *SyncActivityTaskAttributes) GetAttempt() int32 {
 	return 0
 }
 
-func (m *SyncActivityTaskAttributes) GetLastFailure() *v15.Failure {
+func (m *SyncActivityTaskAttributes) GetLastFailure() *v17.Failure {
 	if m != nil {
 		return m.LastFailure
 	}
@@ -991,22 +1023,80 @@ func (m *SyncActivityTaskAttributes) GetLastWorkerIdentity() string {
 	return ""
 }
 
-func (m *SyncActivityTaskAttributes) GetVersionHistory() *v16.VersionHistory {
+func (m *SyncActivityTaskAttributes) GetVersionHistory() *v18.VersionHistory {
 	if m != nil {
 		return m.VersionHistory
 	}
 	return nil
 }
 
+func (m *SyncActivityTaskAttributes) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *SyncActivityTaskAttributes) GetStamp() int32 {
+	if m != nil {
+		return m.Stamp
+	}
+	return 0
+}
+
+func (m *SyncActivityTaskAttributes) GetScheduleToCloseTimeout() *time.Duration {
+	if m != nil {
+		return m.ScheduleToCloseTimeout
+	}
+	return nil
+}
+
+func (m *SyncActivityTaskAttributes) GetScheduleToStartTimeout() *time.Duration {
+	if m != nil {
+		return m.ScheduleToStartTimeout
+	}
+	return nil
+}
+
+func (m *SyncActivityTaskAttributes) GetStartToCloseTimeout() *time.Duration {
+	if m != nil {
+		return m.StartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *SyncActivityTaskAttributes) GetHeartbeatTimeout() *time.Duration {
+	if m != nil {
+		return m.HeartbeatTimeout
+	}
+	return nil
+}
+
+func (m *SyncActivityTaskAttributes) GetRetryPolicy() *v16.RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *SyncActivityTaskAttributes) GetRetryExpirationTime() *time.Time {
+	if m != nil {
+		return m.RetryExpirationTime
+	}
+	return nil
+}
+
 type HistoryTaskV2Attributes struct {
 	TaskId              int64                     `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	NamespaceId         string                    `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId          string                    `protobuf:"bytes,3,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	RunId               string                    `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	VersionHistoryItems []*v16.VersionHistoryItem `protobuf:"bytes,5,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
-	Events              *v14.DataBlob             `protobuf:"bytes,6,opt,name=events,proto3" json:"events,omitempty"`
+	VersionHistoryItems []*v18.VersionHistoryItem `protobuf:"bytes,5,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
+	Events              *v16.DataBlob             `protobuf:"bytes,6,opt,name=events,proto3" json:"events,omitempty"`
 	// New run events does not need version history since there is no prior events.
-	NewRunEvents *v14.DataBlob `protobuf:"bytes,7,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
+	NewRunEvents *v16.DataBlob `protobuf:"bytes,7,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
+	// The deadline rules of the run, they are applied when the events start the run. New runs keep the rules of the run.
+	DeadlineRules *v14.DeadlineRules `protobuf:"bytes,8,opt,name=deadline_rules,json=deadlineRules,proto3" json:"deadline_rules,omitempty"`
 }
 
 func (m *HistoryTaskV2Attributes) Reset()      { *m = HistoryTaskV2Attributes{} }
@@ -1069,27 +1159,34 @@ func (m *HistoryTaskV2Attributes) GetRunId() string {
 	return ""
 }
 
-func (m *HistoryTaskV2Attributes) GetVersionHistoryItems() []*v16.VersionHistoryItem {
+func (m *HistoryTaskV2Attributes) GetVersionHistoryItems() []*v18.VersionHistoryItem {
 	if m != nil {
 		return m.VersionHistoryItems
 	}
 	return nil
 }
 
-func (m *HistoryTaskV2Attributes) GetEvents() *v14.DataBlob {
+func (m *HistoryTaskV2Attributes) GetEvents() *v16.DataBlob {
 	if m != nil {
 		return m.Events
 	}
 	return nil
 }
 
-func (m *HistoryTaskV2Attributes) GetNewRunEvents() *v14.DataBlob {
+func (m *HistoryTaskV2Attributes) GetNewRunEvents() *v16.DataBlob {
 	if m != nil {
 		return m.NewRunEvents
 	}
 	return nil
 }
 
+func (m *HistoryTaskV2Attributes) GetDeadlineRules() *v14.DeadlineRules {
+	if m != nil {
+		return m.DeadlineRules
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ReplicationTask)(nil), "temporal.server.api.replication.v1.ReplicationTask")
 	proto.RegisterType((*ReplicationToken)(nil), "temporal.server.api.replication.v1.ReplicationToken")
@@ -1109,103 +1206,119 @@ func init() {
 }
 
 var fileDescriptor_edd9fae2af6b0532 = []byte{
-	// 1525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x1b, 0xcf, 0xc6, 0x8e, 0x3f, 0x1e, 0x3b, 0x76, 0x3a, 0x79, 0xf3, 0x26, 0xf1, 0xab, 0xb8, 0x89,
-	0xd5, 0xbe, 0x4d, 0x11, 0xb2, 0x1b, 0xe7, 0x00, 0xfd, 0x10, 0x52, 0x52, 0x28, 0x71, 0xa4, 0x96,
-	0x6a, 0x13, 0xb5, 0x12, 0x97, 0x65, 0xe2, 0x1d, 0xdb, 0xab, 0xd8, 0xbb, 0xd6, 0xcc, 0xd8, 0xc1,
-	0x9c, 0x90, 0x38, 0x70, 0x01, 0xa9, 0xff, 0x03, 0x08, 0x71, 0xe2, 0xef, 0xe8, 0xb1, 0x17, 0xa4,
-	0x22, 0x0e, 0xd0, 0xf4, 0xc2, 0xb1, 0x37, 0xae, 0x68, 0x3e, 0xd6, 0xde, 0xcd, 0xda, 0xae, 0x29,
-	0xea, 0x89, 0x9b, 0xe7, 0xf9, 0xf8, 0x3d, 0x33, 0xcf, 0x3c, 0xcf, 0xf3, 0x9b, 0x35, 0xdc, 0xe0,
-	0xa4, 0xd3, 0xf5, 0x28, 0x6e, 0x57, 0x18, 0xa1, 0x7d, 0x42, 0x2b, 0xb8, 0xeb, 0x54, 0x28, 0xe9,
-	0xb6, 0x9d, 0x3a, 0xe6, 0x8e, 0xe7, 0x56, 0xfa, 0x3b, 0x95, 0x0e, 0x61, 0x0c, 0x37, 0x49, 0xb9,
-	0x4b, 0x3d, 0xee, 0xa1, 0x92, 0xef, 0x51, 0x56, 0x1e, 0x65, 0xdc, 0x75, 0xca, 0x01, 0x8f, 0x72,
-	0x7f, 0xa7, 0x70, 0xb9, 0xe9, 0x79, 0xcd, 0x36, 0xa9, 0x48, 0x8f, 0x93, 0x5e, 0xa3, 0xc2, 0x9d,
-	0x0e, 0x61, 0x1c, 0x77, 0xba, 0x0a, 0xa4, 0xb0, 0x65, 0x93, 0x2e, 0x71, 0x6d, 0xe2, 0xd6, 0x1d,
-	0xc2, 0x2a, 0x4d, 0xaf, 0xe9, 0x49, 0xb9, 0xfc, 0xa5, 0x4d, 0xca, 0xe3, 0x76, 0x46, 0xdc, 0x5e,
-	0x87, 0x89, 0x3d, 0x05, 0x03, 0x2a, 0xfb, 0x6b, 0x53, 0xed, 0x39, 0x66, 0xa7, 0xda, 0xf0, 0xdd,
-	0x71, 0x86, 0x2d, 0x87, 0x71, 0x8f, 0x0e, 0x22, 0xc7, 0x2d, 0x5c, 0x19, 0x5a, 0x0b, 0xb3, 0xba,
-	0xd7, 0xe9, 0x8c, 0x49, 0x4a, 0x20, 0xb8, 0xb0, 0x72, 0x71, 0x87, 0xb0, 0x2e, 0xae, 0x93, 0xa8,
-	0xe1, 0xf5, 0x90, 0xe1, 0xb4, 0x44, 0x17, 0xae, 0x86, 0x4c, 0x27, 0x6e, 0x30, 0x6c, 0xd6, 0xc0,
-	0x4e, 0xbb, 0x47, 0xa3, 0x81, 0x4b, 0x3f, 0x24, 0x21, 0x6f, 0x8e, 0xc2, 0x1d, 0x63, 0x76, 0x8a,
-	0x1e, 0x40, 0x5a, 0xe4, 0xc5, 0xe2, 0x83, 0x2e, 0x59, 0x33, 0x36, 0x8d, 0xed, 0x5c, 0x75, 0xa7,
-	0x3c, 0xee, 0x7a, 0x65, 0x1a, 0xcb, 0xfd, 0x9d, 0xf2, 0x05, 0x84, 0xe3, 0x41, 0x97, 0x98, 0x29,
-	0xae, 0x7f, 0xa1, 0x2b, 0x90, 0x63, 0x5e, 0x8f, 0xd6, 0x89, 0x25, 0x61, 0x1d, 0x7b, 0x6d, 0x7e,
-	0xd3, 0xd8, 0x8e, 0x99, 0x59, 0x25, 0x15, 0x1e, 0x35, 0x1b, 0x0d, 0x60, 0x7d, 0x98, 0x20, 0x65,
-	0x88, 0x39, 0xa7, 0xce, 0x49, 0x8f, 0x13, 0xb6, 0x16, 0xdb, 0x34, 0xb6, 0x33, 0xd5, 0xdb, 0xe5,
-	0xd7, 0x17, 0x59, 0xf9, 0x81, 0x0f, 0x22, 0x70, 0xf7, 0x86, 0x10, 0x07, 0x73, 0xe6, 0xaa, 0x3b,
-	0x5e, 0x85, 0x18, 0xac, 0xea, 0x3c, 0x46, 0x02, 0xc7, 0x65, 0xe0, 0x9b, 0xb3, 0x04, 0x3e, 0x50,
-	0x10, 0x91, 0xb0, 0x2b, 0xad, 0x71, 0x0a, 0xf4, 0xad, 0x01, 0x5b, 0x6c, 0xe0, 0xd6, 0x2d, 0xd6,
-	0xc2, 0xd4, 0xb6, 0x18, 0xc7, 0xbc, 0xc7, 0x22, 0xf1, 0x17, 0x64, 0xfc, 0xbd, 0x59, 0xe2, 0x1f,
-	0x0d, 0xdc, 0xfa, 0x91, 0xc0, 0x3a, 0x92, 0x50, 0x91, 0x7d, 0x6c, 0xb0, 0x69, 0x06, 0xe8, 0x2b,
-	0x03, 0xa4, 0x85, 0x85, 0xeb, 0xdc, 0xe9, 0x3b, 0x3c, 0x9a, 0x8b, 0x84, 0xdc, 0xcb, 0x07, 0xb3,
-	0xee, 0x65, 0x4f, 0xe3, 0x44, 0x36, 0x52, 0x60, 0x13, 0xb5, 0xe8, 0x1b, 0x03, 0x36, 0xfd, 0xbb,
-	0xe8, 0x10, 0x8e, 0x6d, 0xcc, 0x71, 0x64, 0x23, 0xc9, 0xd9, 0x93, 0xa2, 0x2f, 0xe5, 0xbe, 0x86,
-	0x8a, 0x26, 0xa5, 0x35, 0xcd, 0x00, 0x7d, 0x01, 0x85, 0x50, 0x65, 0xf4, 0xab, 0xc1, 0x7d, 0xa4,
-	0x66, 0xaf, 0xca, 0x40, 0x71, 0x3c, 0xaa, 0x86, 0xab, 0xb2, 0x35, 0x5e, 0xb5, 0x9f, 0x05, 0x18,
-	0xc5, 0x2a, 0x7d, 0x67, 0xc0, 0x52, 0xb0, 0xcd, 0xbc, 0x53, 0xe2, 0xa2, 0x75, 0x48, 0xa9, 0xea,
-	0x71, 0x6c, 0xd9, 0xa8, 0x0b, 0x66, 0x52, 0xae, 0x6b, 0x36, 0xba, 0x09, 0xeb, 0x6d, 0xcc, 0xb8,
-	0x45, 0x09, 0xa7, 0x0e, 0xe9, 0x13, 0xdb, 0xd2, 0x8d, 0x3f, 0xea, 0xbf, 0xff, 0x0a, 0x03, 0xd3,
-	0xd7, 0xdf, 0x57, 0xea, 0x80, 0x6b, 0x97, 0x7a, 0x75, 0xc2, 0x58, 0xd8, 0x35, 0x36, 0x72, 0x7d,
-	0xe8, 0xeb, 0x87, 0xae, 0xa5, 0x63, 0xc8, 0x5f, 0x28, 0x43, 0xb4, 0x07, 0x19, 0xbf, 0xb6, 0x9d,
-	0x8e, 0x9a, 0x27, 0x99, 0x6a, 0xa1, 0xac, 0xa8, 0xa0, 0xec, 0x53, 0x41, 0xf9, 0xd8, 0xa7, 0x82,
-	0xfd, 0xf8, 0x93, 0xdf, 0x2e, 0x1b, 0x26, 0x28, 0x27, 0x21, 0x2e, 0xfd, 0x34, 0x0f, 0xcb, 0x81,
-	0xb3, 0xeb, 0x70, 0x0c, 0x7d, 0x06, 0x97, 0x02, 0x69, 0x96, 0x37, 0xc4, 0xd6, 0x8c, 0xcd, 0xd8,
-	0x76, 0xa6, 0xba, 0x3b, 0xcb, 0xa5, 0x5c, 0x18, 0x5b, 0xe6, 0x12, 0x0d, 0x0b, 0xd8, 0x3f, 0xc9,
-	0xe2, 0x3a, 0xa4, 0x5a, 0x98, 0x59, 0x1d, 0x8f, 0x12, 0x99, 0xb4, 0x94, 0x99, 0x6c, 0x61, 0x76,
-	0xdf, 0xa3, 0x04, 0x59, 0x70, 0x29, 0xd2, 0xf9, 0x7a, 0xd2, 0xec, 0xbe, 0x41, 0xa7, 0x9b, 0xf9,
-	0x0b, 0x9d, 0x5d, 0xfa, 0x39, 0x9c, 0x30, 0x39, 0x61, 0xdd, 0x86, 0x87, 0xb6, 0x20, 0x3b, 0x9a,
-	0xb1, 0xba, 0x66, 0xd2, 0x66, 0x66, 0x28, 0xab, 0xd9, 0xe8, 0x32, 0x64, 0xce, 0x3c, 0x7a, 0xda,
-	0x68, 0x7b, 0x67, 0xfe, 0x19, 0xd3, 0x26, 0xf8, 0xa2, 0x9a, 0x8d, 0x56, 0x20, 0x41, 0x7b, 0xae,
-	0x5f, 0x0a, 0x69, 0x73, 0x81, 0xf6, 0xdc, 0x9a, 0x8d, 0xee, 0x06, 0x49, 0x23, 0x2e, 0x49, 0xe3,
-	0xff, 0xd3, 0x49, 0x63, 0x0c, 0x53, 0xac, 0x42, 0xd2, 0xa7, 0x88, 0x05, 0x99, 0xdc, 0x04, 0x57,
-	0xe4, 0xb0, 0x06, 0xc9, 0x3e, 0xa1, 0xcc, 0xf1, 0x5c, 0x39, 0x85, 0x62, 0xa6, 0xbf, 0x14, 0xe4,
-	0xd2, 0x70, 0x28, 0xe3, 0x16, 0xe9, 0x13, 0x97, 0x0b, 0xcf, 0xa4, 0x22, 0x17, 0x29, 0xfd, 0x48,
-	0x08, 0x6b, 0x36, 0x2a, 0xc1, 0xa2, 0x4b, 0x3e, 0x0f, 0x18, 0xa5, 0xa4, 0x51, 0x46, 0x08, 0x7d,
-	0x9b, 0x2d, 0xc8, 0xb2, 0x7a, 0x8b, 0xd8, 0xbd, 0x36, 0x91, 0x0d, 0x95, 0x56, 0x26, 0x43, 0x59,
-	0xcd, 0x2e, 0x3d, 0x8d, 0xc1, 0xea, 0x04, 0x7e, 0x41, 0x18, 0x96, 0x47, 0xb9, 0xf5, 0xba, 0x84,
-	0xca, 0xd4, 0x6b, 0xfe, 0xbc, 0x31, 0x3d, 0x15, 0x43, 0xcc, 0x4f, 0x7c, 0x3f, 0x13, 0xb9, 0x11,
-	0x19, 0xca, 0xc1, 0xfc, 0xf0, 0x4a, 0xe6, 0x1d, 0x1b, 0xdd, 0x81, 0xb8, 0xe3, 0x36, 0x3c, 0xcd,
-	0x8e, 0xdb, 0xa3, 0x18, 0x02, 0x7c, 0xe8, 0x1f, 0x0a, 0x20, 0xca, 0xc0, 0x94, 0x5e, 0x68, 0x1f,
-	0x12, 0x75, 0xcf, 0x6d, 0x38, 0x4d, 0x5d, 0x7a, 0xef, 0xcc, 0xe2, 0x7f, 0x57, 0x7a, 0x98, 0xda,
-	0x13, 0x35, 0x00, 0x05, 0x3b, 0x50, 0xe3, 0x29, 0xd2, 0x7a, 0x2f, 0x8c, 0x37, 0x89, 0xa6, 0x03,
-	0x75, 0xaa, 0xc1, 0x83, 0x4d, 0xad, 0x44, 0xe8, 0x2a, 0xe4, 0x14, 0xb6, 0x15, 0x2e, 0x83, 0x45,
-	0x25, 0x7d, 0xa4, 0x8b, 0xe1, 0x3a, 0x2c, 0x89, 0x97, 0x8e, 0xd7, 0x27, 0x74, 0x68, 0xa8, 0xca,
-	0x21, 0xef, 0xcb, 0xb5, 0x69, 0xe9, 0xd7, 0x18, 0xac, 0x8c, 0x65, 0x6c, 0x74, 0x0d, 0xf2, 0x1c,
-	0xd3, 0x26, 0xe1, 0x56, 0xbd, 0xdd, 0x63, 0x9c, 0x50, 0x35, 0x53, 0xd2, 0x66, 0x4e, 0x89, 0xef,
-	0x6a, 0x69, 0xa4, 0x9b, 0xe6, 0x5f, 0xdb, 0x4d, 0xb1, 0x29, 0xdd, 0x14, 0x0f, 0x76, 0x53, 0xb4,
-	0xaa, 0x17, 0x66, 0xa9, 0xea, 0x44, 0xb4, 0xaa, 0x03, 0x9d, 0x93, 0x0c, 0x77, 0xce, 0x2d, 0x48,
-	0x6a, 0xea, 0x91, 0xa5, 0x9e, 0xa9, 0x6e, 0x86, 0x2f, 0x4c, 0x2b, 0x03, 0xec, 0x65, 0xfa, 0x0e,
-	0xe8, 0x00, 0xf2, 0x2e, 0x39, 0xb3, 0xc4, 0xd6, 0x7d, 0x0c, 0x98, 0x11, 0x63, 0xd1, 0x25, 0x67,
-	0x66, 0xcf, 0xd5, 0x4b, 0x74, 0x07, 0xfe, 0xe7, 0x23, 0xa9, 0x63, 0x08, 0x31, 0x19, 0xde, 0x5e,
-	0x56, 0xb2, 0xda, 0xaa, 0xf2, 0x91, 0x67, 0x3a, 0x12, 0x7a, 0x7d, 0x8b, 0x87, 0xf1, 0x54, 0x6a,
-	0x29, 0x7d, 0x18, 0x4f, 0x65, 0x96, 0xb2, 0x87, 0xf1, 0xd4, 0xe2, 0x52, 0xee, 0x30, 0x9e, 0xca,
-	0x2d, 0xe5, 0x4b, 0x5f, 0xcf, 0xc3, 0xc6, 0x54, 0xea, 0xff, 0xb7, 0xdc, 0x72, 0xe9, 0x7b, 0x03,
-	0x36, 0xa6, 0xbe, 0x0c, 0x45, 0x6f, 0xe9, 0xe7, 0xb9, 0xce, 0x84, 0xa6, 0x85, 0x45, 0x25, 0xd5,
-	0x89, 0x08, 0xbd, 0x35, 0x14, 0xf3, 0x0d, 0xdf, 0x1a, 0x17, 0x28, 0x3e, 0xf6, 0x06, 0x14, 0xff,
-	0xcb, 0x02, 0x14, 0x26, 0x3f, 0x1a, 0xdf, 0x26, 0x71, 0x05, 0x52, 0x17, 0x0f, 0x37, 0xc8, 0x45,
-	0x42, 0x58, 0x88, 0x10, 0x02, 0xfa, 0x18, 0x72, 0x23, 0x13, 0x79, 0xf8, 0xc4, 0x8c, 0x87, 0x5f,
-	0x1c, 0xfa, 0x09, 0x0d, 0xda, 0x00, 0x91, 0x0d, 0xca, 0x55, 0x24, 0x75, 0x87, 0x69, 0x2d, 0x91,
-	0xec, 0x9a, 0xf5, 0xd5, 0x32, 0x4a, 0x6a, 0xc6, 0x28, 0x19, 0xed, 0x25, 0x63, 0x3c, 0x84, 0x65,
-	0xf9, 0x98, 0x69, 0x11, 0x4c, 0xf9, 0x09, 0xc1, 0x5c, 0x61, 0xa5, 0x67, 0xc4, 0xba, 0x24, 0x9c,
-	0x0f, 0x7c, 0x5f, 0x89, 0x78, 0x0b, 0x92, 0x36, 0xe1, 0xd8, 0x69, 0xb3, 0xf1, 0xed, 0xaf, 0xbe,
-	0x8b, 0x45, 0xf7, 0x3f, 0xc4, 0x83, 0xb6, 0x87, 0x6d, 0x66, 0xfa, 0x0e, 0x22, 0xef, 0x98, 0x0b,
-	0x6b, 0xbe, 0x96, 0x51, 0x4f, 0x57, 0xbd, 0x14, 0x87, 0x95, 0xfb, 0xd4, 0x1f, 0xad, 0x72, 0x06,
-	0x44, 0xa0, 0xb5, 0x52, 0x60, 0xdf, 0x53, 0x3f, 0xcd, 0x8c, 0xf0, 0xd2, 0x0b, 0x74, 0x03, 0xfe,
-	0x23, 0x41, 0x44, 0x01, 0x10, 0x6a, 0x39, 0x36, 0x71, 0xb9, 0xc3, 0x07, 0x6b, 0x8b, 0xf2, 0xee,
-	0x91, 0xd0, 0x3d, 0x96, 0xaa, 0x9a, 0xd6, 0xa0, 0xc7, 0x90, 0xd7, 0x37, 0x3f, 0x9c, 0x69, 0x39,
-	0x19, 0xb9, 0x3c, 0x96, 0xbc, 0x03, 0xa3, 0x4d, 0x4f, 0x23, 0x7f, 0xc2, 0xe5, 0xfa, 0xa1, 0x75,
-	0xe9, 0xcf, 0x79, 0x58, 0x9d, 0xf0, 0xfe, 0x0f, 0xbe, 0x78, 0x8c, 0xd0, 0x8b, 0xe7, 0x2d, 0x8e,
-	0x9d, 0x06, 0xac, 0x5c, 0x38, 0xa8, 0xe5, 0x70, 0xd2, 0x11, 0x1f, 0x9b, 0xe2, 0xe9, 0x5c, 0xfd,
-	0x7b, 0xc7, 0xad, 0x71, 0xd2, 0x31, 0x97, 0xfb, 0x11, 0x19, 0x43, 0xef, 0x43, 0x42, 0xce, 0x2c,
-	0xff, 0xcb, 0x71, 0x62, 0x71, 0x7c, 0x88, 0x39, 0xde, 0x6f, 0x7b, 0x27, 0xa6, 0xb6, 0x47, 0xf7,
-	0x20, 0x17, 0x22, 0x05, 0xff, 0x93, 0xef, 0xf5, 0x08, 0xd9, 0x00, 0x53, 0xb0, 0x7d, 0xe7, 0xd9,
-	0x8b, 0xe2, 0xdc, 0xf3, 0x17, 0xc5, 0xb9, 0x57, 0x2f, 0x8a, 0xc6, 0x97, 0xe7, 0x45, 0xe3, 0xc7,
-	0xf3, 0xa2, 0xf1, 0xf4, 0xbc, 0x68, 0x3c, 0x3b, 0x2f, 0x1a, 0xbf, 0x9f, 0x17, 0x8d, 0x3f, 0xce,
-	0x8b, 0x73, 0xaf, 0xce, 0x8b, 0xc6, 0x93, 0x97, 0xc5, 0xb9, 0x67, 0x2f, 0x8b, 0x73, 0xcf, 0x5f,
-	0x16, 0xe7, 0x3e, 0xdd, 0x6d, 0x7a, 0xa3, 0x38, 0x8e, 0x37, 0xf9, 0x2f, 0xb0, 0xdb, 0x94, 0x74,
-	0xf5, 0xea, 0x24, 0x21, 0xfb, 0x66, 0xf7, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82, 0xe2, 0xef,
-	0xb6, 0x3a, 0x13, 0x00, 0x00,
+	// 1783 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x8a, 0x14, 0x3f, 0x1e, 0x29, 0x92, 0x1a, 0x59, 0x12, 0xa5, 0xc2, 0xb4, 0xcc, 0x26,
+	0xb5, 0x52, 0x34, 0x94, 0x2d, 0x1f, 0xda, 0x7c, 0xa0, 0x80, 0x64, 0xc7, 0x35, 0x85, 0x3a, 0x35,
+	0x56, 0x6c, 0x02, 0xe4, 0xb2, 0x1d, 0x71, 0x87, 0xe2, 0xc2, 0xcb, 0x5d, 0x62, 0x66, 0x48, 0x87,
+	0x3d, 0xb5, 0xe8, 0xa1, 0x97, 0x16, 0xc8, 0xb1, 0xf7, 0x16, 0x45, 0x4f, 0xfd, 0x3b, 0x7a, 0x2a,
+	0x7c, 0x29, 0x10, 0xa0, 0x87, 0xd6, 0xf2, 0xa5, 0xe8, 0x29, 0x7f, 0x42, 0x31, 0x5f, 0xe4, 0x2e,
+	0x77, 0x49, 0x33, 0x29, 0x7c, 0xca, 0x8d, 0xf3, 0xde, 0xfb, 0xfd, 0xde, 0xcc, 0x9b, 0xf7, 0xe6,
+	0xbd, 0x25, 0xdc, 0xe5, 0x64, 0x30, 0x0c, 0x29, 0xf6, 0x8f, 0x19, 0xa1, 0x63, 0x42, 0x8f, 0xf1,
+	0xd0, 0x3b, 0xa6, 0x64, 0xe8, 0x7b, 0x5d, 0xcc, 0xbd, 0x30, 0x38, 0x1e, 0xdf, 0x3b, 0x1e, 0x10,
+	0xc6, 0xf0, 0x15, 0x69, 0x0d, 0x69, 0xc8, 0x43, 0xd4, 0x34, 0x88, 0x96, 0x42, 0xb4, 0xf0, 0xd0,
+	0x6b, 0x45, 0x10, 0xad, 0xf1, 0xbd, 0x83, 0xc6, 0x55, 0x18, 0x5e, 0xf9, 0xe4, 0x58, 0x22, 0x2e,
+	0x47, 0xbd, 0x63, 0x77, 0x44, 0x95, 0x52, 0x4a, 0x0e, 0x6e, 0xcd, 0xeb, 0xb9, 0x37, 0x20, 0x8c,
+	0xe3, 0xc1, 0x50, 0x1b, 0xdc, 0x76, 0xc9, 0x90, 0x04, 0x2e, 0x09, 0xba, 0x1e, 0x61, 0xc7, 0x57,
+	0xe1, 0x55, 0x28, 0xe5, 0xf2, 0x97, 0x36, 0x69, 0xa5, 0xed, 0x9c, 0x04, 0xa3, 0x01, 0x13, 0x7b,
+	0x8e, 0x6e, 0x48, 0xd9, 0xdf, 0x59, 0x6a, 0xcf, 0x31, 0x7b, 0xa6, 0x0d, 0x7f, 0x90, 0x66, 0xd8,
+	0xf7, 0x18, 0x0f, 0xe9, 0x24, 0x11, 0x8e, 0xf4, 0x6d, 0x04, 0x78, 0x40, 0xd8, 0x10, 0x77, 0x49,
+	0xd2, 0xfe, 0xdd, 0x34, 0xfb, 0xe7, 0x21, 0x7d, 0xd6, 0xf3, 0xc3, 0xe7, 0x49, 0xf3, 0xb7, 0xa6,
+	0xe6, 0xc2, 0xae, 0x1b, 0x0e, 0x06, 0x29, 0x77, 0x72, 0x70, 0x27, 0x66, 0xb5, 0xc4, 0xfb, 0x3b,
+	0x31, 0xc3, 0x65, 0xf7, 0x7c, 0xf0, 0x76, 0xcc, 0x74, 0xe1, 0xf9, 0xe3, 0x66, 0x3d, 0xec, 0xf9,
+	0x23, 0x9a, 0x74, 0xdc, 0xfc, 0x73, 0x1e, 0xaa, 0xf6, 0xcc, 0x5d, 0x07, 0xb3, 0x67, 0xe8, 0x63,
+	0x28, 0x8a, 0xb0, 0x3b, 0x7c, 0x32, 0x24, 0x75, 0xeb, 0xd0, 0x3a, 0xaa, 0x9c, 0xdc, 0x6b, 0xa5,
+	0x65, 0x97, 0xbc, 0xa5, 0xd6, 0xf8, 0x5e, 0x6b, 0x8e, 0xa1, 0x33, 0x19, 0x12, 0xbb, 0xc0, 0xf5,
+	0x2f, 0xf4, 0x16, 0x54, 0x58, 0x38, 0xa2, 0x5d, 0xe2, 0x48, 0x5a, 0xcf, 0xad, 0xaf, 0x1f, 0x5a,
+	0x47, 0x19, 0xbb, 0xac, 0xa4, 0x02, 0xd1, 0x76, 0xd1, 0x04, 0xf6, 0xa7, 0x01, 0x52, 0x86, 0x98,
+	0x73, 0xea, 0x5d, 0x8e, 0x38, 0x61, 0xf5, 0xcc, 0xa1, 0x75, 0x54, 0x3a, 0xf9, 0xa0, 0xf5, 0xfa,
+	0x1c, 0x6f, 0x7d, 0x6c, 0x48, 0x04, 0xef, 0xe9, 0x94, 0xe2, 0xf1, 0x9a, 0xbd, 0x17, 0xa4, 0xab,
+	0x10, 0x83, 0x3d, 0x1d, 0xc7, 0x84, 0xe3, 0xac, 0x74, 0xfc, 0xde, 0x2a, 0x8e, 0x1f, 0x2b, 0x8a,
+	0x84, 0xdb, 0x9d, 0x7e, 0x9a, 0x02, 0xfd, 0xde, 0x82, 0xdb, 0x6c, 0x12, 0x74, 0x1d, 0xd6, 0xc7,
+	0xd4, 0x75, 0x18, 0xc7, 0x7c, 0xc4, 0x12, 0xfe, 0x37, 0xa4, 0xff, 0xd3, 0x55, 0xfc, 0x5f, 0x4c,
+	0x82, 0xee, 0x85, 0xe0, 0xba, 0x90, 0x54, 0x89, 0x7d, 0xdc, 0x64, 0xcb, 0x0c, 0xd0, 0x6f, 0x2c,
+	0x90, 0x16, 0x0e, 0xee, 0x72, 0x6f, 0xec, 0xf1, 0x64, 0x2c, 0x72, 0x72, 0x2f, 0x3f, 0x5e, 0x75,
+	0x2f, 0xa7, 0x9a, 0x27, 0xb1, 0x91, 0x03, 0xb6, 0x50, 0x8b, 0x7e, 0x67, 0xc1, 0xa1, 0xb9, 0x8b,
+	0x01, 0xe1, 0xd8, 0xc5, 0x1c, 0x27, 0x36, 0x92, 0x5f, 0x3d, 0x28, 0xfa, 0x52, 0x9e, 0x68, 0xaa,
+	0x64, 0x50, 0xfa, 0xcb, 0x0c, 0xd0, 0x2f, 0xe1, 0x20, 0x96, 0x19, 0xe3, 0x93, 0xe8, 0x3e, 0x0a,
+	0xab, 0x67, 0x65, 0x24, 0x39, 0x3e, 0x39, 0x89, 0x67, 0x65, 0x3f, 0x5d, 0x75, 0x56, 0x06, 0x98,
+	0xf9, 0x6a, 0xfe, 0xd1, 0x82, 0x5a, 0xb4, 0xcc, 0xc2, 0x67, 0x24, 0x40, 0xfb, 0x50, 0x50, 0xd9,
+	0xe3, 0xb9, 0xb2, 0x50, 0x37, 0xec, 0xbc, 0x5c, 0xb7, 0x5d, 0xf4, 0x1e, 0xec, 0xfb, 0x98, 0x71,
+	0x87, 0x12, 0x4e, 0x3d, 0x32, 0x26, 0xae, 0xa3, 0x0b, 0x7f, 0x56, 0x7f, 0xbb, 0xc2, 0xc0, 0x36,
+	0xfa, 0x27, 0x4a, 0x1d, 0x81, 0x0e, 0x69, 0xd8, 0x25, 0x8c, 0xc5, 0xa1, 0x99, 0x19, 0xf4, 0xa9,
+	0xd1, 0x4f, 0xa1, 0xcd, 0x0e, 0x54, 0xe7, 0xd2, 0x10, 0x9d, 0x42, 0xc9, 0xe4, 0xb6, 0x37, 0x50,
+	0xef, 0x49, 0xe9, 0xe4, 0xa0, 0xa5, 0x3a, 0x4d, 0xcb, 0x74, 0x9a, 0x56, 0xc7, 0x74, 0x9a, 0xb3,
+	0xec, 0x17, 0xff, 0xba, 0x65, 0xd9, 0xa0, 0x40, 0x42, 0xdc, 0xfc, 0xeb, 0x3a, 0x6c, 0x47, 0xce,
+	0xae, 0xdd, 0x31, 0xf4, 0x0b, 0xd8, 0x8a, 0x84, 0x59, 0xde, 0x10, 0xab, 0x5b, 0x87, 0x99, 0xa3,
+	0xd2, 0xc9, 0xfd, 0x55, 0x2e, 0x65, 0xee, 0xd9, 0xb2, 0x6b, 0x34, 0x2e, 0x60, 0xff, 0x4f, 0x14,
+	0xf7, 0xa1, 0xd0, 0xc7, 0xcc, 0x19, 0x84, 0x94, 0xc8, 0xa0, 0x15, 0xec, 0x7c, 0x1f, 0xb3, 0x27,
+	0x21, 0x25, 0xc8, 0x81, 0xad, 0x44, 0xe5, 0xeb, 0x97, 0xe6, 0xfe, 0x37, 0xa8, 0x74, 0xbb, 0x3a,
+	0x57, 0xd9, 0xcd, 0x7f, 0xc4, 0x03, 0x26, 0x5f, 0xd8, 0xa0, 0x17, 0xa2, 0xdb, 0x50, 0x9e, 0xbd,
+	0xb1, 0x3a, 0x67, 0x8a, 0x76, 0x69, 0x2a, 0x6b, 0xbb, 0xe8, 0x16, 0x94, 0x4c, 0xd7, 0x33, 0x67,
+	0x2c, 0xda, 0x60, 0x44, 0x6d, 0x17, 0xed, 0x40, 0x8e, 0x8e, 0x02, 0x93, 0x0a, 0x45, 0x7b, 0x83,
+	0x8e, 0x82, 0xb6, 0x8b, 0x1e, 0x44, 0x9b, 0x46, 0x56, 0x36, 0x8d, 0xef, 0x2d, 0x6f, 0x1a, 0x29,
+	0x9d, 0x62, 0x0f, 0xf2, 0xa6, 0x45, 0x6c, 0xc8, 0xe0, 0xe6, 0xb8, 0x6a, 0x0e, 0x75, 0xc8, 0x8f,
+	0x09, 0x65, 0x5e, 0x18, 0xc8, 0x57, 0x28, 0x63, 0x9b, 0xa5, 0x68, 0x2e, 0x3d, 0x8f, 0x32, 0xee,
+	0x90, 0x31, 0x09, 0xb8, 0x40, 0xe6, 0x55, 0x73, 0x91, 0xd2, 0x8f, 0x84, 0xb0, 0xed, 0xa2, 0x26,
+	0x6c, 0x06, 0xe4, 0xf3, 0x88, 0x51, 0x41, 0x1a, 0x95, 0x84, 0xd0, 0xd8, 0xdc, 0x86, 0x32, 0xeb,
+	0xf6, 0x89, 0x3b, 0xf2, 0x89, 0x2c, 0xa8, 0xa2, 0x32, 0x99, 0xca, 0xda, 0x6e, 0xf3, 0xbf, 0x59,
+	0xd8, 0x5b, 0xd0, 0x5f, 0x10, 0x86, 0xed, 0x59, 0x6c, 0xc3, 0x21, 0x51, 0x83, 0x95, 0xee, 0x9f,
+	0x77, 0x97, 0x87, 0x62, 0xca, 0xf9, 0x33, 0x83, 0xb3, 0x51, 0x90, 0x90, 0xa1, 0x0a, 0xac, 0x4f,
+	0xaf, 0x64, 0xdd, 0x73, 0xd1, 0x87, 0x90, 0xf5, 0x82, 0x5e, 0xa8, 0xbb, 0xe3, 0xd1, 0xcc, 0x87,
+	0x20, 0x9f, 0xe2, 0x63, 0x0e, 0x44, 0x1a, 0xd8, 0x12, 0x85, 0xce, 0x20, 0xd7, 0x0d, 0x83, 0x9e,
+	0x77, 0xa5, 0x53, 0xef, 0xfb, 0xab, 0xe0, 0x1f, 0x48, 0x84, 0xad, 0x91, 0xa8, 0x07, 0x28, 0x5a,
+	0x81, 0x9a, 0x4f, 0x35, 0xad, 0x1f, 0xc6, 0xf9, 0x16, 0xb5, 0xe9, 0x48, 0x9e, 0x6a, 0xf2, 0x2d,
+	0x3a, 0x2f, 0x42, 0x6f, 0x43, 0x45, 0x71, 0x3b, 0xf1, 0x34, 0xd8, 0x54, 0xd2, 0x4f, 0x74, 0x32,
+	0xbc, 0x03, 0x35, 0x31, 0xe9, 0x84, 0x63, 0x42, 0xa7, 0x86, 0x2a, 0x1d, 0xaa, 0x46, 0x6e, 0x4c,
+	0xcf, 0x21, 0x8f, 0x7d, 0x0f, 0x33, 0xf9, 0x8c, 0x8b, 0x17, 0x23, 0xfd, 0x8a, 0xd2, 0xa3, 0x70,
+	0x2a, 0x90, 0xb6, 0x21, 0x40, 0x1d, 0xa8, 0xb8, 0x04, 0xbb, 0xbe, 0x17, 0x10, 0x87, 0x8e, 0x7c,
+	0xc2, 0xea, 0x45, 0x49, 0xf9, 0x6e, 0x2a, 0xa5, 0xa9, 0x25, 0xc1, 0xf8, 0x50, 0xc3, 0xec, 0x91,
+	0x4f, 0xec, 0x4d, 0x37, 0xb2, 0x62, 0xcd, 0x7f, 0x66, 0x60, 0x27, 0x75, 0xa6, 0x40, 0x77, 0xa0,
+	0xca, 0x31, 0xbd, 0x22, 0xdc, 0xe9, 0xfa, 0x23, 0xc6, 0x09, 0x55, 0xaf, 0x5e, 0xd1, 0xae, 0x28,
+	0xf1, 0x03, 0x2d, 0x4d, 0xd4, 0xfb, 0xfa, 0x6b, 0xeb, 0x3d, 0xb3, 0xa4, 0xde, 0xb3, 0xd1, 0x7a,
+	0x4f, 0xd6, 0xdd, 0xc6, 0x2a, 0x75, 0x97, 0x4b, 0xd6, 0x5d, 0xa4, 0xb6, 0xf3, 0xf1, 0xda, 0x7e,
+	0x1f, 0xf2, 0xba, 0x39, 0xca, 0x62, 0x2c, 0x9d, 0x1c, 0xc6, 0x53, 0x4a, 0x2b, 0x23, 0xfd, 0xd5,
+	0x36, 0x00, 0xf4, 0x18, 0xaa, 0x01, 0x79, 0xee, 0x88, 0xad, 0x1b, 0x0e, 0x58, 0x91, 0x63, 0x33,
+	0x20, 0xcf, 0xed, 0x51, 0xa0, 0x97, 0xe8, 0x43, 0xf8, 0x8e, 0x61, 0x52, 0xc7, 0x10, 0x62, 0x32,
+	0xcd, 0xaf, 0xb2, 0xec, 0xbb, 0x7b, 0x0a, 0x23, 0xcf, 0x74, 0x21, 0xf4, 0x3a, 0xcf, 0xce, 0xb3,
+	0x85, 0x42, 0xad, 0x78, 0x9e, 0x2d, 0x94, 0x6a, 0xe5, 0xf3, 0x6c, 0x61, 0xb3, 0x56, 0x39, 0xcf,
+	0x16, 0x2a, 0xb5, 0x6a, 0xf3, 0xb7, 0xeb, 0x70, 0x73, 0xe9, 0x70, 0xf2, 0x6d, 0xb9, 0xe5, 0xe6,
+	0x9f, 0x2c, 0xb8, 0xb9, 0x74, 0x76, 0x15, 0xd5, 0xaf, 0x3f, 0x20, 0x74, 0x24, 0x74, 0xe3, 0xda,
+	0x54, 0x52, 0x1d, 0x88, 0xd8, 0x34, 0xa4, 0x7a, 0xf3, 0x74, 0x1a, 0x9a, 0x1b, 0x42, 0x32, 0xdf,
+	0x60, 0x08, 0xf9, 0x35, 0xc0, 0xc1, 0xe2, 0xb1, 0xf6, 0x4d, 0xb6, 0xd6, 0x48, 0xe8, 0xb2, 0xf1,
+	0x02, 0x99, 0x6f, 0x59, 0x1b, 0x89, 0x96, 0x85, 0x7e, 0x02, 0x95, 0x99, 0x89, 0x3c, 0x7c, 0x6e,
+	0xc5, 0xc3, 0x6f, 0x4e, 0x71, 0x42, 0x83, 0x6e, 0x82, 0x88, 0x06, 0xe5, 0xca, 0x93, 0xba, 0xc3,
+	0xa2, 0x96, 0xc8, 0xfe, 0x5f, 0x36, 0x6a, 0xe9, 0xa5, 0xb0, 0xa2, 0x97, 0x92, 0x46, 0x49, 0x1f,
+	0x4f, 0x61, 0x5b, 0x8e, 0x5b, 0x7d, 0x82, 0x29, 0xbf, 0x24, 0x98, 0x2b, 0xae, 0xe2, 0x8a, 0x5c,
+	0x5b, 0x02, 0xfc, 0xd8, 0x60, 0x25, 0xe3, 0xfb, 0x90, 0x77, 0x09, 0xc7, 0x9e, 0xcf, 0xd2, 0xcb,
+	0x5f, 0x7d, 0xb9, 0x8b, 0xea, 0x7f, 0x8a, 0x27, 0x7e, 0x88, 0x5d, 0x66, 0x1b, 0x80, 0x88, 0x3b,
+	0xe6, 0xc2, 0x9a, 0xd7, 0x4b, 0x6a, 0xb8, 0xd6, 0x4b, 0x71, 0x58, 0xb9, 0x4f, 0xfd, 0x59, 0x5d,
+	0x2f, 0xa7, 0x51, 0x6b, 0xa5, 0xe0, 0x7e, 0xa4, 0x7e, 0xda, 0x25, 0x81, 0xd2, 0x0b, 0x74, 0x17,
+	0x6e, 0x48, 0x12, 0x91, 0x00, 0x84, 0x3a, 0x9e, 0x4b, 0x02, 0xee, 0xf1, 0x49, 0x7d, 0x53, 0xde,
+	0x3d, 0x12, 0xba, 0x4f, 0xa5, 0xaa, 0xad, 0x35, 0xe8, 0x53, 0xa8, 0xea, 0x9b, 0x9f, 0xbe, 0x69,
+	0x15, 0xe9, 0xb9, 0x95, 0xda, 0x68, 0x22, 0x4f, 0x9b, 0x7e, 0x8d, 0xcc, 0x0b, 0x57, 0x19, 0xc7,
+	0xd6, 0x68, 0x17, 0x72, 0x43, 0x3c, 0x62, 0xc4, 0xad, 0x57, 0xe5, 0xa4, 0xaa, 0x57, 0xe8, 0x06,
+	0x6c, 0xc8, 0xf8, 0xd6, 0x6b, 0xf2, 0xfc, 0x6a, 0x81, 0x3e, 0x83, 0x7d, 0x93, 0x1a, 0x0e, 0x0f,
+	0x9d, 0xae, 0x1f, 0x32, 0x22, 0x2f, 0x2a, 0x1c, 0xf1, 0xfa, 0x96, 0xdc, 0xd0, 0x7e, 0xe2, 0xae,
+	0x1e, 0xea, 0x7f, 0x9a, 0xce, 0xb2, 0x7f, 0x10, 0x57, 0xb5, 0x6b, 0x18, 0x3a, 0xe1, 0x03, 0x81,
+	0xef, 0x28, 0xf8, 0x3c, 0xb7, 0x4c, 0x8e, 0x29, 0x37, 0xfa, 0xda, 0xdc, 0x17, 0x02, 0x6f, 0xb8,
+	0x3b, 0xb0, 0xab, 0xf9, 0xe6, 0x37, 0xbd, 0xbd, 0x1a, 0xf1, 0xb6, 0x84, 0xcf, 0xed, 0xf8, 0xa7,
+	0xb0, 0x15, 0x4f, 0x57, 0x41, 0x78, 0x63, 0x35, 0xc2, 0x5a, 0x3f, 0x9a, 0xac, 0x82, 0xed, 0x11,
+	0x94, 0x29, 0xe1, 0x74, 0xe2, 0x0c, 0x43, 0xdf, 0xeb, 0x4e, 0xea, 0x3b, 0x92, 0xe8, 0xbb, 0x8b,
+	0x92, 0x56, 0x7c, 0x77, 0x4c, 0x9e, 0x4a, 0x53, 0xbb, 0x44, 0x67, 0x0b, 0xd4, 0x81, 0x1d, 0xc5,
+	0x43, 0x3e, 0x1f, 0x7a, 0x54, 0x7f, 0x1f, 0x89, 0x5a, 0xda, 0x5d, 0xb1, 0x96, 0xb6, 0x25, 0xfc,
+	0xa3, 0x29, 0x5a, 0xbe, 0x81, 0x7f, 0xcf, 0xc0, 0xde, 0x82, 0x2f, 0xd9, 0xe8, 0xec, 0x6e, 0xc5,
+	0x66, 0xf7, 0x37, 0xd8, 0x9e, 0x7a, 0xb0, 0x33, 0x57, 0x10, 0x8e, 0xc7, 0xc9, 0x40, 0xfc, 0x6d,
+	0x22, 0xe6, 0xaf, 0x93, 0xaf, 0x57, 0x16, 0x6d, 0x4e, 0x06, 0xf6, 0xf6, 0x38, 0x21, 0x63, 0xe8,
+	0x47, 0x90, 0x93, 0xbd, 0xcd, 0xfc, 0x07, 0xb2, 0xf0, 0x11, 0x79, 0x88, 0x39, 0x3e, 0xf3, 0xc3,
+	0x4b, 0x5b, 0xdb, 0xa3, 0x47, 0x50, 0x89, 0x0d, 0x0f, 0xe6, 0xcf, 0x8b, 0xd7, 0x33, 0x94, 0x23,
+	0x13, 0x05, 0x43, 0x3f, 0x4f, 0x8c, 0x98, 0x85, 0x25, 0x95, 0xbf, 0x68, 0xc4, 0x64, 0x73, 0x33,
+	0xe6, 0x99, 0xf7, 0xe2, 0x65, 0x63, 0xed, 0xcb, 0x97, 0x8d, 0xb5, 0xaf, 0x5e, 0x36, 0xac, 0x5f,
+	0x5d, 0x37, 0xac, 0xbf, 0x5c, 0x37, 0xac, 0xbf, 0x5d, 0x37, 0xac, 0x17, 0xd7, 0x0d, 0xeb, 0xdf,
+	0xd7, 0x0d, 0xeb, 0x3f, 0xd7, 0x8d, 0xb5, 0xaf, 0xae, 0x1b, 0xd6, 0x17, 0xaf, 0x1a, 0x6b, 0x2f,
+	0x5e, 0x35, 0xd6, 0xbe, 0x7c, 0xd5, 0x58, 0xfb, 0xec, 0xfe, 0x55, 0x38, 0x73, 0xeb, 0x85, 0x8b,
+	0xff, 0xa2, 0xfe, 0x80, 0x92, 0xa1, 0x5e, 0x5d, 0xe6, 0x64, 0xaa, 0xdd, 0xff, 0xdf, 0x00, 0x09,
+	0xb5, 0xea, 0x7a, 0xda, 0x16, 0x00, 0x00,
 }
 
 func (this *ReplicationTask) Equal(that interface{}) bool {
@@ -1572,6 +1685,22 @@ func (this *NamespaceTaskAttributes) Equal(that interface{}) bool {
 	if this.FailoverVersion != that1.FailoverVersion {
 		return false
 	}
+	if len(this.Aliases) != len(that1.Aliases) {
+		return false
+	}
+	for i := range this.Aliases {
+		if !this.Aliases[i].Equal(that1.Aliases[i]) {
+			return false
+		}
+	}
+	if len(this.DeadlineRules) != len(that1.DeadlineRules) {
+		return false
+	}
+	for i := range this.DeadlineRules {
+		if !this.DeadlineRules[i].Equal(that1.DeadlineRules[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *HistoryTaskAttributes) Equal(that interface{}) bool {
@@ -1784,6 +1913,58 @@ func (this *SyncActivityTaskAttributes) Equal(that interface{}) bool {
 	if !this.VersionHistory.Equal(that1.VersionHistory) {
 		return false
 	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if this.Stamp != that1.Stamp {
+		return false
+	}
+	if this.ScheduleToCloseTimeout != nil && that1.ScheduleToCloseTimeout != nil {
+		if *this.ScheduleToCloseTimeout != *that1.ScheduleToCloseTimeout {
+			return false
+		}
+	} else if this.ScheduleToCloseTimeout != nil {
+		return false
+	} else if that1.ScheduleToCloseTimeout != nil {
+		return false
+	}
+	if this.ScheduleToStartTimeout != nil && that1.ScheduleToStartTimeout != nil {
+		if *this.ScheduleToStartTimeout != *that1.ScheduleToStartTimeout {
+			return false
+		}
+	} else if this.ScheduleToStartTimeout != nil {
+		return false
+	} else if that1.ScheduleToStartTimeout != nil {
+		return false
+	}
+	if this.StartToCloseTimeout != nil && that1.StartToCloseTimeout != nil {
+		if *this.StartToCloseTimeout != *that1.StartToCloseTimeout {
+			return false
+		}
+	} else if this.StartToCloseTimeout != nil {
+		return false
+	} else if that1.StartToCloseTimeout != nil {
+		return false
+	}
+	if this.HeartbeatTimeout != nil && that1.HeartbeatTimeout != nil {
+		if *this.HeartbeatTimeout != *that1.HeartbeatTimeout {
+			return false
+		}
+	} else if this.HeartbeatTimeout != nil {
+		return false
+	} else if that1.HeartbeatTimeout != nil {
+		return false
+	}
+	if !this.RetryPolicy.Equal(that1.RetryPolicy) {
+		return false
+	}
+	if that1.RetryExpirationTime == nil {
+		if this.RetryExpirationTime != nil {
+			return false
+		}
+	} else if !this.RetryExpirationTime.Equal(*that1.RetryExpirationTime) {
+		return false
+	}
 	return true
 }
 func (this *HistoryTaskV2Attributes) Equal(that interface{}) bool {
@@ -1831,6 +2012,9 @@ func (this *HistoryTaskV2Attributes) Equal(that interface{}) bool {
 	if !this.NewRunEvents.Equal(that1.NewRunEvents) {
 		return false
 	}
+	if !this.DeadlineRules.Equal(that1.DeadlineRules) {
+		return false
+	}
 	return true
 }
 func (this *ReplicationTask) GoString() string {
@@ -1956,7 +2140,7 @@ func (this *NamespaceTaskAttributes) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 13)
 	s = append(s, "&repication.NamespaceTaskAttributes{")
 	s = append(s, "NamespaceOperation: "+fmt.Sprintf("%#v", this.NamespaceOperation)+",\n")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
@@ -1971,6 +2155,12 @@ func (this *NamespaceTaskAttributes) GoString() string {
 	}
 	s = append(s, "ConfigVersion: "+fmt.Sprintf("%#v", this.ConfigVersion)+",\n")
 	s = append(s, "FailoverVersion: "+fmt.Sprintf("%#v", this.FailoverVersion)+",\n")
+	if this.Aliases != nil {
+		s = append(s, "Aliases: "+fmt.Sprintf("%#v", this.Aliases)+",\n")
+	}
+	if this.DeadlineRules != nil {
+		s = append(s, "DeadlineRules: "+fmt.Sprintf("%#v", this.DeadlineRules)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2029,7 +2219,7 @@ func (this *SyncActivityTaskAttributes) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 18)
+	s := make([]string, 0, 26)
 	s = append(s, "&repication.SyncActivityTaskAttributes{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -2051,6 +2241,16 @@ func (this *SyncActivityTaskAttributes) GoString() string {
 	if this.VersionHistory != nil {
 		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
 	}
+	s = append(s, "Paused: "+fmt.Sprintf("%#v", this.Paused)+",\n")
+	s = append(s, "Stamp: "+fmt.Sprintf("%#v", this.Stamp)+",\n")
+	s = append(s, "ScheduleToCloseTimeout: "+fmt.Sprintf("%#v", this.ScheduleToCloseTimeout)+",\n")
+	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
+	s = append(s, "StartToCloseTimeout: "+fmt.Sprintf("%#v", this.StartToCloseTimeout)+",\n")
+	s = append(s, "HeartbeatTimeout: "+fmt.Sprintf("%#v", this.HeartbeatTimeout)+",\n")
+	if this.RetryPolicy != nil {
+		s = append(s, "RetryPolicy: "+fmt.Sprintf("%#v", this.RetryPolicy)+",\n")
+	}
+	s = append(s, "RetryExpirationTime: "+fmt.Sprintf("%#v", this.RetryExpirationTime)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2058,7 +2258,7 @@ func (this *HistoryTaskV2Attributes) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&repication.HistoryTaskV2Attributes{")
 	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
@@ -2073,6 +2273,9 @@ func (this *HistoryTaskV2Attributes) GoString() string {
 	if this.NewRunEvents != nil {
 		s = append(s, "NewRunEvents: "+fmt.Sprintf("%#v", this.NewRunEvents)+",\n")
 	}
+	if this.DeadlineRules != nil {
+		s = append(s, "DeadlineRules: "+fmt.Sprintf("%#v", this.DeadlineRules)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2481,6 +2684,34 @@ func (m *NamespaceTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeadlineRules) > 0 {
+		for iNdEx := len(m.DeadlineRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeadlineRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Aliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.FailoverVersion != 0 {
 		i = encodeVarintMessage(dAtA, i, uint64(m.FailoverVersion))
 		i--
@@ -2772,6 +3003,97 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.RetryExpirationTime != nil {
+		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintMessage(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.HeartbeatTimeout != nil {
+		n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintMessage(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.StartToCloseTimeout != nil {
+		n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintMessage(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.ScheduleToStartTimeout != nil {
+		n19, err19 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintMessage(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		n20, err20 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintMessage(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Stamp != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Stamp))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.VersionHistory != nil {
 		{
 			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
@@ -2821,22 +3143,22 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 		dAtA[i] = 0x52
 	}
 	if m.LastHeartbeatTime != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
-		if err18 != nil {
-			return 0, err18
+		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
+		if err24 != nil {
+			return 0, err24
 		}
-		i -= n18
-		i = encodeVarintMessage(dAtA, i, uint64(n18))
+		i -= n24
+		i = encodeVarintMessage(dAtA, i, uint64(n24))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.StartedTime != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err19 != nil {
-			return 0, err19
+		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err25 != nil {
+			return 0, err25
 		}
-		i -= n19
-		i = encodeVarintMessage(dAtA, i, uint64(n19))
+		i -= n25
+		i = encodeVarintMessage(dAtA, i, uint64(n25))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -2846,12 +3168,12 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 		dAtA[i] = 0x38
 	}
 	if m.ScheduledTime != nil {
-		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err20 != nil {
-			return 0, err20
+		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err26 != nil {
+			return 0, err26
 		}
-		i -= n20
-		i = encodeVarintMessage(dAtA, i, uint64(n20))
+		i -= n26
+		i = encodeVarintMessage(dAtA, i, uint64(n26))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -2909,6 +3231,18 @@ func (m *HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.DeadlineRules != nil {
+		{
+			size, err := m.DeadlineRules.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.NewRunEvents != nil {
 		{
 			size, err := m.NewRunEvents.MarshalToSizedBuffer(dAtA[:i])
@@ -3203,6 +3537,18 @@ func (m *NamespaceTaskAttributes) Size() (n int) {
 	if m.FailoverVersion != 0 {
 		n += 1 + sovMessage(uint64(m.FailoverVersion))
 	}
+	if len(m.Aliases) > 0 {
+		for _, e := range m.Aliases {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.DeadlineRules) > 0 {
+		for _, e := range m.DeadlineRules {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3367,6 +3713,36 @@ func (m *SyncActivityTaskAttributes) Size() (n int) {
 		l = m.VersionHistory.Size()
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if m.Paused {
+		n += 2
+	}
+	if m.Stamp != 0 {
+		n += 2 + sovMessage(uint64(m.Stamp))
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout)
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout)
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.StartToCloseTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout)
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.HeartbeatTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout)
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.RetryExpirationTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime)
+		n += 2 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -3405,6 +3781,10 @@ func (m *HistoryTaskV2Attributes) Size() (n int) {
 		l = m.NewRunEvents.Size()
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if m.DeadlineRules != nil {
+		l = m.DeadlineRules.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -3548,6 +3928,16 @@ func (this *NamespaceTaskAttributes) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForAliases := "[]*NamespaceAlias{"
+	for _, f := range this.Aliases {
+		repeatedStringForAliases += strings.Replace(fmt.Sprintf("%v", f), "NamespaceAlias", "v13.NamespaceAlias", 1) + ","
+	}
+	repeatedStringForAliases += "}"
+	repeatedStringForDeadlineRules := "[]*DeadlineRule{"
+	for _, f := range this.DeadlineRules {
+		repeatedStringForDeadlineRules += strings.Replace(fmt.Sprintf("%v", f), "DeadlineRule", "v14.DeadlineRule", 1) + ","
+	}
+	repeatedStringForDeadlineRules += "}"
 	s := strings.Join([]string{`&NamespaceTaskAttributes{`,
 		`NamespaceOperation:` + fmt.Sprintf("%v", this.NamespaceOperation) + `,`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
@@ -3556,6 +3946,8 @@ func (this *NamespaceTaskAttributes) String() string {
 		`ReplicationConfig:` + strings.Replace(fmt.Sprintf("%v", this.ReplicationConfig), "NamespaceReplicationConfig", "v12.NamespaceReplicationConfig", 1) + `,`,
 		`ConfigVersion:` + fmt.Sprintf("%v", this.ConfigVersion) + `,`,
 		`FailoverVersion:` + fmt.Sprintf("%v", this.FailoverVersion) + `,`,
+		`Aliases:` + repeatedStringForAliases + `,`,
+		`DeadlineRules:` + repeatedStringForDeadlineRules + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3572,8 +3964,8 @@ func (this *HistoryTaskAttributes) String() string {
 		`FirstEventId:` + fmt.Sprintf("%v", this.FirstEventId) + `,`,
 		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
 		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`History:` + strings.Replace(fmt.Sprintf("%v", this.History), "History", "v13.History", 1) + `,`,
-		`NewRunHistory:` + strings.Replace(fmt.Sprintf("%v", this.NewRunHistory), "History", "v13.History", 1) + `,`,
+		`History:` + strings.Replace(fmt.Sprintf("%v", this.History), "History", "v15.History", 1) + `,`,
+		`NewRunHistory:` + strings.Replace(fmt.Sprintf("%v", this.NewRunHistory), "History", "v15.History", 1) + `,`,
 		`NewRunEventStoreVersion:` + fmt.Sprintf("%v", this.NewRunEventStoreVersion) + `,`,
 		`}`,
 	}, "")
@@ -3621,11 +4013,19 @@ func (this *SyncActivityTaskAttributes) String() string {
 		`StartedId:` + fmt.Sprintf("%v", this.StartedId) + `,`,
 		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`LastHeartbeatTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Details:` + strings.Replace(fmt.Sprintf("%v", this.Details), "Payloads", "v14.Payloads", 1) + `,`,
+		`Details:` + strings.Replace(fmt.Sprintf("%v", this.Details), "Payloads", "v16.Payloads", 1) + `,`,
 		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`LastFailure:` + strings.Replace(fmt.Sprintf("%v", this.LastFailure), "Failure", "v15.Failure", 1) + `,`,
+		`LastFailure:` + strings.Replace(fmt.Sprintf("%v", this.LastFailure), "Failure", "v17.Failure", 1) + `,`,
 		`LastWorkerIdentity:` + fmt.Sprintf("%v", this.LastWorkerIdentity) + `,`,
-		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v16.VersionHistory", 1) + `,`,
+		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v18.VersionHistory", 1) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`Stamp:` + fmt.Sprintf("%v", this.Stamp) + `,`,
+		`ScheduleToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
+		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
+		`StartToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StartToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
+		`HeartbeatTimeout:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatTimeout), "Duration", "types.Duration", 1) + `,`,
+		`RetryPolicy:` + strings.Replace(fmt.Sprintf("%v", this.RetryPolicy), "RetryPolicy", "v16.RetryPolicy", 1) + `,`,
+		`RetryExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.RetryExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3636,7 +4036,7 @@ func (this *HistoryTaskV2Attributes) String() string {
 	}
 	repeatedStringForVersionHistoryItems := "[]*VersionHistoryItem{"
 	for _, f := range this.VersionHistoryItems {
-		repeatedStringForVersionHistoryItems += strings.Replace(fmt.Sprintf("%v", f), "VersionHistoryItem", "v16.VersionHistoryItem", 1) + ","
+		repeatedStringForVersionHistoryItems += strings.Replace(fmt.Sprintf("%v", f), "VersionHistoryItem", "v18.VersionHistoryItem", 1) + ","
 	}
 	repeatedStringForVersionHistoryItems += "}"
 	s := strings.Join([]string{`&HistoryTaskV2Attributes{`,
@@ -3645,8 +4045,9 @@ func (this *HistoryTaskV2Attributes) String() string {
 		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
 		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
 		`VersionHistoryItems:` + repeatedStringForVersionHistoryItems + `,`,
-		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v14.DataBlob", 1) + `,`,
-		`NewRunEvents:` + strings.Replace(fmt.Sprintf("%v", this.NewRunEvents), "DataBlob", "v14.DataBlob", 1) + `,`,
+		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v16.DataBlob", 1) + `,`,
+		`NewRunEvents:` + strings.Replace(fmt.Sprintf("%v", this.NewRunEvents), "DataBlob", "v16.DataBlob", 1) + `,`,
+		`DeadlineRules:` + strings.Replace(fmt.Sprintf("%v", this.DeadlineRules), "DeadlineRules", "v14.DeadlineRules", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4810,28 +5211,96 @@ func (m *NamespaceTaskAttributes) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessage(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
+			m.Aliases = append(m.Aliases, &v13.NamespaceAlias{})
+			if err := m.Aliases[len(m.Aliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadlineRules = append(m.DeadlineRules, &v14.DeadlineRule{})
+			if err := m.DeadlineRules[len(m.DeadlineRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
 	return nil
 }
 func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
@@ -5078,7 +5547,7 @@ func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.History == nil {
-				m.History = &v13.History{}
+				m.History = &v15.History{}
 			}
 			if err := m.History.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5114,7 +5583,7 @@ func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NewRunHistory == nil {
-				m.NewRunHistory = &v13.History{}
+				m.NewRunHistory = &v15.History{}
 			}
 			if err := m.NewRunHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5861,7 +6330,7 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Details == nil {
-				m.Details = &v14.Payloads{}
+				m.Details = &v16.Payloads{}
 			}
 			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5916,7 +6385,7 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastFailure == nil {
-				m.LastFailure = &v15.Failure{}
+				m.LastFailure = &v17.Failure{}
 			}
 			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5984,12 +6453,267 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.VersionHistory == nil {
-				m.VersionHistory = &v16.VersionHistory{}
+				m.VersionHistory = &v18.VersionHistory{}
 			}
 			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stamp", wireType)
+			}
+			m.Stamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stamp |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToCloseTimeout == nil {
+				m.ScheduleToCloseTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartToCloseTimeout == nil {
+				m.StartToCloseTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StartToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatTimeout == nil {
+				m.HeartbeatTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.HeartbeatTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &v16.RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryExpirationTime == nil {
+				m.RetryExpirationTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.RetryExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -6187,7 +6911,7 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionHistoryItems = append(m.VersionHistoryItems, &v16.VersionHistoryItem{})
+			m.VersionHistoryItems = append(m.VersionHistoryItems, &v18.VersionHistoryItem{})
 			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -6222,7 +6946,7 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Events == nil {
-				m.Events = &v14.DataBlob{}
+				m.Events = &v16.DataBlob{}
 			}
 			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6258,12 +6982,48 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NewRunEvents == nil {
-				m.NewRunEvents = &v14.DataBlob{}
+				m.NewRunEvents = &v16.DataBlob{}
 			}
 			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeadlineRules == nil {
+				m.DeadlineRules = &v14.DeadlineRules{}
+			}
+			if err := m.DeadlineRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -34,15 +34,22 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v1 "go.temporal.io/api/common/v1"
+	v11 "go.temporal.io/api/enums/v1"
+	v12 "go.temporal.io/server/api/enums/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -117,8 +124,132 @@ func (m *ParentExecutionInfo) GetInitiatedId() int64 {
 	return 0
 }
 
+// DeadlineRule fails a workflow run which has not recorded an event of event_type within timeout of its start.
+type DeadlineRule struct {
+	Name      string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	EventType v11.EventType `protobuf:"varint,2,opt,name=event_type,json=eventType,proto3,enum=temporal.api.enums.v1.EventType" json:"event_type,omitempty"`
+	// signal_name narrows WorkflowExecutionSignaled events down to the signals of that name.
+	SignalName string         `protobuf:"bytes,3,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	Timeout    *time.Duration `protobuf:"bytes,4,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+	// action is applied once the deadline is exceeded, the signal action sends a signal named after the rule.
+	Action v12.DeadlineAction `protobuf:"varint,5,opt,name=action,proto3,enum=temporal.server.api.enums.v1.DeadlineAction" json:"action,omitempty"`
+}
+
+func (m *DeadlineRule) Reset()      { *m = DeadlineRule{} }
+func (*DeadlineRule) ProtoMessage() {}
+func (*DeadlineRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4f1ca48d03c9ded, []int{1}
+}
+func (m *DeadlineRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadlineRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeadlineRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeadlineRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadlineRule.Merge(m, src)
+}
+func (m *DeadlineRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadlineRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadlineRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadlineRule proto.InternalMessageInfo
+
+func (m *DeadlineRule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeadlineRule) GetEventType() v11.EventType {
+	if m != nil {
+		return m.EventType
+	}
+	return v11.EVENT_TYPE_UNSPECIFIED
+}
+
+func (m *DeadlineRule) GetSignalName() string {
+	if m != nil {
+		return m.SignalName
+	}
+	return ""
+}
+
+func (m *DeadlineRule) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *DeadlineRule) GetAction() v12.DeadlineAction {
+	if m != nil {
+		return m.Action
+	}
+	return v12.DEADLINE_ACTION_UNSPECIFIED
+}
+
+// DeadlineRules wraps the rules so that setting no rules can be told apart from not setting the rules.
+type DeadlineRules struct {
+	Rules []*DeadlineRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (m *DeadlineRules) Reset()      { *m = DeadlineRules{} }
+func (*DeadlineRules) ProtoMessage() {}
+func (*DeadlineRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4f1ca48d03c9ded, []int{2}
+}
+func (m *DeadlineRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadlineRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeadlineRules.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeadlineRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadlineRules.Merge(m, src)
+}
+func (m *DeadlineRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadlineRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadlineRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadlineRules proto.InternalMessageInfo
+
+func (m *DeadlineRules) GetRules() []*DeadlineRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParentExecutionInfo)(nil), "temporal.server.api.workflow.v1.ParentExecutionInfo")
+	proto.RegisterType((*DeadlineRule)(nil), "temporal.server.api.workflow.v1.DeadlineRule")
+	proto.RegisterType((*DeadlineRules)(nil), "temporal.server.api.workflow.v1.DeadlineRules")
 }
 
 func init() {
@@ -126,26 +257,40 @@ func init() {
 }
 
 var fileDescriptor_c4f1ca48d03c9ded = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x31, 0x4e, 0xc3, 0x30,
-	0x18, 0x85, 0xfd, 0x53, 0x84, 0xd4, 0x94, 0x29, 0x2c, 0x15, 0x42, 0x3f, 0x05, 0x31, 0x94, 0x01,
-	0x47, 0x85, 0x91, 0x0d, 0x09, 0xa1, 0x6c, 0x28, 0x0b, 0x12, 0x0b, 0x32, 0x89, 0x5b, 0x59, 0x34,
-	0x76, 0xe4, 0x98, 0x94, 0x91, 0x23, 0x70, 0x0c, 0xce, 0xc1, 0xc4, 0x98, 0xb1, 0x23, 0x71, 0x16,
-	0xc6, 0x1e, 0x01, 0xa5, 0x25, 0xce, 0x80, 0xd8, 0xec, 0xe7, 0xe7, 0x4f, 0x9f, 0x9e, 0x77, 0x66,
-	0x78, 0x9a, 0x29, 0xcd, 0xe6, 0x41, 0xce, 0x75, 0xc1, 0x75, 0xc0, 0x32, 0x11, 0x2c, 0x94, 0x7e,
-	0x9a, 0xce, 0xd5, 0x22, 0x28, 0x26, 0x41, 0xca, 0xf3, 0x9c, 0xcd, 0x38, 0xcd, 0xb4, 0x32, 0xca,
-	0x3f, 0x6c, 0xeb, 0x74, 0x53, 0xa7, 0x2c, 0x13, 0xb4, 0xad, 0xd3, 0x62, 0xb2, 0x7f, 0xe2, 0x78,
-	0x0d, 0x28, 0x56, 0x69, 0xaa, 0xe4, 0x1f, 0xcc, 0xf1, 0x07, 0x78, 0x7b, 0xb7, 0x4c, 0x73, 0x69,
-	0xae, 0x5f, 0x78, 0xfc, 0x6c, 0x84, 0x92, 0xa1, 0x9c, 0x2a, 0xff, 0xc8, 0xdb, 0x95, 0x2c, 0xe5,
-	0x79, 0xc6, 0x62, 0xfe, 0x20, 0x92, 0x21, 0x8c, 0x60, 0xdc, 0x8f, 0x06, 0x2e, 0x0b, 0x13, 0xff,
-	0xc0, 0xeb, 0xbb, 0xeb, 0x70, 0x6b, 0xfd, 0xde, 0x05, 0xfe, 0x8d, 0xd7, 0xe7, 0x2d, 0x71, 0xd8,
-	0x1b, 0xc1, 0x78, 0x70, 0x7e, 0x4a, 0x9d, 0x73, 0x23, 0xbb, 0x51, 0xa2, 0xc5, 0x84, 0xde, 0xfd,
-	0x6a, 0x3b, 0x85, 0xa8, 0xfb, 0xdb, 0x98, 0x08, 0x29, 0x8c, 0x60, 0x86, 0x27, 0x8d, 0xc9, 0xf6,
-	0x08, 0xc6, 0xbd, 0x68, 0xe0, 0xb2, 0x30, 0xb9, 0x4a, 0xca, 0x0a, 0xc9, 0xb2, 0x42, 0xb2, 0xaa,
-	0x10, 0x5e, 0x2d, 0xc2, 0xbb, 0x45, 0xf8, 0xb4, 0x08, 0xa5, 0x45, 0xf8, 0xb2, 0x08, 0xdf, 0x16,
-	0xc9, 0xca, 0x22, 0xbc, 0xd5, 0x48, 0xca, 0x1a, 0xc9, 0xb2, 0x46, 0x72, 0x4f, 0x67, 0xaa, 0x13,
-	0x12, 0xea, 0x9f, 0xd9, 0x2f, 0xdb, 0xf3, 0xe3, 0xce, 0x7a, 0xb1, 0x8b, 0x9f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xd4, 0x7b, 0x8c, 0xc8, 0xa9, 0x01, 0x00, 0x00,
+	// 518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x4d, 0x5a, 0x94, 0x73, 0x61, 0x38, 0x96, 0x10, 0xa1, 0x4b, 0x1a, 0x21, 0x14,
+	0x04, 0x3d, 0x2b, 0x61, 0x42, 0x0c, 0x88, 0x92, 0x0a, 0x65, 0x41, 0xc8, 0xaa, 0x84, 0xc4, 0x12,
+	0x5d, 0xe3, 0x17, 0xeb, 0x84, 0x7d, 0x67, 0xd9, 0xe7, 0x94, 0x6e, 0x2c, 0xec, 0x8c, 0x7c, 0x04,
+	0x3e, 0x07, 0x13, 0x63, 0xc6, 0x6e, 0x10, 0x67, 0x61, 0xec, 0x47, 0x40, 0x77, 0x8e, 0x9d, 0x56,
+	0x14, 0xb1, 0xbd, 0xbc, 0xfc, 0xde, 0xdd, 0xef, 0xfe, 0x7a, 0xc6, 0x87, 0x1a, 0xe2, 0x44, 0xa5,
+	0x3c, 0xf2, 0x32, 0x48, 0x17, 0x90, 0x7a, 0x3c, 0x11, 0xde, 0x99, 0x4a, 0x3f, 0xcc, 0x23, 0x75,
+	0xe6, 0x2d, 0x86, 0x5e, 0x0c, 0x59, 0xc6, 0x43, 0x60, 0x49, 0xaa, 0xb4, 0x22, 0xdd, 0x0a, 0x67,
+	0x25, 0xce, 0x78, 0x22, 0x58, 0x85, 0xb3, 0xc5, 0xb0, 0x43, 0x43, 0xa5, 0xc2, 0x08, 0x3c, 0x8b,
+	0x9f, 0xe6, 0x73, 0x2f, 0xc8, 0x53, 0xae, 0x85, 0x92, 0xe5, 0x01, 0x9d, 0x83, 0x00, 0x12, 0x90,
+	0x01, 0xc8, 0x99, 0x80, 0xcc, 0x0b, 0x55, 0xa8, 0x6c, 0xdf, 0x56, 0x1b, 0xe4, 0x41, 0xad, 0x64,
+	0x5c, 0x66, 0x2a, 0x8e, 0x95, 0xfc, 0xcb, 0xa4, 0xf3, 0xf0, 0x1a, 0x05, 0x32, 0x8f, 0x33, 0x03,
+	0xc1, 0x02, 0xa4, 0x9e, 0xea, 0xf3, 0xa4, 0xe2, 0x1e, 0xdf, 0xf4, 0xc0, 0x1a, 0xaf, 0xd5, 0x2d,
+	0xdc, 0xff, 0x8e, 0xf0, 0xdd, 0xb7, 0x3c, 0x05, 0xa9, 0x8f, 0x3f, 0xc2, 0x2c, 0x37, 0xde, 0x13,
+	0x39, 0x57, 0xe4, 0x00, 0xef, 0x4b, 0x1e, 0x43, 0x96, 0xf0, 0x19, 0x4c, 0x45, 0xd0, 0x46, 0x3d,
+	0x34, 0x68, 0xf9, 0x6e, 0xdd, 0x9b, 0x04, 0xe4, 0x3e, 0x6e, 0xd5, 0x3f, 0xdb, 0x3b, 0xf6, 0xff,
+	0x6d, 0x83, 0xbc, 0xc6, 0x2d, 0xa8, 0x4e, 0x6c, 0x37, 0x7a, 0x68, 0xe0, 0x8e, 0x1e, 0xb1, 0x3a,
+	0x4b, 0x13, 0x62, 0xf9, 0x4e, 0xb6, 0x18, 0xb2, 0x77, 0x1b, 0xa7, 0x5a, 0xc1, 0xdf, 0xce, 0x1a,
+	0x13, 0x21, 0x85, 0x16, 0x5c, 0x43, 0x60, 0x4c, 0x9a, 0x3d, 0x34, 0x68, 0xf8, 0x6e, 0xdd, 0x9b,
+	0x04, 0xfd, 0xcf, 0x3b, 0x78, 0x7f, 0x0c, 0x3c, 0x88, 0x84, 0x04, 0x3f, 0x8f, 0x80, 0x10, 0xdc,
+	0x34, 0x26, 0x1b, 0x6b, 0x5b, 0x93, 0x17, 0x18, 0x6f, 0xa3, 0xb2, 0xbe, 0x77, 0x46, 0xbd, 0xeb,
+	0x46, 0x36, 0x24, 0x23, 0x74, 0x6c, 0xc0, 0x93, 0xf3, 0x04, 0xfc, 0x16, 0x54, 0x25, 0xe9, 0x62,
+	0x37, 0x13, 0xa1, 0xe4, 0xd1, 0xd4, 0x9e, 0xdd, 0xb0, 0x67, 0xe3, 0xb2, 0xf5, 0xc6, 0xdc, 0xf0,
+	0x0c, 0xdf, 0xd2, 0x22, 0x06, 0x95, 0x6b, 0x2b, 0xe9, 0x8e, 0xee, 0xb1, 0x72, 0x37, 0x58, 0xb5,
+	0x1b, 0x6c, 0xbc, 0xd9, 0x8d, 0xa3, 0xe6, 0xd7, 0x9f, 0x5d, 0xe4, 0x57, 0x3c, 0x19, 0xe3, 0x3d,
+	0x3e, 0xb3, 0x51, 0xed, 0x5a, 0xb1, 0x27, 0xec, 0xa6, 0xb5, 0xab, 0xfd, 0xaa, 0xc7, 0xbe, 0xb4,
+	0x33, 0xfe, 0x66, 0xb6, 0x7f, 0x82, 0x6f, 0x5f, 0x8d, 0x21, 0x23, 0xaf, 0xf0, 0x6e, 0x6a, 0x8a,
+	0x36, 0xea, 0x35, 0x06, 0xee, 0xe8, 0x90, 0xfd, 0x67, 0x99, 0xd9, 0xd5, 0x71, 0xbf, 0x9c, 0x3d,
+	0x0a, 0x96, 0x2b, 0xea, 0x5c, 0xac, 0xa8, 0x73, 0xb9, 0xa2, 0xe8, 0x53, 0x41, 0xd1, 0xb7, 0x82,
+	0xa2, 0x1f, 0x05, 0x45, 0xcb, 0x82, 0xa2, 0x5f, 0x05, 0x45, 0xbf, 0x0b, 0xea, 0x5c, 0x16, 0x14,
+	0x7d, 0x59, 0x53, 0x67, 0xb9, 0xa6, 0xce, 0xc5, 0x9a, 0x3a, 0xef, 0x59, 0xa8, 0xb6, 0xb7, 0x09,
+	0xf5, 0x8f, 0x8f, 0xed, 0x79, 0x55, 0x9f, 0xee, 0xd9, 0x8c, 0x9e, 0xfe, 0x19, 0x00, 0x95, 0x5a,
+	0xc7, 0xf6, 0x9f, 0x03, 0x00, 0x00,
 }
 
 func (this *ParentExecutionInfo) Equal(that interface{}) bool {
@@ -181,6 +326,77 @@ func (this *ParentExecutionInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DeadlineRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeadlineRule)
+	if !ok {
+		that2, ok := that.(DeadlineRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.EventType != that1.EventType {
+		return false
+	}
+	if this.SignalName != that1.SignalName {
+		return false
+	}
+	if this.Timeout != nil && that1.Timeout != nil {
+		if *this.Timeout != *that1.Timeout {
+			return false
+		}
+	} else if this.Timeout != nil {
+		return false
+	} else if that1.Timeout != nil {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	return true
+}
+func (this *DeadlineRules) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeadlineRules)
+	if !ok {
+		that2, ok := that.(DeadlineRules)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Rules) != len(that1.Rules) {
+		return false
+	}
+	for i := range this.Rules {
+		if !this.Rules[i].Equal(that1.Rules[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *ParentExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -196,6 +412,32 @@ func (this *ParentExecutionInfo) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *DeadlineRule) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&workflow.DeadlineRule{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "EventType: "+fmt.Sprintf("%#v", this.EventType)+",\n")
+	s = append(s, "SignalName: "+fmt.Sprintf("%#v", this.SignalName)+",\n")
+	s = append(s, "Timeout: "+fmt.Sprintf("%#v", this.Timeout)+",\n")
+	s = append(s, "Action: "+fmt.Sprintf("%#v", this.Action)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeadlineRules) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&workflow.DeadlineRules{")
+	if this.Rules != nil {
+		s = append(s, "Rules: "+fmt.Sprintf("%#v", this.Rules)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -258,6 +500,100 @@ func (m *ParentExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeadlineRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadlineRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadlineRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timeout != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintMessage(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SignalName) > 0 {
+		i -= len(m.SignalName)
+		copy(dAtA[i:], m.SignalName)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SignalName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EventType != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.EventType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeadlineRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadlineRules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadlineRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -293,6 +629,48 @@ func (m *ParentExecutionInfo) Size() (n int) {
 	return n
 }
 
+func (m *DeadlineRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.EventType != 0 {
+		n += 1 + sovMessage(uint64(m.EventType))
+	}
+	l = len(m.SignalName)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovMessage(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *DeadlineRules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -312,6 +690,35 @@ func (this *ParentExecutionInfo) String() string {
 	}, "")
 	return s
 }
+func (this *DeadlineRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeadlineRule{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`EventType:` + fmt.Sprintf("%v", this.EventType) + `,`,
+		`SignalName:` + fmt.Sprintf("%v", this.SignalName) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "types.Duration", 1) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeadlineRules) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRules := "[]*DeadlineRule{"
+	for _, f := range this.Rules {
+		repeatedStringForRules += strings.Replace(f.String(), "DeadlineRule", "DeadlineRule", 1) + ","
+	}
+	repeatedStringForRules += "}"
+	s := strings.Join([]string{`&DeadlineRules{`,
+		`Rules:` + repeatedStringForRules + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -492,6 +899,284 @@ func (m *ParentExecutionInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeadlineRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadlineRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadlineRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			m.EventType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventType |= v11.EventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= v12.DeadlineAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeadlineRules) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadlineRules: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadlineRules: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, &DeadlineRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
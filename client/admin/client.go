@@ -274,6 +274,187 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return client.RefreshWorkflowTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) RestoreWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RestoreWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RestoreWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RestoreWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) PauseActivity(
+	ctx context.Context,
+	request *adminservice.PauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseActivityResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PauseActivity(ctx, request, opts...)
+}
+
+func (c *clientImpl) UnpauseActivity(
+	ctx context.Context,
+	request *adminservice.UnpauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseActivityResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UnpauseActivity(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResetActivity(
+	ctx context.Context,
+	request *adminservice.ResetActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetActivityResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ResetActivity(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateActivityOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityOptionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateActivityOptionsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateActivityOptions(ctx, request, opts...)
+}
+
+func (c *clientImpl) StreamWorkflowExecutionHistory(
+	ctx context.Context,
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamWorkflowExecutionHistoryClient, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	// the stream lives as long as the caller's context, so no timeout is applied to it
+	return client.StreamWorkflowExecutionHistory(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteNamespaceResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteNamespace(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateNamespace(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateNamespace(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeNamespaceAliases(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceAliasesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceAliasesResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeNamespaceAliases(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.StartWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.StartWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.SignalWithStartWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SignalWithStartWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.SignalWithStartWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -288,6 +469,48 @@ func (c *clientImpl) ResendReplicationTasks(
 	return client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateDynamicConfig(
+	ctx context.Context,
+	request *adminservice.UpdateDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateDynamicConfigResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListDynamicConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
@@ -310,6 +310,225 @@ func (c *metricClient) RefreshWorkflowTasks(
 	return resp, err
 }
 
+func (c *metricClient) RestoreWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RestoreWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RestoreWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRestoreWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRestoreWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.RestoreWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRestoreWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPauseWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPauseWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.PauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPauseWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUnpauseWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUnpauseWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUnpauseWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PauseActivity(
+	ctx context.Context,
+	request *adminservice.PauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseActivityResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPauseActivityScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPauseActivityScope, metrics.ClientLatency)
+	resp, err := c.client.PauseActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPauseActivityScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UnpauseActivity(
+	ctx context.Context,
+	request *adminservice.UnpauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseActivityResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUnpauseActivityScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUnpauseActivityScope, metrics.ClientLatency)
+	resp, err := c.client.UnpauseActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUnpauseActivityScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ResetActivity(
+	ctx context.Context,
+	request *adminservice.ResetActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetActivityResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientResetActivityScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientResetActivityScope, metrics.ClientLatency)
+	resp, err := c.client.ResetActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientResetActivityScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateActivityOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityOptionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateActivityOptionsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateActivityOptionsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateActivityOptionsScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateActivityOptions(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateActivityOptionsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) StreamWorkflowExecutionHistory(
+	ctx context.Context,
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamWorkflowExecutionHistoryClient, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientStreamWorkflowExecutionHistoryScope, metrics.ClientRequests)
+	stream, err := c.client.StreamWorkflowExecutionHistory(ctx, request, opts...)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStreamWorkflowExecutionHistoryScope, metrics.ClientFailures)
+	}
+	return stream, err
+}
+
+func (c *metricClient) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteNamespaceResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteNamespaceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteNamespaceScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteNamespace(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteNamespaceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateNamespace(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateNamespaceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateNamespaceScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateNamespace(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateNamespaceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeNamespaceAliases(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceAliasesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceAliasesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeNamespaceAliasesScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeNamespaceAliasesScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeNamespaceAliases(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeNamespaceAliasesScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) StartWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.StartWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientStartWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientStartWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.StartWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStartWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.SignalWithStartWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SignalWithStartWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientSignalWithStartWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientSignalWithStartWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.SignalWithStartWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientSignalWithStartWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -326,3 +545,54 @@ func (c *metricClient) ResendReplicationTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.GetDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateDynamicConfig(
+	ctx context.Context,
+	request *adminservice.UpdateDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.ListDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
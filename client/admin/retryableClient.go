@@ -286,6 +286,215 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	return resp, err
 }
 
+func (c *retryableClient) RestoreWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RestoreWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RestoreWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.RestoreWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RestoreWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.PauseWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.UnpauseWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseActivity(
+	ctx context.Context,
+	request *adminservice.PauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseActivityResponse, error) {
+
+	var resp *adminservice.PauseActivityResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.PauseActivity(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UnpauseActivity(
+	ctx context.Context,
+	request *adminservice.UnpauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseActivityResponse, error) {
+
+	var resp *adminservice.UnpauseActivityResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UnpauseActivity(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ResetActivity(
+	ctx context.Context,
+	request *adminservice.ResetActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetActivityResponse, error) {
+
+	var resp *adminservice.ResetActivityResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ResetActivity(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateActivityOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityOptionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateActivityOptionsResponse, error) {
+
+	var resp *adminservice.UpdateActivityOptionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateActivityOptions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StreamWorkflowExecutionHistory(
+	ctx context.Context,
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamWorkflowExecutionHistoryClient, error) {
+
+	// only opening the stream is retried, errors received on the stream are returned to the caller
+	var stream adminservice.AdminService_StreamWorkflowExecutionHistoryClient
+	op := func() error {
+		var err error
+		stream, err = c.client.StreamWorkflowExecutionHistory(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return stream, err
+}
+
+func (c *retryableClient) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteNamespaceResponse, error) {
+
+	var resp *adminservice.DeleteNamespaceResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeleteNamespace(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateNamespace(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceResponse, error) {
+
+	var resp *adminservice.UpdateNamespaceResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateNamespace(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeNamespaceAliases(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceAliasesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceAliasesResponse, error) {
+
+	var resp *adminservice.DescribeNamespaceAliasesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeNamespaceAliases(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.StartWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.StartWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.StartWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.SignalWithStartWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SignalWithStartWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.SignalWithStartWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.SignalWithStartWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -301,3 +510,51 @@ func (c *retryableClient) ResendReplicationTasks(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+
+	var resp *adminservice.GetDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateDynamicConfig(
+	ctx context.Context,
+	request *adminservice.UpdateDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateDynamicConfigResponse, error) {
+
+	var resp *adminservice.UpdateDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+
+	var resp *adminservice.ListDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
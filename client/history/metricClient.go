@@ -626,3 +626,137 @@ func (c *metricClient) RefreshWorkflowTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) RestoreWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.RestoreWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RestoreWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientRestoreWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRestoreWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.RestoreWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRestoreWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.PauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientUnpauseWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUnpauseWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUnpauseWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PauseActivity(
+	ctx context.Context,
+	request *historyservice.PauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseActivityResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientPauseActivityScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPauseActivityScope, metrics.ClientLatency)
+	resp, err := c.client.PauseActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPauseActivityScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UnpauseActivity(
+	ctx context.Context,
+	request *historyservice.UnpauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseActivityResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientUnpauseActivityScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUnpauseActivityScope, metrics.ClientLatency)
+	resp, err := c.client.UnpauseActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUnpauseActivityScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ResetActivity(
+	ctx context.Context,
+	request *historyservice.ResetActivityRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ResetActivityResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientResetActivityScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientResetActivityScope, metrics.ClientLatency)
+	resp, err := c.client.ResetActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientResetActivityScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateActivityOptions(
+	ctx context.Context,
+	request *historyservice.UpdateActivityOptionsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateActivityOptionsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientUpdateActivityOptionsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUpdateActivityOptionsScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateActivityOptions(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUpdateActivityOptionsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) StreamWorkflowExecutionHistory(
+	ctx context.Context,
+	request *historyservice.StreamWorkflowExecutionHistoryRequest,
+	opts ...grpc.CallOption,
+) (historyservice.HistoryService_StreamWorkflowExecutionHistoryClient, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientStreamWorkflowExecutionHistoryScope, metrics.ClientRequests)
+	stream, err := c.client.StreamWorkflowExecutionHistory(ctx, request, opts...)
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientStreamWorkflowExecutionHistoryScope, metrics.ClientFailures)
+	}
+	return stream, err
+}
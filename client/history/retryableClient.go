@@ -626,3 +626,139 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) RestoreWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.RestoreWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RestoreWorkflowExecutionResponse, error) {
+
+	var resp *historyservice.RestoreWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RestoreWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseWorkflowExecutionResponse, error) {
+
+	var resp *historyservice.PauseWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+
+	var resp *historyservice.UnpauseWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseActivity(
+	ctx context.Context,
+	request *historyservice.PauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseActivityResponse, error) {
+
+	var resp *historyservice.PauseActivityResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.PauseActivity(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UnpauseActivity(
+	ctx context.Context,
+	request *historyservice.UnpauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseActivityResponse, error) {
+
+	var resp *historyservice.UnpauseActivityResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UnpauseActivity(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ResetActivity(
+	ctx context.Context,
+	request *historyservice.ResetActivityRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ResetActivityResponse, error) {
+
+	var resp *historyservice.ResetActivityResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ResetActivity(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateActivityOptions(
+	ctx context.Context,
+	request *historyservice.UpdateActivityOptionsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateActivityOptionsResponse, error) {
+
+	var resp *historyservice.UpdateActivityOptionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateActivityOptions(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StreamWorkflowExecutionHistory(
+	ctx context.Context,
+	request *historyservice.StreamWorkflowExecutionHistoryRequest,
+	opts ...grpc.CallOption,
+) (historyservice.HistoryService_StreamWorkflowExecutionHistoryClient, error) {
+
+	// only opening the stream is retried, errors received on the stream are returned to the caller
+	var stream historyservice.HistoryService_StreamWorkflowExecutionHistoryClient
+	op := func() error {
+		var err error
+		stream, err = c.client.StreamWorkflowExecutionHistory(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return stream, err
+}
@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/elasticsearch"
+	"go.temporal.io/server/common/largepayload"
 	l "go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/loggerimpl"
 	"go.temporal.io/server/common/log/tag"
@@ -45,6 +46,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/rpc"
@@ -60,10 +62,11 @@ import (
 
 type (
 	server struct {
-		name   string
-		cfg    *config.Config
-		doneC  chan struct{}
-		daemon common.Daemon
+		name        string
+		cfg         *config.Config
+		doneC       chan struct{}
+		daemon      common.Daemon
+		tlsProvider encryption.TLSConfigProvider
 	}
 )
 
@@ -102,6 +105,7 @@ func (s *server) Stop() {
 			log.Printf("timed out waiting for server %v to exit\n", s.name)
 		}
 	}
+	s.tlsProvider.Stop()
 }
 
 // startService starts a service with the given name and config
@@ -110,29 +114,51 @@ func (s *server) startService() common.Daemon {
 
 	params := resource.BootstrapParams{}
 	params.Name = s.name
-	params.Logger = loggerimpl.NewLogger(s.cfg.Log.NewZapLogger())
+	logLevels := s.cfg.Log.NewLevels()
+	params.Logger = loggerimpl.NewLogger(s.cfg.Log.NewZapLoggerWithLevels(logLevels))
 	params.PersistenceConfig = s.cfg.Persistence
+	if encryptionCfg := s.cfg.Persistence.PayloadEncryption; encryptionCfg != nil {
+		keyProvider, err := serialization.NewLocalFileKeyProvider(encryptionCfg.KeyFile)
+		if err != nil {
+			log.Fatalf("error initializing payload encryption: %v", err)
+		}
+		serialization.SetPayloadCodec(serialization.NewEnvelopeEncryptionCodec(keyProvider))
+	}
 
-	params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfigClient, params.Logger.WithTags(tag.Service(params.Name)), s.doneC)
+	params.DynamicConfig, err = s.newDynamicConfigClient(params.Logger.WithTags(tag.Service(params.Name)))
 	if err != nil {
-		log.Printf("error creating file based dynamic config client, use no-op config client instead. error: %v", err)
+		log.Printf("error creating dynamic config client, use no-op config client instead. error: %v", err)
 		params.DynamicConfig = dynamicconfig.NewNopClient()
 	}
 	dc := dynamicconfig.NewCollection(params.DynamicConfig, params.Logger)
 
+	componentLogLevels := make(map[string]interface{}, len(s.cfg.Log.ComponentLevels))
+	for component, level := range s.cfg.Log.ComponentLevels {
+		componentLogLevels[component] = level
+	}
+	go logLevels.WatchDynamicConfig(
+		dc.GetStringProperty(dynamicconfig.LogLevel, s.cfg.Log.Level),
+		dc.GetMapProperty(dynamicconfig.ComponentLogLevels, componentLogLevels),
+		dc.GetBoolProperty(dynamicconfig.RedactLogPayloads, s.cfg.Log.RedactPayloads),
+		s.doneC,
+	)
+
 	err = ringpop.ValidateRingpopConfig(&s.cfg.Global.Membership)
 	if err != nil {
 		log.Fatalf("Ringpop config validation error - %v", err)
 	}
 
-	tlsFactory, err := encryption.NewTLSConfigProviderFromConfig(s.cfg.Global.TLS)
+	svcCfg := s.cfg.Services[s.name]
+	params.MetricScope = svcCfg.Metrics.NewScope(params.Logger)
+	params.MetricsClient = metrics.NewClient(params.MetricScope, metrics.GetMetricsServiceIdx(params.Name, params.Logger))
+
+	tlsFactory, err := encryption.NewTLSConfigProviderFromConfig(s.cfg.Global.TLS, params.MetricsClient, params.Logger, nil)
 
 	if err != nil {
 		log.Fatalf("error initializing TLS provider: %v", err)
 	}
+	s.tlsProvider = tlsFactory
 
-	svcCfg := s.cfg.Services[s.name]
-	params.MetricScope = svcCfg.Metrics.NewScope(params.Logger)
 	params.RPCFactory = rpc.NewFactory(&svcCfg.RPC, params.Name, params.Logger, tlsFactory)
 
 	// Ringpop uses a different port to register handlers, this map is needed to resolve
@@ -157,8 +183,6 @@ func (s *server) startService() common.Daemon {
 
 	params.DCRedirectionPolicy = s.cfg.DCRedirectionPolicy
 
-	params.MetricsClient = metrics.NewClient(params.MetricScope, metrics.GetMetricsServiceIdx(params.Name, params.Logger))
-
 	clusterMetadata := s.cfg.ClusterMetadata
 
 	// This call performs a config check against the configured persistence store for immutable cluster metadata.
@@ -250,12 +274,28 @@ func (s *server) startService() common.Daemon {
 
 	params.ArchiverProvider = provider.NewArchiverProvider(s.cfg.Archival.History.Provider, s.cfg.Archival.Visibility.Provider)
 
+	params.LargePayloadStore, err = largepayload.NewStore(&s.cfg.LargePayload)
+	if err != nil {
+		log.Fatalf("error creating large payload store: %v", err)
+	}
+
 	params.PersistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicconfig.TransactionSizeLimit, common.DefaultTransactionSizeLimit)
 
 	params.Authorizer = authorization.NewNopAuthorizer()
 
 	params.Logger.Info("Starting service " + s.name)
 
+	if s.name == primitives.FrontendService {
+		// default values of dynamic config keys are registered when the configs using them are built, the configs of
+		// the other services are built as well so the admin dynamic config API reports the defaults of all services
+		history.NewConfig(dc,
+			params.PersistenceConfig.NumHistoryShards,
+			params.PersistenceConfig.DefaultStoreType(),
+			params.PersistenceConfig.IsAdvancedVisibilityConfigExist())
+		matching.NewConfig(dc)
+		worker.NewConfig(&params)
+	}
+
 	var daemon common.Daemon
 
 	switch s.name {
@@ -277,6 +317,18 @@ func (s *server) startService() common.Daemon {
 	return daemon
 }
 
+// newDynamicConfigClient creates the dynamic config client, preferring the http provider when it is configured
+func (s *server) newDynamicConfigClient(logger l.Logger) (dynamicconfig.Client, error) {
+	if s.cfg.DynamicConfigHTTPProvider != nil {
+		provider, err := dynamicconfig.NewHTTPProvider(s.cfg.DynamicConfigHTTPProvider, logger)
+		if err != nil {
+			return nil, err
+		}
+		return dynamicconfig.NewProviderClient(provider, logger, s.doneC)
+	}
+	return dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfigClient, logger, s.doneC)
+}
+
 func immutableClusterMetadataInitialization(
 	logger l.Logger,
 	dc *dynamicconfig.Collection,
@@ -0,0 +1,200 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package azblobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	defaultEndpointFormat = "https://%s.blob.core.windows.net"
+	accountKeyEnvVar      = "AZURE_STORAGE_KEY"
+	maxDownloadRetries    = 3
+)
+
+var (
+	errEmptyAccountName   = errors.New("empty azure storage account name")
+	errMissingCredentials = errors.New("azure storage account key or SAS token is required")
+	errInvalidEndpoint    = errors.New("endpoint must be an absolute http or https URL")
+	errContainerNotExists = errors.New("requested container does not exist")
+	errBlobNotExists      = errors.New("requested blob does not exist")
+)
+
+type (
+	// storageClient is the subset of blob storage operations used by the archivers.
+	// The container is the hostname of the URI and blob names are relative to the URI path.
+	storageClient interface {
+		Upload(ctx context.Context, URI archiver.URI, blobName string, data []byte) error
+		Get(ctx context.Context, URI archiver.URI, blobName string) ([]byte, error)
+		Exist(ctx context.Context, URI archiver.URI, blobName string) (bool, error)
+		ContainerExist(ctx context.Context, URI archiver.URI) error
+		List(ctx context.Context, URI archiver.URI, prefix string, marker string, maxResults int) ([]string, string, error)
+	}
+
+	azureStorage struct {
+		serviceURL azblob.ServiceURL
+	}
+)
+
+// newStorageClient returns a storageClient of the configured storage account. The account key is read from
+// the AZURE_STORAGE_KEY environment variable if neither an account key nor a SAS token are configured.
+func newStorageClient(config *config.AzblobArchiver) (storageClient, error) {
+	if config.AccountName == "" {
+		return nil, errEmptyAccountName
+	}
+
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf(defaultEndpointFormat, config.AccountName)
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil || (endpointURL.Scheme != "http" && endpointURL.Scheme != "https") || endpointURL.Host == "" {
+		return nil, errInvalidEndpoint
+	}
+
+	accountKey := config.AccountKey
+	if accountKey == "" && config.SASToken == "" {
+		accountKey = os.Getenv(accountKeyEnvVar)
+	}
+	var credential azblob.Credential
+	switch {
+	case accountKey != "":
+		credential, err = azblob.NewSharedKeyCredential(config.AccountName, accountKey)
+		if err != nil {
+			return nil, err
+		}
+	case config.SASToken != "":
+		credential = azblob.NewAnonymousCredential()
+		endpointURL.RawQuery = strings.TrimPrefix(config.SASToken, "?")
+	default:
+		return nil, errMissingCredentials
+	}
+
+	pipeline := azblob.NewPipeline(credential, azblob.PipelineOptions{})
+	return &azureStorage{serviceURL: azblob.NewServiceURL(*endpointURL, pipeline)}, nil
+}
+
+func (s *azureStorage) Upload(ctx context.Context, URI archiver.URI, blobName string, data []byte) error {
+	blobURL := s.containerURL(URI).NewBlockBlobURL(blobPath(URI, blobName))
+	_, err := azblob.UploadBufferToBlockBlob(ctx, data, blobURL, azblob.UploadToBlockBlobOptions{})
+	return err
+}
+
+func (s *azureStorage) Get(ctx context.Context, URI archiver.URI, blobName string) ([]byte, error) {
+	blobURL := s.containerURL(URI).NewBlobURL(blobPath(URI, blobName))
+	resp, err := blobURL.Download(ctx, 0, azblob.CountToEnd, azblob.BlobAccessConditions{}, false)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, errBlobNotExists
+		}
+		return nil, err
+	}
+	body := resp.Body(azblob.RetryReaderOptions{MaxRetryRequests: maxDownloadRetries})
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+func (s *azureStorage) Exist(ctx context.Context, URI archiver.URI, blobName string) (bool, error) {
+	blobURL := s.containerURL(URI).NewBlobURL(blobPath(URI, blobName))
+	if _, err := blobURL.GetProperties(ctx, azblob.BlobAccessConditions{}); err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *azureStorage) ContainerExist(ctx context.Context, URI archiver.URI) error {
+	if _, err := s.containerURL(URI).GetProperties(ctx, azblob.LeaseAccessConditions{}); err != nil {
+		if isNotFoundError(err) {
+			return errContainerNotExists
+		}
+		return err
+	}
+	return nil
+}
+
+// List returns the names of the blobs starting with prefix, relative to the URI path, and the marker of the
+// next page which is empty for the last page.
+func (s *azureStorage) List(ctx context.Context, URI archiver.URI, prefix string, marker string, maxResults int) ([]string, string, error) {
+	options := azblob.ListBlobsSegmentOptions{Prefix: blobPath(URI, prefix)}
+	if maxResults > 0 {
+		options.MaxResults = int32(maxResults)
+	}
+	resp, err := s.containerURL(URI).ListBlobsFlatSegment(ctx, azblob.Marker{Val: &marker}, options)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, "", errContainerNotExists
+		}
+		return nil, "", err
+	}
+
+	basePath := blobPath(URI, "")
+	blobNames := make([]string, 0, len(resp.Segment.BlobItems))
+	for _, item := range resp.Segment.BlobItems {
+		blobNames = append(blobNames, strings.TrimPrefix(item.Name, basePath))
+	}
+	var nextMarker string
+	if resp.NextMarker.Val != nil {
+		nextMarker = *resp.NextMarker.Val
+	}
+	return blobNames, nextMarker, nil
+}
+
+func (s *azureStorage) containerURL(URI archiver.URI) azblob.ContainerURL {
+	return s.serviceURL.NewContainerURL(URI.Hostname())
+}
+
+func blobPath(URI archiver.URI, blobName string) string {
+	path := strings.Trim(URI.Path(), "/")
+	if path == "" {
+		return blobName
+	}
+	return path + "/" + blobName
+}
+
+func isNotFoundError(err error) bool {
+	storageErr, ok := err.(azblob.StorageError)
+	if !ok {
+		return false
+	}
+	switch storageErr.ServiceCode() {
+	case azblob.ServiceCodeBlobNotFound, azblob.ServiceCodeContainerNotFound:
+		return true
+	}
+	return storageErr.Response() != nil && storageErr.Response().StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,183 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package azblobstore
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const testAccountName = "devstoreaccount1"
+
+type (
+	// fakeBlobServer is an in-process blob service addressed like the Azurite emulator,
+	// with the account name as the first path segment
+	fakeBlobServer struct {
+		*httptest.Server
+
+		sync.Mutex
+		containers     map[string]map[string][]byte
+		authorizations []string
+	}
+
+	enumerationResults struct {
+		XMLName    xml.Name   `xml:"EnumerationResults"`
+		Prefix     string     `xml:"Prefix"`
+		Marker     string     `xml:"Marker"`
+		MaxResults int        `xml:"MaxResults,omitempty"`
+		Blobs      []blobItem `xml:"Blobs>Blob"`
+		NextMarker string     `xml:"NextMarker"`
+	}
+
+	blobItem struct {
+		Name string `xml:"Name"`
+	}
+)
+
+func newFakeBlobServer(containers ...string) *fakeBlobServer {
+	s := &fakeBlobServer{containers: make(map[string]map[string][]byte)}
+	for _, container := range containers {
+		s.containers[container] = make(map[string][]byte)
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// endpoint returns the blob service endpoint of the test account
+func (s *fakeBlobServer) endpoint() string {
+	return s.URL + "/" + testAccountName
+}
+
+func (s *fakeBlobServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+	s.authorizations = append(s.authorizations, r.Header.Get("Authorization"))
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 3)
+	if len(parts) < 2 || parts[0] != testAccountName {
+		writeBlobError(w, r, http.StatusBadRequest, "InvalidUri")
+		return
+	}
+	blobs, ok := s.containers[parts[1]]
+	if !ok {
+		writeBlobError(w, r, http.StatusNotFound, "ContainerNotFound")
+		return
+	}
+	if len(parts) == 2 || parts[2] == "" {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Query().Get("comp") == "list":
+			s.list(w, r, blobs)
+		case r.Method == http.MethodGet || r.Method == http.MethodHead:
+			w.WriteHeader(http.StatusOK)
+		default:
+			writeBlobError(w, r, http.StatusMethodNotAllowed, "UnsupportedHttpVerb")
+		}
+		return
+	}
+
+	name := parts[2]
+	switch r.Method {
+	case http.MethodPut:
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeBlobError(w, r, http.StatusInternalServerError, "InternalError")
+			return
+		}
+		blobs[name] = data
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodGet, http.MethodHead:
+		data, ok := blobs[name]
+		if !ok {
+			writeBlobError(w, r, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	default:
+		writeBlobError(w, r, http.StatusMethodNotAllowed, "UnsupportedHttpVerb")
+	}
+}
+
+// list implements List Blobs where the marker is the name of the last blob of the previous page
+func (s *fakeBlobServer) list(w http.ResponseWriter, r *http.Request, blobs map[string][]byte) {
+	query := r.URL.Query()
+	result := enumerationResults{
+		Prefix: query.Get("prefix"),
+		Marker: query.Get("marker"),
+	}
+	result.MaxResults, _ = strconv.Atoi(query.Get("maxresults"))
+
+	var names []string
+	for name := range blobs {
+		if strings.HasPrefix(name, result.Prefix) && name > result.Marker {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if result.MaxResults > 0 && len(names) > result.MaxResults {
+		names = names[:result.MaxResults]
+		result.NextMarker = names[len(names)-1]
+	}
+	for _, name := range names {
+		result.Blobs = append(result.Blobs, blobItem{Name: name})
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+func (s *fakeBlobServer) blobNames(container string) []string {
+	s.Lock()
+	defer s.Unlock()
+	var names []string
+	for name := range s.containers[container] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *fakeBlobServer) putBlob(container, name string, data []byte) {
+	s.Lock()
+	defer s.Unlock()
+	s.containers[container][name] = data
+}
+
+func writeBlobError(w http.ResponseWriter, r *http.Request, status int, code string) {
+	w.Header().Set("x-ms-error-code", code)
+	w.WriteHeader(status)
+	if r.Method != http.MethodHead {
+		_, _ = w.Write([]byte(xml.Header + "<Error><Code>" + code + "</Code><Message>" + code + "</Message></Error>"))
+	}
+}
@@ -0,0 +1,416 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package azblobstore
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	// URIScheme is the scheme for the azure blob storage implementation
+	URIScheme = "azblob"
+
+	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
+	errEncodeHistory      = "failed to encode history batches"
+	errWriteBlob          = "failed to write history to azure blob storage"
+	listPageSize          = 1000
+)
+
+var (
+	errUploadNonRetryable = errors.New("upload non-retryable error")
+)
+
+type (
+	historyArchiver struct {
+		container *archiver.HistoryBootstrapContainer
+		storage   storageClient
+
+		// only set in test code
+		historyIterator archiver.HistoryIterator
+	}
+
+	progress struct {
+		CurrentPageNumber int
+		IteratorState     []byte
+	}
+
+	getHistoryToken struct {
+		CloseFailoverVersion int64
+		HighestPart          int
+		CurrentPart          int
+		BatchIdxOffset       int
+	}
+)
+
+// NewHistoryArchiver creates a new azure blob storage HistoryArchiver
+func NewHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	config *config.AzblobArchiver,
+) (archiver.HistoryArchiver, error) {
+	storage, err := newStorageClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return newHistoryArchiver(container, nil, storage), nil
+}
+
+func newHistoryArchiver(container *archiver.HistoryBootstrapContainer, historyIterator archiver.HistoryIterator, storage storageClient) *historyArchiver {
+	return &historyArchiver{
+		container:       container,
+		storage:         storage,
+		historyIterator: historyIterator,
+	}
+}
+
+// Archive is used to archive a workflow history. When the context expires the method should stop trying to archive.
+// Implementors are free to archive however they want, including implementing retries of sub-operations. The URI defines
+// the resource that histories should be archived into. The implementor gets to determine how to interpret the URI.
+// The Archive method may or may not be automatically retried by the caller. The ArchiveOptions are used
+// to interact with these retries including giving the implementor the ability to cancel retries and record progress
+// between retry attempts.
+// This method will be invoked after a workflow passes its retention period.
+func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request *archiver.ArchiveHistoryRequest, opts ...archiver.ArchiveOption) (err error) {
+	scope := h.container.MetricsClient.Scope(metrics.HistoryArchiverScope, metrics.NamespaceTag(request.Namespace))
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	sw := scope.StartTimer(metrics.ServiceLatency)
+	defer func() {
+		sw.Stop()
+		if err != nil {
+			if err != errUploadNonRetryable {
+				scope.IncCounter(metrics.HistoryArchiverArchiveTransientErrorCount)
+				return
+			}
+
+			scope.IncCounter(metrics.HistoryArchiverArchiveNonRetryableErrorCount)
+			if featureCatalog.NonRetryableError != nil {
+				err = featureCatalog.NonRetryableError()
+			}
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveHistoryRequestAndURI(h.container.Logger, request, URI.String())
+
+	if err := h.validateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return errUploadNonRetryable
+	}
+
+	if err := archiver.ValidateHistoryArchiveRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return errUploadNonRetryable
+	}
+
+	var totalUploadSize int64
+	historyIterator := h.historyIterator
+	var progress progress
+	if historyIterator == nil { // will only be set by testing code
+		historyIterator = loadHistoryIterator(ctx, request, h.container.HistoryV2Manager, featureCatalog, &progress)
+	}
+
+	encoder := codec.NewJSONPBEncoder()
+
+	for historyIterator.HasNext() {
+		part := progress.CurrentPageNumber
+		historyBlob, err := getNextHistoryBlob(ctx, historyIterator)
+		if err != nil {
+			logger := logger.WithTags(tag.ArchivalArchiveFailReason(archiver.ErrReasonReadHistory), tag.Error(err))
+			if !common.IsPersistenceTransientError(err) {
+				logger.Error(archiver.ArchiveNonRetryableErrorMsg)
+				return errUploadNonRetryable
+			}
+			logger.Error(archiver.ArchiveTransientErrorMsg)
+			return err
+		}
+
+		if historyMutated(request, historyBlob.Body, historyBlob.Header.IsLast) {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonHistoryMutated))
+			return archiver.ErrHistoryMutated
+		}
+
+		encodedHistoryPart, err := encoder.EncodeHistories(historyBlob.Body)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+			return errUploadNonRetryable
+		}
+
+		checksum, err := archiver.HistoryChecksum(historyBlob.Body)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+			return errUploadNonRetryable
+		}
+
+		blobName := constructHistoryBlobName(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, part)
+		if exist, _ := h.storage.Exist(ctx, URI, blobName); !exist {
+			if err := h.storage.Upload(ctx, URI, blobName, encodedHistoryPart); err != nil {
+				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteBlob), tag.Error(err))
+				return err
+			}
+			if err := h.storage.Upload(ctx, URI, constructChecksumBlobName(blobName), []byte(checksum)); err != nil {
+				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteBlob), tag.Error(err))
+				return err
+			}
+
+			totalUploadSize = totalUploadSize + int64(binary.Size(encodedHistoryPart))
+		}
+
+		if err := saveHistoryIteratorState(ctx, featureCatalog, historyIterator, part, &progress); err != nil {
+			logger.Warn("failed to record archival progress", tag.Error(err))
+		}
+	}
+
+	scope.AddCounter(metrics.HistoryArchiverTotalUploadSize, totalUploadSize)
+	scope.AddCounter(metrics.HistoryArchiverHistorySize, totalUploadSize)
+	scope.IncCounter(metrics.HistoryArchiverArchiveSuccessCount)
+	return nil
+}
+
+// Get is used to access an archived history. When context expires method should stop trying to fetch history.
+// The URI identifies the resource from which history should be accessed and it is up to the implementor to interpret this URI.
+// This method should thrift errors - see filestore as an example.
+func (h *historyArchiver) Get(ctx context.Context, URI archiver.URI, request *archiver.GetHistoryRequest) (*archiver.GetHistoryResponse, error) {
+	if err := h.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateGetRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidGetHistoryRequest.Error())
+	}
+
+	var token *getHistoryToken
+	if request.NextPageToken != nil {
+		var err error
+		token, err = deserializeGetHistoryToken(request.NextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	} else {
+		var err error
+		token, err = h.getHighestVersion(ctx, URI, request)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		if token == nil {
+			return nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
+		}
+	}
+
+	response := &archiver.GetHistoryResponse{}
+	numOfEvents := 0
+	encoder := codec.NewJSONPBEncoder()
+
+outer:
+	for token.CurrentPart <= token.HighestPart {
+		blobName := constructHistoryBlobName(request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion, token.CurrentPart)
+		encodedHistoryBatches, err := h.storage.Get(ctx, URI, blobName)
+		if err != nil {
+			if err == errBlobNotExists {
+				return nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
+			}
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+
+		batches, err := encoder.DecodeHistories(encodedHistoryBatches)
+		if err != nil {
+			return nil, serviceerror.NewDataLoss(err.Error())
+		}
+		checksum, err := h.getChecksum(ctx, URI, blobName)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		if err := archiver.ValidateHistoryChecksum(batches, checksum); err != nil {
+			return nil, serviceerror.NewDataLoss(err.Error())
+		}
+		// trim the batches in the beginning based on token.BatchIdxOffset
+		batches = batches[token.BatchIdxOffset:]
+
+		for idx, batch := range batches {
+			response.HistoryBatches = append(response.HistoryBatches, batch)
+			token.BatchIdxOffset++
+			numOfEvents += len(batch.Events)
+
+			if numOfEvents >= request.PageSize {
+				if idx == len(batches)-1 {
+					// handle the edge case where page size is met after adding the last batch
+					token.BatchIdxOffset = 0
+					token.CurrentPart++
+				}
+				break outer
+			}
+		}
+
+		// reset the offset to 0 as we will read a new part
+		token.BatchIdxOffset = 0
+		token.CurrentPart++
+	}
+
+	if token.CurrentPart <= token.HighestPart {
+		nextToken, err := serializeToken(token)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.NextPageToken = nextToken
+	}
+
+	return response, nil
+}
+
+// ValidateURI is used to define what a valid URI for an implementation is.
+func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
+	if err := h.validateURI(URI); err != nil {
+		return err
+	}
+	return h.storage.ContainerExist(context.Background(), URI)
+}
+
+func (h *historyArchiver) validateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+
+	if URI.Hostname() == "" {
+		return archiver.ErrInvalidURI
+	}
+
+	return nil
+}
+
+// getChecksum returns the checksum of the history part, or an empty checksum
+// for parts archived without one
+func (h *historyArchiver) getChecksum(ctx context.Context, URI archiver.URI, blobName string) (string, error) {
+	checksum, err := h.storage.Get(ctx, URI, constructChecksumBlobName(blobName))
+	if err != nil {
+		if err == errBlobNotExists {
+			return "", nil
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(checksum)), nil
+}
+
+// getHighestVersion returns the token of the first part of the history with the highest close failover version,
+// or the requested close failover version, and nil if no such history is archived.
+func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.URI, request *archiver.GetHistoryRequest) (*getHistoryToken, error) {
+	prefix := constructHistoryBlobPrefix(request.NamespaceID, request.WorkflowID, request.RunID)
+
+	var token *getHistoryToken
+	var marker string
+	for {
+		blobNames, nextMarker, err := h.storage.List(ctx, URI, prefix, marker, listPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, blobName := range blobNames {
+			version, part, err := extractHistoryVersionAndPart(blobName)
+			if err != nil || (request.CloseFailoverVersion != nil && version != *request.CloseFailoverVersion) {
+				continue
+			}
+
+			if token == nil || version > token.CloseFailoverVersion {
+				token = &getHistoryToken{
+					CloseFailoverVersion: version,
+					HighestPart:          part,
+					CurrentPart:          part,
+				}
+				continue
+			}
+			if version == token.CloseFailoverVersion {
+				if part > token.HighestPart {
+					token.HighestPart = part
+				}
+				if part < token.CurrentPart {
+					token.CurrentPart = part
+				}
+			}
+		}
+
+		if nextMarker == "" {
+			return token, nil
+		}
+		marker = nextMarker
+	}
+}
+
+func getNextHistoryBlob(ctx context.Context, historyIterator archiver.HistoryIterator) (*archiverspb.HistoryBlob, error) {
+	historyBlob, err := historyIterator.Next()
+	op := func() error {
+		historyBlob, err = historyIterator.Next()
+		return err
+	}
+	for err != nil {
+		if !common.IsPersistenceTransientError(err) {
+			return nil, err
+		}
+		if contextExpired(ctx) {
+			return nil, archiver.ErrContextTimeout
+		}
+		err = backoff.Retry(op, common.CreatePersistanceRetryPolicy(), common.IsPersistenceTransientError)
+	}
+	return historyBlob, nil
+}
+
+func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, historyManager persistence.HistoryManager, featureCatalog *archiver.ArchiveFeatureCatalog, progress *progress) archiver.HistoryIterator {
+	if featureCatalog.ProgressManager != nil && featureCatalog.ProgressManager.HasProgress(ctx) {
+		if err := featureCatalog.ProgressManager.LoadProgress(ctx, progress); err == nil {
+			historyIterator, err := archiver.NewHistoryIteratorFromState(request, historyManager, targetHistoryBlobSize, progress.IteratorState)
+			if err == nil {
+				return historyIterator
+			}
+		}
+		// start over when the recorded progress cannot be resumed
+		progress.CurrentPageNumber = 0
+		progress.IteratorState = nil
+	}
+	historyIterator, _ := archiver.NewHistoryIteratorFromState(request, historyManager, targetHistoryBlobSize, nil)
+	return historyIterator
+}
+
+// saveHistoryIteratorState advances the progress to the next part, and records it if a progress manager is available
+func saveHistoryIteratorState(ctx context.Context, featureCatalog *archiver.ArchiveFeatureCatalog, historyIterator archiver.HistoryIterator, currentPartNum int, progress *progress) error {
+	progress.CurrentPageNumber = currentPartNum + 1
+	if featureCatalog.ProgressManager == nil {
+		return nil
+	}
+	state, err := historyIterator.GetState()
+	if err != nil {
+		return err
+	}
+	progress.IteratorState = state
+	return featureCatalog.ProgressManager.RecordProgress(ctx, progress)
+}
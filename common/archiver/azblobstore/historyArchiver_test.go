@@ -0,0 +1,516 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package azblobstore
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.uber.org/zap"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	testNamespaceID          = "test-namespace-id"
+	testNamespace            = "test-namespace"
+	testWorkflowID           = "test-workflow-id"
+	testRunID                = "test-run-id"
+	testNextEventID          = 1800
+	testCloseFailoverVersion = int64(100)
+	testPageSize             = 100
+	testContainer            = "test-container"
+	testContainerURI         = "azblob://test-container"
+)
+
+var (
+	testBranchToken = []byte{1, 2, 3}
+	testAccountKey  = base64.StdEncoding.EncodeToString([]byte("test-account-key"))
+)
+
+type historyArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+	server             *fakeBlobServer
+	storage            storageClient
+	container          *archiver.HistoryBootstrapContainer
+	testArchivalURI    archiver.URI
+	historyBatchesV1   []*archiverspb.HistoryBlob
+	historyBatchesV100 []*archiverspb.HistoryBlob
+}
+
+func TestHistoryArchiverSuite(t *testing.T) {
+	suite.Run(t, new(historyArchiverSuite))
+}
+
+func (s *historyArchiverSuite) SetupSuite() {
+	var err error
+	s.server = newFakeBlobServer(testContainer)
+	s.storage, err = newStorageClient(newTestConfig(s.server))
+	s.Require().NoError(err)
+	s.testArchivalURI, err = archiver.NewURI(testContainerURI)
+	s.Require().NoError(err)
+	s.setupHistoryDirectory()
+}
+
+func (s *historyArchiverSuite) TearDownSuite() {
+	s.server.Close()
+}
+
+func (s *historyArchiverSuite) SetupTest() {
+	scope := tally.NewTestScope("test", nil)
+	s.Assertions = require.New(s.T())
+	zapLogger := zap.NewNop()
+	s.container = &archiver.HistoryBootstrapContainer{
+		Logger:        loggerimpl.NewLogger(zapLogger),
+		MetricsClient: metrics.NewClient(scope, metrics.HistoryArchiverScope),
+	}
+}
+
+func (s *historyArchiverSuite) TestNewHistoryArchiver_Config() {
+	testCases := []struct {
+		config      *config.AzblobArchiver
+		expectedErr error
+	}{
+		{
+			config:      &config.AzblobArchiver{AccountKey: testAccountKey},
+			expectedErr: errEmptyAccountName,
+		},
+		{
+			config:      &config.AzblobArchiver{AccountName: testAccountName, AccountKey: testAccountKey, Endpoint: "127.0.0.1:10000"},
+			expectedErr: errInvalidEndpoint,
+		},
+		{
+			config:      &config.AzblobArchiver{AccountName: testAccountName, Endpoint: "http://127.0.0.1:10000/devstoreaccount1"},
+			expectedErr: errMissingCredentials,
+		},
+		{
+			config: &config.AzblobArchiver{AccountName: testAccountName, SASToken: "?sv=2019-12-12&sig=signature"},
+		},
+		{
+			config: &config.AzblobArchiver{AccountName: testAccountName, AccountKey: testAccountKey},
+		},
+	}
+
+	s.NoError(os.Unsetenv(accountKeyEnvVar))
+	for _, tc := range testCases {
+		_, err := NewHistoryArchiver(s.container, tc.config)
+		s.Equal(tc.expectedErr, err)
+	}
+}
+
+func (s *historyArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme:///a/b/c",
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "azblob://",
+			expectedErr: archiver.ErrInvalidURI,
+		},
+		{
+			URI:         "azblob://container-not-exists/a/b/c",
+			expectedErr: errContainerNotExists,
+		},
+		{
+			URI:         testContainerURI + "/a/b/c",
+			expectedErr: nil,
+		},
+	}
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, historyArchiver.ValidateURI(URI))
+	}
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_InvalidURI() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, newTestArchiveHistoryRequest())
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := newTestArchiveHistoryRequest()
+	request.WorkflowID = "" // an invalid request
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, request)
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_ErrorOnReadHistory() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(nil, errors.New("some random error")),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, newTestArchiveHistoryRequest())
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_TimeoutWhenReadingHistory() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(nil, serviceerror.NewResourceExhausted("")),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(getCanceledContext(), s.testArchivalURI, newTestArchiveHistoryRequest())
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_HistoryMutated() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{
+			IsLast: true,
+		},
+		Body: []*historypb.History{
+			{
+				Events: []*historypb.HistoryEvent{
+					{
+						EventId:   common.FirstEventID + 1,
+						EventTime: timestamp.TimePtr(time.Now().UTC()),
+						Version:   testCloseFailoverVersion + 1,
+					},
+				},
+			},
+		},
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, newTestArchiveHistoryRequest())
+	s.Equal(archiver.ErrHistoryMutated, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_NonRetryableErrorOption() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(nil, errors.New("some random error")),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	nonRetryableErr := errors.New("some non-retryable error")
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, newTestArchiveHistoryRequest(), archiver.GetNonRetryableErrorOption(nonRetryableErr))
+	s.Equal(nonRetryableErr, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Success() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.historyBatchesV100[0], nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.historyBatchesV100[1], nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	URI, err := archiver.NewURI(testContainerURI + "/TestArchive_Success")
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, newTestArchiveHistoryRequest())
+	s.NoError(err)
+
+	blobName := constructHistoryBlobName(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion, 1)
+	blobNames := s.server.blobNames(testContainer)
+	s.Contains(blobNames, "TestArchive_Success/"+blobName)
+	s.Contains(blobNames, "TestArchive_Success/"+constructChecksumBlobName(blobName))
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_InvalidURI() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	response, err := historyArchiver.Get(context.Background(), URI, newTestGetHistoryRequest(testPageSize))
+	s.Nil(response)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, newTestGetHistoryRequest(0))
+	s.Nil(response)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_InvalidToken() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := newTestGetHistoryRequest(testPageSize)
+	request.NextPageToken = []byte{'r', 'a', 'n', 'd', 'o', 'm'}
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.Nil(response)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_BlobNotExist() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI(testContainerURI + "/non-existent")
+	s.NoError(err)
+	response, err := historyArchiver.Get(context.Background(), URI, newTestGetHistoryRequest(testPageSize))
+	s.Nil(response)
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_ChecksumMismatch() {
+	URI, err := archiver.NewURI(testContainerURI + "/TestGet_Fail_ChecksumMismatch")
+	s.NoError(err)
+	s.writeHistoryBatchesForGetTest(URI, s.historyBatchesV1, int64(1))
+	blobName := constructHistoryBlobName(testNamespaceID, testWorkflowID, testRunID, int64(1), 0)
+	s.server.putBlob(testContainer, "TestGet_Fail_ChecksumMismatch/"+constructChecksumBlobName(blobName), []byte("corrupted"))
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	response, err := historyArchiver.Get(context.Background(), URI, newTestGetHistoryRequest(testPageSize))
+	s.Nil(response)
+	s.IsType(&serviceerror.DataLoss{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_PickHighestVersion() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, newTestGetHistoryRequest(testPageSize))
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_UseProvidedVersion() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := newTestGetHistoryRequest(testPageSize)
+	request.CloseFailoverVersion = convert.Int64Ptr(1)
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV1[0].Body, response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_SmallPageSize() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := newTestGetHistoryRequest(1)
+	request.CloseFailoverVersion = convert.Int64Ptr(testCloseFailoverVersion)
+	var combinedHistory []*historypb.History
+
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.NotNil(response.NextPageToken)
+	s.Len(response.HistoryBatches, 1)
+	combinedHistory = append(combinedHistory, response.HistoryBatches...)
+
+	request.NextPageToken = response.NextPageToken
+	response, err = historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.HistoryBatches, 1)
+	combinedHistory = append(combinedHistory, response.HistoryBatches...)
+
+	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), combinedHistory)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.historyBatchesV100[0], nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.historyBatchesV100[1], nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	URI, err := archiver.NewURI(testContainerURI + "/TestArchiveAndGet")
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, newTestArchiveHistoryRequest())
+	s.NoError(err)
+
+	response, err := historyArchiver.Get(context.Background(), URI, newTestGetHistoryRequest(testPageSize))
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
+
+	s.server.Lock()
+	defer s.server.Unlock()
+	for _, authorization := range s.server.authorizations {
+		s.True(strings.HasPrefix(authorization, "SharedKey "+testAccountName+":"), authorization)
+	}
+}
+
+func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
+	return newHistoryArchiver(s.container, historyIterator, s.storage)
+}
+
+func (s *historyArchiverSuite) setupHistoryDirectory() {
+	now := time.Date(2020, 8, 22, 1, 2, 3, 4, time.UTC)
+
+	s.historyBatchesV1 = []*archiverspb.HistoryBlob{
+		{
+			Header: &archiverspb.HistoryBlobHeader{
+				IsLast: true,
+			},
+			Body: []*historypb.History{
+				{
+					Events: []*historypb.HistoryEvent{
+						{
+							EventId:   testNextEventID - 1,
+							EventTime: &now,
+							Version:   1,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	s.historyBatchesV100 = []*archiverspb.HistoryBlob{
+		{
+			Header: &archiverspb.HistoryBlobHeader{
+				IsLast: false,
+			},
+			Body: []*historypb.History{
+				{
+					Events: []*historypb.HistoryEvent{
+						{
+							EventId:   common.FirstEventID + 1,
+							EventTime: &now,
+							Version:   testCloseFailoverVersion,
+						},
+						{
+							EventId:   common.FirstEventID + 1,
+							EventTime: &now,
+							Version:   testCloseFailoverVersion,
+						},
+					},
+				},
+			},
+		},
+		{
+			Header: &archiverspb.HistoryBlobHeader{
+				IsLast: true,
+			},
+			Body: []*historypb.History{
+				{
+					Events: []*historypb.HistoryEvent{
+						{
+							EventId:   testNextEventID - 1,
+							EventTime: &now,
+							Version:   testCloseFailoverVersion,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	s.writeHistoryBatchesForGetTest(s.testArchivalURI, s.historyBatchesV1, int64(1))
+	s.writeHistoryBatchesForGetTest(s.testArchivalURI, s.historyBatchesV100, testCloseFailoverVersion)
+}
+
+func (s *historyArchiverSuite) writeHistoryBatchesForGetTest(URI archiver.URI, historyBatches []*archiverspb.HistoryBlob, version int64) {
+	encoder := codec.NewJSONPBEncoder()
+	for i, batch := range historyBatches {
+		data, err := encoder.EncodeHistories(batch.Body)
+		s.Require().NoError(err)
+		blobName := constructHistoryBlobName(testNamespaceID, testWorkflowID, testRunID, version, i)
+		s.Require().NoError(s.storage.Upload(context.Background(), URI, blobName, data))
+	}
+}
+
+func newTestConfig(server *fakeBlobServer) *config.AzblobArchiver {
+	return &config.AzblobArchiver{
+		AccountName: testAccountName,
+		AccountKey:  testAccountKey,
+		Endpoint:    server.endpoint(),
+	}
+}
+
+func newTestArchiveHistoryRequest() *archiver.ArchiveHistoryRequest {
+	return &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+}
+
+func newTestGetHistoryRequest(pageSize int) *archiver.GetHistoryRequest {
+	return &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    pageSize,
+	}
+}
+
+func getCanceledContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
@@ -0,0 +1,211 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package azblobstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	"github.com/gogo/protobuf/proto"
+	historypb "go.temporal.io/api/history/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/codec"
+)
+
+const (
+	historyBlobSuffix    = ".history"
+	checksumBlobSuffix   = ".checksum"
+	visibilityBlobSuffix = ".visibility"
+	indexKeyStartTime    = "startTime"
+	indexKeyCloseTime    = "closeTime"
+	// timeFormat has a fixed width so that the visibility blobs sort by time
+	timeFormat = "2006-01-02T15:04:05Z"
+)
+
+// encoding & decoding util
+
+func encode(message proto.Message) ([]byte, error) {
+	encoder := codec.NewJSONPBEncoder()
+	return encoder.Encode(message)
+}
+
+func decodeVisibilityRecord(data []byte) (*archiverspb.ArchiveVisibilityRequest, error) {
+	record := &archiverspb.ArchiveVisibilityRequest{}
+	encoder := codec.NewJSONPBEncoder()
+	if err := encoder.Decode(data, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func serializeToken(token interface{}) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+	return json.Marshal(token)
+}
+
+func deserializeGetHistoryToken(bytes []byte) (*getHistoryToken, error) {
+	token := &getHistoryToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+func deserializeListVisibilityToken(bytes []byte) (*listVisibilityToken, error) {
+	token := &listVisibilityToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+// blob name construction
+//
+// <namespaceID>/history/<hash(workflowID)>/<hash(runID)>/<closeFailoverVersion>_<part>.history[.checksum]
+// <namespaceID>/visibility/<closeTime|startTime>/<time>_<hash(workflowTypeName)>_<hash(workflowID)>_<hash(runID)>.visibility
+
+func constructHistoryBlobPrefix(namespaceID, workflowID, runID string) string {
+	return fmt.Sprintf("%s/history/%s/%s/", namespaceID, hash(workflowID), hash(runID))
+}
+
+func constructHistoryBlobName(namespaceID, workflowID, runID string, version int64, part int) string {
+	return fmt.Sprintf("%s%d_%d%s", constructHistoryBlobPrefix(namespaceID, workflowID, runID), version, part, historyBlobSuffix)
+}
+
+func constructChecksumBlobName(blobName string) string {
+	return blobName + checksumBlobSuffix
+}
+
+// extractHistoryVersionAndPart parses the close failover version and part of a history blob name
+func extractHistoryVersionAndPart(blobName string) (int64, int, error) {
+	name := blobName[strings.LastIndex(blobName, "/")+1:]
+	if !strings.HasSuffix(name, historyBlobSuffix) {
+		return 0, 0, errors.New("not a history blob")
+	}
+	parts := strings.Split(strings.TrimSuffix(name, historyBlobSuffix), "_")
+	if len(parts) != 2 {
+		return 0, 0, errors.New("unknown history blob name structure")
+	}
+	version, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	part, err := strconv.Atoi(parts[1])
+	return version, part, err
+}
+
+func constructVisibilityBlobPrefix(namespaceID, indexKey string) string {
+	return fmt.Sprintf("%s/visibility/%s/", namespaceID, indexKey)
+}
+
+func constructVisibilityBlobName(namespaceID, indexKey string, t time.Time, record *archiverspb.ArchiveVisibilityRequest) string {
+	return fmt.Sprintf("%s%s_%s_%s_%s%s",
+		constructVisibilityBlobPrefix(namespaceID, indexKey),
+		t.UTC().Format(timeFormat),
+		hash(record.WorkflowTypeName),
+		hash(record.GetWorkflowId()),
+		hash(record.GetRunId()),
+		visibilityBlobSuffix,
+	)
+}
+
+// visibilityBlobFilter restricts the visibility blob names by their timestamp and hashes,
+// nil hash sets don't restrict the blob names
+type visibilityBlobFilter struct {
+	earliestTime      time.Time
+	latestTime        time.Time
+	workflowTypeNames map[string]struct{}
+	workflowIDs       map[string]struct{}
+	runIDs            map[string]struct{}
+}
+
+// matchVisibilityBlobName checks the timestamp and the hashed workflow type name, workflow ID and run ID of a visibility blob name
+func matchVisibilityBlobName(blobName string, filter *visibilityBlobFilter) bool {
+	name := strings.TrimSuffix(blobName[strings.LastIndex(blobName, "/")+1:], visibilityBlobSuffix)
+	parts := strings.Split(name, "_")
+	if len(parts) != 4 {
+		return false
+	}
+	t, err := time.Parse(timeFormat, parts[0])
+	if err != nil || t.Before(filter.earliestTime) || t.After(filter.latestTime) {
+		return false
+	}
+	return containsHash(filter.workflowTypeNames, parts[1]) &&
+		containsHash(filter.workflowIDs, parts[2]) &&
+		containsHash(filter.runIDs, parts[3])
+}
+
+func containsHash(hashes map[string]struct{}, h string) bool {
+	if hashes == nil {
+		return true
+	}
+	_, ok := hashes[h]
+	return ok
+}
+
+func hashValues(values []string) map[string]struct{} {
+	hashes := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		hashes[hash(value)] = struct{}{}
+	}
+	return hashes
+}
+
+func isUnboundedTimeRange(earliest time.Time, latest time.Time) bool {
+	return earliest.IsZero() && latest.Equal(archiver.MaxVisibilityTime)
+}
+
+func hash(s string) string {
+	return fmt.Sprintf("%v", farm.Fingerprint64([]byte(s)))
+}
+
+func contextExpired(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+func historyMutated(request *archiver.ArchiveHistoryRequest, historyBatches []*historypb.History, isLast bool) bool {
+	lastBatch := historyBatches[len(historyBatches)-1].Events
+	lastEvent := lastBatch[len(lastBatch)-1]
+	lastFailoverVersion := lastEvent.GetVersion()
+	if lastFailoverVersion > request.CloseFailoverVersion {
+		return true
+	}
+
+	if !isLast {
+		return false
+	}
+	lastEventID := lastEvent.GetEventId()
+	return lastFailoverVersion != request.CloseFailoverVersion || lastEventID+1 != request.NextEventID
+}
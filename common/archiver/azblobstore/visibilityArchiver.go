@@ -0,0 +1,288 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package azblobstore
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	errEncodeVisibilityRecord = "failed to encode visibility record"
+	errWriteVisibilityRecord  = "failed to write visibility record to azure blob storage"
+	timeoutInSeconds          = 5
+)
+
+var (
+	errRetryable = errors.New("retryable error")
+)
+
+var _ archiver.VisibilityLister = (*visibilityArchiver)(nil)
+
+type (
+	visibilityArchiver struct {
+		container *archiver.VisibilityBootstrapContainer
+		storage   storageClient
+	}
+
+	listVisibilityToken struct {
+		Marker string
+	}
+)
+
+// NewVisibilityArchiver creates a new azure blob storage VisibilityArchiver
+func NewVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.AzblobArchiver,
+) (archiver.VisibilityArchiver, error) {
+	storage, err := newStorageClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return newVisibilityArchiver(container, storage), nil
+}
+
+func newVisibilityArchiver(container *archiver.VisibilityBootstrapContainer, storage storageClient) *visibilityArchiver {
+	return &visibilityArchiver{
+		container: container,
+		storage:   storage,
+	}
+}
+
+// Archive is used to archive one workflow visibility record.
+// Check the Archive() method of the HistoryArchiver interface in Step 2 for parameters' meaning and requirements.
+// The only difference is that the ArchiveOption parameter won't include an option for recording process.
+// Please make sure your implementation is lossless. If any in-memory batching mechanism is used, then those batched records will be lost during server restarts.
+// This method will be invoked when workflow closes. Note that because of conflict resolution, it is possible for a workflow to through the closing process multiple times, which means that this method can be invoked more than once after a workflow closes.
+func (v *visibilityArchiver) Archive(ctx context.Context, URI archiver.URI, request *archiverspb.ArchiveVisibilityRequest, opts ...archiver.ArchiveOption) (err error) {
+	scope := v.container.MetricsClient.Scope(metrics.VisibilityArchiverScope, metrics.NamespaceTag(request.Namespace))
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	sw := scope.StartTimer(metrics.ServiceLatency)
+	defer func() {
+		sw.Stop()
+		if err != nil {
+			if err == errRetryable {
+				scope.IncCounter(metrics.VisibilityArchiverArchiveTransientErrorCount)
+			} else {
+				scope.IncCounter(metrics.VisibilityArchiverArchiveNonRetryableErrorCount)
+				if featureCatalog.NonRetryableError != nil {
+					err = featureCatalog.NonRetryableError()
+				}
+			}
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.container.Logger, request, URI.String())
+
+	if err := v.validateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	encodedVisibilityRecord, err := encode(request)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
+		return err
+	}
+
+	// The record is indexed by both close and start time, the blob names embed the hashed
+	// workflow type name, workflow ID and run ID so that queries filter without reading the blobs
+	blobNames := []string{
+		constructVisibilityBlobName(request.GetNamespaceId(), indexKeyCloseTime, timestamp.TimeValue(request.CloseTime), request),
+		constructVisibilityBlobName(request.GetNamespaceId(), indexKeyStartTime, timestamp.TimeValue(request.StartTime), request),
+	}
+	for _, blobName := range blobNames {
+		if err := v.storage.Upload(ctx, URI, blobName, encodedVisibilityRecord); err != nil {
+			logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteVisibilityRecord), tag.Error(err))
+			return errRetryable
+		}
+	}
+
+	scope.IncCounter(metrics.VisibilityArchiveSuccessCount)
+	return nil
+}
+
+// Query is used to retrieve archived visibility records.
+// Check the Get() method of the HistoryArchiver interface in Step 2 for parameters' meaning and requirements.
+// The request includes a string field called query, which describes what kind of visibility records should be returned. For example, it can be some SQL-like syntax query string.
+// Your implementation is responsible for parsing and validating the query, and also returning all visibility records that match the query.
+// Currently the maximum context timeout passed into the method is 3 minutes, so it's ok if this method takes a long time to run.
+func (v *visibilityArchiver) Query(ctx context.Context, URI archiver.URI, request *archiver.QueryVisibilityRequest) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
+	}
+
+	return archiver.QueryVisibility(request, func(query *archiver.VisibilityQuery) ([]*archiverspb.ArchiveVisibilityRequest, error) {
+		return v.loadVisibilityRecords(ctx, URI, request.NamespaceID, query)
+	})
+}
+
+// loadVisibilityRecords reads the records from the close time, or start time, index of the namespace.
+// The blobs are narrowed down to the time range of the query and by the hashes in their names.
+func (v *visibilityArchiver) loadVisibilityRecords(ctx context.Context, URI archiver.URI, namespaceID string, query *archiver.VisibilityQuery) ([]*archiverspb.ArchiveVisibilityRequest, error) {
+	indexKey := indexKeyCloseTime
+	earliest, latest := query.TimeRange(definition.CloseTime)
+	if startEarliest, startLatest := query.TimeRange(definition.StartTime); isUnboundedTimeRange(earliest, latest) && !isUnboundedTimeRange(startEarliest, startLatest) {
+		indexKey = indexKeyStartTime
+		earliest, latest = startEarliest, startLatest
+	}
+	// timestamps of the blob names are truncated to the second
+	earliest = earliest.Truncate(time.Second)
+
+	filter := &visibilityBlobFilter{
+		earliestTime: earliest,
+		latestTime:   latest,
+	}
+	if workflowTypeNames, ok := query.Values(definition.WorkflowType); ok {
+		filter.workflowTypeNames = hashValues(workflowTypeNames)
+	}
+	if workflowIDs, ok := query.Values(definition.WorkflowID); ok {
+		filter.workflowIDs = hashValues(workflowIDs)
+	}
+	if runIDs, ok := query.Values(definition.RunID); ok {
+		filter.runIDs = hashValues(runIDs)
+	}
+
+	prefix := constructVisibilityBlobPrefix(namespaceID, indexKey) + archiver.TimeRangePrefix(earliest, latest, timeFormat)
+	var records []*archiverspb.ArchiveVisibilityRequest
+	var marker string
+	for {
+		blobNames, nextMarker, err := v.storage.List(ctx, URI, prefix, marker, listPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, blobName := range blobNames {
+			if !matchVisibilityBlobName(blobName, filter) {
+				continue
+			}
+			encodedRecord, err := v.storage.Get(ctx, URI, blobName)
+			if err != nil {
+				return nil, err
+			}
+			record, err := decodeVisibilityRecord(encodedRecord)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, record)
+		}
+		if nextMarker == "" {
+			return records, nil
+		}
+		marker = nextMarker
+	}
+}
+
+// List walks the visibility records of the namespace through their close time index.
+func (v *visibilityArchiver) List(ctx context.Context, URI archiver.URI, request *archiver.ListVisibilityRequest) (*archiver.ListVisibilityResponse, error) {
+	if err := v.validateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateListVisibilityRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidListVisibilityRequest.Error())
+	}
+
+	token := new(listVisibilityToken)
+	if request.NextPageToken != nil {
+		var err error
+		token, err = deserializeListVisibilityToken(request.NextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	}
+
+	prefix := constructVisibilityBlobPrefix(request.NamespaceID, indexKeyCloseTime)
+	blobNames, nextMarker, err := v.storage.List(ctx, URI, prefix, token.Marker, request.PageSize)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	response := &archiver.ListVisibilityResponse{}
+	for _, blobName := range blobNames {
+		encodedRecord, err := v.storage.Get(ctx, URI, blobName)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			response.CorruptedRecords = append(response.CorruptedRecords, blobName)
+			continue
+		}
+		response.Records = append(response.Records, record)
+	}
+
+	if nextMarker != "" {
+		encodedToken, err := serializeToken(&listVisibilityToken{Marker: nextMarker})
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.NextPageToken = encodedToken
+	}
+
+	return response, nil
+}
+
+// ValidateURI is used to define what a valid URI for an implementation is.
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	if err := v.validateURI(URI); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeoutInSeconds*time.Second)
+	defer cancel()
+	return v.storage.ContainerExist(ctx, URI)
+}
+
+func (v *visibilityArchiver) validateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+
+	if URI.Hostname() == "" {
+		return archiver.ErrInvalidURI
+	}
+
+	return nil
+}
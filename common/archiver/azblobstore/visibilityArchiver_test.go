@@ -0,0 +1,334 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package azblobstore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.uber.org/zap"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	testWorkflowTypeName = "test-workflow-type"
+)
+
+type visibilityArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+	server                    *fakeBlobServer
+	storage                   storageClient
+	container                 *archiver.VisibilityBootstrapContainer
+	testArchivalURI           archiver.URI
+	visibilityRecords         []*archiverspb.ArchiveVisibilityRequest
+	visibilityRecordCloseTime time.Time
+}
+
+func TestVisibilityArchiverSuite(t *testing.T) {
+	suite.Run(t, new(visibilityArchiverSuite))
+}
+
+func (s *visibilityArchiverSuite) SetupSuite() {
+	var err error
+	s.server = newFakeBlobServer(testContainer)
+	s.storage, err = newStorageClient(newTestConfig(s.server))
+	s.Require().NoError(err)
+	s.testArchivalURI, err = archiver.NewURI(testContainerURI + "/visibility")
+	s.Require().NoError(err)
+	s.setupVisibilityDirectory()
+}
+
+func (s *visibilityArchiverSuite) TearDownSuite() {
+	s.server.Close()
+}
+
+func (s *visibilityArchiverSuite) SetupTest() {
+	scope := tally.NewTestScope("test", nil)
+	s.Assertions = require.New(s.T())
+	zapLogger := zap.NewNop()
+	s.container = &archiver.VisibilityBootstrapContainer{
+		Logger:        loggerimpl.NewLogger(zapLogger),
+		MetricsClient: metrics.NewClient(scope, metrics.VisibilityArchiverScope),
+	}
+}
+
+func (s *visibilityArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme:///a/b/c",
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "azblob://",
+			expectedErr: archiver.ErrInvalidURI,
+		},
+		{
+			URI:         "azblob://container-not-exists/a/b/c",
+			expectedErr: errContainerNotExists,
+		},
+		{
+			URI:         testContainerURI,
+			expectedErr: nil,
+		},
+	}
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, visibilityArchiver.ValidateURI(URI))
+	}
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidURI() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	err = visibilityArchiver.Archive(context.Background(), URI, s.visibilityRecords[0])
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	err := visibilityArchiver.Archive(context.Background(), s.testArchivalURI, &archiverspb.ArchiveVisibilityRequest{})
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Success() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI(testContainerURI + "/TestArchive_Success")
+	s.NoError(err)
+	record := s.visibilityRecords[0]
+	err = visibilityArchiver.Archive(context.Background(), URI, record)
+	s.NoError(err)
+
+	blobNames := s.server.blobNames(testContainer)
+	s.Contains(blobNames, "TestArchive_Success/"+constructVisibilityBlobName(testNamespaceID, indexKeyCloseTime, timestamp.TimeValue(record.CloseTime), record))
+	s.Contains(blobNames, "TestArchive_Success/"+constructVisibilityBlobName(testNamespaceID, indexKeyStartTime, timestamp.TimeValue(record.StartTime), record))
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidURI() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	response, err := visibilityArchiver.Query(context.Background(), URI, s.newTestQueryRequest("WorkflowId = 'id'"))
+	s.Nil(response)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	for _, query := range []string{
+		"some invalid query",
+		"CloseTime = 'not a time'",
+		fmt.Sprintf("WorkflowId = '%s' and SearchPrecision = 'Day'", testWorkflowID),
+		fmt.Sprintf("CloseTime = '%s' and SearchPrecision = 'Week'", s.visibilityRecordCloseTime.Format(time.RFC3339)),
+	} {
+		response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, s.newTestQueryRequest(query))
+		s.Nil(response)
+		s.IsType(&serviceerror.InvalidArgument{}, err, query)
+	}
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := s.newTestQueryRequest(fmt.Sprintf("CloseTime = '%s' and SearchPrecision = 'Day'", s.visibilityRecordCloseTime.Format(time.RFC3339)))
+	request.NextPageToken = []byte{1, 2, 3}
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+	s.Nil(response)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_SearchPrecision() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	testCases := []struct {
+		precision string
+		expected  int
+	}{
+		{precision: archiver.PrecisionSecond, expected: 1},
+		{precision: archiver.PrecisionMinute, expected: 2},
+		{precision: archiver.PrecisionHour, expected: 3},
+		{precision: archiver.PrecisionDay, expected: 4},
+	}
+
+	for _, tc := range testCases {
+		query := fmt.Sprintf("CloseTime = '%s' and SearchPrecision = '%s'", s.visibilityRecordCloseTime.Format(time.RFC3339), tc.precision)
+		response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, s.newTestQueryRequest(query))
+		s.NoError(err)
+		s.Nil(response.NextPageToken)
+		s.Len(response.Executions, tc.expected, tc.precision)
+	}
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_Filters() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	query := fmt.Sprintf("StartTime = '%s' and SearchPrecision = 'Day' and WorkflowId = '%s' and RunId = '%s'",
+		s.visibilityRecordCloseTime.Format(time.RFC3339), testWorkflowID, "run-1")
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, s.newTestQueryRequest(query))
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 1)
+	s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[1]), response.Executions[0])
+
+	query = fmt.Sprintf("CloseTime = '%s' and SearchPrecision = 'Day' and WorkflowType = 'some-other-type'",
+		s.visibilityRecordCloseTime.Format(time.RFC3339))
+	response, err = visibilityArchiver.Query(context.Background(), s.testArchivalURI, s.newTestQueryRequest(query))
+	s.NoError(err)
+	s.Empty(response.Executions)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := s.newTestQueryRequest(fmt.Sprintf("CloseTime = '%s' and SearchPrecision = 'Day' and WorkflowId = '%s'",
+		s.visibilityRecordCloseTime.Format(time.RFC3339), testWorkflowID))
+	request.PageSize = 2
+
+	var executions []string
+	for {
+		response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+		s.NoError(err)
+		s.True(len(response.Executions) <= request.PageSize)
+		for _, execution := range response.Executions {
+			executions = append(executions, execution.Execution.GetRunId())
+		}
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal([]string{"run-3", "run-2", "run-1", "run-0"}, executions)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_OrderBy() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	query := fmt.Sprintf("(RunId IN ('run-0', 'run-2') OR CloseTime > '%s') and WorkflowId = '%s' ORDER BY CloseTime ASC",
+		s.visibilityRecordCloseTime.Add(time.Minute).Format(time.RFC3339), testWorkflowID)
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, s.newTestQueryRequest(query))
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	var executions []string
+	for _, execution := range response.Executions {
+		executions = append(executions, execution.Execution.GetRunId())
+	}
+	s.Equal([]string{"run-0", "run-2", "run-3"}, executions)
+}
+
+func (s *visibilityArchiverSuite) TestList_Success() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.ListVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    3,
+	}
+
+	response, err := visibilityArchiver.List(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Len(response.Records, 3)
+	s.Empty(response.CorruptedRecords)
+	s.NotNil(response.NextPageToken)
+
+	request.NextPageToken = response.NextPageToken
+	response, err = visibilityArchiver.List(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Len(response.Records, 1)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.visibilityRecords[3], response.Records[0])
+}
+
+func (s *visibilityArchiverSuite) TestList_CorruptedRecord() {
+	URI, err := archiver.NewURI(testContainerURI + "/TestList_CorruptedRecord")
+	s.NoError(err)
+	blobName := constructVisibilityBlobName(testNamespaceID, indexKeyCloseTime, s.visibilityRecordCloseTime, s.visibilityRecords[0])
+	s.server.putBlob(testContainer, "TestList_CorruptedRecord/"+blobName, []byte("corrupted"))
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	response, err := visibilityArchiver.List(context.Background(), URI, &archiver.ListVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+	})
+	s.NoError(err)
+	s.Empty(response.Records)
+	s.Equal([]string{blobName}, response.CorruptedRecords)
+}
+
+func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
+	return newVisibilityArchiver(s.container, s.storage)
+}
+
+func (s *visibilityArchiverSuite) newTestQueryRequest(query string) *archiver.QueryVisibilityRequest {
+	return &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    testPageSize,
+		Query:       query,
+	}
+}
+
+// setupVisibilityDirectory archives four runs of the test workflow which closed
+// one second, one minute, one hour and one day apart
+func (s *visibilityArchiverSuite) setupVisibilityDirectory() {
+	s.visibilityRecordCloseTime = time.Date(2020, 8, 22, 11, 12, 13, 0, time.UTC)
+	startTime := s.visibilityRecordCloseTime.Add(-time.Second)
+	closeTimes := []time.Time{
+		s.visibilityRecordCloseTime,
+		s.visibilityRecordCloseTime.Add(time.Second),
+		s.visibilityRecordCloseTime.Add(time.Minute),
+		s.visibilityRecordCloseTime.Add(time.Hour),
+	}
+
+	visibilityArchiver := newVisibilityArchiver(&archiver.VisibilityBootstrapContainer{
+		Logger:        loggerimpl.NewNopLogger(),
+		MetricsClient: metrics.NewClient(tally.NoopScope, metrics.VisibilityArchiverScope),
+	}, s.storage)
+	for i, closeTime := range closeTimes {
+		record := &archiverspb.ArchiveVisibilityRequest{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       testWorkflowID,
+			RunId:            fmt.Sprintf("run-%d", i),
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.TimePtr(startTime),
+			ExecutionTime:    timestamp.TimePtr(startTime),
+			CloseTime:        timestamp.TimePtr(closeTime),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			HistoryLength:    int64(i + 1),
+		}
+		s.Require().NoError(visibilityArchiver.Archive(context.Background(), s.testArchivalURI, record))
+		s.visibilityRecords = append(s.visibilityRecords, record)
+	}
+}
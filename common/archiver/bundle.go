@@ -0,0 +1,116 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package archiver
+
+import (
+	historypb "go.temporal.io/api/history/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// WorkflowBundleFormatVersion is the version of the workflow bundle format written by this server
+const WorkflowBundleFormatVersion = 1
+
+// NewWorkflowBundle bundles the final state of a closed workflow with its full history
+func NewWorkflowBundle(
+	request *ArchiveBundleRequest,
+	historyBatches []*historypb.History,
+	sourceCluster string,
+) (*archiverspb.WorkflowBundle, error) {
+	checksum, err := HistoryChecksum(historyBatches)
+	if err != nil {
+		return nil, err
+	}
+	eventCount := 0
+	for _, batch := range historyBatches {
+		eventCount += len(batch.Events)
+	}
+	return &archiverspb.WorkflowBundle{
+		Header: &archiverspb.WorkflowBundleHeader{
+			FormatVersion:        WorkflowBundleFormatVersion,
+			Namespace:            request.Namespace,
+			NamespaceId:          request.NamespaceID,
+			WorkflowId:           request.WorkflowID,
+			RunId:                request.RunID,
+			WorkflowTypeName:     request.WorkflowTypeName,
+			Status:               request.Status,
+			StartTime:            timestamp.TimePtr(request.StartTime),
+			CloseTime:            timestamp.TimePtr(request.CloseTime),
+			CloseFailoverVersion: request.CloseFailoverVersion,
+			EventCount:           int64(eventCount),
+			SourceCluster:        sourceCluster,
+			Checksum:             checksum,
+		},
+		MutableState:     request.MutableState,
+		Memo:             request.Memo,
+		SearchAttributes: request.SearchAttributes,
+		History:          historyBatches,
+	}, nil
+}
+
+// ValidateWorkflowBundle validates that the bundle was written in a supported format,
+// identifies a workflow and that its history matches the checksum in its header
+func ValidateWorkflowBundle(bundle *archiverspb.WorkflowBundle) error {
+	header := bundle.GetHeader()
+	if header == nil {
+		return ErrInvalidWorkflowBundle
+	}
+	if header.GetFormatVersion() <= 0 || header.GetFormatVersion() > WorkflowBundleFormatVersion {
+		return ErrBundleFormatVersion
+	}
+	if header.GetNamespaceId() == "" || header.GetWorkflowId() == "" || header.GetRunId() == "" {
+		return ErrInvalidWorkflowBundle
+	}
+	return ValidateHistoryChecksum(bundle.GetHistory(), header.GetChecksum())
+}
+
+// EncodeWorkflowBundle encodes the bundle as JSON, so that it can be inspected without any tooling
+func EncodeWorkflowBundle(bundle *archiverspb.WorkflowBundle) ([]byte, error) {
+	return codec.NewJSONPBEncoder().Encode(bundle)
+}
+
+// DecodeWorkflowBundle decodes a bundle encoded by EncodeWorkflowBundle, the bundle is not validated
+func DecodeWorkflowBundle(data []byte) (*archiverspb.WorkflowBundle, error) {
+	bundle := &archiverspb.WorkflowBundle{}
+	if err := codec.NewJSONPBEncoder().Decode(data, bundle); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// ValidateGetBundleRequest validates the get workflow bundle request
+func ValidateGetBundleRequest(request *GetBundleRequest) error {
+	if request.NamespaceID == "" {
+		return errEmptyNamespaceID
+	}
+	if request.WorkflowID == "" {
+		return errEmptyWorkflowID
+	}
+	if request.RunID == "" {
+		return errEmptyRunID
+	}
+	return nil
+}
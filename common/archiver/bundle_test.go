@@ -0,0 +1,135 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package archiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/payload"
+)
+
+type (
+	bundleSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		request        *ArchiveBundleRequest
+		historyBatches []*historypb.History
+	}
+)
+
+func TestBundleSuite(t *testing.T) {
+	suite.Run(t, new(bundleSuite))
+}
+
+func (s *bundleSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	startTime := time.Date(2020, 8, 22, 11, 12, 13, 0, time.UTC)
+	s.request = &ArchiveBundleRequest{
+		ArchiveHistoryRequest: ArchiveHistoryRequest{
+			NamespaceID:          "test-namespace-id",
+			Namespace:            "test-namespace",
+			WorkflowID:           "test-workflow-id",
+			RunID:                "test-run-id",
+			NextEventID:          4,
+			CloseFailoverVersion: 5,
+		},
+		WorkflowTypeName: "test-workflow-type",
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		StartTime:        startTime,
+		CloseTime:        startTime.Add(time.Hour),
+		MutableState:     `{"ExecutionInfo":{"WorkflowId":"test-workflow-id"}}`,
+		Memo:             &commonpb.Memo{Fields: map[string]*commonpb.Payload{"memo": payload.EncodeString("value")}},
+		SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{"CustomKeywordField": payload.EncodeString("keyword")}},
+	}
+	s.historyBatches = []*historypb.History{
+		{Events: []*historypb.HistoryEvent{{EventId: 1, Version: 5}, {EventId: 2, Version: 5}}},
+		{Events: []*historypb.HistoryEvent{{EventId: 3, Version: 5}}},
+	}
+}
+
+func (s *bundleSuite) TestNewWorkflowBundle() {
+	bundle, err := NewWorkflowBundle(s.request, s.historyBatches, "active")
+	s.NoError(err)
+	s.NoError(ValidateWorkflowBundle(bundle))
+
+	header := bundle.GetHeader()
+	s.Equal(int32(WorkflowBundleFormatVersion), header.GetFormatVersion())
+	s.Equal("test-namespace-id", header.GetNamespaceId())
+	s.Equal("test-workflow-id", header.GetWorkflowId())
+	s.Equal("test-run-id", header.GetRunId())
+	s.Equal("test-workflow-type", header.GetWorkflowTypeName())
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, header.GetStatus())
+	s.Equal(s.request.CloseTime, *header.GetCloseTime())
+	s.Equal(int64(5), header.GetCloseFailoverVersion())
+	s.Equal(int64(3), header.GetEventCount())
+	s.Equal("active", header.GetSourceCluster())
+	s.Equal(s.request.MutableState, bundle.GetMutableState())
+	s.Equal(s.request.Memo, bundle.GetMemo())
+	s.Equal(s.request.SearchAttributes, bundle.GetSearchAttributes())
+	s.Equal(s.historyBatches, bundle.GetHistory())
+}
+
+func (s *bundleSuite) TestValidateWorkflowBundle() {
+	bundle, err := NewWorkflowBundle(s.request, s.historyBatches, "active")
+	s.NoError(err)
+
+	s.Equal(ErrInvalidWorkflowBundle, ValidateWorkflowBundle(&archiverspb.WorkflowBundle{History: s.historyBatches}))
+
+	bundle.Header.FormatVersion = WorkflowBundleFormatVersion + 1
+	s.Equal(ErrBundleFormatVersion, ValidateWorkflowBundle(bundle))
+	bundle.Header.FormatVersion = 0
+	s.Equal(ErrBundleFormatVersion, ValidateWorkflowBundle(bundle))
+	bundle.Header.FormatVersion = WorkflowBundleFormatVersion
+
+	bundle.Header.RunId = ""
+	s.Equal(ErrInvalidWorkflowBundle, ValidateWorkflowBundle(bundle))
+	bundle.Header.RunId = "test-run-id"
+
+	bundle.History[1].Events[0].Version = 6
+	s.Equal(ErrHistoryChecksumMismatch, ValidateWorkflowBundle(bundle))
+}
+
+func (s *bundleSuite) TestEncodeDecodeWorkflowBundle() {
+	bundle, err := NewWorkflowBundle(s.request, s.historyBatches, "active")
+	s.NoError(err)
+
+	data, err := EncodeWorkflowBundle(bundle)
+	s.NoError(err)
+	decoded, err := DecodeWorkflowBundle(data)
+	s.NoError(err)
+	s.Equal(bundle, decoded)
+	s.NoError(ValidateWorkflowBundle(decoded))
+
+	_, err = DecodeWorkflowBundle([]byte("not a bundle"))
+	s.Error(err)
+}
@@ -63,4 +63,16 @@ var (
 	ErrNextPageTokenCorrupted = errors.New("next page token is corrupted")
 	// ErrHistoryNotExist is the error for non-exist history
 	ErrHistoryNotExist = errors.New("requested workflow history does not exist")
+	// ErrHistoryChecksumMismatch is the error for archived history not matching its stored checksum
+	ErrHistoryChecksumMismatch = errors.New("archived history does not match its checksum")
+	// ErrInvalidListVisibilityRequest is the error for invalid List Visibility request
+	ErrInvalidListVisibilityRequest = errors.New("list visibility request is invalid")
+	// ErrInvalidGetBundleRequest is the error for invalid GetBundle request
+	ErrInvalidGetBundleRequest = errors.New("get workflow bundle request is invalid")
+	// ErrInvalidWorkflowBundle is the error for a workflow bundle which is missing its header or identifiers
+	ErrInvalidWorkflowBundle = errors.New("workflow bundle is invalid")
+	// ErrBundleFormatVersion is the error for a workflow bundle written in an unsupported format version
+	ErrBundleFormatVersion = errors.New("workflow bundle format version is not supported")
+	// ErrBundleNotExist is the error for non-exist workflow bundle
+	ErrBundleNotExist = errors.New("requested workflow bundle does not exist")
 )
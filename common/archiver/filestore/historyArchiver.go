@@ -26,7 +26,8 @@
 
 // Each Archive() request results in a file named in the format of
 // hash(namespaceID, workflowID, runID)_version.history being created in the specified
-// directory. Workflow histories stored in that file are encoded in JSON format.
+// directory. Workflow histories stored in that file are encoded in JSON format. The checksum
+// of the histories is written next to it, in a file with the same name and a .checksum suffix.
 
 // The Get() method retrieves the archived histories from the directory specified in the
 // URI. It optionally takes in a NextPageToken which specifies the workflow close failover
@@ -36,6 +37,9 @@
 // of NextPageToken or close failover version is specified, the highest close failover version
 // will be picked.
 
+// The historyArchiver also implements archiver.BundleArchiver. A workflow bundle is written to
+// the same directory, in a file named hash(namespaceID, workflowID, runID)_version.bundle.
+
 package filestore
 
 import (
@@ -44,6 +48,7 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"strings"
 
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
@@ -64,10 +69,15 @@ const (
 	errEncodeHistory = "failed to encode history batches"
 	errMakeDirectory = "failed to make directory"
 	errWriteFile     = "failed to write history to file"
+	errChecksum      = "failed to compute history checksum"
+	errEncodeBundle  = "failed to encode workflow bundle"
+	errWriteBundle   = "failed to write workflow bundle to file"
 
 	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
 )
 
+var _ archiver.BundleArchiver = (*historyArchiver)(nil)
+
 var (
 	errInvalidFileMode = errors.New("invalid file mode")
 	errInvalidDirMode  = errors.New("invalid directory mode")
@@ -182,11 +192,21 @@ func (h *historyArchiver) Archive(
 		return err
 	}
 
+	checksum, err := archiver.HistoryChecksum(historyBatches)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errChecksum), tag.Error(err))
+		return err
+	}
+
 	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
 	if err := writeFile(path.Join(dirPath, filename), encodedHistoryBatches, h.fileMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 		return err
 	}
+	if err := writeFile(path.Join(dirPath, constructChecksumFilename(filename)), []byte(checksum), h.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+		return err
+	}
 
 	return nil
 }
@@ -225,7 +245,7 @@ func (h *historyArchiver) Get(
 			NextBatchIdx:         0,
 		}
 	} else {
-		highestVersion, err := getHighestVersion(dirPath, request)
+		highestVersion, err := getHighestVersion(dirPath, request.NamespaceID, request.WorkflowID, request.RunID, historyFileSuffix)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
@@ -252,9 +272,16 @@ func (h *historyArchiver) Get(
 
 	encoder := codec.NewJSONPBEncoder()
 	historyBatches, err := encoder.DecodeHistories(encodedHistoryBatches)
+	if err != nil {
+		return nil, serviceerror.NewDataLoss(err.Error())
+	}
+	checksum, err := readChecksumFile(path.Join(dirPath, constructChecksumFilename(filename)))
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
+	if err := archiver.ValidateHistoryChecksum(historyBatches, checksum); err != nil {
+		return nil, serviceerror.NewDataLoss(err.Error())
+	}
 	historyBatches = historyBatches[token.NextBatchIdx:]
 
 	response := &archiver.GetHistoryResponse{}
@@ -281,6 +308,118 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+func (h *historyArchiver) ArchiveBundle(
+	ctx context.Context,
+	URI archiver.URI,
+	bundle *archiverspb.WorkflowBundle,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	defer func() {
+		if err != nil && featureCatalog.NonRetryableError != nil {
+			err = featureCatalog.NonRetryableError()
+		}
+	}()
+
+	header := bundle.GetHeader()
+	logger := h.container.Logger.WithTags(
+		tag.ArchivalRequestNamespaceID(header.GetNamespaceId()),
+		tag.ArchivalRequestWorkflowID(header.GetWorkflowId()),
+		tag.ArchivalRequestRunID(header.GetRunId()),
+		tag.ArchivalRequestCloseFailoverVersion(header.GetCloseFailoverVersion()),
+		tag.ArchivalURI(URI.String()),
+	)
+
+	if err := h.ValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateWorkflowBundle(bundle); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	encodedBundle, err := archiver.EncodeWorkflowBundle(bundle)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeBundle), tag.Error(err))
+		return err
+	}
+
+	dirPath := URI.Path()
+	if err = mkdirAll(dirPath, h.dirMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
+		return err
+	}
+
+	filename := constructBundleFilename(header.GetNamespaceId(), header.GetWorkflowId(), header.GetRunId(), header.GetCloseFailoverVersion())
+	if err := writeFile(path.Join(dirPath, filename), encodedBundle, h.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteBundle), tag.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (h *historyArchiver) GetBundle(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.GetBundleRequest,
+) (*archiverspb.WorkflowBundle, error) {
+	if err := h.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateGetBundleRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidGetBundleRequest.Error())
+	}
+
+	dirPath := URI.Path()
+	exists, err := directoryExists(dirPath)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if !exists {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrBundleNotExist.Error())
+	}
+
+	var version int64
+	if request.CloseFailoverVersion != nil {
+		version = *request.CloseFailoverVersion
+	} else {
+		highestVersion, err := getHighestVersion(dirPath, request.NamespaceID, request.WorkflowID, request.RunID, bundleFileSuffix)
+		if err == archiver.ErrHistoryNotExist {
+			return nil, serviceerror.NewNotFound(archiver.ErrBundleNotExist.Error())
+		}
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		version = *highestVersion
+	}
+
+	filepath := path.Join(dirPath, constructBundleFilename(request.NamespaceID, request.WorkflowID, request.RunID, version))
+	exists, err = fileExists(filepath)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if !exists {
+		return nil, serviceerror.NewNotFound(archiver.ErrBundleNotExist.Error())
+	}
+
+	encodedBundle, err := readFile(filepath)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	bundle, err := archiver.DecodeWorkflowBundle(encodedBundle)
+	if err != nil {
+		return nil, serviceerror.NewDataLoss(err.Error())
+	}
+	if err := archiver.ValidateWorkflowBundle(bundle); err != nil {
+		return nil, serviceerror.NewDataLoss(err.Error())
+	}
+	return bundle, nil
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
@@ -307,14 +446,17 @@ func getNextHistoryBlob(ctx context.Context, historyIterator archiver.HistoryIte
 	return historyBlob, nil
 }
 
-func getHighestVersion(dirPath string, request *archiver.GetHistoryRequest) (*int64, error) {
-	filenames, err := listFilesByPrefix(dirPath, constructHistoryFilenamePrefix(request.NamespaceID, request.WorkflowID, request.RunID))
+func getHighestVersion(dirPath, namespaceID, workflowID, runID, suffix string) (*int64, error) {
+	filenames, err := listFilesByPrefix(dirPath, constructHistoryFilenamePrefix(namespaceID, workflowID, runID))
 	if err != nil {
 		return nil, err
 	}
 
 	var highestVersion *int64
 	for _, filename := range filenames {
+		if !strings.HasSuffix(filename, suffix) {
+			continue
+		}
 		version, err := extractCloseFailoverVersion(filename)
 		if err != nil {
 			continue
@@ -36,6 +36,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.uber.org/zap"
@@ -517,6 +518,7 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 
 	expectedFilename := constructHistoryFilename(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)
 	s.assertFileExists(path.Join(dir, expectedFilename))
+	s.assertFileExists(path.Join(dir, constructChecksumFilename(expectedFilename)))
 
 	getRequest := &archiver.GetHistoryRequest{
 		NamespaceID: testNamespaceID,
@@ -529,6 +531,148 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.NotNil(response)
 	s.Nil(response.NextPageToken)
 	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+
+	err = writeFile(path.Join(dir, constructChecksumFilename(expectedFilename)), []byte("deadbeef"), testFileMode)
+	s.NoError(err)
+	response, err = historyArchiver.Get(context.Background(), URI, getRequest)
+	s.Nil(response)
+	s.IsType(&serviceerror.DataLoss{}, err)
+}
+
+func (s *historyArchiverSuite) TestArchiveBundle_Fail_InvalidURI() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	err = historyArchiver.ArchiveBundle(context.Background(), URI, s.newTestWorkflowBundle(s.historyBatchesV100, testCloseFailoverVersion))
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchiveBundle_Fail_InvalidBundle() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	dir, err := ioutil.TempDir("", "TestArchiveBundle")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+
+	bundle := s.newTestWorkflowBundle(s.historyBatchesV100, testCloseFailoverVersion)
+	bundle.Header.WorkflowId = ""
+	nonRetryableErr := errors.New("some non-retryable error")
+	err = historyArchiver.ArchiveBundle(context.Background(), URI, bundle, archiver.GetNonRetryableErrorOption(nonRetryableErr))
+	s.Equal(nonRetryableErr, err)
+}
+
+func (s *historyArchiverSuite) TestGetBundle_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("file://" + s.testGetDirectory)
+	s.NoError(err)
+	bundle, err := historyArchiver.GetBundle(context.Background(), URI, &archiver.GetBundleRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+	})
+	s.Nil(bundle)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *historyArchiverSuite) TestGetBundle_Fail_BundleNotExist() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("file://" + s.testGetDirectory)
+	s.NoError(err)
+	bundle, err := historyArchiver.GetBundle(context.Background(), URI, &archiver.GetBundleRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+	})
+	s.Nil(bundle)
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *historyArchiverSuite) TestGetBundle_Fail_ChecksumMismatch() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	dir, err := ioutil.TempDir("", "TestGetBundle")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+
+	bundle := s.newTestWorkflowBundle(s.historyBatchesV100, testCloseFailoverVersion)
+	bundle.Header.Checksum = "00000000"
+	data, err := archiver.EncodeWorkflowBundle(bundle)
+	s.NoError(err)
+	filename := constructBundleFilename(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	s.NoError(writeFile(path.Join(dir, filename), data, testFileMode))
+
+	response, err := historyArchiver.GetBundle(context.Background(), URI, &archiver.GetBundleRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+	})
+	s.Nil(response)
+	s.IsType(&serviceerror.DataLoss{}, err)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGetBundle() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	dir, err := ioutil.TempDir("", "TestArchiveAndGetBundle")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+
+	bundleV1 := s.newTestWorkflowBundle(s.historyBatchesV1, 1)
+	bundleV100 := s.newTestWorkflowBundle(s.historyBatchesV100, testCloseFailoverVersion)
+	s.NoError(historyArchiver.ArchiveBundle(context.Background(), URI, bundleV1))
+	s.NoError(historyArchiver.ArchiveBundle(context.Background(), URI, bundleV100))
+	s.assertFileExists(path.Join(dir, constructBundleFilename(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)))
+
+	request := &archiver.GetBundleRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+	}
+	bundle, err := historyArchiver.GetBundle(context.Background(), URI, request)
+	s.NoError(err)
+	s.Equal(bundleV100, bundle)
+
+	version := int64(1)
+	request.CloseFailoverVersion = &version
+	bundle, err = historyArchiver.GetBundle(context.Background(), URI, request)
+	s.NoError(err)
+	s.Equal(bundleV1, bundle)
+
+	// bundles are ignored when picking the highest version of the archived history
+	data, err := encodeHistories(s.historyBatchesV1)
+	s.NoError(err)
+	s.NoError(writeFile(path.Join(dir, constructHistoryFilename(testNamespaceID, testWorkflowID, testRunID, 1)), data, testFileMode))
+	response, err := historyArchiver.Get(context.Background(), URI, &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	})
+	s.NoError(err)
+	s.Equal(s.historyBatchesV1, response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) newTestWorkflowBundle(historyBatches []*historypb.History, version int64) *archiverspb.WorkflowBundle {
+	lastBatch := historyBatches[len(historyBatches)-1].Events
+	bundle, err := archiver.NewWorkflowBundle(&archiver.ArchiveBundleRequest{
+		ArchiveHistoryRequest: archiver.ArchiveHistoryRequest{
+			NamespaceID:          testNamespaceID,
+			Namespace:            testNamespace,
+			WorkflowID:           testWorkflowID,
+			RunID:                testRunID,
+			NextEventID:          lastBatch[len(lastBatch)-1].GetEventId() + 1,
+			CloseFailoverVersion: version,
+		},
+		WorkflowTypeName: "test-workflow-type",
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		StartTime:        time.Date(2020, 8, 22, 1, 2, 3, 0, time.UTC),
+		CloseTime:        time.Date(2020, 8, 22, 2, 2, 3, 0, time.UTC),
+		MutableState:     "{}",
+	}, historyBatches, "active")
+	s.Require().NoError(err)
+	return bundle
 }
 
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
@@ -45,6 +45,12 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
+const (
+	historyFileSuffix  = ".history"
+	bundleFileSuffix   = ".bundle"
+	checksumFileSuffix = ".checksum"
+)
+
 var (
 	errDirectoryExpected  = errors.New("a path to a directory was expected")
 	errFileExpected       = errors.New("a path to a file was expected")
@@ -112,6 +118,20 @@ func readFile(filepath string) ([]byte, error) {
 	return ioutil.ReadFile(filepath)
 }
 
+// readChecksumFile returns the content of the checksum file, or an empty checksum
+// if the file doesn't exist, e.g. for histories archived before checksums were written
+func readChecksumFile(filepath string) (string, error) {
+	exists, err := fileExists(filepath)
+	if err != nil || !exists {
+		return "", err
+	}
+	checksum, err := readFile(filepath)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(checksum)), nil
+}
+
 func listFiles(dirPath string) ([]string, error) {
 	if info, err := os.Stat(dirPath); err != nil {
 		return nil, err
@@ -181,17 +201,20 @@ func deserializeGetHistoryToken(bytes []byte) (*getHistoryToken, error) {
 	return token, err
 }
 
-func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error) {
-	token := &queryVisibilityToken{}
-	err := json.Unmarshal(bytes, token)
-	return token, err
-}
-
 // File name construction
 
 func constructHistoryFilename(namespaceID, workflowID, runID string, version int64) string {
 	combinedHash := constructHistoryFilenamePrefix(namespaceID, workflowID, runID)
-	return fmt.Sprintf("%s_%v.history", combinedHash, version)
+	return fmt.Sprintf("%s_%v%s", combinedHash, version, historyFileSuffix)
+}
+
+func constructBundleFilename(namespaceID, workflowID, runID string, version int64) string {
+	combinedHash := constructHistoryFilenamePrefix(namespaceID, workflowID, runID)
+	return fmt.Sprintf("%s_%v%s", combinedHash, version, bundleFileSuffix)
+}
+
+func constructChecksumFilename(filename string) string {
+	return filename + checksumFileSuffix
 }
 
 func constructHistoryFilenamePrefix(namespaceID, workflowID, runID string) string {
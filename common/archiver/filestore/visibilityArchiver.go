@@ -26,6 +26,7 @@ package filestore
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path"
@@ -34,12 +35,11 @@ import (
 	"strings"
 	"time"
 
-	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
-	workflowpb "go.temporal.io/api/workflow/v1"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/service/config"
@@ -47,26 +47,21 @@ import (
 
 const (
 	errEncodeVisibilityRecord = "failed to encode visibility record"
+
+	visibilityFileSuffix = ".visibility"
 )
 
+var _ archiver.VisibilityLister = (*visibilityArchiver)(nil)
+
 type (
 	visibilityArchiver struct {
-		container   *archiver.VisibilityBootstrapContainer
-		fileMode    os.FileMode
-		dirMode     os.FileMode
-		queryParser QueryParser
+		container *archiver.VisibilityBootstrapContainer
+		fileMode  os.FileMode
+		dirMode   os.FileMode
 	}
 
-	queryVisibilityToken struct {
-		LastCloseTime time.Time
-		LastRunID     string
-	}
-
-	queryVisibilityRequest struct {
-		namespaceID   string
-		pageSize      int
-		nextPageToken []byte
-		parsedQuery   *parsedQuery
+	listVisibilityToken struct {
+		LastFilename string
 	}
 )
 
@@ -84,10 +79,9 @@ func NewVisibilityArchiver(
 		return nil, errInvalidDirMode
 	}
 	return &visibilityArchiver{
-		container:   container,
-		fileMode:    os.FileMode(fileMode),
-		dirMode:     os.FileMode(dirMode),
-		queryParser: NewQueryParser(),
+		container: container,
+		fileMode:  os.FileMode(fileMode),
+		dirMode:   os.FileMode(dirMode),
 	}, nil
 }
 
@@ -152,94 +146,122 @@ func (v *visibilityArchiver) Query(
 		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
 	}
 
-	parsedQuery, err := v.queryParser.Parse(request.Query)
+	dirPath := path.Join(URI.Path(), request.NamespaceID)
+	return archiver.QueryVisibility(request, func(query *archiver.VisibilityQuery) ([]*archiverspb.ArchiveVisibilityRequest, error) {
+		return loadVisibilityRecords(dirPath, query)
+	})
+}
+
+// loadVisibilityRecords reads the visibility records whose close time and run ID,
+// which are part of the filenames, may match the query
+func loadVisibilityRecords(dirPath string, query *archiver.VisibilityQuery) ([]*archiverspb.ArchiveVisibilityRequest, error) {
+	exists, err := directoryExists(dirPath)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	files, err := listFiles(dirPath)
 	if err != nil {
-		return nil, serviceerror.NewInvalidArgument(err.Error())
+		return nil, err
 	}
 
-	if parsedQuery.emptyResult {
-		return &archiver.QueryVisibilityResponse{}, nil
+	earliestCloseTime, latestCloseTime := query.TimeRange(definition.CloseTime)
+	runIDs, filterRunIDs := query.Values(definition.RunID)
+	hashedRunIDs := make(map[string]struct{}, len(runIDs))
+	for _, runID := range runIDs {
+		hashedRunIDs[hash(runID)] = struct{}{}
 	}
 
-	return v.query(ctx, URI, &queryVisibilityRequest{
-		namespaceID:   request.NamespaceID,
-		pageSize:      request.PageSize,
-		nextPageToken: request.NextPageToken,
-		parsedQuery:   parsedQuery,
-	})
+	var records []*archiverspb.ArchiveVisibilityRequest
+	for _, file := range files {
+		closeTime, hashedRunID, err := parseVisibilityFilename(file)
+		if err != nil {
+			return nil, err
+		}
+		if closeTime.Before(earliestCloseTime) || closeTime.After(latestCloseTime) {
+			continue
+		}
+		if _, ok := hashedRunIDs[hashedRunID]; filterRunIDs && !ok {
+			continue
+		}
+
+		encodedRecord, err := readFile(path.Join(dirPath, file))
+		if err != nil {
+			return nil, err
+		}
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
 }
 
-func (v *visibilityArchiver) query(
+// List walks the visibility records of the namespace in the order of their filenames
+func (v *visibilityArchiver) List(
 	ctx context.Context,
 	URI archiver.URI,
-	request *queryVisibilityRequest,
-) (*archiver.QueryVisibilityResponse, error) {
-	var token *queryVisibilityToken
-	if request.nextPageToken != nil {
-		var err error
-		token, err = deserializeQueryVisibilityToken(request.nextPageToken)
-		if err != nil {
+	request *archiver.ListVisibilityRequest,
+) (*archiver.ListVisibilityResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateListVisibilityRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidListVisibilityRequest.Error())
+	}
+
+	token := &listVisibilityToken{}
+	if request.NextPageToken != nil {
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil {
 			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
 		}
 	}
 
-	dirPath := path.Join(URI.Path(), request.namespaceID)
+	dirPath := path.Join(URI.Path(), request.NamespaceID)
 	exists, err := directoryExists(dirPath)
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
 	if !exists {
-		return &archiver.QueryVisibilityResponse{}, nil
+		return &archiver.ListVisibilityResponse{}, nil
 	}
 
 	files, err := listFiles(dirPath)
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
-
-	files, err = sortAndFilterFiles(files, token)
-	if err != nil {
-		return nil, serviceerror.NewInternal(err.Error())
-	}
-	if len(files) == 0 {
-		return &archiver.QueryVisibilityResponse{}, nil
+	var filenames []string
+	for _, file := range files {
+		if strings.HasSuffix(file, visibilityFileSuffix) && file > token.LastFilename {
+			filenames = append(filenames, file)
+		}
 	}
+	sort.Strings(filenames)
 
-	response := &archiver.QueryVisibilityResponse{}
-	for idx, file := range files {
-		encodedRecord, err := readFile(path.Join(dirPath, file))
-		if err != nil {
-			return nil, serviceerror.NewInternal(err.Error())
+	response := &archiver.ListVisibilityResponse{}
+	for idx, filename := range filenames {
+		if idx == request.PageSize {
+			encodedToken, err := serializeToken(&listVisibilityToken{LastFilename: filenames[idx-1]})
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+			response.NextPageToken = encodedToken
+			break
 		}
 
-		record, err := decodeVisibilityRecord(encodedRecord)
+		encodedRecord, err := readFile(path.Join(dirPath, filename))
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
-
-		if record.CloseTime.Before(request.parsedQuery.earliestCloseTime) {
-			break
-		}
-
-		if matchQuery(record, request.parsedQuery) {
-			response.Executions = append(response.Executions, convertToExecutionInfo(record))
-			if len(response.Executions) == request.pageSize {
-				if idx != len(files) {
-					newToken := &queryVisibilityToken{
-						LastCloseTime: timestamp.TimeValue(record.CloseTime),
-						LastRunID:     record.GetRunId(),
-					}
-					encodedToken, err := serializeToken(newToken)
-					if err != nil {
-						return nil, serviceerror.NewInternal(err.Error())
-					}
-					response.NextPageToken = encodedToken
-				}
-				break
-			}
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			response.CorruptedRecords = append(response.CorruptedRecords, path.Join(dirPath, filename))
+			continue
 		}
+		response.Records = append(response.Records, record)
 	}
-
 	return response, nil
 }
 
@@ -251,100 +273,18 @@ func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	return validateDirPath((URI.Path()))
 }
 
-type parsedVisFilename struct {
-	name        string
-	closeTime   time.Time
-	hashedRunID string
-}
-
-// sortAndFilterFiles sort visibility record file names based on close timestamp (desc) and use hashed runID to break ties.
-// if a nextPageToken is give, it only returns filenames that have a smaller close timestamp
-func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]string, error) {
-	var parsedFilenames []*parsedVisFilename
-	for _, name := range filenames {
-		pieces := strings.FieldsFunc(name, func(r rune) bool {
-			return r == '_' || r == '.'
-		})
-		if len(pieces) != 3 {
-			return nil, fmt.Errorf("failed to parse visibility filename %s", name)
-		}
-
-		closeTime, err := strconv.ParseInt(pieces[0], 10, 64)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse visibility filename %s", name)
-		}
-		parsedFilenames = append(parsedFilenames, &parsedVisFilename{
-			name:        name,
-			closeTime:   timestamp.UnixOrZeroTime(closeTime),
-			hashedRunID: pieces[1],
-		})
-	}
-
-	sort.Slice(parsedFilenames, func(i, j int) bool {
-		if parsedFilenames[i].closeTime.Equal(parsedFilenames[j].closeTime) {
-			return parsedFilenames[i].hashedRunID > parsedFilenames[j].hashedRunID
-		}
-		return parsedFilenames[i].closeTime.After(parsedFilenames[j].closeTime)
+// parseVisibilityFilename extracts the close time and the hashed run ID of a visibility record filename
+func parseVisibilityFilename(filename string) (time.Time, string, error) {
+	pieces := strings.FieldsFunc(filename, func(r rune) bool {
+		return r == '_' || r == '.'
 	})
-
-	startIdx := 0
-	if token != nil {
-		LastHashedRunID := hash(token.LastRunID)
-		startIdx = sort.Search(len(parsedFilenames), func(i int) bool {
-			if parsedFilenames[i].closeTime.Equal(token.LastCloseTime) {
-				return parsedFilenames[i].hashedRunID < LastHashedRunID
-			}
-			return parsedFilenames[i].closeTime.Before(token.LastCloseTime)
-		})
-	}
-
-	if startIdx == len(parsedFilenames) {
-		return []string{}, nil
-	}
-
-	var filteredFilenames []string
-	for _, parsedFilename := range parsedFilenames[startIdx:] {
-		filteredFilenames = append(filteredFilenames, parsedFilename.name)
+	if len(pieces) != 3 {
+		return time.Time{}, "", fmt.Errorf("failed to parse visibility filename %s", filename)
 	}
-	return filteredFilenames, nil
-}
 
-func matchQuery(record *archiverspb.ArchiveVisibilityRequest, query *parsedQuery) bool {
-	if record.CloseTime.Before(query.earliestCloseTime) || record.CloseTime.After(query.latestCloseTime) {
-		return false
-	}
-	if query.workflowID != nil && record.GetWorkflowId() != *query.workflowID {
-		return false
-	}
-	if query.runID != nil && record.GetRunId() != *query.runID {
-		return false
-	}
-	if query.workflowTypeName != nil && record.WorkflowTypeName != *query.workflowTypeName {
-		return false
-	}
-	if query.status != nil && record.Status != *query.status {
-		return false
-	}
-	return true
-}
-
-func convertToExecutionInfo(record *archiverspb.ArchiveVisibilityRequest) *workflowpb.WorkflowExecutionInfo {
-	return &workflowpb.WorkflowExecutionInfo{
-		Execution: &commonpb.WorkflowExecution{
-			WorkflowId: record.GetWorkflowId(),
-			RunId:      record.GetRunId(),
-		},
-		Type: &commonpb.WorkflowType{
-			Name: record.WorkflowTypeName,
-		},
-		StartTime:     record.StartTime,
-		ExecutionTime: record.ExecutionTime,
-		CloseTime:     record.CloseTime,
-		Status:        record.Status,
-		HistoryLength: record.HistoryLength,
-		Memo:          record.Memo,
-		SearchAttributes: &commonpb.SearchAttributes{
-			IndexedFields: archiver.ConvertSearchAttrToPayload(record.SearchAttributes),
-		},
+	closeTime, err := strconv.ParseInt(pieces[0], 10, 64)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("failed to parse visibility filename %s", filename)
 	}
+	return timestamp.UnixOrZeroTime(closeTime), pieces[1], nil
 }
@@ -38,13 +38,13 @@ import (
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.uber.org/zap"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/codec"
-	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log/loggerimpl"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -208,128 +208,56 @@ func (s *visibilityArchiverSuite) TestArchive_Success() {
 	s.Equal(request, archivedRecord)
 }
 
-func (s *visibilityArchiverSuite) TestMatchQuery() {
-	testCases := []struct {
-		query       *parsedQuery
-		record      *archiverspb.ArchiveVisibilityRequest
-		shouldMatch bool
-	}{
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-			},
-			record: &archiverspb.ArchiveVisibilityRequest{
-				CloseTime: timestamp.UnixOrZeroTimePtr(1999),
-			},
-			shouldMatch: true,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-			},
-			record: &archiverspb.ArchiveVisibilityRequest{
-				CloseTime: timestamp.UnixOrZeroTimePtr(999),
-			},
-			shouldMatch: false,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-				workflowID:        convert.StringPtr("random workflowID"),
-			},
-			record: &archiverspb.ArchiveVisibilityRequest{
-				CloseTime: timestamp.UnixOrZeroTimePtr(2000),
-			},
-			shouldMatch: false,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-				workflowID:        convert.StringPtr("random workflowID"),
-				runID:             convert.StringPtr("random runID"),
-			},
-			record: &archiverspb.ArchiveVisibilityRequest{
-				CloseTime:        timestamp.UnixOrZeroTimePtr(12345),
-				WorkflowId:       "random workflowID",
-				RunId:            "random runID",
-				WorkflowTypeName: "random type name",
-			},
-			shouldMatch: true,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-				workflowTypeName:  convert.StringPtr("some random type name"),
-			},
-			record: &archiverspb.ArchiveVisibilityRequest{
-				CloseTime: timestamp.UnixOrZeroTimePtr(12345),
-			},
-			shouldMatch: false,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-				workflowTypeName:  convert.StringPtr("some random type name"),
-				status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW),
-			},
-			record: &archiverspb.ArchiveVisibilityRequest{
-				CloseTime:        timestamp.UnixOrZeroTimePtr(12345),
-				Status:           enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW,
-				WorkflowTypeName: "some random type name",
-			},
-			shouldMatch: true,
-		},
-	}
+func (s *visibilityArchiverSuite) TestParseVisibilityFilename() {
+	closeTime, hashedRunID, err := parseVisibilityFilename(constructVisibilityFilename(timestamp.UnixOrZeroTimePtr(12345), testRunID))
+	s.NoError(err)
+	s.Equal(timestamp.UnixOrZeroTime(12345), closeTime)
+	s.Equal(hash(testRunID), hashedRunID)
 
-	for _, tc := range testCases {
-		s.Equal(tc.shouldMatch, matchQuery(tc.record, tc.query))
-	}
+	_, _, err = parseVisibilityFilename("not a visibility filename")
+	s.Error(err)
+	_, _, err = parseVisibilityFilename("abc_123.visibility")
+	s.Error(err)
 }
 
-func (s *visibilityArchiverSuite) TestSortAndFilterFiles() {
+func (s *visibilityArchiverSuite) TestLoadVisibilityRecords() {
+	dirPath := path.Join(s.testQueryDirectory, testNamespaceID)
 	testCases := []struct {
-		filenames      []string
-		token          *queryVisibilityToken
-		expectedResult []string
+		query           string
+		expectedRecords []*archiverspb.ArchiveVisibilityRequest
 	}{
 		{
-			filenames:      []string{"9_12345.vis", "5_0.vis", "9_54321.vis", "1000_654.vis", "1000_78.vis"},
-			expectedResult: []string{"1000_78.vis", "1000_654.vis", "9_54321.vis", "9_12345.vis", "5_0.vis"},
+			query:           "CloseTime >= 1000 AND CloseTime <= 12345",
+			expectedRecords: []*archiverspb.ArchiveVisibilityRequest{s.visibilityRecords[0], s.visibilityRecords[1]},
 		},
 		{
-			filenames: []string{"9_12345.vis", "5_0.vis", "9_54321.vis", "1000_654.vis", "1000_78.vis"},
-			token: &queryVisibilityToken{
-				LastCloseTime: time.Unix(0, 3),
-			},
-			expectedResult: []string{},
+			query:           "CloseTime < 10",
+			expectedRecords: []*archiverspb.ArchiveVisibilityRequest{s.visibilityRecords[3]},
 		},
 		{
-			filenames: []string{"9_12345.vis", "5_0.vis", "9_54321.vis", "1000_654.vis", "1000_78.vis"},
-			token: &queryVisibilityToken{
-				LastCloseTime: time.Unix(0, 999),
-			},
-			expectedResult: []string{"9_54321.vis", "9_12345.vis", "5_0.vis"},
+			// disjunctions can't narrow down the files to read, records are filtered by the query afterwards
+			query:           "RunId = 'another run ID' OR RunId = 'some random run ID'",
+			expectedRecords: s.visibilityRecords[:4],
 		},
 		{
-			filenames: []string{"9_12345.vis", "5_0.vis", "9_54321.vis", "1000_654.vis", "1000_78.vis"},
-			token: &queryVisibilityToken{
-				LastCloseTime: time.Unix(0, 5).UTC(),
-			},
-			expectedResult: []string{"5_0.vis"},
+			query:           "RunId IN ('another run ID', 'some random run ID')",
+			expectedRecords: []*archiverspb.ArchiveVisibilityRequest{s.visibilityRecords[1], s.visibilityRecords[2]},
 		},
 	}
 
 	for i, tc := range testCases {
-		result, err := sortAndFilterFiles(tc.filenames, tc.token)
+		query, err := archiver.ParseVisibilityQuery(tc.query)
 		s.NoError(err, "case %d", i)
-		s.Equal(tc.expectedResult, result, "case %d", i)
+		records, err := loadVisibilityRecords(dirPath, query)
+		s.NoError(err, "case %d", i)
+		s.ElementsMatch(tc.expectedRecords, records, "case %d", i)
 	}
+
+	query, err := archiver.ParseVisibilityQuery("CloseTime > 0")
+	s.NoError(err)
+	records, err := loadVisibilityRecords(path.Join(s.testQueryDirectory, "non-existent namespace ID"), query)
+	s.NoError(err)
+	s.Empty(records)
 }
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidURI() {
@@ -354,29 +282,21 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
-	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
 		NamespaceID: "some random namespaceID",
 		PageSize:    10,
 		Query:       "some invalid query",
 	})
 	s.Error(err)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
 	s.Nil(response)
 }
 
 func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 1),
-		latestCloseTime:   time.Unix(0, 101),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
-		Query:       "parsed by mockParser",
+		Query:       "CloseTime >= 1 AND CloseTime <= 101",
 		PageSize:    1,
 	}
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
@@ -388,36 +308,24 @@ func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 1),
-		latestCloseTime:   time.Unix(0, 101),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID:   testNamespaceID,
-		Query:         "parsed by mockParser",
+		Query:         "CloseTime >= 1 AND CloseTime <= 101",
 		PageSize:      1,
 		NextPageToken: []byte{1, 2, 3},
 	}
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
 	s.Error(err)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
 	s.Nil(response)
 }
 
 func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 1),
-		latestCloseTime:   time.Unix(0, 10001),
-		workflowID:        convert.StringPtr(testWorkflowID),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
 		PageSize:    10,
-		Query:       "parsed by mockParser",
+		Query:       "WorkflowId = '" + testWorkflowID + "' AND CloseTime >= 1 AND CloseTime <= 10001",
 	}
 	URI, err := archiver.NewURI("file://" + s.testQueryDirectory)
 	s.NoError(err)
@@ -426,22 +334,15 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	s.NotNil(response)
 	s.Nil(response.NextPageToken)
 	s.Len(response.Executions, 1)
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
+	s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
 }
 
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 1),
-		latestCloseTime:   time.Unix(0, 10001),
-		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
 		PageSize:    2,
-		Query:       "parsed by mockParser",
+		Query:       "ExecutionStatus = 'Failed' AND CloseTime >= 1 AND CloseTime <= 10001",
 	}
 	URI, err := archiver.NewURI("file://" + s.testQueryDirectory)
 	s.NoError(err)
@@ -450,8 +351,8 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.NotNil(response)
 	s.NotNil(response.NextPageToken)
 	s.Len(response.Executions, 2)
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), response.Executions[1])
+	s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
+	s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[1]), response.Executions[1])
 
 	request.NextPageToken = response.NextPageToken
 	response, err = visibilityArchiver.Query(context.Background(), URI, request)
@@ -459,7 +360,24 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.NotNil(response)
 	s.Nil(response.NextPageToken)
 	s.Len(response.Executions, 1)
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[3]), response.Executions[0])
+	s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[3]), response.Executions[0])
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_OrderBy() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+		Query:       "WorkflowId IN ('" + testWorkflowID + "', 'another workflow ID') OR ExecutionStatus = 'ContinuedAsNew' ORDER BY CloseTime ASC",
+	}
+	URI, err := archiver.NewURI("file://" + s.testQueryDirectory)
+	s.NoError(err)
+	response, err := visibilityArchiver.Query(context.Background(), URI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 2)
+	s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[2]), response.Executions[0])
+	s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[0]), response.Executions[1])
 }
 
 func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
@@ -468,13 +386,6 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	defer os.RemoveAll(dir)
 
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 10),
-		latestCloseTime:   time.Unix(0, 10001),
-		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	URI, err := archiver.NewURI("file://" + dir)
 	s.NoError(err)
 	for _, record := range s.visibilityRecords {
@@ -485,7 +396,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
 		PageSize:    1,
-		Query:       "parsed by mockParser",
+		Query:       "ExecutionStatus = 'Failed' AND CloseTime >= 10 AND CloseTime <= 10001",
 	}
 	executions := []*workflowpb.WorkflowExecutionInfo{}
 	for len(executions) == 0 || request.NextPageToken != nil {
@@ -496,8 +407,55 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 		request.NextPageToken = response.NextPageToken
 	}
 	s.Len(executions, 2)
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), executions[0])
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
+	s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[0]), executions[0])
+	s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[1]), executions[1])
+}
+
+func (s *visibilityArchiverSuite) TestList_Success() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.ListVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    3,
+	}
+	URI, err := archiver.NewURI("file://" + s.testQueryDirectory)
+	s.NoError(err)
+	response, err := visibilityArchiver.List(context.Background(), URI, request)
+	s.NoError(err)
+	s.NotNil(response.NextPageToken)
+	s.Len(response.Records, 3)
+	s.Empty(response.CorruptedRecords)
+	records := response.Records
+
+	request.NextPageToken = response.NextPageToken
+	response, err = visibilityArchiver.List(context.Background(), URI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Records, 1)
+	records = append(records, response.Records...)
+	s.ElementsMatch(s.visibilityRecords[:4], records)
+}
+
+func (s *visibilityArchiverSuite) TestList_CorruptedRecord() {
+	dir, err := ioutil.TempDir("", "TestListCorrupted")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	err = visibilityArchiver.Archive(context.Background(), URI, s.visibilityRecords[0])
+	s.NoError(err)
+	corruptedFile := path.Join(dir, testNamespaceID, "1_2.visibility")
+	s.NoError(writeFile(corruptedFile, []byte("not a visibility record"), testFileMode))
+
+	response, err := visibilityArchiver.List(context.Background(), URI, &archiver.ListVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+	})
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal([]*archiverspb.ArchiveVisibilityRequest{s.visibilityRecords[0]}, response.Records)
+	s.Equal([]string{corruptedFile}, response.CorruptedRecords)
 }
 
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
@@ -29,6 +29,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"path/filepath"
+	"strings"
 
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
@@ -57,6 +58,7 @@ const (
 	errEncodeHistory      = "failed to encode history batches"
 	errBucketHistory      = "failed to get google storage bucket handle"
 	errWriteFile          = "failed to write history to google storage"
+	checksumFileSuffix    = ".checksum"
 )
 
 type historyArchiver struct {
@@ -173,6 +175,12 @@ func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request
 			return errUploadNonRetryable
 		}
 
+		checksum, err := archiver.HistoryChecksum(historyBlob.Body)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+			return errUploadNonRetryable
+		}
+
 		filename := constructHistoryFilenameMultipart(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, part)
 		if exist, _ := h.gcloudStorage.Exist(ctx, URI, filename); !exist {
 			if err := h.gcloudStorage.Upload(ctx, URI, filename, encodedHistoryPart); err != nil {
@@ -180,6 +188,11 @@ func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request
 				scope.IncCounter(metrics.HistoryArchiverArchiveTransientErrorCount)
 				return err
 			}
+			if err := h.gcloudStorage.Upload(ctx, URI, constructChecksumFilename(filename), []byte(checksum)); err != nil {
+				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+				scope.IncCounter(metrics.HistoryArchiverArchiveTransientErrorCount)
+				return err
+			}
 
 			totalUploadSize = totalUploadSize + int64(binary.Size(encodedHistoryPart))
 		}
@@ -246,9 +259,16 @@ outer:
 		}
 
 		batches, err := encoder.DecodeHistories(encodedHistoryBatches)
+		if err != nil {
+			return nil, serviceerror.NewDataLoss(err.Error())
+		}
+		checksum, err := h.getChecksum(ctx, URI, filename)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
+		if err := archiver.ValidateHistoryChecksum(batches, checksum); err != nil {
+			return nil, serviceerror.NewDataLoss(err.Error())
+		}
 		// trim the batches in the beginning based on token.BatchIdxOffset
 		batches = batches[token.BatchIdxOffset:]
 
@@ -339,6 +359,21 @@ func historyMutated(request *archiver.ArchiveHistoryRequest, historyBatches []*h
 	return lastFailoverVersion != request.CloseFailoverVersion || lastEventID+1 != request.NextEventID
 }
 
+// getChecksum returns the checksum of the history part, or an empty checksum
+// for parts archived before checksums were written
+func (h *historyArchiver) getChecksum(ctx context.Context, URI archiver.URI, filename string) (string, error) {
+	checksumFilename := constructChecksumFilename(filename)
+	exist, err := h.gcloudStorage.Exist(ctx, URI, checksumFilename)
+	if err != nil || !exist {
+		return "", err
+	}
+	checksum, err := h.gcloudStorage.Get(ctx, URI, checksumFilename)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(checksum)), nil
+}
+
 func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.URI, request *archiver.GetHistoryRequest) (*int64, *int, *int, error) {
 
 	filenames, err := h.gcloudStorage.Query(ctx, URI, constructHistoryFilenamePrefix(request.NamespaceID, request.WorkflowID, request.RunID))
@@ -27,6 +27,7 @@ package gcloud
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -436,6 +437,7 @@ func (h *historyArchiverSuite) TestGet_Success_PickHighestVersion() {
 	storageWrapper := &mocks.Client{}
 	storageWrapper.On("Exist", ctx, URI, "").Return(true, nil).Times(1)
 	storageWrapper.On("Query", ctx, URI, mock.Anything).Return([]string{"905702227796330300141628222723188294514017512010591354159_-24_0.history", "905702227796330300141628222723188294514017512010591354159_-25_0.history"}, nil).Times(1)
+	storageWrapper.On("Exist", ctx, URI, mock.MatchedBy(isChecksumFilename)).Return(false, nil)
 	storageWrapper.On("Get", ctx, URI, "141323698701063509081739672280485489488911532452831150339470_-24_0.history").Return([]byte(exampleHistoryRecord), nil)
 	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
 	historyArchiver := newHistoryArchiver(h.container, historyIterator, storageWrapper)
@@ -460,6 +462,7 @@ func (h *historyArchiverSuite) TestGet_Success_UseProvidedVersion() {
 	storageWrapper := &mocks.Client{}
 	storageWrapper.On("Exist", ctx, URI, "").Return(true, nil).Times(1)
 	storageWrapper.On("Query", ctx, URI, "141323698701063509081739672280485489488911532452831150339470").Return([]string{"905702227796330300141628222723188294514017512010591354159_-24_0.history", "905702227796330300141628222723188294514017512010591354159_-25_0.history"}, nil).Times(1)
+	storageWrapper.On("Exist", ctx, URI, mock.MatchedBy(isChecksumFilename)).Return(false, nil)
 	storageWrapper.On("Get", ctx, URI, "141323698701063509081739672280485489488911532452831150339470_-25_0.history").Return([]byte(exampleHistoryRecord), nil)
 	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
 	historyArchiver := newHistoryArchiver(h.container, historyIterator, storageWrapper)
@@ -477,6 +480,33 @@ func (h *historyArchiverSuite) TestGet_Success_UseProvidedVersion() {
 	h.Nil(response.NextPageToken)
 }
 
+func (h *historyArchiverSuite) TestGet_Fail_ChecksumMismatch() {
+
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(h.T())
+	URI, err := archiver.NewURI("gs://my-bucket-cad/temporal_archival/development")
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", ctx, URI, "").Return(true, nil).Times(1)
+	storageWrapper.On("Query", ctx, URI, "141323698701063509081739672280485489488911532452831150339470").Return([]string{"905702227796330300141628222723188294514017512010591354159_-24_0.history"}, nil).Times(1)
+	storageWrapper.On("Get", ctx, URI, "141323698701063509081739672280485489488911532452831150339470_-24_0.history").Return([]byte(exampleHistoryRecord), nil)
+	storageWrapper.On("Exist", ctx, URI, "141323698701063509081739672280485489488911532452831150339470_-24_0.history.checksum").Return(true, nil)
+	storageWrapper.On("Get", ctx, URI, "141323698701063509081739672280485489488911532452831150339470_-24_0.history.checksum").Return([]byte("deadbeef"), nil)
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyArchiver := newHistoryArchiver(h.container, historyIterator, storageWrapper)
+	request := &archiver.GetHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		PageSize:             testPageSize,
+		CloseFailoverVersion: convert.Int64Ptr(-24),
+	}
+
+	h.NoError(err)
+	response, err := historyArchiver.Get(ctx, URI, request)
+	h.Nil(response)
+	h.IsType(&serviceerror.DataLoss{}, err)
+}
+
 func (h *historyArchiverSuite) TestGet_Success_PageSize() {
 
 	ctx := context.Background()
@@ -485,6 +515,7 @@ func (h *historyArchiverSuite) TestGet_Success_PageSize() {
 	storageWrapper := &mocks.Client{}
 	storageWrapper.On("Exist", ctx, URI, "").Return(true, nil).Times(1)
 	storageWrapper.On("Query", ctx, URI, "141323698701063509081739672280485489488911532452831150339470").Return([]string{"905702227796330300141628222723188294514017512010591354159_-24_0.history", "905702227796330300141628222723188294514017512010591354159_-24_1.history", "905702227796330300141628222723188294514017512010591354159_-24_2.history", "905702227796330300141628222723188294514017512010591354159_-24_3.history", "905702227796330300141628222723188294514017512010591354159_-25_0.history"}, nil).Times(1)
+	storageWrapper.On("Exist", ctx, URI, mock.MatchedBy(isChecksumFilename)).Return(false, nil)
 	storageWrapper.On("Get", ctx, URI, "141323698701063509081739672280485489488911532452831150339470_-24_0.history").Return([]byte(exampleHistoryRecord), nil)
 	storageWrapper.On("Get", ctx, URI, "141323698701063509081739672280485489488911532452831150339470_-24_1.history").Return([]byte(exampleHistoryRecord), nil)
 	storageWrapper.On("Get", ctx, URI, "141323698701063509081739672280485489488911532452831150339470_-24_2.history").Return([]byte(exampleHistoryRecord), nil)
@@ -514,6 +545,7 @@ func (h *historyArchiverSuite) TestGet_Success_FromToken() {
 	storageWrapper := &mocks.Client{}
 	storageWrapper.On("Exist", ctx, URI, "").Return(true, nil).Times(1)
 	storageWrapper.On("Query", ctx, URI, "141323698701063509081739672280485489488911532452831150339470").Return([]string{"905702227796330300141628222723188294514017512010591354159_-24_0.history", "905702227796330300141628222723188294514017512010591354159_-24_1.history", "905702227796330300141628222723188294514017512010591354159_-24_2.history", "905702227796330300141628222723188294514017512010591354159_-24_3.history", "905702227796330300141628222723188294514017512010591354159_-25_0.history"}, nil).Times(1)
+	storageWrapper.On("Exist", ctx, URI, mock.MatchedBy(isChecksumFilename)).Return(false, nil)
 	storageWrapper.On("Get", ctx, URI, "141323698701063509081739672280485489488911532452831150339470_-24_0.history").Return([]byte(exampleHistoryRecord), nil)
 	storageWrapper.On("Get", ctx, URI, "141323698701063509081739672280485489488911532452831150339470_-24_1.history").Return([]byte(exampleHistoryRecord), nil)
 	storageWrapper.On("Get", ctx, URI, "141323698701063509081739672280485489488911532452831150339470_-24_2.history").Return([]byte(exampleHistoryRecord), nil)
@@ -560,3 +592,7 @@ func (h *historyArchiverSuite) TestGet_Success_FromToken() {
 
 	h.EqualValues(4, numOfEvents)
 }
+
+func isChecksumFilename(filename string) bool {
+	return strings.HasSuffix(filename, checksumFileSuffix)
+}
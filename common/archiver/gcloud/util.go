@@ -29,14 +29,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/dgryski/go-farm"
 	"github.com/gogo/protobuf/proto"
-	commonpb "go.temporal.io/api/common/v1"
-	workflowpb "go.temporal.io/api/workflow/v1"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
@@ -59,6 +58,10 @@ func constructHistoryFilenameMultipart(namespaceID, workflowID, runID string, ve
 	return fmt.Sprintf("%s_%v_%v.history", combinedHash, version, partNumber)
 }
 
+func constructChecksumFilename(filename string) string {
+	return filename + checksumFileSuffix
+}
+
 func constructHistoryFilenamePrefix(namespaceID, workflowID, runID string) string {
 	return strings.Join([]string{hash(namespaceID), hash(workflowID), hash(runID)}, "")
 }
@@ -67,25 +70,6 @@ func constructVisibilityFilenamePrefix(namespaceID, tag string) string {
 	return fmt.Sprintf("%s/%s", namespaceID, tag)
 }
 
-func constructTimeBasedSearchKey(namespaceID, tag string, t time.Time, precision string) string {
-	var timeFormat = ""
-	switch precision {
-	case PrecisionSecond:
-		timeFormat = ":05"
-		fallthrough
-	case PrecisionMinute:
-		timeFormat = ":04" + timeFormat
-		fallthrough
-	case PrecisionHour:
-		timeFormat = "15" + timeFormat
-		fallthrough
-	case PrecisionDay:
-		timeFormat = "2006-01-02T" + timeFormat
-	}
-
-	return fmt.Sprintf("%s_%s", constructVisibilityFilenamePrefix(namespaceID, tag), t.Format(timeFormat))
-}
-
 func hash(s string) (result string) {
 	if s != "" {
 		return fmt.Sprintf("%v", farm.Fingerprint64([]byte(s)))
@@ -153,97 +137,56 @@ func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error
 	return token, err
 }
 
-func convertToExecutionInfo(record *archiverspb.ArchiveVisibilityRequest) *workflowpb.WorkflowExecutionInfo {
-	return &workflowpb.WorkflowExecutionInfo{
-		Execution: &commonpb.WorkflowExecution{
-			WorkflowId: record.GetWorkflowId(),
-			RunId:      record.GetRunId(),
-		},
-		Type: &commonpb.WorkflowType{
-			Name: record.WorkflowTypeName,
-		},
-		StartTime:     record.StartTime,
-		ExecutionTime: record.ExecutionTime,
-		CloseTime:     record.CloseTime,
-		Status:        record.Status,
-		HistoryLength: record.HistoryLength,
-		Memo:          record.Memo,
-		SearchAttributes: &commonpb.SearchAttributes{
-			IndexedFields: archiver.ConvertSearchAttrToPayload(record.SearchAttributes),
-		},
-	}
+// visibilityFilenameFilter restricts the visibility filenames by their timestamp and hashes,
+// nil hash sets don't restrict the filenames
+type visibilityFilenameFilter struct {
+	earliestTime      time.Time
+	latestTime        time.Time
+	workflowTypeNames map[string]struct{}
+	workflowIDs       map[string]struct{}
+	runIDs            map[string]struct{}
 }
 
-func newRunIDPrecondition(runID string) connector.Precondition {
+func newVisibilityFilenamePrecondition(filter *visibilityFilenameFilter) connector.Precondition {
 	return func(subject interface{}) bool {
-
-		if runID == "" {
-			return true
-		}
-
 		fileName, ok := subject.(string)
 		if !ok {
 			return false
 		}
 
-		if strings.Contains(fileName, runID) {
-			fileNameParts := strings.Split(fileName, "_")
-			if len(fileNameParts) != 5 {
-				return true
-			}
-			return strings.Contains(fileName, fileNameParts[4])
-		}
-
-		return false
-	}
-}
-
-func newWorkflowIDPrecondition(workflowID string) connector.Precondition {
-	return func(subject interface{}) bool {
-
-		if workflowID == "" {
-			return true
-		}
-
-		fileName, ok := subject.(string)
-		if !ok {
+		// filenames have the format <tag>_<timestamp>_<workflowTypeName hash>_<workflowID hash>_<runID hash>.visibility
+		fileNameParts := strings.Split(strings.TrimSuffix(path.Base(fileName), ".visibility"), "_")
+		if len(fileNameParts) != 5 {
 			return false
 		}
-
-		if strings.Contains(fileName, workflowID) {
-			fileNameParts := strings.Split(fileName, "_")
-			if len(fileNameParts) != 5 {
-				return true
-			}
-			return strings.Contains(fileName, fileNameParts[3])
+		t, err := time.Parse(time.RFC3339, fileNameParts[1])
+		if err != nil || t.Before(filter.earliestTime) || t.After(filter.latestTime) {
+			return false
 		}
-
-		return false
+		return containsHash(filter.workflowTypeNames, fileNameParts[2]) &&
+			containsHash(filter.workflowIDs, fileNameParts[3]) &&
+			containsHash(filter.runIDs, fileNameParts[4])
 	}
 }
 
-func newWorkflowTypeNamePrecondition(workflowTypeName string) connector.Precondition {
-	return func(subject interface{}) bool {
-
-		if workflowTypeName == "" {
-			return true
-		}
-
-		fileName, ok := subject.(string)
-		if !ok {
-			return false
-		}
-
-		if strings.Contains(fileName, workflowTypeName) {
-			fileNameParts := strings.Split(fileName, "_")
-			if len(fileNameParts) != 5 {
-				return true
-			}
-			return strings.Contains(fileName, fileNameParts[2])
-		}
+func containsHash(hashes map[string]struct{}, h string) bool {
+	if hashes == nil {
+		return true
+	}
+	_, ok := hashes[h]
+	return ok
+}
 
-		return false
+func hashValues(values []string) map[string]struct{} {
+	hashes := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		hashes[hash(value)] = struct{}{}
 	}
+	return hashes
+}
+
+func isUnboundedTimeRange(earliest time.Time, latest time.Time) bool {
+	return earliest.IsZero() && latest.Equal(archiver.MaxVisibilityTime)
 }
 
 func isRetryableError(err error) (retryable bool) {
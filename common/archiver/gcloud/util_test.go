@@ -33,6 +33,7 @@ import (
 	historypb "go.temporal.io/api/history/v1"
 
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/codec"
 )
 
@@ -135,110 +136,67 @@ func (s *utilSuite) TestConstructVisibilityFilenamePrefix() {
 	s.Equal("namespaceID/startTimeout", constructVisibilityFilenamePrefix("namespaceID", indexKeyStartTimeout))
 }
 
-func (s *utilSuite) TestConstructTimeBasedSearchKey() {
-	t, _ := time.Parse(time.RFC3339, "2019-10-04T11:00:00+00:00")
-	s.Equal("namespaceID/startTimeout_2019-10-04T", constructTimeBasedSearchKey("namespaceID", indexKeyStartTimeout, t, "Day"))
-}
-
 func (s *utilSuite) TestConstructVisibilityFilename() {
 	s.Equal("namespaceID/startTimeout_1970-01-01T00:24:32Z_4346151385925082125_8344541402884576509_131521284625246243.visibility", constructVisibilityFilename("namespaceID", "workflowTypeName", "workflowID", "runID", indexKeyStartTimeout, time.Date(1970, 01, 01, 0, 24, 32, 0, time.UTC)))
 }
 
-func (s *utilSuite) TestWorkflowIdPrecondition() {
+func (s *utilSuite) TestVisibilityFilenamePrecondition() {
+	fileName := "temporal_archival/development/namespaceID/closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility"
+	closeTime := time.Date(2020, 02, 27, 9, 42, 28, 0, time.UTC)
 	testCases := []struct {
-		workflowID     string
+		filter         *visibilityFilenameFilter
 		fileName       string
 		expectedResult bool
 	}{
 		{
-			workflowID:     "4418294404690464320",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
+			filter:         &visibilityFilenameFilter{latestTime: archiver.MaxVisibilityTime},
+			fileName:       fileName,
 			expectedResult: true,
 		},
 		{
-			workflowID:     "testWorkflowID",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
-			expectedResult: false,
-		},
-		{
-			workflowID:     "",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
+			filter:         &visibilityFilenameFilter{earliestTime: closeTime, latestTime: closeTime},
+			fileName:       fileName,
 			expectedResult: true,
 		},
-	}
-
-	for _, testCase := range testCases {
-		s.Equal(newWorkflowIDPrecondition(testCase.workflowID)(testCase.fileName), testCase.expectedResult)
-	}
-
-}
-
-func (s *utilSuite) TestRunIdPrecondition() {
-	testCases := []struct {
-		workflowID     string
-		runID          string
-		fileName       string
-		expectedResult bool
-	}{
 		{
-			workflowID:     "4418294404690464320",
-			runID:          "15619178330501475177",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
-			expectedResult: true,
-		},
-		{
-			workflowID:     "4418294404690464320",
-			runID:          "15619178330501475177",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_unkonwnRunID.visibility",
+			filter:         &visibilityFilenameFilter{earliestTime: closeTime.Add(time.Second), latestTime: archiver.MaxVisibilityTime},
+			fileName:       fileName,
 			expectedResult: false,
 		},
 		{
-			workflowID:     "4418294404690464320",
-			runID:          "",
-			fileName:       "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_unkonwnRunID.visibility",
+			filter: &visibilityFilenameFilter{
+				latestTime:        archiver.MaxVisibilityTime,
+				workflowTypeNames: map[string]struct{}{"12851121011173788097": {}},
+				workflowIDs:       map[string]struct{}{"4418294404690464320": {}, "testWorkflowID": {}},
+				runIDs:            map[string]struct{}{"15619178330501475177": {}},
+			},
+			fileName:       fileName,
 			expectedResult: true,
 		},
-	}
-
-	for _, testCase := range testCases {
-		s.Equal(newRunIDPrecondition(testCase.runID)(testCase.fileName), testCase.expectedResult)
-	}
-
-}
-
-func (s *utilSuite) TestWorkflowTypeNamePrecondition() {
-	testCases := []struct {
-		workflowID       string
-		runID            string
-		workflowTypeName string
-		fileName         string
-		expectedResult   bool
-	}{
 		{
-			workflowID:       "4418294404690464320",
-			runID:            "15619178330501475177",
-			workflowTypeName: "12851121011173788097",
-			fileName:         "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility",
-			expectedResult:   true,
+			filter: &visibilityFilenameFilter{
+				latestTime:  archiver.MaxVisibilityTime,
+				workflowIDs: map[string]struct{}{"testWorkflowID": {}},
+			},
+			fileName:       fileName,
+			expectedResult: false,
 		},
 		{
-			workflowID:       "4418294404690464320",
-			runID:            "15619178330501475177",
-			workflowTypeName: "12851121011173788097",
-			fileName:         "closeTimeout_2020-02-27T09:42:28Z_12851121011173788098_4418294404690464320_15619178330501475177.visibility",
-			expectedResult:   false,
+			filter: &visibilityFilenameFilter{
+				latestTime: archiver.MaxVisibilityTime,
+				runIDs:     map[string]struct{}{},
+			},
+			fileName:       fileName,
+			expectedResult: false,
 		},
 		{
-			workflowID:       "4418294404690464320",
-			runID:            "15619178330501475177",
-			workflowTypeName: "",
-			fileName:         "closeTimeout_2020-02-27T09:42:28Z_unkownWorkflowTypeName_4418294404690464320_15619178330501475177.visibility",
-			expectedResult:   true,
+			filter:         &visibilityFilenameFilter{latestTime: archiver.MaxVisibilityTime},
+			fileName:       "closeTimeout_2020-02-27T09:42:28Z_unknownRunID.visibility",
+			expectedResult: false,
 		},
 	}
 
-	for _, testCase := range testCases {
-		s.Equal(newWorkflowTypeNamePrecondition(testCase.workflowTypeName)(testCase.fileName), testCase.expectedResult)
+	for i, testCase := range testCases {
+		s.Equal(testCase.expectedResult, newVisibilityFilenamePrecondition(testCase.filter)(testCase.fileName), "case %d", i)
 	}
-
 }
@@ -36,6 +36,7 @@ import (
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/gcloud/connector"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -53,30 +54,23 @@ var (
 	errRetryable = errors.New("retryable error")
 )
 
+var _ archiver.VisibilityLister = (*visibilityArchiver)(nil)
+
 type (
 	visibilityArchiver struct {
 		container     *archiver.VisibilityBootstrapContainer
 		gcloudStorage connector.Client
-		queryParser   QueryParser
 	}
 
 	queryVisibilityToken struct {
 		Offset int
 	}
-
-	queryVisibilityRequest struct {
-		namespaceID   string
-		pageSize      int
-		nextPageToken []byte
-		parsedQuery   *parsedQuery
-	}
 )
 
 func newVisibilityArchiver(container *archiver.VisibilityBootstrapContainer, storage connector.Client) *visibilityArchiver {
 	return &visibilityArchiver{
 		container:     container,
 		gcloudStorage: storage,
-		queryParser:   NewQueryParser(),
 	}
 }
 
@@ -163,81 +157,105 @@ func (v *visibilityArchiver) Query(ctx context.Context, URI archiver.URI, reques
 		return nil, &serviceerror.InvalidArgument{Message: archiver.ErrInvalidQueryVisibilityRequest.Error()}
 	}
 
-	parsedQuery, err := v.queryParser.Parse(request.Query)
-	if err != nil {
-		return nil, &serviceerror.InvalidArgument{Message: err.Error()}
+	return archiver.QueryVisibility(request, func(query *archiver.VisibilityQuery) ([]*archiverspb.ArchiveVisibilityRequest, error) {
+		return v.loadVisibilityRecords(ctx, URI, request.NamespaceID, query)
+	})
+}
+
+// loadVisibilityRecords reads the records from the close time, or start time, index of the namespace.
+// The files are narrowed down to the time range of the query and by the hashes in their filenames.
+func (v *visibilityArchiver) loadVisibilityRecords(ctx context.Context, URI archiver.URI, namespaceID string, query *archiver.VisibilityQuery) ([]*archiverspb.ArchiveVisibilityRequest, error) {
+	indexKey := indexKeyCloseTimeout
+	earliest, latest := query.TimeRange(definition.CloseTime)
+	if startEarliest, startLatest := query.TimeRange(definition.StartTime); isUnboundedTimeRange(earliest, latest) && !isUnboundedTimeRange(startEarliest, startLatest) {
+		indexKey = indexKeyStartTimeout
+		earliest, latest = startEarliest, startLatest
 	}
+	// timestamps of the filenames are truncated to the second
+	earliest = earliest.Truncate(time.Second)
 
-	if parsedQuery.emptyResult {
-		return &archiver.QueryVisibilityResponse{}, nil
+	filter := &visibilityFilenameFilter{
+		earliestTime: earliest,
+		latestTime:   latest,
+	}
+	if workflowTypeNames, ok := query.Values(definition.WorkflowType); ok {
+		filter.workflowTypeNames = hashValues(workflowTypeNames)
+	}
+	if workflowIDs, ok := query.Values(definition.WorkflowID); ok {
+		filter.workflowIDs = hashValues(workflowIDs)
+	}
+	if runIDs, ok := query.Values(definition.RunID); ok {
+		filter.runIDs = hashValues(runIDs)
 	}
 
-	return v.query(ctx, URI, &queryVisibilityRequest{
-		namespaceID:   request.NamespaceID,
-		pageSize:      request.PageSize,
-		nextPageToken: request.NextPageToken,
-		parsedQuery:   parsedQuery,
-	})
-}
+	prefix := constructVisibilityFilenamePrefix(namespaceID, indexKey) + "_" + archiver.TimeRangePrefix(earliest, latest, time.RFC3339)
+	filenames, _, _, err := v.gcloudStorage.QueryWithFilters(ctx, URI, prefix, 0, 0, []connector.Precondition{newVisibilityFilenamePrecondition(filter)})
+	if err != nil {
+		return nil, err
+	}
 
-func (v *visibilityArchiver) query(ctx context.Context, URI archiver.URI, request *queryVisibilityRequest) (*archiver.QueryVisibilityResponse, error) {
-	token := new(queryVisibilityToken)
-	if request.nextPageToken != nil {
-		var err error
-		token, err = deserializeQueryVisibilityToken(request.nextPageToken)
+	records := make([]*archiverspb.ArchiveVisibilityRequest, 0, len(filenames))
+	for _, file := range filenames {
+		encodedRecord, err := v.gcloudStorage.Get(ctx, URI, fmt.Sprintf("%s/%s", namespaceID, filepath.Base(file)))
 		if err != nil {
-			return nil, &serviceerror.InvalidArgument{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+			return nil, err
 		}
-	}
 
-	var prefix = constructVisibilityFilenamePrefix(request.namespaceID, indexKeyCloseTimeout)
-	if !request.parsedQuery.closeTime.IsZero() {
-		prefix = constructTimeBasedSearchKey(request.namespaceID, indexKeyCloseTimeout, request.parsedQuery.closeTime, *request.parsedQuery.searchPrecision)
-	}
-	if !request.parsedQuery.startTime.IsZero() {
-		prefix = constructTimeBasedSearchKey(request.namespaceID, indexKeyStartTimeout, request.parsedQuery.startTime, *request.parsedQuery.searchPrecision)
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
 	}
+	return records, nil
+}
 
-	filters := make([]connector.Precondition, 0)
-	if request.parsedQuery.workflowID != nil {
-		filters = append(filters, newWorkflowIDPrecondition(hash(*request.parsedQuery.workflowID)))
+// List walks the visibility records of the namespace through their close time index.
+func (v *visibilityArchiver) List(ctx context.Context, URI archiver.URI, request *archiver.ListVisibilityRequest) (*archiver.ListVisibilityResponse, error) {
+	if err := v.validateURI(URI); err != nil {
+		return nil, &serviceerror.InvalidArgument{Message: archiver.ErrInvalidURI.Error()}
 	}
 
-	if request.parsedQuery.runID != nil {
-		filters = append(filters, newWorkflowIDPrecondition(hash(*request.parsedQuery.runID)))
+	if err := archiver.ValidateListVisibilityRequest(request); err != nil {
+		return nil, &serviceerror.InvalidArgument{Message: archiver.ErrInvalidListVisibilityRequest.Error()}
 	}
 
-	if request.parsedQuery.workflowType != nil {
-		filters = append(filters, newWorkflowIDPrecondition(hash(*request.parsedQuery.workflowType)))
+	token := new(queryVisibilityToken)
+	if request.NextPageToken != nil {
+		var err error
+		token, err = deserializeQueryVisibilityToken(request.NextPageToken)
+		if err != nil {
+			return nil, &serviceerror.InvalidArgument{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+		}
 	}
 
-	filenames, completed, currentCursorPos, err := v.gcloudStorage.QueryWithFilters(ctx, URI, prefix, request.pageSize, token.Offset, filters)
+	prefix := constructVisibilityFilenamePrefix(request.NamespaceID, indexKeyCloseTimeout)
+	filenames, _, currentCursorPos, err := v.gcloudStorage.QueryWithFilters(ctx, URI, prefix, request.PageSize, token.Offset, nil)
 	if err != nil {
-		return nil, &serviceerror.InvalidArgument{Message: err.Error()}
+		return nil, &serviceerror.Internal{Message: err.Error()}
 	}
 
-	response := &archiver.QueryVisibilityResponse{}
+	response := &archiver.ListVisibilityResponse{}
 	for _, file := range filenames {
-		encodedRecord, err := v.gcloudStorage.Get(ctx, URI, fmt.Sprintf("%s/%s", request.namespaceID, filepath.Base(file)))
+		filename := fmt.Sprintf("%s/%s", request.NamespaceID, filepath.Base(file))
+		encodedRecord, err := v.gcloudStorage.Get(ctx, URI, filename)
 		if err != nil {
-			return nil, &serviceerror.InvalidArgument{Message: err.Error()}
+			return nil, &serviceerror.Internal{Message: err.Error()}
 		}
 
 		record, err := decodeVisibilityRecord(encodedRecord)
 		if err != nil {
-			return nil, &serviceerror.InvalidArgument{Message: err.Error()}
+			response.CorruptedRecords = append(response.CorruptedRecords, filename)
+			continue
 		}
-
-		response.Executions = append(response.Executions, convertToExecutionInfo(record))
+		response.Records = append(response.Records, record)
 	}
 
-	if !completed {
-		newToken := &queryVisibilityToken{
-			Offset: currentCursorPos,
-		}
-		encodedToken, err := serializeToken(newToken)
+	// QueryWithFilters reports a full page as completed, a full page may be followed by more records
+	if len(filenames) == request.PageSize {
+		encodedToken, err := serializeToken(&queryVisibilityToken{Offset: currentCursorPos})
 		if err != nil {
-			return nil, &serviceerror.InvalidArgument{Message: err.Error()}
+			return nil, &serviceerror.Internal{Message: err.Error()}
 		}
 		response.NextPageToken = encodedToken
 	}
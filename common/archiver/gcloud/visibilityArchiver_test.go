@@ -27,8 +27,9 @@ package gcloud
 import (
 	"context"
 	"errors"
+	"sort"
+	"strings"
 	"testing"
-	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/mock"
@@ -36,12 +37,13 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.uber.org/zap"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/gcloud/connector"
 	"go.temporal.io/server/common/archiver/gcloud/connector/mocks"
-	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log/loggerimpl"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -71,6 +73,28 @@ func (s *visibilityArchiverSuite) SetupTest() {
 			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
 			HistoryLength:    36,
 		},
+		{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       "another-workflow-id",
+			RunId:            "another-run-id",
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.UnixOrZeroTimePtr(1580900400000000000),
+			CloseTime:        timestamp.UnixOrZeroTimePtr(1580904000000000000),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			HistoryLength:    72,
+		},
+		{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       "yet-another-workflow-id",
+			RunId:            "yet-another-run-id",
+			WorkflowTypeName: "another-workflow-type",
+			StartTime:        timestamp.UnixOrZeroTimePtr(1580860800000000000),
+			CloseTime:        timestamp.UnixOrZeroTimePtr(1580943600000000000),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT,
+			HistoryLength:    108,
+		},
 	}
 }
 func TestVisibilityArchiverSuiteSuite(t *testing.T) {
@@ -206,19 +230,14 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	storageWrapper := &mocks.Client{}
 	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
 	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
-	s.NoError(err)
-	mockCtrl := gomock.NewController(s.T())
-	defer mockCtrl.Finish()
 
-	mockParser := NewMockQueryParser(mockCtrl)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
-	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(ctx, URI, &archiver.QueryVisibilityRequest{
 		NamespaceID: "some random namespaceID",
 		PageSize:    10,
 		Query:       "some invalid query",
 	})
 	s.Error(err)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
 	s.Nil(response)
 }
 
@@ -228,26 +247,34 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 	storageWrapper := &mocks.Client{}
 	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
 	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
-	s.NoError(err)
-	mockCtrl := gomock.NewController(s.T())
-	defer mockCtrl.Finish()
 
-	mockParser := NewMockQueryParser(mockCtrl)
-	startTime, _ := time.Parse(time.RFC3339, "2019-10-04T11:00:00+00:00")
-	closeTime := startTime.Add(time.Hour)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime: closeTime,
-		startTime: startTime,
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID:   testNamespaceID,
-		Query:         "parsed by mockParser",
+		Query:         "StartTime = '2019-10-04T11:00:00Z' AND SearchPrecision = 'Hour'",
 		PageSize:      1,
 		NextPageToken: []byte{1, 2, 3},
 	}
 	response, err := visibilityArchiver.Query(context.Background(), URI, request)
 	s.Error(err)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Nil(response)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_StorageError() {
+	URI, err := archiver.NewURI("gs://my-bucket-cad/temporal_archival/visibility")
+	s.NoError(err)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
+	storageWrapper.On("QueryWithFilters", mock.Anything, URI, mock.Anything, 0, 0, mock.Anything).Return(nil, false, 0, errors.New("some random error"))
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+
+	response, err := visibilityArchiver.Query(context.Background(), URI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		Query:       "WorkflowId = '" + testWorkflowID + "'",
+		PageSize:    1,
+	})
+	s.Error(err)
+	s.IsType(&serviceerror.Internal{}, err)
 	s.Nil(response)
 }
 
@@ -257,29 +284,13 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	s.NoError(err)
 	storageWrapper := &mocks.Client{}
 	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
-	storageWrapper.On("QueryWithFilters", mock.Anything, URI, mock.Anything, 10, 0, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]string{"closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, true, 1, nil).Times(1)
-	storageWrapper.On("Get", mock.Anything, URI, "test-namespace-id/closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
-
+	s.mockVisibilityFiles(storageWrapper, URI)
 	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
-	s.NoError(err)
-	mockCtrl := gomock.NewController(s.T())
-	defer mockCtrl.Finish()
 
-	mockParser := NewMockQueryParser(mockCtrl)
-	dayPrecision := string("Day")
-	closeTime, _ := time.Parse(time.RFC3339, "2019-10-04T11:00:00+00:00")
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       closeTime,
-		searchPrecision: &dayPrecision,
-		workflowType:    convert.StringPtr("MobileOnlyWorkflow::processMobileOnly"),
-		workflowID:      convert.StringPtr(testWorkflowID),
-		runID:           convert.StringPtr(testRunID),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
 		PageSize:    10,
-		Query:       "parsed by mockParser",
+		Query:       "WorkflowType = '" + testWorkflowTypeName + "' AND WorkflowId = '" + testWorkflowID + "' AND RunId = '" + testRunID + "' AND CloseTime = '2020-02-05T11:00:00Z' AND SearchPrecision = 'Day'",
 	}
 
 	response, err := visibilityArchiver.Query(ctx, URI, request)
@@ -287,43 +298,23 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	s.NotNil(response)
 	s.Nil(response.NextPageToken)
 	s.Len(response.Executions, 1)
-	s.Equal(convertToExecutionInfo(s.expectedVisibilityRecords[0]), response.Executions[0])
+	s.Equal(archiver.ConvertToExecutionInfo(s.expectedVisibilityRecords[0]), response.Executions[0])
 }
 
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
-
 	pageSize := 2
 	ctx := context.Background()
 	URI, err := archiver.NewURI("gs://my-bucket-cad/temporal_archival/visibility")
 	s.NoError(err)
 	storageWrapper := &mocks.Client{}
 	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
-	storageWrapper.On("QueryWithFilters", mock.Anything, URI, mock.Anything, pageSize, 0, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]string{"closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility", "closeTimeout_2020-02-05T09:56:15Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, false, 1, nil).Times(2)
-	storageWrapper.On("QueryWithFilters", mock.Anything, URI, mock.Anything, pageSize, 1, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]string{"closeTimeout_2020-02-05T09:56:16Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, true, 2, nil).Times(2)
-	storageWrapper.On("Get", mock.Anything, URI, "test-namespace-id/closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
-	storageWrapper.On("Get", mock.Anything, URI, "test-namespace-id/closeTimeout_2020-02-05T09:56:15Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
-	storageWrapper.On("Get", mock.Anything, URI, "test-namespace-id/closeTimeout_2020-02-05T09:56:16Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
-
+	s.mockVisibilityFiles(storageWrapper, URI)
 	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
-	s.NoError(err)
-	mockCtrl := gomock.NewController(s.T())
-	defer mockCtrl.Finish()
 
-	mockParser := NewMockQueryParser(mockCtrl)
-	dayPrecision := "Day"
-	closeTime, _ := time.Parse(time.RFC3339, "2019-10-04T11:00:00+00:00")
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       closeTime,
-		searchPrecision: &dayPrecision,
-		workflowType:    convert.StringPtr("MobileOnlyWorkflow::processMobileOnly"),
-		workflowID:      convert.StringPtr(testWorkflowID),
-		runID:           convert.StringPtr(testRunID),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
 		PageSize:    pageSize,
-		Query:       "parsed by mockParser",
+		Query:       "CloseTime = '2020-02-05T11:00:00Z' AND SearchPrecision = 'Day'",
 	}
 
 	response, err := visibilityArchiver.Query(ctx, URI, request)
@@ -331,8 +322,8 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.NotNil(response)
 	s.NotNil(response.NextPageToken)
 	s.Len(response.Executions, 2)
-	s.Equal(convertToExecutionInfo(s.expectedVisibilityRecords[0]), response.Executions[0])
-	s.Equal(convertToExecutionInfo(s.expectedVisibilityRecords[0]), response.Executions[1])
+	s.Equal(archiver.ConvertToExecutionInfo(s.expectedVisibilityRecords[2]), response.Executions[0])
+	s.Equal(archiver.ConvertToExecutionInfo(s.expectedVisibilityRecords[1]), response.Executions[1])
 
 	request.NextPageToken = response.NextPageToken
 	response, err = visibilityArchiver.Query(ctx, URI, request)
@@ -340,5 +331,93 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.NotNil(response)
 	s.Nil(response.NextPageToken)
 	s.Len(response.Executions, 1)
-	s.Equal(convertToExecutionInfo(s.expectedVisibilityRecords[0]), response.Executions[0])
+	s.Equal(archiver.ConvertToExecutionInfo(s.expectedVisibilityRecords[0]), response.Executions[0])
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_Filters() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/temporal_archival/visibility")
+	s.NoError(err)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
+	s.mockVisibilityFiles(storageWrapper, URI)
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+
+	testCases := []struct {
+		query    string
+		expected []*archiverspb.ArchiveVisibilityRequest
+	}{
+		{
+			query:    "WorkflowId IN ('" + testWorkflowID + "', 'another-workflow-id') ORDER BY CloseTime ASC",
+			expected: []*archiverspb.ArchiveVisibilityRequest{s.expectedVisibilityRecords[0], s.expectedVisibilityRecords[1]},
+		},
+		{
+			query:    "WorkflowType = 'another-workflow-type' OR ExecutionStatus = 'Completed'",
+			expected: []*archiverspb.ArchiveVisibilityRequest{s.expectedVisibilityRecords[2], s.expectedVisibilityRecords[0]},
+		},
+		{
+			query:    "StartTime >= '2020-02-05T10:00:00Z' AND StartTime < '2020-02-05T12:00:00Z'",
+			expected: []*archiverspb.ArchiveVisibilityRequest{s.expectedVisibilityRecords[1]},
+		},
+		{
+			query:    "CloseTime > '2020-02-05T09:56:15.946478Z' AND HistoryLength > 100",
+			expected: []*archiverspb.ArchiveVisibilityRequest{s.expectedVisibilityRecords[2]},
+		},
+		{
+			query:    "RunId = 'some random runID'",
+			expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		response, err := visibilityArchiver.Query(ctx, URI, &archiver.QueryVisibilityRequest{
+			NamespaceID: testNamespaceID,
+			PageSize:    10,
+			Query:       testCase.query,
+		})
+		s.NoError(err, testCase.query)
+		s.Nil(response.NextPageToken)
+		s.Len(response.Executions, len(testCase.expected), testCase.query)
+		for i, record := range testCase.expected {
+			s.Equal(archiver.ConvertToExecutionInfo(record), response.Executions[i], testCase.query)
+		}
+	}
+}
+
+// mockVisibilityFiles lets the storage serve the close and start time index files of the expected
+// visibility records, the preconditions given to QueryWithFilters are applied to the object names
+func (s *visibilityArchiverSuite) mockVisibilityFiles(storageWrapper *mocks.Client, URI archiver.URI) {
+	files := make(map[string][]byte)
+	for _, record := range s.expectedVisibilityRecords {
+		data, err := encode(record)
+		s.NoError(err)
+		closeTimeFilename := constructVisibilityFilename(record.GetNamespaceId(), record.WorkflowTypeName, record.GetWorkflowId(), record.GetRunId(), indexKeyCloseTimeout, timestamp.TimeValue(record.CloseTime))
+		startTimeFilename := constructVisibilityFilename(record.GetNamespaceId(), record.WorkflowTypeName, record.GetWorkflowId(), record.GetRunId(), indexKeyStartTimeout, timestamp.TimeValue(record.StartTime))
+		files[closeTimeFilename] = data
+		files[startTimeFilename] = data
+	}
+
+	storageWrapper.On("QueryWithFilters", mock.Anything, URI, mock.Anything, 0, 0, mock.Anything).Return(
+		func(_ context.Context, _ archiver.URI, prefix string, _ int, _ int, filters []connector.Precondition) []string {
+			var objectNames []string
+			for filename := range files {
+				if !strings.HasPrefix(filename, prefix) {
+					continue
+				}
+				objectName := strings.TrimPrefix(URI.Path(), "/") + "/" + filename
+				valid := true
+				for _, f := range filters {
+					valid = valid && f(objectName)
+				}
+				if valid {
+					objectNames = append(objectNames, objectName)
+				}
+			}
+			sort.Strings(objectNames)
+			return objectNames
+		}, true, 0, nil)
+	storageWrapper.On("Get", mock.Anything, URI, mock.Anything).Return(
+		func(_ context.Context, _ archiver.URI, filename string) []byte {
+			return files[filename]
+		}, nil)
 }
@@ -26,7 +26,10 @@ package archiver
 
 import (
 	"context"
+	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 
@@ -67,6 +70,27 @@ type (
 		NextPageToken  []byte
 	}
 
+	// ArchiveBundleRequest is the request to bundle the final state of a closed workflow with its history
+	ArchiveBundleRequest struct {
+		ArchiveHistoryRequest
+		WorkflowTypeName string
+		Status           enumspb.WorkflowExecutionStatus
+		StartTime        time.Time
+		CloseTime        time.Time
+		// MutableState is the JSON encoded final mutable state of the workflow
+		MutableState     string
+		Memo             *commonpb.Memo
+		SearchAttributes *commonpb.SearchAttributes
+	}
+
+	// GetBundleRequest is the request to Get an archived workflow bundle
+	GetBundleRequest struct {
+		NamespaceID          string
+		WorkflowID           string
+		RunID                string
+		CloseFailoverVersion *int64
+	}
+
 	// HistoryBootstrapContainer contains components needed by all history Archiver implementations
 	HistoryBootstrapContainer struct {
 		HistoryV2Manager persistence.HistoryManager
@@ -88,12 +112,24 @@ type (
 		Archive(context.Context, URI, *ArchiveHistoryRequest, ...ArchiveOption) error
 		// Get is used to access an archived history. When context expires this method should stop trying to fetch history.
 		// The URI identifies the resource from which history should be accessed and it is up to the implementor to interpret this URI.
-		// This method should emit api service errors - see the filestore as an example.
+		// This method should emit api service errors - see the filestore as an example. Archived history which
+		// can't be decoded or doesn't match its checksum should be reported as a DataLoss error.
 		Get(context.Context, URI, *GetHistoryRequest) (*GetHistoryResponse, error)
 		// ValidateURI is used to define what a valid URI for an implementation is.
 		ValidateURI(URI) error
 	}
 
+	// BundleArchiver is implemented by history archivers which are able to archive a closed workflow as a
+	// single self-describing bundle, holding its final mutable state, memo, search attributes and full history.
+	// Bundles are archived next to the history they were built from and are read back by tooling only.
+	BundleArchiver interface {
+		// ArchiveBundle archives the bundle, archiving the same bundle again should overwrite the previous one.
+		ArchiveBundle(context.Context, URI, *archiverspb.WorkflowBundle, ...ArchiveOption) error
+		// GetBundle returns the archived bundle. If no close failover version is given the bundle
+		// with the highest one is returned. Bundles which don't match their checksum are reported as a DataLoss error.
+		GetBundle(context.Context, URI, *GetBundleRequest) (*archiverspb.WorkflowBundle, error)
+	}
+
 	// VisibilityBootstrapContainer contains components needed by all visibility Archiver implementations
 	VisibilityBootstrapContainer struct {
 		Logger          log.Logger
@@ -116,6 +152,28 @@ type (
 		NextPageToken []byte
 	}
 
+	// ListVisibilityRequest is the request to list all archived visibility records of a namespace
+	ListVisibilityRequest struct {
+		NamespaceID   string
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ListVisibilityResponse is the response of listing archived visibility records
+	ListVisibilityResponse struct {
+		Records []*archiverspb.ArchiveVisibilityRequest
+		// CorruptedRecords are the keys of the records in the page which could not be decoded
+		CorruptedRecords []string
+		NextPageToken    []byte
+	}
+
+	// VisibilityLister is implemented by visibility archivers which are able to walk all of the
+	// visibility records archived for a namespace. It is used to verify archived data, each record
+	// should be returned once, in a stable order, so that the walk can be resumed from a page token.
+	VisibilityLister interface {
+		List(context.Context, URI, *ListVisibilityRequest) (*ListVisibilityResponse, error)
+	}
+
 	// VisibilityArchiver is used to archive visibility and read archived visibility
 	VisibilityArchiver interface {
 		// Archive is used to archive one Workflow visibility record.
@@ -159,3 +159,80 @@ func (_m *VisibilityArchiverMock) ValidateURI(uri URI) error {
 
 	return r0
 }
+
+// VisibilityListerMock is an autogenerated mock type for the VisibilityLister type
+type VisibilityListerMock struct {
+	mock.Mock
+}
+
+// List provides a mock function with given fields: _a0, _a1, _a2
+func (_m *VisibilityListerMock) List(_a0 context.Context, _a1 URI, _a2 *ListVisibilityRequest) (*ListVisibilityResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *ListVisibilityResponse
+	if rf, ok := ret.Get(0).(func(context.Context, URI, *ListVisibilityRequest) *ListVisibilityResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListVisibilityResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, URI, *ListVisibilityRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BundleArchiverMock is an autogenerated mock type for the BundleArchiver type
+type BundleArchiverMock struct {
+	mock.Mock
+}
+
+// ArchiveBundle provides a mock function with given fields: ctx, uri, bundle, opts
+func (_m *BundleArchiverMock) ArchiveBundle(ctx context.Context, uri URI, bundle *archiverspb.WorkflowBundle, opts ...ArchiveOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, uri, bundle)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, URI, *archiverspb.WorkflowBundle, ...ArchiveOption) error); ok {
+		r0 = rf(ctx, uri, bundle, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetBundle provides a mock function with given fields: ctx, uri, request
+func (_m *BundleArchiverMock) GetBundle(ctx context.Context, uri URI, request *GetBundleRequest) (*archiverspb.WorkflowBundle, error) {
+	ret := _m.Called(ctx, uri, request)
+
+	var r0 *archiverspb.WorkflowBundle
+	if rf, ok := ret.Get(0).(func(context.Context, URI, *GetBundleRequest) *archiverspb.WorkflowBundle); ok {
+		r0 = rf(ctx, uri, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*archiverspb.WorkflowBundle)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, URI, *GetBundleRequest) error); ok {
+		r1 = rf(ctx, uri, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,192 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package s3store
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type (
+	// fakeS3Server is an in-process S3 compatible store which only supports path-style addressing,
+	// like a MinIO deployment without a domain configured
+	fakeS3Server struct {
+		*httptest.Server
+
+		sync.Mutex
+		buckets        map[string]map[string][]byte
+		authorizations []string
+		paths          []string
+	}
+
+	listBucketResult struct {
+		XMLName               xml.Name       `xml:"ListBucketResult"`
+		Name                  string         `xml:"Name"`
+		Prefix                string         `xml:"Prefix"`
+		KeyCount              int            `xml:"KeyCount"`
+		IsTruncated           bool           `xml:"IsTruncated"`
+		NextContinuationToken string         `xml:"NextContinuationToken,omitempty"`
+		Contents              []listObject   `xml:"Contents"`
+		CommonPrefixes        []commonPrefix `xml:"CommonPrefixes"`
+	}
+
+	listObject struct {
+		Key  string `xml:"Key"`
+		Size int    `xml:"Size"`
+	}
+
+	commonPrefix struct {
+		Prefix string `xml:"Prefix"`
+	}
+)
+
+func newFakeS3Server(buckets ...string) *fakeS3Server {
+	s := &fakeS3Server{buckets: make(map[string]map[string][]byte)}
+	for _, bucket := range buckets {
+		s.buckets[bucket] = make(map[string][]byte)
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *fakeS3Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+	s.authorizations = append(s.authorizations, r.Header.Get("Authorization"))
+	s.paths = append(s.paths, r.URL.Path)
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	objects, ok := s.buckets[parts[0]]
+	if !ok {
+		writeS3Error(w, r, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+	if len(parts) == 1 || parts[1] == "" {
+		switch r.Method {
+		case http.MethodHead:
+			w.WriteHeader(http.StatusOK)
+		case http.MethodGet:
+			s.list(w, r, parts[0], objects)
+		default:
+			writeS3Error(w, r, http.StatusMethodNotAllowed, "MethodNotAllowed")
+		}
+		return
+	}
+
+	key := parts[1]
+	switch r.Method {
+	case http.MethodPut:
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeS3Error(w, r, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		objects[key] = data
+		w.WriteHeader(http.StatusOK)
+	case http.MethodHead, http.MethodGet:
+		data, ok := objects[key]
+		if !ok {
+			writeS3Error(w, r, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	default:
+		writeS3Error(w, r, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+// list implements ListObjectsV2, the continuation token is the last key of the previous page
+func (s *fakeS3Server) list(w http.ResponseWriter, r *http.Request, bucket string, objects map[string][]byte) {
+	query := r.URL.Query()
+	prefix := query.Get("prefix")
+	delimiter := query.Get("delimiter")
+	token := query.Get("continuation-token")
+	maxKeys := 1000
+	if v := query.Get("max-keys"); v != "" {
+		maxKeys, _ = strconv.Atoi(v)
+	}
+
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		if strings.HasPrefix(key, prefix) && key > token {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	result := listBucketResult{Name: bucket, Prefix: prefix}
+	seenPrefixes := make(map[string]struct{})
+	for _, key := range keys {
+		if result.KeyCount == maxKeys {
+			result.IsTruncated = true
+			break
+		}
+		if delimiter != "" {
+			if idx := strings.Index(key[len(prefix):], delimiter); idx >= 0 {
+				commonPrefixValue := key[:len(prefix)+idx+len(delimiter)]
+				if _, ok := seenPrefixes[commonPrefixValue]; !ok {
+					seenPrefixes[commonPrefixValue] = struct{}{}
+					result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: commonPrefixValue})
+				}
+				continue
+			}
+		}
+		result.Contents = append(result.Contents, listObject{Key: key, Size: len(objects[key])})
+		result.KeyCount++
+		result.NextContinuationToken = key
+	}
+	if !result.IsTruncated {
+		result.NextContinuationToken = ""
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+func (s *fakeS3Server) requests() ([]string, []string) {
+	s.Lock()
+	defer s.Unlock()
+	return append([]string(nil), s.paths...), append([]string(nil), s.authorizations...)
+}
+
+func writeS3Error(w http.ResponseWriter, r *http.Request, status int, code string) {
+	if r.Method == http.MethodHead {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte("<Error><Code>" + code + "</Code><Message>" + code + "</Message></Error>"))
+}
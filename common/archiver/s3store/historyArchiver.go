@@ -37,7 +37,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"go.temporal.io/api/serviceerror"
@@ -66,6 +65,8 @@ var (
 	errNoBucketSpecified = errors.New("no bucket specified")
 	errBucketNotExists   = errors.New("requested bucket does not exist")
 	errEmptyAwsRegion    = errors.New("empty aws region")
+	errInvalidEndpoint   = errors.New("endpoint must be an absolute http or https URL")
+	errIncompleteCreds   = errors.New("both accessKeyId and secretAccessKey are required for static credentials")
 )
 
 type (
@@ -103,22 +104,14 @@ func newHistoryArchiver(
 	config *config.S3Archiver,
 	historyIterator archiver.HistoryIterator,
 ) (*historyArchiver, error) {
-	if len(config.Region) == 0 {
-		return nil, errEmptyAwsRegion
-	}
-	s3Config := &aws.Config{
-		Endpoint:         config.Endpoint,
-		Region:           aws.String(config.Region),
-		S3ForcePathStyle: aws.Bool(config.S3ForcePathStyle),
-	}
-	sess, err := session.NewSession(s3Config)
+	s3cli, err := newS3Client(config)
 	if err != nil {
 		return nil, err
 	}
 
 	return &historyArchiver{
 		container:       container,
-		s3cli:           s3.New(sess),
+		s3cli:           s3cli,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -179,6 +172,12 @@ func (h *historyArchiver) Archive(
 			return archiver.ErrHistoryMutated
 		}
 
+		historyBlob.Header.Checksum, err = archiver.HistoryChecksum(historyBlob.Body)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+			return err
+		}
+
 		encoder := codec.NewJSONPBEncoder()
 		encodedHistoryBlob, err := encoder.Encode(historyBlob)
 		if err != nil {
@@ -319,7 +318,10 @@ func (h *historyArchiver) Get(
 		historyBlob := archiverspb.HistoryBlob{}
 		err = encoder.Decode(encodedRecord, &historyBlob)
 		if err != nil {
-			return nil, &serviceerror.Internal{Message: err.Error()}
+			return nil, serviceerror.NewDataLoss(err.Error())
+		}
+		if err := archiver.ValidateHistoryChecksum(historyBlob.Body, historyBlob.Header.GetChecksum()); err != nil {
+			return nil, serviceerror.NewDataLoss(err.Error())
 		}
 
 		for _, batch := range historyBlob.Body {
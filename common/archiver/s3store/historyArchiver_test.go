@@ -59,6 +59,7 @@ import (
 	"go.temporal.io/server/common/log/loggerimpl"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/service/config"
 )
 
 const (
@@ -633,6 +634,103 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestNewHistoryArchiver_Config() {
+	testCases := []struct {
+		config      *config.S3Archiver
+		expectedErr error
+	}{
+		{
+			config:      &config.S3Archiver{},
+			expectedErr: errEmptyAwsRegion,
+		},
+		{
+			config:      &config.S3Archiver{Region: "us-east-1"},
+			expectedErr: nil,
+		},
+		{
+			config:      &config.S3Archiver{Endpoint: convert.StringPtr("http://127.0.0.1:9000"), S3ForcePathStyle: true},
+			expectedErr: nil,
+		},
+		{
+			config:      &config.S3Archiver{Endpoint: convert.StringPtr("127.0.0.1:9000")},
+			expectedErr: errInvalidEndpoint,
+		},
+		{
+			config:      &config.S3Archiver{Region: "us-east-1", AccessKeyID: "access-key"},
+			expectedErr: errIncompleteCreds,
+		},
+		{
+			config:      &config.S3Archiver{Region: "us-east-1", AccessKeyID: "access-key", SecretAccessKey: "secret-key"},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewHistoryArchiver(s.container, tc.config)
+		s.Equal(tc.expectedErr, err)
+	}
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet_S3CompatibleStore() {
+	server := newFakeS3Server(testBucket)
+	defer server.Close()
+
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.historyBatchesV100[0], nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.historyBatchesV100[1], nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver, err := newHistoryArchiver(s.container, &config.S3Archiver{
+		Endpoint:         convert.StringPtr(server.URL),
+		S3ForcePathStyle: true,
+		AccessKeyID:      "test-access-key",
+		SecretAccessKey:  "test-secret-key",
+	}, historyIterator)
+	s.NoError(err)
+
+	URI, err := archiver.NewURI(testBucketURI + "/TestArchiveAndGet")
+	s.NoError(err)
+	s.NoError(historyArchiver.ValidateURI(URI))
+	err = historyArchiver.Archive(context.Background(), URI, &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	})
+	s.NoError(err)
+
+	response, err := historyArchiver.Get(context.Background(), URI, &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	})
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
+
+	paths, authorizations := server.requests()
+	for _, path := range paths {
+		s.True(strings.HasPrefix(path, "/"+testBucket), path)
+	}
+	for _, authorization := range authorizations {
+		s.Contains(authorization, "Credential=test-access-key/")
+	}
+
+	invalidURI, err := archiver.NewURI("s3://bucket-not-exists")
+	s.NoError(err)
+	s.Equal(errBucketNotExists, historyArchiver.ValidateURI(invalidURI))
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	//config := &config.S3Archiver{}
 	//archiver, err := newHistoryArchiver(s.container, config, historyIterator)
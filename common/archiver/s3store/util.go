@@ -30,23 +30,25 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/gogo/protobuf/proto"
-	commonpb "go.temporal.io/api/common/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
-	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.uber.org/multierr"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/service/config"
 )
 
 // encoding & decoding util
@@ -87,6 +89,47 @@ func serializeQueryVisibilityToken(token string) []byte {
 	return []byte(token)
 }
 
+// client util
+
+// defaultRegion is used for custom endpoints without a region, S3 compatible stores such as MinIO accept any region
+const defaultRegion = "us-east-1"
+
+// NewClient creates an S3 client from the S3 archiver config, it is shared by the other S3 backed stores
+func NewClient(config *config.S3Archiver) (s3iface.S3API, error) {
+	return newS3Client(config)
+}
+
+func newS3Client(config *config.S3Archiver) (s3iface.S3API, error) {
+	s3Config := &aws.Config{
+		Region:           aws.String(config.Region),
+		S3ForcePathStyle: aws.Bool(config.S3ForcePathStyle),
+	}
+	if endpoint := aws.StringValue(config.Endpoint); endpoint != "" {
+		endpointURL, err := url.Parse(endpoint)
+		if err != nil || (endpointURL.Scheme != "http" && endpointURL.Scheme != "https") || endpointURL.Host == "" {
+			return nil, errInvalidEndpoint
+		}
+		s3Config.Endpoint = aws.String(endpoint)
+		if len(config.Region) == 0 {
+			s3Config.Region = aws.String(defaultRegion)
+		}
+	} else if len(config.Region) == 0 {
+		return nil, errEmptyAwsRegion
+	}
+	if len(config.AccessKeyID) != 0 || len(config.SecretAccessKey) != 0 {
+		if len(config.AccessKeyID) == 0 || len(config.SecretAccessKey) == 0 {
+			return nil, errIncompleteCreds
+		}
+		s3Config.Credentials = credentials.NewStaticCredentials(config.AccessKeyID, config.SecretAccessKey, config.SessionToken)
+	}
+
+	sess, err := session.NewSession(s3Config)
+	if err != nil {
+		return nil, err
+	}
+	return s3.New(sess), nil
+}
+
 // Only validates the scheme and buckets are passed
 func softValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
@@ -149,33 +192,31 @@ func constructHistoryKeyPrefix(path, namespaceID, workflowID, runID string) stri
 	return strings.TrimLeft(strings.Join([]string{path, namespaceID, "history", workflowID, runID}, "/"), "/")
 }
 
-func constructTimeBasedSearchKey(path, namespaceID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, t time.Time, precision string) string {
-	var timeFormat = ""
-	switch precision {
-	case PrecisionSecond:
-		timeFormat = ":05"
-		fallthrough
-	case PrecisionMinute:
-		timeFormat = ":04" + timeFormat
-		fallthrough
-	case PrecisionHour:
-		timeFormat = "15" + timeFormat
-		fallthrough
-	case PrecisionDay:
-		timeFormat = "2006-01-02T" + timeFormat
-	}
-
-	return fmt.Sprintf("%s/%s", constructVisibilitySearchPrefix(path, namespaceID, primaryIndexKey, primaryIndexValue, secondaryIndexKey), t.Format(timeFormat))
-}
-
 func constructTimestampIndex(path, namespaceID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, secondaryIndexValue time.Time, runID string) string {
 	return fmt.Sprintf("%s/%s/%s", constructVisibilitySearchPrefix(path, namespaceID, primaryIndexKey, primaryIndexValue, secondaryIndexKey), secondaryIndexValue.Format(time.RFC3339), runID)
 }
 
+// parseTimestampIndex extracts the secondary index, its timestamp and the run ID of a key built by constructTimestampIndex
+func parseTimestampIndex(key string) (secondaryIndex string, t time.Time, runID string, ok bool) {
+	pieces := strings.Split(key, "/")
+	if len(pieces) < 3 {
+		return "", time.Time{}, "", false
+	}
+	t, err := time.Parse(time.RFC3339, pieces[len(pieces)-2])
+	if err != nil {
+		return "", time.Time{}, "", false
+	}
+	return pieces[len(pieces)-3], t, pieces[len(pieces)-1], true
+}
+
 func constructVisibilitySearchPrefix(path, namespaceID, primaryIndexKey, primaryIndexValue, secondaryIndexType string) string {
 	return strings.TrimLeft(strings.Join([]string{path, namespaceID, "visibility", primaryIndexKey, primaryIndexValue, secondaryIndexType}, "/"), "/")
 }
 
+func constructVisibilityIndexPrefix(path, namespaceID, primaryIndexKey string) string {
+	return strings.TrimLeft(strings.Join([]string{path, namespaceID, "visibility", primaryIndexKey}, "/"), "/")
+}
+
 func ensureContextTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
 	if _, ok := ctx.Deadline(); ok {
 		return ctx, func() {}
@@ -259,24 +300,3 @@ func contextExpired(ctx context.Context) bool {
 		return false
 	}
 }
-
-func convertToExecutionInfo(record *archiverspb.ArchiveVisibilityRequest) *workflowpb.WorkflowExecutionInfo {
-	return &workflowpb.WorkflowExecutionInfo{
-		Execution: &commonpb.WorkflowExecution{
-			WorkflowId: record.GetWorkflowId(),
-			RunId:      record.GetRunId(),
-		},
-		Type: &commonpb.WorkflowType{
-			Name: record.WorkflowTypeName,
-		},
-		StartTime:     record.StartTime,
-		ExecutionTime: record.ExecutionTime,
-		CloseTime:     record.CloseTime,
-		Status:        record.Status,
-		HistoryLength: record.HistoryLength,
-		Memo:          record.Memo,
-		SearchAttributes: &commonpb.SearchAttributes{
-			IndexedFields: archiver.ConvertSearchAttrToPayload(record.SearchAttributes),
-		},
-	}
-}
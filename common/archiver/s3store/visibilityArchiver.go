@@ -26,34 +26,29 @@ package s3store
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"go.temporal.io/api/serviceerror"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/service/config"
 )
 
+var _ archiver.VisibilityLister = (*visibilityArchiver)(nil)
+
 type (
 	visibilityArchiver struct {
-		container   *archiver.VisibilityBootstrapContainer
-		s3cli       s3iface.S3API
-		queryParser QueryParser
-	}
-
-	queryVisibilityRequest struct {
-		namespaceID   string
-		pageSize      int
-		nextPageToken []byte
-		parsedQuery   *parsedQuery
+		container *archiver.VisibilityBootstrapContainer
+		s3cli     s3iface.S3API
 	}
 
 	indexToArchive struct {
@@ -83,19 +78,13 @@ func NewVisibilityArchiver(
 func newVisibilityArchiver(
 	container *archiver.VisibilityBootstrapContainer,
 	config *config.S3Archiver) (*visibilityArchiver, error) {
-	s3Config := &aws.Config{
-		Endpoint:         config.Endpoint,
-		Region:           aws.String(config.Region),
-		S3ForcePathStyle: aws.Bool(config.S3ForcePathStyle),
-	}
-	sess, err := session.NewSession(s3Config)
+	s3cli, err := newS3Client(config)
 	if err != nil {
 		return nil, err
 	}
 	return &visibilityArchiver{
-		container:   container,
-		s3cli:       s3.New(sess),
-		queryParser: NewQueryParser(),
+		container: container,
+		s3cli:     s3cli,
 	}, nil
 }
 
@@ -175,48 +164,147 @@ func (v *visibilityArchiver) Query(
 		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
 	}
 
-	parsedQuery, err := v.queryParser.Parse(request.Query)
-	if err != nil {
-		return nil, serviceerror.NewInvalidArgument(err.Error())
-	}
-
-	return v.query(ctx, URI, &queryVisibilityRequest{
-		namespaceID:   request.NamespaceID,
-		pageSize:      request.PageSize,
-		nextPageToken: request.NextPageToken,
-		parsedQuery:   parsedQuery,
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	return archiver.QueryVisibility(request, func(query *archiver.VisibilityQuery) ([]*archiverspb.ArchiveVisibilityRequest, error) {
+		return v.loadVisibilityRecords(ctx, URI, request.NamespaceID, query)
 	})
 }
 
-func (v *visibilityArchiver) query(
+// loadVisibilityRecords reads the records from the most selective index the query allows. The workflowID
+// or workflowTypeName index is used when the query restricts them, and the keys are narrowed down to
+// the close time, or start time, range of the query.
+func (v *visibilityArchiver) loadVisibilityRecords(
 	ctx context.Context,
 	URI archiver.URI,
-	request *queryVisibilityRequest,
-) (*archiver.QueryVisibilityResponse, error) {
-	ctx, cancel := ensureContextTimeout(ctx)
-	defer cancel()
+	namespaceID string,
+	query *archiver.VisibilityQuery,
+) ([]*archiverspb.ArchiveVisibilityRequest, error) {
+	secondaryIndex := secondaryIndexKeyCloseTimeout
+	earliest, latest := query.TimeRange(definition.CloseTime)
+	if isUnboundedTimeRange(earliest, latest) {
+		if startEarliest, startLatest := query.TimeRange(definition.StartTime); !isUnboundedTimeRange(startEarliest, startLatest) {
+			secondaryIndex = secondaryIndexKeyStartTimeout
+			earliest, latest = startEarliest, startLatest
+		}
+	}
+	// timestamps of the keys are truncated to the second
+	earliest = earliest.Truncate(time.Second)
+	timePrefix := archiver.TimeRangePrefix(earliest, latest, time.RFC3339)
+
+	var prefixes []string
+	if workflowIDs, ok := query.Values(definition.WorkflowID); ok {
+		for _, workflowID := range workflowIDs {
+			prefixes = append(prefixes, constructVisibilitySearchPrefix(URI.Path(), namespaceID, primaryIndexKeyWorkflowID, workflowID, secondaryIndex)+"/"+timePrefix)
+		}
+	} else if workflowTypeNames, ok := query.Values(definition.WorkflowType); ok {
+		for _, workflowTypeName := range workflowTypeNames {
+			prefixes = append(prefixes, constructVisibilitySearchPrefix(URI.Path(), namespaceID, primaryIndexKeyWorkflowTypeName, workflowTypeName, secondaryIndex)+"/"+timePrefix)
+		}
+	} else {
+		prefixes = append(prefixes, constructVisibilityIndexPrefix(URI.Path(), namespaceID, primaryIndexKeyWorkflowID)+"/")
+	}
+	runIDs, filterRunIDs := query.Values(definition.RunID)
+
+	var records []*archiverspb.ArchiveVisibilityRequest
+	visited := make(map[string]struct{})
+	for _, prefix := range prefixes {
+		keys, err := v.listKeys(ctx, URI, prefix)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			if _, ok := visited[key]; ok {
+				continue
+			}
+			visited[key] = struct{}{}
+
+			keyIndex, keyTime, runID, ok := parseTimestampIndex(key)
+			if !ok || keyIndex != secondaryIndex || keyTime.Before(earliest) || keyTime.After(latest) {
+				continue
+			}
+			if filterRunIDs && !containsRunID(runIDs, runID) {
+				continue
+			}
+
+			encodedRecord, err := download(ctx, v.s3cli, URI, key)
+			if err != nil {
+				return nil, err
+			}
+			record, err := decodeVisibilityRecord(encodedRecord)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+func (v *visibilityArchiver) listKeys(ctx context.Context, URI archiver.URI, prefix string) ([]string, error) {
+	var keys []string
 	var token *string
-	if request.nextPageToken != nil {
-		token = deserializeQueryVisibilityToken(request.nextPageToken)
+	for {
+		results, err := v.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+			Bucket:            aws.String(URI.Hostname()),
+			Prefix:            aws.String(prefix),
+			ContinuationToken: token,
+		})
+		if err != nil {
+			if isRetryableError(err) {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+			return nil, serviceerror.NewInvalidArgument(err.Error())
+		}
+		for _, item := range results.Contents {
+			keys = append(keys, aws.StringValue(item.Key))
+		}
+		if !aws.BoolValue(results.IsTruncated) {
+			return keys, nil
+		}
+		token = results.NextContinuationToken
 	}
-	primaryIndex := primaryIndexKeyWorkflowTypeName
-	primaryIndexValue := request.parsedQuery.workflowTypeName
-	if request.parsedQuery.workflowID != nil {
-		primaryIndex = primaryIndexKeyWorkflowID
-		primaryIndexValue = request.parsedQuery.workflowID
+}
+
+func isUnboundedTimeRange(earliest time.Time, latest time.Time) bool {
+	return earliest.IsZero() && latest.Equal(archiver.MaxVisibilityTime)
+}
+
+func containsRunID(runIDs []string, runID string) bool {
+	for _, id := range runIDs {
+		if id == runID {
+			return true
+		}
 	}
-	var prefix = constructVisibilitySearchPrefix(URI.Path(), request.namespaceID, primaryIndex, *primaryIndexValue, secondaryIndexKeyCloseTimeout) + "/"
-	if request.parsedQuery.closeTime != nil {
-		prefix = constructTimeBasedSearchKey(URI.Path(), request.namespaceID, primaryIndex, *primaryIndexValue, secondaryIndexKeyCloseTimeout, *request.parsedQuery.closeTime, *request.parsedQuery.searchPrecision)
+	return false
+}
+
+// List walks the visibility records of the namespace through their workflowID and close time index.
+// Every record is indexed under its close time and start time, the start time entries are skipped.
+func (v *visibilityArchiver) List(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ListVisibilityRequest,
+) (*archiver.ListVisibilityResponse, error) {
+	if err := softValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
 	}
-	if request.parsedQuery.startTime != nil {
-		prefix = constructTimeBasedSearchKey(URI.Path(), request.namespaceID, primaryIndex, *primaryIndexValue, secondaryIndexKeyStartTimeout, *request.parsedQuery.startTime, *request.parsedQuery.searchPrecision)
+
+	if err := archiver.ValidateListVisibilityRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidListVisibilityRequest.Error())
 	}
 
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	var token *string
+	if request.NextPageToken != nil {
+		token = deserializeQueryVisibilityToken(request.NextPageToken)
+	}
+	prefix := constructVisibilityIndexPrefix(URI.Path(), request.NamespaceID, primaryIndexKeyWorkflowID) + "/"
 	results, err := v.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
 		Bucket:            aws.String(URI.Hostname()),
 		Prefix:            aws.String(prefix),
-		MaxKeys:           aws.Int64(int64(request.pageSize)),
+		MaxKeys:           aws.Int64(int64(request.PageSize)),
 		ContinuationToken: token,
 	})
 	if err != nil {
@@ -225,25 +313,26 @@ func (v *visibilityArchiver) query(
 		}
 		return nil, serviceerror.NewInvalidArgument(err.Error())
 	}
-	if len(results.Contents) == 0 {
-		return &archiver.QueryVisibilityResponse{}, nil
-	}
 
-	response := &archiver.QueryVisibilityResponse{}
-	if *results.IsTruncated {
-		response.NextPageToken = serializeQueryVisibilityToken(*results.NextContinuationToken)
+	response := &archiver.ListVisibilityResponse{}
+	if aws.BoolValue(results.IsTruncated) {
+		response.NextPageToken = serializeQueryVisibilityToken(aws.StringValue(results.NextContinuationToken))
 	}
 	for _, item := range results.Contents {
-		encodedRecord, err := download(ctx, v.s3cli, URI, *item.Key)
+		key := aws.StringValue(item.Key)
+		if !strings.Contains(key, "/"+secondaryIndexKeyCloseTimeout+"/") {
+			continue
+		}
+		encodedRecord, err := download(ctx, v.s3cli, URI, key)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
-
 		record, err := decodeVisibilityRecord(encodedRecord)
 		if err != nil {
-			return nil, serviceerror.NewInternal(err.Error())
+			response.CorruptedRecords = append(response.CorruptedRecords, key)
+			continue
 		}
-		response.Executions = append(response.Executions, convertToExecutionInfo(record))
+		response.Records = append(response.Records, record)
 	}
 	return response, nil
 }
@@ -28,6 +28,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -38,6 +39,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
@@ -49,6 +51,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/service/config"
 
 	"github.com/uber-go/tally"
 	commonpb "go.temporal.io/api/common/v1"
@@ -115,9 +118,8 @@ func (s *visibilityArchiverSuite) TestValidateURI() {
 
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
 	archiver := &visibilityArchiver{
-		container:   s.container,
-		s3cli:       s.s3cli,
-		queryParser: NewQueryParser(),
+		container: s.container,
+		s3cli:     s.s3cli,
 	}
 	return archiver
 }
@@ -256,29 +258,34 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
-	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
 		NamespaceID: "some random namespaceID",
 		PageSize:    10,
 		Query:       "some invalid query",
 	})
 	s.Error(err)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
 	s.Nil(response)
 }
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		NamespaceID:   testNamespaceID,
+		PageSize:      10,
+		Query:         "WorkflowId = '" + testWorkflowID + "'",
+		NextPageToken: []byte{1, 2, 3},
+	})
+	s.Error(err)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Nil(response)
+}
+
 func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		workflowID:      convert.StringPtr(testWorkflowID),
-		closeTime:       &time.Time{},
-		searchPrecision: convert.StringPtr(PrecisionSecond),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
-		Query:       "parsed by mockParser",
+		Query:       "WorkflowId = 'some random workflowID' AND CloseTime = 0 AND SearchPrecision = 'Second'",
 		PageSize:    1,
 	}
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
@@ -290,17 +297,10 @@ func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       timestamp.TimePtr(time.Unix(0, int64(1*time.Hour)).UTC()),
-		searchPrecision: convert.StringPtr(PrecisionHour),
-		workflowID:      convert.StringPtr(testWorkflowID),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
 		PageSize:    10,
-		Query:       "parsed by mockParser",
+		Query:       "WorkflowId = '" + testWorkflowID + "' AND CloseTime = '1970-01-01T01:00:00Z' AND SearchPrecision = 'Hour'",
 	}
 	URI, err := archiver.NewURI(testBucketURI)
 	s.NoError(err)
@@ -309,22 +309,16 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	s.NotNil(response)
 	s.Nil(response.NextPageToken)
 	s.Len(response.Executions, 2)
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
+	s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[1]), response.Executions[0])
+	s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[0]), response.Executions[1])
 }
 
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       timestamp.TimePtr(time.Unix(0, 0).UTC()),
-		searchPrecision: convert.StringPtr(PrecisionDay),
-		workflowID:      convert.StringPtr(testWorkflowID),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
 		PageSize:    2,
-		Query:       "parsed by mockParser",
+		Query:       "WorkflowId = '" + testWorkflowID + "' AND CloseTime = 0 AND SearchPrecision = 'Day'",
 	}
 	URI, err := archiver.NewURI(testBucketURI)
 	s.NoError(err)
@@ -333,8 +327,8 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.NotNil(response)
 	s.NotNil(response.NextPageToken)
 	s.Len(response.Executions, 2)
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), response.Executions[1])
+	s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[2]), response.Executions[0])
+	s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[1]), response.Executions[1])
 
 	request.NextPageToken = response.NextPageToken
 	response, err = visibilityArchiver.Query(context.Background(), URI, request)
@@ -342,7 +336,48 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.NotNil(response)
 	s.Nil(response.NextPageToken)
 	s.Len(response.Executions, 1)
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), response.Executions[0])
+	s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_Filters() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI(testBucketURI)
+	s.NoError(err)
+	testCases := []struct {
+		query    string
+		expected []*archiverspb.ArchiveVisibilityRequest
+	}{
+		{
+			query:    "WorkflowType = '" + testWorkflowTypeName + "' AND CloseTime > '1970-01-01T01:15:00Z' ORDER BY CloseTime ASC",
+			expected: []*archiverspb.ArchiveVisibilityRequest{s.visibilityRecords[1], s.visibilityRecords[2]},
+		},
+		{
+			query:    "WorkflowId IN ('" + testWorkflowID + "', 'some random workflowID') AND CloseTime BETWEEN '1970-01-01T01:15:00Z' AND '1970-01-01T02:00:00Z'",
+			expected: []*archiverspb.ArchiveVisibilityRequest{s.visibilityRecords[1]},
+		},
+		{
+			query:    "RunId = '" + testRunID + "' OR CloseTime >= '1970-01-01T03:00:00Z'",
+			expected: []*archiverspb.ArchiveVisibilityRequest{s.visibilityRecords[2], s.visibilityRecords[0]},
+		},
+		{
+			query:    "StartTime < 2 AND ExecutionStatus != 'Failed'",
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		response, err := visibilityArchiver.Query(context.Background(), URI, &archiver.QueryVisibilityRequest{
+			NamespaceID: testNamespaceID,
+			PageSize:    10,
+			Query:       tc.query,
+		})
+		s.NoError(err, tc.query)
+		s.Nil(response.NextPageToken)
+		s.Len(response.Executions, len(tc.expected), tc.query)
+		for i, record := range tc.expected {
+			s.Equal(archiver.ConvertToExecutionInfo(record), response.Executions[i], tc.query)
+		}
+	}
 }
 
 type precisionTest struct {
@@ -360,70 +395,70 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 			hour:      0,
 			minute:    0,
 			second:    0,
-			precision: PrecisionDay,
+			precision: archiver.PrecisionDay,
 		},
 		{
 			day:       1,
 			hour:      1,
 			minute:    0,
 			second:    0,
-			precision: PrecisionDay,
+			precision: archiver.PrecisionDay,
 		},
 		{
 			day:       2,
 			hour:      1,
 			minute:    0,
 			second:    0,
-			precision: PrecisionHour,
+			precision: archiver.PrecisionHour,
 		},
 		{
 			day:       2,
 			hour:      1,
 			minute:    30,
 			second:    0,
-			precision: PrecisionHour,
+			precision: archiver.PrecisionHour,
 		},
 		{
 			day:       3,
 			hour:      2,
 			minute:    1,
 			second:    0,
-			precision: PrecisionMinute,
+			precision: archiver.PrecisionMinute,
 		},
 		{
 			day:       3,
 			hour:      2,
 			minute:    1,
 			second:    30,
-			precision: PrecisionMinute,
+			precision: archiver.PrecisionMinute,
 		},
 		{
 			day:       4,
 			hour:      3,
 			minute:    2,
 			second:    1,
-			precision: PrecisionSecond,
+			precision: archiver.PrecisionSecond,
 		},
 		{
 			day:       4,
 			hour:      3,
 			minute:    2,
 			second:    1,
-			precision: PrecisionSecond,
+			precision: archiver.PrecisionSecond,
 		},
 		{
 			day:       4,
 			hour:      3,
 			minute:    2,
 			second:    2,
-			precision: PrecisionSecond,
+			precision: archiver.PrecisionSecond,
 		},
 		{
 			day:       4,
 			hour:      3,
 			minute:    2,
 			second:    2,
-			precision: PrecisionSecond,
+			precision: archiver.PrecisionSecond,
 		},
 	}
 	visibilityArchiver := s.newTestVisibilityArchiver()
@@ -446,66 +481,27 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 		s.NoError(err, "case %d", i)
 	}
 
-	request := &archiver.QueryVisibilityRequest{
-		NamespaceID: testNamespaceID,
-		PageSize:    100,
-		Query:       "parsed by mockParser",
-	}
-
 	for i, testData := range precisionTests {
-		mockParser := NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			closeTime:       timestamp.TimePtr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
-			searchPrecision: convert.StringPtr(testData.precision),
-			workflowID:      convert.StringPtr(testWorkflowID),
-		}, nil).AnyTimes()
-		visibilityArchiver.queryParser = mockParser
-
-		response, err := visibilityArchiver.Query(context.Background(), URI, request)
-		s.NoError(err)
-		s.NotNil(response)
-		s.Len(response.Executions, 2, "Iteration ", i)
-
-		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			startTime:       timestamp.TimePtr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
-			searchPrecision: convert.StringPtr(testData.precision),
-			workflowID:      convert.StringPtr(testWorkflowID),
-		}, nil).AnyTimes()
-		visibilityArchiver.queryParser = mockParser
-
-		response, err = visibilityArchiver.Query(context.Background(), URI, request)
-		s.NoError(err)
-		s.NotNil(response)
-		s.Len(response.Executions, 2, "Iteration ", i)
-
-		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			closeTime:        timestamp.TimePtr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
-			searchPrecision:  convert.StringPtr(testData.precision),
-			workflowTypeName: convert.StringPtr(testWorkflowTypeName),
-		}, nil).AnyTimes()
-		visibilityArchiver.queryParser = mockParser
-
-		response, err = visibilityArchiver.Query(context.Background(), URI, request)
-		s.NoError(err)
-		s.NotNil(response)
-		s.Len(response.Executions, 2, "Iteration ", i)
-
-		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			startTime:        timestamp.TimePtr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
-			searchPrecision:  convert.StringPtr(testData.precision),
-			workflowTypeName: convert.StringPtr(testWorkflowTypeName),
-		}, nil).AnyTimes()
-		visibilityArchiver.queryParser = mockParser
-
-		response, err = visibilityArchiver.Query(context.Background(), URI, request)
-		s.NoError(err)
-		s.NotNil(response)
-		s.Len(response.Executions, 2, "Iteration ", i)
+		searchTime := time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC).Format(time.RFC3339)
+		queries := []string{
+			fmt.Sprintf("WorkflowId = '%s' AND CloseTime = '%s' AND SearchPrecision = '%s'", testWorkflowID, searchTime, testData.precision),
+			fmt.Sprintf("WorkflowId = '%s' AND StartTime = '%s' AND SearchPrecision = '%s'", testWorkflowID, searchTime, testData.precision),
+			fmt.Sprintf("WorkflowTypeName = '%s' AND CloseTime = '%s' AND SearchPrecision = '%s'", testWorkflowTypeName, searchTime, testData.precision),
+			fmt.Sprintf("WorkflowTypeName = '%s' AND StartTime = '%s' AND SearchPrecision = '%s'", testWorkflowTypeName, searchTime, testData.precision),
+		}
+		for _, query := range queries {
+			response, err := visibilityArchiver.Query(context.Background(), URI, &archiver.QueryVisibilityRequest{
+				NamespaceID: testNamespaceID,
+				PageSize:    100,
+				Query:       query,
+			})
+			s.NoError(err)
+			s.NotNil(response)
+			s.Len(response.Executions, 2, "Iteration %d: %s", i, query)
+		}
 	}
 }
+
 func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	URI, err := archiver.NewURI(testBucketURI + "/archive-and-query")
@@ -515,55 +511,83 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 		s.NoError(err)
 	}
 
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		workflowID: convert.StringPtr(testWorkflowID),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
+	for _, query := range []string{
+		"WorkflowId = '" + testWorkflowID + "'",
+		"WorkflowTypeName = '" + testWorkflowTypeName + "'",
+	} {
+		request := &archiver.QueryVisibilityRequest{
+			NamespaceID: testNamespaceID,
+			PageSize:    1,
+			Query:       query,
+		}
+		executions := []*workflowpb.WorkflowExecutionInfo{}
+		for first := true; first || request.NextPageToken != nil; first = false {
+			response, err := visibilityArchiver.Query(context.Background(), URI, request)
+			s.NoError(err)
+			s.NotNil(response)
+			executions = append(executions, response.Executions...)
+			request.NextPageToken = response.NextPageToken
+		}
+		s.Len(executions, 3)
+		s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[2]), executions[0])
+		s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[1]), executions[1])
+		s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[0]), executions[2])
+	}
+}
+
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_S3CompatibleStore() {
+	server := newFakeS3Server(testBucket)
+	defer server.Close()
+
+	visibilityArchiver, err := newVisibilityArchiver(s.container, &config.S3Archiver{
+		Endpoint:         convert.StringPtr(server.URL),
+		S3ForcePathStyle: true,
+		AccessKeyID:      "test-access-key",
+		SecretAccessKey:  "test-secret-key",
+	})
+	s.NoError(err)
+	URI, err := archiver.NewURI(testBucketURI + "/archive-and-query")
+	s.NoError(err)
+	for _, record := range s.visibilityRecords {
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, record))
+	}
+
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
-		PageSize:    1,
-		Query:       "parsed by mockParser",
+		PageSize:    2,
+		Query:       "WorkflowId = '" + testWorkflowID + "'",
 	}
 	executions := []*workflowpb.WorkflowExecutionInfo{}
-	var first = true
-	for first || request.NextPageToken != nil {
+	for first := true; first || request.NextPageToken != nil; first = false {
 		response, err := visibilityArchiver.Query(context.Background(), URI, request)
 		s.NoError(err)
-		s.NotNil(response)
 		executions = append(executions, response.Executions...)
 		request.NextPageToken = response.NextPageToken
-		first = false
 	}
 	s.Len(executions, 3)
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), executions[0])
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), executions[2])
-
-	mockParser = NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		workflowTypeName: convert.StringPtr(testWorkflowTypeName),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
-	request = &archiver.QueryVisibilityRequest{
+	s.Equal(archiver.ConvertToExecutionInfo(s.visibilityRecords[2]), executions[0])
+
+	listRequest := &archiver.ListVisibilityRequest{
 		NamespaceID: testNamespaceID,
-		PageSize:    1,
-		Query:       "parsed by mockParser",
+		PageSize:    2,
 	}
-	executions = []*workflowpb.WorkflowExecutionInfo{}
-	first = true
-	for first || request.NextPageToken != nil {
-		response, err := visibilityArchiver.Query(context.Background(), URI, request)
+	var records []*archiverspb.ArchiveVisibilityRequest
+	for first := true; first || listRequest.NextPageToken != nil; first = false {
+		response, err := visibilityArchiver.List(context.Background(), URI, listRequest)
 		s.NoError(err)
-		s.NotNil(response)
-		executions = append(executions, response.Executions...)
-		request.NextPageToken = response.NextPageToken
-		first = false
+		s.Empty(response.CorruptedRecords)
+		records = append(records, response.Records...)
+		listRequest.NextPageToken = response.NextPageToken
+	}
+	s.Len(records, 3)
+
+	paths, authorizations := server.requests()
+	for _, path := range paths {
+		s.True(strings.HasPrefix(path, "/"+testBucket), path)
+	}
+	for _, authorization := range authorizations {
+		s.Contains(authorization, "Credential=test-access-key/")
 	}
-	s.Len(executions, 3)
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), executions[0])
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
-	s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), executions[2])
 }
 
 func (s *visibilityArchiverSuite) setupVisibilityDirectory() {
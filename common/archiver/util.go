@@ -26,10 +26,15 @@ package archiver
 
 import (
 	"errors"
+	"fmt"
+	"hash/crc32"
 
 	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/payload"
@@ -150,6 +155,66 @@ func ValidateQueryRequest(request *QueryVisibilityRequest) error {
 	return nil
 }
 
+// ValidateListVisibilityRequest validates the list visibility request
+func ValidateListVisibilityRequest(request *ListVisibilityRequest) error {
+	if request.NamespaceID == "" {
+		return errEmptyNamespaceID
+	}
+	if request.PageSize == 0 {
+		return errInvalidPageSize
+	}
+	return nil
+}
+
+// HistoryChecksum computes the checksum stored along with archived history batches.
+// The checksum is computed over the JSON encoding of the batches, which unlike the
+// proto encoding is stable across encodings of the same batches.
+func HistoryChecksum(historyBatches []*historypb.History) (string, error) {
+	encoded, err := codec.NewJSONPBEncoder().EncodeHistories(historyBatches)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%08x", crc32.Checksum(encoded, crc32.MakeTable(crc32.Castagnoli))), nil
+}
+
+// ValidateHistoryChecksum returns ErrHistoryChecksumMismatch if the history batches don't
+// match the checksum. An empty checksum, i.e. history archived without one, is always valid.
+func ValidateHistoryChecksum(historyBatches []*historypb.History, checksum string) error {
+	if checksum == "" {
+		return nil
+	}
+	actual, err := HistoryChecksum(historyBatches)
+	if err != nil {
+		return err
+	}
+	if actual != checksum {
+		return ErrHistoryChecksumMismatch
+	}
+	return nil
+}
+
+// ConvertToExecutionInfo converts an archived visibility record to the execution info returned by visibility queries
+func ConvertToExecutionInfo(record *archiverspb.ArchiveVisibilityRequest) *workflowpb.WorkflowExecutionInfo {
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: record.GetWorkflowId(),
+			RunId:      record.GetRunId(),
+		},
+		Type: &commonpb.WorkflowType{
+			Name: record.WorkflowTypeName,
+		},
+		StartTime:     record.StartTime,
+		ExecutionTime: record.ExecutionTime,
+		CloseTime:     record.CloseTime,
+		Status:        record.Status,
+		HistoryLength: record.HistoryLength,
+		Memo:          record.Memo,
+		SearchAttributes: &commonpb.SearchAttributes{
+			IndexedFields: ConvertSearchAttrToPayload(record.SearchAttributes),
+		},
+	}
+}
+
 // ConvertSearchAttrToPayload converts search attribute value from string back to byte array
 func ConvertSearchAttrToPayload(searchAttrStr map[string]string) map[string]*commonpb.Payload {
 	searchAttr := make(map[string]*commonpb.Payload)
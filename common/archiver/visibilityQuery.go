@@ -0,0 +1,853 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package archiver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	// VisibilityQuery is a parsed archived visibility query. It is evaluated against
+	// archived visibility records in the same way by all visibility archivers
+	VisibilityQuery struct {
+		// conditions are the top level conditions of the where clause, which all have to match
+		conditions []visibilityCondition
+		orderBy    []visibilityOrder
+	}
+
+	// VisibilityRecordLoader returns the archived visibility records of the namespace which
+	// may match the query, it is free to return records which do not match the query
+	VisibilityRecordLoader func(query *VisibilityQuery) ([]*archiverspb.ArchiveVisibilityRequest, error)
+
+	visibilityCondition interface {
+		match(record *archiverspb.ArchiveVisibilityRequest) bool
+	}
+
+	visibilityAndCondition struct {
+		left  visibilityCondition
+		right visibilityCondition
+	}
+
+	visibilityOrCondition struct {
+		left  visibilityCondition
+		right visibilityCondition
+	}
+
+	visibilityNotCondition struct {
+		condition visibilityCondition
+	}
+
+	visibilityComparison struct {
+		field    visibilityField
+		operator string
+		values   []interface{}
+	}
+
+	// visibilityField is either a field of the archived record or a custom search attribute
+	visibilityField struct {
+		name            string
+		searchAttribute bool
+	}
+
+	visibilityOrder struct {
+		field      visibilityField
+		descending bool
+	}
+
+	visibilityQueryParser struct {
+		precision     time.Duration
+		precisionUsed bool
+	}
+
+	visibilityQueryToken struct {
+		Offset int
+	}
+)
+
+// SearchPrecision turns an equality on a time field into a match of the whole
+// second, minute, hour or day of the given time
+const (
+	SearchPrecision = "SearchPrecision"
+
+	PrecisionDay    = "Day"
+	PrecisionHour   = "Hour"
+	PrecisionMinute = "Minute"
+	PrecisionSecond = "Second"
+)
+
+const (
+	visibilityQueryMissingValue = "missing"
+	// workflowTypeNameAlias is accepted for WorkflowType for compatibility with earlier queries
+	workflowTypeNameAlias = "WorkflowTypeName"
+)
+
+var (
+	// MaxVisibilityTime is the latest time of an unbounded TimeRange
+	MaxVisibilityTime = time.Unix(0, math.MaxInt64).UTC()
+
+	visibilityTimeFields = map[string]struct{}{
+		definition.StartTime:     {},
+		definition.ExecutionTime: {},
+		definition.CloseTime:     {},
+	}
+
+	visibilityStringFields = map[string]struct{}{
+		definition.NamespaceID:  {},
+		definition.WorkflowID:   {},
+		definition.RunID:        {},
+		definition.WorkflowType: {},
+	}
+
+	visibilityPrecisions = map[string]time.Duration{
+		PrecisionDay:    24 * time.Hour,
+		PrecisionHour:   time.Hour,
+		PrecisionMinute: time.Minute,
+		PrecisionSecond: time.Second,
+	}
+
+	searchAttributeNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	errVisibilityQueryNotSelect = errors.New("invalid visibility query")
+)
+
+// ParseVisibilityQuery parses an archived visibility query, that is a where clause optionally
+// followed by an order by clause, e.g.
+// WorkflowType = 'type' AND (ExecutionStatus = 'Failed' OR CustomIntField > 10) ORDER BY StartTime DESC
+// Records are ordered by CloseTime DESC unless the query has an order by clause
+func ParseVisibilityQuery(query string) (*VisibilityQuery, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return &VisibilityQuery{}, nil
+	}
+
+	// IMPORTANT: the placeholder select is never executed, it is just used to parse the query
+	var placeholderQuery string
+	if common.IsJustOrderByClause(query) {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy %s", query)
+	} else {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy WHERE %s", query)
+	}
+	stmt, err := sqlparser.Parse(placeholderQuery)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Limit != nil || sel.GroupBy != nil || sel.Having != nil {
+		return nil, errVisibilityQueryNotSelect
+	}
+
+	result := &VisibilityQuery{}
+	if sel.Where != nil {
+		if result.conditions, err = parseVisibilityWhere(sel.Where.Expr); err != nil {
+			return nil, err
+		}
+	}
+	for _, order := range sel.OrderBy {
+		colName, ok := order.Expr.(*sqlparser.ColName)
+		if !ok {
+			return nil, fmt.Errorf("invalid order by expression: %s", sqlparser.String(order.Expr))
+		}
+		field, err := convertVisibilityField(colName)
+		if err != nil {
+			return nil, err
+		}
+		result.orderBy = append(result.orderBy, visibilityOrder{
+			field:      field,
+			descending: order.Direction == sqlparser.DescScr,
+		})
+	}
+	return result, nil
+}
+
+// QueryVisibility answers a visibility query with the records returned by load. The records are
+// filtered, ordered and paged the same way for every visibility archiver, errors are returned as
+// service errors
+func QueryVisibility(request *QueryVisibilityRequest, load VisibilityRecordLoader) (*QueryVisibilityResponse, error) {
+	query, err := ParseVisibilityQuery(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	token := &visibilityQueryToken{}
+	if request.NextPageToken != nil {
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil || token.Offset < 0 {
+			return nil, serviceerror.NewInvalidArgument(ErrNextPageTokenCorrupted.Error())
+		}
+	}
+
+	records, err := load(query)
+	if err != nil {
+		if _, ok := err.(serviceerror.ServiceError); ok {
+			return nil, err
+		}
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	records = query.Filter(records)
+	if token.Offset >= len(records) {
+		return &QueryVisibilityResponse{}, nil
+	}
+	records = records[token.Offset:]
+
+	response := &QueryVisibilityResponse{}
+	if len(records) > request.PageSize {
+		records = records[:request.PageSize]
+		nextPageToken, err := json.Marshal(&visibilityQueryToken{Offset: token.Offset + request.PageSize})
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.NextPageToken = nextPageToken
+	}
+	for _, record := range records {
+		response.Executions = append(response.Executions, ConvertToExecutionInfo(record))
+	}
+	return response, nil
+}
+
+// Filter returns the records matching the query in the order of the query
+func (q *VisibilityQuery) Filter(records []*archiverspb.ArchiveVisibilityRequest) []*archiverspb.ArchiveVisibilityRequest {
+	var result []*archiverspb.ArchiveVisibilityRequest
+	for _, record := range records {
+		if q.Match(record) {
+			result = append(result, record)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return q.less(result[i], result[j])
+	})
+	return result
+}
+
+// Match returns whether the record matches the where clause of the query
+func (q *VisibilityQuery) Match(record *archiverspb.ArchiveVisibilityRequest) bool {
+	for _, condition := range q.conditions {
+		if !condition.match(record) {
+			return false
+		}
+	}
+	return true
+}
+
+// TimeRange returns the inclusive range the given time field is restricted to by the query.
+// Unbounded ends are the zero time and MaxVisibilityTime.
+func (q *VisibilityQuery) TimeRange(field string) (earliest time.Time, latest time.Time) {
+	latest = MaxVisibilityTime
+	q.visitComparisons(field, func(c *visibilityComparison) {
+		if len(c.values) != 1 {
+			return
+		}
+		t, ok := c.values[0].(time.Time)
+		if !ok {
+			return
+		}
+		switch c.operator {
+		case sqlparser.EqualStr:
+			earliest = common.MaxTime(earliest, t)
+			latest = common.MinTime(latest, t)
+		case sqlparser.GreaterThanStr:
+			earliest = common.MaxTime(earliest, t.Add(time.Nanosecond))
+		case sqlparser.GreaterEqualStr:
+			earliest = common.MaxTime(earliest, t)
+		case sqlparser.LessThanStr:
+			latest = common.MinTime(latest, t.Add(-time.Nanosecond))
+		case sqlparser.LessEqualStr:
+			latest = common.MinTime(latest, t)
+		}
+	})
+	return earliest, latest
+}
+
+// Values returns the values the given string field is restricted to by the query, ok is false
+// when the field is not restricted. An empty list of values means no record can match.
+func (q *VisibilityQuery) Values(field string) (values []string, ok bool) {
+	q.visitComparisons(field, func(c *visibilityComparison) {
+		if c.operator != sqlparser.EqualStr && c.operator != sqlparser.InStr {
+			return
+		}
+		var current []string
+		for _, value := range c.values {
+			if s, isString := value.(string); isString && (!ok || containsString(values, s)) {
+				current = append(current, s)
+			}
+		}
+		values, ok = current, true
+	})
+	return values, ok
+}
+
+// visitComparisons calls fn with the comparisons of the field which all matching records satisfy
+func (q *VisibilityQuery) visitComparisons(field string, fn func(c *visibilityComparison)) {
+	var visit func(condition visibilityCondition)
+	visit = func(condition visibilityCondition) {
+		switch c := condition.(type) {
+		case *visibilityAndCondition:
+			visit(c.left)
+			visit(c.right)
+		case *visibilityComparison:
+			if !c.field.searchAttribute && c.field.name == field {
+				fn(c)
+			}
+		}
+	}
+	for _, condition := range q.conditions {
+		visit(condition)
+	}
+}
+
+// less orders records by the order by clause of the query, or CloseTime DESC by default,
+// and run ID to break ties
+func (q *VisibilityQuery) less(a *archiverspb.ArchiveVisibilityRequest, b *archiverspb.ArchiveVisibilityRequest) bool {
+	orderBy := q.orderBy
+	if len(orderBy) == 0 {
+		orderBy = []visibilityOrder{{field: visibilityField{name: definition.CloseTime}, descending: true}}
+	}
+	for _, order := range orderBy {
+		aValue, aOk := order.field.value(a)
+		bValue, bOk := order.field.value(b)
+		// records without a value come last whatever the direction
+		if !aOk || !bOk {
+			if aOk != bOk {
+				return aOk
+			}
+			continue
+		}
+		if result := compareVisibilityValues(aValue, bValue); result != 0 {
+			return (result < 0) != order.descending
+		}
+	}
+	return a.GetRunId() < b.GetRunId()
+}
+
+func (c *visibilityAndCondition) match(record *archiverspb.ArchiveVisibilityRequest) bool {
+	return c.left.match(record) && c.right.match(record)
+}
+
+func (c *visibilityOrCondition) match(record *archiverspb.ArchiveVisibilityRequest) bool {
+	return c.left.match(record) || c.right.match(record)
+}
+
+func (c *visibilityNotCondition) match(record *archiverspb.ArchiveVisibilityRequest) bool {
+	return !c.condition.match(record)
+}
+
+func (c *visibilityComparison) match(record *archiverspb.ArchiveVisibilityRequest) bool {
+	value, ok := c.field.value(record)
+	if len(c.values) == 0 { // comparison with missing value
+		return ok == (c.operator == sqlparser.NotEqualStr)
+	}
+	if !ok {
+		return false
+	}
+
+	// a list value, e.g. a keyword list search attribute, matches when any of its items match
+	items, isList := value.([]interface{})
+	if !isList {
+		items = []interface{}{value}
+	}
+	switch c.operator {
+	case sqlparser.NotEqualStr, sqlparser.NotInStr:
+		for _, item := range items {
+			for _, v := range c.values {
+				if compareVisibilityValues(item, v) == 0 {
+					return false
+				}
+			}
+		}
+		return true
+	default:
+		for _, item := range items {
+			for _, v := range c.values {
+				if compareVisibilityOperator(c.operator, compareVisibilityValues(item, v)) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+// value returns the value of the field in the record, ok is false when the record has no such value
+func (f visibilityField) value(record *archiverspb.ArchiveVisibilityRequest) (interface{}, bool) {
+	if f.searchAttribute {
+		s, ok := record.SearchAttributes[f.name]
+		if !ok {
+			return nil, false
+		}
+		// search attribute values are archived as strings, other types in their JSON encoding
+		var value interface{}
+		if err := json.Unmarshal([]byte(s), &value); err != nil || value == nil {
+			return s, true
+		}
+		return value, true
+	}
+
+	switch f.name {
+	case definition.NamespaceID:
+		return record.GetNamespaceId(), true
+	case definition.WorkflowID:
+		return record.GetWorkflowId(), true
+	case definition.RunID:
+		return record.GetRunId(), true
+	case definition.WorkflowType:
+		return record.GetWorkflowTypeName(), true
+	case definition.StartTime:
+		return timeValue(record.GetStartTime())
+	case definition.ExecutionTime:
+		return timeValue(record.GetExecutionTime())
+	case definition.CloseTime:
+		return timeValue(record.GetCloseTime())
+	case definition.ExecutionStatus:
+		return int64(record.GetStatus()), true
+	case definition.HistoryLength:
+		return record.GetHistoryLength(), true
+	default:
+		return nil, false
+	}
+}
+
+func timeValue(t *time.Time) (interface{}, bool) {
+	if t == nil {
+		return nil, false
+	}
+	return t.UTC(), true
+}
+
+// compareVisibilityValues compares a record value to a query value. Numbers are compared by their
+// values and values of different types by their string representations
+func compareVisibilityValues(a interface{}, b interface{}) int {
+	switch a := a.(type) {
+	case time.Time:
+		if b, ok := b.(time.Time); ok {
+			switch {
+			case a.Before(b):
+				return -1
+			case a.After(b):
+				return 1
+			}
+			return 0
+		}
+	case bool:
+		if b, ok := b.(bool); ok {
+			switch {
+			case a == b:
+				return 0
+			case !a:
+				return -1
+			}
+			return 1
+		}
+	}
+	if aNumber, ok := toFloat(a); ok {
+		if bNumber, ok := toFloat(b); ok {
+			switch {
+			case aNumber < bNumber:
+				return -1
+			case aNumber > bNumber:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(toString(a), toString(b))
+}
+
+func compareVisibilityOperator(operator string, result int) bool {
+	switch operator {
+	case sqlparser.EqualStr, sqlparser.InStr:
+		return result == 0
+	case sqlparser.LessThanStr:
+		return result < 0
+	case sqlparser.LessEqualStr:
+		return result <= 0
+	case sqlparser.GreaterThanStr:
+		return result > 0
+	case sqlparser.GreaterEqualStr:
+		return result >= 0
+	default:
+		return false
+	}
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// parseVisibilityWhere converts the top level conditions of the where clause. SearchPrecision may
+// only appear at the top level and applies to all time equalities of the query
+func parseVisibilityWhere(expr sqlparser.Expr) ([]visibilityCondition, error) {
+	p := &visibilityQueryParser{}
+	var exprs []sqlparser.Expr
+	for _, conjunct := range splitVisibilityConjuncts(expr) {
+		comparison, ok := conjunct.(*sqlparser.ComparisonExpr)
+		if !ok || !isVisibilityColumn(comparison.Left, SearchPrecision) {
+			exprs = append(exprs, conjunct)
+			continue
+		}
+		if p.precision != 0 {
+			return nil, fmt.Errorf("only one expression is allowed for %s", SearchPrecision)
+		}
+		precision, err := convertVisibilityPrecision(comparison)
+		if err != nil {
+			return nil, err
+		}
+		p.precision = precision
+	}
+
+	conditions := make([]visibilityCondition, 0, len(exprs))
+	for _, expr := range exprs {
+		condition, err := p.convertExpr(expr)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+	if p.precision != 0 && !p.precisionUsed {
+		return nil, fmt.Errorf("%s requires an equality on StartTime, ExecutionTime or CloseTime", SearchPrecision)
+	}
+	return conditions, nil
+}
+
+func splitVisibilityConjuncts(expr sqlparser.Expr) []sqlparser.Expr {
+	switch e := expr.(type) {
+	case *sqlparser.AndExpr:
+		return append(splitVisibilityConjuncts(e.Left), splitVisibilityConjuncts(e.Right)...)
+	case *sqlparser.ParenExpr:
+		if _, ok := e.Expr.(*sqlparser.AndExpr); ok {
+			return splitVisibilityConjuncts(e.Expr)
+		}
+	}
+	return []sqlparser.Expr{expr}
+}
+
+func convertVisibilityPrecision(expr *sqlparser.ComparisonExpr) (time.Duration, error) {
+	val, ok := expr.Right.(*sqlparser.SQLVal)
+	if expr.Operator != sqlparser.EqualStr || !ok || val.Type != sqlparser.StrVal {
+		return 0, fmt.Errorf("invalid expression for %s: %s", SearchPrecision, sqlparser.String(expr))
+	}
+	precision, ok := visibilityPrecisions[string(val.Val)]
+	if !ok {
+		return 0, fmt.Errorf("invalid value for %s: %s", SearchPrecision, string(val.Val))
+	}
+	return precision, nil
+}
+
+func (p *visibilityQueryParser) convertExpr(expr sqlparser.Expr) (visibilityCondition, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		left, right, err := p.convertExprs(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &visibilityAndCondition{left: left, right: right}, nil
+	case *sqlparser.OrExpr:
+		left, right, err := p.convertExprs(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &visibilityOrCondition{left: left, right: right}, nil
+	case *sqlparser.NotExpr:
+		condition, err := p.convertExpr(expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &visibilityNotCondition{condition: condition}, nil
+	case *sqlparser.ParenExpr:
+		return p.convertExpr(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		return p.convertComparisonExpr(expr)
+	case *sqlparser.RangeCond:
+		return p.convertRangeCond(expr)
+	default:
+		return nil, fmt.Errorf("invalid where clause: %s", sqlparser.String(expr))
+	}
+}
+
+func (p *visibilityQueryParser) convertExprs(left sqlparser.Expr, right sqlparser.Expr) (visibilityCondition, visibilityCondition, error) {
+	leftCondition, err := p.convertExpr(left)
+	if err != nil {
+		return nil, nil, err
+	}
+	rightCondition, err := p.convertExpr(right)
+	if err != nil {
+		return nil, nil, err
+	}
+	return leftCondition, rightCondition, nil
+}
+
+func (p *visibilityQueryParser) convertComparisonExpr(expr *sqlparser.ComparisonExpr) (visibilityCondition, error) {
+	colName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil, fmt.Errorf("invalid comparison expression: %s", sqlparser.String(expr))
+	}
+	field, err := convertVisibilityField(colName)
+	if err != nil {
+		return nil, err
+	}
+
+	switch expr.Operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr:
+		if isVisibilityMissingValue(expr.Right) {
+			return &visibilityComparison{field: field, operator: expr.Operator}, nil
+		}
+	case sqlparser.LessThanStr, sqlparser.LessEqualStr, sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("invalid value list: %s", sqlparser.String(expr.Right))
+		}
+		values := make([]interface{}, len(tuple))
+		for i, valExpr := range tuple {
+			if values[i], err = convertVisibilityValue(field, valExpr); err != nil {
+				return nil, err
+			}
+		}
+		return &visibilityComparison{field: field, operator: expr.Operator, values: values}, nil
+	default:
+		return nil, fmt.Errorf("operator %s is not supported", expr.Operator)
+	}
+
+	value, err := convertVisibilityValue(field, expr.Right)
+	if err != nil {
+		return nil, err
+	}
+
+	// with a search precision an equality on a time field matches the whole second, minute, hour or day
+	if t, ok := value.(time.Time); ok && p.precision != 0 && expr.Operator == sqlparser.EqualStr {
+		p.precisionUsed = true
+		from := t.Truncate(p.precision)
+		return &visibilityAndCondition{
+			left:  &visibilityComparison{field: field, operator: sqlparser.GreaterEqualStr, values: []interface{}{from}},
+			right: &visibilityComparison{field: field, operator: sqlparser.LessThanStr, values: []interface{}{from.Add(p.precision)}},
+		}, nil
+	}
+	return &visibilityComparison{field: field, operator: expr.Operator, values: []interface{}{value}}, nil
+}
+
+func (p *visibilityQueryParser) convertRangeCond(expr *sqlparser.RangeCond) (visibilityCondition, error) {
+	colName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil, fmt.Errorf("invalid range expression: %s", sqlparser.String(expr))
+	}
+	field, err := convertVisibilityField(colName)
+	if err != nil {
+		return nil, err
+	}
+	from, err := convertVisibilityValue(field, expr.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := convertVisibilityValue(field, expr.To)
+	if err != nil {
+		return nil, err
+	}
+
+	if expr.Operator == sqlparser.NotBetweenStr {
+		return &visibilityOrCondition{
+			left:  &visibilityComparison{field: field, operator: sqlparser.LessThanStr, values: []interface{}{from}},
+			right: &visibilityComparison{field: field, operator: sqlparser.GreaterThanStr, values: []interface{}{to}},
+		}, nil
+	}
+	return &visibilityAndCondition{
+		left:  &visibilityComparison{field: field, operator: sqlparser.GreaterEqualStr, values: []interface{}{from}},
+		right: &visibilityComparison{field: field, operator: sqlparser.LessEqualStr, values: []interface{}{to}},
+	}, nil
+}
+
+func convertVisibilityField(colName *sqlparser.ColName) (visibilityField, error) {
+	name := colName.Name.String()
+	qualifier := colName.Qualifier.Name.String()
+	switch {
+	case qualifier == definition.Attr:
+	case qualifier != "":
+		return visibilityField{}, fmt.Errorf("invalid search attribute: %s", sqlparser.String(colName))
+	case name == SearchPrecision:
+		return visibilityField{}, fmt.Errorf("%s is only allowed in the top level conditions of the query", SearchPrecision)
+	case name == workflowTypeNameAlias:
+		return visibilityField{name: definition.WorkflowType}, nil
+	default:
+		if _, ok := visibilityTimeFields[name]; ok {
+			return visibilityField{name: name}, nil
+		}
+		if _, ok := visibilityStringFields[name]; ok {
+			return visibilityField{name: name}, nil
+		}
+		if name == definition.ExecutionStatus || name == definition.HistoryLength {
+			return visibilityField{name: name}, nil
+		}
+	}
+
+	if !searchAttributeNameRegex.MatchString(name) {
+		return visibilityField{}, fmt.Errorf("invalid search attribute: %s", name)
+	}
+	return visibilityField{name: name, searchAttribute: true}, nil
+}
+
+func isVisibilityColumn(expr sqlparser.Expr, name string) bool {
+	colName, ok := expr.(*sqlparser.ColName)
+	return ok && colName.Qualifier.IsEmpty() && colName.Name.String() == name
+}
+
+func isVisibilityMissingValue(expr sqlparser.Expr) bool {
+	colName, ok := expr.(*sqlparser.ColName)
+	return ok && colName.Qualifier.IsEmpty() && strings.EqualFold(colName.Name.String(), visibilityQueryMissingValue)
+}
+
+// convertVisibilityValue converts a literal of the query into the type of the values of the field
+func convertVisibilityValue(field visibilityField, expr sqlparser.Expr) (interface{}, error) {
+	if field.searchAttribute {
+		return convertSearchAttributeValue(expr)
+	}
+
+	val, ok := expr.(*sqlparser.SQLVal)
+	if !ok {
+		return nil, fmt.Errorf("invalid value for %s: %s", field.name, sqlparser.String(expr))
+	}
+	if _, ok := visibilityTimeFields[field.name]; ok {
+		return convertVisibilityTimeValue(val)
+	}
+	switch field.name {
+	case definition.ExecutionStatus:
+		return convertVisibilityStatusValue(val)
+	case definition.HistoryLength:
+		if val.Type == sqlparser.IntVal {
+			if length, err := strconv.ParseInt(string(val.Val), 10, 64); err == nil {
+				return length, nil
+			}
+		}
+	default:
+		if val.Type == sqlparser.StrVal {
+			return string(val.Val), nil
+		}
+	}
+	return nil, fmt.Errorf("invalid value for %s: %s", field.name, sqlparser.String(expr))
+}
+
+// convertVisibilityTimeValue accepts either unix nanoseconds or RFC3339 time strings
+func convertVisibilityTimeValue(val *sqlparser.SQLVal) (interface{}, error) {
+	if val.Type == sqlparser.IntVal || val.Type == sqlparser.StrVal {
+		if nanos, err := strconv.ParseInt(string(val.Val), 10, 64); err == nil {
+			return timestamp.UnixOrZeroTime(nanos), nil
+		}
+		if t, err := time.Parse(time.RFC3339Nano, string(val.Val)); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return nil, fmt.Errorf("invalid time value: %s", sqlparser.String(val))
+}
+
+// convertVisibilityStatusValue accepts either the number or the name of a workflow execution status,
+// names are case insensitive and may contain underscores, e.g. ContinuedAsNew or continued_as_new
+func convertVisibilityStatusValue(val *sqlparser.SQLVal) (interface{}, error) {
+	name := strings.ToLower(strings.ReplaceAll(string(val.Val), "_", ""))
+	if status, err := strconv.ParseInt(name, 10, 32); err == nil {
+		if _, ok := enumspb.WorkflowExecutionStatus_name[int32(status)]; ok {
+			return status, nil
+		}
+	}
+	for statusName, status := range enumspb.WorkflowExecutionStatus_value {
+		if strings.ToLower(statusName) == name {
+			return int64(status), nil
+		}
+	}
+	return nil, fmt.Errorf("invalid workflow execution status: %s", sqlparser.String(val))
+}
+
+func convertSearchAttributeValue(expr sqlparser.Expr) (interface{}, error) {
+	switch val := expr.(type) {
+	case sqlparser.BoolVal:
+		return bool(val), nil
+	case *sqlparser.SQLVal:
+		switch val.Type {
+		case sqlparser.StrVal:
+			return string(val.Val), nil
+		case sqlparser.IntVal:
+			if value, err := strconv.ParseInt(string(val.Val), 10, 64); err == nil {
+				return value, nil
+			}
+		case sqlparser.FloatVal:
+			if value, err := strconv.ParseFloat(string(val.Val), 64); err == nil {
+				return value, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("invalid search attribute value: %s", sqlparser.String(expr))
+}
+
+// TimeRangePrefix returns the common prefix of the earliest and latest times of a range in the
+// given layout. For a layout whose strings sort like the times, every time of the range starts with it.
+func TimeRangePrefix(earliest time.Time, latest time.Time, layout string) string {
+	a := earliest.UTC().Format(layout)
+	b := latest.UTC().Format(layout)
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return a[:i]
+}
@@ -0,0 +1,291 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package archiver
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	visibilityQuerySuite struct {
+		*require.Assertions
+		suite.Suite
+
+		records []*archiverspb.ArchiveVisibilityRequest
+	}
+)
+
+var testVisibilityQueryTime = time.Date(2020, 8, 22, 11, 12, 13, 0, time.UTC)
+
+func TestVisibilityQuerySuite(t *testing.T) {
+	suite.Run(t, new(visibilityQuerySuite))
+}
+
+func (s *visibilityQuerySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.records = []*archiverspb.ArchiveVisibilityRequest{
+		{
+			NamespaceId:      "test-namespace-id",
+			WorkflowId:       "workflow-0",
+			RunId:            "run-0",
+			WorkflowTypeName: "type-a",
+			StartTime:        timestamp.TimePtr(testVisibilityQueryTime.Add(-time.Hour)),
+			CloseTime:        timestamp.TimePtr(testVisibilityQueryTime),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			HistoryLength:    10,
+			SearchAttributes: map[string]string{
+				"CustomKeywordField": "keyword-0",
+				"CustomIntField":     "5",
+				"CustomBoolField":    "true",
+			},
+		},
+		{
+			NamespaceId:      "test-namespace-id",
+			WorkflowId:       "workflow-1",
+			RunId:            "run-1",
+			WorkflowTypeName: "type-a",
+			StartTime:        timestamp.TimePtr(testVisibilityQueryTime.Add(-time.Hour)),
+			CloseTime:        timestamp.TimePtr(testVisibilityQueryTime.Add(time.Minute)),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			HistoryLength:    20,
+			SearchAttributes: map[string]string{
+				"CustomKeywordField": `["keyword-1","keyword-2"]`,
+				"CustomIntField":     "15",
+			},
+		},
+		{
+			NamespaceId:      "test-namespace-id",
+			WorkflowId:       "workflow-1",
+			RunId:            "run-2",
+			WorkflowTypeName: "type-b",
+			StartTime:        timestamp.TimePtr(testVisibilityQueryTime),
+			CloseTime:        timestamp.TimePtr(testVisibilityQueryTime.Add(24 * time.Hour)),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW,
+			HistoryLength:    30,
+		},
+	}
+}
+
+func (s *visibilityQuerySuite) TestParseVisibilityQuery_Invalid() {
+	for _, query := range []string{
+		"some invalid query",
+		"WorkflowId",
+		"WorkflowId = 1",
+		"WorkflowId LIKE 'workflow%'",
+		"CloseTime = 'not a time'",
+		"ExecutionStatus = 'NotAStatus'",
+		"HistoryLength = 'ten'",
+		"Unknown.WorkflowId = 'workflow-0'",
+		"`Custom-Field` = 1",
+		"CloseTime = 0 AND SearchPrecision = 'Week'",
+		"CloseTime = 0 AND SearchPrecision > 'Day'",
+		"CloseTime = 0 AND SearchPrecision = 'Day' AND SearchPrecision = 'Hour'",
+		"WorkflowId = 'workflow-0' AND SearchPrecision = 'Day'",
+		"WorkflowId = 'workflow-0' OR (CloseTime = 0 AND SearchPrecision = 'Day')",
+		"WorkflowId = 'workflow-0' LIMIT 10",
+		"WorkflowId = 'workflow-0' ORDER BY LENGTH(WorkflowId)",
+	} {
+		_, err := ParseVisibilityQuery(query)
+		s.Error(err, query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestMatch() {
+	testCases := []struct {
+		query    string
+		expected []string
+	}{
+		{query: "", expected: []string{"run-0", "run-1", "run-2"}},
+		{query: "WorkflowId = 'workflow-1'", expected: []string{"run-1", "run-2"}},
+		{query: "WorkflowId = 'workflow-1' AND RunId != 'run-1'", expected: []string{"run-2"}},
+		{query: "RunId IN ('run-0', 'run-2')", expected: []string{"run-0", "run-2"}},
+		{query: "RunId NOT IN ('run-0', 'run-2')", expected: []string{"run-1"}},
+		{query: "NOT (RunId = 'run-0')", expected: []string{"run-1", "run-2"}},
+		{query: "WorkflowType = 'type-b' OR ExecutionStatus = 'Completed'", expected: []string{"run-0", "run-2"}},
+		{query: "WorkflowTypeName = 'type-b'", expected: []string{"run-2"}},
+		{query: "ExecutionStatus = 'continued_as_new'", expected: []string{"run-2"}},
+		{query: "ExecutionStatus = 3", expected: []string{"run-1"}},
+		{query: "HistoryLength >= 20", expected: []string{"run-1", "run-2"}},
+		{query: "HistoryLength BETWEEN 15 AND 25", expected: []string{"run-1"}},
+		{query: "HistoryLength NOT BETWEEN 15 AND 25", expected: []string{"run-0", "run-2"}},
+		{query: "CloseTime > '2020-08-22T11:12:13Z'", expected: []string{"run-1", "run-2"}},
+		{query: "CloseTime <= '2020-08-22T11:13:13Z'", expected: []string{"run-0", "run-1"}},
+		{query: "CloseTime = 1598094733000000000", expected: []string{"run-0"}},
+		{query: "CloseTime = '2020-08-22T11:00:00Z' AND SearchPrecision = 'Hour'", expected: []string{"run-0", "run-1"}},
+		{query: "CloseTime = '2020-08-22T11:12:00Z' AND SearchPrecision = 'Minute'", expected: []string{"run-0"}},
+		{query: "StartTime = '2020-08-22T00:00:00Z' AND SearchPrecision = 'Day'", expected: []string{"run-0", "run-1", "run-2"}},
+		{query: "ExecutionTime = missing", expected: []string{"run-0", "run-1", "run-2"}},
+		{query: "CustomKeywordField = 'keyword-0'", expected: []string{"run-0"}},
+		{query: "Attr.CustomKeywordField = 'keyword-2'", expected: []string{"run-1"}},
+		{query: "CustomKeywordField != 'keyword-2'", expected: []string{"run-0"}},
+		{query: "CustomIntField > 10", expected: []string{"run-1"}},
+		{query: "CustomIntField IN (5, 6)", expected: []string{"run-0"}},
+		{query: "CustomBoolField = true", expected: []string{"run-0"}},
+		{query: "CustomIntField = missing", expected: []string{"run-2"}},
+		{query: "CustomIntField != missing", expected: []string{"run-0", "run-1"}},
+	}
+
+	for _, tc := range testCases {
+		query, err := ParseVisibilityQuery(tc.query)
+		s.NoError(err, tc.query)
+		var matched []string
+		for _, record := range s.records {
+			if query.Match(record) {
+				matched = append(matched, record.GetRunId())
+			}
+		}
+		s.Equal(tc.expected, matched, tc.query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestFilter_Order() {
+	testCases := []struct {
+		query    string
+		expected []string
+	}{
+		{query: "", expected: []string{"run-2", "run-1", "run-0"}},
+		{query: "ORDER BY CloseTime ASC", expected: []string{"run-0", "run-1", "run-2"}},
+		{query: "WorkflowType = 'type-a' ORDER BY HistoryLength DESC", expected: []string{"run-1", "run-0"}},
+		{query: "ORDER BY StartTime, RunId DESC", expected: []string{"run-1", "run-0", "run-2"}},
+		{query: "ORDER BY CustomIntField DESC", expected: []string{"run-1", "run-0", "run-2"}},
+	}
+
+	for _, tc := range testCases {
+		query, err := ParseVisibilityQuery(tc.query)
+		s.NoError(err, tc.query)
+		var runIDs []string
+		for _, record := range query.Filter(s.records) {
+			runIDs = append(runIDs, record.GetRunId())
+		}
+		s.Equal(tc.expected, runIDs, tc.query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestTimeRange() {
+	query, err := ParseVisibilityQuery("CloseTime > 100 AND CloseTime <= 200 AND (StartTime = 1 OR StartTime = 2)")
+	s.NoError(err)
+	earliest, latest := query.TimeRange(definition.CloseTime)
+	s.Equal(time.Unix(0, 101).UTC(), earliest)
+	s.Equal(time.Unix(0, 200).UTC(), latest)
+	earliest, latest = query.TimeRange(definition.StartTime)
+	s.True(earliest.IsZero())
+	s.Equal(MaxVisibilityTime, latest)
+
+	query, err = ParseVisibilityQuery("CloseTime = '2020-08-22T11:12:13Z' AND SearchPrecision = 'Day'")
+	s.NoError(err)
+	earliest, latest = query.TimeRange(definition.CloseTime)
+	s.Equal(time.Date(2020, 8, 22, 0, 0, 0, 0, time.UTC), earliest)
+	s.Equal(time.Date(2020, 8, 23, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond), latest)
+	s.Equal("2020-08-22T", TimeRangePrefix(earliest, latest, time.RFC3339))
+}
+
+func (s *visibilityQuerySuite) TestValues() {
+	query, err := ParseVisibilityQuery("WorkflowId IN ('workflow-0', 'workflow-1') AND WorkflowId != 'workflow-1' AND RunId = 'run-0' OR RunId = 'run-1'")
+	s.NoError(err)
+	_, ok := query.Values(definition.RunID)
+	s.False(ok)
+
+	query, err = ParseVisibilityQuery("WorkflowId IN ('workflow-0', 'workflow-1') AND (WorkflowId = 'workflow-1' AND RunId = 'run-1')")
+	s.NoError(err)
+	values, ok := query.Values(definition.WorkflowID)
+	s.True(ok)
+	s.Equal([]string{"workflow-1"}, values)
+	values, ok = query.Values(definition.RunID)
+	s.True(ok)
+	s.Equal([]string{"run-1"}, values)
+	_, ok = query.Values(definition.WorkflowType)
+	s.False(ok)
+
+	query, err = ParseVisibilityQuery("WorkflowId = 'workflow-0' AND WorkflowId = 'workflow-1'")
+	s.NoError(err)
+	values, ok = query.Values(definition.WorkflowID)
+	s.True(ok)
+	s.Empty(values)
+}
+
+func (s *visibilityQuerySuite) TestQueryVisibility() {
+	load := func(query *VisibilityQuery) ([]*archiverspb.ArchiveVisibilityRequest, error) {
+		return s.records, nil
+	}
+	request := &QueryVisibilityRequest{
+		NamespaceID: "test-namespace-id",
+		PageSize:    2,
+		Query:       "HistoryLength > 5 ORDER BY HistoryLength",
+	}
+
+	response, err := QueryVisibility(request, load)
+	s.NoError(err)
+	s.Len(response.Executions, 2)
+	s.Equal(ConvertToExecutionInfo(s.records[0]), response.Executions[0])
+	s.Equal(ConvertToExecutionInfo(s.records[1]), response.Executions[1])
+	s.NotNil(response.NextPageToken)
+
+	request.NextPageToken = response.NextPageToken
+	response, err = QueryVisibility(request, load)
+	s.NoError(err)
+	s.Len(response.Executions, 1)
+	s.Equal(ConvertToExecutionInfo(s.records[2]), response.Executions[0])
+	s.Nil(response.NextPageToken)
+
+	request.NextPageToken, err = json.Marshal(&visibilityQueryToken{Offset: 3})
+	s.NoError(err)
+	response, err = QueryVisibility(request, load)
+	s.NoError(err)
+	s.Empty(response.Executions)
+	s.Nil(response.NextPageToken)
+}
+
+func (s *visibilityQuerySuite) TestQueryVisibility_Errors() {
+	load := func(query *VisibilityQuery) ([]*archiverspb.ArchiveVisibilityRequest, error) {
+		return s.records, nil
+	}
+
+	_, err := QueryVisibility(&QueryVisibilityRequest{PageSize: 1, Query: "some invalid query"}, load)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = QueryVisibility(&QueryVisibilityRequest{PageSize: 1, NextPageToken: []byte{1, 2, 3}}, load)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Equal(ErrNextPageTokenCorrupted.Error(), err.Error())
+
+	_, err = QueryVisibility(&QueryVisibilityRequest{PageSize: 1}, func(query *VisibilityQuery) ([]*archiverspb.ArchiveVisibilityRequest, error) {
+		return nil, serviceerror.NewInvalidArgument("bucket not found")
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = QueryVisibility(&QueryVisibilityRequest{PageSize: 1}, func(query *VisibilityQuery) ([]*archiverspb.ArchiveVisibilityRequest, error) {
+		return nil, ErrHistoryNotExist
+	})
+	s.IsType(&serviceerror.Internal{}, err)
+}
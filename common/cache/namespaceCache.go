@@ -99,6 +99,7 @@ type (
 		GetNamespaceByID(id string) (*NamespaceCacheEntry, error)
 		GetNamespaceID(name string) (string, error)
 		GetNamespaceName(id string) (string, error)
+		GetNamespaceAliases(name string) []string
 		GetAllNamespace() map[string]*NamespaceCacheEntry
 		GetCacheSize() (sizeOfCacheByName int64, sizeOfCacheByID int64)
 	}
@@ -390,6 +391,33 @@ func (c *namespaceCache) GetNamespaceName(
 	return entry.info.Name, nil
 }
 
+// GetNamespaceAliases returns the unexpired aliases of a namespace, the previous names which still resolve to it.
+// Only cached namespaces are looked up, so the call never reaches the persistence layer.
+func (c *namespaceCache) GetNamespaceAliases(
+	name string,
+) []string {
+
+	id, cacheHit := c.cacheNameToID.Load().(Cache).Get(name).(string)
+	if !cacheHit {
+		return nil
+	}
+	entry, cacheHit := c.cacheByID.Load().(Cache).Get(id).(*NamespaceCacheEntry)
+	if !cacheHit {
+		return nil
+	}
+
+	entry.RLock()
+	defer entry.RUnlock()
+	var aliases []string
+	now := c.timeSource.Now()
+	for _, alias := range entry.info.GetAliases() {
+		if alias.GetName() != name && timestamp.TimeValue(alias.GetExpirationTime()).After(now) {
+			aliases = append(aliases, alias.GetName())
+		}
+	}
+	return aliases
+}
+
 func (c *namespaceCache) refreshLoop() {
 	timer := time.NewTicker(NamespaceCacheRefreshInterval)
 	defer timer.Stop()
@@ -458,7 +486,7 @@ func (c *namespaceCache) refreshNamespacesLocked() error {
 	newCacheNameToID := newNamespaceCache()
 	newCacheByID := newNamespaceCache()
 	for _, namespace := range c.GetAllNamespace() {
-		newCacheNameToID.Put(namespace.info.Name, namespace.info.Id)
+		c.updateNameToIDCache(newCacheNameToID, namespace.info)
 		newCacheByID.Put(namespace.info.Id, namespace)
 	}
 
@@ -476,7 +504,7 @@ UpdateLoop:
 		if err != nil {
 			return err
 		}
-		c.updateNameToIDCache(newCacheNameToID, nextEntry.info.Name, nextEntry.info.Id)
+		c.updateNameToIDCache(newCacheNameToID, nextEntry.info)
 
 		if prevEntry != nil {
 			prevEntries = append(prevEntries, prevEntry)
@@ -504,13 +532,22 @@ func (c *namespaceCache) checkNamespaceExists(
 	return err
 }
 
+// updateNameToIDCache maps the name and the unexpired aliases of a namespace to its id,
+// an alias never overrides the name of another namespace
 func (c *namespaceCache) updateNameToIDCache(
 	cacheNameToID Cache,
-	name string,
-	id string,
+	info *persistenceblobs.NamespaceInfo,
 ) {
 
-	cacheNameToID.Put(name, id)
+	cacheNameToID.Put(info.Name, info.Id)
+	now := c.timeSource.Now()
+	for _, alias := range info.Aliases {
+		if timestamp.TimeValue(alias.GetExpirationTime()).After(now) {
+			if _, err := cacheNameToID.PutIfNotExist(alias.GetName(), info.Id); err != nil {
+				c.logger.Warn("Unable to cache namespace alias", tag.WorkflowNamespaceAlias(alias.GetName()), tag.Error(err))
+			}
+		}
+	}
 }
 
 func (c *namespaceCache) updateIDToNamespaceCache(
@@ -165,6 +165,20 @@ func (mr *MockNamespaceCacheMockRecorder) GetNamespaceName(id interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceName", reflect.TypeOf((*MockNamespaceCache)(nil).GetNamespaceName), id)
 }
 
+// GetNamespaceAliases mocks base method.
+func (m *MockNamespaceCache) GetNamespaceAliases(name string) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaceAliases", name)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetNamespaceAliases indicates an expected call of GetNamespaceAliases.
+func (mr *MockNamespaceCacheMockRecorder) GetNamespaceAliases(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceAliases", reflect.TypeOf((*MockNamespaceCache)(nil).GetNamespaceAliases), name)
+}
+
 // GetAllNamespace mocks base method.
 func (m *MockNamespaceCache) GetAllNamespace() map[string]*NamespaceCacheEntry {
 	m.ctrl.T.Helper()
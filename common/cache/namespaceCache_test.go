@@ -36,6 +36,7 @@ import (
 	namespacepb "go.temporal.io/api/namespace/v1"
 	"go.temporal.io/api/serviceerror"
 
+	namespacespb "go.temporal.io/server/api/namespace/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
@@ -248,6 +249,63 @@ func (s *namespaceCacheSuite) TestGetNamespace_NonLoaded_GetByName() {
 	s.Equal(entry, entryByName)
 }
 
+func (s *namespaceCacheSuite) TestGetNamespace_ByAlias() {
+	s.clusterMetadata.On("IsGlobalNamespaceEnabled").Return(true)
+	namespaceNotificationVersion := int64(999999) // make this notification version really large for test
+	s.metadataMgr.On("GetMetadata").Return(&persistence.GetMetadataResponse{NotificationVersion: namespaceNotificationVersion}, nil)
+	namespaceRecord := &persistence.GetNamespaceResponse{
+		Namespace: &persistenceblobs.NamespaceDetail{
+			Info: &persistenceblobs.NamespaceInfo{
+				Id:   uuid.New(),
+				Name: "some random namespace name",
+				Data: make(map[string]string),
+				Aliases: []*namespacespb.NamespaceAlias{
+					{Name: "previous namespace name", ExpirationTime: timestamp.TimePtr(time.Now().UTC().Add(time.Hour))},
+					{Name: "expired namespace name", ExpirationTime: timestamp.TimePtr(time.Now().UTC().Add(-time.Hour))},
+				},
+			},
+			Config: &persistenceblobs.NamespaceConfig{
+				Retention: timestamp.DurationFromDays(1),
+				BadBinaries: &namespacepb.BadBinaries{
+					Binaries: map[string]*namespacepb.BadBinaryInfo{},
+				}},
+			ReplicationConfig: &persistenceblobs.NamespaceReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []string{
+					cluster.TestCurrentClusterName,
+				},
+			},
+		},
+	}
+	entry := s.buildEntryFromRecord(namespaceRecord)
+
+	s.metadataMgr.On("ListNamespaces", &persistence.ListNamespacesRequest{
+		PageSize:      namespaceCacheRefreshPageSize,
+		NextPageToken: nil,
+	}).Return(&persistence.ListNamespacesResponse{
+		Namespaces:    []*persistence.GetNamespaceResponse{namespaceRecord},
+		NextPageToken: nil,
+	}, nil).Once()
+	s.metadataMgr.On("GetNamespace", &persistence.GetNamespaceRequest{Name: "expired namespace name"}).
+		Return(nil, serviceerror.NewNotFound("Namespace expired namespace name does not exist.")).Once()
+
+	s.namespaceCache.Start()
+	defer s.namespaceCache.Stop()
+
+	entryByAlias, err := s.namespaceCache.GetNamespace("previous namespace name")
+	s.NoError(err)
+	s.Equal(entry, entryByAlias)
+	namespaceID, err := s.namespaceCache.GetNamespaceID("previous namespace name")
+	s.NoError(err)
+	s.Equal(namespaceRecord.Namespace.Info.Id, namespaceID)
+
+	_, err = s.namespaceCache.GetNamespace("expired namespace name")
+	s.IsType(&serviceerror.NotFound{}, err)
+
+	s.Equal([]string{"previous namespace name"}, s.namespaceCache.GetNamespaceAliases("some random namespace name"))
+	s.Empty(s.namespaceCache.GetNamespaceAliases("unknown namespace name"))
+}
+
 func (s *namespaceCacheSuite) TestGetNamespace_NonLoaded_GetByID() {
 	s.clusterMetadata.On("IsGlobalNamespaceEnabled").Return(true)
 	namespaceNotificationVersion := int64(999999) // make this notification version really large for test
@@ -123,6 +123,25 @@ const (
 	DefaultWorkflowTaskTimeout = 10 * time.Second
 )
 
+const (
+	// ContinueAsNewSuggestedSignalName is the name of the signal history sends to a workflow once its history is
+	// large enough for continue-as-new to be suggested. The signal input is a map holding the HistorySizeBytes and
+	// HistoryEventCount of the workflow when the signal was recorded, so a replay reads the same values.
+	ContinueAsNewSuggestedSignalName = "__temporal_continue_as_new_suggested"
+	// WorkflowPausedSignalName is the name of the signal history records when a workflow is paused. The signal
+	// input is a map holding the identity and the reason given by the operator.
+	WorkflowPausedSignalName = "__temporal_workflow_paused"
+	// WorkflowUnpausedSignalName is the name of the signal history records when a workflow is unpaused. The signal
+	// input is a map holding the identity and the reason given by the operator.
+	WorkflowUnpausedSignalName = "__temporal_workflow_unpaused"
+	// ActivityOptionsUpdatedSignalName is the name of the signal history records when the options of a pending
+	// activity are updated. The signal input is the update request.
+	ActivityOptionsUpdatedSignalName = "__temporal_activity_options_updated"
+	// DeadlineExceededSignalName is the name of the signal history records when a deadline with the flag action is
+	// exceeded. The signal input is the name of the deadline rule.
+	DeadlineExceededSignalName = "__temporal_deadline_exceeded"
+)
+
 const (
 	// DefaultTransactionSizeLimit is the largest allowed transaction size to persistence
 	DefaultTransactionSizeLimit = 14 * 1024 * 1024
@@ -53,6 +53,12 @@ const (
 	TemporalChangeVersion = "TemporalChangeVersion"
 	CustomNamespace       = "CustomNamespace"
 	Operator              = "Operator"
+
+	HistorySizeBytes       = "HistorySizeBytes"
+	HistoryEventCount      = "HistoryEventCount"
+	ContinueAsNewSuggested = "ContinueAsNewSuggested"
+	WorkflowPaused         = "WorkflowPaused"
+	DeadlinesExceeded      = "DeadlinesExceeded"
 )
 
 // valid non-indexed fields on ES
@@ -78,6 +84,12 @@ func createDefaultIndexedKeys() map[string]interface{} {
 		BinaryChecksums:       enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 		CustomNamespace:       enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 		Operator:              enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+
+		HistorySizeBytes:       enumspb.INDEXED_VALUE_TYPE_INT,
+		HistoryEventCount:      enumspb.INDEXED_VALUE_TYPE_INT,
+		ContinueAsNewSuggested: enumspb.INDEXED_VALUE_TYPE_BOOL,
+		WorkflowPaused:         enumspb.INDEXED_VALUE_TYPE_BOOL,
+		DeadlinesExceeded:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 	}
 	for k, v := range systemIndexedKeys {
 		defaultIndexedKeys[k] = v
@@ -111,3 +123,18 @@ func IsSystemIndexedKey(key string) bool {
 	_, ok := systemIndexedKeys[key]
 	return ok
 }
+
+// serverManagedKeys are custom search attributes which are only written by the server
+var serverManagedKeys = map[string]struct{}{
+	HistorySizeBytes:       {},
+	HistoryEventCount:      {},
+	ContinueAsNewSuggested: {},
+	WorkflowPaused:         {},
+	DeadlinesExceeded:      {},
+}
+
+// IsServerManagedKey return true if key is a custom search attribute maintained by the server
+func IsServerManagedKey(key string) bool {
+	_, ok := serverManagedKeys[key]
+	return ok
+}
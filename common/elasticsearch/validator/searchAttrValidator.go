@@ -101,7 +101,7 @@ func (sv *SearchAttributesValidator) ValidateSearchAttributes(input *commonpb.Se
 			return serviceerror.NewInvalidArgument(fmt.Sprintf("%v is not a valid search attribute value for key %s", invalidValue, key))
 		}
 		// verify: key is not system reserved
-		if definition.IsSystemIndexedKey(key) {
+		if definition.IsSystemIndexedKey(key) || definition.IsServerManagedKey(key) {
 			sv.logger.WithTags(tag.ESKey(key), tag.WorkflowNamespace(namespace)).
 				Error("illegal update of system reserved attribute")
 			return serviceerror.NewInvalidArgument(fmt.Sprintf("%s is read-only Temporal reservered attribute", key))
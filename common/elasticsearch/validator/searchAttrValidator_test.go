@@ -113,6 +113,13 @@ func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes() {
 	err = validator.ValidateSearchAttributes(attr, namespace)
 	s.Equal("StartTime is read-only Temporal reservered attribute", err.Error())
 
+	fields = map[string]*commonpb.Payload{
+		"HistorySizeBytes": intPayload,
+	}
+	attr.IndexedFields = fields
+	err = validator.ValidateSearchAttributes(attr, namespace)
+	s.Equal("HistorySizeBytes is read-only Temporal reservered attribute", err.Error())
+
 	fields = map[string]*commonpb.Payload{
 		"CustomKeywordField": payload.EncodeString("123456"),
 	}
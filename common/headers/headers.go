@@ -34,6 +34,10 @@ const (
 	ClientNameHeaderName              = "client-name"
 	ClientVersionHeaderName           = "client-version"
 	SupportedServerVersionsHeaderName = "supported-server-versions"
+
+	// request header of a workflow query, it is either "eventual" or "strong" (default), strong is how
+	// queries were answered before the header existed and setting it only makes that choice explicit
+	QueryConsistencyLevelHeaderName = "query-consistency-level"
 )
 
 var (
@@ -0,0 +1,113 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package largepayload
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	defaultFileMode = os.FileMode(0666)
+	defaultDirMode  = os.FileMode(0766)
+)
+
+var (
+	errInvalidFileMode = errors.New("invalid file mode")
+	errInvalidDirMode  = errors.New("invalid directory mode")
+	errInvalidDirPath  = errors.New("filestore path must be absolute")
+)
+
+type (
+	filestore struct {
+		root     string
+		fileMode os.FileMode
+		dirMode  os.FileMode
+	}
+)
+
+func newFilestore(URI archiver.URI, cfg *config.FilestoreArchiver) (*filestore, error) {
+	if !filepath.IsAbs(URI.Path()) {
+		return nil, errInvalidDirPath
+	}
+	store := &filestore{
+		root:     filepath.Clean(URI.Path()),
+		fileMode: defaultFileMode,
+		dirMode:  defaultDirMode,
+	}
+	if cfg != nil && cfg.FileMode != "" {
+		fileMode, err := strconv.ParseUint(cfg.FileMode, 0, 32)
+		if err != nil {
+			return nil, errInvalidFileMode
+		}
+		store.fileMode = os.FileMode(fileMode)
+	}
+	if cfg != nil && cfg.DirMode != "" {
+		dirMode, err := strconv.ParseUint(cfg.DirMode, 0, 32)
+		if err != nil {
+			return nil, errInvalidDirMode
+		}
+		store.dirMode = os.FileMode(dirMode)
+	}
+	return store, nil
+}
+
+func (s *filestore) Put(_ context.Context, key string, data []byte) error {
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), s.dirMode); err != nil {
+		return err
+	}
+	// write to a temporary file first so that readers never see a partial blob
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, s.fileMode); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+func (s *filestore) Get(_ context.Context, key string) ([]byte, error) {
+	// #nosec
+	data, err := ioutil.ReadFile(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, ErrBlobNotFound
+	}
+	return data, err
+}
+
+func (s *filestore) DeletePrefix(_ context.Context, prefix string) error {
+	return os.RemoveAll(s.path(prefix))
+}
+
+func (s *filestore) path(key string) string {
+	// keys are built from IDs generated by the server, joining cleans any relative element
+	return filepath.Join(s.root, filepath.Clean("/"+filepath.FromSlash(key)))
+}
@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package largepayload
+
+import (
+	"bytes"
+	"context"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	rehydratingHistoryManager struct {
+		persistence.HistoryManager
+		offloader Offloader
+	}
+)
+
+var _ persistence.HistoryManager = (*rehydratingHistoryManager)(nil)
+
+// NewRehydratingHistoryManager wraps manager so that the events it reads hold the offloaded payloads instead of
+// references to them, e.g. for the archivers whose histories must outlive the blobs. Raw history is returned as is.
+func NewRehydratingHistoryManager(manager persistence.HistoryManager, offloader Offloader) persistence.HistoryManager {
+	return &rehydratingHistoryManager{
+		HistoryManager: manager,
+		offloader:      offloader,
+	}
+}
+
+func (m *rehydratingHistoryManager) ReadHistoryBranch(
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadHistoryBranchResponse, error) {
+	response, err := m.HistoryManager.ReadHistoryBranch(request)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.offloader.Rehydrate(context.Background(), response.HistoryEvents); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (m *rehydratingHistoryManager) ReadHistoryBranchByBatch(
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadHistoryBranchByBatchResponse, error) {
+	response, err := m.HistoryManager.ReadHistoryBranchByBatch(request)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.offloader.Rehydrate(context.Background(), response.History); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// RehydrateEventsBlob returns blob, a serialized batch of history events, with the references it holds replaced
+// by the payloads they refer to. Histories sent to other clusters are read raw, the blob store of the source
+// cluster may not be reachable from there. The blob is returned as is when it holds no reference.
+func RehydrateEventsBlob(
+	ctx context.Context,
+	offloader Offloader,
+	serializer persistence.PayloadSerializer,
+	blob *serialization.DataBlob,
+) (*serialization.DataBlob, error) {
+	if blob.Encoding == enumspb.ENCODING_TYPE_PROTO3 && !bytes.Contains(blob.Data, []byte(ReferenceEncoding)) {
+		return blob, nil
+	}
+	events, err := serializer.DeserializeBatchEvents(blob)
+	if err != nil {
+		return nil, err
+	}
+	if !anyPayload(events, IsReference) {
+		return blob, nil
+	}
+	if err := offloader.Rehydrate(ctx, events); err != nil {
+		return nil, err
+	}
+	return serializer.SerializeBatchEvents(events, blob.Encoding)
+}
@@ -0,0 +1,66 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package largepayload
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+)
+
+type (
+	// Store is the blob store holding the payloads offloaded from workflow histories, keys are / separated paths
+	Store interface {
+		// Put writes the blob under key, replacing any existing blob
+		Put(ctx context.Context, key string, data []byte) error
+		// Get reads the blob stored under key, it returns ErrBlobNotFound if there is none
+		Get(ctx context.Context, key string) ([]byte, error)
+		// DeletePrefix deletes every blob whose key starts with prefix, a prefix ends with a /
+		DeletePrefix(ctx context.Context, prefix string) error
+	}
+
+	// Offloader replaces the large payloads of history events by references to the blob store and back
+	Offloader interface {
+		// Offload writes the payloads of events larger than threshold bytes to the blob store and returns the events
+		// with these payloads replaced by references. Events are copied before being changed, references to the
+		// payloads of another execution are copied under the given execution. A threshold of 0 disables offloading.
+		Offload(
+			ctx context.Context,
+			namespaceID string,
+			execution commonpb.WorkflowExecution,
+			events []*historypb.HistoryEvent,
+			threshold int,
+		) ([]*historypb.HistoryEvent, error)
+		// Rehydrate replaces in place the references found in value, a message or a slice of messages,
+		// by the payloads they refer to
+		Rehydrate(ctx context.Context, value interface{}) error
+		// OffloadedSize returns the total size of the payloads of value, a message or a slice of messages, that
+		// Offload would move to the blob store with the given threshold. Blob size limits don't apply to them.
+		OffloadedSize(value interface{}, threshold int) int
+		// DeleteExecution deletes the payloads offloaded from the history of the given execution
+		DeleteExecution(ctx context.Context, namespaceID string, execution commonpb.WorkflowExecution) error
+	}
+)
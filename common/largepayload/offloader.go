@@ -0,0 +1,298 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package largepayload
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	farm "github.com/dgryski/go-farm"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pborman/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	// ReferenceEncoding is the encoding of the payloads replaced by a reference to the blob store,
+	// the data of such payloads is the key of the blob holding the original payload
+	ReferenceEncoding = "binary/large-payload-reference"
+
+	metadataEncoding = "encoding"
+)
+
+var (
+	payloadType = reflect.TypeOf(commonpb.Payload{})
+	// search attributes and memo are read back into mutable state and visibility, they are never offloaded
+	skippedTypes = map[reflect.Type]struct{}{
+		reflect.TypeOf(commonpb.SearchAttributes{}): {},
+		reflect.TypeOf(commonpb.Memo{}):             {},
+	}
+	// payloadHolders caches whether values of a type may hold payloads
+	payloadHolders sync.Map
+)
+
+type (
+	offloader struct {
+		store Store
+	}
+)
+
+var _ Offloader = (*offloader)(nil)
+
+// NewOffloader creates an offloader backed by store, a nil store disables offloading
+// and makes rehydration fail with ErrStoreNotConfigured when a reference is found
+func NewOffloader(store Store) Offloader {
+	return &offloader{store: store}
+}
+
+func (o *offloader) Offload(
+	ctx context.Context,
+	namespaceID string,
+	execution commonpb.WorkflowExecution,
+	events []*historypb.HistoryEvent,
+	threshold int,
+) ([]*historypb.HistoryEvent, error) {
+	if o.store == nil || threshold <= 0 {
+		return events, nil
+	}
+
+	prefix := executionPrefix(namespaceID, execution)
+	needsOffload := func(payload *commonpb.Payload) bool {
+		if key, ok := referenceKey(payload); ok {
+			return !strings.HasPrefix(key, prefix)
+		}
+		return payload.Size() > threshold
+	}
+
+	result := events
+	copied := false
+	for i, event := range events {
+		if !anyPayload(event, needsOffload) {
+			continue
+		}
+		if !copied {
+			result = append([]*historypb.HistoryEvent(nil), events...)
+			copied = true
+		}
+		event = proto.Clone(event).(*historypb.HistoryEvent)
+		err := visitPayloads(reflect.ValueOf(event), func(payload *commonpb.Payload) error {
+			if !needsOffload(payload) {
+				return nil
+			}
+			data, err := o.blob(ctx, payload)
+			if err != nil {
+				return err
+			}
+			key := fmt.Sprintf("%s%d-%s", prefix, event.GetEventId(), uuid.New())
+			if err := o.store.Put(ctx, key, data); err != nil {
+				return err
+			}
+			payload.Metadata = map[string][]byte{metadataEncoding: []byte(ReferenceEncoding)}
+			payload.Data = []byte(key)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		result[i] = event
+	}
+	return result, nil
+}
+
+func (o *offloader) Rehydrate(ctx context.Context, value interface{}) error {
+	return visitPayloads(reflect.ValueOf(value), func(payload *commonpb.Payload) error {
+		if _, ok := referenceKey(payload); !ok {
+			return nil
+		}
+		if o.store == nil {
+			return ErrStoreNotConfigured
+		}
+		data, err := o.blob(ctx, payload)
+		if err != nil {
+			return err
+		}
+		original := &commonpb.Payload{}
+		if err := original.Unmarshal(data); err != nil {
+			return err
+		}
+		if err := serialization.DecodePayloads(original); err != nil {
+			return err
+		}
+		payload.Metadata = original.Metadata
+		payload.Data = original.Data
+		return nil
+	})
+}
+
+func (o *offloader) OffloadedSize(value interface{}, threshold int) int {
+	if o.store == nil || threshold <= 0 {
+		return 0
+	}
+	size := 0
+	_ = visitPayloads(reflect.ValueOf(value), func(payload *commonpb.Payload) error {
+		if payloadSize := payload.Size(); payloadSize > threshold {
+			size += payloadSize
+		}
+		return nil
+	})
+	return size
+}
+
+func (o *offloader) DeleteExecution(ctx context.Context, namespaceID string, execution commonpb.WorkflowExecution) error {
+	if o.store == nil {
+		return nil
+	}
+	return o.store.DeletePrefix(ctx, executionPrefix(namespaceID, execution))
+}
+
+// blob returns the serialized original payload, read from the store if payload is a reference. Like history,
+// blobs are encoded by the persistence payload codec.
+func (o *offloader) blob(ctx context.Context, payload *commonpb.Payload) ([]byte, error) {
+	if key, ok := referenceKey(payload); ok {
+		return o.store.Get(ctx, key)
+	}
+	encoded, err := serialization.EncodePayloads(payload)
+	if err != nil {
+		return nil, err
+	}
+	return encoded.(*commonpb.Payload).Marshal()
+}
+
+func executionPrefix(namespaceID string, execution commonpb.WorkflowExecution) string {
+	// workflow IDs are user provided, they are hashed to be safe in paths and object keys
+	workflowID := fmt.Sprintf("%v", farm.Fingerprint64([]byte(execution.GetWorkflowId())))
+	return fmt.Sprintf("%s/%s/%s/", namespaceID, workflowID, execution.GetRunId())
+}
+
+// IsReference returns true if payload is a reference to an offloaded payload, payloads received from
+// clients must not be references
+func IsReference(payload *commonpb.Payload) bool {
+	_, ok := referenceKey(payload)
+	return ok
+}
+
+func referenceKey(payload *commonpb.Payload) (string, bool) {
+	if string(payload.GetMetadata()[metadataEncoding]) != ReferenceEncoding {
+		return "", false
+	}
+	return string(payload.GetData()), true
+}
+
+func anyPayload(value interface{}, predicate func(*commonpb.Payload) bool) bool {
+	found := false
+	_ = visitPayloads(reflect.ValueOf(value), func(payload *commonpb.Payload) error {
+		found = found || predicate(payload)
+		return nil
+	})
+	return found
+}
+
+// visitPayloads calls fn on every payload reachable from value, it stops at the first error
+func visitPayloads(value reflect.Value, fn func(*commonpb.Payload) error) error {
+	if !value.IsValid() || !mayHoldPayloads(value.Type()) {
+		return nil
+	}
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return nil
+		}
+		if value.Type().Elem() == payloadType {
+			return fn(value.Interface().(*commonpb.Payload))
+		}
+		return visitPayloads(value.Elem(), fn)
+	case reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return visitPayloads(value.Elem(), fn)
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			if err := visitPayloads(value.Field(i), fn); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if err := visitPayloads(value.Index(i), fn); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := value.MapRange()
+		for iter.Next() {
+			if err := visitPayloads(iter.Value(), fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// mayHoldPayloads returns false for the types whose values can't hold payloads, so that they are not walked
+func mayHoldPayloads(t reflect.Type) bool {
+	if result, ok := payloadHolders.Load(t); ok {
+		return result.(bool)
+	}
+	// only the answer for t is cached, answers for the types met on a cycle are partial
+	result := reaches(t, map[reflect.Type]struct{}{})
+	payloadHolders.Store(t, result)
+	return result
+}
+
+func reaches(t reflect.Type, visited map[reflect.Type]struct{}) bool {
+	if t == payloadType {
+		return true
+	}
+	if _, ok := skippedTypes[t]; ok {
+		return false
+	}
+	if _, ok := visited[t]; ok {
+		return false
+	}
+	visited[t] = struct{}{}
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return reaches(t.Elem(), visited)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).PkgPath == "" && reaches(t.Field(i).Type, visited) {
+				return true
+			}
+		}
+	}
+	return false
+}
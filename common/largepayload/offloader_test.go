@@ -0,0 +1,278 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package largepayload
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/common/mocks"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	testNamespaceID = "test-namespace-id"
+	testThreshold   = 64
+)
+
+type offloaderSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	dir       string
+	store     Store
+	offloader Offloader
+	execution commonpb.WorkflowExecution
+}
+
+func TestOffloaderSuite(t *testing.T) {
+	suite.Run(t, new(offloaderSuite))
+}
+
+func (s *offloaderSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dir, err := ioutil.TempDir("", "TestOffloader")
+	s.NoError(err)
+	s.dir = dir
+	s.store, err = NewStore(&config.LargePayload{URI: "file://" + dir})
+	s.NoError(err)
+	s.offloader = NewOffloader(s.store)
+	s.execution = commonpb.WorkflowExecution{WorkflowId: "test-workflow-id", RunId: "test-run-id"}
+}
+
+func (s *offloaderSuite) TearDownTest() {
+	s.NoError(os.RemoveAll(s.dir))
+}
+
+func (s *offloaderSuite) TestNewStore_NotConfigured() {
+	store, err := NewStore(&config.LargePayload{})
+	s.NoError(err)
+	s.Nil(store)
+
+	_, err = NewStore(&config.LargePayload{URI: "gs://bucket"})
+	s.Error(err)
+}
+
+func (s *offloaderSuite) TestOffloadAndRehydrate() {
+	large := bytes.Repeat([]byte("x"), 2*testThreshold)
+	events := []*historypb.HistoryEvent{
+		s.startedEvent(1, large, large),
+		s.startedEvent(2, []byte("small"), nil),
+	}
+
+	offloaded, err := s.offloader.Offload(context.Background(), testNamespaceID, s.execution, events, testThreshold)
+	s.NoError(err)
+	s.Len(offloaded, 2)
+	// the given events are left untouched, events without large payloads are not copied
+	s.Equal(large, s.input(events[0]).Data)
+	s.True(events[1] == offloaded[1])
+
+	reference := s.input(offloaded[0])
+	key, ok := referenceKey(reference)
+	s.True(ok)
+	s.Contains(key, executionPrefix(testNamespaceID, s.execution))
+	// memo is never offloaded
+	s.Equal(large, offloaded[0].GetWorkflowExecutionStartedEventAttributes().GetMemo().GetFields()["memo"].GetData())
+
+	s.NoError(s.offloader.Rehydrate(context.Background(), offloaded))
+	s.Equal(large, s.input(offloaded[0]).Data)
+	s.Equal([]byte("small"), s.input(offloaded[1]).Data)
+}
+
+func (s *offloaderSuite) TestOffload_CopiesReferencesOfOtherExecutions() {
+	large := bytes.Repeat([]byte("x"), 2*testThreshold)
+	offloaded, err := s.offloader.Offload(context.Background(), testNamespaceID, s.execution, []*historypb.HistoryEvent{s.startedEvent(1, large, nil)}, testThreshold)
+	s.NoError(err)
+
+	newExecution := commonpb.WorkflowExecution{WorkflowId: "test-workflow-id", RunId: "new-run-id"}
+	copied, err := s.offloader.Offload(context.Background(), testNamespaceID, newExecution, offloaded, testThreshold)
+	s.NoError(err)
+	key, ok := referenceKey(s.input(copied[0]))
+	s.True(ok)
+	s.Contains(key, executionPrefix(testNamespaceID, newExecution))
+
+	// the copy outlives the execution it was copied from
+	s.NoError(s.offloader.DeleteExecution(context.Background(), testNamespaceID, s.execution))
+	s.Error(s.offloader.Rehydrate(context.Background(), offloaded))
+	s.NoError(s.offloader.Rehydrate(context.Background(), copied))
+	s.Equal(large, s.input(copied[0]).Data)
+}
+
+func (s *offloaderSuite) TestOffload_Disabled() {
+	large := bytes.Repeat([]byte("x"), 2*testThreshold)
+	events := []*historypb.HistoryEvent{s.startedEvent(1, large, nil)}
+
+	offloaded, err := s.offloader.Offload(context.Background(), testNamespaceID, s.execution, events, 0)
+	s.NoError(err)
+	s.Equal(large, s.input(offloaded[0]).Data)
+
+	offloaded, err = NewOffloader(nil).Offload(context.Background(), testNamespaceID, s.execution, events, testThreshold)
+	s.NoError(err)
+	s.Equal(large, s.input(offloaded[0]).Data)
+}
+
+func (s *offloaderSuite) TestRehydrate_StoreNotConfigured() {
+	large := bytes.Repeat([]byte("x"), 2*testThreshold)
+	offloaded, err := s.offloader.Offload(context.Background(), testNamespaceID, s.execution, []*historypb.HistoryEvent{s.startedEvent(1, large, nil)}, testThreshold)
+	s.NoError(err)
+
+	s.Equal(ErrStoreNotConfigured, NewOffloader(nil).Rehydrate(context.Background(), offloaded))
+}
+
+func (s *offloaderSuite) TestOffloadAndRehydrate_PayloadCodec() {
+	serialization.SetPayloadCodec(testCodec{})
+	defer serialization.SetPayloadCodec(nil)
+
+	large := bytes.Repeat([]byte("x"), 2*testThreshold)
+	offloaded, err := s.offloader.Offload(context.Background(), testNamespaceID, s.execution, []*historypb.HistoryEvent{s.startedEvent(1, large, nil)}, testThreshold)
+	s.NoError(err)
+
+	key, ok := referenceKey(s.input(offloaded[0]))
+	s.True(ok)
+	data, err := s.store.Get(context.Background(), key)
+	s.NoError(err)
+	blob := &commonpb.Payload{}
+	s.NoError(blob.Unmarshal(data))
+	s.Equal([]byte("true"), blob.Metadata[testCodecMetadata])
+
+	s.NoError(s.offloader.Rehydrate(context.Background(), offloaded))
+	s.Equal(large, s.input(offloaded[0]).Data)
+	s.NotContains(s.input(offloaded[0]).Metadata, testCodecMetadata)
+}
+
+func (s *offloaderSuite) TestOffloadedSize() {
+	large := bytes.Repeat([]byte("x"), 2*testThreshold)
+	largePayload := payloads.EncodeBytes(large).GetPayloads()[0]
+	input := &commonpb.Payloads{Payloads: []*commonpb.Payload{largePayload, {Data: []byte("small")}, largePayload}}
+	largeSize := largePayload.Size()
+
+	s.Equal(2*largeSize, s.offloader.OffloadedSize(input, testThreshold))
+	s.Equal(2*largeSize, s.offloader.OffloadedSize(map[string]*commonpb.Payloads{"details": input}, testThreshold))
+	s.Equal(0, s.offloader.OffloadedSize(input, 0))
+	s.Equal(0, NewOffloader(nil).OffloadedSize(input, testThreshold))
+	// memo is never offloaded
+	s.Equal(largeSize, s.offloader.OffloadedSize(s.startedEvent(1, large, large), testThreshold))
+}
+
+func (s *offloaderSuite) TestRehydrateEventsBlob() {
+	serializer := persistence.NewPayloadSerializer()
+	large := bytes.Repeat([]byte("x"), 2*testThreshold)
+	events := []*historypb.HistoryEvent{s.startedEvent(1, large, nil)}
+
+	blob, err := serializer.SerializeBatchEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	rehydrated, err := RehydrateEventsBlob(context.Background(), s.offloader, serializer, blob)
+	s.NoError(err)
+	s.True(blob == rehydrated)
+
+	offloaded, err := s.offloader.Offload(context.Background(), testNamespaceID, s.execution, events, testThreshold)
+	s.NoError(err)
+	blob, err = serializer.SerializeBatchEvents(offloaded, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	rehydrated, err = RehydrateEventsBlob(context.Background(), s.offloader, serializer, blob)
+	s.NoError(err)
+	s.Equal(enumspb.ENCODING_TYPE_PROTO3, rehydrated.Encoding)
+	rehydratedEvents, err := serializer.DeserializeBatchEvents(rehydrated)
+	s.NoError(err)
+	s.Equal(large, s.input(rehydratedEvents[0]).Data)
+}
+
+func (s *offloaderSuite) TestRehydratingHistoryManager() {
+	large := bytes.Repeat([]byte("x"), 2*testThreshold)
+	offloaded, err := s.offloader.Offload(context.Background(), testNamespaceID, s.execution, []*historypb.HistoryEvent{s.startedEvent(1, large, nil)}, testThreshold)
+	s.NoError(err)
+	batchOffloaded, err := s.offloader.Offload(context.Background(), testNamespaceID, s.execution, []*historypb.HistoryEvent{s.startedEvent(1, large, nil)}, testThreshold)
+	s.NoError(err)
+
+	historyManager := &mocks.HistoryV2Manager{}
+	defer historyManager.AssertExpectations(s.T())
+	historyManager.On("ReadHistoryBranch", mock.Anything).Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: offloaded}, nil).Once()
+	historyManager.On("ReadHistoryBranchByBatch", mock.Anything).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History: []*historypb.History{{Events: batchOffloaded}},
+	}, nil).Once()
+	rehydratingManager := NewRehydratingHistoryManager(historyManager, s.offloader)
+
+	response, err := rehydratingManager.ReadHistoryBranch(&persistence.ReadHistoryBranchRequest{})
+	s.NoError(err)
+	s.Equal(large, s.input(response.HistoryEvents[0]).Data)
+
+	batchResponse, err := rehydratingManager.ReadHistoryBranchByBatch(&persistence.ReadHistoryBranchRequest{})
+	s.NoError(err)
+	s.Equal(large, s.input(batchResponse.History[0].Events[0]).Data)
+}
+
+func (s *offloaderSuite) startedEvent(eventID int64, input []byte, memo []byte) *historypb.HistoryEvent {
+	attributes := &historypb.WorkflowExecutionStartedEventAttributes{
+		Input: payloads.EncodeBytes(input),
+	}
+	if memo != nil {
+		attributes.Memo = &commonpb.Memo{Fields: map[string]*commonpb.Payload{"memo": {Data: memo}}}
+	}
+	return &historypb.HistoryEvent{
+		EventId:    eventID,
+		EventType:  enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: attributes},
+	}
+}
+
+func (s *offloaderSuite) input(event *historypb.HistoryEvent) *commonpb.Payload {
+	return event.GetWorkflowExecutionStartedEventAttributes().GetInput().GetPayloads()[0]
+}
+
+const testCodecMetadata = "test-codec"
+
+// testCodec marks the payloads it encodes
+type testCodec struct{}
+
+func (testCodec) Encode(payload *commonpb.Payload) (*commonpb.Payload, error) {
+	metadata := map[string][]byte{testCodecMetadata: []byte("true")}
+	for k, v := range payload.Metadata {
+		metadata[k] = v
+	}
+	return &commonpb.Payload{Metadata: metadata, Data: payload.Data}, nil
+}
+
+func (testCodec) Decode(payload *commonpb.Payload) (*commonpb.Payload, error) {
+	metadata := make(map[string][]byte, len(payload.Metadata))
+	for k, v := range payload.Metadata {
+		if k != testCodecMetadata {
+			metadata[k] = v
+		}
+	}
+	return &commonpb.Payload{Metadata: metadata, Data: payload.Data}, nil
+}
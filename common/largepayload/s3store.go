@@ -0,0 +1,140 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package largepayload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/s3store"
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	defaultS3Timeout = time.Minute
+)
+
+var (
+	errS3ConfigNotSet = errors.New("s3store config is not set")
+)
+
+type (
+	s3Store struct {
+		s3cli  s3iface.S3API
+		bucket string
+		prefix string
+	}
+)
+
+func newS3store(URI archiver.URI, cfg *config.S3Archiver) (*s3Store, error) {
+	if cfg == nil {
+		return nil, errS3ConfigNotSet
+	}
+	s3cli, err := s3store.NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	prefix := strings.Trim(URI.Path(), "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	return &s3Store{
+		s3cli:  s3cli,
+		bucket: URI.Hostname(),
+		prefix: prefix,
+	}, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, data []byte) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	_, err := s.s3cli.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + key),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+func (s *s3Store) Get(ctx context.Context, key string) ([]byte, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	result, err := s.s3cli.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrBlobNotFound
+		}
+		return nil, err
+	}
+	defer func() { _ = result.Body.Close() }()
+	return ioutil.ReadAll(result.Body)
+}
+
+func (s *s3Store) DeletePrefix(ctx context.Context, prefix string) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	var deleteErr error
+	err := s.s3cli.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(s.prefix + prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if len(page.Contents) == 0 {
+			return true
+		}
+		objects := make([]*s3.ObjectIdentifier, 0, len(page.Contents))
+		for _, object := range page.Contents {
+			objects = append(objects, &s3.ObjectIdentifier{Key: object.Key})
+		}
+		_, deleteErr = s.s3cli.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(s.bucket),
+			Delete: &s3.Delete{Objects: objects, Quiet: aws.Bool(true)},
+		})
+		return deleteErr == nil
+	})
+	if err != nil {
+		return err
+	}
+	return deleteErr
+}
+
+func ensureContextTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, defaultS3Timeout)
+}
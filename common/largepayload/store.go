@@ -0,0 +1,66 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package largepayload
+
+import (
+	"errors"
+	"fmt"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	// FilestoreScheme is the URI scheme of the filestore
+	FilestoreScheme = "file"
+	// S3storeScheme is the URI scheme of the S3 store
+	S3storeScheme = "s3"
+)
+
+var (
+	// ErrBlobNotFound is returned when a blob doesn't exist in the store
+	ErrBlobNotFound = errors.New("large payload blob not found")
+	// ErrStoreNotConfigured is returned when a reference is found but no store is configured
+	ErrStoreNotConfigured = errors.New("large payload store is not configured")
+)
+
+// NewStore creates the blob store described by cfg, it returns nil if no URI is configured
+func NewStore(cfg *config.LargePayload) (Store, error) {
+	if cfg == nil || cfg.URI == "" {
+		return nil, nil
+	}
+	URI, err := archiver.NewURI(cfg.URI)
+	if err != nil {
+		return nil, err
+	}
+	switch URI.Scheme() {
+	case FilestoreScheme:
+		return newFilestore(URI, cfg.Filestore)
+	case S3storeScheme:
+		return newS3store(URI, cfg.S3store)
+	default:
+		return nil, fmt.Errorf("unsupported large payload store scheme: %v", URI.Scheme())
+	}
+}
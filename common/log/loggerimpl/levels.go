@@ -0,0 +1,286 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package loggerimpl
+
+import (
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+const (
+	componentFieldKey = "component"
+	redactedValue     = "<redacted>"
+
+	levelsRefreshInterval = 10 * time.Second
+)
+
+type (
+	// Levels controls the minimum level of log entries, optionally per component,
+	// and whether tags carrying user payloads are redacted.
+	// Levels can be updated at runtime, the change applies to all loggers built on top of it.
+	Levels struct {
+		level           zap.AtomicLevel
+		componentLevels atomic.Value // map[string]zapcore.Level
+		redactPayloads  int32
+	}
+
+	// levelCore filters log entries by the level of the component the logger is tagged with
+	levelCore struct {
+		zapcore.Core
+		levels    *Levels
+		component string
+	}
+
+	// redactionCore replaces the value of payload tags before they are encoded. The fields loggers are tagged with
+	// are handed to the wrapped core, except for payload tags which are kept aside and encoded with each entry,
+	// so turning redaction on applies to loggers built before the change.
+	redactionCore struct {
+		zapcore.Core
+		levels        *Levels
+		payloadFields []zapcore.Field
+	}
+)
+
+// NewLevels returns new log levels
+func NewLevels(
+	level zapcore.Level,
+	componentLevels map[string]zapcore.Level,
+	redactPayloads bool,
+) *Levels {
+	levels := &Levels{
+		level: zap.NewAtomicLevelAt(level),
+	}
+	levels.SetComponentLevels(componentLevels)
+	levels.SetRedactPayloads(redactPayloads)
+	return levels
+}
+
+// SetLevel updates the default minimum level
+func (l *Levels) SetLevel(level zapcore.Level) {
+	l.level.SetLevel(level)
+}
+
+// SetComponentLevels replaces the per component minimum levels
+func (l *Levels) SetComponentLevels(componentLevels map[string]zapcore.Level) {
+	levels := make(map[string]zapcore.Level, len(componentLevels))
+	for component, level := range componentLevels {
+		levels[component] = level
+	}
+	l.componentLevels.Store(levels)
+}
+
+// SetRedactPayloads updates whether tags carrying user payloads are redacted
+func (l *Levels) SetRedactPayloads(redactPayloads bool) {
+	var value int32
+	if redactPayloads {
+		value = 1
+	}
+	atomic.StoreInt32(&l.redactPayloads, value)
+}
+
+// Enabled returns whether an entry of the given level is emitted for the given component
+func (l *Levels) Enabled(component string, level zapcore.Level) bool {
+	if component != "" {
+		if componentLevel, ok := l.componentLevels.Load().(map[string]zapcore.Level)[component]; ok {
+			return componentLevel.Enabled(level)
+		}
+	}
+	return l.level.Enabled(level)
+}
+
+// RedactPayloads returns whether tags carrying user payloads are redacted
+func (l *Levels) RedactPayloads() bool {
+	return atomic.LoadInt32(&l.redactPayloads) == 1
+}
+
+// WrapCore returns a zap core which enforces these levels on top of the given core.
+// The given core should be enabled for all levels.
+func (l *Levels) WrapCore(core zapcore.Core) zapcore.Core {
+	return &levelCore{
+		Core:   core,
+		levels: l,
+	}
+}
+
+// WrapRedactionCore returns a zap core which redacts payload tags before handing entries to the given core
+func (l *Levels) WrapRedactionCore(core zapcore.Core) zapcore.Core {
+	return &redactionCore{
+		Core:   core,
+		levels: l,
+	}
+}
+
+// WatchDynamicConfig periodically applies the levels and payload redaction setting from dynamic config,
+// until doneC is closed
+func (l *Levels) WatchDynamicConfig(
+	level dynamicconfig.StringPropertyFn,
+	componentLevels dynamicconfig.MapPropertyFn,
+	redactPayloads dynamicconfig.BoolPropertyFn,
+	doneC <-chan struct{},
+) {
+	l.updateFromDynamicConfig(level, componentLevels, redactPayloads)
+
+	ticker := time.NewTicker(levelsRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			l.updateFromDynamicConfig(level, componentLevels, redactPayloads)
+		case <-doneC:
+			return
+		}
+	}
+}
+
+func (l *Levels) updateFromDynamicConfig(
+	level dynamicconfig.StringPropertyFn,
+	componentLevels dynamicconfig.MapPropertyFn,
+	redactPayloads dynamicconfig.BoolPropertyFn,
+) {
+	if lvl, ok := parseLevel(level()); ok {
+		l.SetLevel(lvl)
+	}
+
+	levels := make(map[string]zapcore.Level)
+	for component, value := range componentLevels() {
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if lvl, ok := parseLevel(str); ok {
+			levels[component] = lvl
+		}
+	}
+	l.SetComponentLevels(levels)
+
+	l.SetRedactPayloads(redactPayloads())
+}
+
+func parseLevel(level string) (zapcore.Level, bool) {
+	var lvl zapcore.Level
+	if err := lvl.UnmarshalText([]byte(strings.ToLower(level))); err != nil {
+		return zapcore.InfoLevel, false
+	}
+	return lvl, true
+}
+
+func (c *levelCore) Enabled(level zapcore.Level) bool {
+	return c.levels.Enabled(c.component, level)
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	component := c.component
+	for _, field := range fields {
+		if field.Key == componentFieldKey && field.Type == zapcore.StringType {
+			component = field.String
+		}
+	}
+	return &levelCore{
+		Core:      c.Core.With(fields),
+		levels:    c.levels,
+		component: component,
+	}
+}
+
+func (c *levelCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.Enabled(entry.Level) {
+		return checked
+	}
+	return c.Core.Check(entry, checked)
+}
+
+func (c *redactionCore) With(fields []zapcore.Field) zapcore.Core {
+	payloadFields := c.payloadFields
+	var otherFields []zapcore.Field
+	for i, field := range fields {
+		if !tag.IsPayloadKey(field.Key) {
+			if otherFields != nil {
+				otherFields = append(otherFields, field)
+			}
+			continue
+		}
+		if otherFields == nil {
+			otherFields = make([]zapcore.Field, i, len(fields))
+			copy(otherFields, fields[:i])
+			payloadFields = append([]zapcore.Field(nil), c.payloadFields...)
+		}
+		payloadFields = append(payloadFields, field)
+	}
+	if otherFields == nil {
+		otherFields = fields
+	}
+
+	return &redactionCore{
+		Core:          c.Core.With(otherFields),
+		levels:        c.levels,
+		payloadFields: payloadFields,
+	}
+}
+
+func (c *redactionCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *redactionCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	if len(c.payloadFields) > 0 {
+		withFields := make([]zapcore.Field, 0, len(c.payloadFields)+len(fields))
+		withFields = append(withFields, c.payloadFields...)
+		fields = append(withFields, fields...)
+	}
+	return c.Core.Write(entry, c.redact(fields))
+}
+
+func (c *redactionCore) redact(fields []zapcore.Field) []zapcore.Field {
+	if !c.levels.RedactPayloads() {
+		return fields
+	}
+
+	var redacted []zapcore.Field
+	for i, field := range fields {
+		if !tag.IsPayloadKey(field.Key) {
+			continue
+		}
+		if redacted == nil {
+			redacted = make([]zapcore.Field, len(fields))
+			copy(redacted, fields)
+		}
+		redacted[i] = zap.String(field.Key, redactedValue)
+	}
+
+	if redacted == nil {
+		return fields
+	}
+	return redacted
+}
@@ -0,0 +1,128 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package loggerimpl
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"go.temporal.io/server/common/log/tag"
+)
+
+func newTestLevelsLogger(levels *Levels, buf *bytes.Buffer) *loggerImpl {
+	encoder := zapcore.NewJSONEncoder(zapcore.EncoderConfig{MessageKey: "msg"})
+	core := zapcore.NewCore(encoder, zapcore.AddSync(buf), zap.DebugLevel)
+	core = levels.WrapCore(levels.WrapRedactionCore(core))
+	return NewLogger(zap.New(core)).(*loggerImpl)
+}
+
+func TestLevels_ComponentLevels(t *testing.T) {
+	var buf bytes.Buffer
+	levels := NewLevels(zap.InfoLevel, map[string]zapcore.Level{"shard": zap.DebugLevel}, true)
+	logger := newTestLevelsLogger(levels, &buf)
+
+	logger.Debug("default debug")
+	logger.WithTags(tag.ComponentShard).Debug("shard debug")
+	logger.WithTags(tag.ComponentMatchingEngine).Debug("matching debug")
+	logger.WithTags(tag.ComponentMatchingEngine).Info("matching info")
+
+	out := buf.String()
+	assert.NotContains(t, out, "default debug")
+	assert.Contains(t, out, "shard debug")
+	assert.NotContains(t, out, "matching debug")
+	assert.Contains(t, out, "matching info")
+
+	buf.Reset()
+	levels.SetLevel(zap.DebugLevel)
+	levels.SetComponentLevels(map[string]zapcore.Level{"shard": zap.ErrorLevel})
+	logger.Debug("default debug")
+	logger.WithTags(tag.ComponentShard).Warn("shard warn")
+
+	out = buf.String()
+	assert.Contains(t, out, "default debug")
+	assert.NotContains(t, out, "shard warn")
+}
+
+func TestLevels_RedactPayloads(t *testing.T) {
+	var buf bytes.Buffer
+	levels := NewLevels(zap.InfoLevel, nil, true)
+	logger := newTestLevelsLogger(levels, &buf)
+
+	logger.Info("redacted", tag.ESValue([]byte("secret input")), tag.WorkflowID("wid"))
+	logger.WithTags(tag.ESRequest("secret request")).Info("redacted with tags")
+	out := buf.String()
+	assert.NotContains(t, out, "secret")
+	assert.Equal(t, 2, strings.Count(out, redactedValue))
+	assert.Contains(t, out, "wid")
+
+	buf.Reset()
+	levels.SetRedactPayloads(false)
+	logger.Info("not redacted", tag.ESValue([]byte("secret input")))
+	assert.Contains(t, buf.String(), "secret input")
+}
+
+func TestLevels_RedactPayloads_TurnedOnAtRuntime(t *testing.T) {
+	var buf bytes.Buffer
+	levels := NewLevels(zap.InfoLevel, nil, false)
+	logger := newTestLevelsLogger(levels, &buf).WithTags(
+		tag.ActivityInfo(struct{ HeartbeatDetails string }{"secret details"}),
+		tag.WorkflowID("wid"),
+	)
+
+	logger.Info("not redacted")
+	assert.Contains(t, buf.String(), "secret details")
+
+	buf.Reset()
+	levels.SetRedactPayloads(true)
+	logger.Info("redacted", tag.Value("secret value"))
+	out := buf.String()
+	assert.NotContains(t, out, "secret")
+	assert.Equal(t, 2, strings.Count(out, redactedValue))
+	assert.Contains(t, out, "wid")
+}
+
+func TestLevels_RedactionCoreWith(t *testing.T) {
+	levels := NewLevels(zap.InfoLevel, nil, false)
+	workflowIDTag := tag.WorkflowID("wid")
+	valueTag := tag.Value("secret value")
+	core := levels.WrapRedactionCore(zapcore.NewNopCore()).With([]zapcore.Field{
+		workflowIDTag.Field(),
+		valueTag.Field(),
+	}).(*redactionCore)
+
+	// only payload tags are kept aside, the other fields are handed to the wrapped core
+	assert.Len(t, core.payloadFields, 1)
+	assert.Equal(t, valueTag.Field(), core.payloadFields[0])
+
+	var buf bytes.Buffer
+	logger := newTestLevelsLogger(levels, &buf)
+	logger.Info("not redacted", tag.Value("secret value"))
+	assert.Contains(t, buf.String(), `"value":"secret value"`)
+}
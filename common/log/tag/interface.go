@@ -33,6 +33,23 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
+// payloadKeys are the keys of the tags which may carry user payloads (e.g. workflow input, memo or search attributes).
+// Loggers which redact payloads recognize such tags by key, so the tags are encoded the same way whether
+// redaction is on or off.
+var payloadKeys = map[string]struct{}{
+	"value":                     {},
+	"es-request":                {},
+	"es-mapping-value":          {},
+	"archival-visibility-query": {},
+	"activity-info":             {},
+}
+
+// IsPayloadKey returns whether the tag with the given key may carry user payloads
+func IsPayloadKey(key string) bool {
+	_, ok := payloadKeys[key]
+	return ok
+}
+
 // Tag is the interface for logging system
 type Tag struct {
 	// keep this field private
@@ -182,6 +182,21 @@ func WorkflowNamespace(namespace string) Tag {
 	return newStringTag("wf-namespace", namespace)
 }
 
+// WorkflowNamespaceAlias returns tag for WorkflowNamespaceAlias
+func WorkflowNamespaceAlias(alias string) Tag {
+	return newStringTag("wf-namespace-alias", alias)
+}
+
+// WorkflowOperationReason returns tag for WorkflowOperationReason
+func WorkflowOperationReason(reason string) Tag {
+	return newStringTag("wf-operation-reason", reason)
+}
+
+// WorkflowOperationIdentity returns tag for WorkflowOperationIdentity
+func WorkflowOperationIdentity(identity string) Tag {
+	return newStringTag("wf-operation-identity", identity)
+}
+
 // WorkflowNamespaceIDs returns tag for WorkflowNamespaceIDs
 func WorkflowNamespaceIDs(namespaceIDs interface{}) Tag {
 	return newObjectTag("wf-namespace-ids", namespaceIDs)
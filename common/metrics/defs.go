@@ -152,6 +152,10 @@ const (
 	PersistenceDeleteCurrentWorkflowExecutionScope
 	// PersistenceGetCurrentExecutionScope tracks GetCurrentExecution calls made by service to persistence layer
 	PersistenceGetCurrentExecutionScope
+	// PersistenceCreateStartRequestIDScope tracks CreateStartRequestID calls made by service to persistence layer
+	PersistenceCreateStartRequestIDScope
+	// PersistenceGetStartRequestIDScope tracks GetStartRequestID calls made by service to persistence layer
+	PersistenceGetStartRequestIDScope
 	// PersistenceListConcreteExecutionsScope tracks ListConcreteExecutions calls made by service to persistence layer
 	PersistenceListConcreteExecutionsScope
 	// PersistenceGetTransferTaskScope tracks GetTransferTask calls made by service to persistence layer
@@ -220,6 +224,8 @@ const (
 	PersistenceDeleteNamespaceScope
 	// PersistenceDeleteNamespaceByNameScope tracks DeleteNamespaceByName calls made by service to persistence layer
 	PersistenceDeleteNamespaceByNameScope
+	// PersistenceRenameNamespaceScope tracks RenameNamespace calls made by service to persistence layer
+	PersistenceRenameNamespaceScope
 	// PersistenceListNamespaceScope tracks DeleteNamespaceByName calls made by service to persistence layer
 	PersistenceListNamespaceScope
 	// PersistenceGetMetadataScope tracks DeleteNamespaceByName calls made by service to persistence layer
@@ -350,6 +356,22 @@ const (
 	HistoryClientMergeDLQMessagesScope
 	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
 	HistoryClientRefreshWorkflowTasksScope
+	// HistoryClientRestoreWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientRestoreWorkflowExecutionScope
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope
+	// HistoryClientUnpauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUnpauseWorkflowExecutionScope
+	// HistoryClientPauseActivityScope tracks RPC calls to history service
+	HistoryClientPauseActivityScope
+	// HistoryClientUnpauseActivityScope tracks RPC calls to history service
+	HistoryClientUnpauseActivityScope
+	// HistoryClientResetActivityScope tracks RPC calls to history service
+	HistoryClientResetActivityScope
+	// HistoryClientUpdateActivityOptionsScope tracks RPC calls to history service
+	HistoryClientUpdateActivityOptionsScope
+	// HistoryClientStreamWorkflowExecutionHistoryScope tracks RPC calls to history service
+	HistoryClientStreamWorkflowExecutionHistoryScope
 	// MatchingClientPollWorkflowTaskQueueScope tracks RPC calls to matching service
 	MatchingClientPollWorkflowTaskQueueScope
 	// MatchingClientPollActivityTaskQueueScope tracks RPC calls to matching service
@@ -478,7 +500,39 @@ const (
 	AdminClientMergeDLQMessagesScope
 	// AdminClientRefreshWorkflowTasksScope tracks RPC calls to admin service
 	AdminClientRefreshWorkflowTasksScope
+	// AdminClientRestoreWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientRestoreWorkflowExecutionScope
+	// AdminClientPauseWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientPauseWorkflowExecutionScope
+	// AdminClientUnpauseWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientUnpauseWorkflowExecutionScope
+	// AdminClientPauseActivityScope tracks RPC calls to admin service
+	AdminClientPauseActivityScope
+	// AdminClientUnpauseActivityScope tracks RPC calls to admin service
+	AdminClientUnpauseActivityScope
+	// AdminClientResetActivityScope tracks RPC calls to admin service
+	AdminClientResetActivityScope
+	// AdminClientUpdateActivityOptionsScope tracks RPC calls to admin service
+	AdminClientUpdateActivityOptionsScope
+	// AdminClientStreamWorkflowExecutionHistoryScope tracks RPC calls to admin service
+	AdminClientStreamWorkflowExecutionHistoryScope
+	// AdminClientDeleteNamespaceScope tracks RPC calls to admin service
+	AdminClientDeleteNamespaceScope
+	// AdminClientUpdateNamespaceScope tracks RPC calls to admin service
+	AdminClientUpdateNamespaceScope
+	// AdminClientDescribeNamespaceAliasesScope tracks RPC calls to admin service
+	AdminClientDescribeNamespaceAliasesScope
+	// AdminClientStartWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientStartWorkflowExecutionScope
+	// AdminClientSignalWithStartWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientSignalWithStartWorkflowExecutionScope
 	// AdminClientResendReplicationTasksScope tracks RPC calls to admin service
+	// AdminClientGetDynamicConfigScope tracks RPC calls to admin service
+	AdminClientGetDynamicConfigScope
+	// AdminClientUpdateDynamicConfigScope tracks RPC calls to admin service
+	AdminClientUpdateDynamicConfigScope
+	// AdminClientListDynamicConfigScope tracks RPC calls to admin service
+	AdminClientListDynamicConfigScope
 	AdminClientResendReplicationTasksScope
 	// DCRedirectionDeprecateNamespaceScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateNamespaceScope
@@ -656,6 +710,9 @@ const (
 	// BlobstoreClientDirectoryExistsScope tracks DirectoryExists calls to blobstore
 	BlobstoreClientDirectoryExistsScope
 
+	// ServerTLSScope is scope used by all metrics emitted by the TLS certificate providers
+	ServerTLSScope
+
 	NumCommonScopes
 )
 
@@ -681,8 +738,40 @@ const (
 	AdminReapplyEventsScope
 	// AdminRefreshWorkflowTasksScope is the metric scope for admin.RefreshWorkflowTasks
 	AdminRefreshWorkflowTasksScope
+	// AdminRestoreWorkflowExecutionScope is the metric scope for admin.RestoreWorkflowExecution
+	AdminRestoreWorkflowExecutionScope
+	// AdminPauseWorkflowExecutionScope is the metric scope for admin.PauseWorkflowExecution
+	AdminPauseWorkflowExecutionScope
+	// AdminUnpauseWorkflowExecutionScope is the metric scope for admin.UnpauseWorkflowExecution
+	AdminUnpauseWorkflowExecutionScope
+	// AdminPauseActivityScope is the metric scope for admin.PauseActivity
+	AdminPauseActivityScope
+	// AdminUnpauseActivityScope is the metric scope for admin.UnpauseActivity
+	AdminUnpauseActivityScope
+	// AdminResetActivityScope is the metric scope for admin.ResetActivity
+	AdminResetActivityScope
+	// AdminUpdateActivityOptionsScope is the metric scope for admin.UpdateActivityOptions
+	AdminUpdateActivityOptionsScope
+	// AdminStreamWorkflowExecutionHistoryScope is the metric scope for admin.StreamWorkflowExecutionHistory
+	AdminStreamWorkflowExecutionHistoryScope
+	// AdminDeleteNamespaceScope is the metric scope for admin.DeleteNamespace
+	AdminDeleteNamespaceScope
+	// AdminUpdateNamespaceScope is the metric scope for admin.UpdateNamespace
+	AdminUpdateNamespaceScope
+	// AdminDescribeNamespaceAliasesScope is the metric scope for admin.DescribeNamespaceAliases
+	AdminDescribeNamespaceAliasesScope
+	// AdminStartWorkflowExecutionScope is the metric scope for admin.StartWorkflowExecution
+	AdminStartWorkflowExecutionScope
+	// AdminSignalWithStartWorkflowExecutionScope is the metric scope for admin.SignalWithStartWorkflowExecution
+	AdminSignalWithStartWorkflowExecutionScope
 	// AdminResendReplicationTasksScope is the metric scope for admin.ResendReplicationTasks
 	AdminResendReplicationTasksScope
+	// AdminGetDynamicConfigScope is the metric scope for admin.GetDynamicConfig
+	AdminGetDynamicConfigScope
+	// AdminUpdateDynamicConfigScope is the metric scope for admin.UpdateDynamicConfig
+	AdminUpdateDynamicConfigScope
+	// AdminListDynamicConfigScope is the metric scope for admin.ListDynamicConfig
+	AdminListDynamicConfigScope
 	// AdminRemoveTaskScope is the metric scope for admin.AdminRemoveTaskScope
 	AdminRemoveTaskScope
 	//AdminCloseShardTaskScope is the metric scope for admin.AdminRemoveTaskScope
@@ -851,6 +940,22 @@ const (
 	HistoryReapplyEventsScope
 	// HistoryRefreshWorkflowTasksScope is the scope used by refresh workflow tasks API
 	HistoryRefreshWorkflowTasksScope
+	// HistoryRestoreWorkflowExecutionScope is the scope used by restore workflow execution API
+	HistoryRestoreWorkflowExecutionScope
+	// HistoryPauseWorkflowExecutionScope is the scope used by pause workflow execution API
+	HistoryPauseWorkflowExecutionScope
+	// HistoryUnpauseWorkflowExecutionScope is the scope used by unpause workflow execution API
+	HistoryUnpauseWorkflowExecutionScope
+	// HistoryPauseActivityScope is the scope used by pause activity API
+	HistoryPauseActivityScope
+	// HistoryUnpauseActivityScope is the scope used by unpause activity API
+	HistoryUnpauseActivityScope
+	// HistoryResetActivityScope is the scope used by reset activity API
+	HistoryResetActivityScope
+	// HistoryUpdateActivityOptionsScope is the scope used by update activity options API
+	HistoryUpdateActivityOptionsScope
+	// HistoryStreamWorkflowExecutionHistoryScope is the scope used by stream workflow execution history API
+	HistoryStreamWorkflowExecutionHistoryScope
 	// TaskPriorityAssignerScope is the scope used by all metric emitted by task priority assigner
 	TaskPriorityAssignerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -913,6 +1018,8 @@ const (
 	TimerActiveTaskActivityRetryTimerScope
 	// TimerActiveTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerActiveTaskWorkflowBackoffTimerScope
+	// TimerActiveTaskWorkflowDeadlineTimerScope is the scope used by metric emitted by timer queue processor for processing deadline task.
+	TimerActiveTaskWorkflowDeadlineTimerScope
 	// TimerActiveTaskDeleteHistoryEventScope is the scope used by metric emitted by timer queue processor for processing history event cleanup
 	TimerActiveTaskDeleteHistoryEventScope
 	// TimerStandbyTaskActivityTimeoutScope is the scope used by metric emitted by timer queue processor for processing activity timeouts
@@ -929,6 +1036,8 @@ const (
 	TimerStandbyTaskDeleteHistoryEventScope
 	// TimerStandbyTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerStandbyTaskWorkflowBackoffTimerScope
+	// TimerStandbyTaskWorkflowDeadlineTimerScope is the scope used by metric emitted by timer queue processor for processing deadline task.
+	TimerStandbyTaskWorkflowDeadlineTimerScope
 	// HistoryEventNotificationScope is the scope used by shard history event nitification
 	HistoryEventNotificationScope
 	// ReplicatorQueueProcessorScope is the scope used by all metric emitted by replicator queue processor
@@ -1049,12 +1158,16 @@ const (
 	TaskQueueScavengerScope
 	// ExecutionsScavengerScope is scope used by all metrics emitted by worker.executions.Scavenger module
 	ExecutionsScavengerScope
+	// ArchivalScavengerScope is scope used by all metrics emitted by worker.archival.Scavenger module
+	ArchivalScavengerScope
 	// BatcherScope is scope used by all metrics emitted by worker.Batcher module
 	BatcherScope
 	// HistoryScavengerScope is scope used by all metrics emitted by worker.history.Scavenger module
 	HistoryScavengerScope
 	// ParentClosePolicyProcessorScope is scope used by all metrics emitted by worker.ParentClosePolicyProcessor
 	ParentClosePolicyProcessorScope
+	// NamespaceDeleterScope is scope used by all metrics emitted by worker.deletenamespace module
+	NamespaceDeleterScope
 
 	NumWorkerScopes
 )
@@ -1074,6 +1187,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceDeleteWorkflowExecutionScope:                  {operation: "DeleteWorkflowExecution"},
 		PersistenceDeleteCurrentWorkflowExecutionScope:           {operation: "DeleteCurrentWorkflowExecution"},
 		PersistenceGetCurrentExecutionScope:                      {operation: "GetCurrentExecution"},
+		PersistenceCreateStartRequestIDScope:                     {operation: "CreateStartRequestID"},
+		PersistenceGetStartRequestIDScope:                        {operation: "GetStartRequestID"},
 		PersistenceListConcreteExecutionsScope:                   {operation: "ListConcreteExecutions"},
 		PersistenceGetTransferTaskScope:                          {operation: "GetTransferTask"},
 		PersistenceGetTransferTasksScope:                         {operation: "GetTransferTasks"},
@@ -1108,6 +1223,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceUpdateNamespaceScope:                          {operation: "UpdateNamespace"},
 		PersistenceDeleteNamespaceScope:                          {operation: "DeleteNamespace"},
 		PersistenceDeleteNamespaceByNameScope:                    {operation: "DeleteNamespaceByName"},
+		PersistenceRenameNamespaceScope:                          {operation: "RenameNamespace"},
 		PersistenceListNamespaceScope:                            {operation: "ListNamespace"},
 		PersistenceGetMetadataScope:                              {operation: "GetMetadata"},
 		PersistenceRecordWorkflowExecutionStartedScope:           {operation: "RecordWorkflowExecutionStarted"},
@@ -1184,6 +1300,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientPurgeDLQMessagesScope:                    {operation: "HistoryClientPurgeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                    {operation: "HistoryClientMergeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRefreshWorkflowTasksScope:                {operation: "HistoryClientRefreshWorkflowTasksScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRestoreWorkflowExecutionScope:            {operation: "HistoryClientRestoreWorkflowExecutionScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPauseWorkflowExecutionScope:              {operation: "HistoryClientPauseWorkflowExecutionScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUnpauseWorkflowExecutionScope:            {operation: "HistoryClientUnpauseWorkflowExecutionScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPauseActivityScope:                       {operation: "HistoryClientPauseActivityScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUnpauseActivityScope:                     {operation: "HistoryClientUnpauseActivityScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientResetActivityScope:                       {operation: "HistoryClientResetActivityScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUpdateActivityOptionsScope:               {operation: "HistoryClientUpdateActivityOptionsScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientStreamWorkflowExecutionHistoryScope:      {operation: "HistoryClientStreamWorkflowExecutionHistoryScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollWorkflowTaskQueueScope:              {operation: "MatchingClientPollWorkflowTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollActivityTaskQueueScope:              {operation: "MatchingClientPollActivityTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                    {operation: "MatchingClientAddActivityTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
@@ -1244,7 +1368,23 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientGetWorkflowExecutionRawHistoryV2Scope:      {operation: "AdminClientGetWorkflowExecutionRawHistoryV2", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeClusterScope:                       {operation: "AdminClientDescribeCluster", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientRefreshWorkflowTasksScope:                  {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientRestoreWorkflowExecutionScope:              {operation: "AdminClientRestoreWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientPauseWorkflowExecutionScope:                {operation: "AdminClientPauseWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUnpauseWorkflowExecutionScope:              {operation: "AdminClientUnpauseWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientPauseActivityScope:                         {operation: "AdminClientPauseActivity", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUnpauseActivityScope:                       {operation: "AdminClientUnpauseActivity", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientResetActivityScope:                         {operation: "AdminClientResetActivity", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateActivityOptionsScope:                 {operation: "AdminClientUpdateActivityOptions", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStreamWorkflowExecutionHistoryScope:        {operation: "AdminClientStreamWorkflowExecutionHistory", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteNamespaceScope:                       {operation: "AdminClientDeleteNamespace", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateNamespaceScope:                       {operation: "AdminClientUpdateNamespace", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeNamespaceAliasesScope:              {operation: "AdminClientDescribeNamespaceAliases", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStartWorkflowExecutionScope:                {operation: "AdminClientStartWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientSignalWithStartWorkflowExecutionScope:      {operation: "AdminClientSignalWithStartWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientResendReplicationTasksScope:                {operation: "AdminClientResendReplicationTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetDynamicConfigScope:                      {operation: "AdminClientGetDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateDynamicConfigScope:                   {operation: "AdminClientUpdateDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListDynamicConfigScope:                     {operation: "AdminClientListDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetDLQMessagesScope:                        {operation: "AdminClientGetDLQMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientPurgeDLQMessagesScope:                      {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
@@ -1327,6 +1467,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		BlobstoreClientExistsScope:          {operation: "BlobstoreClientExists", tags: map[string]string{ServiceRoleTagName: BlobstoreRoleTagValue}},
 		BlobstoreClientDeleteScope:          {operation: "BlobstoreClientDelete", tags: map[string]string{ServiceRoleTagName: BlobstoreRoleTagValue}},
 		BlobstoreClientDirectoryExistsScope: {operation: "BlobstoreClientDirectoryExists", tags: map[string]string{ServiceRoleTagName: BlobstoreRoleTagValue}},
+
+		ServerTLSScope: {operation: "ServerTls"},
 	},
 	// Frontend Scope Names
 	Frontend: {
@@ -1346,7 +1488,23 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminGetDLQReplicationMessagesScope:        {operation: "AdminGetDLQReplicationMessages"},
 		AdminReapplyEventsScope:                    {operation: "ReapplyEvents"},
 		AdminRefreshWorkflowTasksScope:             {operation: "RefreshWorkflowTasks"},
+		AdminRestoreWorkflowExecutionScope:         {operation: "AdminRestoreWorkflowExecution"},
+		AdminPauseWorkflowExecutionScope:           {operation: "AdminPauseWorkflowExecution"},
+		AdminUnpauseWorkflowExecutionScope:         {operation: "AdminUnpauseWorkflowExecution"},
+		AdminPauseActivityScope:                    {operation: "AdminPauseActivity"},
+		AdminUnpauseActivityScope:                  {operation: "AdminUnpauseActivity"},
+		AdminResetActivityScope:                    {operation: "AdminResetActivity"},
+		AdminUpdateActivityOptionsScope:            {operation: "AdminUpdateActivityOptions"},
+		AdminStreamWorkflowExecutionHistoryScope:   {operation: "AdminStreamWorkflowExecutionHistory"},
+		AdminDeleteNamespaceScope:                  {operation: "AdminDeleteNamespace"},
+		AdminUpdateNamespaceScope:                  {operation: "AdminUpdateNamespace"},
+		AdminDescribeNamespaceAliasesScope:         {operation: "AdminDescribeNamespaceAliases"},
+		AdminStartWorkflowExecutionScope:           {operation: "AdminStartWorkflowExecution"},
+		AdminSignalWithStartWorkflowExecutionScope: {operation: "AdminSignalWithStartWorkflowExecution"},
 		AdminResendReplicationTasksScope:           {operation: "ResendReplicationTasks"},
+		AdminGetDynamicConfigScope:                 {operation: "GetDynamicConfig"},
+		AdminUpdateDynamicConfigScope:              {operation: "UpdateDynamicConfig"},
+		AdminListDynamicConfigScope:                {operation: "ListDynamicConfig"},
 
 		FrontendStartWorkflowExecutionScope:             {operation: "StartWorkflowExecution"},
 		FrontendPollWorkflowTaskQueueScope:              {operation: "PollWorkflowTaskQueue"},
@@ -1427,6 +1585,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryShardControllerScope:                            {operation: "ShardController"},
 		HistoryReapplyEventsScope:                              {operation: "EventReapplication"},
 		HistoryRefreshWorkflowTasksScope:                       {operation: "RefreshWorkflowTasks"},
+		HistoryRestoreWorkflowExecutionScope:                   {operation: "RestoreWorkflowExecution"},
+		HistoryPauseWorkflowExecutionScope:                     {operation: "PauseWorkflowExecution"},
+		HistoryUnpauseWorkflowExecutionScope:                   {operation: "UnpauseWorkflowExecution"},
+		HistoryPauseActivityScope:                              {operation: "PauseActivity"},
+		HistoryUnpauseActivityScope:                            {operation: "UnpauseActivity"},
+		HistoryResetActivityScope:                              {operation: "ResetActivity"},
+		HistoryUpdateActivityOptionsScope:                      {operation: "UpdateActivityOptions"},
+		HistoryStreamWorkflowExecutionHistoryScope:             {operation: "StreamWorkflowExecutionHistory"},
 		TaskPriorityAssignerScope:                              {operation: "TaskPriorityAssigner"},
 		TransferQueueProcessorScope:                            {operation: "TransferQueueProcessor"},
 		TransferActiveQueueProcessorScope:                      {operation: "TransferActiveQueueProcessor"},
@@ -1458,6 +1624,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TimerActiveTaskWorkflowTimeoutScope:                    {operation: "TimerActiveTaskWorkflowTimeout"},
 		TimerActiveTaskActivityRetryTimerScope:                 {operation: "TimerActiveTaskActivityRetryTimer"},
 		TimerActiveTaskWorkflowBackoffTimerScope:               {operation: "TimerActiveTaskWorkflowBackoffTimer"},
+		TimerActiveTaskWorkflowDeadlineTimerScope:              {operation: "TimerActiveTaskWorkflowDeadlineTimer"},
 		TimerActiveTaskDeleteHistoryEventScope:                 {operation: "TimerActiveTaskDeleteHistoryEvent"},
 		TimerStandbyTaskActivityTimeoutScope:                   {operation: "TimerStandbyTaskActivityTimeout"},
 		TimerStandbyTaskWorkflowTaskTimeoutScope:               {operation: "TimerStandbyTaskWorkflowTaskTimeout"},
@@ -1465,6 +1632,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TimerStandbyTaskWorkflowTimeoutScope:                   {operation: "TimerStandbyTaskWorkflowTimeout"},
 		TimerStandbyTaskActivityRetryTimerScope:                {operation: "TimerStandbyTaskActivityRetryTimer"},
 		TimerStandbyTaskWorkflowBackoffTimerScope:              {operation: "TimerStandbyTaskWorkflowBackoffTimer"},
+		TimerStandbyTaskWorkflowDeadlineTimerScope:             {operation: "TimerStandbyTaskWorkflowDeadlineTimer"},
 		TimerStandbyTaskDeleteHistoryEventScope:                {operation: "TimerStandbyTaskDeleteHistoryEvent"},
 		HistoryEventNotificationScope:                          {operation: "HistoryEventNotification"},
 		ReplicatorQueueProcessorScope:                          {operation: "ReplicatorQueueProcessor"},
@@ -1523,9 +1691,11 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ArchiverArchivalWorkflowScope:          {operation: "ArchiverArchivalWorkflow"},
 		TaskQueueScavengerScope:                {operation: "taskqueuescavenger"},
 		ExecutionsScavengerScope:               {operation: "executionsscavenger"},
+		ArchivalScavengerScope:                 {operation: "archivalscavenger"},
 		HistoryScavengerScope:                  {operation: "historyscavenger"},
 		BatcherScope:                           {operation: "batcher"},
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
+		NamespaceDeleterScope:                  {operation: "namespacedeleter"},
 	},
 }
 
@@ -1655,6 +1825,11 @@ const (
 	ServiceErrUnauthorizedPerTaskQueueCounter
 	ServiceErrAuthorizeFailedPerTaskQueueCounter
 
+	TLSCertsExpired
+	TLSCertsExpiring
+	TLSCertReloadCounter
+	TLSCertReloadFailedCounter
+
 	NumCommonMetrics // Needs to be last on this list for iota numbering
 )
 
@@ -1821,6 +1996,9 @@ const (
 	QueryBeforeFirstWorkflowTaskCount
 	QueryBufferExceededCount
 	QueryRegistryInvalidStateCount
+	QueryAnsweredCount
+	QueryFailedCount
+	QueryRejectedCount
 	WorkerNotSupportsConsistentQueryCount
 	WorkflowTaskTimeoutOverrideCount
 	WorkflowRunTimeoutOverrideCount
@@ -1911,6 +2089,21 @@ const (
 	TaskQueueDeletedCount
 	TaskQueueOutstandingCount
 	ExecutionsOutstandingCount
+	ExecutionsScannedCount
+	ExecutionsCheckFailedCount
+	ExecutionsCorruptedCount
+	ExecutionsFixedCount
+	ExecutionsFixSkippedCount
+	ExecutionsFixFailedCount
+	ArchivalVisibilityScannedCount
+	ArchivalVisibilityCorruptedCount
+	ArchivalHistoryVerifiedCount
+	ArchivalHistoryMissingCount
+	ArchivalHistoryCorruptedCount
+	ArchivalHistoryReArchivedCount
+	ArchivalHistoryReArchiveSkippedCount
+	ArchivalHistoryReArchiveFailedCount
+	ArchivalScanErrorCount
 	StartedCount
 	StoppedCount
 	ExecutorTasksDeferredCount
@@ -1923,6 +2116,10 @@ const (
 	ParentClosePolicyProcessorSuccess
 	ParentClosePolicyProcessorFailures
 	NamespaceReplicationEnqueueDLQCount
+	NamespaceDeleterExecutionsTerminatedCount
+	NamespaceDeleterExecutionsDeletedCount
+	NamespaceDeleterTaskQueuesDeletedCount
+	NamespaceDeleterFailures
 
 	NumWorkerMetrics
 )
@@ -2077,6 +2274,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ServiceErrAuthorizeFailedPerTaskQueueCounter: {
 			metricName: "service_errors_authorize_failed_per_tl", metricRollupName: "service_errors_authorize_failed", metricType: Counter,
 		},
+
+		TLSCertsExpired:            {metricName: "certificates_expired", metricType: Gauge},
+		TLSCertsExpiring:           {metricName: "certificates_expiring", metricType: Gauge},
+		TLSCertReloadCounter:       {metricName: "certificate_reloads", metricType: Counter},
+		TLSCertReloadFailedCounter: {metricName: "certificate_reload_errors", metricType: Counter},
 	},
 	History: {
 		TaskRequests:                                      {metricName: "task_requests", metricType: Counter},
@@ -2237,6 +2439,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		QueryBeforeFirstWorkflowTaskCount:                 {metricName: "query_before_first_workflow_task", metricType: Counter},
 		QueryBufferExceededCount:                          {metricName: "query_buffer_exceeded", metricType: Counter},
 		QueryRegistryInvalidStateCount:                    {metricName: "query_registry_invalid_state", metricType: Counter},
+		QueryAnsweredCount:                                {metricName: "query_answered", metricType: Counter},
+		QueryFailedCount:                                  {metricName: "query_failed", metricType: Counter},
+		QueryRejectedCount:                                {metricName: "query_rejected", metricType: Counter},
 		WorkerNotSupportsConsistentQueryCount:             {metricName: "worker_not_supports_consistent_query", metricType: Counter},
 		WorkflowTaskTimeoutOverrideCount:                  {metricName: "workflow_task_timeout_overrides", metricType: Counter},
 		WorkflowRunTimeoutOverrideCount:                   {metricName: "workflow_run_timeout_overrides", metricType: Counter},
@@ -2319,6 +2524,21 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskQueueDeletedCount:                         {metricName: "taskqueue_deleted", metricType: Gauge},
 		TaskQueueOutstandingCount:                     {metricName: "taskqueue_outstanding", metricType: Gauge},
 		ExecutionsOutstandingCount:                    {metricName: "executions_outstanding", metricType: Gauge},
+		ExecutionsScannedCount:                        {metricName: "executions_scanned", metricType: Counter},
+		ExecutionsCheckFailedCount:                    {metricName: "executions_check_failed", metricType: Counter},
+		ExecutionsCorruptedCount:                      {metricName: "executions_corrupted", metricType: Counter},
+		ExecutionsFixedCount:                          {metricName: "executions_fixed", metricType: Counter},
+		ExecutionsFixSkippedCount:                     {metricName: "executions_fix_skipped", metricType: Counter},
+		ExecutionsFixFailedCount:                      {metricName: "executions_fix_failed", metricType: Counter},
+		ArchivalVisibilityScannedCount:                {metricName: "archival_visibility_scanned", metricType: Counter},
+		ArchivalVisibilityCorruptedCount:              {metricName: "archival_visibility_corrupted", metricType: Counter},
+		ArchivalHistoryVerifiedCount:                  {metricName: "archival_history_verified", metricType: Counter},
+		ArchivalHistoryMissingCount:                   {metricName: "archival_history_missing", metricType: Counter},
+		ArchivalHistoryCorruptedCount:                 {metricName: "archival_history_corrupted", metricType: Counter},
+		ArchivalHistoryReArchivedCount:                {metricName: "archival_history_rearchived", metricType: Counter},
+		ArchivalHistoryReArchiveSkippedCount:          {metricName: "archival_history_rearchive_skipped", metricType: Counter},
+		ArchivalHistoryReArchiveFailedCount:           {metricName: "archival_history_rearchive_failed", metricType: Counter},
+		ArchivalScanErrorCount:                        {metricName: "archival_scan_errors", metricType: Counter},
 		StartedCount:                                  {metricName: "started", metricType: Counter},
 		StoppedCount:                                  {metricName: "stopped", metricType: Counter},
 		ExecutorTasksDeferredCount:                    {metricName: "executor_deferred", metricType: Counter},
@@ -2331,6 +2551,10 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ParentClosePolicyProcessorSuccess:             {metricName: "parent_close_policy_processor_requests", metricType: Counter},
 		ParentClosePolicyProcessorFailures:            {metricName: "parent_close_policy_processor_errors", metricType: Counter},
 		NamespaceReplicationEnqueueDLQCount:           {metricName: "namespace_replication_dlq_enqueue_requests", metricType: Counter},
+		NamespaceDeleterExecutionsTerminatedCount:     {metricName: "namespace_deleter_executions_terminated", metricType: Counter},
+		NamespaceDeleterExecutionsDeletedCount:        {metricName: "namespace_deleter_executions_deleted", metricType: Counter},
+		NamespaceDeleterTaskQueuesDeletedCount:        {metricName: "namespace_deleter_task_queues_deleted", metricType: Counter},
+		NamespaceDeleterFailures:                      {metricName: "namespace_deleter_errors", metricType: Counter},
 	},
 }
 
@@ -39,6 +39,8 @@ const (
 	workflowType  = "workflowType"
 	activityType  = "activityType"
 	commandType   = "commandType"
+	invariantType = "invariantType"
+	queryType     = "queryType"
 
 	namespaceAllValue = "all"
 	unknownValue      = "_unknown_"
@@ -82,6 +84,14 @@ type (
 	commandTypeTag struct {
 		value string
 	}
+
+	invariantTypeTag struct {
+		value string
+	}
+
+	queryTypeTag struct {
+		value string
+	}
 )
 
 // NamespaceTag returns a new namespace tag. For timers, this also ensures that we
@@ -238,3 +248,40 @@ func (d commandTypeTag) Key() string {
 func (d commandTypeTag) Value() string {
 	return d.value
 }
+
+// InvariantTypeTag returns a new invariant type tag.
+func InvariantTypeTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return invariantTypeTag{value}
+}
+
+// Key returns the key of the invariant type tag
+func (d invariantTypeTag) Key() string {
+	return invariantType
+}
+
+// Value returns the value of the invariant type tag
+func (d invariantTypeTag) Value() string {
+	return d.value
+}
+
+// QueryTypeTag returns a new query type tag. Query types are user defined, the number of their values
+// is bounded by the maxTagValues setting of the prometheus reporter.
+func QueryTypeTag(value string) Tag {
+	if len(value) == 0 {
+		return queryTypeTag{unknownValue}
+	}
+	return queryTypeTag{value}
+}
+
+// Key returns the key of the query type tag
+func (d queryTypeTag) Key() string {
+	return queryType
+}
+
+// Value returns the value of the query type tag
+func (d queryTypeTag) Value() string {
+	return d.value
+}
@@ -0,0 +1,200 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package prometheus
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	prom "github.com/m3db/prometheus_client_golang/prometheus"
+	"github.com/uber-go/tally"
+	tallyprometheus "github.com/uber-go/tally/prometheus"
+)
+
+// OverflowTagValue is reported in place of a tag value once the number of
+// distinct values seen for that tag key exceeds its configured limit
+const OverflowTagValue = "_overflow_"
+
+type (
+	// Options contains the temporal specific extensions on top of the tally prometheus reporter
+	Options struct {
+		// HistogramBuckets maps a timer name to the histogram buckets (in seconds) it should use,
+		// overriding the reporter's default timer type and buckets for that timer
+		HistogramBuckets map[string][]float64
+		// MaxTagValues maps a tag key to the maximum number of distinct values reported for it
+		MaxTagValues map[string]int
+		// OnError is invoked when a metric cannot be registered
+		OnError func(err error)
+	}
+
+	temporalTallyPrometheusReporter struct {
+		//Wrapper on top of "github.com/uber-go/tally/prometheus"
+		tallyprometheus.Reporter
+
+		histogramBuckets map[string][]float64
+		tagLimiter       *tagValueLimiter
+		onError          func(err error)
+	}
+
+	tagValueLimiter struct {
+		sync.Mutex
+		limits map[string]int
+		seen   map[string]map[string]struct{}
+	}
+
+	histogramTimer struct {
+		histogram prom.Histogram
+	}
+
+	noopTimer struct{}
+)
+
+var _ tallyprometheus.Reporter = (*temporalTallyPrometheusReporter)(nil)
+
+// NewReporter is a wrapper on top of "github.com/uber-go/tally/prometheus"
+// The purpose is to support per timer histogram buckets and to guard
+// the cardinality of high volume tags such as namespace and task queue
+func NewReporter(reporter tallyprometheus.Reporter, opts Options) tallyprometheus.Reporter {
+	onError := opts.OnError
+	if onError == nil {
+		onError = func(err error) {}
+	}
+	return &temporalTallyPrometheusReporter{
+		Reporter:         reporter,
+		histogramBuckets: opts.HistogramBuckets,
+		tagLimiter:       newTagValueLimiter(opts.MaxTagValues),
+		onError:          onError,
+	}
+}
+
+func (r *temporalTallyPrometheusReporter) AllocateCounter(name string, tags map[string]string) tally.CachedCount {
+	return r.Reporter.AllocateCounter(name, r.tagLimiter.limit(tags))
+}
+
+func (r *temporalTallyPrometheusReporter) AllocateGauge(name string, tags map[string]string) tally.CachedGauge {
+	return r.Reporter.AllocateGauge(name, r.tagLimiter.limit(tags))
+}
+
+func (r *temporalTallyPrometheusReporter) AllocateTimer(name string, tags map[string]string) tally.CachedTimer {
+	tags = r.tagLimiter.limit(tags)
+	buckets, ok := r.histogramBuckets[name]
+	if !ok {
+		return r.Reporter.AllocateTimer(name, tags)
+	}
+
+	timer, err := r.RegisterTimer(
+		name,
+		tagKeys(tags),
+		name+" histogram",
+		&tallyprometheus.RegisterTimerOptions{
+			TimerType:        tallyprometheus.HistogramTimerType,
+			HistogramBuckets: buckets,
+		},
+	)
+	if err == nil && timer.Histogram == nil {
+		err = fmt.Errorf("timer %v is already registered as a summary", name)
+	}
+	if err != nil {
+		r.onError(err)
+		return noopTimer{}
+	}
+	return &histogramTimer{histogram: timer.Histogram.With(tags)}
+}
+
+func (r *temporalTallyPrometheusReporter) AllocateHistogram(
+	name string,
+	tags map[string]string,
+	buckets tally.Buckets,
+) tally.CachedHistogram {
+	return r.Reporter.AllocateHistogram(name, r.tagLimiter.limit(tags), buckets)
+}
+
+func (t *histogramTimer) ReportTimer(interval time.Duration) {
+	t.histogram.Observe(float64(interval) / float64(time.Second))
+}
+
+func (t noopTimer) ReportTimer(interval time.Duration) {}
+
+func newTagValueLimiter(limits map[string]int) *tagValueLimiter {
+	seen := make(map[string]map[string]struct{}, len(limits))
+	for key := range limits {
+		seen[key] = make(map[string]struct{})
+	}
+	return &tagValueLimiter{
+		limits: limits,
+		seen:   seen,
+	}
+}
+
+// limit returns the tags to report, replacing the value of every limited tag key
+// which has already reached its maximum number of distinct values with OverflowTagValue
+func (l *tagValueLimiter) limit(tags map[string]string) map[string]string {
+	if len(l.limits) == 0 {
+		return tags
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	var result map[string]string
+	for key, value := range tags {
+		maxValues, ok := l.limits[key]
+		if !ok {
+			continue
+		}
+		values := l.seen[key]
+		if _, ok := values[value]; ok {
+			continue
+		}
+		if len(values) < maxValues {
+			values[value] = struct{}{}
+			continue
+		}
+
+		if result == nil {
+			result = make(map[string]string, len(tags))
+			for k, v := range tags {
+				result[k] = v
+			}
+		}
+		result[key] = OverflowTagValue
+	}
+
+	if result == nil {
+		return tags
+	}
+	return result
+}
+
+func tagKeys(tags map[string]string) []string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
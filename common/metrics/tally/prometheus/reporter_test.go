@@ -0,0 +1,105 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package prometheus
+
+import (
+	"testing"
+	"time"
+
+	prom "github.com/m3db/prometheus_client_golang/prometheus"
+	"github.com/stretchr/testify/suite"
+	tallyprometheus "github.com/uber-go/tally/prometheus"
+)
+
+type (
+	reporterSuite struct {
+		suite.Suite
+
+		registry *prom.Registry
+		reporter tallyprometheus.Reporter
+	}
+)
+
+func TestReporterSuite(t *testing.T) {
+	s := new(reporterSuite)
+	suite.Run(t, s)
+}
+
+func (s *reporterSuite) SetupTest() {
+	s.registry = prom.NewRegistry()
+	s.reporter = NewReporter(
+		tallyprometheus.NewReporter(tallyprometheus.Options{
+			Registerer:       s.registry,
+			DefaultTimerType: tallyprometheus.SummaryTimerType,
+		}),
+		Options{
+			HistogramBuckets: map[string][]float64{"service_latency": {0.1, 1}},
+			MaxTagValues:     map[string]int{"namespace": 2},
+			OnError:          func(err error) { s.NoError(err) },
+		},
+	)
+}
+
+func (s *reporterSuite) TestAllocateTimer_HistogramBuckets() {
+	s.reporter.AllocateTimer("service_latency", map[string]string{"operation": "test"}).ReportTimer(500 * time.Millisecond)
+	s.reporter.AllocateTimer("persistence_latency", map[string]string{"operation": "test"}).ReportTimer(time.Second)
+
+	families, err := s.registry.Gather()
+	s.NoError(err)
+	s.Len(families, 2)
+	for _, family := range families {
+		switch family.GetName() {
+		case "service_latency":
+			histogram := family.GetMetric()[0].GetHistogram()
+			s.NotNil(histogram)
+			s.Len(histogram.GetBucket(), 2)
+			s.Equal(uint64(0), histogram.GetBucket()[0].GetCumulativeCount())
+			s.Equal(uint64(1), histogram.GetBucket()[1].GetCumulativeCount())
+		case "persistence_latency":
+			s.NotNil(family.GetMetric()[0].GetSummary())
+		default:
+			s.Fail("unexpected metric family", family.GetName())
+		}
+	}
+}
+
+func (s *reporterSuite) TestAllocateCounter_MaxTagValues() {
+	for _, namespace := range []string{"ns1", "ns2", "ns3", "ns4", "ns1"} {
+		s.reporter.AllocateCounter("service_requests", map[string]string{"namespace": namespace}).ReportCount(1)
+	}
+
+	families, err := s.registry.Gather()
+	s.NoError(err)
+	s.Len(families, 1)
+	counts := make(map[string]float64)
+	for _, metric := range families[0].GetMetric() {
+		counts[metric.GetLabel()[0].GetValue()] = metric.GetCounter().GetValue()
+	}
+	s.Equal(map[string]float64{
+		"ns1":            2,
+		"ns2":            1,
+		OverflowTagValue: 2,
+	}, counts)
+}
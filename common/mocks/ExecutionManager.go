@@ -211,6 +211,43 @@ func (_m *ExecutionManager) GetCurrentExecution(request *persistence.GetCurrentE
 	return r0, r1
 }
 
+// CreateStartRequestID provides a mock function with given fields: request
+func (_m *ExecutionManager) CreateStartRequestID(request *persistence.CreateStartRequestIDRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.CreateStartRequestIDRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetStartRequestID provides a mock function with given fields: request
+func (_m *ExecutionManager) GetStartRequestID(request *persistence.GetStartRequestIDRequest) (*persistence.GetStartRequestIDResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.GetStartRequestIDResponse
+	if rf, ok := ret.Get(0).(func(*persistence.GetStartRequestIDRequest) *persistence.GetStartRequestIDResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetStartRequestIDResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.GetStartRequestIDRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListConcreteExecutions provides a mock function with given fields: request
 func (_m *ExecutionManager) ListConcreteExecutions(request *persistence.ListConcreteExecutionsRequest) (*persistence.ListConcreteExecutionsResponse, error) {
 	ret := _m.Called(request)
@@ -109,6 +109,20 @@ func (_m *MetadataManager) DeleteNamespaceByName(request *persistence.DeleteName
 	return r0
 }
 
+// RenameNamespace provides a mock function with given fields: request
+func (_m *MetadataManager) RenameNamespace(request *persistence.RenameNamespaceRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.RenameNamespaceRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetNamespace provides a mock function with given fields: request
 func (_m *MetadataManager) GetNamespace(request *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
 	ret := _m.Called(request)
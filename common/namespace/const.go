@@ -24,10 +24,23 @@
 
 package namespace
 
+import (
+	"time"
+)
+
 const (
 	// MinRetentionDays is the minimal retention days for any namespace
 	MinRetentionDays = 1
 
 	// MaxBadBinaries is the maximal number of bad client binaries stored in a namespace
 	MaxBadBinaries = 10
+
+	// DefaultAliasRetention is the default time a renamed namespace remains reachable by its previous name
+	DefaultAliasRetention = 30 * 24 * time.Hour
+
+	// aliasLookupPageSize is the page size used to list the namespaces when building the alias index
+	aliasLookupPageSize = 200
+
+	// aliasIndexRefreshInterval is the maximal age of the alias index used to look namespace aliases up
+	aliasIndexRefreshInterval = 10 * time.Second
 )
@@ -36,4 +36,8 @@ var (
 	errCannotDoNamespaceFailoverAndUpdate = serviceerror.NewInvalidArgument("Cannot set active cluster to current cluster when other parameters are set.")
 	errInvalidRetentionPeriod             = serviceerror.NewInvalidArgument("A valid retention period is not set on request.")
 	errInvalidArchivalConfig              = serviceerror.NewInvalidArgument("Invalid to enable archival without specifying a uri.")
+	errCannotDeleteSystemNamespace        = serviceerror.NewInvalidArgument("Cannot delete the system namespace.")
+	errCannotDeleteGlobalNamespace        = serviceerror.NewInvalidArgument("Cannot delete a global namespace.")
+	errNamespaceDeleted                   = serviceerror.NewInvalidArgument("Namespace is deleted.")
+	errCannotRenameSystemNamespace        = serviceerror.NewInvalidArgument("Cannot rename the system namespace.")
 )
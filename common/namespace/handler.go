@@ -29,6 +29,7 @@ package namespace
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -38,8 +39,11 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	namespacespb "go.temporal.io/server/api/namespace/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
@@ -54,6 +58,10 @@ import (
 type (
 	// Handler is the namespace operation handler
 	Handler interface {
+		DeleteNamespace(
+			ctx context.Context,
+			deleteRequest *adminservice.DeleteNamespaceRequest,
+		) (*adminservice.DeleteNamespaceResponse, error)
 		DeprecateNamespace(
 			ctx context.Context,
 			deprecateRequest *workflowservice.DeprecateNamespaceRequest,
@@ -62,6 +70,10 @@ type (
 			ctx context.Context,
 			describeRequest *workflowservice.DescribeNamespaceRequest,
 		) (*workflowservice.DescribeNamespaceResponse, error)
+		DescribeNamespaceAliases(
+			ctx context.Context,
+			describeRequest *adminservice.DescribeNamespaceAliasesRequest,
+		) (*adminservice.DescribeNamespaceAliasesResponse, error)
 		ListNamespaces(
 			ctx context.Context,
 			listRequest *workflowservice.ListNamespacesRequest,
@@ -74,6 +86,10 @@ type (
 			ctx context.Context,
 			updateRequest *workflowservice.UpdateNamespaceRequest,
 		) (*workflowservice.UpdateNamespaceResponse, error)
+		AdminUpdateNamespace(
+			ctx context.Context,
+			updateRequest *adminservice.UpdateNamespaceRequest,
+		) (*adminservice.UpdateNamespaceResponse, error)
 	}
 
 	// HandlerImpl is the namespace operation handler implementation
@@ -86,6 +102,15 @@ type (
 		namespaceAttrValidator *AttrValidatorImpl
 		archivalMetadata       archiver.ArchivalMetadata
 		archiverProvider       provider.ArchiverProvider
+		aliases                *aliasIndex
+	}
+
+	// aliasIndex maps the unexpired aliases of the namespaces to the namespace ids. Aliases are only kept within
+	// the namespace records, so the index is built by listing all of the namespaces, at most once per refresh interval.
+	aliasIndex struct {
+		sync.Mutex
+		ids         map[string]string
+		refreshTime time.Time
 	}
 )
 
@@ -111,6 +136,7 @@ func NewHandler(
 		namespaceAttrValidator: newAttrValidator(clusterMetadata, int32(minRetentionDays)),
 		archivalMetadata:       archivalMetadata,
 		archiverProvider:       archiverProvider,
+		aliases:                &aliasIndex{},
 	}
 }
 
@@ -133,8 +159,8 @@ func (d *HandlerImpl) RegisterNamespace(
 		}
 	}
 
-	// first check if the name is already registered as the local namespace
-	_, err := d.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: registerRequest.GetName()})
+	// first check if the name is already registered as the local namespace, or kept as the alias of a renamed one
+	_, err := d.getNamespaceByNameOrLatestAlias(registerRequest.GetName(), true)
 	switch err.(type) {
 	case nil:
 		// namespace already exists, cannot proceed
@@ -332,11 +358,16 @@ func (d *HandlerImpl) DescribeNamespace(
 ) (*workflowservice.DescribeNamespaceResponse, error) {
 
 	// TODO, we should migrate the non global namespace to new table, see #773
-	req := &persistence.GetNamespaceRequest{
-		Name: describeRequest.GetName(),
-		ID:   describeRequest.GetId(),
+	var resp *persistence.GetNamespaceResponse
+	var err error
+	if describeRequest.GetId() == "" {
+		resp, err = d.getNamespaceByNameOrAlias(describeRequest.GetName())
+	} else {
+		resp, err = d.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{
+			Name: describeRequest.GetName(),
+			ID:   describeRequest.GetId(),
+		})
 	}
-	resp, err := d.metadataMgr.GetNamespace(req)
 	if err != nil {
 		return nil, err
 	}
@@ -356,6 +387,31 @@ func (d *HandlerImpl) UpdateNamespace(
 	updateRequest *workflowservice.UpdateNamespaceRequest,
 ) (*workflowservice.UpdateNamespaceResponse, error) {
 
+	resp, err := d.updateNamespace(ctx, &adminservice.UpdateNamespaceRequest{Request: updateRequest})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Response, nil
+}
+
+// AdminUpdateNamespace updates the namespace with the options the public update request doesn't carry.
+// A rename keeps the previous name as an alias which resolves to the namespace until the alias retention
+// of the request expires, dynamic config values constrained to the previous name keep applying meanwhile.
+// Deadline rules replace the default deadline rules of the workflows started in the namespace afterwards.
+func (d *HandlerImpl) AdminUpdateNamespace(
+	ctx context.Context,
+	updateRequest *adminservice.UpdateNamespaceRequest,
+) (*adminservice.UpdateNamespaceResponse, error) {
+
+	return d.updateNamespace(ctx, updateRequest)
+}
+
+func (d *HandlerImpl) updateNamespace(
+	ctx context.Context,
+	adminRequest *adminservice.UpdateNamespaceRequest,
+) (*adminservice.UpdateNamespaceResponse, error) {
+
+	updateRequest := adminRequest.GetRequest()
 	// must get the metadata (notificationVersion) first
 	// this version can be regarded as the lock on the v2 namespace table
 	// and since we do not know which table will return the namespace afterwards
@@ -365,7 +421,7 @@ func (d *HandlerImpl) UpdateNamespace(
 		return nil, err
 	}
 	notificationVersion := metadata.NotificationVersion
-	getResponse, err := d.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: updateRequest.GetName()})
+	getResponse, err := d.getNamespaceByNameOrAlias(updateRequest.GetName())
 	if err != nil {
 		return nil, err
 	}
@@ -498,6 +554,28 @@ func (d *HandlerImpl) UpdateNamespace(
 		}
 	}
 
+	now := time.Now().UTC()
+	previousName := info.Name
+	renamed := false
+	if newName := adminRequest.GetNewName(); newName != "" && newName != info.Name {
+		if err := d.renameNamespace(info, updateRequest.GetName(), newName, timestamp.DurationValue(adminRequest.GetAliasRetention()), now); err != nil {
+			return nil, err
+		}
+		configurationChanged = true
+		renamed = true
+	}
+
+	if deadlineRules := adminRequest.GetDeadlineRules(); deadlineRules != nil {
+		if info.State == enumspb.NAMESPACE_STATE_DELETED {
+			return nil, errNamespaceDeleted
+		}
+		if err := common.ValidateDeadlineRules(deadlineRules.GetRules()); err != nil {
+			return nil, err
+		}
+		configurationChanged = true
+		config.DeadlineRules = deadlineRules.GetRules()
+	}
+
 	if err := d.namespaceAttrValidator.validateNamespaceConfig(config); err != nil {
 		return nil, err
 	}
@@ -534,18 +612,27 @@ func (d *HandlerImpl) UpdateNamespace(
 			failoverNotificationVersion = notificationVersion
 		}
 
-		updateReq := &persistence.UpdateNamespaceRequest{
-			Namespace: &persistenceblobs.NamespaceDetail{
-				Info:                        info,
-				Config:                      config,
-				ReplicationConfig:           replicationConfig,
-				ConfigVersion:               configVersion,
-				FailoverVersion:             failoverVersion,
-				FailoverNotificationVersion: failoverNotificationVersion,
-			},
-			NotificationVersion: notificationVersion,
+		detail := &persistenceblobs.NamespaceDetail{
+			Info:                        info,
+			Config:                      config,
+			ReplicationConfig:           replicationConfig,
+			ConfigVersion:               configVersion,
+			FailoverVersion:             failoverVersion,
+			FailoverNotificationVersion: failoverNotificationVersion,
+		}
+		if renamed {
+			// the rename rewrites the whole namespace detail together with the name index
+			err = d.metadataMgr.RenameNamespace(&persistence.RenameNamespaceRequest{
+				PreviousName:        previousName,
+				Namespace:           detail,
+				NotificationVersion: notificationVersion,
+			})
+		} else {
+			err = d.metadataMgr.UpdateNamespace(&persistence.UpdateNamespaceRequest{
+				Namespace:           detail,
+				NotificationVersion: notificationVersion,
+			})
 		}
-		err = d.metadataMgr.UpdateNamespace(updateReq)
 		if err != nil {
 			return nil, err
 		}
@@ -569,11 +656,74 @@ func (d *HandlerImpl) UpdateNamespace(
 	}
 	response.NamespaceInfo, response.Config, response.ReplicationConfig = d.createResponse(ctx, info, config, replicationConfig)
 
-	d.logger.Info("Update namespace succeeded",
-		tag.WorkflowNamespace(info.Name),
-		tag.WorkflowNamespaceID(info.Id),
-	)
-	return response, nil
+	if renamed {
+		d.aliases.invalidate()
+		d.logger.Info("Rename namespace succeeded",
+			tag.WorkflowNamespace(info.Name),
+			tag.WorkflowNamespaceID(info.Id),
+			tag.WorkflowNamespaceAlias(previousName),
+		)
+	} else {
+		d.logger.Info("Update namespace succeeded",
+			tag.WorkflowNamespace(info.Name),
+			tag.WorkflowNamespaceID(info.Id),
+		)
+	}
+	return &adminservice.UpdateNamespaceResponse{
+		Response:      response,
+		Aliases:       activeAliases(info.Aliases, now),
+		DeadlineRules: &workflowspb.DeadlineRules{Rules: config.DeadlineRules},
+	}, nil
+}
+
+// renameNamespace renames the namespace info to the new name, its previous name is kept as an alias
+// for the alias retention
+func (d *HandlerImpl) renameNamespace(
+	info *persistenceblobs.NamespaceInfo,
+	requestedName string,
+	newName string,
+	aliasRetention time.Duration,
+	now time.Time,
+) error {
+
+	if info.Name == common.SystemLocalNamespace || newName == common.SystemLocalNamespace {
+		return errCannotRenameSystemNamespace
+	}
+	if info.Name != requestedName {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Namespace %v is an alias of namespace %v.", requestedName, info.Name))
+	}
+	if info.State == enumspb.NAMESPACE_STATE_DELETED {
+		return errNamespaceDeleted
+	}
+
+	existing, err := d.getNamespaceByNameOrLatestAlias(newName, true)
+	switch err.(type) {
+	case nil:
+		// the new name can only be taken by the namespace itself, as one of its aliases
+		if existing.Namespace.Info.Id != info.Id {
+			return serviceerror.NewNamespaceAlreadyExists("Namespace already exists.")
+		}
+	case *serviceerror.NotFound:
+		// new name is not taken, proceeds
+	default:
+		return err
+	}
+
+	var aliases []*namespacespb.NamespaceAlias
+	for _, alias := range activeAliases(info.Aliases, now) {
+		if alias.GetName() != newName {
+			aliases = append(aliases, alias)
+		}
+	}
+	if aliasRetention > 0 {
+		aliases = append(aliases, &namespacespb.NamespaceAlias{
+			Name:           info.Name,
+			ExpirationTime: timestamp.TimePtr(now.Add(aliasRetention)),
+		})
+	}
+	info.Name = newName
+	info.Aliases = aliases
+	return nil
 }
 
 // DeprecateNamespace deprecates a namespace
@@ -597,11 +747,15 @@ func (d *HandlerImpl) DeprecateNamespace(
 		return nil, err
 	}
 	notificationVersion := metadata.NotificationVersion
-	getResponse, err := d.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: deprecateRequest.GetName()})
+	getResponse, err := d.getNamespaceByNameOrAlias(deprecateRequest.GetName())
 	if err != nil {
 		return nil, err
 	}
 
+	if getResponse.Namespace.Info.State == enumspb.NAMESPACE_STATE_DELETED {
+		return nil, errNamespaceDeleted
+	}
+
 	getResponse.Namespace.ConfigVersion = getResponse.Namespace.ConfigVersion + 1
 	getResponse.Namespace.Info.State = enumspb.NAMESPACE_STATE_DEPRECATED
 	updateReq := &persistence.UpdateNamespaceRequest{
@@ -622,6 +776,186 @@ func (d *HandlerImpl) DeprecateNamespace(
 	return nil, nil
 }
 
+// DeleteNamespace marks a namespace as deleted, new traffic to the namespace is then rejected
+// and its resources can be removed by the namespace deleter system workflow
+func (d *HandlerImpl) DeleteNamespace(
+	ctx context.Context,
+	deleteRequest *adminservice.DeleteNamespaceRequest,
+) (*adminservice.DeleteNamespaceResponse, error) {
+
+	if deleteRequest.GetNamespace() == common.SystemLocalNamespace {
+		return nil, errCannotDeleteSystemNamespace
+	}
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 namespace table
+	// and since we do not know which table will return the namespace afterwards
+	// this call has to be made
+	metadata, err := d.metadataMgr.GetMetadata()
+	if err != nil {
+		return nil, err
+	}
+	notificationVersion := metadata.NotificationVersion
+	getResponse, err := d.getNamespaceByNameOrAlias(deleteRequest.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	// deleting a global namespace would have to be replicated to, and cleaned up in, all of its clusters
+	if getResponse.IsGlobalNamespace {
+		return nil, errCannotDeleteGlobalNamespace
+	}
+
+	info := getResponse.Namespace.Info
+	response := &adminservice.DeleteNamespaceResponse{
+		NamespaceId: info.Id,
+	}
+	if info.State == enumspb.NAMESPACE_STATE_DELETED {
+		return response, nil
+	}
+
+	info.State = enumspb.NAMESPACE_STATE_DELETED
+	updateReq := &persistence.UpdateNamespaceRequest{
+		Namespace: &persistenceblobs.NamespaceDetail{
+			Info:                        info,
+			Config:                      getResponse.Namespace.Config,
+			ReplicationConfig:           getResponse.Namespace.ReplicationConfig,
+			ConfigVersion:               getResponse.Namespace.ConfigVersion + 1,
+			FailoverVersion:             getResponse.Namespace.FailoverVersion,
+			FailoverNotificationVersion: getResponse.Namespace.FailoverNotificationVersion,
+		},
+		NotificationVersion: notificationVersion,
+	}
+	if err := d.metadataMgr.UpdateNamespace(updateReq); err != nil {
+		return nil, err
+	}
+
+	d.logger.Info("Delete namespace succeeded",
+		tag.WorkflowNamespace(info.Name),
+		tag.WorkflowNamespaceID(info.Id),
+	)
+	return response, nil
+}
+
+// DescribeNamespaceAliases returns the unexpired aliases of a namespace
+func (d *HandlerImpl) DescribeNamespaceAliases(
+	_ context.Context,
+	describeRequest *adminservice.DescribeNamespaceAliasesRequest,
+) (*adminservice.DescribeNamespaceAliasesResponse, error) {
+
+	getResponse, err := d.getNamespaceByNameOrAlias(describeRequest.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	info := getResponse.Namespace.Info
+	return &adminservice.DescribeNamespaceAliasesResponse{
+		Namespace:   info.Name,
+		NamespaceId: info.Id,
+		Aliases:     activeAliases(info.Aliases, time.Now().UTC()),
+	}, nil
+}
+
+// getNamespaceByNameOrAlias gets a namespace by its name, or by one of its unexpired aliases if no namespace has this name.
+// Aliases are resolved with the alias index, an alias added by another host may not be found until the index is refreshed.
+func (d *HandlerImpl) getNamespaceByNameOrAlias(
+	name string,
+) (*persistence.GetNamespaceResponse, error) {
+
+	return d.getNamespaceByNameOrLatestAlias(name, false)
+}
+
+// getNamespaceByNameOrLatestAlias gets a namespace by its name or by one of its unexpired aliases, refresh rebuilds
+// the alias index first, it is used before a name is taken so that the aliases added by other hosts are found.
+func (d *HandlerImpl) getNamespaceByNameOrLatestAlias(
+	name string,
+	refresh bool,
+) (*persistence.GetNamespaceResponse, error) {
+
+	getResponse, err := d.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: name})
+	if _, ok := err.(*serviceerror.NotFound); !ok {
+		return getResponse, err
+	}
+
+	id, ok, aliasErr := d.lookupAlias(name, refresh)
+	if aliasErr != nil {
+		return nil, aliasErr
+	}
+	if !ok {
+		return nil, err
+	}
+	aliasResponse, aliasErr := d.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{ID: id})
+	if aliasErr != nil {
+		if _, ok := aliasErr.(*serviceerror.NotFound); ok {
+			return nil, err
+		}
+		return nil, aliasErr
+	}
+	// the index may be stale, the alias could have expired or moved to another namespace since
+	for _, alias := range activeAliases(aliasResponse.Namespace.Info.Aliases, time.Now().UTC()) {
+		if alias.GetName() == name {
+			return aliasResponse, nil
+		}
+	}
+	return nil, err
+}
+
+// lookupAlias returns the id of the namespace with the given alias in the alias index
+func (d *HandlerImpl) lookupAlias(
+	name string,
+	refresh bool,
+) (string, bool, error) {
+
+	d.aliases.Lock()
+	defer d.aliases.Unlock()
+
+	now := time.Now().UTC()
+	if refresh || d.aliases.ids == nil || now.Sub(d.aliases.refreshTime) >= aliasIndexRefreshInterval {
+		ids := make(map[string]string)
+		listRequest := &persistence.ListNamespacesRequest{PageSize: aliasLookupPageSize}
+		for {
+			listResponse, err := d.metadataMgr.ListNamespaces(listRequest)
+			if err != nil {
+				return "", false, err
+			}
+			for _, namespace := range listResponse.Namespaces {
+				for _, alias := range activeAliases(namespace.Namespace.Info.Aliases, now) {
+					ids[alias.GetName()] = namespace.Namespace.Info.Id
+				}
+			}
+			if len(listResponse.NextPageToken) == 0 {
+				break
+			}
+			listRequest.NextPageToken = listResponse.NextPageToken
+		}
+		d.aliases.ids = ids
+		d.aliases.refreshTime = now
+	}
+
+	id, ok := d.aliases.ids[name]
+	return id, ok, nil
+}
+
+// invalidate makes the next lookup rebuild the index
+func (a *aliasIndex) invalidate() {
+	a.Lock()
+	defer a.Unlock()
+	a.ids = nil
+}
+
+func activeAliases(
+	aliases []*namespacespb.NamespaceAlias,
+	now time.Time,
+) []*namespacespb.NamespaceAlias {
+
+	var result []*namespacespb.NamespaceAlias
+	for _, alias := range aliases {
+		if timestamp.TimeValue(alias.GetExpirationTime()).After(now) {
+			result = append(result, alias)
+		}
+	}
+	return result
+}
+
 func (d *HandlerImpl) createResponse(
 	ctx context.Context,
 	info *persistenceblobs.NamespaceInfo,
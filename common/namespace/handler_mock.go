@@ -34,6 +34,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	workflowservice "go.temporal.io/api/workflowservice/v1"
+	adminservice "go.temporal.io/server/api/adminservice/v1"
 )
 
 // MockHandler is a mock of Handler interface.
@@ -59,6 +60,21 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 	return m.recorder
 }
 
+// DeleteNamespace mocks base method.
+func (m *MockHandler) DeleteNamespace(ctx context.Context, deleteRequest *adminservice.DeleteNamespaceRequest) (*adminservice.DeleteNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNamespace", ctx, deleteRequest)
+	ret0, _ := ret[0].(*adminservice.DeleteNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNamespace indicates an expected call of DeleteNamespace.
+func (mr *MockHandlerMockRecorder) DeleteNamespace(ctx, deleteRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespace", reflect.TypeOf((*MockHandler)(nil).DeleteNamespace), ctx, deleteRequest)
+}
+
 // DeprecateNamespace mocks base method.
 func (m *MockHandler) DeprecateNamespace(ctx context.Context, deprecateRequest *workflowservice.DeprecateNamespaceRequest) (*workflowservice.DeprecateNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -89,6 +105,21 @@ func (mr *MockHandlerMockRecorder) DescribeNamespace(ctx, describeRequest interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespace", reflect.TypeOf((*MockHandler)(nil).DescribeNamespace), ctx, describeRequest)
 }
 
+// DescribeNamespaceAliases mocks base method.
+func (m *MockHandler) DescribeNamespaceAliases(ctx context.Context, describeRequest *adminservice.DescribeNamespaceAliasesRequest) (*adminservice.DescribeNamespaceAliasesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNamespaceAliases", ctx, describeRequest)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespaceAliasesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaceAliases indicates an expected call of DescribeNamespaceAliases.
+func (mr *MockHandlerMockRecorder) DescribeNamespaceAliases(ctx, describeRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaceAliases", reflect.TypeOf((*MockHandler)(nil).DescribeNamespaceAliases), ctx, describeRequest)
+}
+
 // ListNamespaces mocks base method.
 func (m *MockHandler) ListNamespaces(ctx context.Context, listRequest *workflowservice.ListNamespacesRequest) (*workflowservice.ListNamespacesResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,3 +164,18 @@ func (mr *MockHandlerMockRecorder) UpdateNamespace(ctx, updateRequest interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespace", reflect.TypeOf((*MockHandler)(nil).UpdateNamespace), ctx, updateRequest)
 }
+
+// AdminUpdateNamespace mocks base method.
+func (m *MockHandler) AdminUpdateNamespace(ctx context.Context, updateRequest *adminservice.UpdateNamespaceRequest) (*adminservice.UpdateNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminUpdateNamespace", ctx, updateRequest)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdminUpdateNamespace indicates an expected call of AdminUpdateNamespace.
+func (mr *MockHandlerMockRecorder) AdminUpdateNamespace(ctx, updateRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminUpdateNamespace", reflect.TypeOf((*MockHandler)(nil).AdminUpdateNamespace), ctx, updateRequest)
+}
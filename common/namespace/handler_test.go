@@ -39,8 +39,12 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
@@ -423,6 +427,175 @@ func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_InvalidRetentionPeriod
 	s.Nil(resp)
 }
 
+func (s *namespaceHandlerCommonSuite) TestDeleteNamespace() {
+	namespace := s.getRandomNamespace()
+	registerRequest := &workflowservice.RegisterNamespaceRequest{
+		Name:                             namespace,
+		Description:                      namespace,
+		WorkflowExecutionRetentionPeriod: timestamp.DurationPtr(10 * time.Hour * 24),
+		IsGlobalNamespace:                false,
+	}
+	_, err := s.handler.RegisterNamespace(context.Background(), registerRequest)
+	s.NoError(err)
+
+	deleteResp, err := s.handler.DeleteNamespace(context.Background(), &adminservice.DeleteNamespaceRequest{
+		Namespace: namespace,
+	})
+	s.NoError(err)
+	s.NotEmpty(deleteResp.GetNamespaceId())
+
+	describeResp, err := s.handler.DescribeNamespace(context.Background(), &workflowservice.DescribeNamespaceRequest{
+		Name: namespace,
+	})
+	s.NoError(err)
+	s.Equal(deleteResp.GetNamespaceId(), describeResp.NamespaceInfo.GetId())
+	s.Equal(enumspb.NAMESPACE_STATE_DELETED, describeResp.NamespaceInfo.GetState())
+
+	// deleting again returns the same namespace, so the deletion can be resumed
+	retryResp, err := s.handler.DeleteNamespace(context.Background(), &adminservice.DeleteNamespaceRequest{
+		Namespace: namespace,
+	})
+	s.NoError(err)
+	s.Equal(deleteResp.GetNamespaceId(), retryResp.GetNamespaceId())
+
+	_, err = s.handler.DeprecateNamespace(context.Background(), &workflowservice.DeprecateNamespaceRequest{
+		Name: namespace,
+	})
+	s.Equal(errNamespaceDeleted, err)
+}
+
+func (s *namespaceHandlerCommonSuite) TestDeleteNamespace_SystemNamespace() {
+	resp, err := s.handler.DeleteNamespace(context.Background(), &adminservice.DeleteNamespaceRequest{
+		Namespace: common.SystemLocalNamespace,
+	})
+	s.Equal(errCannotDeleteSystemNamespace, err)
+	s.Nil(resp)
+}
+
+func (s *namespaceHandlerCommonSuite) TestRenameNamespace() {
+	namespace := s.getRandomNamespace()
+	newNamespace := s.getRandomNamespace()
+	registerRequest := &workflowservice.RegisterNamespaceRequest{
+		Name:                             namespace,
+		Description:                      namespace,
+		WorkflowExecutionRetentionPeriod: timestamp.DurationPtr(10 * time.Hour * 24),
+		IsGlobalNamespace:                false,
+	}
+	_, err := s.handler.RegisterNamespace(context.Background(), registerRequest)
+	s.NoError(err)
+
+	renameResp, err := s.handler.AdminUpdateNamespace(context.Background(), &adminservice.UpdateNamespaceRequest{
+		Request: &workflowservice.UpdateNamespaceRequest{
+			Name: namespace,
+			UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+				Description: "renamed",
+			},
+		},
+		NewName:        newNamespace,
+		AliasRetention: timestamp.DurationPtr(time.Hour),
+	})
+	s.NoError(err)
+	s.NotEmpty(renameResp.GetResponse().GetNamespaceInfo().GetId())
+	s.Equal(newNamespace, renameResp.GetResponse().GetNamespaceInfo().GetName())
+	s.Equal("renamed", renameResp.GetResponse().GetNamespaceInfo().GetDescription())
+	s.Len(renameResp.GetAliases(), 1)
+	s.Equal(namespace, renameResp.GetAliases()[0].GetName())
+
+	// the previous name resolves to the renamed namespace
+	describeResp, err := s.handler.DescribeNamespace(context.Background(), &workflowservice.DescribeNamespaceRequest{
+		Name: namespace,
+	})
+	s.NoError(err)
+	s.Equal(renameResp.GetResponse().GetNamespaceInfo().GetId(), describeResp.NamespaceInfo.GetId())
+	s.Equal(newNamespace, describeResp.NamespaceInfo.GetName())
+
+	aliasesResp, err := s.handler.DescribeNamespaceAliases(context.Background(), &adminservice.DescribeNamespaceAliasesRequest{
+		Namespace: namespace,
+	})
+	s.NoError(err)
+	s.Equal(newNamespace, aliasesResp.GetNamespace())
+	s.Equal(renameResp.GetAliases(), aliasesResp.GetAliases())
+
+	// the previous name cannot be registered while it is an alias
+	_, err = s.handler.RegisterNamespace(context.Background(), registerRequest)
+	s.IsType(&serviceerror.NamespaceAlreadyExists{}, err)
+
+	// renaming back turns the alias into the name again
+	renameResp, err = s.handler.AdminUpdateNamespace(context.Background(), &adminservice.UpdateNamespaceRequest{
+		Request: &workflowservice.UpdateNamespaceRequest{
+			Name: newNamespace,
+		},
+		NewName: namespace,
+	})
+	s.NoError(err)
+	s.Empty(renameResp.GetAliases())
+	_, err = s.handler.DescribeNamespace(context.Background(), &workflowservice.DescribeNamespaceRequest{
+		Name: newNamespace,
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *namespaceHandlerCommonSuite) TestRenameNamespace_SystemNamespace() {
+	resp, err := s.handler.AdminUpdateNamespace(context.Background(), &adminservice.UpdateNamespaceRequest{
+		Request: &workflowservice.UpdateNamespaceRequest{
+			Name: common.SystemLocalNamespace,
+		},
+		NewName: s.getRandomNamespace(),
+	})
+	s.Equal(errCannotRenameSystemNamespace, err)
+	s.Nil(resp)
+}
+
+func (s *namespaceHandlerCommonSuite) TestUpdateNamespaceDeadlineRules() {
+	namespace := s.getRandomNamespace()
+	registerRequest := &workflowservice.RegisterNamespaceRequest{
+		Name:                             namespace,
+		Description:                      namespace,
+		WorkflowExecutionRetentionPeriod: timestamp.DurationPtr(10 * time.Hour * 24),
+		IsGlobalNamespace:                false,
+	}
+	_, err := s.handler.RegisterNamespace(context.Background(), registerRequest)
+	s.NoError(err)
+
+	rules := &workflowspb.DeadlineRules{Rules: []*workflowspb.DeadlineRule{{
+		Name:      "approval",
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Timeout:   timestamp.DurationPtr(time.Hour),
+		Action:    enumsspb.DEADLINE_ACTION_TERMINATE,
+	}}}
+	updateResp, err := s.handler.AdminUpdateNamespace(context.Background(), &adminservice.UpdateNamespaceRequest{
+		Request:       &workflowservice.UpdateNamespaceRequest{Name: namespace},
+		DeadlineRules: rules,
+	})
+	s.NoError(err)
+	s.Equal(rules.GetRules(), updateResp.GetDeadlineRules().GetRules())
+
+	// no rules in the request leaves the rules unchanged
+	describeResp, err := s.handler.AdminUpdateNamespace(context.Background(), &adminservice.UpdateNamespaceRequest{
+		Request: &workflowservice.UpdateNamespaceRequest{Name: namespace},
+	})
+	s.NoError(err)
+	s.Equal(rules.GetRules(), describeResp.GetDeadlineRules().GetRules())
+
+	// an empty list of rules clears the rules
+	_, err = s.handler.AdminUpdateNamespace(context.Background(), &adminservice.UpdateNamespaceRequest{
+		Request:       &workflowservice.UpdateNamespaceRequest{Name: namespace},
+		DeadlineRules: &workflowspb.DeadlineRules{},
+	})
+	s.NoError(err)
+	describeResp, err = s.handler.AdminUpdateNamespace(context.Background(), &adminservice.UpdateNamespaceRequest{
+		Request: &workflowservice.UpdateNamespaceRequest{Name: namespace},
+	})
+	s.NoError(err)
+	s.Empty(describeResp.GetDeadlineRules().GetRules())
+
+	_, err = s.handler.AdminUpdateNamespace(context.Background(), &adminservice.UpdateNamespaceRequest{
+		Request:       &workflowservice.UpdateNamespaceRequest{Name: namespace},
+		DeadlineRules: &workflowspb.DeadlineRules{Rules: append(rules.GetRules(), rules.GetRules()...)},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
 func (s *namespaceHandlerCommonSuite) getRandomNamespace() string {
 	return "namespace" + uuid.New()
 }
@@ -120,6 +120,7 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceCreationReplicatio
 				Description: task.Info.GetDescription(),
 				Owner:       task.Info.GetOwnerEmail(),
 				Data:        task.Info.Data,
+				Aliases:     task.GetAliases(),
 			},
 			Config: &persistenceblobs.NamespaceConfig{
 				Retention:               task.Config.GetWorkflowExecutionRetentionTtl(),
@@ -127,6 +128,7 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceCreationReplicatio
 				HistoryArchivalUri:      task.Config.GetHistoryArchivalUri(),
 				VisibilityArchivalState: task.Config.GetVisibilityArchivalState(),
 				VisibilityArchivalUri:   task.Config.GetVisibilityArchivalUri(),
+				DeadlineRules:           task.GetDeadlineRules(),
 			},
 			ReplicationConfig: &persistenceblobs.NamespaceReplicationConfig{
 				ActiveClusterName: task.ReplicationConfig.GetActiveClusterName(),
@@ -204,8 +206,9 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 
 	// plus, we need to check whether the config version is <= the config version set in the input
 	// plus, we need to check whether the failover version is <= the failover version set in the input
+	// the namespace is looked up by id, since the task can carry a new name of a renamed namespace
 	resp, err := h.metadataManagerV2.GetNamespace(&persistence.GetNamespaceRequest{
-		Name: task.Info.GetName(),
+		ID: task.GetId(),
 	})
 	if err != nil {
 		if _, ok := err.(*serviceerror.NotFound); ok {
@@ -217,6 +220,7 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 	}
 
 	recordUpdated := false
+	previousName := resp.Namespace.Info.Name
 	request := &persistence.UpdateNamespaceRequest{
 		Namespace:           resp.Namespace,
 		NotificationVersion: notificationVersion,
@@ -231,6 +235,7 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 			Description: task.Info.GetDescription(),
 			Owner:       task.Info.GetOwnerEmail(),
 			Data:        task.Info.Data,
+			Aliases:     task.GetAliases(),
 		}
 		request.Namespace.Config = &persistenceblobs.NamespaceConfig{
 			Retention:               task.Config.GetWorkflowExecutionRetentionTtl(),
@@ -238,6 +243,7 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 			HistoryArchivalUri:      task.Config.GetHistoryArchivalUri(),
 			VisibilityArchivalState: task.Config.GetVisibilityArchivalState(),
 			VisibilityArchivalUri:   task.Config.GetVisibilityArchivalUri(),
+			DeadlineRules:           task.GetDeadlineRules(),
 		}
 		if task.Config.GetBadBinaries() != nil {
 			request.Namespace.Config.BadBinaries = task.Config.GetBadBinaries()
@@ -256,6 +262,13 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 		return nil
 	}
 
+	if request.Namespace.Info.Name != previousName {
+		return h.metadataManagerV2.RenameNamespace(&persistence.RenameNamespaceRequest{
+			PreviousName:        previousName,
+			Namespace:           request.Namespace,
+			NotificationVersion: request.NotificationVersion,
+		})
+	}
 	return h.metadataManagerV2.UpdateNamespace(request)
 }
 
@@ -37,6 +37,7 @@ import (
 	"go.uber.org/zap"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	namespacespb "go.temporal.io/server/api/namespace/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common/log/loggerimpl"
 	"go.temporal.io/server/common/persistence"
@@ -810,3 +811,63 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 	s.Equal(int64(0), resp.Namespace.FailoverNotificationVersion)
 	s.Equal(notificationVersion, resp.NotificationVersion)
 }
+
+func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_RenameNamespace() {
+	id := uuid.New()
+	name := "some random namespace test name"
+	newName := "some random new namespace test name"
+	retention := 10 * time.Hour * 24
+	clusterActive := "some random active cluster name"
+	clusters := []*replicationpb.ClusterReplicationConfig{
+		{
+			ClusterName: clusterActive,
+		},
+	}
+
+	createTask := &replicationspb.NamespaceTaskAttributes{
+		NamespaceOperation: enumsspb.NAMESPACE_OPERATION_CREATE,
+		Id:                 id,
+		Info: &namespacepb.NamespaceInfo{
+			Name:  name,
+			State: enumspb.NAMESPACE_STATE_REGISTERED,
+		},
+		Config: &namespacepb.NamespaceConfig{
+			WorkflowExecutionRetentionTtl: &retention,
+		},
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+			ActiveClusterName: clusterActive,
+			Clusters:          clusters,
+		},
+		ConfigVersion:   0,
+		FailoverVersion: 59,
+	}
+	err := s.namespaceReplicator.Execute(createTask)
+	s.Nil(err)
+
+	aliasExpirationTime := time.Now().UTC().Add(time.Hour)
+	renameTask := &replicationspb.NamespaceTaskAttributes{
+		NamespaceOperation: enumsspb.NAMESPACE_OPERATION_UPDATE,
+		Id:                 id,
+		Info: &namespacepb.NamespaceInfo{
+			Name:  newName,
+			State: enumspb.NAMESPACE_STATE_REGISTERED,
+		},
+		Config:            createTask.Config,
+		ReplicationConfig: createTask.ReplicationConfig,
+		ConfigVersion:     1,
+		FailoverVersion:   59,
+		Aliases:           []*namespacespb.NamespaceAlias{{Name: name, ExpirationTime: &aliasExpirationTime}},
+	}
+	err = s.namespaceReplicator.Execute(renameTask)
+	s.Nil(err)
+
+	resp, err := s.MetadataManager.GetNamespace(&persistence.GetNamespaceRequest{ID: id})
+	s.Nil(err)
+	s.Equal(newName, resp.Namespace.Info.Name)
+	s.Equal(int64(1), resp.Namespace.ConfigVersion)
+	s.Len(resp.Namespace.Info.Aliases, 1)
+	s.Equal(name, resp.Namespace.Info.Aliases[0].GetName())
+
+	_, err = s.MetadataManager.GetNamespace(&persistence.GetNamespaceRequest{Name: name})
+	s.IsType(&serviceerror.NotFound{}, err)
+}
@@ -96,6 +96,8 @@ func (namespaceReplicator *namespaceReplicatorImpl) HandleTransmissionTask(names
 			},
 			ConfigVersion:   configVersion,
 			FailoverVersion: failoverVersion,
+			Aliases:         info.Aliases,
+			DeadlineRules:   config.DeadlineRules,
 		},
 	}
 
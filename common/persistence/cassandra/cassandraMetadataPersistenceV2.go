@@ -55,6 +55,10 @@ const (
 	templateDeleteNamespaceQuery = `DELETE FROM namespaces_by_id ` +
 		`WHERE id = ?`
 
+	templateUpdateNamespaceNameQuery = `UPDATE namespaces_by_id ` +
+		`SET name = ? ` +
+		`WHERE id = ?`
+
 	templateNamespaceColumns = `id, name, detail, detail_encoding, notification_version, is_global_namespace`
 
 	templateCreateNamespaceByNameQueryWithinBatchV2 = `INSERT INTO namespaces ` +
@@ -222,6 +226,64 @@ func (m *cassandraMetadataPersistenceV2) UpdateNamespace(request *p.InternalUpda
 	return nil
 }
 
+// RenameNamespace renames a namespace
+// The namespaces table is keyed by name, so the row under the new name is inserted and the row under the previous
+// name is deleted within the same conditional batch. Cassandra rejects conditional batches spanning several tables,
+// so the name is then updated in the namespaces_by_id table on its own. If this update fails, the stale name is
+// repaired by the next GetNamespace by ID, which falls back to finding the namespace by its ID.
+func (m *cassandraMetadataPersistenceV2) RenameNamespace(request *p.InternalRenameNamespaceRequest) error {
+	var isGlobalNamespace bool
+	query := m.session.Query(templateGetNamespaceByNameQueryV2, constNamespacePartition, request.PreviousName)
+	if err := query.Scan(nil, nil, nil, nil, nil, &isGlobalNamespace); err != nil {
+		if err == gocql.ErrNotFound {
+			return serviceerror.NewNotFound(fmt.Sprintf("Namespace %s does not exist.", request.PreviousName))
+		}
+		return serviceerror.NewInternal(fmt.Sprintf("RenameNamespace operation failed. Error: %v", err))
+	}
+
+	batch := m.session.NewBatch(gocql.LoggedBatch)
+	batch.Query(templateCreateNamespaceByNameQueryWithinBatchV2,
+		constNamespacePartition,
+		request.Id,
+		request.Name,
+		request.Namespace.Data,
+		request.Namespace.Encoding.String(),
+		request.NotificationVersion,
+		isGlobalNamespace,
+	)
+	batch.Query(templateDeleteNamespaceByNameQueryV2,
+		constNamespacePartition,
+		request.PreviousName,
+	)
+	m.updateMetadataBatch(batch, request.NotificationVersion)
+
+	previous := make(map[string]interface{})
+	applied, iter, err := m.session.MapExecuteBatchCAS(batch, previous)
+	defer func() {
+		if iter != nil {
+			iter.Close()
+		}
+	}()
+
+	if err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("RenameNamespace operation failed. Error: %v", err))
+	}
+	if !applied {
+		if name, ok := previous["name"].(string); ok && name == request.Name {
+			return serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf("Namespace %s already exists.", request.Name))
+		}
+		return serviceerror.NewInternal(fmt.Sprintf("RenameNamespace operation failed because of conditional failure."))
+	}
+
+	query = m.session.Query(templateUpdateNamespaceNameQuery, request.Name, request.Id)
+	if err := query.Exec(); err != nil {
+		m.logger.Warn("Unable to update namespaces_by_id table, it is repaired on the next read by ID",
+			tag.WorkflowNamespaceID(request.Id), tag.Error(err))
+	}
+
+	return nil
+}
+
 func (m *cassandraMetadataPersistenceV2) GetNamespace(request *p.GetNamespaceRequest) (*p.InternalGetNamespaceResponse, error) {
 	var query *gocql.Query
 	var err error
@@ -267,6 +329,11 @@ func (m *cassandraMetadataPersistenceV2) GetNamespace(request *p.GetNamespaceReq
 		&isGlobalNamespace,
 	)
 
+	if err == gocql.ErrNotFound && len(request.ID) > 0 {
+		// the name in the namespaces_by_id table is stale if the namespace was renamed and the update of
+		// the namespaces_by_id table failed
+		return m.getNamespaceByIDFromNamespaces(request.ID)
+	}
 	if err != nil {
 		return nil, handleError(request.Name, request.ID, err)
 	}
@@ -278,6 +345,41 @@ func (m *cassandraMetadataPersistenceV2) GetNamespace(request *p.GetNamespaceReq
 	}, nil
 }
 
+// getNamespaceByIDFromNamespaces finds a namespace by its ID in the namespaces table, then repairs the name
+// in the namespaces_by_id table
+func (m *cassandraMetadataPersistenceV2) getNamespaceByIDFromNamespaces(ID string) (*p.InternalGetNamespaceResponse, error) {
+	iter := m.session.Query(templateListNamespaceQueryV2, constNamespacePartition).Iter()
+	var id string
+	var name string
+	var detail []byte
+	var detailEncoding string
+	var notificationVersion int64
+	var isGlobalNamespace bool
+	found := false
+	for iter.Scan(&id, &name, &detail, &detailEncoding, &notificationVersion, &isGlobalNamespace) {
+		if id == ID && name != namespaceMetadataRecordName {
+			found = true
+			break
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("GetNamespace operation failed. Error %v", err))
+	}
+	if !found {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("Namespace %s does not exist.", ID))
+	}
+
+	query := m.session.Query(templateUpdateNamespaceNameQuery, name, ID)
+	if err := query.Exec(); err != nil {
+		m.logger.Warn("Unable to repair namespaces_by_id table", tag.WorkflowNamespaceID(ID), tag.Error(err))
+	}
+	return &p.InternalGetNamespaceResponse{
+		Namespace:           p.NewDataBlob(detail, detailEncoding),
+		IsGlobal:            isGlobalNamespace,
+		NotificationVersion: notificationVersion,
+	}, nil
+}
+
 func (m *cassandraMetadataPersistenceV2) ListNamespaces(request *p.ListNamespacesRequest) (*p.InternalListNamespacesResponse, error) {
 	var query *gocql.Query
 
@@ -218,6 +218,17 @@ workflow_state = ? ` +
 		`and visibility_ts = ? ` +
 		`and task_id = ?`
 
+	templateCreateStartRequestIDQuery = `INSERT INTO start_request_ids (` +
+		`shard_id, namespace_id, workflow_id, request_id, run_id) ` +
+		`VALUES(?, ?, ?, ?, ?) USING TTL ?`
+
+	templateGetStartRequestIDQuery = `SELECT run_id ` +
+		`FROM start_request_ids ` +
+		`WHERE shard_id = ? ` +
+		`and namespace_id = ? ` +
+		`and workflow_id = ? ` +
+		`and request_id = ?`
+
 	templateListWorkflowExecutionQuery = `SELECT run_id, execution, execution_encoding, next_event_id ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -1854,6 +1865,48 @@ func (d *cassandraPersistence) GetCurrentExecution(request *p.GetCurrentExecutio
 	}, nil
 }
 
+func (d *cassandraPersistence) CreateStartRequestID(request *p.CreateStartRequestIDRequest) error {
+	query := d.session.Query(templateCreateStartRequestIDQuery,
+		d.shardID,
+		request.NamespaceID,
+		request.WorkflowID,
+		request.RequestID,
+		request.RunID,
+		int64(request.TTL.Seconds()))
+
+	if err := query.Exec(); err != nil {
+		if isThrottlingError(err) {
+			return serviceerror.NewResourceExhausted(fmt.Sprintf("CreateStartRequestID operation failed. Error: %v", err))
+		}
+		return serviceerror.NewInternal(fmt.Sprintf("CreateStartRequestID operation failed. Error: %v", err))
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) GetStartRequestID(request *p.GetStartRequestIDRequest) (*p.GetStartRequestIDResponse, error) {
+	query := d.session.Query(templateGetStartRequestIDQuery,
+		d.shardID,
+		request.NamespaceID,
+		request.WorkflowID,
+		request.RequestID)
+
+	var runID gocql.UUID
+	if err := query.Scan(&runID); err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, serviceerror.NewNotFound(fmt.Sprintf("Start request not found.  RequestId: %v", request.RequestID))
+		} else if isThrottlingError(err) {
+			return nil, serviceerror.NewResourceExhausted(fmt.Sprintf("GetStartRequestID operation failed. Error: %v", err))
+		}
+
+		return nil, serviceerror.NewInternal(fmt.Sprintf("GetStartRequestID operation failed. Error: %v", err))
+	}
+
+	return &p.GetStartRequestIDResponse{
+		RunID: runID.String(),
+	}, nil
+}
+
 func (d *cassandraPersistence) ListConcreteExecutions(
 	request *p.ListConcreteExecutionsRequest,
 ) (*p.InternalListConcreteExecutionsResponse, error) {
@@ -783,6 +783,7 @@ func createTimerTasks(
 	for _, task := range timerTasks {
 		eventID := int64(0)
 		attempt := int32(1)
+		stamp := int32(0)
 
 		timeoutType := enumspb.TIMEOUT_TYPE_UNSPECIFIED
 		workflowBackoffType := enumsspb.WORKFLOW_BACKOFF_TYPE_UNSPECIFIED
@@ -804,6 +805,7 @@ func createTimerTasks(
 		case *p.ActivityRetryTimerTask:
 			eventID = t.EventID
 			attempt = t.Attempt
+			stamp = t.Stamp
 
 		case *p.WorkflowBackoffTimerTask:
 			eventID = t.EventID
@@ -812,6 +814,9 @@ func createTimerTasks(
 		case *p.WorkflowTimeoutTask:
 			// noop
 
+		case *p.WorkflowDeadlineTimerTask:
+			// noop
+
 		case *p.DeleteHistoryEventTask:
 			// noop
 
@@ -835,6 +840,7 @@ func createTimerTasks(
 			EventId:             eventID,
 			TaskId:              task.GetTaskID(),
 			VisibilityTime:      &goTs,
+			Stamp:               stamp,
 		})
 
 		if err != nil {
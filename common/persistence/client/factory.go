@@ -28,6 +28,7 @@ import (
 	"sync"
 
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
@@ -147,7 +148,7 @@ func NewFactory(
 		config:                   cfg,
 		abstractDataStoreFactory: abstractDataStoreFactory,
 		metricsClient:            metricsClient,
-		logger:                   logger,
+		logger:                   logger.WithTags(tag.ComponentPersistence),
 		clusterName:              clusterName,
 	}
 	limiters := buildRatelimiters(cfg, persistenceMaxQPS)
@@ -325,7 +326,7 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	case defaultCfg.Cassandra != nil:
 		defaultDataStore.factory = cassandra.NewFactory(*defaultCfg.Cassandra, clusterName, f.logger)
 	case defaultCfg.SQL != nil:
-		defaultDataStore.factory = sql.NewFactory(*defaultCfg.SQL, clusterName, f.logger)
+		defaultDataStore.factory = sql.NewFactory(*defaultCfg.SQL, clusterName, nil, f.logger)
 	case defaultCfg.CustomDataStoreConfig != nil:
 		defaultDataStore.factory = f.abstractDataStoreFactory.NewFactory(*defaultCfg.CustomDataStoreConfig, clusterName, f.logger)
 	default:
@@ -344,7 +345,11 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	case visibilityCfg.Cassandra != nil:
 		visibilityDataStore.factory = cassandra.NewFactory(*visibilityCfg.Cassandra, clusterName, f.logger)
 	case visibilityCfg.SQL != nil:
-		visibilityDataStore.factory = sql.NewFactory(*visibilityCfg.SQL, clusterName, f.logger)
+		var validSearchAttributes dynamicconfig.MapPropertyFn
+		if f.config.VisibilityConfig != nil {
+			validSearchAttributes = f.config.VisibilityConfig.ValidSearchAttributes
+		}
+		visibilityDataStore.factory = sql.NewFactory(*visibilityCfg.SQL, clusterName, validSearchAttributes, f.logger)
 	default:
 		f.logger.Fatal("invalid config: one of cassandra or sql params must be specified")
 	}
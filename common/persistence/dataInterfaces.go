@@ -38,6 +38,7 @@ import (
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common/checksum"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives"
@@ -248,6 +249,12 @@ type (
 		// Cron
 		CronSchedule   string
 		ExecutionStats *persistenceblobs.ExecutionStats
+		// Paused stops the dispatch of the workflow and activity tasks
+		Paused bool
+		// Deadlines are the pending deadline rules of the workflow
+		Deadlines []*persistenceblobs.DeadlineInfo
+		// DeadlineRules are the deadline rules of the workflow run
+		DeadlineRules []*workflowspb.DeadlineRule
 	}
 
 	// ReplicationTaskInfoWrapper describes a replication task.
@@ -407,6 +414,7 @@ type (
 		EventID             int64
 		Version             int64
 		Attempt             int32
+		Stamp               int32
 	}
 
 	// WorkflowBackoffTimerTask to schedule first workflow task for retried workflow
@@ -418,6 +426,13 @@ type (
 		WorkflowBackoffType enumsspb.WorkflowBackoffType
 	}
 
+	// WorkflowDeadlineTimerTask to enforce the deadline rules of a workflow
+	WorkflowDeadlineTimerTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+
 	// HistoryReplicationTask is the replication task created for shipping history replication events to other clusters
 	HistoryReplicationTask struct {
 		VisibilityTimestamp time.Time
@@ -555,6 +570,27 @@ type (
 		LastWriteVersion int64
 	}
 
+	// CreateStartRequestIDRequest is used to record the run started by a start request
+	CreateStartRequestIDRequest struct {
+		NamespaceID string
+		WorkflowID  string
+		RequestID   string
+		RunID       string
+		TTL         time.Duration
+	}
+
+	// GetStartRequestIDRequest is used to retrieve the run started by a start request
+	GetStartRequestIDRequest struct {
+		NamespaceID string
+		WorkflowID  string
+		RequestID   string
+	}
+
+	// GetStartRequestIDResponse is the response to GetStartRequestID
+	GetStartRequestIDResponse struct {
+		RunID string
+	}
+
 	// UpdateWorkflowExecutionRequest is used to update a workflow execution
 	UpdateWorkflowExecutionRequest struct {
 		RangeID int64
@@ -929,6 +965,13 @@ type (
 		NotificationVersion int64
 	}
 
+	// RenameNamespaceRequest is used to rename a namespace, the namespace detail carries the new name
+	RenameNamespaceRequest struct {
+		PreviousName        string
+		Namespace           *persistenceblobs.NamespaceDetail
+		NotificationVersion int64
+	}
+
 	// DeleteNamespaceRequest is used to delete namespace entry from namespaces table
 	DeleteNamespaceRequest struct {
 		ID string
@@ -1259,6 +1302,10 @@ type (
 		DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error
 		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
 
+		// Start request ID related methods
+		CreateStartRequestID(request *CreateStartRequestIDRequest) error
+		GetStartRequestID(request *GetStartRequestIDRequest) (*GetStartRequestIDResponse, error)
+
 		// Transfer task related methods
 		GetTransferTask(request *GetTransferTaskRequest) (*GetTransferTaskResponse, error)
 		GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error)
@@ -1350,6 +1397,7 @@ type (
 		CreateNamespace(request *CreateNamespaceRequest) (*CreateNamespaceResponse, error)
 		GetNamespace(request *GetNamespaceRequest) (*GetNamespaceResponse, error)
 		UpdateNamespace(request *UpdateNamespaceRequest) error
+		RenameNamespace(request *RenameNamespaceRequest) error
 		DeleteNamespace(request *DeleteNamespaceRequest) error
 		DeleteNamespaceByName(request *DeleteNamespaceByNameRequest) error
 		ListNamespaces(request *ListNamespacesRequest) (*ListNamespacesResponse, error)
@@ -1797,6 +1845,41 @@ func (r *WorkflowBackoffTimerTask) SetVisibilityTimestamp(t time.Time) {
 	r.VisibilityTimestamp = t
 }
 
+// GetType returns the type of the deadline timer task
+func (r *WorkflowDeadlineTimerTask) GetType() enumsspb.TaskType {
+	return enumsspb.TASK_TYPE_WORKFLOW_DEADLINE_TIMER
+}
+
+// GetVersion returns the version of the deadline timer task
+func (r *WorkflowDeadlineTimerTask) GetVersion() int64 {
+	return r.Version
+}
+
+// SetVersion returns the version of the deadline timer task
+func (r *WorkflowDeadlineTimerTask) SetVersion(version int64) {
+	r.Version = version
+}
+
+// GetTaskID returns the sequence ID.
+func (r *WorkflowDeadlineTimerTask) GetTaskID() int64 {
+	return r.TaskID
+}
+
+// SetTaskID sets the sequence ID.
+func (r *WorkflowDeadlineTimerTask) SetTaskID(id int64) {
+	r.TaskID = id
+}
+
+// GetVisibilityTime gets the visibility time stamp
+func (r *WorkflowDeadlineTimerTask) GetVisibilityTimestamp() time.Time {
+	return r.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp gets the visibility time stamp
+func (r *WorkflowDeadlineTimerTask) SetVisibilityTimestamp(t time.Time) {
+	r.VisibilityTimestamp = t
+}
+
 // GetType returns the type of the timeout task.
 func (u *WorkflowTimeoutTask) GetType() enumsspb.TaskType {
 	return enumsspb.TASK_TYPE_WORKFLOW_RUN_TIMEOUT
@@ -153,6 +153,9 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		RetryNonRetryableErrorTypes:            info.RetryNonRetryableErrorTypes,
 		EventBranchToken:                       info.EventBranchToken,
 		CronSchedule:                           info.CronSchedule,
+		Paused:                                 info.Paused,
+		Deadlines:                              info.Deadlines,
+		DeadlineRules:                          info.DeadlineRules,
 		AutoResetPoints:                        info.AutoResetPoints,
 		SearchAttributes:                       info.SearchAttributes,
 		Memo:                                   info.Memo,
@@ -272,6 +275,9 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		RetryNonRetryableErrorTypes:            info.RetryNonRetryableErrorTypes,
 		EventBranchToken:                       info.EventBranchToken,
 		CronSchedule:                           info.CronSchedule,
+		Paused:                                 info.Paused,
+		Deadlines:                              info.Deadlines,
+		DeadlineRules:                          info.DeadlineRules,
 		Memo:                                   info.Memo,
 		SearchAttributes:                       info.SearchAttributes,
 
@@ -515,6 +521,18 @@ func (m *executionManagerImpl) GetCurrentExecution(
 	return m.persistence.GetCurrentExecution(request)
 }
 
+func (m *executionManagerImpl) CreateStartRequestID(
+	request *CreateStartRequestIDRequest,
+) error {
+	return m.persistence.CreateStartRequestID(request)
+}
+
+func (m *executionManagerImpl) GetStartRequestID(
+	request *GetStartRequestIDRequest,
+) (*GetStartRequestIDResponse, error) {
+	return m.persistence.GetStartRequestID(request)
+}
+
 func (m *executionManagerImpl) ListConcreteExecutions(
 	request *ListConcreteExecutionsRequest,
 ) (*ListConcreteExecutionsResponse, error) {
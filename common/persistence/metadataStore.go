@@ -99,6 +99,23 @@ func (m *metadataManagerImpl) UpdateNamespace(request *UpdateNamespaceRequest) e
 	})
 }
 
+func (m *metadataManagerImpl) RenameNamespace(request *RenameNamespaceRequest) error {
+	datablob, err := serialization.NamespaceDetailToBlob(request.Namespace)
+	if err != nil {
+		return err
+	}
+
+	return m.persistence.RenameNamespace(&InternalRenameNamespaceRequest{
+		InternalUpdateNamespaceRequest: &InternalUpdateNamespaceRequest{
+			Id:                  request.Namespace.Info.Id,
+			Name:                request.Namespace.Info.Name,
+			Namespace:           &datablob,
+			NotificationVersion: request.NotificationVersion,
+		},
+		PreviousName: request.PreviousName,
+	})
+}
+
 func (m *metadataManagerImpl) DeleteNamespace(request *DeleteNamespaceRequest) error {
 	return m.persistence.DeleteNamespace(request)
 }
@@ -1706,6 +1706,45 @@ func (s *ExecutionManagerSuite) TestGetCurrentWorkflow() {
 	s.Empty(task1, "Expected empty task identifier.")
 }
 
+// TestStartRequestID test
+func (s *ExecutionManagerSuite) TestStartRequestID() {
+	namespaceID := "8f1d1f49-1b55-4a0b-9b4e-4c0c3b4fd2a4"
+	workflowID := "start-request-id-test"
+	requestID := uuid.New()
+	runID := uuid.New()
+
+	_, err := s.ExecutionManager.GetStartRequestID(&p.GetStartRequestIDRequest{
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RequestID:   requestID,
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+
+	err = s.ExecutionManager.CreateStartRequestID(&p.CreateStartRequestIDRequest{
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RequestID:   requestID,
+		RunID:       runID,
+		TTL:         time.Hour,
+	})
+	s.NoError(err)
+
+	response, err := s.ExecutionManager.GetStartRequestID(&p.GetStartRequestIDRequest{
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RequestID:   requestID,
+	})
+	s.NoError(err)
+	s.Equal(runID, response.RunID)
+
+	_, err = s.ExecutionManager.GetStartRequestID(&p.GetStartRequestIDRequest{
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RequestID:   uuid.New(),
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
 // TestTransferTasksThroughUpdate test
 func (s *ExecutionManagerSuite) TestTransferTasksThroughUpdate() {
 	namespaceID := "b785a8ba-bd7d-4760-bb05-41b115f3e10a"
@@ -42,6 +42,7 @@ import (
 	namespacepb "go.temporal.io/api/namespace/v1"
 	"go.temporal.io/api/serviceerror"
 
+	namespacespb "go.temporal.io/server/api/namespace/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
 	"go.temporal.io/server/common/cluster"
 	p "go.temporal.io/server/common/persistence"
@@ -888,6 +889,96 @@ func (m *MetadataPersistenceSuiteV2) TestDeleteNamespace() {
 }
 
 // TestListNamespaces test
+func (m *MetadataPersistenceSuiteV2) TestRenameNamespace() {
+	id := uuid.New()
+	name := "rename-namespace-test-name"
+	newName := "rename-namespace-test-new-name"
+	clusterActive := "some random active cluster name"
+
+	resp1, err1 := m.CreateNamespace(
+		&persistenceblobs.NamespaceInfo{
+			Id:          id,
+			Name:        name,
+			State:       enumspb.NAMESPACE_STATE_REGISTERED,
+			Description: "rename-namespace-test-description",
+			Owner:       "rename-namespace-test-owner",
+			Data:        map[string]string{"k1": "v1"},
+		},
+		&persistenceblobs.NamespaceConfig{
+			Retention:               timestamp.DurationFromDays(10),
+			HistoryArchivalState:    enumspb.ARCHIVAL_STATE_DISABLED,
+			VisibilityArchivalState: enumspb.ARCHIVAL_STATE_DISABLED,
+		},
+		&persistenceblobs.NamespaceReplicationConfig{
+			ActiveClusterName: clusterActive,
+			Clusters:          []string{clusterActive},
+		},
+		true,
+		1,
+		0,
+	)
+	m.NoError(err1)
+	m.EqualValues(id, resp1.ID)
+
+	resp2, err2 := m.GetNamespace(id, "")
+	m.NoError(err2)
+	metadata, err := m.MetadataManager.GetMetadata()
+	m.NoError(err)
+
+	expirationTime := time.Now().UTC().Add(time.Hour)
+	detail := resp2.Namespace
+	detail.Info.Name = newName
+	detail.Info.Aliases = []*namespacespb.NamespaceAlias{{Name: name, ExpirationTime: &expirationTime}}
+	detail.ConfigVersion++
+	err3 := m.MetadataManager.RenameNamespace(&p.RenameNamespaceRequest{
+		PreviousName:        name,
+		Namespace:           detail,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	m.NoError(err3)
+
+	resp4, err4 := m.GetNamespace("", newName)
+	m.NoError(err4)
+	m.Equal(id, resp4.Namespace.Info.Id)
+	m.Equal(newName, resp4.Namespace.Info.Name)
+	m.True(resp4.IsGlobalNamespace)
+	m.Equal(int64(2), resp4.Namespace.ConfigVersion)
+	m.Len(resp4.Namespace.Info.Aliases, 1)
+	m.Equal(name, resp4.Namespace.Info.Aliases[0].GetName())
+
+	resp5, err5 := m.GetNamespace(id, "")
+	m.NoError(err5)
+	m.Equal(newName, resp5.Namespace.Info.Name)
+
+	_, err6 := m.GetNamespace("", name)
+	m.IsType(&serviceerror.NotFound{}, err6)
+
+	// the name of another namespace cannot be taken
+	otherID := uuid.New()
+	_, err7 := m.CreateNamespace(
+		&persistenceblobs.NamespaceInfo{Id: otherID, Name: name, State: enumspb.NAMESPACE_STATE_REGISTERED},
+		&persistenceblobs.NamespaceConfig{Retention: timestamp.DurationFromDays(1)},
+		&persistenceblobs.NamespaceReplicationConfig{ActiveClusterName: clusterActive, Clusters: []string{clusterActive}},
+		false,
+		0,
+		0,
+	)
+	m.NoError(err7)
+	metadata, err = m.MetadataManager.GetMetadata()
+	m.NoError(err)
+	resp4.Namespace.Info.Name = name
+	err8 := m.MetadataManager.RenameNamespace(&p.RenameNamespaceRequest{
+		PreviousName:        newName,
+		Namespace:           resp4.Namespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	m.IsType(&serviceerror.NamespaceAlreadyExists{}, err8)
+
+	resp9, err9 := m.GetNamespace(id, "")
+	m.NoError(err9)
+	m.Equal(newName, resp9.Namespace.Info.Name)
+}
+
 func (m *MetadataPersistenceSuiteV2) TestListNamespaces() {
 	clusterActive1 := "some random active cluster name"
 	clusterStandby1 := "some random standby cluster name"
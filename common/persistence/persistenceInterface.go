@@ -56,6 +56,7 @@ type (
 		CreateNamespace(request *InternalCreateNamespaceRequest) (*CreateNamespaceResponse, error)
 		GetNamespace(request *GetNamespaceRequest) (*InternalGetNamespaceResponse, error)
 		UpdateNamespace(request *InternalUpdateNamespaceRequest) error
+		RenameNamespace(request *InternalRenameNamespaceRequest) error
 		DeleteNamespace(request *DeleteNamespaceRequest) error
 		DeleteNamespaceByName(request *DeleteNamespaceByNameRequest) error
 		ListNamespaces(request *ListNamespacesRequest) (*InternalListNamespacesResponse, error)
@@ -93,6 +94,10 @@ type (
 		DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error
 		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
 
+		// Start request ID related methods
+		CreateStartRequestID(request *CreateStartRequestIDRequest) error
+		GetStartRequestID(request *GetStartRequestIDRequest) (*GetStartRequestIDResponse, error)
+
 		// Transfer task related methods
 		GetTransferTask(request *GetTransferTaskRequest) (*GetTransferTaskResponse, error)
 		GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error)
@@ -529,6 +534,12 @@ type (
 		NotificationVersion int64
 	}
 
+	// InternalRenameNamespaceRequest is used to rename namespace
+	InternalRenameNamespaceRequest struct {
+		*InternalUpdateNamespaceRequest
+		PreviousName string
+	}
+
 	// InternalListNamespacesResponse is the response for GetNamespace
 	InternalListNamespacesResponse struct {
 		Namespaces    []*InternalGetNamespaceResponse
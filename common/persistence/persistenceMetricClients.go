@@ -351,6 +351,34 @@ func (p *workflowExecutionPersistenceClient) GetCurrentExecution(request *GetCur
 	return response, err
 }
 
+func (p *workflowExecutionPersistenceClient) CreateStartRequestID(request *CreateStartRequestIDRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceCreateStartRequestIDScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceCreateStartRequestIDScope, metrics.PersistenceLatency)
+	err := p.persistence.CreateStartRequestID(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceCreateStartRequestIDScope, err)
+	}
+
+	return err
+}
+
+func (p *workflowExecutionPersistenceClient) GetStartRequestID(request *GetStartRequestIDRequest) (*GetStartRequestIDResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetStartRequestIDScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetStartRequestIDScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetStartRequestID(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetStartRequestIDScope, err)
+	}
+
+	return response, err
+}
+
 func (p *workflowExecutionPersistenceClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListConcreteExecutionsScope, metrics.PersistenceRequests)
 
@@ -828,6 +856,20 @@ func (p *metadataPersistenceClient) DeleteNamespaceByName(request *DeleteNamespa
 	return err
 }
 
+func (p *metadataPersistenceClient) RenameNamespace(request *RenameNamespaceRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceRenameNamespaceScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceRenameNamespaceScope, metrics.PersistenceLatency)
+	err := p.persistence.RenameNamespace(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceRenameNamespaceScope, err)
+	}
+
+	return err
+}
+
 func (p *metadataPersistenceClient) ListNamespaces(request *ListNamespacesRequest) (*ListNamespacesResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListNamespaceScope, metrics.PersistenceRequests)
 
@@ -284,6 +284,24 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetCurrentExecution(requ
 	return response, err
 }
 
+func (p *workflowExecutionRateLimitedPersistenceClient) CreateStartRequestID(request *CreateStartRequestIDRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.CreateStartRequestID(request)
+	return err
+}
+
+func (p *workflowExecutionRateLimitedPersistenceClient) GetStartRequestID(request *GetStartRequestIDRequest) (*GetStartRequestIDResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.GetStartRequestID(request)
+	return response, err
+}
+
 func (p *workflowExecutionRateLimitedPersistenceClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return nil, ErrPersistenceLimitExceeded
@@ -568,6 +586,15 @@ func (p *metadataRateLimitedPersistenceClient) DeleteNamespaceByName(request *De
 	return err
 }
 
+func (p *metadataRateLimitedPersistenceClient) RenameNamespace(request *RenameNamespaceRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.RenameNamespace(request)
+	return err
+}
+
 func (p *metadataRateLimitedPersistenceClient) ListNamespaces(request *ListNamespacesRequest) (*ListNamespacesResponse, error) {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return nil, ErrPersistenceLimitExceeded
@@ -65,6 +65,9 @@ func WorkflowExecutionToProto(executionInfo *WorkflowExecutionInfo, startVersion
 		StickyScheduleToStartTimeout:      executionInfo.StickyScheduleToStartTimeout,
 		SignalCount:                       executionInfo.SignalCount,
 		CronSchedule:                      executionInfo.CronSchedule,
+		Paused:                            executionInfo.Paused,
+		Deadlines:                         executionInfo.Deadlines,
+		DeadlineRules:                     executionInfo.DeadlineRules,
 		CompletionEventBatchId:            executionInfo.CompletionEventBatchId,
 		HasRetryPolicy:                    executionInfo.HasRetryPolicy,
 		Attempt:                           executionInfo.Attempt,
@@ -162,6 +165,9 @@ func WorkflowExecutionFromProto(info *persistenceblobs.WorkflowExecutionInfo, st
 		SignalCount:                            info.GetSignalCount(),
 		ExecutionStats:                         info.GetExecutionStats(),
 		CronSchedule:                           info.GetCronSchedule(),
+		Paused:                                 info.GetPaused(),
+		Deadlines:                              info.GetDeadlines(),
+		DeadlineRules:                          info.GetDeadlineRules(),
 		CompletionEventBatchId:                 info.GetCompletionEventBatchId(),
 		HasRetryPolicy:                         info.GetHasRetryPolicy(),
 		Attempt:                                info.GetAttempt(),
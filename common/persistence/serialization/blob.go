@@ -59,6 +59,13 @@ func decodeErr(encoding enumspb.EncodingType, err error) error {
 
 func proto3Encode(m proto.Marshaler) (DataBlob, error) {
 	blob := DataBlob{Encoding: enumspb.ENCODING_TYPE_PROTO3}
+	if msg, ok := m.(proto.Message); ok {
+		encoded, err := EncodePayloads(msg)
+		if err != nil {
+			return blob, encodeErr(enumspb.ENCODING_TYPE_PROTO3, err)
+		}
+		m = encoded.(proto.Marshaler)
+	}
 	data, err := m.Marshal()
 	if err != nil {
 		return blob, encodeErr(enumspb.ENCODING_TYPE_PROTO3, err)
@@ -67,11 +74,17 @@ func proto3Encode(m proto.Marshaler) (DataBlob, error) {
 	return blob, nil
 }
 
-func proto3Decode(b []byte, proto string, result proto.Unmarshaler) error {
-	if err := validateProtoEncoding(proto, enumspb.ENCODING_TYPE_PROTO3); err != nil {
+func proto3Decode(b []byte, encoding string, result proto.Unmarshaler) error {
+	if err := validateProtoEncoding(encoding, enumspb.ENCODING_TYPE_PROTO3); err != nil {
 		return err
 	}
-	return decodeErr(enumspb.ENCODING_TYPE_PROTO3, result.Unmarshal(b))
+	if err := result.Unmarshal(b); err != nil {
+		return decodeErr(enumspb.ENCODING_TYPE_PROTO3, err)
+	}
+	if msg, ok := result.(proto.Message); ok {
+		return decodeErr(enumspb.ENCODING_TYPE_PROTO3, DecodePayloads(msg))
+	}
+	return nil
 }
 
 func ShardInfoToBlob(info *persistenceblobs.ShardInfo) (DataBlob, error) {
@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+)
+
+type (
+	// LocalFileKeys is the content of the key file read by the local file key provider
+	LocalFileKeys struct {
+		// CurrentKeyID is the id of the key used to wrap new data keys
+		CurrentKeyID string `yaml:"currentKeyId"`
+		// Keys maps key ids to base64 encoded 32 byte AES keys
+		Keys map[string]string `yaml:"keys"`
+	}
+
+	localFileKeyProvider struct {
+		currentKeyID string
+		keys         map[string][]byte
+	}
+)
+
+var _ KeyProvider = (*localFileKeyProvider)(nil)
+
+// NewLocalFileKeyProvider creates a key provider reading the key encryption keys from a local
+// yaml file, e.g.
+//
+//	currentKeyId: key-2
+//	keys:
+//	  key-1: <base64 encoded 32 byte key>
+//	  key-2: <base64 encoded 32 byte key>
+//
+// Keys are only read once, at creation. This provider keeps the key encryption keys next to the
+// server and is meant for testing and development, not for protecting production data.
+func NewLocalFileKeyProvider(keyFile string) (KeyProvider, error) {
+	content, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read key file %v: %v", keyFile, err)
+	}
+	var keys LocalFileKeys
+	if err := yaml.Unmarshal(content, &keys); err != nil {
+		return nil, fmt.Errorf("unable to parse key file %v: %v", keyFile, err)
+	}
+	return NewLocalKeyProvider(keys)
+}
+
+// NewLocalKeyProvider creates a key provider from in memory keys.
+func NewLocalKeyProvider(keys LocalFileKeys) (KeyProvider, error) {
+	provider := &localFileKeyProvider{
+		currentKeyID: keys.CurrentKeyID,
+		keys:         make(map[string][]byte, len(keys.Keys)),
+	}
+	for id, encoded := range keys.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode key %v: %v", id, err)
+		}
+		if len(key) != dataKeySize {
+			return nil, fmt.Errorf("key %v must be %v bytes, got %v", id, dataKeySize, len(key))
+		}
+		provider.keys[id] = key
+	}
+	if _, ok := provider.keys[provider.currentKeyID]; !ok {
+		return nil, fmt.Errorf("current key %q not found", provider.currentKeyID)
+	}
+	return provider, nil
+}
+
+func (p *localFileKeyProvider) CurrentKeyID() (string, error) {
+	return p.currentKeyID, nil
+}
+
+func (p *localFileKeyProvider) WrapKey(keyID string, dataKey []byte) ([]byte, error) {
+	aead, err := p.getCipher(keyID)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("unable to generate nonce: %v", err)
+	}
+	return aead.Seal(nonce, nonce, dataKey, []byte(keyID)), nil
+}
+
+func (p *localFileKeyProvider) UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	aead, err := p.getCipher(keyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, fmt.Errorf("wrapped key is too short")
+	}
+	dataKey, err := aead.Open(nil, wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():], []byte(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("unable to unwrap data key with key %v: %v", keyID, err)
+	}
+	return dataKey, nil
+}
+
+func (p *localFileKeyProvider) getCipher(keyID string) (cipher.AEAD, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found", keyID)
+	}
+	return newAESGCM(key)
+}
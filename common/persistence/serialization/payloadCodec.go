@@ -0,0 +1,202 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gogo/protobuf/proto"
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+type (
+	// PayloadCodec transforms the payloads of the messages written to and read from persistence,
+	// e.g. to encrypt them at rest. Decode must pass through payloads which were not produced by
+	// Encode, so that a codec can be enabled on a cluster with existing data.
+	PayloadCodec interface {
+		Encode(payload *commonpb.Payload) (*commonpb.Payload, error)
+		Decode(payload *commonpb.Payload) (*commonpb.Payload, error)
+	}
+
+	payloadCodecHolder struct {
+		codec PayloadCodec
+	}
+)
+
+var (
+	payloadCodec atomic.Value
+
+	payloadPtrType = reflect.TypeOf((*commonpb.Payload)(nil))
+	payloadType    = payloadPtrType.Elem()
+
+	// containsPayloadCache caches, per type, whether a value of the type can reference a payload
+	containsPayloadCache sync.Map
+)
+
+// SetPayloadCodec sets the codec applied to every payload on the persistence serialization path.
+// It is process wide and is expected to be set once at startup, before any persistence call.
+// A nil codec disables payload encoding.
+func SetPayloadCodec(codec PayloadCodec) {
+	payloadCodec.Store(payloadCodecHolder{codec: codec})
+}
+
+func getPayloadCodec() PayloadCodec {
+	holder, ok := payloadCodec.Load().(payloadCodecHolder)
+	if !ok {
+		return nil
+	}
+	return holder.codec
+}
+
+// EncodePayloads returns the message with all of its payloads encoded by the payload codec.
+// The given message is never modified, a copy is returned if it contains any payload.
+func EncodePayloads(m proto.Message) (proto.Message, error) {
+	codec := getPayloadCodec()
+	if codec == nil || m == nil || !containsPayload(reflect.TypeOf(m)) {
+		return m, nil
+	}
+	encoded := proto.Clone(m)
+	if err := transformPayloads(reflect.ValueOf(encoded), codec.Encode); err != nil {
+		return nil, err
+	}
+	return encoded, nil
+}
+
+// DecodePayloads decodes, in place, all payloads of the message with the payload codec.
+func DecodePayloads(m proto.Message) error {
+	codec := getPayloadCodec()
+	if codec == nil || m == nil {
+		return nil
+	}
+	return transformPayloads(reflect.ValueOf(m), codec.Decode)
+}
+
+// VisitPayloads calls fn on every payload of the message, it stops at the first error
+func VisitPayloads(m proto.Message, fn func(*commonpb.Payload) error) error {
+	if m == nil {
+		return nil
+	}
+	return transformPayloads(reflect.ValueOf(m), func(payload *commonpb.Payload) (*commonpb.Payload, error) {
+		return payload, fn(payload)
+	})
+}
+
+func transformPayloads(
+	v reflect.Value,
+	fn func(*commonpb.Payload) (*commonpb.Payload, error),
+) error {
+	if !containsPayload(v.Type()) {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return transformPayloads(v.Elem(), fn)
+	case reflect.Struct:
+		if v.Type() == payloadType {
+			if !v.CanAddr() {
+				return nil
+			}
+			payload := v.Addr().Interface().(*commonpb.Payload)
+			result, err := fn(payload)
+			if err != nil {
+				return err
+			}
+			if result != payload {
+				*payload = *result
+			}
+			return nil
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			if err := transformPayloads(v.Field(i), fn); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := transformPayloads(v.Index(i), fn); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			// map values are not addressable, transform a copy and put it back
+			value := reflect.New(v.Type().Elem()).Elem()
+			value.Set(iter.Value())
+			if err := transformPayloads(value, fn); err != nil {
+				return err
+			}
+			v.SetMapIndex(iter.Key(), value)
+		}
+	}
+	return nil
+}
+
+func containsPayload(t reflect.Type) bool {
+	return containsPayloadVisiting(t, make(map[reflect.Type]struct{}))
+}
+
+func containsPayloadVisiting(t reflect.Type, visiting map[reflect.Type]struct{}) bool {
+	if cached, ok := containsPayloadCache.Load(t); ok {
+		return cached.(bool)
+	}
+	if _, ok := visiting[t]; ok {
+		// recursive type, be conservative so that the cached results stay correct
+		return true
+	}
+	visiting[t] = struct{}{}
+	defer delete(visiting, t)
+
+	result := false
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		result = containsPayloadVisiting(t.Elem(), visiting)
+	case reflect.Interface:
+		// dynamic type is unknown, e.g. a oneof field
+		result = true
+	case reflect.Struct:
+		if t == payloadType {
+			result = true
+			break
+		}
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).PkgPath == "" && containsPayloadVisiting(t.Field(i).Type, visiting) {
+				result = true
+				break
+			}
+		}
+	}
+	containsPayloadCache.Store(t, result)
+	return result
+}
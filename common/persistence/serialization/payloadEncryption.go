@@ -0,0 +1,254 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"sync"
+
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+const (
+	// MetadataEncryptionCipher is the payload metadata key marking a payload encrypted by the server
+	MetadataEncryptionCipher = "server-encryption-cipher"
+	// MetadataEncryptionKeyID is the payload metadata key of the id of the key wrapping the data key
+	MetadataEncryptionKeyID = "server-encryption-key-id"
+	// MetadataEncryptionDataKey is the payload metadata key of the wrapped data key
+	MetadataEncryptionDataKey = "server-encryption-data-key"
+
+	encryptionCipherAES256GCM = "aes-256-gcm"
+
+	dataKeySize = 32
+	// a data key is rotated well before the number of random nonces used with it becomes a concern
+	dataKeyMaxUses = 1 << 24
+	// upper bound of unwrapped data keys kept in memory for decoding
+	dataKeyCacheSize = 1024
+)
+
+type (
+	// KeyProvider manages the key encryption keys used to wrap the data keys of the envelope
+	// encryption codec. Keys are never removed from a provider while data encrypted with them
+	// may still exist; rotation is done by adding a new key and making it the current one.
+	KeyProvider interface {
+		// CurrentKeyID returns the id of the key used to wrap new data keys
+		CurrentKeyID() (string, error)
+		// WrapKey encrypts the data key with the key encryption key of the given id
+		WrapKey(keyID string, dataKey []byte) ([]byte, error)
+		// UnwrapKey decrypts the data key with the key encryption key of the given id
+		UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error)
+	}
+
+	envelopeEncryptionCodec struct {
+		keyProvider KeyProvider
+
+		sync.Mutex
+		current     *dataKey
+		decodeCache map[string]cipher.AEAD
+	}
+
+	dataKey struct {
+		keyID   string
+		wrapped []byte
+		aead    cipher.AEAD
+		uses    int
+	}
+)
+
+var _ PayloadCodec = (*envelopeEncryptionCodec)(nil)
+
+// IsReservedMetadataKey returns true for the payload metadata keys set by the envelope encryption codec,
+// payloads received from clients must not carry them
+func IsReservedMetadataKey(key string) bool {
+	switch key {
+	case MetadataEncryptionCipher, MetadataEncryptionKeyID, MetadataEncryptionDataKey:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewEnvelopeEncryptionCodec creates a payload codec which encrypts payload data with AES-256-GCM.
+// Payloads are encrypted with a random data key, which is wrapped by the current key of the key
+// provider and stored, together with the key id, in the payload metadata. A new data key is
+// generated whenever the current key of the provider changes.
+func NewEnvelopeEncryptionCodec(keyProvider KeyProvider) PayloadCodec {
+	return &envelopeEncryptionCodec{
+		keyProvider: keyProvider,
+		decodeCache: make(map[string]cipher.AEAD),
+	}
+}
+
+func (c *envelopeEncryptionCodec) Encode(payload *commonpb.Payload) (*commonpb.Payload, error) {
+	if payload == nil {
+		return nil, nil
+	}
+	if _, ok := payload.GetMetadata()[MetadataEncryptionCipher]; ok {
+		return payload, nil
+	}
+
+	key, err := c.getDataKey()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, key.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("unable to generate nonce: %v", err)
+	}
+
+	metadata := make(map[string][]byte, len(payload.GetMetadata())+3)
+	for k, v := range payload.GetMetadata() {
+		metadata[k] = v
+	}
+	metadata[MetadataEncryptionCipher] = []byte(encryptionCipherAES256GCM)
+	metadata[MetadataEncryptionKeyID] = []byte(key.keyID)
+	metadata[MetadataEncryptionDataKey] = key.wrapped
+
+	return &commonpb.Payload{
+		Metadata: metadata,
+		Data:     key.aead.Seal(nonce, nonce, payload.GetData(), nil),
+	}, nil
+}
+
+func (c *envelopeEncryptionCodec) Decode(payload *commonpb.Payload) (*commonpb.Payload, error) {
+	if payload == nil {
+		return nil, nil
+	}
+	encryptionCipher, ok := payload.GetMetadata()[MetadataEncryptionCipher]
+	if !ok {
+		return payload, nil
+	}
+	if string(encryptionCipher) != encryptionCipherAES256GCM {
+		return nil, fmt.Errorf("unsupported payload encryption cipher: %v", string(encryptionCipher))
+	}
+
+	aead, err := c.getDecodeCipher(
+		string(payload.GetMetadata()[MetadataEncryptionKeyID]),
+		payload.GetMetadata()[MetadataEncryptionDataKey],
+	)
+	if err != nil {
+		return nil, err
+	}
+	data := payload.GetData()
+	if len(data) < aead.NonceSize() {
+		return nil, fmt.Errorf("encrypted payload is too short")
+	}
+	plaintext, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt payload: %v", err)
+	}
+
+	metadata := make(map[string][]byte, len(payload.GetMetadata()))
+	for k, v := range payload.GetMetadata() {
+		if !IsReservedMetadataKey(k) {
+			metadata[k] = v
+		}
+	}
+	return &commonpb.Payload{
+		Metadata: metadata,
+		Data:     plaintext,
+	}, nil
+}
+
+func (c *envelopeEncryptionCodec) getDataKey() (*dataKey, error) {
+	keyID, err := c.keyProvider.CurrentKeyID()
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.current != nil && c.current.keyID == keyID && c.current.uses < dataKeyMaxUses {
+		c.current.uses++
+		return c.current, nil
+	}
+
+	plaintext := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, plaintext); err != nil {
+		return nil, fmt.Errorf("unable to generate data key: %v", err)
+	}
+	wrapped, err := c.keyProvider.WrapKey(keyID, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAESGCM(plaintext)
+	if err != nil {
+		return nil, err
+	}
+	c.current = &dataKey{
+		keyID:   keyID,
+		wrapped: wrapped,
+		aead:    aead,
+		uses:    1,
+	}
+	c.cacheDecodeCipherLocked(keyID, wrapped, aead)
+	return c.current, nil
+}
+
+func (c *envelopeEncryptionCodec) getDecodeCipher(keyID string, wrapped []byte) (cipher.AEAD, error) {
+	cacheKey := keyID + "/" + string(wrapped)
+
+	c.Lock()
+	aead, ok := c.decodeCache[cacheKey]
+	c.Unlock()
+	if ok {
+		return aead, nil
+	}
+
+	plaintext, err := c.keyProvider.UnwrapKey(keyID, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	aead, err = newAESGCM(plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	c.cacheDecodeCipherLocked(keyID, wrapped, aead)
+	return aead, nil
+}
+
+func (c *envelopeEncryptionCodec) cacheDecodeCipherLocked(keyID string, wrapped []byte, aead cipher.AEAD) {
+	if len(c.decodeCache) >= dataKeyCacheSize {
+		// data keys are rotated rarely, simply start over instead of tracking usage
+		c.decodeCache = make(map[string]cipher.AEAD)
+	}
+	c.decodeCache[keyID+"/"+string(wrapped)] = aead
+}
+
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,197 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common/payloads"
+)
+
+type (
+	payloadEncryptionSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+const (
+	testKey1 = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	testKey2 = "ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="
+)
+
+func TestPayloadEncryptionSuite(t *testing.T) {
+	suite.Run(t, new(payloadEncryptionSuite))
+}
+
+func (s *payloadEncryptionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *payloadEncryptionSuite) TearDownTest() {
+	SetPayloadCodec(nil)
+}
+
+func (s *payloadEncryptionSuite) TestBlobRoundTrip() {
+	s.setCodec("key-1", map[string]string{"key-1": testKey1})
+
+	info := &persistenceblobs.ActivityInfo{
+		ActivityId:           "activity-id",
+		LastHeartbeatDetails: payloads.EncodeString("secret heartbeat"),
+	}
+	blob, err := ActivityInfoToBlob(info)
+	s.NoError(err)
+	s.False(bytes.Contains(blob.Data, []byte("secret heartbeat")))
+	s.True(bytes.Contains(blob.Data, []byte("activity-id")))
+	// caller's message must not be modified
+	s.Equal(payloads.EncodeString("secret heartbeat"), info.LastHeartbeatDetails)
+
+	result, err := ActivityInfoFromBlob(blob.Data, enumspb.ENCODING_TYPE_PROTO3.String())
+	s.NoError(err)
+	s.Equal(info, result)
+}
+
+func (s *payloadEncryptionSuite) TestHistoryEventRoundTrip() {
+	s.setCodec("key-1", map[string]string{"key-1": testKey1})
+
+	history := &historypb.History{
+		Events: []*historypb.HistoryEvent{{
+			EventId:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+				WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+					SignalName: "signal",
+					Input:      payloads.EncodeString("secret signal"),
+				},
+			},
+		}},
+	}
+	encoded, err := EncodePayloads(history)
+	s.NoError(err)
+	data, err := encoded.(*historypb.History).Marshal()
+	s.NoError(err)
+	s.False(bytes.Contains(data, []byte("secret signal")))
+
+	result := &historypb.History{}
+	s.NoError(result.Unmarshal(data))
+	s.NoError(DecodePayloads(result))
+	s.Equal(history, result)
+}
+
+func (s *payloadEncryptionSuite) TestKeyRotation() {
+	s.setCodec("key-1", map[string]string{"key-1": testKey1})
+	before := s.encode("before rotation")
+	s.Equal("key-1", string(before.Metadata[MetadataEncryptionKeyID]))
+
+	s.setCodec("key-2", map[string]string{"key-1": testKey1, "key-2": testKey2})
+	after := s.encode("after rotation")
+	s.Equal("key-2", string(after.Metadata[MetadataEncryptionKeyID]))
+
+	s.Equal("before rotation", s.decode(before))
+	s.Equal("after rotation", s.decode(after))
+
+	// a key removed from the provider can no longer decrypt its data
+	s.setCodec("key-2", map[string]string{"key-2": testKey2})
+	_, err := getPayloadCodec().Decode(before)
+	s.Error(err)
+}
+
+func (s *payloadEncryptionSuite) TestUnencryptedPassthrough() {
+	info := &persistenceblobs.ActivityInfo{
+		ActivityId:           "activity-id",
+		LastHeartbeatDetails: payloads.EncodeString("plain heartbeat"),
+	}
+	blob, err := ActivityInfoToBlob(info)
+	s.NoError(err)
+
+	s.setCodec("key-1", map[string]string{"key-1": testKey1})
+	result, err := ActivityInfoFromBlob(blob.Data, enumspb.ENCODING_TYPE_PROTO3.String())
+	s.NoError(err)
+	s.Equal(info, result)
+}
+
+func (s *payloadEncryptionSuite) TestLocalFileKeyProvider() {
+	file, err := ioutil.TempFile("", "keys*.yaml")
+	s.NoError(err)
+	defer os.Remove(file.Name())
+	_, err = file.WriteString("currentKeyId: key-2\nkeys:\n  key-1: " + testKey1 + "\n  key-2: " + testKey2 + "\n")
+	s.NoError(err)
+	s.NoError(file.Close())
+
+	provider, err := NewLocalFileKeyProvider(file.Name())
+	s.NoError(err)
+	keyID, err := provider.CurrentKeyID()
+	s.NoError(err)
+	s.Equal("key-2", keyID)
+
+	dataKey := []byte("0123456789abcdef0123456789abcdef")
+	wrapped, err := provider.WrapKey("key-1", dataKey)
+	s.NoError(err)
+	_, err = provider.UnwrapKey("key-2", wrapped)
+	s.Error(err)
+	unwrapped, err := provider.UnwrapKey("key-1", wrapped)
+	s.NoError(err)
+	s.Equal(dataKey, unwrapped)
+
+	_, err = NewLocalKeyProvider(LocalFileKeys{
+		CurrentKeyID: "key-1",
+		Keys:         map[string]string{"key-1": base64.StdEncoding.EncodeToString([]byte("short"))},
+	})
+	s.Error(err)
+}
+
+func (s *payloadEncryptionSuite) setCodec(currentKeyID string, keys map[string]string) {
+	provider, err := NewLocalKeyProvider(LocalFileKeys{CurrentKeyID: currentKeyID, Keys: keys})
+	s.NoError(err)
+	SetPayloadCodec(NewEnvelopeEncryptionCodec(provider))
+}
+
+func (s *payloadEncryptionSuite) encode(data string) *commonpb.Payload {
+	payload, err := getPayloadCodec().Encode(&commonpb.Payload{
+		Metadata: map[string][]byte{"encoding": []byte("binary/plain")},
+		Data:     []byte(data),
+	})
+	s.NoError(err)
+	s.NotEqual([]byte(data), payload.Data)
+	return payload
+}
+
+func (s *payloadEncryptionSuite) decode(payload *commonpb.Payload) string {
+	result, err := getPayloadCodec().Decode(payload)
+	s.NoError(err)
+	s.Equal(map[string][]byte{"encoding": []byte("binary/plain")}, result.Metadata)
+	return string(result.Data)
+}
@@ -112,7 +112,7 @@ func (t *serializerImpl) DeserializeBatchEvents(data *serialization.DataBlob) ([
 	switch data.Encoding {
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Client API currently specifies encodingType on requests which span multiple of these objects
-		err = events.Unmarshal(data.Data)
+		err = t.deserialize(data.Data, events)
 	default:
 		return nil, NewDeserializationError("DeserializeBatchEvents invalid encoding")
 	}
@@ -142,7 +142,7 @@ func (t *serializerImpl) DeserializeEvent(data *serialization.DataBlob) (*histor
 	switch data.Encoding {
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Client API currently specifies encodingType on requests which span multiple of these objects
-		err = event.Unmarshal(data.Data)
+		err = t.deserialize(data.Data, event)
 	default:
 		return nil, NewDeserializationError("DeserializeEvent invalid encoding")
 	}
@@ -175,7 +175,7 @@ func (t *serializerImpl) DeserializeResetPoints(data *serialization.DataBlob) (*
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Thrift == Proto for this object so that we can maintain test behavior until thrift is gone
 		// Client API currently specifies encodingType on requests which span multiple of these objects
-		err = memo.Unmarshal(data.Data)
+		err = t.deserialize(data.Data, memo)
 	default:
 		return nil, NewDeserializationError("DeserializeResetPoints invalid encoding")
 	}
@@ -208,7 +208,7 @@ func (t *serializerImpl) DeserializeBadBinaries(data *serialization.DataBlob) (*
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Thrift == Proto for this object so that we can maintain test behavior until thrift is gone
 		// Client API currently specifies encodingType on requests which span multiple of these objects
-		err = memo.Unmarshal(data.Data)
+		err = t.deserialize(data.Data, memo)
 	default:
 		return nil, NewDeserializationError("DeserializeBadBinaries invalid encoding")
 	}
@@ -243,7 +243,7 @@ func (t *serializerImpl) DeserializeVisibilityMemo(data *serialization.DataBlob)
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Thrift == Proto for this object so that we can maintain test behavior until thrift is gone
 		// Client API currently specifies encodingType on requests which span multiple of these objects
-		err = memo.Unmarshal(data.Data)
+		err = t.deserialize(data.Data, memo)
 	default:
 		return nil, NewDeserializationError("DeserializeVisibilityMemo invalid encoding")
 	}
@@ -276,7 +276,7 @@ func (t *serializerImpl) DeserializeVersionHistories(data *serialization.DataBlo
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Thrift == Proto for this object so that we can maintain test behavior until thrift is gone
 		// Client API currently specifies encodingType on requests which span multiple of these objects
-		err = memo.Unmarshal(data.Data)
+		err = t.deserialize(data.Data, memo)
 	default:
 		return nil, NewDeserializationError("DeserializeVersionHistories invalid encoding")
 	}
@@ -309,7 +309,7 @@ func (t *serializerImpl) DeserializeImmutableClusterMetadata(data *serialization
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Thrift == Proto for this object so that we can maintain test behavior until thrift is gone
 		// Client API currently specifies encodingType on requests which span multiple of these objects
-		err = event.Unmarshal(data.Data)
+		err = t.deserialize(data.Data, event)
 	default:
 		return nil, NewDeserializationError("DeserializeImmutableClusterMetadata invalid encoding")
 	}
@@ -332,7 +332,7 @@ func (t *serializerImpl) serialize(p proto.Marshaler, encodingType enumspb.Encod
 	switch encodingType {
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Client API currently specifies encodingType on requests which span multiple of these objects
-		data, err = p.Marshal()
+		data, err = t.marshal(p)
 	default:
 		return nil, NewUnknownEncodingTypeError(encodingType)
 	}
@@ -352,6 +352,26 @@ func (t *serializerImpl) serialize(p proto.Marshaler, encodingType enumspb.Encod
 	}, nil
 }
 
+// marshal encodes the payloads of the message with the payload codec, if any, before marshaling it
+func (t *serializerImpl) marshal(p proto.Marshaler) ([]byte, error) {
+	if msg, ok := p.(proto.Message); ok {
+		encoded, err := serialization.EncodePayloads(msg)
+		if err != nil {
+			return nil, err
+		}
+		p = encoded.(proto.Marshaler)
+	}
+	return p.Marshal()
+}
+
+// deserialize unmarshals the message and decodes its payloads with the payload codec, if any
+func (t *serializerImpl) deserialize(data []byte, result proto.Message) error {
+	if err := proto.Unmarshal(data, result); err != nil {
+		return err
+	}
+	return serialization.DecodePayloads(result)
+}
+
 // NewUnknownEncodingTypeError returns a new instance of encoding type error
 func NewUnknownEncodingTypeError(encodingType enumspb.EncodingType) error {
 	return &UnknownEncodingTypeError{encodingType: encodingType}
@@ -25,6 +25,7 @@
 package persistence
 
 import (
+	"bytes"
 	"reflect"
 	"sync"
 	"testing"
@@ -39,11 +40,13 @@ import (
 	workflowpb "go.temporal.io/api/workflow/v1"
 
 	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/persistenceblobs/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/loggerimpl"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
@@ -328,3 +331,64 @@ func (s *temporalSerializerSuite) TestSerializer() {
 	succ := common.AwaitWaitGroup(&doneWG, 10*time.Second)
 	s.True(succ, "test timed out")
 }
+
+func (s *temporalSerializerSuite) TestSerializerPayloadCodec() {
+	provider, err := serialization.NewLocalKeyProvider(serialization.LocalFileKeys{
+		CurrentKeyID: "key-1",
+		Keys:         map[string]string{"key-1": "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="},
+	})
+	s.NoError(err)
+	serialization.SetPayloadCodec(serialization.NewEnvelopeEncryptionCodec(provider))
+	defer serialization.SetPayloadCodec(nil)
+
+	serializer := NewPayloadSerializer()
+
+	// history
+	events := []*historypb.HistoryEvent{{
+		EventId:   1,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+			WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+				SignalName: "signal",
+				Input:      payloads.EncodeString("secret signal"),
+			},
+		},
+	}}
+	blob, err := serializer.SerializeBatchEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	s.False(bytes.Contains(blob.Data, []byte("secret signal")))
+	s.Equal(payloads.EncodeString("secret signal"), events[0].GetWorkflowExecutionSignaledEventAttributes().GetInput())
+	result, err := serializer.DeserializeBatchEvents(blob)
+	s.NoError(err)
+	s.Equal(events, result)
+
+	// mutable state
+	info := &persistenceblobs.WorkflowExecutionInfo{
+		NamespaceId: "namespace-id",
+		WorkflowId:  "workflow-id",
+		Memo:        map[string]*commonpb.Payload{"memo": payload.EncodeString("secret memo")},
+	}
+	infoBlob, err := serialization.WorkflowExecutionInfoToBlob(info)
+	s.NoError(err)
+	s.False(bytes.Contains(infoBlob.Data, []byte("secret memo")))
+	s.True(bytes.Contains(infoBlob.Data, []byte("workflow-id")))
+	infoResult, err := serialization.WorkflowExecutionInfoFromBlob(infoBlob.Data, infoBlob.Encoding.String())
+	s.NoError(err)
+	s.Equal(info, infoResult)
+}
+
+func (s *temporalSerializerSuite) TestSerializerPayloadCodecNotSet() {
+	serialization.SetPayloadCodec(nil)
+	serializer := NewPayloadSerializer()
+
+	memo := &commonpb.Memo{Fields: map[string]*commonpb.Payload{"memo": payload.EncodeString("plain memo")}}
+	blob, err := serializer.SerializeVisibilityMemo(memo, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	data, err := memo.Marshal()
+	s.NoError(err)
+	s.Equal(data, blob.Data)
+
+	result, err := serializer.DeserializeVisibilityMemo(blob)
+	s.NoError(err)
+	s.Equal(memo, result)
+}
@@ -682,6 +682,57 @@ func (m *sqlExecutionManager) GetCurrentExecution(
 	}, nil
 }
 
+func (m *sqlExecutionManager) CreateStartRequestID(
+	request *p.CreateStartRequestIDRequest,
+) error {
+
+	now := time.Now().UTC()
+	namespaceID := primitives.MustParseUUID(request.NamespaceID)
+	// there is no TTL on SQL rows, expired request IDs of the workflow are removed here instead
+	if _, err := m.db.DeleteFromStartRequestIDs(&sqlplugin.StartRequestIDsFilter{
+		ShardID:       int64(m.shardID),
+		NamespaceID:   namespaceID,
+		WorkflowID:    request.WorkflowID,
+		MinExpiryTime: now,
+	}); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("CreateStartRequestID operation failed. Error: %v", err))
+	}
+
+	if _, err := m.db.ReplaceIntoStartRequestIDs(&sqlplugin.StartRequestIDsRow{
+		ShardID:     int64(m.shardID),
+		NamespaceID: namespaceID,
+		WorkflowID:  request.WorkflowID,
+		RequestID:   request.RequestID,
+		RunID:       primitives.MustParseUUID(request.RunID),
+		ExpiryTime:  now.Add(request.TTL),
+	}); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("CreateStartRequestID operation failed. Error: %v", err))
+	}
+	return nil
+}
+
+func (m *sqlExecutionManager) GetStartRequestID(
+	request *p.GetStartRequestIDRequest,
+) (*p.GetStartRequestIDResponse, error) {
+
+	row, err := m.db.SelectFromStartRequestIDs(&sqlplugin.StartRequestIDsFilter{
+		ShardID:       int64(m.shardID),
+		NamespaceID:   primitives.MustParseUUID(request.NamespaceID),
+		WorkflowID:    request.WorkflowID,
+		RequestID:     request.RequestID,
+		MinExpiryTime: time.Now().UTC(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, serviceerror.NewNotFound(err.Error())
+		}
+		return nil, serviceerror.NewInternal(fmt.Sprintf("GetStartRequestID operation failed. Error: %v", err))
+	}
+	return &p.GetStartRequestIDResponse{
+		RunID: row.RunID.String(),
+	}, nil
+}
+
 func (m *sqlExecutionManager) ListConcreteExecutions(
 	_ *p.ListConcreteExecutionsRequest,
 ) (*p.InternalListConcreteExecutionsResponse, error) {
@@ -861,6 +861,7 @@ func createTimerTasks(
 			case *p.ActivityRetryTimerTask:
 				info.EventId = t.EventID
 				info.ScheduleAttempt = t.Attempt
+				info.Stamp = t.Stamp
 
 			case *p.WorkflowBackoffTimerTask:
 				info.EventId = t.EventID
@@ -869,6 +870,9 @@ func createTimerTasks(
 			case *p.WorkflowTimeoutTask:
 				// noop
 
+			case *p.WorkflowDeadlineTimerTask:
+				// noop
+
 			case *p.DeleteHistoryEventTask:
 				// noop
 
@@ -165,12 +165,21 @@ func (m *sqlMetadataManagerV2) namespaceRowToGetNamespaceResponse(row *sqlplugin
 }
 
 func (m *sqlMetadataManagerV2) UpdateNamespace(request *persistence.InternalUpdateNamespaceRequest) error {
+	return m.updateNamespace("UpdateNamespace", request)
+}
+
+// RenameNamespace renames a namespace, namespace rows are keyed by id so the name is updated in place
+func (m *sqlMetadataManagerV2) RenameNamespace(request *persistence.InternalRenameNamespaceRequest) error {
+	return m.updateNamespace("RenameNamespace", request.InternalUpdateNamespaceRequest)
+}
+
+func (m *sqlMetadataManagerV2) updateNamespace(operation string, request *persistence.InternalUpdateNamespaceRequest) error {
 	idBytes, err := primitives.ParseUUID(request.Id)
 	if err != nil {
 		return err
 	}
 
-	return m.txExecute("UpdateNamespace", func(tx sqlplugin.Tx) error {
+	return m.txExecute(operation, func(tx sqlplugin.Tx) error {
 		result, err := tx.UpdateNamespace(&sqlplugin.NamespaceRow{
 			Name:                request.Name,
 			ID:                  idBytes,
@@ -179,6 +188,9 @@ func (m *sqlMetadataManagerV2) UpdateNamespace(request *persistence.InternalUpda
 			NotificationVersion: request.NotificationVersion,
 		})
 		if err != nil {
+			if m.db.IsDupEntryError(err) {
+				return serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf("name: %v", request.Name))
+			}
 			return err
 		}
 		noRowsAffected, err := result.RowsAffected()
@@ -30,18 +30,27 @@ import (
 	"fmt"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/service/config"
+	"go.temporal.io/server/common/service/dynamicconfig"
 )
 
+// defaultVisibilityQueryPageSize is the page size of query based list and scan requests without one
+const defaultVisibilityQueryPageSize = 1000
+
 type (
 	sqlVisibilityStore struct {
 		sqlStore
+		validSearchAttributes dynamicconfig.MapPropertyFn
 	}
 
 	visibilityPageToken struct {
@@ -50,8 +59,9 @@ type (
 	}
 )
 
-// NewSQLVisibilityStore creates an instance of ExecutionStore
-func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore, error) {
+// NewSQLVisibilityStore creates an instance of ExecutionStore. Queries may only use the custom
+// search attributes of validSearchAttributes, or the default ones when it is nil
+func NewSQLVisibilityStore(cfg config.SQL, validSearchAttributes dynamicconfig.MapPropertyFn, logger log.Logger) (p.VisibilityStore, error) {
 	db, err := NewSQLDB(&cfg)
 	if err != nil {
 		return nil, err
@@ -61,6 +71,7 @@ func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore
 			db:     db,
 			logger: logger,
 		},
+		validSearchAttributes: validSearchAttributes,
 	}, nil
 }
 
@@ -75,6 +86,8 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalR
 		Status:           int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING), // Underlying value (1) is hardcoded in SQL queries.
 		Memo:             request.Memo.Data,
 		Encoding:         request.Memo.Encoding.String(),
+		TaskQueue:        request.TaskQueue,
+		SearchAttributes: s.serializeSearchAttributes(request.SearchAttributes),
 	})
 
 	return err
@@ -94,6 +107,8 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRe
 		HistoryLength:    &request.HistoryLength,
 		Memo:             request.Memo.Data,
 		Encoding:         request.Memo.Encoding.String(),
+		TaskQueue:        request.TaskQueue,
+		SearchAttributes: s.serializeSearchAttributes(request.SearchAttributes),
 	})
 	if err != nil {
 		return err
@@ -108,7 +123,23 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRe
 	return nil
 }
 
-func (s *sqlVisibilityStore) UpsertWorkflowExecution(_ *p.InternalUpsertWorkflowExecutionRequest) error {
+func (s *sqlVisibilityStore) UpsertWorkflowExecution(request *p.InternalUpsertWorkflowExecutionRequest) error {
+	_, err := s.db.UpsertIntoVisibility(&sqlplugin.VisibilityRow{
+		NamespaceID:      request.NamespaceID,
+		WorkflowID:       request.WorkflowID,
+		RunID:            request.RunID,
+		StartTime:        time.Unix(0, request.StartTimestamp).UTC(),
+		ExecutionTime:    time.Unix(0, request.ExecutionTimestamp).UTC(),
+		WorkflowTypeName: request.WorkflowTypeName,
+		Status:           int32(request.Status),
+		Memo:             request.Memo.Data,
+		Encoding:         request.Memo.Encoding.String(),
+		TaskQueue:        request.TaskQueue,
+		SearchAttributes: s.serializeSearchAttributes(request.SearchAttributes),
+	})
+	if err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("UpsertWorkflowExecution operation failed. Error: %v", err))
+	}
 	return nil
 }
 
@@ -249,15 +280,85 @@ func (s *sqlVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDelete
 }
 
 func (s *sqlVisibilityStore) ListWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	return s.queryWorkflowExecutions("ListWorkflowExecutions", request, false)
 }
 
+// ScanWorkflowExecutions pages through executions in run ID order, which does not skip or repeat
+// executions when rows are added or removed during the scan, any order by of the query is ignored
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	return s.queryWorkflowExecutions("ScanWorkflowExecutions", request, true)
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := s.parseQuery(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Error when parse query: %v", err))
+	}
+	count, err := s.db.CountFromVisibility(&sqlplugin.VisibilityQueryFilter{
+		NamespaceID: request.NamespaceID,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("CountWorkflowExecutions operation failed. Select failed: %v", err))
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
+func (s *sqlVisibilityStore) queryWorkflowExecutions(
+	opName string,
+	request *p.ListWorkflowExecutionsRequestV2,
+	scan bool,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+	query, err := s.parseQuery(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Error when parse query: %v", err))
+	}
+	token := &visibilityPageToken{}
+	if len(request.NextPageToken) > 0 {
+		if token, err = s.deserializePageToken(request.NextPageToken); err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid next page token: %v", err))
+		}
+	}
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultVisibilityQueryPageSize
+	}
+
+	filter := &sqlplugin.VisibilityQueryFilter{
+		NamespaceID: request.NamespaceID,
+		Query:       query,
+		PageSize:    pageSize,
+	}
+	if scan {
+		filter.MinRunID = &token.RunID
+	} else if len(request.NextPageToken) > 0 {
+		filter.After = &sqlplugin.VisibilityQueryCursor{Time: token.Time, RunID: token.RunID}
+	}
+	rows, err := s.db.SelectFromVisibilityWithQuery(filter)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("%v operation failed. Select failed: %v", opName, err))
+	}
+
+	var nextPageToken []byte
+	if len(rows) == pageSize {
+		lastRow := &rows[len(rows)-1]
+		nextToken := &visibilityPageToken{RunID: lastRow.RunID}
+		if !scan {
+			cursor := query.NextCursor(lastRow)
+			nextToken = &visibilityPageToken{Time: cursor.Time, RunID: cursor.RunID}
+		}
+		if nextPageToken, err = s.serializePageToken(nextToken); err != nil {
+			return nil, err
+		}
+	}
+	infos := make([]*p.VisibilityWorkflowExecutionInfo, len(rows))
+	for i := range rows {
+		infos[i] = s.rowToInfo(&rows[i])
+	}
+	return &p.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.VisibilityWorkflowExecutionInfo {
@@ -272,6 +373,13 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.Visibili
 		ExecutionTime: row.ExecutionTime,
 		Memo:          p.NewDataBlob(row.Memo, row.Encoding),
 		Status:        enumspb.WorkflowExecutionStatus(row.Status),
+		TaskQueue:     row.TaskQueue,
+	}
+	if len(row.SearchAttributes) > 0 {
+		if err := json.Unmarshal(row.SearchAttributes, &info.SearchAttributes); err != nil {
+			s.logger.Error("unable to decode search attributes",
+				tag.WorkflowID(row.WorkflowID), tag.WorkflowRunID(row.RunID), tag.Error(err))
+		}
 	}
 	if row.CloseTime != nil {
 		info.CloseTime = *row.CloseTime
@@ -334,6 +442,42 @@ func (s *sqlVisibilityStore) listWorkflowExecutions(
 	}, nil
 }
 
+// parseQuery parses the query of a list, scan or count request, which may only use valid search attributes
+func (s *sqlVisibilityStore) parseQuery(query string) (*sqlplugin.VisibilityQuery, error) {
+	validSearchAttributes := definition.GetDefaultIndexedKeys()
+	if s.validSearchAttributes != nil {
+		validSearchAttributes = s.validSearchAttributes()
+	}
+	searchAttributes := make(map[string]enumspb.IndexedValueType, len(validSearchAttributes))
+	for name, valueType := range validSearchAttributes {
+		searchAttributes[name] = common.ConvertIndexedValueTypeToProtoType(valueType, s.logger)
+	}
+	return sqlplugin.ParseVisibilityQuery(query, searchAttributes)
+}
+
+// serializeSearchAttributes stores search attributes as a JSON object, skipping values which are not JSON
+func (s *sqlVisibilityStore) serializeSearchAttributes(searchAttributes map[string]*commonpb.Payload) []byte {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	fields := make(map[string]json.RawMessage, len(searchAttributes))
+	for k, v := range searchAttributes {
+		// TODO: current implementation assumes that payload is JSON, same as the Elasticsearch store.
+		data := v.GetData()
+		if !json.Valid(data) {
+			s.logger.Warn("skip search attribute which is not JSON", tag.Key(k))
+			continue
+		}
+		fields[k] = data
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		s.logger.Error("unable to encode search attributes", tag.Error(err))
+		return nil
+	}
+	return data
+}
+
 func (s *sqlVisibilityStore) deserializePageToken(data []byte) (*visibilityPageToken, error) {
 	var token visibilityPageToken
 	err := json.Unmarshal(data, &token)
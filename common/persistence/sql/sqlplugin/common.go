@@ -83,5 +83,12 @@ type (
 		//     - workflowID, workflowTypeName, status (along with closed=true)
 		SelectFromVisibility(filter *VisibilityFilter) ([]VisibilityRow, error)
 		DeleteFromVisibility(filter *VisibilityFilter) (sql.Result, error)
+		// UpsertIntoVisibility inserts a row into visibility table. If a row already exist,
+		// only its memo and search attributes are updated
+		UpsertIntoVisibility(row *VisibilityRow) (sql.Result, error)
+		// SelectFromVisibilityWithQuery returns one page of rows matching the visibility query
+		SelectFromVisibilityWithQuery(filter *VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibility returns the number of rows matching the visibility query
+		CountFromVisibility(filter *VisibilityQueryFilter) (int64, error)
 	}
 )
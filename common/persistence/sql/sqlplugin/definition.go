@@ -175,6 +175,26 @@ type (
 		RunID       primitives.UUID
 	}
 
+	// StartRequestIDsRow represents a row in start_request_ids table
+	StartRequestIDsRow struct {
+		ShardID     int64
+		NamespaceID primitives.UUID
+		WorkflowID  string
+		RequestID   string
+		RunID       primitives.UUID
+		ExpiryTime  time.Time
+	}
+
+	// StartRequestIDsFilter contains the column names within start_request_ids table that
+	// can be used to filter results through a WHERE clause
+	StartRequestIDsFilter struct {
+		ShardID       int64
+		NamespaceID   primitives.UUID
+		WorkflowID    string
+		RequestID     string
+		MinExpiryTime time.Time
+	}
+
 	// BufferedEventsRow represents a row in buffered_events table
 	BufferedEventsRow struct {
 		ShardID      int
@@ -495,6 +515,8 @@ type (
 		HistoryLength    *int64
 		Memo             []byte
 		Encoding         string
+		TaskQueue        string
+		SearchAttributes []byte
 	}
 
 	// VisibilityFilter contains the column names within executions_visibility table that
@@ -67,6 +67,14 @@ type (
 		// Required params - {shardID, namespaceID, workflowID, runID}
 		DeleteFromCurrentExecutions(filter *CurrentExecutionsFilter) (sql.Result, error)
 		LockCurrentExecutions(filter *CurrentExecutionsFilter) (*CurrentExecutionsRow, error)
+
+		ReplaceIntoStartRequestIDs(row *StartRequestIDsRow) (sql.Result, error)
+		// SelectFromStartRequestIDs returns the row from start_request_ids table which has not expired yet
+		// Required params - {shardID, namespaceID, workflowID, requestID, minExpiryTime}
+		SelectFromStartRequestIDs(filter *StartRequestIDsFilter) (*StartRequestIDsRow, error)
+		// DeleteFromStartRequestIDs deletes the expired rows of a workflow from start_request_ids table
+		// Required params - {shardID, namespaceID, workflowID, minExpiryTime}
+		DeleteFromStartRequestIDs(filter *StartRequestIDsFilter) (sql.Result, error)
 	}
 
 	// historyExecutionBuffer is the SQL persistence interface for history nodes and history execution buffer events
@@ -83,6 +83,18 @@ namespace_id = :namespace_id AND
 workflow_id = :workflow_id
 `
 
+	replaceIntoStartRequestIDsQuery = `INSERT INTO start_request_ids
+(shard_id, namespace_id, workflow_id, request_id, run_id, expiry_time) VALUES
+(:shard_id, :namespace_id, :workflow_id, :request_id, :run_id, :expiry_time)
+ON DUPLICATE KEY UPDATE run_id = VALUES(run_id), expiry_time = VALUES(expiry_time)`
+
+	getStartRequestIDQuery = `SELECT
+shard_id, namespace_id, workflow_id, request_id, run_id, expiry_time
+FROM start_request_ids WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND request_id = ? AND expiry_time > ?`
+
+	deleteExpiredStartRequestIDsQuery = `DELETE FROM start_request_ids
+WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND expiry_time <= ?`
+
 	getTransferTasksQuery = `SELECT task_id, data, data_encoding 
  FROM transfer_tasks WHERE shard_id = ? AND task_id > ? AND task_id <= ? ORDER BY shard_id, task_id`
 
@@ -234,6 +246,27 @@ func (mdb *db) LockCurrentExecutionsJoinExecutions(filter *sqlplugin.CurrentExec
 	return rows, err
 }
 
+// ReplaceIntoStartRequestIDs replaces a single row in start_request_ids table
+func (mdb *db) ReplaceIntoStartRequestIDs(row *sqlplugin.StartRequestIDsRow) (sql.Result, error) {
+	row.ExpiryTime = mdb.converter.ToMySQLDateTime(row.ExpiryTime)
+	return mdb.conn.NamedExec(replaceIntoStartRequestIDsQuery, row)
+}
+
+// SelectFromStartRequestIDs reads a single unexpired row from start_request_ids table
+func (mdb *db) SelectFromStartRequestIDs(filter *sqlplugin.StartRequestIDsFilter) (*sqlplugin.StartRequestIDsRow, error) {
+	var row sqlplugin.StartRequestIDsRow
+	err := mdb.conn.Get(&row, getStartRequestIDQuery, filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RequestID,
+		mdb.converter.ToMySQLDateTime(filter.MinExpiryTime))
+	row.ExpiryTime = mdb.converter.FromMySQLDateTime(row.ExpiryTime)
+	return &row, err
+}
+
+// DeleteFromStartRequestIDs deletes the expired rows of a workflow from start_request_ids table
+func (mdb *db) DeleteFromStartRequestIDs(filter *sqlplugin.StartRequestIDsFilter) (sql.Result, error) {
+	return mdb.conn.Exec(deleteExpiredStartRequestIDsQuery, filter.ShardID, filter.NamespaceID, filter.WorkflowID,
+		mdb.converter.ToMySQLDateTime(filter.MinExpiryTime))
+}
+
 // InsertIntoTransferTasks inserts one or more rows into transfer_tasks table
 func (mdb *db) InsertIntoTransferTasks(rows []sqlplugin.TransferTasksRow) (sql.Result, error) {
 	return mdb.conn.NamedExec(createTransferTasksQuery, rows)
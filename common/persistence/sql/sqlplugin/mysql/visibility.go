@@ -29,21 +29,28 @@ import (
 	"errors"
 	"fmt"
 
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
 		`ON DUPLICATE KEY UPDATE ` +
 		`run_id=VALUES(run_id)`
 
 	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
 		`ON DUPLICATE KEY UPDATE start_time = VALUES(start_time), execution_time = VALUES(execution_time), workflow_type_name = VALUES(workflow_type_name), ` +
-		`close_time = VALUES(close_time), status = VALUES(status), history_length = VALUES(history_length), memo = VALUES(memo), encoding = VALUES(encoding)`
+		`close_time = VALUES(close_time), status = VALUES(status), history_length = VALUES(history_length), memo = VALUES(memo), encoding = VALUES(encoding), ` +
+		`task_queue = VALUES(task_queue), search_attributes = VALUES(search_attributes)`
+
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
+		`ON DUPLICATE KEY UPDATE memo = VALUES(memo), encoding = VALUES(encoding), search_attributes = VALUES(search_attributes)`
 
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND namespace_id = ?
@@ -86,8 +93,25 @@ const (
 		 AND run_id = ?`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE namespace_id=? AND run_id=?"
+
+	templateQueryFieldNames = templateOpenFieldNames + `, close_time, history_length, task_queue, search_attributes`
+
+	templateQueryWorkflowExecutions = `SELECT ` + templateQueryFieldNames + ` FROM executions_visibility WHERE namespace_id = ?`
+
+	templateCountWorkflowExecutions = `SELECT COUNT(*) FROM executions_visibility WHERE namespace_id = ?`
 )
 
+type visibilityQueryDialect struct{}
+
+// searchAttributeColumns are the generated columns of executions_visibility holding the scalar
+// values of the predefined custom search attributes. They are indexed, unlike the JSON column
+var searchAttributeColumns = map[string]string{
+	definition.CustomKeywordField: "custom_keyword_field",
+	definition.CustomIntField:     "custom_int_field",
+	definition.CustomDoubleField:  "custom_double_field",
+	definition.CustomBoolField:    "custom_bool_field",
+}
+
 var errCloseParams = errors.New("missing one of {closeTime, historyLength} params")
 
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
@@ -103,7 +127,9 @@ func (mdb *db) InsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, e
 		row.WorkflowTypeName,
 		row.Status,
 		row.Memo,
-		row.Encoding)
+		row.Encoding,
+		row.TaskQueue,
+		searchAttributesArg(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -123,12 +149,32 @@ func (mdb *db) ReplaceIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result,
 			row.Status,
 			*row.HistoryLength,
 			row.Memo,
-			row.Encoding)
+			row.Encoding,
+			row.TaskQueue,
+			searchAttributesArg(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
 }
 
+// UpsertIntoVisibility inserts a row into visibility table. If a row already exist,
+// only its memo and search attributes are updated
+func (mdb *db) UpsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	row.StartTime = mdb.converter.ToMySQLDateTime(row.StartTime)
+	return mdb.conn.Exec(templateUpsertWorkflowExecution,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Status,
+		row.Memo,
+		row.Encoding,
+		row.TaskQueue,
+		searchAttributesArg(row.SearchAttributes))
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (mdb *db) DeleteFromVisibility(filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
 	return mdb.conn.Exec(templateDeleteWorkflowExecution, filter.NamespaceID, filter.RunID)
@@ -213,6 +259,62 @@ func (mdb *db) SelectFromVisibility(filter *sqlplugin.VisibilityFilter) ([]sqlpl
 	if err != nil {
 		return nil, err
 	}
+	mdb.convertVisibilityRows(rows)
+	return rows, err
+}
+
+// SelectFromVisibilityWithQuery reads one page of rows matching the visibility query
+func (mdb *db) SelectFromVisibilityWithQuery(filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	var condition, orderBy string
+	var args []interface{}
+	if filter.MinRunID != nil {
+		condition, _, args = filter.Query.Build(visibilityQueryDialect{}, 1)
+	} else {
+		var after *sqlplugin.VisibilityQueryCursor
+		if filter.After != nil {
+			after = &sqlplugin.VisibilityQueryCursor{
+				Time:  mdb.converter.ToMySQLDateTime(filter.After.Time),
+				RunID: filter.After.RunID,
+			}
+		}
+		condition, orderBy, args = filter.Query.BuildPage(visibilityQueryDialect{}, 1, after)
+	}
+	qry := templateQueryWorkflowExecutions
+	if condition != "" {
+		qry += " AND (" + condition + ")"
+	}
+	args = append([]interface{}{filter.NamespaceID}, args...)
+	if filter.MinRunID != nil {
+		qry += " AND run_id > ? ORDER BY run_id LIMIT ?"
+		args = append(args, *filter.MinRunID, filter.PageSize)
+	} else {
+		qry += " ORDER BY " + orderBy + " LIMIT ?"
+		args = append(args, filter.PageSize)
+	}
+
+	var rows []sqlplugin.VisibilityRow
+	if err := mdb.conn.Select(&rows, qry, args...); err != nil {
+		return nil, err
+	}
+	mdb.convertVisibilityRows(rows)
+	return rows, nil
+}
+
+// CountFromVisibility returns the number of rows matching the visibility query
+func (mdb *db) CountFromVisibility(filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	condition, _, args := filter.Query.Build(visibilityQueryDialect{}, 1)
+	qry := templateCountWorkflowExecutions
+	if condition != "" {
+		qry += " AND (" + condition + ")"
+	}
+	args = append([]interface{}{filter.NamespaceID}, args...)
+
+	var count int64
+	err := mdb.conn.Get(&count, qry, args...)
+	return count, err
+}
+
+func (mdb *db) convertVisibilityRows(rows []sqlplugin.VisibilityRow) {
 	for i := range rows {
 		rows[i].StartTime = mdb.converter.FromMySQLDateTime(rows[i].StartTime)
 		rows[i].ExecutionTime = mdb.converter.FromMySQLDateTime(rows[i].ExecutionTime)
@@ -221,5 +323,37 @@ func (mdb *db) SelectFromVisibility(filter *sqlplugin.VisibilityFilter) ([]sqlpl
 			rows[i].CloseTime = &closeTime
 		}
 	}
-	return rows, err
+}
+
+// searchAttributesArg binds search attributes as text, MySQL refuses to convert binary strings to JSON
+func searchAttributesArg(data []byte) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	return string(data)
+}
+
+// Placeholder implements sqlplugin.VisibilityQueryDialect
+func (visibilityQueryDialect) Placeholder(_ int) string {
+	return "?"
+}
+
+// SearchAttribute implements sqlplugin.VisibilityQueryDialect
+func (visibilityQueryDialect) SearchAttribute(name string) string {
+	return fmt.Sprintf("JSON_EXTRACT(search_attributes, '$.%s')", name)
+}
+
+// SearchAttributeEquals implements sqlplugin.VisibilityQueryDialect
+func (visibilityQueryDialect) SearchAttributeEquals(name string, placeholder string) string {
+	return fmt.Sprintf("JSON_CONTAINS(search_attributes, %s, '$.%s')", placeholder, name)
+}
+
+// JSONValue implements sqlplugin.VisibilityQueryDialect
+func (visibilityQueryDialect) JSONValue(placeholder string) string {
+	return fmt.Sprintf("CAST(%s AS JSON)", placeholder)
+}
+
+// SearchAttributeColumn implements sqlplugin.VisibilityQueryDialect
+func (visibilityQueryDialect) SearchAttributeColumn(name string) string {
+	return searchAttributeColumns[name]
 }
@@ -83,6 +83,19 @@ namespace_id = :namespace_id AND
 workflow_id = :workflow_id
 `
 
+	replaceIntoStartRequestIDsQuery = `INSERT INTO start_request_ids
+(shard_id, namespace_id, workflow_id, request_id, run_id, expiry_time) VALUES
+(:shard_id, :namespace_id, :workflow_id, :request_id, :run_id, :expiry_time)
+ON CONFLICT (shard_id, namespace_id, workflow_id, request_id) DO UPDATE
+	SET run_id = excluded.run_id, expiry_time = excluded.expiry_time`
+
+	getStartRequestIDQuery = `SELECT
+shard_id, namespace_id, workflow_id, request_id, run_id, expiry_time
+FROM start_request_ids WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3 AND request_id = $4 AND expiry_time > $5`
+
+	deleteExpiredStartRequestIDsQuery = `DELETE FROM start_request_ids
+WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3 AND expiry_time <= $4`
+
 	getTransferTasksQuery = `SELECT task_id, data, data_encoding 
  FROM transfer_tasks WHERE shard_id = $1 AND task_id > $2 AND task_id <= $3 ORDER BY shard_id, task_id`
 
@@ -233,6 +246,27 @@ func (pdb *db) LockCurrentExecutionsJoinExecutions(filter *sqlplugin.CurrentExec
 	return rows, err
 }
 
+// ReplaceIntoStartRequestIDs replaces a single row in start_request_ids table
+func (pdb *db) ReplaceIntoStartRequestIDs(row *sqlplugin.StartRequestIDsRow) (sql.Result, error) {
+	row.ExpiryTime = pdb.converter.ToPostgresDateTime(row.ExpiryTime)
+	return pdb.conn.NamedExec(replaceIntoStartRequestIDsQuery, row)
+}
+
+// SelectFromStartRequestIDs reads a single unexpired row from start_request_ids table
+func (pdb *db) SelectFromStartRequestIDs(filter *sqlplugin.StartRequestIDsFilter) (*sqlplugin.StartRequestIDsRow, error) {
+	var row sqlplugin.StartRequestIDsRow
+	err := pdb.conn.Get(&row, getStartRequestIDQuery, filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RequestID,
+		pdb.converter.ToPostgresDateTime(filter.MinExpiryTime))
+	row.ExpiryTime = pdb.converter.FromPostgresDateTime(row.ExpiryTime)
+	return &row, err
+}
+
+// DeleteFromStartRequestIDs deletes the expired rows of a workflow from start_request_ids table
+func (pdb *db) DeleteFromStartRequestIDs(filter *sqlplugin.StartRequestIDsFilter) (sql.Result, error) {
+	return pdb.conn.Exec(deleteExpiredStartRequestIDsQuery, filter.ShardID, filter.NamespaceID, filter.WorkflowID,
+		pdb.converter.ToPostgresDateTime(filter.MinExpiryTime))
+}
+
 // InsertIntoTransferTasks inserts one or more rows into transfer_tasks table
 func (pdb *db) InsertIntoTransferTasks(rows []sqlplugin.TransferTasksRow) (sql.Result, error) {
 	return pdb.conn.NamedExec(createTransferTasksQuery, rows)
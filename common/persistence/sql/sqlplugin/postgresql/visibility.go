@@ -35,13 +35,13 @@ import (
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
          ON CONFLICT (namespace_id, run_id) DO NOTHING`
 
 	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		ON CONFLICT (namespace_id, run_id) DO UPDATE 
 		  SET workflow_id = excluded.workflow_id,
 		      start_time = excluded.start_time,
@@ -51,7 +51,17 @@ const (
 			  status = excluded.status,
 			  history_length = excluded.history_length,
 			  memo = excluded.memo,
-			  encoding = excluded.encoding`
+			  encoding = excluded.encoding,
+			  task_queue = excluded.task_queue,
+			  search_attributes = excluded.search_attributes`
+
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (namespace_id, run_id) DO UPDATE
+		  SET memo = excluded.memo,
+			  encoding = excluded.encoding,
+			  search_attributes = excluded.search_attributes`
 
 	// RunID condition is needed for correct pagination
 	templateConditions1 = ` AND namespace_id = $1
@@ -108,8 +118,16 @@ const (
 		 AND run_id = $2`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE namespace_id=$1 AND run_id=$2"
+
+	templateQueryFieldNames = templateOpenFieldNames + `, close_time, history_length, task_queue, search_attributes`
+
+	templateQueryWorkflowExecutions = `SELECT ` + templateQueryFieldNames + ` FROM executions_visibility WHERE namespace_id = $1`
+
+	templateCountWorkflowExecutions = `SELECT COUNT(*) FROM executions_visibility WHERE namespace_id = $1`
 )
 
+type visibilityQueryDialect struct{}
+
 var errCloseParams = errors.New("missing one of {closeTime, historyLength} params")
 
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
@@ -125,7 +143,9 @@ func (pdb *db) InsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, e
 		row.WorkflowTypeName,
 		row.Status,
 		row.Memo,
-		row.Encoding)
+		row.Encoding,
+		row.TaskQueue,
+		searchAttributesArg(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -145,12 +165,32 @@ func (pdb *db) ReplaceIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result,
 			row.Status,
 			*row.HistoryLength,
 			row.Memo,
-			row.Encoding)
+			row.Encoding,
+			row.TaskQueue,
+			searchAttributesArg(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
 }
 
+// UpsertIntoVisibility inserts a row into visibility table. If a row already exist,
+// only its memo and search attributes are updated
+func (pdb *db) UpsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	row.StartTime = pdb.converter.ToPostgresDateTime(row.StartTime)
+	return pdb.conn.Exec(templateUpsertWorkflowExecution,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Status,
+		row.Memo,
+		row.Encoding,
+		row.TaskQueue,
+		searchAttributesArg(row.SearchAttributes))
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (pdb *db) DeleteFromVisibility(filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
 	return pdb.conn.Exec(templateDeleteWorkflowExecution, filter.NamespaceID, filter.RunID)
@@ -237,6 +277,62 @@ func (pdb *db) SelectFromVisibility(filter *sqlplugin.VisibilityFilter) ([]sqlpl
 	if err != nil {
 		return nil, err
 	}
+	pdb.convertVisibilityRows(rows)
+	return rows, err
+}
+
+// SelectFromVisibilityWithQuery reads one page of rows matching the visibility query
+func (pdb *db) SelectFromVisibilityWithQuery(filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	var condition, orderBy string
+	var args []interface{}
+	if filter.MinRunID != nil {
+		condition, _, args = filter.Query.Build(visibilityQueryDialect{}, 1)
+	} else {
+		var after *sqlplugin.VisibilityQueryCursor
+		if filter.After != nil {
+			after = &sqlplugin.VisibilityQueryCursor{
+				Time:  pdb.converter.ToPostgresDateTime(filter.After.Time),
+				RunID: filter.After.RunID,
+			}
+		}
+		condition, orderBy, args = filter.Query.BuildPage(visibilityQueryDialect{}, 1, after)
+	}
+	qry := templateQueryWorkflowExecutions
+	if condition != "" {
+		qry += " AND (" + condition + ")"
+	}
+	args = append([]interface{}{filter.NamespaceID}, args...)
+	if filter.MinRunID != nil {
+		qry += fmt.Sprintf(" AND run_id > $%d ORDER BY run_id LIMIT $%d", len(args)+1, len(args)+2)
+		args = append(args, *filter.MinRunID, filter.PageSize)
+	} else {
+		qry += fmt.Sprintf(" ORDER BY %s LIMIT $%d", orderBy, len(args)+1)
+		args = append(args, filter.PageSize)
+	}
+
+	var rows []sqlplugin.VisibilityRow
+	if err := pdb.conn.Select(&rows, qry, args...); err != nil {
+		return nil, err
+	}
+	pdb.convertVisibilityRows(rows)
+	return rows, nil
+}
+
+// CountFromVisibility returns the number of rows matching the visibility query
+func (pdb *db) CountFromVisibility(filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	condition, _, args := filter.Query.Build(visibilityQueryDialect{}, 1)
+	qry := templateCountWorkflowExecutions
+	if condition != "" {
+		qry += " AND (" + condition + ")"
+	}
+	args = append([]interface{}{filter.NamespaceID}, args...)
+
+	var count int64
+	err := pdb.conn.Get(&count, qry, args...)
+	return count, err
+}
+
+func (pdb *db) convertVisibilityRows(rows []sqlplugin.VisibilityRow) {
 	for i := range rows {
 		rows[i].StartTime = pdb.converter.FromPostgresDateTime(rows[i].StartTime)
 		rows[i].ExecutionTime = pdb.converter.FromPostgresDateTime(rows[i].ExecutionTime)
@@ -247,5 +343,40 @@ func (pdb *db) SelectFromVisibility(filter *sqlplugin.VisibilityFilter) ([]sqlpl
 		rows[i].RunID = strings.TrimSpace(rows[i].RunID)
 		rows[i].WorkflowID = strings.TrimSpace(rows[i].WorkflowID)
 	}
-	return rows, err
+}
+
+// searchAttributesArg binds search attributes as text, binary arguments are sent as bytea
+func searchAttributesArg(data []byte) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	return string(data)
+}
+
+// Placeholder implements sqlplugin.VisibilityQueryDialect
+func (visibilityQueryDialect) Placeholder(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+// SearchAttribute implements sqlplugin.VisibilityQueryDialect
+func (visibilityQueryDialect) SearchAttribute(name string) string {
+	return fmt.Sprintf("search_attributes->'%s'", name)
+}
+
+// SearchAttributeEquals implements sqlplugin.VisibilityQueryDialect. Both containment
+// checks are served by the GIN index on search_attributes, the second one matches lists
+func (visibilityQueryDialect) SearchAttributeEquals(name string, placeholder string) string {
+	return fmt.Sprintf("(search_attributes @> jsonb_build_object('%[1]s', CAST(%[2]s AS jsonb)) OR "+
+		"search_attributes @> jsonb_build_object('%[1]s', jsonb_build_array(CAST(%[2]s AS jsonb))))", name, placeholder)
+}
+
+// JSONValue implements sqlplugin.VisibilityQueryDialect
+func (visibilityQueryDialect) JSONValue(placeholder string) string {
+	return fmt.Sprintf("CAST(%s AS jsonb)", placeholder)
+}
+
+// SearchAttributeColumn implements sqlplugin.VisibilityQueryDialect. Equality is served by the
+// GIN index and ranges by the expression indexes on search_attributes, so there are no columns
+func (visibilityQueryDialect) SearchAttributeColumn(_ string) string {
+	return ""
 }
@@ -0,0 +1,626 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+)
+
+type (
+	// VisibilityQueryDialect defines the database specific syntax used when a
+	// visibility query is translated into SQL
+	VisibilityQueryDialect interface {
+		// Placeholder returns the bind variable for the n-th (starting at 1) query argument
+		Placeholder(n int) string
+		// SearchAttribute returns an expression evaluating to the JSON value of a custom search attribute
+		SearchAttribute(name string) string
+		// SearchAttributeEquals returns a condition matching rows where the custom search attribute
+		// equals the JSON value bound to placeholder, or contains it when the attribute is a list
+		SearchAttributeEquals(name string, placeholder string) string
+		// JSONValue casts the bind variable holding a JSON document to the JSON type of the database
+		JSONValue(placeholder string) string
+		// SearchAttributeColumn returns the indexed column holding the scalar value of a custom
+		// search attribute, or an empty string when the attribute is only stored as JSON
+		SearchAttributeColumn(name string) string
+	}
+
+	// VisibilityQuery is a parsed and validated visibility query as accepted by
+	// ListWorkflowExecutions, ScanWorkflowExecutions and CountWorkflowExecutions
+	VisibilityQuery struct {
+		where   visibilityCondition
+		orderBy visibilityOrder
+	}
+
+	// VisibilityQueryCursor is the position of the last row of a page in the order of the query
+	VisibilityQueryCursor struct {
+		Time  time.Time
+		RunID string
+	}
+
+	// VisibilityQueryFilter contains the params to select or count rows of
+	// executions_visibility table matching a visibility query
+	VisibilityQueryFilter struct {
+		NamespaceID string
+		Query       *VisibilityQuery
+		// MinRunID switches to scan mode: only rows with run_id greater than MinRunID
+		// are returned, ordered by run_id, and the order by of the query is ignored
+		MinRunID *string
+		// After is the position of the last row of the previous page, nil for the first page
+		After    *VisibilityQueryCursor
+		PageSize int
+	}
+
+	visibilityCondition interface {
+		build(b *visibilityQueryBuilder)
+	}
+
+	visibilityLogicalCondition struct {
+		operator string
+		left     visibilityCondition
+		right    visibilityCondition
+	}
+
+	visibilityParenCondition struct {
+		condition visibilityCondition
+	}
+
+	visibilityComparison struct {
+		field    visibilityField
+		operator string
+		values   []interface{}
+	}
+
+	visibilityField struct {
+		column          string
+		searchAttribute string
+		valueType       enumspb.IndexedValueType
+	}
+
+	visibilityOrder struct {
+		field      visibilityField
+		descending bool
+	}
+
+	visibilityQueryConverter struct {
+		searchAttributes map[string]enumspb.IndexedValueType
+	}
+
+	visibilityQueryBuilder struct {
+		dialect  VisibilityQueryDialect
+		argStart int
+		args     []interface{}
+		buf      strings.Builder
+	}
+)
+
+const (
+	visibilityQueryMissingValue = "missing"
+)
+
+var (
+	// visibilityColumns maps system search attributes to executions_visibility columns
+	visibilityColumns = map[string]string{
+		definition.NamespaceID:     "namespace_id",
+		definition.WorkflowID:      "workflow_id",
+		definition.RunID:           "run_id",
+		definition.WorkflowType:    "workflow_type_name",
+		definition.StartTime:       "start_time",
+		definition.ExecutionTime:   "execution_time",
+		definition.CloseTime:       "close_time",
+		definition.ExecutionStatus: "status",
+		definition.HistoryLength:   "history_length",
+		definition.TaskQueue:       "task_queue",
+	}
+
+	// nullableVisibilityColumns are the columns which are NULL until the execution is closed
+	nullableVisibilityColumns = map[string]bool{
+		"close_time":     true,
+		"history_length": true,
+	}
+
+	// orderByVisibilityColumns are the columns a query can be ordered by, pages are read with
+	// a keyset on the column and run_id, so only one column is supported
+	orderByVisibilityColumns = map[string]bool{
+		"start_time":     true,
+		"execution_time": true,
+		"close_time":     true,
+	}
+
+	defaultVisibilityOrder = visibilityOrder{field: visibilityField{column: "start_time"}, descending: true}
+
+	searchAttributeNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	errVisibilityQueryNotSelect = errors.New("invalid select query")
+)
+
+// ParseVisibilityQuery parses a visibility query, that is a where clause optionally
+// followed by an order by clause, e.g.
+// WorkflowType = "type" AND CustomIntField > 10 ORDER BY StartTime DESC
+// Custom search attributes may be given with or without the Attr prefix, they must be
+// in searchAttributes and are compared with values of their indexed value type
+func ParseVisibilityQuery(query string, searchAttributes map[string]enumspb.IndexedValueType) (*VisibilityQuery, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return &VisibilityQuery{orderBy: defaultVisibilityOrder}, nil
+	}
+
+	// IMPORTANT: the placeholder select is never executed, it is just used to parse the query
+	var placeholderQuery string
+	if common.IsJustOrderByClause(query) {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy %s", query)
+	} else {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy WHERE %s", query)
+	}
+	stmt, err := sqlparser.Parse(placeholderQuery)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, errVisibilityQueryNotSelect
+	}
+	if sel.Limit != nil || sel.GroupBy != nil || sel.Having != nil {
+		return nil, errVisibilityQueryNotSelect
+	}
+
+	converter := &visibilityQueryConverter{searchAttributes: searchAttributes}
+	result := &VisibilityQuery{orderBy: defaultVisibilityOrder}
+	if sel.Where != nil {
+		if result.where, err = converter.convertExpr(sel.Where.Expr); err != nil {
+			return nil, err
+		}
+	}
+	if len(sel.OrderBy) > 1 {
+		return nil, errors.New("order by supports only one field")
+	}
+	for _, order := range sel.OrderBy {
+		colName, ok := order.Expr.(*sqlparser.ColName)
+		if !ok {
+			return nil, fmt.Errorf("invalid order by expression: %s", sqlparser.String(order.Expr))
+		}
+		field, err := converter.convertField(colName)
+		if err != nil {
+			return nil, err
+		}
+		if !orderByVisibilityColumns[field.column] {
+			return nil, fmt.Errorf("order by %s is not supported, use StartTime, ExecutionTime or CloseTime", sqlparser.String(colName))
+		}
+		result.orderBy = visibilityOrder{
+			field:      field,
+			descending: order.Direction == sqlparser.DescScr,
+		}
+	}
+	return result, nil
+}
+
+// Build translates the query into a SQL condition and an order by list. The condition
+// is empty when the query has no where clause. argStart is the number of arguments
+// already bound in the statement the condition is embedded into
+func (q *VisibilityQuery) Build(dialect VisibilityQueryDialect, argStart int) (condition string, orderBy string, args []interface{}) {
+	b := &visibilityQueryBuilder{dialect: dialect, argStart: argStart}
+	if q.where != nil {
+		q.where.build(b)
+		condition = b.buf.String()
+	}
+	return condition, q.orderBy.build(), b.args
+}
+
+// BuildPage is Build for reading one page of rows in the order of the query. Rows are ordered
+// by the order by column and run_id, after restricts the condition to the rows following this
+// position, so a page is read with an index range instead of an offset
+func (q *VisibilityQuery) BuildPage(dialect VisibilityQueryDialect, argStart int, after *VisibilityQueryCursor) (condition string, orderBy string, args []interface{}) {
+	condition, orderBy, args = q.Build(dialect, argStart)
+	b := &visibilityQueryBuilder{dialect: dialect, argStart: argStart, args: args}
+	var conditions []string
+	if condition != "" {
+		conditions = append(conditions, condition)
+	}
+
+	column := q.orderBy.field.column
+	if nullableVisibilityColumns[column] {
+		// open executions have no close time and are not part of a query ordered by close time
+		conditions = append(conditions, column+" IS NOT NULL")
+	}
+	if after != nil {
+		comparison := ">"
+		if q.orderBy.descending {
+			comparison = "<"
+		}
+		conditions = append(conditions, fmt.Sprintf("%[1]s %[2]s= %[3]s AND (%[1]s %[2]s %[4]s OR run_id > %[5]s)",
+			column, comparison, b.bind(after.Time), b.bind(after.Time), b.bind(after.RunID)))
+	}
+
+	if len(conditions) > 1 && condition != "" {
+		conditions[0] = "(" + conditions[0] + ")"
+	}
+	return strings.Join(conditions, " AND "), orderBy, b.args
+}
+
+// NextCursor returns the position of the row in the order of the query
+func (q *VisibilityQuery) NextCursor(row *VisibilityRow) *VisibilityQueryCursor {
+	cursor := &VisibilityQueryCursor{RunID: row.RunID}
+	switch q.orderBy.field.column {
+	case "execution_time":
+		cursor.Time = row.ExecutionTime
+	case "close_time":
+		if row.CloseTime != nil {
+			cursor.Time = *row.CloseTime
+		}
+	default:
+		cursor.Time = row.StartTime
+	}
+	return cursor
+}
+
+func (o visibilityOrder) build() string {
+	// run_id is the tie breaker which keeps pages stable
+	if o.descending {
+		return o.field.column + " DESC, run_id"
+	}
+	return o.field.column + ", run_id"
+}
+
+func (b *visibilityQueryBuilder) bind(value interface{}) string {
+	b.args = append(b.args, value)
+	return b.dialect.Placeholder(b.argStart + len(b.args))
+}
+
+func (c *visibilityLogicalCondition) build(b *visibilityQueryBuilder) {
+	c.left.build(b)
+	b.buf.WriteString(" " + c.operator + " ")
+	c.right.build(b)
+}
+
+func (c *visibilityParenCondition) build(b *visibilityQueryBuilder) {
+	b.buf.WriteString("(")
+	c.condition.build(b)
+	b.buf.WriteString(")")
+}
+
+func (c *visibilityComparison) build(b *visibilityQueryBuilder) {
+	expr := c.field.expression(b.dialect)
+	if len(c.values) == 0 { // comparison with missing value
+		if c.operator == sqlparser.EqualStr {
+			b.buf.WriteString(expr + " IS NULL")
+		} else {
+			b.buf.WriteString(expr + " IS NOT NULL")
+		}
+		return
+	}
+
+	negated := c.operator == sqlparser.NotEqualStr || c.operator == sqlparser.NotInStr
+	var condition string
+	if column := c.field.columnExpression(b.dialect); column != "" {
+		switch c.operator {
+		case sqlparser.InStr, sqlparser.NotInStr:
+			placeholders := make([]string, len(c.values))
+			for i, value := range c.values {
+				placeholders[i] = b.bind(value)
+			}
+			condition = fmt.Sprintf("%s %s (%s)", column, strings.ToUpper(c.operator), strings.Join(placeholders, ", "))
+		default:
+			condition = fmt.Sprintf("%s %s %s", column, c.operator, b.bind(c.values[0]))
+		}
+		// a row without a value is not equal to any value
+		if negated && (c.field.searchAttribute != "" || nullableVisibilityColumns[column]) {
+			condition = fmt.Sprintf("(%s IS NULL OR %s)", column, condition)
+		}
+		b.buf.WriteString(condition)
+		return
+	}
+
+	switch c.operator {
+	case sqlparser.EqualStr, sqlparser.InStr, sqlparser.NotEqualStr, sqlparser.NotInStr:
+		conditions := make([]string, len(c.values))
+		for i, value := range c.values {
+			conditions[i] = b.dialect.SearchAttributeEquals(c.field.searchAttribute, b.bind(jsonValue(value)))
+		}
+		condition = strings.Join(conditions, " OR ")
+		if negated {
+			// a row without the search attribute is not equal to any value
+			condition = fmt.Sprintf("(%s IS NULL OR NOT (%s))", expr, condition)
+		} else if len(conditions) > 1 {
+			condition = "(" + condition + ")"
+		}
+	default:
+		condition = fmt.Sprintf("%s %s %s", expr, c.operator, b.dialect.JSONValue(b.bind(jsonValue(c.values[0]))))
+	}
+	b.buf.WriteString(condition)
+}
+
+func (f visibilityField) expression(dialect VisibilityQueryDialect) string {
+	if column := f.columnExpression(dialect); column != "" {
+		return column
+	}
+	return dialect.SearchAttribute(f.searchAttribute)
+}
+
+// columnExpression returns the column holding the value of the field, values of custom search
+// attributes are compared as JSON unless the dialect has an indexed column for them
+func (f visibilityField) columnExpression(dialect VisibilityQueryDialect) string {
+	if f.column != "" {
+		return f.column
+	}
+	return dialect.SearchAttributeColumn(f.searchAttribute)
+}
+
+// jsonValue encodes a value of a custom search attribute as JSON, which is how they are stored
+func jsonValue(value interface{}) string {
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
+func (c *visibilityQueryConverter) convertExpr(expr sqlparser.Expr) (visibilityCondition, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return c.convertLogicalExpr("AND", expr.Left, expr.Right)
+	case *sqlparser.OrExpr:
+		return c.convertLogicalExpr("OR", expr.Left, expr.Right)
+	case *sqlparser.ParenExpr:
+		condition, err := c.convertExpr(expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &visibilityParenCondition{condition: condition}, nil
+	case *sqlparser.ComparisonExpr:
+		return c.convertComparisonExpr(expr)
+	case *sqlparser.RangeCond:
+		return c.convertRangeCond(expr)
+	default:
+		return nil, fmt.Errorf("invalid where clause: %s", sqlparser.String(expr))
+	}
+}
+
+func (c *visibilityQueryConverter) convertLogicalExpr(operator string, left sqlparser.Expr, right sqlparser.Expr) (visibilityCondition, error) {
+	leftCondition, err := c.convertExpr(left)
+	if err != nil {
+		return nil, err
+	}
+	rightCondition, err := c.convertExpr(right)
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityLogicalCondition{operator: operator, left: leftCondition, right: rightCondition}, nil
+}
+
+func (c *visibilityQueryConverter) convertComparisonExpr(expr *sqlparser.ComparisonExpr) (visibilityCondition, error) {
+	colName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil, fmt.Errorf("invalid comparison expression: %s", sqlparser.String(expr))
+	}
+	field, err := c.convertField(colName)
+	if err != nil {
+		return nil, err
+	}
+
+	switch expr.Operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr:
+		if isVisibilityMissingValue(expr.Right) {
+			return &visibilityComparison{field: field, operator: expr.Operator}, nil
+		}
+	case sqlparser.LessThanStr, sqlparser.LessEqualStr, sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("invalid value list: %s", sqlparser.String(expr.Right))
+		}
+		values := make([]interface{}, len(tuple))
+		for i, valExpr := range tuple {
+			if values[i], err = convertVisibilityValue(field, valExpr); err != nil {
+				return nil, err
+			}
+		}
+		return &visibilityComparison{field: field, operator: expr.Operator, values: values}, nil
+	default:
+		return nil, fmt.Errorf("operator %s is not supported", expr.Operator)
+	}
+
+	value, err := convertVisibilityValue(field, expr.Right)
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityComparison{field: field, operator: expr.Operator, values: []interface{}{value}}, nil
+}
+
+func (c *visibilityQueryConverter) convertRangeCond(expr *sqlparser.RangeCond) (visibilityCondition, error) {
+	colName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil, fmt.Errorf("invalid range expression: %s", sqlparser.String(expr))
+	}
+	field, err := c.convertField(colName)
+	if err != nil {
+		return nil, err
+	}
+	from, err := convertVisibilityValue(field, expr.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := convertVisibilityValue(field, expr.To)
+	if err != nil {
+		return nil, err
+	}
+
+	var condition visibilityCondition = &visibilityLogicalCondition{
+		operator: "AND",
+		left:     &visibilityComparison{field: field, operator: sqlparser.GreaterEqualStr, values: []interface{}{from}},
+		right:    &visibilityComparison{field: field, operator: sqlparser.LessEqualStr, values: []interface{}{to}},
+	}
+	if expr.Operator == sqlparser.NotBetweenStr {
+		condition = &visibilityLogicalCondition{
+			operator: "OR",
+			left:     &visibilityComparison{field: field, operator: sqlparser.LessThanStr, values: []interface{}{from}},
+			right:    &visibilityComparison{field: field, operator: sqlparser.GreaterThanStr, values: []interface{}{to}},
+		}
+	}
+	return &visibilityParenCondition{condition: condition}, nil
+}
+
+func (c *visibilityQueryConverter) convertField(colName *sqlparser.ColName) (visibilityField, error) {
+	name := colName.Name.String()
+	qualifier := colName.Qualifier.Name.String()
+	switch {
+	case qualifier == definition.Attr:
+	case qualifier != "":
+		return visibilityField{}, fmt.Errorf("invalid search attribute: %s", sqlparser.String(colName))
+	case strings.HasPrefix(name, definition.Attr+"."):
+		name = strings.TrimPrefix(name, definition.Attr+".")
+	default:
+		if definition.IsSystemIndexedKey(name) {
+			column, ok := visibilityColumns[name]
+			if !ok {
+				return visibilityField{}, fmt.Errorf("search attribute %s is not supported", name)
+			}
+			return visibilityField{column: column}, nil
+		}
+	}
+
+	if !searchAttributeNameRegex.MatchString(name) {
+		return visibilityField{}, fmt.Errorf("invalid search attribute: %s", name)
+	}
+	valueType, ok := c.searchAttributes[name]
+	if !ok || definition.IsSystemIndexedKey(name) {
+		return visibilityField{}, fmt.Errorf("search attribute %s is not defined", name)
+	}
+	return visibilityField{searchAttribute: name, valueType: valueType}, nil
+}
+
+func isVisibilityMissingValue(expr sqlparser.Expr) bool {
+	colName, ok := expr.(*sqlparser.ColName)
+	return ok && colName.Qualifier.IsEmpty() && strings.EqualFold(colName.Name.String(), visibilityQueryMissingValue)
+}
+
+// convertVisibilityValue converts a literal of the query into the value bound to the SQL statement
+func convertVisibilityValue(field visibilityField, expr sqlparser.Expr) (interface{}, error) {
+	if field.column == "" {
+		return convertSearchAttributeValue(field, expr)
+	}
+
+	switch field.column {
+	case "start_time", "execution_time", "close_time":
+		return convertVisibilityTimeValue(expr)
+	case "status":
+		return convertVisibilityStatusValue(expr)
+	case "history_length":
+		val, ok := expr.(*sqlparser.SQLVal)
+		if !ok || val.Type != sqlparser.IntVal {
+			return nil, fmt.Errorf("invalid value for %s: %s", field.column, sqlparser.String(expr))
+		}
+		return strconv.ParseInt(string(val.Val), 10, 64)
+	default:
+		val, ok := expr.(*sqlparser.SQLVal)
+		if !ok || val.Type != sqlparser.StrVal {
+			return nil, fmt.Errorf("invalid value for %s: %s", field.column, sqlparser.String(expr))
+		}
+		return string(val.Val), nil
+	}
+}
+
+// convertVisibilityTimeValue accepts either unix nanoseconds or RFC3339 time strings
+func convertVisibilityTimeValue(expr sqlparser.Expr) (time.Time, error) {
+	val, ok := expr.(*sqlparser.SQLVal)
+	if !ok || (val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal) {
+		return time.Time{}, fmt.Errorf("invalid time value: %s", sqlparser.String(expr))
+	}
+	if nanos, err := strconv.ParseInt(string(val.Val), 10, 64); err == nil {
+		return time.Unix(0, nanos).UTC(), nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, string(val.Val))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time value: %s", sqlparser.String(expr))
+	}
+	return t.UTC(), nil
+}
+
+// convertVisibilityStatusValue accepts either the number or the name of a workflow execution status
+func convertVisibilityStatusValue(expr sqlparser.Expr) (interface{}, error) {
+	val, ok := expr.(*sqlparser.SQLVal)
+	if ok && val.Type == sqlparser.IntVal {
+		status, err := strconv.ParseInt(string(val.Val), 10, 32)
+		if err == nil {
+			return int32(status), nil
+		}
+	}
+	if ok && val.Type == sqlparser.StrVal {
+		for name, status := range enumspb.WorkflowExecutionStatus_value {
+			if strings.EqualFold(name, string(val.Val)) {
+				return status, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("invalid workflow execution status: %s", sqlparser.String(expr))
+}
+
+// convertSearchAttributeValue checks that the literal has the indexed value type of the
+// custom search attribute. Datetime values are stored as RFC3339 strings by the SDKs
+func convertSearchAttributeValue(field visibilityField, expr sqlparser.Expr) (interface{}, error) {
+	invalidValueErr := fmt.Errorf("invalid value for search attribute %s of type %s: %s",
+		field.searchAttribute, field.valueType, sqlparser.String(expr))
+
+	if val, ok := expr.(sqlparser.BoolVal); ok {
+		if field.valueType != enumspb.INDEXED_VALUE_TYPE_BOOL {
+			return nil, invalidValueErr
+		}
+		return bool(val), nil
+	}
+	val, ok := expr.(*sqlparser.SQLVal)
+	if !ok {
+		return nil, invalidValueErr
+	}
+	switch field.valueType {
+	case enumspb.INDEXED_VALUE_TYPE_STRING, enumspb.INDEXED_VALUE_TYPE_KEYWORD:
+		if val.Type == sqlparser.StrVal {
+			return string(val.Val), nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		if val.Type == sqlparser.IntVal {
+			if value, err := strconv.ParseInt(string(val.Val), 10, 64); err == nil {
+				return value, nil
+			}
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		if val.Type == sqlparser.IntVal || val.Type == sqlparser.FloatVal {
+			if value, err := strconv.ParseFloat(string(val.Val), 64); err == nil {
+				return value, nil
+			}
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		if t, err := convertVisibilityTimeValue(expr); err == nil {
+			return t.Format(time.RFC3339Nano), nil
+		}
+	}
+	return nil, invalidValueErr
+}
@@ -0,0 +1,217 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/definition"
+)
+
+type (
+	visibilityQuerySuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+
+	testVisibilityQueryDialect struct{}
+)
+
+var testSearchAttributes = map[string]enumspb.IndexedValueType{
+	definition.CustomStringField:   enumspb.INDEXED_VALUE_TYPE_STRING,
+	definition.CustomKeywordField:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	definition.CustomIntField:      enumspb.INDEXED_VALUE_TYPE_INT,
+	definition.CustomDoubleField:   enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+	definition.CustomBoolField:     enumspb.INDEXED_VALUE_TYPE_BOOL,
+	definition.CustomDatetimeField: enumspb.INDEXED_VALUE_TYPE_DATETIME,
+	definition.WorkflowID:          enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+}
+
+func TestVisibilityQuerySuite(t *testing.T) {
+	suite.Run(t, new(visibilityQuerySuite))
+}
+
+func (s *visibilityQuerySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (testVisibilityQueryDialect) Placeholder(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+func (testVisibilityQueryDialect) SearchAttribute(name string) string {
+	return "sa." + name
+}
+
+func (testVisibilityQueryDialect) SearchAttributeEquals(name string, placeholder string) string {
+	return fmt.Sprintf("sa.%s == %s", name, placeholder)
+}
+
+func (testVisibilityQueryDialect) JSONValue(placeholder string) string {
+	return "json(" + placeholder + ")"
+}
+
+func (testVisibilityQueryDialect) SearchAttributeColumn(name string) string {
+	if name == definition.CustomIntField {
+		return "custom_int"
+	}
+	return ""
+}
+
+func (s *visibilityQuerySuite) TestBuild() {
+	startTime := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		query     string
+		condition string
+		orderBy   string
+		args      []interface{}
+	}{
+		{
+			query:   "",
+			orderBy: "start_time DESC, run_id",
+		},
+		{
+			query:     `WorkflowType = "type" and ExecutionStatus = 2`,
+			condition: "workflow_type_name = $2 AND status = $3",
+			orderBy:   "start_time DESC, run_id",
+			args:      []interface{}{"type", int32(2)},
+		},
+		{
+			query:     `ExecutionStatus = "Completed" or CloseTime = missing`,
+			condition: "status = $2 OR close_time IS NULL",
+			orderBy:   "start_time DESC, run_id",
+			args:      []interface{}{int32(2)},
+		},
+		{
+			query:     `StartTime >= "2020-05-01T10:00:00Z" and StartTime < 1588327200000000000`,
+			condition: "start_time >= $2 AND start_time < $3",
+			orderBy:   "start_time DESC, run_id",
+			args:      []interface{}{startTime, startTime},
+		},
+		{
+			query:     `WorkflowId in ("wid1", "wid2") and (RunId != "rid" or HistoryLength between 1 and 10)`,
+			condition: "workflow_id IN ($2, $3) AND (run_id != $4 OR (history_length >= $5 AND history_length <= $6))",
+			orderBy:   "start_time DESC, run_id",
+			args:      []interface{}{"wid1", "wid2", "rid", int64(1), int64(10)},
+		},
+		{
+			query:     `HistoryLength != 10`,
+			condition: "(history_length IS NULL OR history_length != $2)",
+			orderBy:   "start_time DESC, run_id",
+			args:      []interface{}{int64(10)},
+		},
+		{
+			query:     "`Attr.CustomKeywordField` = 'keyword' and Attr.CustomIntField > 10 and CustomBoolField != true",
+			condition: "sa.CustomKeywordField == $2 AND custom_int > $3 AND (sa.CustomBoolField IS NULL OR NOT (sa.CustomBoolField == $4))",
+			orderBy:   "start_time DESC, run_id",
+			args:      []interface{}{`"keyword"`, int64(10), "true"},
+		},
+		{
+			query:     `CustomIntField not in (1, 2) and CustomDatetimeField < 1588327200000000000`,
+			condition: "(custom_int IS NULL OR custom_int NOT IN ($2, $3)) AND sa.CustomDatetimeField < json($4)",
+			orderBy:   "start_time DESC, run_id",
+			args:      []interface{}{int64(1), int64(2), `"2020-05-01T10:00:00Z"`},
+		},
+		{
+			query:     `CustomDoubleField in (1.5, 2) order by ExecutionTime`,
+			condition: "(sa.CustomDoubleField == $2 OR sa.CustomDoubleField == $3)",
+			orderBy:   "execution_time, run_id",
+			args:      []interface{}{"1.5", "2"},
+		},
+		{
+			query:   "order by CloseTime desc",
+			orderBy: "close_time DESC, run_id",
+		},
+	}
+
+	for _, tc := range testCases {
+		query, err := ParseVisibilityQuery(tc.query, testSearchAttributes)
+		s.NoError(err, tc.query)
+		condition, orderBy, args := query.Build(testVisibilityQueryDialect{}, 1)
+		s.Equal(tc.condition, condition, tc.query)
+		s.Equal(tc.orderBy, orderBy, tc.query)
+		s.Equal(tc.args, args, tc.query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestBuildPage() {
+	closeTime := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+	query, err := ParseVisibilityQuery(`WorkflowType = "a" or WorkflowType = "b" order by CloseTime desc`, testSearchAttributes)
+	s.NoError(err)
+
+	condition, orderBy, args := query.BuildPage(testVisibilityQueryDialect{}, 1, nil)
+	s.Equal("(workflow_type_name = $2 OR workflow_type_name = $3) AND close_time IS NOT NULL", condition)
+	s.Equal("close_time DESC, run_id", orderBy)
+	s.Equal([]interface{}{"a", "b"}, args)
+
+	cursor := query.NextCursor(&VisibilityRow{RunID: "rid", StartTime: closeTime.Add(-time.Hour), CloseTime: &closeTime})
+	s.Equal(&VisibilityQueryCursor{Time: closeTime, RunID: "rid"}, cursor)
+	condition, _, args = query.BuildPage(testVisibilityQueryDialect{}, 1, cursor)
+	s.Equal("(workflow_type_name = $2 OR workflow_type_name = $3) AND close_time IS NOT NULL AND "+
+		"close_time <= $4 AND (close_time < $5 OR run_id > $6)", condition)
+	s.Equal([]interface{}{"a", "b", closeTime, closeTime, "rid"}, args)
+
+	query, err = ParseVisibilityQuery("", testSearchAttributes)
+	s.NoError(err)
+	condition, orderBy, args = query.BuildPage(testVisibilityQueryDialect{}, 1, &VisibilityQueryCursor{Time: closeTime, RunID: "rid"})
+	s.Equal("start_time <= $2 AND (start_time < $3 OR run_id > $4)", condition)
+	s.Equal("start_time DESC, run_id", orderBy)
+	s.Equal([]interface{}{closeTime, closeTime, "rid"}, args)
+}
+
+func (s *visibilityQuerySuite) TestParseInvalidQuery() {
+	queries := []string{
+		"WorkflowId",
+		`WorkflowId like "wid%"`,
+		`HistoryLength = "ten"`,
+		`ExecutionStatus = "Unknown"`,
+		`StartTime > "yesterday"`,
+		`KafkaKey = "key"`,
+		`other.CustomIntField = 1`,
+		"`Custom-Field` = 1",
+		`CustomIntField = WorkflowId`,
+		`WorkflowId = "wid" limit 10`,
+		`UnknownField = 1`,
+		`Attr.WorkflowId = "wid"`,
+		`CustomIntField = "ten"`,
+		`CustomIntField = 1.5`,
+		`CustomKeywordField = 1`,
+		`CustomBoolField = "true"`,
+		`CustomDatetimeField > "yesterday"`,
+		`order by CustomIntField`,
+		`order by WorkflowId`,
+		`order by StartTime, CloseTime`,
+	}
+	for _, query := range queries {
+		_, err := ParseVisibilityQuery(query, testSearchAttributes)
+		s.Error(err, query)
+	}
+}
@@ -34,6 +34,7 @@ import (
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/elasticsearch"
+	"go.temporal.io/server/common/largepayload"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/messaging"
@@ -68,7 +69,9 @@ type (
 		PublicClient                 sdkclient.Client
 		ArchivalMetadata             archiver.ArchivalMetadata
 		ArchiverProvider             provider.ArchiverProvider
+		LargePayloadStore            largepayload.Store
 		Authorizer                   authorization.Authorizer
+		CertIdentityExtractor        authorization.CertIdentityExtractor
 	}
 
 	// MembershipMonitorFactory provides a bootstrapped membership monitor
@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/largepayload"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/messaging"
@@ -68,6 +69,7 @@ type (
 		GetPayloadSerializer() persistence.PayloadSerializer
 		GetMetricsClient() metrics.Client
 		GetArchiverProvider() provider.ArchiverProvider
+		GetLargePayloadOffloader() largepayload.Offloader
 		GetMessagingClient() messaging.Client
 
 		// membership infos
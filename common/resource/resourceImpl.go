@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/largepayload"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/loggerimpl"
 	"go.temporal.io/server/common/log/tag"
@@ -81,13 +82,14 @@ type (
 
 		// other common resources
 
-		namespaceCache    cache.NamespaceCache
-		timeSource        clock.TimeSource
-		payloadSerializer persistence.PayloadSerializer
-		metricsClient     metrics.Client
-		messagingClient   messaging.Client
-		archivalMetadata  archiver.ArchivalMetadata
-		archiverProvider  provider.ArchiverProvider
+		namespaceCache        cache.NamespaceCache
+		timeSource            clock.TimeSource
+		payloadSerializer     persistence.PayloadSerializer
+		metricsClient         metrics.Client
+		messagingClient       messaging.Client
+		archivalMetadata      archiver.ArchivalMetadata
+		archiverProvider      provider.ArchiverProvider
+		largePayloadOffloader largepayload.Offloader
 
 		// membership infos
 
@@ -239,6 +241,10 @@ func New(
 		params.MetricsClient,
 		logger,
 	)
+	// dynamic config values constrained to the previous name of a renamed namespace keep applying to it
+	if aliasAware, ok := params.DynamicConfig.(dynamicconfig.NamespaceAliasAware); ok {
+		aliasAware.SetNamespaceAliasResolver(namespaceCache.GetNamespaceAliases)
+	}
 
 	frontendRawClient := clientBean.GetFrontendClient()
 	frontendClient := frontend.NewRetryableClient(
@@ -264,8 +270,11 @@ func New(
 		common.IsWhitelistServiceTransientError,
 	)
 
+	largePayloadOffloader := largepayload.NewOffloader(params.LargePayloadStore)
+
 	historyArchiverBootstrapContainer := &archiver.HistoryBootstrapContainer{
-		HistoryV2Manager: persistenceBean.GetHistoryManager(),
+		// archived histories hold the offloaded payloads, they outlive the blobs deleted with the workflow
+		HistoryV2Manager: largepayload.NewRehydratingHistoryManager(persistenceBean.GetHistoryManager(), largePayloadOffloader),
 		Logger:           logger,
 		MetricsClient:    params.MetricsClient,
 		ClusterMetadata:  params.ClusterMetadata,
@@ -298,13 +307,14 @@ func New(
 
 		// other common resources
 
-		namespaceCache:    namespaceCache,
-		timeSource:        clock.NewRealTimeSource(),
-		payloadSerializer: persistence.NewPayloadSerializer(),
-		metricsClient:     params.MetricsClient,
-		messagingClient:   params.MessagingClient,
-		archivalMetadata:  params.ArchivalMetadata,
-		archiverProvider:  params.ArchiverProvider,
+		namespaceCache:        namespaceCache,
+		timeSource:            clock.NewRealTimeSource(),
+		payloadSerializer:     persistence.NewPayloadSerializer(),
+		metricsClient:         params.MetricsClient,
+		messagingClient:       params.MessagingClient,
+		archivalMetadata:      params.ArchivalMetadata,
+		archiverProvider:      params.ArchiverProvider,
+		largePayloadOffloader: largePayloadOffloader,
 
 		// membership infos
 
@@ -458,6 +468,11 @@ func (h *Impl) GetArchiverProvider() provider.ArchiverProvider {
 	return h.archiverProvider
 }
 
+// GetLargePayloadOffloader return large payload offloader
+func (h *Impl) GetLargePayloadOffloader() largepayload.Offloader {
+	return h.largePayloadOffloader
+}
+
 // membership infos
 
 // GetMembershipMonitor return the membership monitor
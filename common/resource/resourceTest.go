@@ -49,6 +49,7 @@ import (
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/largepayload"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/loggerimpl"
 	"go.temporal.io/server/common/membership"
@@ -67,12 +68,13 @@ type (
 
 		// other common resources
 
-		NamespaceCache    *cache.MockNamespaceCache
-		TimeSource        clock.TimeSource
-		PayloadSerializer persistence.PayloadSerializer
-		MetricsClient     metrics.Client
-		ArchivalMetadata  *archiver.MockArchivalMetadata
-		ArchiverProvider  *provider.MockArchiverProvider
+		NamespaceCache        *cache.MockNamespaceCache
+		TimeSource            clock.TimeSource
+		PayloadSerializer     persistence.PayloadSerializer
+		MetricsClient         metrics.Client
+		ArchivalMetadata      *archiver.MockArchivalMetadata
+		ArchiverProvider      *provider.MockArchiverProvider
+		LargePayloadOffloader largepayload.Offloader
 
 		// membership infos
 
@@ -177,12 +179,13 @@ func NewTest(
 
 		// other common resources
 
-		NamespaceCache:    cache.NewMockNamespaceCache(controller),
-		TimeSource:        clock.NewRealTimeSource(),
-		PayloadSerializer: persistence.NewPayloadSerializer(),
-		MetricsClient:     metrics.NewClient(scope, serviceMetricsIndex),
-		ArchivalMetadata:  &archiver.MockArchivalMetadata{},
-		ArchiverProvider:  &provider.MockArchiverProvider{},
+		NamespaceCache:        cache.NewMockNamespaceCache(controller),
+		TimeSource:            clock.NewRealTimeSource(),
+		PayloadSerializer:     persistence.NewPayloadSerializer(),
+		MetricsClient:         metrics.NewClient(scope, serviceMetricsIndex),
+		ArchivalMetadata:      &archiver.MockArchivalMetadata{},
+		ArchiverProvider:      &provider.MockArchiverProvider{},
+		LargePayloadOffloader: largepayload.NewOffloader(nil),
 
 		// membership infos
 
@@ -288,6 +291,11 @@ func (s *Test) GetArchiverProvider() provider.ArchiverProvider {
 	return s.ArchiverProvider
 }
 
+// GetLargePayloadOffloader for testing
+func (s *Test) GetLargePayloadOffloader() largepayload.Offloader {
+	return s.LargePayloadOffloader
+}
+
 // membership infos
 
 // GetMembershipMonitor for testing
@@ -25,26 +25,75 @@
 package encryption
 
 import (
+	"bytes"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"sync"
+	"time"
 
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/service/config"
 )
 
 var _ CertProvider = (*localStoreCertProvider)(nil)
+var _ CertExpirationChecker = (*localStoreCertProvider)(nil)
 
-type localStoreCertProvider struct {
-	sync.RWMutex
+type (
+	localStoreCertProvider struct {
+		sync.RWMutex
 
-	tlsSettings *config.GroupTLS
+		tlsSettings     *config.GroupTLS
+		refreshInterval time.Duration
+		metricsClient   metrics.Client
+		logger          log.Logger
 
-	serverCert *tls.Certificate
-	clientCAs  *x509.CertPool
-	serverCAs  *x509.CertPool
+		certs *loadedCerts
+
+		refreshOnce sync.Once
+		stopOnce    sync.Once
+		stopC       chan struct{}
+	}
+
+	// loadedCerts is a consistent snapshot of all the certificates of a provider
+	loadedCerts struct {
+		serverCert    *tls.Certificate
+		serverLeaf    *x509.Certificate
+		clientCAs     *x509.CertPool
+		clientCACerts []*x509.Certificate
+		serverCAs     *x509.CertPool
+		serverCACerts []*x509.Certificate
+
+		// raw holds the PEM content the certificates were loaded from, it is used to detect rotation
+		raw []byte
+	}
+)
+
+func newLocalStoreCertProvider(
+	tlsSettings *config.GroupTLS,
+	refreshInterval time.Duration,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *localStoreCertProvider {
+	return &localStoreCertProvider{
+		tlsSettings:     tlsSettings,
+		refreshInterval: refreshInterval,
+		metricsClient:   metricsClient,
+		logger:          logger,
+		stopC:           make(chan struct{}),
+	}
+}
+
+// Stop stops the background refresh of certificates
+func (s *localStoreCertProvider) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopC)
+	})
 }
 
 func (s *localStoreCertProvider) GetSettings() *config.GroupTLS {
@@ -52,99 +101,253 @@ func (s *localStoreCertProvider) GetSettings() *config.GroupTLS {
 }
 
 func (s *localStoreCertProvider) FetchServerCertificate() (*tls.Certificate, error) {
-	if s.tlsSettings.Server.CertFile == "" {
+	if s.tlsSettings.Server.CertFile == "" && s.tlsSettings.Server.CertData == "" {
 		return nil, nil
 	}
-
-	// Check under a read lock first
-	s.RLock()
-	if s.serverCert != nil {
-		defer s.RUnlock()
-		return s.serverCert, nil
+	certs, err := s.getCerts()
+	if err != nil {
+		return nil, err
 	}
-	// Not found, manually unlock read lock and move to write lock
-	s.RUnlock()
-	s.Lock()
-	defer s.Unlock()
+	return certs.serverCert, nil
+}
 
-	// Get serverCert from disk
-	serverCert, err := tls.LoadX509KeyPair(s.tlsSettings.Server.CertFile, s.tlsSettings.Server.KeyFile)
+func (s *localStoreCertProvider) FetchClientCAs() (*x509.CertPool, error) {
+	if s.tlsSettings.Server.ClientCAFiles == nil && s.tlsSettings.Server.ClientCAData == nil {
+		return nil, nil
+	}
+	certs, err := s.getCerts()
 	if err != nil {
-		return nil, fmt.Errorf("loading server tls certificate failed: %v", err)
+		return nil, err
 	}
-
-	s.serverCert = &serverCert
-	return s.serverCert, nil
+	return certs.clientCAs, nil
 }
 
-func (s *localStoreCertProvider) FetchClientCAs() (*x509.CertPool, error) {
-	if s.tlsSettings.Server.ClientCAFiles == nil {
+func (s *localStoreCertProvider) FetchServerRootCAsForClient() (*x509.CertPool, error) {
+	if s.tlsSettings.Client.RootCAFiles == nil && s.tlsSettings.Client.RootCAData == nil {
 		return nil, nil
 	}
+	certs, err := s.getCerts()
+	if err != nil {
+		return nil, err
+	}
+	return certs.serverCAs, nil
+}
+
+// GetExpiringCerts returns the certificates that expire within the given time window, and the ones that already expired
+func (s *localStoreCertProvider) GetExpiringCerts(timeWindow time.Duration) (expiring []CertExpirationData, expired []CertExpirationData, err error) {
+	certs, err := s.getCerts()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now().UTC()
+	check := func(cert *x509.Certificate) {
+		if cert == nil {
+			return
+		}
+		data := CertExpirationData{
+			Subject:    cert.Subject.String(),
+			IsCA:       cert.IsCA,
+			Expiration: cert.NotAfter,
+		}
+		if now.After(cert.NotAfter) {
+			expired = append(expired, data)
+		} else if now.Add(timeWindow).After(cert.NotAfter) {
+			expiring = append(expiring, data)
+		}
+	}
 
+	check(certs.serverLeaf)
+	for _, cert := range certs.clientCACerts {
+		check(cert)
+	}
+	for _, cert := range certs.serverCACerts {
+		check(cert)
+	}
+	return expiring, expired, nil
+}
+
+// getCerts returns the current certificates, loading them on first use
+func (s *localStoreCertProvider) getCerts() (*loadedCerts, error) {
 	// Check under a read lock first
 	s.RLock()
-	if s.clientCAs != nil {
+	if s.certs != nil {
 		defer s.RUnlock()
-		return s.clientCAs, nil
+		return s.certs, nil
 	}
 	// Not found, manually unlock read lock and move to write lock
 	s.RUnlock()
 	s.Lock()
 	defer s.Unlock()
+	// Check if someone got here first while waiting for write lock
+	if s.certs != nil {
+		return s.certs, nil
+	}
 
-	var clientCaPool *x509.CertPool
-	if len(s.tlsSettings.Server.ClientCAFiles) > 0 {
-		var err error
-		clientCaPool, err = buildCAPool(s.tlsSettings.Server.ClientCAFiles)
-		if err != nil {
-			return nil, err
-		}
+	certs, err := s.loadCerts()
+	if err != nil {
+		return nil, err
 	}
+	s.certs = certs
+	s.startRefresh()
+	return s.certs, nil
+}
 
-	s.clientCAs = clientCaPool
-	return s.clientCAs, nil
+// startRefresh starts the background refresh of certificates, if enabled
+func (s *localStoreCertProvider) startRefresh() {
+	if s.refreshInterval <= 0 {
+		return
+	}
+	s.refreshOnce.Do(func() {
+		go s.refreshLoop()
+	})
 }
 
-func (s *localStoreCertProvider) FetchServerRootCAsForClient() (*x509.CertPool, error) {
-	if s.tlsSettings.Client.RootCAFiles == nil {
-		return nil, nil
+func (s *localStoreCertProvider) refreshLoop() {
+	ticker := time.NewTicker(s.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopC:
+			return
+		case <-ticker.C:
+			s.refresh()
+		}
 	}
-	// Check under a read lock first
-	s.RLock()
-	if s.serverCAs != nil {
-		defer s.RUnlock()
-		return s.clientCAs, nil
+}
+
+// refresh reloads the certificates and swaps them in if they changed. The certificates currently
+// in use are kept if loading fails, so that a partially written file never breaks new connections.
+func (s *localStoreCertProvider) refresh() {
+	certs, err := s.loadCerts()
+	if err != nil {
+		s.metricsClient.IncCounter(metrics.ServerTLSScope, metrics.TLSCertReloadFailedCounter)
+		s.logger.Error("failed to reload TLS certificates, keeping the current ones", tag.Error(err))
+		return
 	}
-	// Not found, manually unlock read lock and move to write lock
-	s.RUnlock()
+
 	s.Lock()
 	defer s.Unlock()
+	if s.certs != nil && bytes.Equal(s.certs.raw, certs.raw) {
+		return
+	}
+	s.certs = certs
+	s.metricsClient.IncCounter(metrics.ServerTLSScope, metrics.TLSCertReloadCounter)
+	s.logger.Info("reloaded TLS certificates")
+}
 
-	var serverCAPool *x509.CertPool
-	if len(s.tlsSettings.Client.RootCAFiles) > 0 {
-		var err error
-		serverCAPool, err = buildCAPool(s.tlsSettings.Client.RootCAFiles)
+func (s *localStoreCertProvider) loadCerts() (*loadedCerts, error) {
+	var raw bytes.Buffer
+	certs := &loadedCerts{}
+
+	server := s.tlsSettings.Server
+	certPEM, err := readPEM(server.CertFile, server.CertData, "server certificate")
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := readPEM(server.KeyFile, server.KeyData, "server key")
+	if err != nil {
+		return nil, err
+	}
+	if certPEM != nil {
+		serverCert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("loading server tls certificate failed: %v", err)
+		}
+		certs.serverLeaf, err = x509.ParseCertificate(serverCert.Certificate[0])
+		if err != nil {
+			return nil, fmt.Errorf("parsing server tls certificate failed: %v", err)
+		}
+		certs.serverCert = &serverCert
+		raw.Write(certPEM)
+		raw.Write(keyPEM)
+	}
+
+	if len(server.ClientCAFiles) > 0 || len(server.ClientCAData) > 0 {
+		certs.clientCAs, certs.clientCACerts, err = buildCAPool(server.ClientCAFiles, server.ClientCAData, &raw)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	s.serverCAs = serverCAPool
-	return s.serverCAs, nil
+	client := s.tlsSettings.Client
+	if len(client.RootCAFiles) > 0 || len(client.RootCAData) > 0 {
+		certs.serverCAs, certs.serverCACerts, err = buildCAPool(client.RootCAFiles, client.RootCAData, &raw)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	certs.raw = raw.Bytes()
+	return certs, nil
 }
 
-func buildCAPool(caFiles []string) (*x509.CertPool, error) {
-	caPool := x509.NewCertPool()
+// readPEM reads PEM content either from the given file or the inline data, it is an error to specify both
+func readPEM(file string, data string, name string) ([]byte, error) {
+	switch {
+	case file != "" && data != "":
+		return nil, fmt.Errorf("only one of file or data can be specified for %v", name)
+	case file != "":
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading %v: %v", name, err)
+		}
+		return content, nil
+	case data != "":
+		return []byte(data), nil
+	default:
+		return nil, nil
+	}
+}
+
+func buildCAPool(caFiles []string, caData []string, raw *bytes.Buffer) (*x509.CertPool, []*x509.Certificate, error) {
+	var caPEMs [][]byte
 	for _, ca := range caFiles {
 		caBytes, err := ioutil.ReadFile(ca)
 		if err != nil {
-			return nil, fmt.Errorf("failed reading client ca cert: %v", err)
+			return nil, nil, fmt.Errorf("failed reading client ca cert: %v", err)
+		}
+		caPEMs = append(caPEMs, caBytes)
+	}
+	for _, ca := range caData {
+		caPEMs = append(caPEMs, []byte(ca))
+	}
+
+	caPool := x509.NewCertPool()
+	var caCerts []*x509.Certificate
+	for _, caBytes := range caPEMs {
+		certs, err := parseCertificates(caBytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(certs) == 0 {
+			return nil, nil, errors.New("unknown failure constructing cert pool for ca")
 		}
+		for _, cert := range certs {
+			caPool.AddCert(cert)
+		}
+		caCerts = append(caCerts, certs...)
+		raw.Write(caBytes)
+	}
+	return caPool, caCerts, nil
+}
 
-		if !caPool.AppendCertsFromPEM(caBytes) {
-			return nil, errors.New("unknown failure constructing cert pool for ca")
+func parseCertificates(pemBytes []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for len(pemBytes) > 0 {
+		var block *pem.Block
+		block, pemBytes = pem.Decode(pemBytes)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" || len(block.Headers) != 0 {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing ca cert: %v", err)
 		}
+		certs = append(certs, cert)
 	}
-	return caPool, nil
+	return certs, nil
 }
@@ -27,9 +27,14 @@ package encryption
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/service/config"
 )
 
@@ -45,15 +50,56 @@ type localStoreTlsProvider struct {
 	internodeClientConfig *tls.Config
 	frontendServerConfig  *tls.Config
 	frontendClientConfig  *tls.Config
+
+	metricsClient metrics.Client
+	logger        log.Logger
+
+	stopOnce sync.Once
+	stopC    chan struct{}
+}
+
+// stoppableCertProvider is implemented by cert providers which run background work
+type stoppableCertProvider interface {
+	Stop()
 }
 
-func NewLocalStoreTlsProvider(tlsConfig *config.RootTLS) (TLSConfigProvider, error) {
-	return &localStoreTlsProvider{
-		internodeCertProvider: &localStoreCertProvider{tlsSettings: &tlsConfig.Internode},
-		frontendCertProvider:  &localStoreCertProvider{tlsSettings: &tlsConfig.Frontend},
+func NewLocalStoreTlsProvider(
+	tlsConfig *config.RootTLS,
+	metricsClient metrics.Client,
+	logger log.Logger,
+	certProviderFactory CertProviderFactory,
+) (TLSConfigProvider, error) {
+	if certProviderFactory == nil {
+		certProviderFactory = func(tlsSettings *config.GroupTLS, metricsClient metrics.Client, logger log.Logger) CertProvider {
+			return newLocalStoreCertProvider(tlsSettings, tlsConfig.RefreshInterval, metricsClient, logger)
+		}
+	}
+
+	provider := &localStoreTlsProvider{
+		internodeCertProvider: certProviderFactory(&tlsConfig.Internode, metricsClient, logger),
+		frontendCertProvider:  certProviderFactory(&tlsConfig.Frontend, metricsClient, logger),
 		RWMutex:               sync.RWMutex{},
 		settings:              tlsConfig,
-	}, nil
+		metricsClient:         metricsClient,
+		logger:                logger,
+		stopC:                 make(chan struct{}),
+	}
+	if tlsConfig.ExpirationChecks.CheckInterval > 0 {
+		go provider.checkExpirationLoop()
+	}
+	return provider, nil
+}
+
+// Stop stops the expiration checks and the background refresh of certificates
+func (s *localStoreTlsProvider) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopC)
+		for _, provider := range []CertProvider{s.internodeCertProvider, s.frontendCertProvider} {
+			if stoppable, ok := provider.(stoppableCertProvider); ok {
+				stoppable.Stop()
+			}
+		}
+	})
 }
 
 func (s *localStoreTlsProvider) GetInternodeClientConfig() (*tls.Config, error) {
@@ -108,7 +154,73 @@ func (s *localStoreTlsProvider) getOrCreateConfig(
 	return *cachedConfig, nil
 }
 
+func (s *localStoreTlsProvider) checkExpirationLoop() {
+	ticker := time.NewTicker(s.settings.ExpirationChecks.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopC:
+			return
+		case <-ticker.C:
+			s.checkExpiration()
+		}
+	}
+}
+
+// checkExpiration emits metrics on the number of expired and expiring certificates,
+// it logs a warning for certificates within the warning window and an error for the
+// ones within the error window or already expired
+func (s *localStoreTlsProvider) checkExpiration() {
+	checks := s.settings.ExpirationChecks
+	var nExpiring, nExpired int
+	for _, provider := range []CertProvider{s.internodeCertProvider, s.frontendCertProvider} {
+		checker, ok := provider.(CertExpirationChecker)
+		if !ok || !provider.GetSettings().IsEnabled() {
+			continue
+		}
+
+		expiring, expired, err := checker.GetExpiringCerts(checks.WarningWindow)
+		if err != nil {
+			s.logger.Error("failed to check certificate expiration", tag.Error(err))
+			continue
+		}
+		nExpiring += len(expiring)
+		nExpired += len(expired)
+
+		now := time.Now().UTC()
+		for _, cert := range expiring {
+			logTags := []tag.Tag{tag.Key(cert.Subject), tag.Bool(cert.IsCA), tag.Timestamp(cert.Expiration)}
+			if cert.Expiration.Before(now.Add(checks.ErrorWindow)) {
+				s.logger.Error("certificate is about to expire", logTags...)
+			} else {
+				s.logger.Warn("certificate is about to expire", logTags...)
+			}
+		}
+		for _, cert := range expired {
+			s.logger.Error("certificate has expired", tag.Key(cert.Subject), tag.Bool(cert.IsCA), tag.Timestamp(cert.Expiration))
+		}
+	}
+	s.metricsClient.UpdateGauge(metrics.ServerTLSScope, metrics.TLSCertsExpiring, float64(nExpiring))
+	s.metricsClient.UpdateGauge(metrics.ServerTLSScope, metrics.TLSCertsExpired, float64(nExpired))
+}
+
+// newServerTLSConfig builds a server config which fetches the certificate and client CAs
+// from the cert provider on every handshake, so that rotated certificates are picked up
+// by new connections without affecting established ones
 func newServerTLSConfig(certProvider CertProvider, settingsProvider CertProvider) (*tls.Config, error) {
+	// Build the config once upfront to fail fast on a bad certificate
+	serverConfig, err := getServerTLSConfigFromCertProvider(certProvider, settingsProvider)
+	if err != nil || serverConfig == nil {
+		return serverConfig, err
+	}
+
+	serverConfig.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		return getServerTLSConfigFromCertProvider(certProvider, settingsProvider)
+	}
+	return serverConfig, nil
+}
+
+func getServerTLSConfigFromCertProvider(certProvider CertProvider, settingsProvider CertProvider) (*tls.Config, error) {
 	// Get serverCert from disk
 	serverCert, err := certProvider.FetchServerCertificate()
 	if err != nil {
@@ -144,6 +256,11 @@ func newServerTLSConfig(certProvider CertProvider, settingsProvider CertProvider
 	}, nil
 }
 
+// newClientTLSConfig builds a client config which fetches the client certificate and the
+// server root CAs from the cert provider on every handshake. The crypto/tls client reads
+// RootCAs once, so with a server name the server certificate is verified by the config
+// against the current root CAs instead. Without one the root CAs are read once, gRPC
+// only picks the server name per connection.
 func newClientTLSConfig(localProvider CertProvider, remoteProvider CertProvider) (*tls.Config, error) {
 	// Optional ServerCA for client if not already trusted by host
 	serverCa, err := remoteProvider.FetchServerRootCAsForClient()
@@ -151,8 +268,20 @@ func newClientTLSConfig(localProvider CertProvider, remoteProvider CertProvider)
 		return nil, fmt.Errorf("failed to load client ca: %v", err)
 	}
 
+	serverName := remoteProvider.GetSettings().Client.ServerName
+	clientConfig := &tls.Config{
+		RootCAs:    serverCa,
+		ServerName: serverName,
+	}
+	if serverCa != nil && serverName != "" {
+		// #nosec the server certificate chain and name are verified by VerifyPeerCertificate
+		clientConfig.InsecureSkipVerify = true
+		clientConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return verifyServerCertificate(rawCerts, remoteProvider, serverName)
+		}
+	}
+
 	// mTLS enabled, present certificate
-	var clientCerts []tls.Certificate
 	if remoteProvider.GetSettings().Server.RequireClientAuth {
 		cert, err := localProvider.FetchServerCertificate()
 		if err != nil {
@@ -162,12 +291,39 @@ func newClientTLSConfig(localProvider CertProvider, remoteProvider CertProvider)
 		if cert == nil {
 			return nil, fmt.Errorf("client auth required, but no certificate provided")
 		}
-		clientCerts = []tls.Certificate{*cert}
+		clientConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return localProvider.FetchServerCertificate()
+		}
 	}
 
-	return &tls.Config{
-		Certificates: clientCerts,
-		RootCAs:      serverCa,
-		ServerName:   remoteProvider.GetSettings().Client.ServerName,
-	}, nil
+	return clientConfig, nil
+}
+
+// verifyServerCertificate verifies the certificate chain presented by a server against the
+// current root CAs of the cert provider, the same way crypto/tls does with RootCAs
+func verifyServerCertificate(rawCerts [][]byte, provider CertProvider, serverName string) error {
+	serverCa, err := provider.FetchServerRootCAsForClient()
+	if err != nil {
+		return fmt.Errorf("failed to load client ca: %v", err)
+	}
+	if len(rawCerts) == 0 {
+		return errors.New("server presented no certificate")
+	}
+
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, rawCert := range rawCerts {
+		if certs[i], err = x509.ParseCertificate(rawCert); err != nil {
+			return fmt.Errorf("failed to parse server certificate: %v", err)
+		}
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = certs[0].Verify(x509.VerifyOptions{
+		Roots:         serverCa,
+		DNSName:       serverName,
+		Intermediates: intermediates,
+	})
+	return err
 }
@@ -27,7 +27,10 @@ package encryption
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"time"
 
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/service/config"
 )
 
@@ -38,9 +41,13 @@ type (
 		GetInternodeClientConfig() (*tls.Config, error)
 		GetFrontendServerConfig() (*tls.Config, error)
 		GetFrontendClientConfig() (*tls.Config, error)
+		// Stop stops any background work of the provider, like the refresh of certificates
+		Stop()
 	}
 
 	// CertProvider is a common interface to load raw TLS/X509 primitives.
+	// The Fetch methods are invoked on every TLS handshake, so a provider
+	// can rotate certificates by returning new values from them.
 	CertProvider interface {
 		FetchServerCertificate() (*tls.Certificate, error)
 		FetchClientCAs() (*x509.CertPool, error)
@@ -48,6 +55,22 @@ type (
 		GetSettings() *config.GroupTLS
 	}
 
+	// CertExpirationChecker is optionally implemented by a CertProvider to report on the expiration of its certificates.
+	CertExpirationChecker interface {
+		GetExpiringCerts(timeWindow time.Duration) (expiring []CertExpirationData, expired []CertExpirationData, err error)
+	}
+
+	// CertExpirationData contains the expiration details of a single certificate
+	CertExpirationData struct {
+		Subject    string
+		IsCA       bool
+		Expiration time.Time
+	}
+
+	// CertProviderFactory creates a CertProvider for the given TLS settings, it allows plugging in
+	// certificates that are kept in memory or supplied by an external system instead of the local store.
+	CertProviderFactory func(tlsSettings *config.GroupTLS, metricsClient metrics.Client, logger log.Logger) CertProvider
+
 	tlsConfigConstructor func(localProvider CertProvider, settingsProvider CertProvider) (*tls.Config, error)
 
 	providerType string
@@ -58,8 +81,14 @@ const (
 	providerTypeSelfSigned providerType = "selfsigned"
 )
 
-// NewTLSConfigProviderFromConfig creates a new TLS Config provider from RootTLS config
-func NewTLSConfigProviderFromConfig(encryptionSettings config.RootTLS) (TLSConfigProvider, error) {
+// NewTLSConfigProviderFromConfig creates a new TLS Config provider from RootTLS config.
+// Certificates are read from the local store unless a certProviderFactory is given.
+func NewTLSConfigProviderFromConfig(
+	encryptionSettings config.RootTLS,
+	metricsClient metrics.Client,
+	logger log.Logger,
+	certProviderFactory CertProviderFactory,
+) (TLSConfigProvider, error) {
 	/* if || encryptionSettings.Provider == ""  {
 		return nil, nil
 	}
@@ -69,7 +98,7 @@ func NewTLSConfigProviderFromConfig(encryptionSettings config.RootTLS) (TLSConfi
 	case providerTypeSelfSigned:
 		return NewSelfSignedTlsFactory(encryptionSettings, hostname)
 	case providerTypeLocalStore:*/
-	return NewLocalStoreTlsProvider(&encryptionSettings)
+	return NewLocalStoreTlsProvider(&encryptionSettings, metricsClient, logger, certProviderFactory)
 	//}
 
 	//return nil, fmt.Errorf("unknown provider: %v", encryptionSettings.Provider)
@@ -27,6 +27,7 @@ package rpc
 import (
 	"context"
 	"crypto/tls"
+	"io"
 	"time"
 
 	"github.com/gogo/status"
@@ -74,6 +75,7 @@ func Dial(hostName string, tlsConfig *tls.Config) (*grpc.ClientConn, error) {
 		grpc.WithChainUnaryInterceptor(
 			versionHeadersInterceptor,
 			errorInterceptor),
+		grpc.WithChainStreamInterceptor(errorStreamInterceptor),
 		grpc.WithDefaultServiceConfig(DefaultServiceConfig),
 		grpc.WithDisableServiceConfig(),
 		grpc.WithConnectParams(cp),
@@ -86,6 +88,27 @@ func errorInterceptor(ctx context.Context, method string, req, reply interface{}
 	return err
 }
 
+func errorStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, serviceerrors.FromStatus(status.Convert(err))
+	}
+	return &errorClientStream{ClientStream: stream}, nil
+}
+
+// errorClientStream converts the errors received on a stream, like errorInterceptor does for unary calls
+type errorClientStream struct {
+	grpc.ClientStream
+}
+
+func (s *errorClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == nil || err == io.EOF {
+		return err
+	}
+	return serviceerrors.FromStatus(status.Convert(err))
+}
+
 func versionHeadersInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 	ctx = headers.PropagateVersions(ctx)
 	return invoker(ctx, method, req, reply, cc, opts...)
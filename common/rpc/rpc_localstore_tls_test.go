@@ -26,17 +26,21 @@ package rpc
 
 import (
 	"bytes"
+	"crypto/tls"
 	"crypto/x509"
 	"encoding/pem"
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/service/config"
 )
@@ -45,7 +49,8 @@ type localStoreRPCSuite struct {
 	*require.Assertions
 	suite.Suite
 
-	logger log.Logger
+	logger        log.Logger
+	metricsClient metrics.Client
 
 	insecureRPCFactory           *TestFactory
 	internodeMutualTLSRPCFactory *TestFactory
@@ -77,8 +82,9 @@ func (s *localStoreRPCSuite) TearDownSuite() {
 func (s *localStoreRPCSuite) SetupSuite() {
 	s.Assertions = require.New(s.T())
 	s.logger = loggerimpl.NewDevelopmentForTest(s.Suite)
+	s.metricsClient = metrics.NewClient(tally.NoopScope, metrics.Frontend)
 
-	provider, err := encryption.NewTLSConfigProviderFromConfig(serverCfgInsecure.TLS)
+	provider, err := encryption.NewTLSConfigProviderFromConfig(serverCfgInsecure.TLS, s.metricsClient, s.logger, nil)
 	s.NoError(err)
 	insecureFactory := NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
 	s.NotNil(insecureFactory)
@@ -135,12 +141,12 @@ func (s *localStoreRPCSuite) setupFrontend(internodeChain CertChain, frontendCha
 		},
 	}
 
-	provider, err := encryption.NewTLSConfigProviderFromConfig(localStoreMutualTLS.TLS)
+	provider, err := encryption.NewTLSConfigProviderFromConfig(localStoreMutualTLS.TLS, s.metricsClient, s.logger, nil)
 	s.NoError(err)
 	frontendMutualTLSFactory := NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
 	s.NotNil(frontendMutualTLSFactory)
 
-	provider, err = encryption.NewTLSConfigProviderFromConfig(localStoreServerTLS.TLS)
+	provider, err = encryption.NewTLSConfigProviderFromConfig(localStoreServerTLS.TLS, s.metricsClient, s.logger, nil)
 	s.NoError(err)
 	frontendServerTLSFactory := NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
 	s.NoError(err)
@@ -202,12 +208,12 @@ func (s *localStoreRPCSuite) setupInternode(internodeChain CertChain, frontendCh
 		},
 	}
 
-	provider, err := encryption.NewTLSConfigProviderFromConfig(localStoreMutualTLS.TLS)
+	provider, err := encryption.NewTLSConfigProviderFromConfig(localStoreMutualTLS.TLS, s.metricsClient, s.logger, nil)
 	s.NoError(err)
 	internodeMutualTLSFactory := NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
 	s.NotNil(internodeMutualTLSFactory)
 
-	provider, err = encryption.NewTLSConfigProviderFromConfig(localStoreServerTLS.TLS)
+	provider, err = encryption.NewTLSConfigProviderFromConfig(localStoreServerTLS.TLS, s.metricsClient, s.logger, nil)
 	s.NoError(err)
 	internodeServerTLSFactory := NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
 	s.NoError(err)
@@ -293,3 +299,102 @@ func (s *localStoreRPCSuite) TestMutualTLSButClientNoCert() {
 func (s *localStoreRPCSuite) TestServerTLSButClientAddsCert() {
 	runHelloWorldTest(s.Suite, s.internodeServerTLSRPCFactory, s.internodeMutualTLSRPCFactory, true)
 }
+
+func (s *localStoreRPCSuite) TestServerTLSInlineCertData() {
+	certData, err := ioutil.ReadFile(s.frontendChain.CertPubFile)
+	s.NoError(err)
+	keyData, err := ioutil.ReadFile(s.frontendChain.CertKeyFile)
+	s.NoError(err)
+
+	provider, err := encryption.NewTLSConfigProviderFromConfig(config.RootTLS{
+		Frontend: config.GroupTLS{
+			Server: config.ServerTLS{
+				CertData: string(certData),
+				KeyData:  string(keyData),
+			},
+		},
+	}, s.metricsClient, s.logger, nil)
+	s.NoError(err)
+	inlineFactory := NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	s.NotNil(inlineFactory)
+
+	runHelloWorldTest(s.Suite, f(inlineFactory), s.internodeServerTLSRPCFactory, true)
+}
+
+func (s *localStoreRPCSuite) TestServerCertReload() {
+	certDir, err := ioutil.TempDir("", "localStoreRPCSuiteReload")
+	s.NoError(err)
+	defer func() { _ = os.RemoveAll(certDir) }()
+	chain := s.GenerateTestChain(certDir)
+
+	provider, err := encryption.NewTLSConfigProviderFromConfig(config.RootTLS{
+		Frontend: config.GroupTLS{
+			Server: config.ServerTLS{
+				CertFile: chain.CertPubFile,
+				KeyFile:  chain.CertKeyFile,
+			},
+		},
+		RefreshInterval: 10 * time.Millisecond,
+	}, s.metricsClient, s.logger, nil)
+	s.NoError(err)
+	serverConfig, err := provider.GetFrontendServerConfig()
+	s.NoError(err)
+	initialCert := s.handshakeCertificate(serverConfig)
+
+	// rotate the certificate in place, new handshakes must pick it up without rebuilding the config
+	s.GenerateTestChain(certDir)
+	s.Eventually(func() bool {
+		return !bytes.Equal(initialCert, s.handshakeCertificate(serverConfig))
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func (s *localStoreRPCSuite) handshakeCertificate(serverConfig *tls.Config) []byte {
+	handshakeConfig, err := serverConfig.GetConfigForClient(&tls.ClientHelloInfo{})
+	s.NoError(err)
+	return handshakeConfig.Certificates[0].Certificate[0]
+}
+
+func (s *localStoreRPCSuite) TestClientRootCAReload() {
+	certDir, err := ioutil.TempDir("", "localStoreRPCSuiteRootCAReload")
+	s.NoError(err)
+	defer func() { _ = os.RemoveAll(certDir) }()
+	chain := s.GenerateTestChain(certDir)
+	initialCert := s.readCertificate(chain.CertPubFile)
+
+	provider, err := encryption.NewTLSConfigProviderFromConfig(config.RootTLS{
+		Internode: config.GroupTLS{
+			Server: config.ServerTLS{
+				CertFile: chain.CertPubFile,
+				KeyFile:  chain.CertKeyFile,
+			},
+			Client: config.ClientTLS{
+				ServerName:  "localhost",
+				RootCAFiles: []string{chain.CaPubFile},
+			},
+		},
+		RefreshInterval: 10 * time.Millisecond,
+	}, s.metricsClient, s.logger, nil)
+	s.NoError(err)
+	defer provider.Stop()
+	clientConfig, err := provider.GetInternodeClientConfig()
+	s.NoError(err)
+	s.NoError(clientConfig.VerifyPeerCertificate([][]byte{initialCert}, nil))
+
+	// rotate the CA in place, new handshakes must trust the new CA only
+	chain = s.GenerateTestChain(certDir)
+	rotatedCert := s.readCertificate(chain.CertPubFile)
+	s.Eventually(func() bool {
+		return clientConfig.VerifyPeerCertificate([][]byte{rotatedCert}, nil) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	s.Error(clientConfig.VerifyPeerCertificate([][]byte{initialCert}, nil))
+
+	provider.Stop()
+}
+
+func (s *localStoreRPCSuite) readCertificate(file string) []byte {
+	data, err := ioutil.ReadFile(file)
+	s.NoError(err)
+	block, _ := pem.Decode(data)
+	s.NotNil(block)
+	return block.Bytes
+}
@@ -68,8 +68,13 @@ type (
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
 		// Filepath should be relative to the root directory
 		DynamicConfigClient dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
+		// DynamicConfigHTTPProvider is the config for polling dynamic config from a config service,
+		// it takes precedence over DynamicConfigClient when set
+		DynamicConfigHTTPProvider *dynamicconfig.HTTPProviderConfig `yaml:"dynamicConfigHTTPProvider"`
 		// NamespaceDefaults is the default config for every namespace
 		NamespaceDefaults NamespaceDefaults `yaml:"namespaceDefaults"`
+		// LargePayload is the config for the blob store holding the payloads offloaded from workflow histories
+		LargePayload LargePayload `yaml:"largePayload"`
 	}
 
 	// Service contains the service specific config items
@@ -116,6 +121,21 @@ type (
 		Internode GroupTLS `yaml:"internode"`
 		// Frontend controls SDK Client to Frontend communication TLS settings.
 		Frontend GroupTLS `yaml:"frontend"`
+		// RefreshInterval is the interval at which certificate and CA files are re-read, so that
+		// rotated certificates are picked up without a restart. Reloading is disabled if zero.
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// ExpirationChecks controls the periodic checks for expiring certificates.
+		ExpirationChecks CertExpirationValidation `yaml:"expirationChecks"`
+	}
+
+	// CertExpirationValidation contains settings for periodic checks of TLS certificate expiration
+	CertExpirationValidation struct {
+		// WarningWindow is the time window before certificate expiration when warnings start being logged.
+		WarningWindow time.Duration `yaml:"warningWindow"`
+		// ErrorWindow is the time window before certificate expiration when errors start being logged.
+		ErrorWindow time.Duration `yaml:"errorWindow"`
+		// CheckInterval is the interval between checks for certificate expiration. Checks are disabled if zero.
+		CheckInterval time.Duration `yaml:"checkInterval"`
 	}
 
 	// GroupTLS contains an instance client and server TLS settings
@@ -137,6 +157,13 @@ type (
 		ClientCAFiles []string `yaml:"clientCaFiles"`
 		// Requires clients to authenticate with a certificate when connecting, otherwise known as mutual TLS.
 		RequireClientAuth bool `yaml:"requireClientAuth"`
+
+		// The PEM-encoded public key of the certificate to use, as an alternative to CertFile.
+		CertData string `yaml:"certData"`
+		// The PEM-encoded private key of the certificate to use, as an alternative to KeyFile. It is never printed as part of the config.
+		KeyData string `yaml:"keyData" json:"-"`
+		// A list of PEM-encoded public keys of the client Certificate Authorities, as an alternative to ClientCAFiles.
+		ClientCAData []string `yaml:"clientCaData"`
 	}
 
 	// ClientTLS contains TLS configuration for clients.
@@ -148,6 +175,8 @@ type (
 
 		// Optional - A list of paths to files containing the PEM-encoded public key of the Certificate Authorities you wish to trust.
 		RootCAFiles []string `yaml:"rootCaFiles"`
+		// Optional - A list of PEM-encoded public keys of the Certificate Authorities you wish to trust, as an alternative to RootCAFiles.
+		RootCAData []string `yaml:"rootCaData"`
 	}
 
 	// Membership contains config items related to the membership layer of temporal
@@ -180,6 +209,17 @@ type (
 		VisibilityConfig *VisibilityConfig `yaml:"-" json:"-"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// PayloadEncryption is the config for encrypting payloads at rest, disabled if not set
+		PayloadEncryption *PayloadEncryption `yaml:"payloadEncryption"`
+	}
+
+	// PayloadEncryption is the config for encrypting the payloads of persisted
+	// history events and mutable state. All services of all clusters sharing
+	// replicated data must use the same keys.
+	PayloadEncryption struct {
+		// KeyFile is the path of the file holding the key encryption keys,
+		// see serialization.NewLocalFileKeyProvider for its format
+		KeyFile string `yaml:"keyFile"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -300,6 +340,37 @@ type (
 		Level string `yaml:"level"`
 		// OutputFile is the path to the log output file
 		OutputFile string `yaml:"outputFile"`
+		// Encoding is the log encoding, either json (default) or console
+		Encoding string `yaml:"encoding"`
+		// ComponentLevels overrides Level for loggers tagged with the given component,
+		// e.g. shard, matching-engine or persistence
+		ComponentLevels map[string]string `yaml:"componentLevels"`
+		// Sampling limits the number of identical log entries emitted per second, no sampling if not set
+		Sampling *LogSampling `yaml:"sampling"`
+		// Rotation is the rotation policy for OutputFile, the file is never rotated if not set
+		Rotation *LogRotation `yaml:"rotation"`
+		// RedactPayloads replaces the value of tags carrying user payloads (e.g. memo, search attributes) in log entries
+		RedactPayloads bool `yaml:"redactPayloads"`
+	}
+
+	// LogSampling contains the config items for log sampling
+	LogSampling struct {
+		// Initial is the number of identical entries logged every second before sampling kicks in
+		Initial int `yaml:"initial"`
+		// Thereafter logs every Thereafter-th identical entry after Initial is reached
+		Thereafter int `yaml:"thereafter"`
+	}
+
+	// LogRotation contains the config items for log file rotation
+	LogRotation struct {
+		// MaxSizeMB is the size in megabytes at which the log file is rotated, defaults to 100
+		MaxSizeMB int `yaml:"maxSizeMB"`
+		// MaxBackups is the maximum number of rotated files to keep, all are kept if 0
+		MaxBackups int `yaml:"maxBackups"`
+		// MaxAgeDays is the maximum number of days to keep rotated files, all are kept if 0
+		MaxAgeDays int `yaml:"maxAgeDays"`
+		// Compress determines if rotated files are gzipped
+		Compress bool `yaml:"compress"`
 	}
 
 	// ClusterMetadata contains the all cluster which participated in cross DC
@@ -356,6 +427,8 @@ type (
 		Statsd *Statsd `yaml:"statsd"`
 		// Prometheus is the configuration for prometheus reporter
 		Prometheus *prometheus.Configuration `yaml:"prometheus"`
+		// PrometheusSettings contains temporal specific settings for the prometheus reporter
+		PrometheusSettings *PrometheusSettings `yaml:"prometheusSettings"`
 		// Tags is the set of key-value pairs to be reported
 		// as part of every metric
 		Tags map[string]string `yaml:"tags"`
@@ -363,6 +436,19 @@ type (
 		Prefix string `yaml:"prefix"`
 	}
 
+	// PrometheusSettings contains temporal specific settings for the prometheus reporter
+	PrometheusSettings struct {
+		// HistogramBuckets overrides the histogram buckets (in seconds) per timer name,
+		// the name must match the emitted metric name including any prefix
+		HistogramBuckets map[string][]float64 `yaml:"histogramBuckets"`
+		// EnableGoCollector registers the standard go runtime and process collectors,
+		// they are exposed on the same endpoint as the rest of the metrics
+		EnableGoCollector bool `yaml:"enableGoCollector"`
+		// MaxTagValues limits the number of distinct values reported per tag key (e.g. namespace, taskqueue, queryType),
+		// values beyond the limit are reported as "_overflow_"
+		MaxTagValues map[string]int `yaml:"maxTagValues"`
+	}
+
 	// Statsd contains the config items for statsd metrics reporter
 	Statsd struct {
 		// The host and port of the statsd server
@@ -401,6 +487,7 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 		S3store   *S3Archiver        `yaml:"s3store"`
+		Azblob    *AzblobArchiver    `yaml:"azblob"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -418,6 +505,7 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		S3store   *S3Archiver        `yaml:"s3store"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		Azblob    *AzblobArchiver    `yaml:"azblob"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
@@ -433,9 +521,42 @@ type (
 
 	// S3Archiver contains the config for S3 archiver
 	S3Archiver struct {
-		Region           string  `yaml:"region"`
-		Endpoint         *string `yaml:"endpoint"`
-		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
+		// Region is required unless a custom endpoint is set, S3 compatible stores usually ignore it
+		Region string `yaml:"region"`
+		// Endpoint is an optional custom endpoint of an S3 compatible store, e.g. http://127.0.0.1:9000 for MinIO
+		Endpoint *string `yaml:"endpoint"`
+		// S3ForcePathStyle addresses buckets as http://endpoint/bucket instead of http://bucket.endpoint
+		S3ForcePathStyle bool `yaml:"s3ForcePathStyle"`
+		// AccessKeyID and SecretAccessKey are optional static credentials, the default AWS credential chain is used if not set
+		AccessKeyID     string `yaml:"accessKeyId"`
+		SecretAccessKey string `yaml:"secretAccessKey"`
+		// SessionToken is an optional session token of temporary static credentials
+		SessionToken string `yaml:"sessionToken"`
+	}
+
+	// LargePayload contains the config for the blob store holding the large payloads offloaded from workflow
+	// histories, offloading itself is enabled per namespace by the history.largePayloadThreshold dynamic config
+	LargePayload struct {
+		// URI is the location of the blob store, file:///path for the filestore or s3://bucket/prefix for S3,
+		// large payloads are not offloaded if it is not set
+		URI string `yaml:"uri"`
+		// Filestore contains the file and directory modes of the filestore
+		Filestore *FilestoreArchiver `yaml:"filestore"`
+		// S3store contains the config of the S3 connection
+		S3store *S3Archiver `yaml:"s3store"`
+	}
+
+	// AzblobArchiver contains the config for Azure Blob Storage archiver
+	AzblobArchiver struct {
+		// AccountName is the name of the storage account
+		AccountName string `yaml:"accountName"`
+		// AccountKey is the shared key of the storage account, AZURE_STORAGE_KEY environment variable is used if not set
+		AccountKey string `yaml:"accountKey"`
+		// SASToken is a shared access signature used instead of the account key
+		SASToken string `yaml:"sasToken"`
+		// Endpoint is an optional blob service endpoint, e.g. http://127.0.0.1:10000/devstoreaccount1 for Azurite,
+		// defaults to https://<accountName>.blob.core.windows.net
+		Endpoint string `yaml:"endpoint"`
 	}
 
 	// PublicClient is config for connecting to temporal frontend
@@ -492,5 +613,5 @@ func (c *Config) String() string {
 }
 
 func (r *GroupTLS) IsEnabled() bool {
-	return r.Server.KeyFile != ""
+	return r.Server.KeyFile != "" || r.Server.KeyData != ""
 }
@@ -27,16 +27,44 @@ package config
 import (
 	"os"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"go.temporal.io/server/common/log/loggerimpl"
 )
 
-const fileMode = os.FileMode(0644)
+const (
+	fileMode = os.FileMode(0644)
+
+	encodingConsole = "console"
+
+	defaultRotationMaxSizeMB = 100
+)
 
 // NewZapLogger builds and returns a new zap
 // logger for this logging configuration
 func (cfg *Logger) NewZapLogger() *zap.Logger {
+	return cfg.NewZapLoggerWithLevels(cfg.NewLevels())
+}
+
+// NewLevels returns the log levels described by
+// this logging configuration, the levels can be
+// updated at runtime
+func (cfg *Logger) NewLevels() *loggerimpl.Levels {
+	componentLevels := make(map[string]zapcore.Level, len(cfg.ComponentLevels))
+	for component, level := range cfg.ComponentLevels {
+		componentLevels[component] = parseZapLevel(level)
+	}
+	return loggerimpl.NewLevels(parseZapLevel(cfg.Level), componentLevels, cfg.RedactPayloads)
+}
+
+// NewZapLoggerWithLevels builds and returns a new zap
+// logger for this logging configuration, whose levels
+// are controlled by the given levels
+func (cfg *Logger) NewZapLoggerWithLevels(levels *loggerimpl.Levels) *zap.Logger {
 	encodeConfig := zapcore.EncoderConfig{
 		TimeKey:        "ts",
 		LevelKey:       "level",
@@ -51,6 +79,39 @@ func (cfg *Logger) NewZapLogger() *zap.Logger {
 		EncodeCaller:   nil,
 	}
 
+	var encoder zapcore.Encoder
+	if strings.ToLower(cfg.Encoding) == encodingConsole {
+		encoder = zapcore.NewConsoleEncoder(encodeConfig)
+	} else {
+		encoder = zapcore.NewJSONEncoder(encodeConfig)
+	}
+
+	output := cfg.newOutput()
+	core := zapcore.NewCore(encoder, output, zap.DebugLevel)
+	core = levels.WrapRedactionCore(core)
+	if cfg.Sampling != nil {
+		core = zapcore.NewSampler(core, time.Second, cfg.Sampling.Initial, cfg.Sampling.Thereafter)
+	}
+	core = levels.WrapCore(core)
+
+	return zap.New(core, zap.ErrorOutput(output), zap.AddStacktrace(zap.ErrorLevel))
+}
+
+func (cfg *Logger) newOutput() zapcore.WriteSyncer {
+	if len(cfg.OutputFile) > 0 && !cfg.Stdout && cfg.Rotation != nil {
+		maxSize := cfg.Rotation.MaxSizeMB
+		if maxSize <= 0 {
+			maxSize = defaultRotationMaxSizeMB
+		}
+		return zapcore.AddSync(&lumberjack.Logger{
+			Filename:   cfg.OutputFile,
+			MaxSize:    maxSize,
+			MaxBackups: cfg.Rotation.MaxBackups,
+			MaxAge:     cfg.Rotation.MaxAgeDays,
+			Compress:   cfg.Rotation.Compress,
+		})
+	}
+
 	outputPath := "stderr"
 	if len(cfg.OutputFile) > 0 {
 		outputPath = cfg.OutputFile
@@ -58,18 +119,11 @@ func (cfg *Logger) NewZapLogger() *zap.Logger {
 			outputPath = "stdout"
 		}
 	}
-
-	config := zap.Config{
-		Level:            zap.NewAtomicLevelAt(parseZapLevel(cfg.Level)),
-		Development:      false,
-		Sampling:         nil, // consider exposing this to config for our external customer
-		Encoding:         "json",
-		EncoderConfig:    encodeConfig,
-		OutputPaths:      []string{outputPath},
-		ErrorOutputPaths: []string{outputPath},
+	output, _, err := zap.Open(outputPath)
+	if err != nil {
+		return zapcore.Lock(os.Stderr)
 	}
-	logger, _ := config.Build()
-	return logger
+	return output
 }
 
 func parseZapLevel(level string) zapcore.Level {
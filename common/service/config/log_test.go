@@ -72,3 +72,30 @@ func (s *LogSuite) TestNewLogger() {
 	_, err = os.Stat(dir + "/test.log")
 	s.Nil(err)
 }
+
+func (s *LogSuite) TestNewLogger_ConsoleWithRotation() {
+	dir, err := ioutil.TempDir("", "config.testNewLoggerConsoleWithRotation")
+	s.Nil(err)
+	defer os.RemoveAll(dir)
+
+	config := &Logger{
+		Level:           "warn",
+		OutputFile:      dir + "/test.log",
+		Encoding:        "console",
+		ComponentLevels: map[string]string{"shard": "debug"},
+		Sampling:        &LogSampling{Initial: 10, Thereafter: 10},
+		Rotation:        &LogRotation{MaxSizeMB: 1, MaxBackups: 1},
+	}
+
+	log := config.NewZapLogger()
+	s.NotNil(log)
+	log.Info("dropped")
+	log.With(zap.String("component", "shard")).Debug("emitted")
+	s.NoError(log.Sync())
+
+	content, err := ioutil.ReadFile(dir + "/test.log")
+	s.Nil(err)
+	s.NotContains(string(content), "dropped")
+	s.Contains(string(content), "emitted")
+	s.Contains(string(content), "\tdebug\temitted\t")
+}
@@ -25,6 +25,7 @@
 package config
 
 import (
+	"os"
 	"time"
 
 	"github.com/cactus/go-statsd-client/statsd"
@@ -35,6 +36,7 @@ import (
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	prometheusreporter "go.temporal.io/server/common/metrics/tally/prometheus"
 	statsdreporter "go.temporal.io/server/common/metrics/tally/statsd"
 )
 
@@ -128,17 +130,30 @@ func (c *Metrics) newStatsdScope(logger log.Logger) tally.Scope {
 // newPrometheusScope returns a new prometheus scope with
 // a default reporting interval of a second
 func (c *Metrics) newPrometheusScope(logger log.Logger) tally.Scope {
+	onError := func(err error) {
+		logger.Warn("error in prometheus reporter", tag.Error(err))
+	}
+	registry := prom.NewRegistry()
 	reporter, err := c.Prometheus.NewReporter(
 		prometheus.ConfigurationOptions{
-			Registry: prom.NewRegistry(),
-			OnError: func(err error) {
-				logger.Warn("error in prometheus reporter", tag.Error(err))
-			},
+			Registry: registry,
+			OnError:  onError,
 		},
 	)
 	if err != nil {
 		logger.Fatal("error creating prometheus reporter", tag.Error(err))
 	}
+	if settings := c.PrometheusSettings; settings != nil {
+		if settings.EnableGoCollector {
+			registry.MustRegister(prom.NewGoCollector())
+			registry.MustRegister(prom.NewProcessCollector(os.Getpid(), ""))
+		}
+		reporter = prometheusreporter.NewReporter(reporter, prometheusreporter.Options{
+			HistogramBuckets: settings.HistogramBuckets,
+			MaxTagValues:     settings.MaxTagValues,
+			OnError:          onError,
+		})
+	}
 	scopeOpts := tally.ScopeOptions{
 		Tags:            c.Tags,
 		CachedReporter:  reporter,
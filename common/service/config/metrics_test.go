@@ -85,6 +85,23 @@ func (s *MetricsSuite) TestPrometheus() {
 	s.NotNil(scope)
 }
 
+func (s *MetricsSuite) TestPrometheusSettings() {
+	prom := &prometheus.Configuration{
+		OnError:       "panic",
+		TimerType:     "summary",
+		ListenAddress: "127.0.0.1:0",
+	}
+	config := new(Metrics)
+	config.Prometheus = prom
+	config.PrometheusSettings = &PrometheusSettings{
+		HistogramBuckets:  map[string][]float64{"service_latency": {0.01, 0.1, 1}},
+		EnableGoCollector: true,
+		MaxTagValues:      map[string]int{"namespace": 100},
+	}
+	scope := config.NewScope(loggerimpl.NewNopLogger())
+	s.NotNil(scope)
+}
+
 func (s *MetricsSuite) TestNoop() {
 	config := &Metrics{}
 	scope := config.NewScope(loggerimpl.NewNopLogger())
@@ -78,6 +78,11 @@ func (c *Persistence) IsAdvancedVisibilityConfigExist() bool {
 	return len(c.AdvancedVisibilityStore) != 0
 }
 
+// IsSQLVisibilityStore returns whether the visibility store is a SQL database
+func (c *Persistence) IsSQLVisibilityStore() bool {
+	return c.DataStores[c.VisibilityStore].SQL != nil
+}
+
 // GetConsistency returns the gosql.Consistency setting from the configuration for the given store type
 func (c *CassandraStoreConsistency) GetConsistency() gocql.Consistency {
 	return gocql.ParseConsistency(c.getConsistencySettings().Consistency)
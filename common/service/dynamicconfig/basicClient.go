@@ -0,0 +1,283 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"go.temporal.io/server/common/log"
+)
+
+// NamespaceAliasResolver returns the unexpired aliases of a namespace, the previous names which still resolve to it
+type NamespaceAliasResolver func(namespace string) []string
+
+// NamespaceAliasAware is implemented by the clients which resolve values constrained to a namespace name through
+// the aliases of the namespace, so the values keep applying to a namespace after it is renamed.
+type NamespaceAliasAware interface {
+	// SetNamespaceAliasResolver sets the resolver of the namespace aliases
+	SetNamespaceAliasResolver(resolver NamespaceAliasResolver)
+}
+
+type constrainedValue struct {
+	Value       interface{}
+	Constraints map[string]interface{}
+}
+
+// basicClient serves values from the latest snapshot of dynamic config,
+// it is shared by all clients which load the whole config at once
+type basicClient struct {
+	values        atomic.Value
+	logger        log.Logger
+	aliasResolver atomic.Value // NamespaceAliasResolver
+
+	callbackLock sync.RWMutex
+	callbacks    []func()
+}
+
+func newBasicClient(logger log.Logger) *basicClient {
+	client := &basicClient{
+		logger: logger,
+	}
+	client.values.Store(make(map[string][]*constrainedValue))
+	return client
+}
+
+func (bc *basicClient) GetValue(name Key, defaultValue interface{}) (interface{}, error) {
+	return bc.getValueWithFilters(name, nil, defaultValue)
+}
+
+func (bc *basicClient) GetValueWithFilters(name Key, filters map[Filter]interface{}, defaultValue interface{}) (interface{}, error) {
+	return bc.getValueWithFilters(name, filters, defaultValue)
+}
+
+func (bc *basicClient) GetIntValue(name Key, filters map[Filter]interface{}, defaultValue int) (int, error) {
+	val, err := bc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	if intVal, ok := val.(int); ok {
+		return intVal, nil
+	}
+	return defaultValue, errors.New("value type is not int")
+}
+
+func (bc *basicClient) GetFloatValue(name Key, filters map[Filter]interface{}, defaultValue float64) (float64, error) {
+	val, err := bc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	if floatVal, ok := val.(float64); ok {
+		return floatVal, nil
+	} else if intVal, ok := val.(int); ok {
+		return float64(intVal), nil
+	}
+	return defaultValue, errors.New("value type is not float64")
+}
+
+func (bc *basicClient) GetBoolValue(name Key, filters map[Filter]interface{}, defaultValue bool) (bool, error) {
+	val, err := bc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	if boolVal, ok := val.(bool); ok {
+		return boolVal, nil
+	}
+	return defaultValue, errors.New("value type is not bool")
+}
+
+func (bc *basicClient) GetStringValue(name Key, filters map[Filter]interface{}, defaultValue string) (string, error) {
+	val, err := bc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	if stringVal, ok := val.(string); ok {
+		return stringVal, nil
+	}
+	return defaultValue, errors.New("value type is not string")
+}
+
+func (bc *basicClient) GetMapValue(
+	name Key, filters map[Filter]interface{}, defaultValue map[string]interface{},
+) (map[string]interface{}, error) {
+	val, err := bc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+	if mapVal, ok := val.(map[string]interface{}); ok {
+		return mapVal, nil
+	}
+	return defaultValue, errors.New("value type is not map")
+}
+
+func (bc *basicClient) GetDurationValue(
+	name Key, filters map[Filter]interface{}, defaultValue time.Duration,
+) (time.Duration, error) {
+	val, err := bc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	durationString, ok := val.(string)
+	if !ok {
+		return defaultValue, errors.New("value type is not string")
+	}
+
+	durationVal, err := time.ParseDuration(durationString)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to parse duration: %v", err)
+	}
+	return durationVal, nil
+}
+
+func (bc *basicClient) updateContent(content []byte) error {
+	newValues := make(map[string][]*constrainedValue)
+	if err := yaml.Unmarshal(content, newValues); err != nil {
+		return fmt.Errorf("failed to decode dynamic config %v", err)
+	}
+	return bc.storeValues(newValues)
+}
+
+func (bc *basicClient) storeValues(newValues map[string][]*constrainedValue) error {
+	if err := normalizeValues(newValues); err != nil {
+		return err
+	}
+
+	bc.values.Store(newValues)
+	bc.logger.Info("Updated dynamic config")
+
+	bc.callbackLock.RLock()
+	callbacks := bc.callbacks
+	bc.callbackLock.RUnlock()
+	for _, callback := range callbacks {
+		callback()
+	}
+	return nil
+}
+
+// normalizeValues converts the values decoded from yaml to the types used by the client and validates them
+func normalizeValues(values map[string][]*constrainedValue) error {
+	// yaml will unmarshal map into map[interface{}]interface{} instead of map[string]interface{}
+	// manually convert key type to string for all values here
+	// We don't need to convert constraints as their type can't be map. If user does use a map as filter
+	// value, it won't match anyway.
+	for _, s := range values {
+		for _, cv := range s {
+			var err error
+			cv.Value, err = convertKeyTypeToString(cv.Value)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return validateValues(values)
+}
+
+func (bc *basicClient) AddUpdateCallback(callback func()) {
+	bc.callbackLock.Lock()
+	defer bc.callbackLock.Unlock()
+	bc.callbacks = append(bc.callbacks, callback)
+}
+
+// SetNamespaceAliasResolver sets the resolver of the namespace aliases, values constrained to a namespace name
+// which is not matched exactly are matched against the aliases of the namespace in order
+func (bc *basicClient) SetNamespaceAliasResolver(resolver NamespaceAliasResolver) {
+	bc.aliasResolver.Store(resolver)
+}
+
+func (bc *basicClient) getValueWithFilters(key Key, filters map[Filter]interface{}, defaultValue interface{}) (interface{}, error) {
+	keyName := keys[key]
+	values := bc.values.Load().(map[string][]*constrainedValue)
+	found := false
+	for _, constrainedValue := range values[keyName] {
+		if len(constrainedValue.Constraints) == 0 {
+			// special handling for default value (value without any constraints)
+			defaultValue = constrainedValue.Value
+			found = true
+			continue
+		}
+		if match(constrainedValue, filters) {
+			return constrainedValue.Value, nil
+		}
+	}
+	for _, aliasFilters := range bc.namespaceAliasFilters(filters) {
+		for _, constrainedValue := range values[keyName] {
+			if len(constrainedValue.Constraints) != 0 && match(constrainedValue, aliasFilters) {
+				return constrainedValue.Value, nil
+			}
+		}
+	}
+	if !found {
+		return defaultValue, errors.New("unable to find key")
+	}
+	return defaultValue, nil
+}
+
+// namespaceAliasFilters returns the filters with the namespace replaced by each of its aliases
+func (bc *basicClient) namespaceAliasFilters(filters map[Filter]interface{}) []map[Filter]interface{} {
+	namespace, ok := filters[Namespace].(string)
+	if !ok || namespace == "" {
+		return nil
+	}
+	resolver, ok := bc.aliasResolver.Load().(NamespaceAliasResolver)
+	if !ok || resolver == nil {
+		return nil
+	}
+
+	var aliasFilters []map[Filter]interface{}
+	for _, alias := range resolver(namespace) {
+		f := make(map[Filter]interface{}, len(filters))
+		for filter, value := range filters {
+			f[filter] = value
+		}
+		f[Namespace] = alias
+		aliasFilters = append(aliasFilters, f)
+	}
+	return aliasFilters
+}
+
+// match will return true if the constraints matches the filters exactly
+func match(v *constrainedValue, filters map[Filter]interface{}) bool {
+	if len(v.Constraints) != len(filters) {
+		return false
+	}
+
+	for filter, filterValue := range filters {
+		if v.Constraints[filter.String()] != filterValue {
+			return false
+		}
+	}
+	return true
+}
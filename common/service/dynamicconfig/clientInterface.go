@@ -30,8 +30,8 @@ import (
 	"time"
 )
 
-// Client allows fetching values from a dynamic configuration system NOTE: This does not have async
-// options right now. In the interest of keeping it minimal, we can add when requirement arises.
+// Client allows fetching values from a dynamic configuration system. Clients which also implement
+// UpdateNotifier allow Collection to cache values and notify subscribers about changes.
 type Client interface {
 	GetValue(name Key, defaultValue interface{}) (interface{}, error)
 	GetValueWithFilters(name Key, filters map[Filter]interface{}, defaultValue interface{}) (interface{}, error)
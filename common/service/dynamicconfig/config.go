@@ -42,12 +42,17 @@ const (
 
 // NewCollection creates a new collection
 func NewCollection(client Client, logger log.Logger) *Collection {
-	return &Collection{
-		client:   client,
-		logger:   logger,
-		keys:     &sync.Map{},
-		errCount: -1,
+	collection := &Collection{
+		client:        client,
+		logger:        logger,
+		keys:          &sync.Map{},
+		errCount:      -1,
+		subscriptions: make(map[*subscription]struct{}),
 	}
+	if notifier, ok := client.(UpdateNotifier); ok {
+		notifier.AddUpdateCallback(collection.onClientUpdate)
+	}
+	return collection
 }
 
 // Collection wraps dynamic config client with a closure so that across the code, the config values
@@ -58,6 +63,10 @@ type Collection struct {
 	logger   log.Logger
 	keys     *sync.Map // map of config Key to strongly typed value
 	errCount int64
+	version  int64 // incremented every time the client values are updated
+
+	subscriptionLock sync.Mutex
+	subscriptions    map[*subscription]struct{}
 }
 
 func (c *Collection) logError(key Key, err error) {
@@ -131,6 +140,9 @@ type StringPropertyFnWithNamespaceFilter func(namespace string) string
 // MapPropertyFnWithNamespaceFilter is a wrapper to get map property from dynamic config
 type MapPropertyFnWithNamespaceFilter func(namespace string) map[string]interface{}
 
+// MapPropertyFnWithNamespaceIDFilter is a wrapper to get map property from dynamic config
+type MapPropertyFnWithNamespaceIDFilter func(id string) map[string]interface{}
+
 // BoolPropertyFnWithNamespaceFilter is a wrapper to get bool property from dynamic config
 type BoolPropertyFnWithNamespaceFilter func(namespace string) bool
 
@@ -142,13 +154,17 @@ type BoolPropertyFnWithTaskQueueInfoFilters func(namespace string, taskQueue str
 
 // GetProperty gets a interface property and returns defaultValue if property is not found
 func (c *Collection) GetProperty(key Key, defaultValue interface{}) PropertyFn {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func() interface{} {
-		val, err := c.client.GetValue(key, defaultValue)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, reflect.DeepEqual)
-		return val
+		return cache.load(noFilters, func() interface{} {
+			val, err := c.client.GetValue(key, defaultValue)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, reflect.DeepEqual)
+			return val
+		})
 	}
 }
 
@@ -163,252 +179,344 @@ func getFilterMap(opts ...FilterOption) map[Filter]interface{} {
 
 // GetIntProperty gets property and asserts that it's an integer
 func (c *Collection) GetIntProperty(key Key, defaultValue int) IntPropertyFn {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(opts ...FilterOption) int {
-		val, err := c.client.GetIntValue(key, getFilterMap(opts...), defaultValue)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, intCompareEquals)
-		return val
+		return cache.load(filterKey(opts), func() interface{} {
+			val, err := c.client.GetIntValue(key, getFilterMap(opts...), defaultValue)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, intCompareEquals)
+			return val
+		}).(int)
 	}
 }
 
 // GetIntPropertyFilteredByNamespace gets property with namespace filter and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByNamespace(key Key, defaultValue int) IntPropertyFnWithNamespaceFilter {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(namespace string) int {
-		val, err := c.client.GetIntValue(key, getFilterMap(NamespaceFilter(namespace)), defaultValue)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, intCompareEquals)
-		return val
+		return cache.load(namespace, func() interface{} {
+			val, err := c.client.GetIntValue(key, getFilterMap(NamespaceFilter(namespace)), defaultValue)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, intCompareEquals)
+			return val
+		}).(int)
 	}
 }
 
 // GetIntPropertyFilteredByTaskQueueInfo gets property with taskQueueInfo as filters and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByTaskQueueInfo(key Key, defaultValue int) IntPropertyFnWithTaskQueueInfoFilters {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) int {
-		val, err := c.client.GetIntValue(
-			key,
-			getFilterMap(NamespaceFilter(namespace), TaskQueueFilter(taskQueue), TaskTypeFilter(taskType)),
-			defaultValue,
-		)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, intCompareEquals)
-		return val
+		return cache.load(taskQueueFilterKey{namespace, taskQueue, taskType}, func() interface{} {
+			val, err := c.client.GetIntValue(
+				key,
+				getFilterMap(NamespaceFilter(namespace), TaskQueueFilter(taskQueue), TaskTypeFilter(taskType)),
+				defaultValue,
+			)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, intCompareEquals)
+			return val
+		}).(int)
 	}
 }
 
 // GetIntPropertyFilteredByShardID gets property with shardID as filter and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByShardID(key Key, defaultValue int) IntPropertyFnWithShardIDFilter {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(shardID int) int {
-		val, err := c.client.GetIntValue(
-			key,
-			getFilterMap(ShardIDFilter(shardID)),
-			defaultValue,
-		)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, intCompareEquals)
-		return val
+		return cache.load(shardID, func() interface{} {
+			val, err := c.client.GetIntValue(
+				key,
+				getFilterMap(ShardIDFilter(shardID)),
+				defaultValue,
+			)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, intCompareEquals)
+			return val
+		}).(int)
 	}
 }
 
 // GetFloat64Property gets property and asserts that it's a float64
 func (c *Collection) GetFloat64Property(key Key, defaultValue float64) FloatPropertyFn {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(opts ...FilterOption) float64 {
-		val, err := c.client.GetFloatValue(key, getFilterMap(opts...), defaultValue)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, float64CompareEquals)
-		return val
+		return cache.load(filterKey(opts), func() interface{} {
+			val, err := c.client.GetFloatValue(key, getFilterMap(opts...), defaultValue)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, float64CompareEquals)
+			return val
+		}).(float64)
 	}
 }
 
 // GetFloat64PropertyFilteredByShardID gets property with shardID filter and asserts that it's a float64
 func (c *Collection) GetFloat64PropertyFilteredByShardID(key Key, defaultValue float64) FloatPropertyFnWithShardIDFilter {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(shardID int) float64 {
-		val, err := c.client.GetFloatValue(
-			key,
-			getFilterMap(ShardIDFilter(shardID)),
-			defaultValue,
-		)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, float64CompareEquals)
-		return val
+		return cache.load(shardID, func() interface{} {
+			val, err := c.client.GetFloatValue(
+				key,
+				getFilterMap(ShardIDFilter(shardID)),
+				defaultValue,
+			)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, float64CompareEquals)
+			return val
+		}).(float64)
 	}
 }
 
 // GetDurationProperty gets property and asserts that it's a duration
 func (c *Collection) GetDurationProperty(key Key, defaultValue time.Duration) DurationPropertyFn {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(opts ...FilterOption) time.Duration {
-		val, err := c.client.GetDurationValue(key, getFilterMap(opts...), defaultValue)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, durationCompareEquals)
-		return val
+		return cache.load(filterKey(opts), func() interface{} {
+			val, err := c.client.GetDurationValue(key, getFilterMap(opts...), defaultValue)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, durationCompareEquals)
+			return val
+		}).(time.Duration)
 	}
 }
 
 // GetDurationPropertyFilteredByNamespace gets property with namespace filter and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByNamespace(key Key, defaultValue time.Duration) DurationPropertyFnWithNamespaceFilter {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(namespace string) time.Duration {
-		val, err := c.client.GetDurationValue(key, getFilterMap(NamespaceFilter(namespace)), defaultValue)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, durationCompareEquals)
-		return val
+		return cache.load(namespace, func() interface{} {
+			val, err := c.client.GetDurationValue(key, getFilterMap(NamespaceFilter(namespace)), defaultValue)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, durationCompareEquals)
+			return val
+		}).(time.Duration)
 	}
 }
 
 // GetDurationPropertyFilteredByNamespaceID gets property with namespaceID filter and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByNamespaceID(key Key, defaultValue time.Duration) DurationPropertyFnWithNamespaceIDFilter {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(namespaceID string) time.Duration {
-		val, err := c.client.GetDurationValue(key, getFilterMap(NamespaceIDFilter(namespaceID)), defaultValue)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, durationCompareEquals)
-		return val
+		return cache.load(namespaceID, func() interface{} {
+			val, err := c.client.GetDurationValue(key, getFilterMap(NamespaceIDFilter(namespaceID)), defaultValue)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, durationCompareEquals)
+			return val
+		}).(time.Duration)
 	}
 }
 
 // GetDurationPropertyFilteredByTaskQueueInfo gets property with taskQueueInfo as filters and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByTaskQueueInfo(key Key, defaultValue time.Duration) DurationPropertyFnWithTaskQueueInfoFilters {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) time.Duration {
-		val, err := c.client.GetDurationValue(
-			key,
-			getFilterMap(NamespaceFilter(namespace), TaskQueueFilter(taskQueue), TaskTypeFilter(taskType)),
-			defaultValue,
-		)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, durationCompareEquals)
-		return val
+		return cache.load(taskQueueFilterKey{namespace, taskQueue, taskType}, func() interface{} {
+			val, err := c.client.GetDurationValue(
+				key,
+				getFilterMap(NamespaceFilter(namespace), TaskQueueFilter(taskQueue), TaskTypeFilter(taskType)),
+				defaultValue,
+			)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, durationCompareEquals)
+			return val
+		}).(time.Duration)
 	}
 }
 
 // GetDurationPropertyFilteredByShardID gets property with shardID id as filter and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByShardID(key Key, defaultValue time.Duration) DurationPropertyFnWithShardIDFilter {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(shardID int) time.Duration {
-		val, err := c.client.GetDurationValue(
-			key,
-			getFilterMap(ShardIDFilter(shardID)),
-			defaultValue,
-		)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, durationCompareEquals)
-		return val
+		return cache.load(shardID, func() interface{} {
+			val, err := c.client.GetDurationValue(
+				key,
+				getFilterMap(ShardIDFilter(shardID)),
+				defaultValue,
+			)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, durationCompareEquals)
+			return val
+		}).(time.Duration)
 	}
 }
 
 // GetBoolProperty gets property and asserts that it's an bool
 func (c *Collection) GetBoolProperty(key Key, defaultValue bool) BoolPropertyFn {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(opts ...FilterOption) bool {
-		val, err := c.client.GetBoolValue(key, getFilterMap(opts...), defaultValue)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, boolCompareEquals)
-		return val
+		return cache.load(filterKey(opts), func() interface{} {
+			val, err := c.client.GetBoolValue(key, getFilterMap(opts...), defaultValue)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, boolCompareEquals)
+			return val
+		}).(bool)
 	}
 }
 
 // GetStringProperty gets property and asserts that it's an string
 func (c *Collection) GetStringProperty(key Key, defaultValue string) StringPropertyFn {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(opts ...FilterOption) string {
-		val, err := c.client.GetStringValue(key, getFilterMap(opts...), defaultValue)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, stringCompareEquals)
-		return val
+		return cache.load(filterKey(opts), func() interface{} {
+			val, err := c.client.GetStringValue(key, getFilterMap(opts...), defaultValue)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, stringCompareEquals)
+			return val
+		}).(string)
 	}
 }
 
 // GetMapProperty gets property and asserts that it's a map
 func (c *Collection) GetMapProperty(key Key, defaultValue map[string]interface{}) MapPropertyFn {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(opts ...FilterOption) map[string]interface{} {
-		val, err := c.client.GetMapValue(key, getFilterMap(opts...), defaultValue)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, reflect.DeepEqual)
-		return val
+		return cache.load(filterKey(opts), func() interface{} {
+			val, err := c.client.GetMapValue(key, getFilterMap(opts...), defaultValue)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, reflect.DeepEqual)
+			return val
+		}).(map[string]interface{})
 	}
 }
 
 // GetStringPropertyFnWithNamespaceFilter gets property with namespace filter and asserts that its namespace
 func (c *Collection) GetStringPropertyFnWithNamespaceFilter(key Key, defaultValue string) StringPropertyFnWithNamespaceFilter {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(namespace string) string {
-		val, err := c.client.GetStringValue(key, getFilterMap(NamespaceFilter(namespace)), defaultValue)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, stringCompareEquals)
-		return val
+		return cache.load(namespace, func() interface{} {
+			val, err := c.client.GetStringValue(key, getFilterMap(NamespaceFilter(namespace)), defaultValue)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, stringCompareEquals)
+			return val
+		}).(string)
 	}
 }
 
 // GetMapPropertyFnWithNamespaceFilter gets property and asserts that it's a map
 func (c *Collection) GetMapPropertyFnWithNamespaceFilter(key Key, defaultValue map[string]interface{}) MapPropertyFnWithNamespaceFilter {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(namespace string) map[string]interface{} {
-		val, err := c.client.GetMapValue(key, getFilterMap(NamespaceFilter(namespace)), defaultValue)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, reflect.DeepEqual)
-		return val
+		return cache.load(namespace, func() interface{} {
+			val, err := c.client.GetMapValue(key, getFilterMap(NamespaceFilter(namespace)), defaultValue)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, reflect.DeepEqual)
+			return val
+		}).(map[string]interface{})
+	}
+}
+
+// GetMapPropertyFnWithNamespaceIDFilter gets property with namespaceID filter and asserts that it's a map
+func (c *Collection) GetMapPropertyFnWithNamespaceIDFilter(key Key, defaultValue map[string]interface{}) MapPropertyFnWithNamespaceIDFilter {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
+	return func(id string) map[string]interface{} {
+		return cache.load(id, func() interface{} {
+			val, err := c.client.GetMapValue(key, getFilterMap(NamespaceIDFilter(id)), defaultValue)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, reflect.DeepEqual)
+			return val
+		}).(map[string]interface{})
 	}
 }
 
 // GetBoolPropertyFnWithNamespaceFilter gets property with namespace filter and asserts that its namespace
 func (c *Collection) GetBoolPropertyFnWithNamespaceFilter(key Key, defaultValue bool) BoolPropertyFnWithNamespaceFilter {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(namespace string) bool {
-		val, err := c.client.GetBoolValue(key, getFilterMap(NamespaceFilter(namespace)), defaultValue)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, boolCompareEquals)
-		return val
+		return cache.load(namespace, func() interface{} {
+			val, err := c.client.GetBoolValue(key, getFilterMap(NamespaceFilter(namespace)), defaultValue)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, boolCompareEquals)
+			return val
+		}).(bool)
 	}
 }
 
 // GetBoolPropertyFnWithNamespaceIDFilter gets property with namespaceID filter and asserts that it's a bool
 func (c *Collection) GetBoolPropertyFnWithNamespaceIDFilter(key Key, defaultValue bool) BoolPropertyFnWithNamespaceIDFilter {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(id string) bool {
-		val, err := c.client.GetBoolValue(key, getFilterMap(NamespaceIDFilter(id)), defaultValue)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, boolCompareEquals)
-		return val
+		return cache.load(id, func() interface{} {
+			val, err := c.client.GetBoolValue(key, getFilterMap(NamespaceIDFilter(id)), defaultValue)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, boolCompareEquals)
+			return val
+		}).(bool)
 	}
 }
 
 // GetBoolPropertyFilteredByTaskQueueInfo gets property with taskQueueInfo as filters and asserts that it's an bool
 func (c *Collection) GetBoolPropertyFilteredByTaskQueueInfo(key Key, defaultValue bool) BoolPropertyFnWithTaskQueueInfoFilters {
+	registerDefault(key, defaultValue)
+	cache := c.newPropertyCache()
 	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) bool {
-		val, err := c.client.GetBoolValue(
-			key,
-			getFilterMap(NamespaceFilter(namespace), TaskQueueFilter(taskQueue), TaskTypeFilter(taskType)),
-			defaultValue,
-		)
-		if err != nil {
-			c.logError(key, err)
-		}
-		c.logValue(key, val, defaultValue, boolCompareEquals)
-		return val
+		return cache.load(taskQueueFilterKey{namespace, taskQueue, taskType}, func() interface{} {
+			val, err := c.client.GetBoolValue(
+				key,
+				getFilterMap(NamespaceFilter(namespace), TaskQueueFilter(taskQueue), TaskTypeFilter(taskType)),
+				defaultValue,
+			)
+			if err != nil {
+				c.logError(key, err)
+			}
+			c.logValue(key, val, defaultValue, boolCompareEquals)
+			return val
+		}).(bool)
 	}
 }
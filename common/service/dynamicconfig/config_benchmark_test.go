@@ -28,6 +28,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	enumspb "go.temporal.io/api/enums/v1"
 
 	"go.temporal.io/server/common/log"
 )
@@ -41,3 +42,13 @@ func BenchmarkGetIntProperty(b *testing.B) {
 		assert.Equal(b, 10, size())
 	}
 }
+
+func BenchmarkGetIntPropertyCached(b *testing.B) {
+	client, err := NewProviderClient(&testProvider{}, log.NewNoop(), make(chan struct{}))
+	assert.NoError(b, err)
+	cln := NewCollection(client, log.NewNoop())
+	size := cln.GetIntPropertyFilteredByTaskQueueInfo(MatchingMaxTaskBatchSize, 10)
+	for i := 0; i < b.N; i++ {
+		assert.Equal(b, 10, size("samples-namespace", "samples-taskqueue", enumspb.TASK_QUEUE_TYPE_WORKFLOW))
+	}
+}
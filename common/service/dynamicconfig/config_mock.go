@@ -91,3 +91,8 @@ func GetMapPropertyFn(value map[string]interface{}) func(opts ...FilterOption) m
 func GetMapPropertyFnWithNamespaceFilter(value map[string]interface{}) func(namespace string) map[string]interface{} {
 	return func(namespace string) map[string]interface{} { return value }
 }
+
+// GetMapPropertyFnWithNamespaceIDFilter returns value as MapPropertyFn
+func GetMapPropertyFnWithNamespaceIDFilter(value map[string]interface{}) func(id string) map[string]interface{} {
+	return func(id string) map[string]interface{} { return value }
+}
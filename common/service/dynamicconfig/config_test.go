@@ -210,6 +210,15 @@ func (s *configSuite) TestGetBoolPropertyFilteredByNamespaceID() {
 	s.Equal(false, value(namespaceID))
 }
 
+func (s *configSuite) TestGetMapPropertyFilteredByNamespaceID() {
+	key := testGetMapPropertyFilteredByNamespaceIDKey
+	namespaceID := "testNamespaceID"
+	value := s.cln.GetMapPropertyFnWithNamespaceIDFilter(key, map[string]interface{}{"*": true})
+	s.Equal(map[string]interface{}{"*": true}, value(namespaceID))
+	s.client.SetValue(key, map[string]interface{}{"*": false})
+	s.Equal(map[string]interface{}{"*": false}, value(namespaceID))
+}
+
 func (s *configSuite) TestGetBoolPropertyFilteredByTaskQueueInfo() {
 	key := testGetBoolPropertyFilteredByTaskQueueInfoKey
 	namespace := "testNamespace"
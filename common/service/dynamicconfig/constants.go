@@ -56,6 +56,7 @@ var keys = map[Key]string{
 	testGetIntPropertyFilteredByTaskQueueInfoKey:      "testGetIntPropertyFilteredByTaskQueueInfoKey",
 	testGetDurationPropertyFilteredByTaskQueueInfoKey: "testGetDurationPropertyFilteredByTaskQueueInfoKey",
 	testGetBoolPropertyFilteredByNamespaceIDKey:       "testGetBoolPropertyFilteredByNamespaceIDKey",
+	testGetMapPropertyFilteredByNamespaceIDKey:        "testGetMapPropertyFilteredByNamespaceIDKey",
 	testGetBoolPropertyFilteredByTaskQueueInfoKey:     "testGetBoolPropertyFilteredByTaskQueueInfoKey",
 
 	// system settings
@@ -74,9 +75,13 @@ var keys = map[Key]string{
 	DisallowQuery:                          "system.disallowQuery",
 	EnableBatcher:                          "worker.enableBatcher",
 	EnableParentClosePolicyWorker:          "system.enableParentClosePolicyWorker",
+	EnableNamespaceDeleter:                 "worker.enableNamespaceDeleter",
 	EnableStickyQuery:                      "system.enableStickyQuery",
 	EnablePriorityTaskProcessor:            "system.enablePriorityTaskProcessor",
 	EnableAuthorization:                    "system.enableAuthorization",
+	LogLevel:                               "system.logLevel",
+	ComponentLogLevels:                     "system.componentLogLevels",
+	RedactLogPayloads:                      "system.redactLogPayloads",
 
 	// size limit
 	BlobSizeLimitError:     "limit.blobSize.error",
@@ -87,6 +92,9 @@ var keys = map[Key]string{
 	HistoryCountLimitWarn:  "limit.historyCount.warn",
 	MaxIDLengthLimit:       "limit.maxIDLength",
 
+	HistorySizeSuggestContinueAsNew:  "limit.historySize.suggestContinueAsNew",
+	HistoryCountSuggestContinueAsNew: "limit.historyCount.suggestContinueAsNew",
+
 	// frontend settings
 	FrontendPersistenceMaxQPS:             "frontend.persistenceMaxQPS",
 	FrontendPersistenceGlobalMaxQPS:       "frontend.persistenceGlobalMaxQPS",
@@ -94,6 +102,7 @@ var keys = map[Key]string{
 	FrontendVisibilityListMaxQPS:          "frontend.visibilityListMaxQPS",
 	FrontendESVisibilityListMaxQPS:        "frontend.esVisibilityListMaxQPS",
 	FrontendMaxBadBinaries:                "frontend.maxBadBinaries",
+	FrontendNamespaceAliasRetention:       "frontend.namespaceAliasRetention",
 	FrontendESIndexMaxResultWindow:        "frontend.esIndexMaxResultWindow",
 	FrontendHistoryMaxPageSize:            "frontend.historyMaxPageSize",
 	FrontendRPS:                           "frontend.rps",
@@ -183,6 +192,7 @@ var keys = map[Key]string{
 	TimerProcessorMaxTimeShift:                             "history.timerProcessorMaxTimeShift",
 	TimerProcessorHistoryArchivalSizeLimit:                 "history.timerProcessorHistoryArchivalSizeLimit",
 	TimerProcessorArchivalTimeLimit:                        "history.timerProcessorArchivalTimeLimit",
+	TimerProcessorArchiveWorkflowBundle:                    "history.timerProcessorArchiveWorkflowBundle",
 	TransferTaskBatchSize:                                  "history.transferTaskBatchSize",
 	TransferProcessorFailoverMaxPollRPS:                    "history.transferProcessorFailoverMaxPollRPS",
 	TransferProcessorMaxPollRPS:                            "history.transferProcessorMaxPollRPS",
@@ -219,8 +229,9 @@ var keys = map[Key]string{
 	MaximumSignalsPerExecution:                             "history.maximumSignalsPerExecution",
 	ShardUpdateMinInterval:                                 "history.shardUpdateMinInterval",
 	ShardSyncMinInterval:                                   "history.shardSyncMinInterval",
-	ShardSyncTimerJitterCoefficient:                        "history.shardSyncMinInterval",
+	ShardSyncTimerJitterCoefficient:                        "history.shardSyncTimerJitterCoefficient",
 	DefaultEventEncoding:                                   "history.defaultEventEncoding",
+	LargePayloadThreshold:                                  "history.largePayloadThreshold",
 	EnableAdminProtection:                                  "history.enableAdminProtection",
 	AdminOperationToken:                                    "history.adminOperationToken",
 	EnableParentClosePolicy:                                "history.enableParentClosePolicy",
@@ -263,6 +274,9 @@ var keys = map[Key]string{
 	SkipReapplicationByNamespaceId:                         "history.SkipReapplicationByNamespaceId",
 	DefaultActivityRetryPolicy:                             "history.defaultActivityRetryPolicy",
 	DefaultWorkflowRetryPolicy:                             "history.defaultWorkflowRetryPolicy",
+	EnableCompleteUnstartedActivityByID:                    "history.enableCompleteUnstartedActivityByID",
+	CrossNamespaceCallAllowList:                            "history.crossNamespaceCallAllowList",
+	StartRequestIDTTL:                                      "history.startRequestIDTTL",
 
 	WorkerPersistenceMaxQPS:                         "worker.persistenceMaxQPS",
 	WorkerPersistenceGlobalMaxQPS:                   "worker.persistenceGlobalMaxQPS",
@@ -292,9 +306,14 @@ var keys = map[Key]string{
 	WorkerTimeLimitPerArchivalIteration:             "worker.TimeLimitPerArchivalIteration",
 	WorkerThrottledLogRPS:                           "worker.throttledLogRPS",
 	ScannerPersistenceMaxQPS:                        "worker.scannerPersistenceMaxQPS",
+	NamespaceDeleterPersistenceMaxQPS:               "worker.namespaceDeleterPersistenceMaxQPS",
 	TaskQueueScannerEnabled:                         "worker.taskQueueScannerEnabled",
 	HistoryScannerEnabled:                           "worker.historyScannerEnabled",
 	ExecutionsScannerEnabled:                        "worker.executionsScannerEnabled",
+	ExecutionsScannerFixEnabled:                     "worker.executionsScannerFixEnabled",
+	ArchivalScannerEnabled:                          "worker.archivalScannerEnabled",
+	ArchivalScannerSampleRate:                       "worker.archivalScannerSampleRate",
+	ArchivalScannerReArchiveEnabled:                 "worker.archivalScannerReArchiveEnabled",
 }
 
 const (
@@ -313,6 +332,7 @@ const (
 	testGetIntPropertyFilteredByTaskQueueInfoKey
 	testGetDurationPropertyFilteredByTaskQueueInfoKey
 	testGetBoolPropertyFilteredByNamespaceIDKey
+	testGetMapPropertyFilteredByNamespaceIDKey
 	testGetBoolPropertyFilteredByTaskQueueInfoKey
 
 	// EnableGlobalNamespace is key for enable global namespace
@@ -350,6 +370,12 @@ const (
 	EnablePriorityTaskProcessor
 	// EnableAuthorization is the key to enable authorization for a namespace
 	EnableAuthorization
+	// LogLevel is the minimum level of emitted log entries
+	LogLevel
+	// ComponentLogLevels is the minimum level of emitted log entries per component
+	ComponentLogLevels
+	// RedactLogPayloads is the key to redact log tags carrying user payloads
+	RedactLogPayloads
 	// BlobSizeLimitError is the per event blob size limit
 	BlobSizeLimitError
 	// BlobSizeLimitWarn is the per event blob size limit for warning
@@ -362,6 +388,10 @@ const (
 	HistoryCountLimitError
 	// HistoryCountLimitWarn is the per workflow execution history event count limit for warning
 	HistoryCountLimitWarn
+	// HistorySizeSuggestContinueAsNew is the per workflow execution history size from which continue-as-new is suggested to the worker
+	HistorySizeSuggestContinueAsNew
+	// HistoryCountSuggestContinueAsNew is the per workflow execution history event count from which continue-as-new is suggested to the worker
+	HistoryCountSuggestContinueAsNew
 
 	// MaxIDLengthLimit is the length limit for various IDs, including: Namespace, TaskQueue, WorkflowID, ActivityID, TimerID,
 	// WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID
@@ -400,6 +430,8 @@ const (
 
 	// FrontendMaxBadBinaries is the max number of bad binaries in namespace config
 	FrontendMaxBadBinaries
+	// FrontendNamespaceAliasRetention is the default duration a renamed namespace keeps its previous name as an alias
+	FrontendNamespaceAliasRetention
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes
 	// SendRawWorkflowHistory is whether to enable raw history retrieving
@@ -556,6 +588,8 @@ const (
 	TimerProcessorHistoryArchivalSizeLimit
 	// TimerProcessorArchivalTimeLimit is the upper time limit for inline history archival
 	TimerProcessorArchivalTimeLimit
+	// TimerProcessorArchiveWorkflowBundle is whether a workflow bundle is archived along with the history of closed workflows
+	TimerProcessorArchiveWorkflowBundle
 	// TransferTaskBatchSize is batch size for transferQueueProcessor
 	TransferTaskBatchSize
 	// TransferProcessorFailoverMaxPollRPS is max poll rate per second for transferQueueProcessor
@@ -642,6 +676,18 @@ const (
 	// DefaultWorkflowRetryPolicy represents the out-of-box retry policy for unset fields
 	// where the user has set an explicit RetryPolicy, but not specified all the fields
 	DefaultWorkflowRetryPolicy
+	// EnableCompleteUnstartedActivityByID is whether activities can be completed or failed by ID while no attempt is
+	// running, e.g. by an operator while they wait for a retry. A started event is then recorded first.
+	EnableCompleteUnstartedActivityByID
+	// CrossNamespaceCallAllowList is, for the namespace ID it is filtered by, the set of namespaces its workflows can
+	// start child workflows and activities in, signal and cancel workflows of. Keys are namespace IDs, "*" stands
+	// for any namespace, and values tell if the calls are allowed. Cross namespace calls are allowed by default,
+	// set "*" to false to deny them. Signals and cancellations to a global namespace active in another cluster are
+	// forwarded to the frontend of that cluster, they are not routed through replication.
+	CrossNamespaceCallAllowList
+	// StartRequestIDTTL is how long the request ID of a workflow start is kept to deduplicate retried starts
+	// against runs which are no longer the current run, zero disables the start request ID index
+	StartRequestIDTTL
 
 	// EnableAdminProtection is whether to enable admin checking
 	EnableAdminProtection
@@ -650,6 +696,10 @@ const (
 	// HistoryMaxAutoResetPoints is the key for max number of auto reset points stored in mutableState
 	HistoryMaxAutoResetPoints
 
+	// LargePayloadThreshold is the size in bytes from which payloads are offloaded from the history to the large
+	// payload store, 0 disables offloading. Offloaded payloads don't count toward BlobSizeLimitError and BlobSizeLimitWarn.
+	LargePayloadThreshold
+
 	// EnableParentClosePolicy whether to  ParentClosePolicy
 	EnableParentClosePolicy
 	// ParentClosePolicyThreshold decides that parent close policy will be processed by sys workers(if enabled) if
@@ -744,10 +794,22 @@ const (
 	HistoryScannerEnabled
 	// ExecutionsScannerEnabled indicates if executions scanner should be started as part of worker.Scanner
 	ExecutionsScannerEnabled
+	// ExecutionsScannerFixEnabled indicates if executions scanner should repair the corrupted executions it finds
+	ExecutionsScannerFixEnabled
+	// ArchivalScannerEnabled indicates if archival scanner should be started as part of worker.Scanner
+	ArchivalScannerEnabled
+	// ArchivalScannerSampleRate is the fraction of archived workflows the archival scanner verifies per run, all are verified if not in (0, 1)
+	ArchivalScannerSampleRate
+	// ArchivalScannerReArchiveEnabled indicates if archival scanner should re-archive missing or corrupted histories whose source still exists
+	ArchivalScannerReArchiveEnabled
 	// EnableBatcher decides whether start batcher in our worker
 	EnableBatcher
 	// EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task
 	EnableParentClosePolicyWorker
+	// EnableNamespaceDeleter decides whether or not enable system workers deleting the resources of deleted namespaces
+	EnableNamespaceDeleter
+	// NamespaceDeleterPersistenceMaxQPS is the maximum rate of persistence calls from worker.NamespaceDeleter
+	NamespaceDeleterPersistenceMaxQPS
 	// EnableStickyQuery indicates if sticky query should be enabled per namespace
 	EnableStickyQuery
 
@@ -806,7 +868,7 @@ const (
 type Filter int
 
 func (f Filter) String() string {
-	if f <= unknownFilter || f > TaskType {
+	if f <= unknownFilter || f >= lastFilterTypeForTest {
 		return filters[unknownFilter]
 	}
 	return filters[f]
@@ -29,7 +29,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
-	"sync/atomic"
 	"time"
 
 	"gopkg.in/yaml.v2"
@@ -45,11 +44,6 @@ const (
 	fileMode        = 0644 // used for update config file
 )
 
-type constrainedValue struct {
-	Value       interface{}
-	Constraints map[string]interface{}
-}
-
 // FileBasedClientConfig is the config for the file based dynamic config client.
 // It specifies where the config file is stored and how often the config should be
 // updated by checking the config file again.
@@ -59,11 +53,10 @@ type FileBasedClientConfig struct {
 }
 
 type fileBasedClient struct {
-	values          atomic.Value
+	*basicClient
 	lastUpdatedTime time.Time
 	config          *FileBasedClientConfig
 	doneCh          chan struct{}
-	logger          log.Logger
 }
 
 // NewFileBasedClient creates a file based client.
@@ -73,9 +66,9 @@ func NewFileBasedClient(config *FileBasedClientConfig, logger log.Logger, doneCh
 	}
 
 	client := &fileBasedClient{
-		config: config,
-		doneCh: doneCh,
-		logger: logger,
+		basicClient: newBasicClient(logger),
+		config:      config,
+		doneCh:      doneCh,
 	}
 	if err := client.update(); err != nil {
 		return nil, err
@@ -98,98 +91,13 @@ func NewFileBasedClient(config *FileBasedClientConfig, logger log.Logger, doneCh
 	return client, nil
 }
 
-func (fc *fileBasedClient) GetValue(name Key, defaultValue interface{}) (interface{}, error) {
-	return fc.getValueWithFilters(name, nil, defaultValue)
-}
-
-func (fc *fileBasedClient) GetValueWithFilters(name Key, filters map[Filter]interface{}, defaultValue interface{}) (interface{}, error) {
-	return fc.getValueWithFilters(name, filters, defaultValue)
-}
-
-func (fc *fileBasedClient) GetIntValue(name Key, filters map[Filter]interface{}, defaultValue int) (int, error) {
-	val, err := fc.getValueWithFilters(name, filters, defaultValue)
-	if err != nil {
-		return defaultValue, err
-	}
-
-	if intVal, ok := val.(int); ok {
-		return intVal, nil
-	}
-	return defaultValue, errors.New("value type is not int")
-}
-
-func (fc *fileBasedClient) GetFloatValue(name Key, filters map[Filter]interface{}, defaultValue float64) (float64, error) {
-	val, err := fc.getValueWithFilters(name, filters, defaultValue)
-	if err != nil {
-		return defaultValue, err
-	}
-
-	if floatVal, ok := val.(float64); ok {
-		return floatVal, nil
-	} else if intVal, ok := val.(int); ok {
-		return float64(intVal), nil
-	}
-	return defaultValue, errors.New("value type is not float64")
-}
-
-func (fc *fileBasedClient) GetBoolValue(name Key, filters map[Filter]interface{}, defaultValue bool) (bool, error) {
-	val, err := fc.getValueWithFilters(name, filters, defaultValue)
-	if err != nil {
-		return defaultValue, err
-	}
-
-	if boolVal, ok := val.(bool); ok {
-		return boolVal, nil
-	}
-	return defaultValue, errors.New("value type is not bool")
-}
-
-func (fc *fileBasedClient) GetStringValue(name Key, filters map[Filter]interface{}, defaultValue string) (string, error) {
-	val, err := fc.getValueWithFilters(name, filters, defaultValue)
-	if err != nil {
-		return defaultValue, err
-	}
-
-	if stringVal, ok := val.(string); ok {
-		return stringVal, nil
-	}
-	return defaultValue, errors.New("value type is not string")
-}
-
-func (fc *fileBasedClient) GetMapValue(
-	name Key, filters map[Filter]interface{}, defaultValue map[string]interface{},
-) (map[string]interface{}, error) {
-	val, err := fc.getValueWithFilters(name, filters, defaultValue)
-	if err != nil {
-		return defaultValue, err
-	}
-	if mapVal, ok := val.(map[string]interface{}); ok {
-		return mapVal, nil
-	}
-	return defaultValue, errors.New("value type is not map")
-}
-
-func (fc *fileBasedClient) GetDurationValue(
-	name Key, filters map[Filter]interface{}, defaultValue time.Duration,
-) (time.Duration, error) {
-	val, err := fc.getValueWithFilters(name, filters, defaultValue)
-	if err != nil {
-		return defaultValue, err
-	}
-
-	durationString, ok := val.(string)
-	if !ok {
-		return defaultValue, errors.New("value type is not string")
-	}
-
-	durationVal, err := time.ParseDuration(durationString)
-	if err != nil {
-		return defaultValue, fmt.Errorf("failed to parse duration: %v", err)
+// UpdateValue sets the value of the key which applies without filters, the values of the key constrained by filters
+// are kept. Only the config file of this host is changed, other hosts keep serving the values of their own config file.
+func (fc *fileBasedClient) UpdateValue(name Key, value interface{}) error {
+	if err := validateValue(name, value); err != nil {
+		return err
 	}
-	return durationVal, nil
-}
 
-func (fc *fileBasedClient) UpdateValue(name Key, value interface{}) error {
 	keyName := keys[name]
 	currentValues := make(map[string][]*constrainedValue)
 
@@ -202,11 +110,15 @@ func (fc *fileBasedClient) UpdateValue(name Key, value interface{}) error {
 		return fmt.Errorf("failed to decode dynamic config %v", err)
 	}
 
-	cVal := &constrainedValue{
-		Value: value,
+	currentValues[keyName] = mergeUnconstrainedValue(currentValues[keyName], value)
+	// the whole config is validated before it is written, so an update never leaves an invalid config file behind
+	if err := normalizeValues(currentValues); err != nil {
+		return err
+	}
+	newBytes, err := yaml.Marshal(currentValues)
+	if err != nil {
+		return fmt.Errorf("failed to encode dynamic config %v", err)
 	}
-	currentValues[keyName] = []*constrainedValue{cVal}
-	newBytes, _ := yaml.Marshal(currentValues)
 
 	err = ioutil.WriteFile(fc.config.Filepath, newBytes, fileMode)
 	if err != nil {
@@ -216,13 +128,31 @@ func (fc *fileBasedClient) UpdateValue(name Key, value interface{}) error {
 	return fc.storeValues(currentValues)
 }
 
+// mergeUnconstrainedValue replaces the value without constraints among the values of a key, or adds it if there is none
+func mergeUnconstrainedValue(values []*constrainedValue, value interface{}) []*constrainedValue {
+	result := make([]*constrainedValue, 0, len(values)+1)
+	replaced := false
+	for _, cv := range values {
+		if len(cv.Constraints) == 0 {
+			if !replaced {
+				result = append(result, &constrainedValue{Value: value})
+				replaced = true
+			}
+			continue
+		}
+		result = append(result, cv)
+	}
+	if !replaced {
+		result = append(result, &constrainedValue{Value: value})
+	}
+	return result
+}
+
 func (fc *fileBasedClient) update() error {
 	defer func() {
 		fc.lastUpdatedTime = time.Now().UTC()
 	}()
 
-	newValues := make(map[string][]*constrainedValue)
-
 	info, err := os.Stat(fc.config.Filepath)
 	if err != nil {
 		return fmt.Errorf("failed to get status of dynamic config file: %v", err)
@@ -236,66 +166,7 @@ func (fc *fileBasedClient) update() error {
 		return fmt.Errorf("failed to read dynamic config file %v: %v", fc.config.Filepath, err)
 	}
 
-	if err = yaml.Unmarshal(confContent, newValues); err != nil {
-		return fmt.Errorf("failed to decode dynamic config %v", err)
-	}
-
-	return fc.storeValues(newValues)
-}
-
-func (fc *fileBasedClient) storeValues(newValues map[string][]*constrainedValue) error {
-	// yaml will unmarshal map into map[interface{}]interface{} instead of map[string]interface{}
-	// manually convert key type to string for all values here
-	// We don't need to convert constraints as their type can't be map. If user does use a map as filter
-	// value, it won't match anyway.
-	for _, s := range newValues {
-		for _, cv := range s {
-			var err error
-			cv.Value, err = convertKeyTypeToString(cv.Value)
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	fc.values.Store(newValues)
-	fc.logger.Info("Updated dynamic config")
-	return nil
-}
-
-func (fc *fileBasedClient) getValueWithFilters(key Key, filters map[Filter]interface{}, defaultValue interface{}) (interface{}, error) {
-	keyName := keys[key]
-	values := fc.values.Load().(map[string][]*constrainedValue)
-	found := false
-	for _, constrainedValue := range values[keyName] {
-		if len(constrainedValue.Constraints) == 0 {
-			// special handling for default value (value without any constraints)
-			defaultValue = constrainedValue.Value
-			found = true
-			continue
-		}
-		if match(constrainedValue, filters) {
-			return constrainedValue.Value, nil
-		}
-	}
-	if !found {
-		return defaultValue, errors.New("unable to find key")
-	}
-	return defaultValue, nil
-}
-
-// match will return true if the constraints matches the filters exactly
-func match(v *constrainedValue, filters map[Filter]interface{}) bool {
-	if len(v.Constraints) != len(filters) {
-		return false
-	}
-
-	for filter, filterValue := range filters {
-		if v.Constraints[filter.String()] != filterValue {
-			return false
-		}
-	}
-	return true
+	return fc.updateContent(confContent)
 }
 
 func convertKeyTypeToString(v interface{}) (interface{}, error) {
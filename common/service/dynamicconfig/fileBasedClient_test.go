@@ -25,6 +25,8 @@
 package dynamicconfig
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
 	"time"
 
@@ -111,6 +113,36 @@ func (s *fileBasedClientSuite) TestGetValueWithFilters_UnknownFilter() {
 	s.Equal(false, v)
 }
 
+func (s *fileBasedClientSuite) TestGetValueWithFilters_NamespaceAlias() {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	client, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     "config/testConfig.yaml",
+		PollInterval: time.Second * 5,
+	}, log.NewNoop(), doneCh)
+	s.NoError(err)
+	client.(NamespaceAliasAware).SetNamespaceAliasResolver(func(namespace string) []string {
+		if namespace == "renamed-samples-namespace" {
+			return []string{"global-samples-namespace"}
+		}
+		return nil
+	})
+
+	filters := map[Filter]interface{}{
+		Namespace: "renamed-samples-namespace",
+	}
+	v, err := client.GetValueWithFilters(testGetBoolPropertyKey, filters, false)
+	s.NoError(err)
+	s.Equal(true, v)
+
+	filters = map[Filter]interface{}{
+		Namespace: "non-exist-namespace",
+	}
+	v, err = client.GetValueWithFilters(testGetBoolPropertyKey, filters, true)
+	s.NoError(err)
+	s.Equal(false, v)
+}
+
 func (s *fileBasedClientSuite) TestGetIntValue() {
 	v, err := s.client.GetIntValue(testGetIntPropertyKey, nil, 1)
 	s.NoError(err)
@@ -355,3 +387,41 @@ func (s *fileBasedClientSuite) TestUpdateConfig() {
 	err = client.UpdateValue(key, v)
 	s.NoError(err)
 }
+
+func (s *fileBasedClientSuite) TestUpdateConfig_KeepsConstrainedValues() {
+	file, err := ioutil.TempFile("", "dynamicconfig")
+	s.NoError(err)
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(`
+limit.blobSize.error:
+- value: 1000
+- value: 2000
+  constraints:
+    namespace: test-namespace
+`)
+	s.NoError(err)
+	s.NoError(file.Close())
+
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	client, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     file.Name(),
+		PollInterval: time.Second * 5,
+	}, log.NewNoop(), doneCh)
+	s.NoError(err)
+
+	s.NoError(client.UpdateValue(BlobSizeLimitError, 3000))
+	value, err := client.GetIntValue(BlobSizeLimitError, nil, 0)
+	s.NoError(err)
+	s.Equal(3000, value)
+	value, err = client.GetIntValue(BlobSizeLimitError, map[Filter]interface{}{Namespace: "test-namespace"}, 0)
+	s.NoError(err)
+	s.Equal(2000, value)
+
+	// an invalid value is rejected before the file is written
+	s.Error(client.UpdateValue(BlobSizeLimitError, "not a number"))
+	content, err := ioutil.ReadFile(file.Name())
+	s.NoError(err)
+	s.Contains(string(content), "3000")
+	s.Contains(string(content), "namespace: test-namespace")
+}
@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+var _ Provider = (*httpProvider)(nil)
+
+// HTTPProviderConfig is the config for the http dynamic config provider.
+// The provider polls the url, which must return the dynamic config in yaml format.
+type HTTPProviderConfig struct {
+	URL          string        `yaml:"url"`
+	PollInterval time.Duration `yaml:"pollInterval"`
+	Timeout      time.Duration `yaml:"timeout"`
+}
+
+type httpProvider struct {
+	config      *HTTPProviderConfig
+	httpClient  *http.Client
+	logger      log.Logger
+	stopCh      chan struct{}
+	etag        string
+	lastContent []byte
+}
+
+// NewHTTPProvider creates a provider which polls dynamic config from a http endpoint.
+func NewHTTPProvider(config *HTTPProviderConfig, logger log.Logger) (Provider, error) {
+	if config == nil || config.URL == "" {
+		return nil, errors.New("no url found for http dynamic config provider")
+	}
+	if config.PollInterval < minPollInterval {
+		return nil, fmt.Errorf("poll interval should be at least %v", minPollInterval)
+	}
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = minPollInterval
+	}
+	return &httpProvider{
+		config:     config,
+		httpClient: &http.Client{Timeout: timeout},
+		logger:     logger,
+		stopCh:     make(chan struct{}),
+	}, nil
+}
+
+func (p *httpProvider) Start(update func(content []byte) error) error {
+	if err := p.poll(update); err != nil {
+		return err
+	}
+	go func() {
+		ticker := time.NewTicker(p.config.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := p.poll(update); err != nil {
+					p.logger.Error("Failed to update dynamic config", tag.Error(err))
+				}
+			case <-p.stopCh:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (p *httpProvider) Stop() {
+	close(p.stopCh)
+}
+
+func (p *httpProvider) poll(update func(content []byte) error) error {
+	request, err := http.NewRequest(http.MethodGet, p.config.URL, nil)
+	if err != nil {
+		return err
+	}
+	if p.etag != "" {
+		request.Header.Set("If-None-Match", p.etag)
+	}
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to fetch dynamic config from %v: %v", p.config.URL, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotModified {
+		return nil
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch dynamic config from %v: status %v", p.config.URL, response.Status)
+	}
+	content, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read dynamic config from %v: %v", p.config.URL, err)
+	}
+	if p.lastContent != nil && bytes.Equal(content, p.lastContent) {
+		return nil
+	}
+	if err := update(content); err != nil {
+		return err
+	}
+	p.etag = response.Header.Get("ETag")
+	p.lastContent = content
+	return nil
+}
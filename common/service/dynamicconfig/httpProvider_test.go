@@ -0,0 +1,110 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/log"
+)
+
+func TestHTTPProviderClient(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.Header.Get("If-None-Match") == "v1" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", "v1")
+		_, _ = w.Write([]byte(`
+history.persistenceMaxQPS:
+- value: 3000
+frontend.visibilityListMaxQPS:
+- value: 20
+  constraints:
+    namespace: samples-namespace
+`))
+	}))
+	defer server.Close()
+
+	provider, err := NewHTTPProvider(&HTTPProviderConfig{
+		URL:          server.URL,
+		PollInterval: minPollInterval,
+	}, log.NewNoop())
+	require.NoError(t, err)
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	client, err := NewProviderClient(provider, log.NewNoop(), doneCh)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	value, err := client.GetIntValue(HistoryPersistenceMaxQPS, nil, 100)
+	require.NoError(t, err)
+	require.Equal(t, 3000, value)
+	value, err = client.GetIntValue(FrontendVisibilityListMaxQPS, map[Filter]interface{}{Namespace: "samples-namespace"}, 10)
+	require.NoError(t, err)
+	require.Equal(t, 20, value)
+
+	require.Error(t, client.UpdateValue(HistoryPersistenceMaxQPS, 100))
+
+	// not modified content keeps the current values
+	require.NoError(t, provider.(*httpProvider).poll(func(content []byte) error {
+		t.Fatal("update should not be called for unchanged content")
+		return nil
+	}))
+}
+
+func TestHTTPProviderClient_InvalidContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`
+history.persistenceMaxQps:
+- value: 3000
+`))
+	}))
+	defer server.Close()
+
+	provider, err := NewHTTPProvider(&HTTPProviderConfig{
+		URL:          server.URL,
+		PollInterval: minPollInterval,
+	}, log.NewNoop())
+	require.NoError(t, err)
+	_, err = NewProviderClient(provider, log.NewNoop(), make(chan struct{}))
+	require.Error(t, err)
+}
+
+func TestNewHTTPProvider_ShortPollInterval(t *testing.T) {
+	_, err := NewHTTPProvider(&HTTPProviderConfig{
+		URL:          "http://127.0.0.1:7934/dynamicconfig",
+		PollInterval: time.Second,
+	}, log.NewNoop())
+	require.Error(t, err)
+}
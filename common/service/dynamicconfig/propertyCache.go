@@ -0,0 +1,173 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+const (
+	// propertyCacheMaxSize bounds the number of filter values, e.g. task queues, a property caches values for
+	propertyCacheMaxSize = 1000
+	// propertyCacheTTL bounds how long a value is served from the cache, values are also dropped
+	// as soon as the client values change
+	propertyCacheTTL = time.Minute
+)
+
+type (
+	// propertyCache caches the values read by a property function per filter values, it is a bounded LRU cache
+	// whose entries expire after propertyCacheTTL or when the client values change
+	propertyCache struct {
+		collection *Collection
+		timeSource func() time.Time
+
+		sync.Mutex
+		version int64
+		entries map[interface{}]*list.Element
+		order   *list.List // of *propertyCacheEntry, most recently used first
+	}
+
+	propertyCacheEntry struct {
+		key        interface{}
+		value      interface{}
+		expiryTime time.Time
+	}
+
+	taskQueueFilterKey struct {
+		namespace string
+		taskQueue string
+		taskType  enumspb.TaskQueueType
+	}
+
+	// filterOptionsKey holds the value of each filter set by filter options
+	filterOptionsKey [lastFilterTypeForTest]interface{}
+
+	noFiltersKey struct{}
+)
+
+var noFilters = noFiltersKey{}
+
+// newPropertyCache returns nil, which disables caching, if the client can't tell when its values change
+func (c *Collection) newPropertyCache() *propertyCache {
+	if _, ok := c.client.(UpdateNotifier); !ok {
+		return nil
+	}
+	return &propertyCache{
+		collection: c,
+		timeSource: time.Now,
+		entries:    make(map[interface{}]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// filterKey returns the cache key for filter options
+func filterKey(opts []FilterOption) interface{} {
+	if len(opts) == 0 {
+		return noFilters
+	}
+	var key filterOptionsKey
+	for filter, value := range getFilterMap(opts...) {
+		if filter <= unknownFilter || filter >= lastFilterTypeForTest {
+			return nil
+		}
+		key[filter] = value
+	}
+	return key
+}
+
+func (p *propertyCache) load(filterKey interface{}, read func() interface{}) interface{} {
+	if p == nil || filterKey == nil {
+		return read()
+	}
+
+	version := atomic.LoadInt64(&p.collection.version)
+	if value, ok := p.get(filterKey, version); ok {
+		return value
+	}
+	value := read()
+	p.put(filterKey, value, version)
+	return value
+}
+
+func (p *propertyCache) get(key interface{}, version int64) (interface{}, bool) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.resetIfChanged(version)
+	element, ok := p.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*propertyCacheEntry)
+	if p.timeSource().After(entry.expiryTime) {
+		p.order.Remove(element)
+		delete(p.entries, key)
+		return nil, false
+	}
+	p.order.MoveToFront(element)
+	return entry.value, true
+}
+
+func (p *propertyCache) put(key interface{}, value interface{}, version int64) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.resetIfChanged(version)
+	// the value was read before the client values changed, it is not cached to not hide the change
+	if p.version != version {
+		return
+	}
+	entry := &propertyCacheEntry{
+		key:        key,
+		value:      value,
+		expiryTime: p.timeSource().Add(propertyCacheTTL),
+	}
+	if element, ok := p.entries[key]; ok {
+		element.Value = entry
+		p.order.MoveToFront(element)
+		return
+	}
+	p.entries[key] = p.order.PushFront(entry)
+	for p.order.Len() > propertyCacheMaxSize {
+		oldest := p.order.Back()
+		p.order.Remove(oldest)
+		delete(p.entries, oldest.Value.(*propertyCacheEntry).key)
+	}
+}
+
+// resetIfChanged drops all entries if the client values changed since they were read
+func (p *propertyCache) resetIfChanged(version int64) {
+	if version <= p.version {
+		return
+	}
+	p.version = version
+	p.entries = make(map[interface{}]*list.Element)
+	p.order.Init()
+}
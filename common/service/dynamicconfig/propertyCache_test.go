@@ -0,0 +1,97 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package dynamicconfig
+
+import (
+	"container/list"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPropertyCache(now *time.Time) *propertyCache {
+	return &propertyCache{
+		collection: &Collection{},
+		timeSource: func() time.Time { return *now },
+		entries:    make(map[interface{}]*list.Element),
+		order:      list.New(),
+	}
+}
+
+func TestPropertyCache_FilterOptions(t *testing.T) {
+	now := time.Now()
+	cache := newTestPropertyCache(&now)
+	reads := 0
+	read := func(value interface{}) func() interface{} {
+		return func() interface{} {
+			reads++
+			return value
+		}
+	}
+
+	require.Equal(t, "a", cache.load(filterKey([]FilterOption{NamespaceFilter("a")}), read("a")))
+	require.Equal(t, "b", cache.load(filterKey([]FilterOption{NamespaceFilter("b")}), read("b")))
+	require.Equal(t, "a", cache.load(filterKey([]FilterOption{NamespaceFilter("a")}), read("other")))
+	require.Equal(t, "ab", cache.load(filterKey([]FilterOption{NamespaceFilter("a"), TaskQueueFilter("b")}), read("ab")))
+	require.Equal(t, 3, reads)
+}
+
+func TestPropertyCache_Bounded(t *testing.T) {
+	now := time.Now()
+	cache := newTestPropertyCache(&now)
+	for i := 0; i < propertyCacheMaxSize*2; i++ {
+		key := fmt.Sprintf("task-queue-%v", i)
+		cache.load(key, func() interface{} { return key })
+	}
+	require.Equal(t, propertyCacheMaxSize, cache.order.Len())
+	require.Equal(t, propertyCacheMaxSize, len(cache.entries))
+
+	// the least recently used entries are evicted
+	require.Equal(t, "new", cache.load("task-queue-0", func() interface{} { return "new" }))
+	last := fmt.Sprintf("task-queue-%v", propertyCacheMaxSize*2-1)
+	require.Equal(t, last, cache.load(last, func() interface{} { return "new" }))
+}
+
+func TestPropertyCache_Expiry(t *testing.T) {
+	now := time.Now()
+	cache := newTestPropertyCache(&now)
+	require.Equal(t, 1, cache.load(noFilters, func() interface{} { return 1 }))
+	require.Equal(t, 1, cache.load(noFilters, func() interface{} { return 2 }))
+
+	now = now.Add(propertyCacheTTL + time.Second)
+	require.Equal(t, 3, cache.load(noFilters, func() interface{} { return 3 }))
+}
+
+func TestPropertyCache_ClientUpdate(t *testing.T) {
+	now := time.Now()
+	cache := newTestPropertyCache(&now)
+	require.Equal(t, 1, cache.load(noFilters, func() interface{} { return 1 }))
+
+	atomic.AddInt64(&cache.collection.version, 1)
+	require.Equal(t, 2, cache.load(noFilters, func() interface{} { return 2 }))
+	require.Equal(t, 2, cache.load(noFilters, func() interface{} { return 3 }))
+}
@@ -0,0 +1,69 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+
+	"go.temporal.io/server/common/log"
+)
+
+var _ Client = (*providerClient)(nil)
+
+// Provider pushes dynamic config from an external source, e.g. a config service.
+// The content has the same yaml format as the dynamic config file.
+type Provider interface {
+	// Start starts watching the source. It must deliver the initial content before returning
+	// and call update with the full content every time it changes afterwards.
+	Start(update func(content []byte) error) error
+	// Stop stops watching the source.
+	Stop()
+}
+
+type providerClient struct {
+	*basicClient
+	provider Provider
+}
+
+// NewProviderClient creates a client which serves the dynamic config pushed by the provider.
+// The provider is stopped when doneCh is closed.
+func NewProviderClient(provider Provider, logger log.Logger, doneCh chan struct{}) (Client, error) {
+	client := &providerClient{
+		basicClient: newBasicClient(logger),
+		provider:    provider,
+	}
+	if err := provider.Start(client.updateContent); err != nil {
+		return nil, err
+	}
+	go func() {
+		<-doneCh
+		provider.Stop()
+	}()
+	return client, nil
+}
+
+func (pc *providerClient) UpdateValue(name Key, value interface{}) error {
+	return errors.New("dynamic config is read only, update it at the provider source")
+}
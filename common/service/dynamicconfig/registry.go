@@ -0,0 +1,531 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"gopkg.in/yaml.v2"
+)
+
+// ValueType is the type of value stored under a dynamic config key
+type ValueType int
+
+const (
+	// AnyType accepts values of any type
+	AnyType ValueType = iota
+	// IntType is an integer value
+	IntType
+	// FloatType is a float value, integers are accepted as well
+	FloatType
+	// BoolType is a boolean value
+	BoolType
+	// StringType is a string value
+	StringType
+	// MapType is a map with string keys
+	MapType
+	// DurationType is a string parsable by time.ParseDuration
+	DurationType
+)
+
+var valueTypes = []string{
+	"any",
+	"int",
+	"float",
+	"bool",
+	"string",
+	"map",
+	"duration",
+}
+
+func (t ValueType) String() string {
+	if t < AnyType || t > DurationType {
+		return "unknown"
+	}
+	return valueTypes[t]
+}
+
+type keyDefinition struct {
+	valueType ValueType
+	// filters are the filters that may be used as constraints for the key,
+	// a value without constraints is always allowed
+	filters []Filter
+}
+
+var (
+	namespaceFilters   = []Filter{Namespace}
+	namespaceIDFilters = []Filter{NamespaceID}
+	taskQueueFilters   = []Filter{Namespace, TaskQueueName, TaskType}
+	shardIDFilters     = []Filter{ShardID}
+	allFilters         = []Filter{Namespace, NamespaceID, TaskQueueName, TaskType, ShardID}
+
+	// test keys accept any value and constraint so that type handling of clients can be tested
+	testKeyDefinition = keyDefinition{valueType: AnyType, filters: allFilters}
+)
+
+// Mapping from Key to its definition, every key in keys must have an entry here.
+var keyDefinitions = map[Key]keyDefinition{
+	// tests keys
+	testGetPropertyKey:                                testKeyDefinition,
+	testGetIntPropertyKey:                             testKeyDefinition,
+	testGetFloat64PropertyKey:                         testKeyDefinition,
+	testGetDurationPropertyKey:                        testKeyDefinition,
+	testGetBoolPropertyKey:                            testKeyDefinition,
+	testGetStringPropertyKey:                          testKeyDefinition,
+	testGetMapPropertyKey:                             testKeyDefinition,
+	testGetIntPropertyFilteredByNamespaceKey:          testKeyDefinition,
+	testGetDurationPropertyFilteredByNamespaceKey:     testKeyDefinition,
+	testGetIntPropertyFilteredByTaskQueueInfoKey:      testKeyDefinition,
+	testGetDurationPropertyFilteredByTaskQueueInfoKey: testKeyDefinition,
+	testGetBoolPropertyFilteredByNamespaceIDKey:       testKeyDefinition,
+	testGetMapPropertyFilteredByNamespaceIDKey:        testKeyDefinition,
+	testGetBoolPropertyFilteredByTaskQueueInfoKey:     testKeyDefinition,
+
+	// system settings
+	EnableGlobalNamespace:                  {valueType: BoolType},
+	EnableVisibilitySampling:               {valueType: BoolType},
+	AdvancedVisibilityWritingMode:          {valueType: StringType},
+	EnableReadVisibilityFromES:             {valueType: BoolType, filters: namespaceFilters},
+	HistoryArchivalState:                   {valueType: StringType},
+	EnableReadFromHistoryArchival:          {valueType: BoolType},
+	VisibilityArchivalState:                {valueType: StringType},
+	EnableReadFromVisibilityArchival:       {valueType: BoolType},
+	EnableNamespaceNotActiveAutoForwarding: {valueType: BoolType, filters: namespaceFilters},
+	TransactionSizeLimit:                   {valueType: IntType},
+	MinRetentionDays:                       {valueType: IntType},
+	MaxWorkflowTaskTimeout:                 {valueType: DurationType, filters: namespaceFilters},
+	DisallowQuery:                          {valueType: BoolType, filters: namespaceFilters},
+	EnableBatcher:                          {valueType: BoolType},
+	EnableParentClosePolicyWorker:          {valueType: BoolType},
+	EnableNamespaceDeleter:                 {valueType: BoolType},
+	EnableStickyQuery:                      {valueType: BoolType, filters: namespaceFilters},
+	EnablePriorityTaskProcessor:            {valueType: BoolType},
+	EnableAuthorization:                    {valueType: BoolType, filters: namespaceFilters},
+	LogLevel:                               {valueType: StringType},
+	ComponentLogLevels:                     {valueType: MapType},
+	RedactLogPayloads:                      {valueType: BoolType},
+
+	// size limit
+	BlobSizeLimitError:     {valueType: IntType, filters: namespaceFilters},
+	BlobSizeLimitWarn:      {valueType: IntType, filters: namespaceFilters},
+	HistorySizeLimitError:  {valueType: IntType, filters: namespaceFilters},
+	HistorySizeLimitWarn:   {valueType: IntType, filters: namespaceFilters},
+	HistoryCountLimitError: {valueType: IntType, filters: namespaceFilters},
+	HistoryCountLimitWarn:  {valueType: IntType, filters: namespaceFilters},
+	MaxIDLengthLimit:       {valueType: IntType},
+
+	HistorySizeSuggestContinueAsNew:  {valueType: IntType, filters: namespaceFilters},
+	HistoryCountSuggestContinueAsNew: {valueType: IntType, filters: namespaceFilters},
+
+	// frontend settings
+	FrontendPersistenceMaxQPS:             {valueType: IntType},
+	FrontendPersistenceGlobalMaxQPS:       {valueType: IntType},
+	FrontendVisibilityMaxPageSize:         {valueType: IntType, filters: namespaceFilters},
+	FrontendVisibilityListMaxQPS:          {valueType: IntType, filters: namespaceFilters},
+	FrontendESVisibilityListMaxQPS:        {valueType: IntType, filters: namespaceFilters},
+	FrontendMaxBadBinaries:                {valueType: IntType, filters: namespaceFilters},
+	FrontendNamespaceAliasRetention:       {valueType: DurationType},
+	FrontendESIndexMaxResultWindow:        {valueType: IntType},
+	FrontendHistoryMaxPageSize:            {valueType: IntType, filters: namespaceFilters},
+	FrontendRPS:                           {valueType: IntType},
+	FrontendMaxNamespaceRPSPerInstance:    {valueType: IntType, filters: namespaceFilters},
+	FrontendGlobalNamespaceRPS:            {valueType: IntType, filters: namespaceFilters},
+	FrontendHistoryMgrNumConns:            {valueType: IntType},
+	FrontendShutdownDrainDuration:         {valueType: DurationType},
+	DisableListVisibilityByFilter:         {valueType: BoolType, filters: namespaceFilters},
+	FrontendThrottledLogRPS:               {valueType: IntType},
+	EnableClientVersionCheck:              {valueType: BoolType},
+	ValidSearchAttributes:                 {valueType: MapType},
+	SendRawWorkflowHistory:                {valueType: BoolType, filters: namespaceFilters},
+	FrontendEnableRPCReplication:          {valueType: BoolType},
+	FrontendEnableCleanupReplicationTask:  {valueType: BoolType},
+	SearchAttributesNumberOfKeysLimit:     {valueType: IntType, filters: namespaceFilters},
+	SearchAttributesSizeOfValueLimit:      {valueType: IntType, filters: namespaceFilters},
+	SearchAttributesTotalSizeLimit:        {valueType: IntType, filters: namespaceFilters},
+	VisibilityArchivalQueryMaxPageSize:    {valueType: IntType},
+	VisibilityArchivalQueryMaxRangeInDays: {valueType: IntType},
+	VisibilityArchivalQueryMaxQPS:         {valueType: IntType},
+
+	// matching settings
+	MatchingRPS:                             {valueType: IntType},
+	MatchingPersistenceMaxQPS:               {valueType: IntType},
+	MatchingPersistenceGlobalMaxQPS:         {valueType: IntType},
+	MatchingMinTaskThrottlingBurstSize:      {valueType: IntType, filters: taskQueueFilters},
+	MatchingGetTasksBatchSize:               {valueType: IntType, filters: taskQueueFilters},
+	MatchingLongPollExpirationInterval:      {valueType: DurationType, filters: taskQueueFilters},
+	MatchingEnableSyncMatch:                 {valueType: BoolType, filters: taskQueueFilters},
+	MatchingUpdateAckInterval:               {valueType: DurationType, filters: taskQueueFilters},
+	MatchingIdleTaskqueueCheckInterval:      {valueType: DurationType, filters: taskQueueFilters},
+	MaxTaskqueueIdleTime:                    {valueType: DurationType, filters: taskQueueFilters},
+	MatchingOutstandingTaskAppendsThreshold: {valueType: IntType, filters: taskQueueFilters},
+	MatchingMaxTaskBatchSize:                {valueType: IntType, filters: taskQueueFilters},
+	MatchingMaxTaskDeleteBatchSize:          {valueType: IntType, filters: taskQueueFilters},
+	MatchingThrottledLogRPS:                 {valueType: IntType},
+	MatchingNumTaskqueueWritePartitions:     {valueType: IntType, filters: taskQueueFilters},
+	MatchingNumTaskqueueReadPartitions:      {valueType: IntType, filters: taskQueueFilters},
+	MatchingForwarderMaxOutstandingPolls:    {valueType: IntType, filters: taskQueueFilters},
+	MatchingForwarderMaxOutstandingTasks:    {valueType: IntType, filters: taskQueueFilters},
+	MatchingForwarderMaxRatePerSecond:       {valueType: IntType, filters: taskQueueFilters},
+	MatchingForwarderMaxChildrenPerNode:     {valueType: IntType, filters: taskQueueFilters},
+	MatchingShutdownDrainDuration:           {valueType: DurationType},
+
+	// history settings
+	HistoryRPS:                                             {valueType: IntType},
+	HistoryPersistenceMaxQPS:                               {valueType: IntType},
+	HistoryPersistenceGlobalMaxQPS:                         {valueType: IntType},
+	HistoryVisibilityOpenMaxQPS:                            {valueType: IntType, filters: namespaceFilters},
+	HistoryVisibilityClosedMaxQPS:                          {valueType: IntType, filters: namespaceFilters},
+	HistoryLongPollExpirationInterval:                      {valueType: DurationType, filters: namespaceFilters},
+	HistoryCacheInitialSize:                                {valueType: IntType},
+	HistoryMaxAutoResetPoints:                              {valueType: IntType, filters: namespaceFilters},
+	HistoryCacheMaxSize:                                    {valueType: IntType},
+	HistoryCacheTTL:                                        {valueType: DurationType},
+	HistoryShutdownDrainDuration:                           {valueType: DurationType},
+	EventsCacheInitialSize:                                 {valueType: IntType},
+	EventsCacheMaxSize:                                     {valueType: IntType},
+	EventsCacheTTL:                                         {valueType: DurationType},
+	AcquireShardInterval:                                   {valueType: DurationType},
+	AcquireShardConcurrency:                                {valueType: IntType},
+	StandbyClusterDelay:                                    {valueType: DurationType},
+	StandbyTaskMissingEventsResendDelay:                    {valueType: DurationType},
+	StandbyTaskMissingEventsDiscardDelay:                   {valueType: DurationType},
+	TaskProcessRPS:                                         {valueType: IntType, filters: namespaceFilters},
+	TaskSchedulerType:                                      {valueType: IntType},
+	TaskSchedulerWorkerCount:                               {valueType: IntType},
+	TaskSchedulerQueueSize:                                 {valueType: IntType},
+	TaskSchedulerRoundRobinWeights:                         {valueType: MapType},
+	TimerTaskBatchSize:                                     {valueType: IntType},
+	TimerTaskWorkerCount:                                   {valueType: IntType},
+	TimerTaskMaxRetryCount:                                 {valueType: IntType},
+	TimerProcessorGetFailureRetryCount:                     {valueType: IntType},
+	TimerProcessorCompleteTimerFailureRetryCount:           {valueType: IntType},
+	TimerProcessorUpdateShardTaskCount:                     {valueType: IntType},
+	TimerProcessorUpdateAckInterval:                        {valueType: DurationType},
+	TimerProcessorUpdateAckIntervalJitterCoefficient:       {valueType: FloatType},
+	TimerProcessorCompleteTimerInterval:                    {valueType: DurationType},
+	TimerProcessorFailoverMaxPollRPS:                       {valueType: IntType},
+	TimerProcessorMaxPollRPS:                               {valueType: IntType},
+	TimerProcessorMaxPollInterval:                          {valueType: DurationType},
+	TimerProcessorMaxPollIntervalJitterCoefficient:         {valueType: FloatType},
+	TimerProcessorRedispatchInterval:                       {valueType: DurationType},
+	TimerProcessorRedispatchIntervalJitterCoefficient:      {valueType: FloatType},
+	TimerProcessorMaxRedispatchQueueSize:                   {valueType: IntType},
+	TimerProcessorEnablePriorityTaskProcessor:              {valueType: BoolType},
+	TimerProcessorMaxTimeShift:                             {valueType: DurationType},
+	TimerProcessorHistoryArchivalSizeLimit:                 {valueType: IntType},
+	TimerProcessorArchivalTimeLimit:                        {valueType: DurationType},
+	TimerProcessorArchiveWorkflowBundle:                    {valueType: BoolType, filters: namespaceFilters},
+	TransferTaskBatchSize:                                  {valueType: IntType},
+	TransferProcessorFailoverMaxPollRPS:                    {valueType: IntType},
+	TransferProcessorMaxPollRPS:                            {valueType: IntType},
+	TransferTaskWorkerCount:                                {valueType: IntType},
+	TransferTaskMaxRetryCount:                              {valueType: IntType},
+	TransferProcessorCompleteTransferFailureRetryCount:     {valueType: IntType},
+	TransferProcessorUpdateShardTaskCount:                  {valueType: IntType},
+	TransferProcessorMaxPollInterval:                       {valueType: DurationType},
+	TransferProcessorMaxPollIntervalJitterCoefficient:      {valueType: FloatType},
+	TransferProcessorUpdateAckInterval:                     {valueType: DurationType},
+	TransferProcessorUpdateAckIntervalJitterCoefficient:    {valueType: FloatType},
+	TransferProcessorCompleteTransferInterval:              {valueType: DurationType},
+	TransferProcessorRedispatchInterval:                    {valueType: DurationType},
+	TransferProcessorRedispatchIntervalJitterCoefficient:   {valueType: FloatType},
+	TransferProcessorMaxRedispatchQueueSize:                {valueType: IntType},
+	TransferProcessorEnablePriorityTaskProcessor:           {valueType: BoolType},
+	TransferProcessorVisibilityArchivalTimeLimit:           {valueType: DurationType},
+	ReplicatorTaskBatchSize:                                {valueType: IntType},
+	ReplicatorTaskWorkerCount:                              {valueType: IntType},
+	ReplicatorTaskMaxRetryCount:                            {valueType: IntType},
+	ReplicatorProcessorMaxPollRPS:                          {valueType: IntType},
+	ReplicatorProcessorUpdateShardTaskCount:                {valueType: IntType},
+	ReplicatorProcessorMaxPollInterval:                     {valueType: DurationType},
+	ReplicatorProcessorMaxPollIntervalJitterCoefficient:    {valueType: FloatType},
+	ReplicatorProcessorUpdateAckInterval:                   {valueType: DurationType},
+	ReplicatorProcessorUpdateAckIntervalJitterCoefficient:  {valueType: FloatType},
+	ReplicatorProcessorRedispatchInterval:                  {valueType: DurationType},
+	ReplicatorProcessorRedispatchIntervalJitterCoefficient: {valueType: FloatType},
+	ReplicatorProcessorMaxRedispatchQueueSize:              {valueType: IntType},
+	ReplicatorProcessorEnablePriorityTaskProcessor:         {valueType: BoolType},
+	ExecutionMgrNumConns:                                   {valueType: IntType},
+	HistoryMgrNumConns:                                     {valueType: IntType},
+	MaximumBufferedEventsBatch:                             {valueType: IntType},
+	MaximumSignalsPerExecution:                             {valueType: IntType, filters: namespaceFilters},
+	ShardUpdateMinInterval:                                 {valueType: DurationType},
+	ShardSyncMinInterval:                                   {valueType: DurationType},
+	ShardSyncTimerJitterCoefficient:                        {valueType: FloatType},
+	DefaultEventEncoding:                                   {valueType: StringType, filters: namespaceFilters},
+	LargePayloadThreshold:                                  {valueType: IntType, filters: namespaceFilters},
+	EnableAdminProtection:                                  {valueType: BoolType},
+	AdminOperationToken:                                    {valueType: StringType},
+	EnableParentClosePolicy:                                {valueType: BoolType, filters: namespaceFilters},
+	NumArchiveSystemWorkflows:                              {valueType: IntType},
+	ArchiveRequestRPS:                                      {valueType: IntType},
+	EmitShardDiffLog:                                       {valueType: BoolType},
+	HistoryThrottledLogRPS:                                 {valueType: IntType},
+	StickyTTL:                                              {valueType: DurationType, filters: namespaceFilters},
+	DefaultWorkflowExecutionTimeout:                        {valueType: DurationType, filters: namespaceFilters},
+	DefaultWorkflowRunTimeout:                              {valueType: DurationType, filters: namespaceFilters},
+	MaxWorkflowExecutionTimeout:                            {valueType: DurationType, filters: namespaceFilters},
+	MaxWorkflowRunTimeout:                                  {valueType: DurationType, filters: namespaceFilters},
+	WorkflowTaskHeartbeatTimeout:                           {valueType: DurationType, filters: namespaceFilters},
+	DefaultWorkflowTaskTimeout:                             {valueType: DurationType, filters: namespaceFilters},
+	ParentClosePolicyThreshold:                             {valueType: IntType, filters: namespaceFilters},
+	NumParentClosePolicySystemWorkflows:                    {valueType: IntType},
+	ReplicationTaskFetcherParallelism:                      {valueType: IntType},
+	ReplicationTaskFetcherAggregationInterval:              {valueType: DurationType},
+	ReplicationTaskFetcherTimerJitterCoefficient:           {valueType: FloatType},
+	ReplicationTaskFetcherErrorRetryWait:                   {valueType: DurationType},
+	ReplicationTaskProcessorErrorRetryWait:                 {valueType: DurationType, filters: shardIDFilters},
+	ReplicationTaskProcessorErrorRetryMaxAttempts:          {valueType: IntType, filters: shardIDFilters},
+	ReplicationTaskProcessorNoTaskInitialWait:              {valueType: DurationType, filters: shardIDFilters},
+	ReplicationTaskProcessorCleanupInterval:                {valueType: DurationType, filters: shardIDFilters},
+	ReplicationTaskProcessorCleanupJitterCoefficient:       {valueType: FloatType, filters: shardIDFilters},
+	ReplicationTaskProcessorStartWait:                      {valueType: DurationType, filters: shardIDFilters},
+	ReplicationTaskProcessorStartWaitJitterCoefficient:     {valueType: FloatType, filters: shardIDFilters},
+	ReplicationTaskProcessorHostQPS:                        {valueType: FloatType},
+	ReplicationTaskProcessorShardQPS:                       {valueType: FloatType},
+	HistoryEnableRPCReplication:                            {valueType: BoolType},
+	HistoryEnableKafkaReplication:                          {valueType: BoolType},
+	HistoryEnableCleanupReplicationTask:                    {valueType: BoolType},
+	MaxBufferedQueryCount:                                  {valueType: IntType},
+	MutableStateChecksumGenProbability:                     {valueType: IntType, filters: namespaceFilters},
+	MutableStateChecksumVerifyProbability:                  {valueType: IntType, filters: namespaceFilters},
+	MutableStateChecksumInvalidateBefore:                   {valueType: FloatType},
+	ReplicationEventsFromCurrentCluster:                    {valueType: BoolType, filters: namespaceFilters},
+	StandbyTaskReReplicationContextTimeout:                 {valueType: DurationType, filters: namespaceIDFilters},
+	EnableDropStuckTaskByNamespaceID:                       {valueType: BoolType, filters: namespaceIDFilters},
+	SkipReapplicationByNamespaceId:                         {valueType: BoolType, filters: namespaceIDFilters},
+	DefaultActivityRetryPolicy:                             {valueType: MapType, filters: namespaceFilters},
+	DefaultWorkflowRetryPolicy:                             {valueType: MapType, filters: namespaceFilters},
+	EnableCompleteUnstartedActivityByID:                    {valueType: BoolType, filters: namespaceFilters},
+	CrossNamespaceCallAllowList:                            {valueType: MapType, filters: namespaceIDFilters},
+	StartRequestIDTTL:                                      {valueType: DurationType, filters: namespaceFilters},
+
+	WorkerPersistenceMaxQPS:                         {valueType: IntType},
+	WorkerPersistenceGlobalMaxQPS:                   {valueType: IntType},
+	WorkerReplicatorMetaTaskConcurrency:             {valueType: IntType},
+	WorkerReplicatorTaskConcurrency:                 {valueType: IntType},
+	WorkerReplicatorMessageConcurrency:              {valueType: IntType},
+	WorkerReplicatorActivityBufferRetryCount:        {valueType: IntType},
+	WorkerReplicatorHistoryBufferRetryCount:         {valueType: IntType},
+	WorkerReplicationTaskMaxRetryCount:              {valueType: IntType},
+	WorkerReplicationTaskMaxRetryDuration:           {valueType: DurationType},
+	WorkerReplicationTaskContextDuration:            {valueType: DurationType},
+	WorkerReReplicationContextTimeout:               {valueType: DurationType, filters: namespaceIDFilters},
+	WorkerEnableRPCReplication:                      {valueType: BoolType},
+	WorkerEnableKafkaReplication:                    {valueType: BoolType},
+	WorkerIndexerConcurrency:                        {valueType: IntType},
+	WorkerESProcessorNumOfWorkers:                   {valueType: IntType},
+	WorkerESProcessorBulkActions:                    {valueType: IntType},
+	WorkerESProcessorBulkSize:                       {valueType: IntType},
+	WorkerESProcessorFlushInterval:                  {valueType: DurationType},
+	EnableArchivalCompression:                       {valueType: BoolType},
+	WorkerHistoryPageSize:                           {valueType: IntType},
+	WorkerTargetArchivalBlobSize:                    {valueType: IntType},
+	WorkerArchiverConcurrency:                       {valueType: IntType},
+	WorkerArchivalsPerIteration:                     {valueType: IntType},
+	WorkerDeterministicConstructionCheckProbability: {valueType: FloatType},
+	WorkerBlobIntegrityCheckProbability:             {valueType: FloatType},
+	WorkerTimeLimitPerArchivalIteration:             {valueType: DurationType},
+	WorkerThrottledLogRPS:                           {valueType: IntType},
+	ScannerPersistenceMaxQPS:                        {valueType: IntType},
+	NamespaceDeleterPersistenceMaxQPS:               {valueType: IntType},
+	TaskQueueScannerEnabled:                         {valueType: BoolType},
+	HistoryScannerEnabled:                           {valueType: BoolType},
+	ExecutionsScannerEnabled:                        {valueType: BoolType},
+	ExecutionsScannerFixEnabled:                     {valueType: BoolType},
+	ArchivalScannerEnabled:                          {valueType: BoolType},
+	ArchivalScannerSampleRate:                       {valueType: FloatType},
+	ArchivalScannerReArchiveEnabled:                 {valueType: BoolType},
+}
+
+var (
+	keyNames    = make(map[string]Key, len(keys))
+	filterNames = make(map[string]Filter, len(filters))
+	keyDefaults sync.Map // map of Key to the default value registered by Collection
+)
+
+func init() {
+	for key, name := range keys {
+		keyNames[name] = key
+	}
+	for filter := unknownFilter + 1; filter < lastFilterTypeForTest; filter++ {
+		filterNames[filter.String()] = filter
+	}
+}
+
+// ValueType returns the declared value type of the key
+func (k Key) ValueType() ValueType {
+	return keyDefinitions[k].valueType
+}
+
+// AllowedFilters returns the filters which can be used as constraints for the key
+func (k Key) AllowedFilters() []Filter {
+	return keyDefinitions[k].filters
+}
+
+// KeyFromName returns the key registered under the given name
+func KeyFromName(name string) (Key, bool) {
+	key, ok := keyNames[name]
+	return key, ok && key != unknownKey
+}
+
+// Keys returns all registered keys except the test ones sorted by name
+func Keys() []Key {
+	result := make([]Key, 0, len(keys))
+	for key := testGetBoolPropertyFilteredByTaskQueueInfoKey + 1; key < lastKeyForTest; key++ {
+		result = append(result, key)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return keys[result[i]] < keys[result[j]]
+	})
+	return result
+}
+
+// DefaultValue returns the default value of the key as registered by the services running in this process.
+// Durations are returned in their string form, the same way they are written in config.
+func DefaultValue(key Key) (interface{}, bool) {
+	return keyDefaults.Load(key)
+}
+
+func registerDefault(key Key, defaultValue interface{}) {
+	if d, ok := defaultValue.(time.Duration); ok {
+		defaultValue = d.String()
+	}
+	keyDefaults.Store(key, defaultValue)
+}
+
+// DecodeValue decodes a yaml (or json) encoded value and validates it against the type of the key
+func DecodeValue(key Key, data []byte) (interface{}, error) {
+	var value interface{}
+	if err := yaml.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("failed to decode value of %v: %v", key, err)
+	}
+	value, err := convertKeyTypeToString(value)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateValue(key, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// ParseFilters converts filter names and their string values to the filter map used by Client
+func ParseFilters(key Key, filterValues map[string]string) (map[Filter]interface{}, error) {
+	result := make(map[Filter]interface{}, len(filterValues))
+	for name, value := range filterValues {
+		filter, ok := filterNames[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown filter %v", name)
+		}
+		if !isFilterAllowed(key, filter) {
+			return nil, fmt.Errorf("filter %v is not allowed for %v", name, key)
+		}
+		switch filter {
+		case TaskType:
+			taskType, ok := enumspb.TaskQueueType_value[value]
+			if !ok {
+				return nil, fmt.Errorf("invalid task type %v", value)
+			}
+			result[filter] = enumspb.TaskQueueType(taskType)
+		case ShardID:
+			shardID, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid shard id %v", value)
+			}
+			result[filter] = shardID
+		default:
+			result[filter] = value
+		}
+	}
+	return result, nil
+}
+
+func isFilterAllowed(key Key, filter Filter) bool {
+	for _, f := range key.AllowedFilters() {
+		if f == filter {
+			return true
+		}
+	}
+	return false
+}
+
+// validateValues checks that every key is registered and all its values match the key definition
+func validateValues(values map[string][]*constrainedValue) error {
+	for name, constrainedValues := range values {
+		key, ok := KeyFromName(name)
+		if !ok {
+			return fmt.Errorf("unknown dynamic config key %v", name)
+		}
+		for _, cv := range constrainedValues {
+			if err := validateValue(key, cv.Value); err != nil {
+				return err
+			}
+			for filterName := range cv.Constraints {
+				filter, ok := filterNames[filterName]
+				if !ok || !isFilterAllowed(key, filter) {
+					return fmt.Errorf("constraint %v is not allowed for %v", filterName, name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func validateValue(key Key, value interface{}) error {
+	valueType := key.ValueType()
+	valid := false
+	switch valueType {
+	case AnyType:
+		valid = true
+	case IntType:
+		_, valid = value.(int)
+	case FloatType:
+		switch value.(type) {
+		case int, float64:
+			valid = true
+		}
+	case BoolType:
+		_, valid = value.(bool)
+	case StringType:
+		_, valid = value.(string)
+	case MapType:
+		_, valid = value.(map[string]interface{})
+	case DurationType:
+		if s, ok := value.(string); ok {
+			_, err := time.ParseDuration(s)
+			valid = err == nil
+		}
+	}
+	if !valid {
+		return fmt.Errorf("value %v of %v is not of type %v", value, key, valueType)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/log"
+)
+
+func TestDynamicConfigKeyIsDefined(t *testing.T) {
+	for i := unknownKey + 1; i < lastKeyForTest; i++ {
+		_, ok := keyDefinitions[i]
+		require.True(t, ok, fmt.Sprintf("key %v is not defined", i))
+	}
+	require.Equal(t, len(keys)-1, len(keyDefinitions))
+	require.Equal(t, len(keys), len(keyNames), "key names must be unique")
+}
+
+func TestKeyFromName(t *testing.T) {
+	key, ok := KeyFromName("history.persistenceMaxQPS")
+	require.True(t, ok)
+	require.Equal(t, HistoryPersistenceMaxQPS, key)
+
+	_, ok = KeyFromName("history.persistenceMaxQps")
+	require.False(t, ok)
+	_, ok = KeyFromName("unknownKey")
+	require.False(t, ok)
+}
+
+func TestKeys(t *testing.T) {
+	result := Keys()
+	require.Equal(t, int(lastKeyForTest-EnableGlobalNamespace), len(result))
+	for i := 1; i < len(result); i++ {
+		require.True(t, result[i-1].String() < result[i].String())
+	}
+}
+
+func TestValidateValues(t *testing.T) {
+	valid := map[string][]*constrainedValue{
+		"history.persistenceMaxQPS": {{Value: 3000}},
+		"frontend.visibilityListMaxQPS": {
+			{Value: 10},
+			{Value: 20, Constraints: map[string]interface{}{"namespace": "samples-namespace"}},
+		},
+		"matching.longPollExpirationInterval":                    {{Value: "1m"}},
+		"history.standbyTaskMissingEventsResendDelay":            {{Value: "15m"}},
+		"history.timerProcessorMaxPollIntervalJitterCoefficient": {{Value: 1}},
+	}
+	require.NoError(t, validateValues(valid))
+
+	testCases := map[string]map[string][]*constrainedValue{
+		"unknown key": {
+			"history.persistenceMaxQps": {{Value: 3000}},
+		},
+		"wrong type": {
+			"history.persistenceMaxQPS": {{Value: "3000"}},
+		},
+		"invalid duration": {
+			"matching.longPollExpirationInterval": {{Value: "1 minute"}},
+		},
+		"filter not allowed": {
+			"history.persistenceMaxQPS": {{Value: 3000, Constraints: map[string]interface{}{"namespace": "samples-namespace"}}},
+		},
+		"unknown filter": {
+			"frontend.visibilityListMaxQPS": {{Value: 10, Constraints: map[string]interface{}{"cluster": "active"}}},
+		},
+	}
+	for name, values := range testCases {
+		require.Error(t, validateValues(values), name)
+	}
+}
+
+func TestDecodeValue(t *testing.T) {
+	value, err := DecodeValue(HistoryPersistenceMaxQPS, []byte("3000"))
+	require.NoError(t, err)
+	require.Equal(t, 3000, value)
+
+	value, err = DecodeValue(ValidSearchAttributes, []byte(`{"CustomKeywordField": 1}`))
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"CustomKeywordField": 1}, value)
+
+	_, err = DecodeValue(HistoryPersistenceMaxQPS, []byte("true"))
+	require.Error(t, err)
+}
+
+func TestParseFilters(t *testing.T) {
+	filters, err := ParseFilters(MatchingNumTaskqueueReadPartitions, map[string]string{
+		"namespace":     "samples-namespace",
+		"taskQueueName": "samples-taskqueue",
+		"taskType":      "Activity",
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[Filter]interface{}{
+		Namespace:     "samples-namespace",
+		TaskQueueName: "samples-taskqueue",
+		TaskType:      enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	}, filters)
+
+	_, err = ParseFilters(MatchingNumTaskqueueReadPartitions, map[string]string{"taskType": "Timer"})
+	require.Error(t, err)
+	_, err = ParseFilters(HistoryPersistenceMaxQPS, map[string]string{"namespace": "samples-namespace"})
+	require.Error(t, err)
+}
+
+func TestDefaultValue(t *testing.T) {
+	collection := NewCollection(newInMemoryClient(), log.NewNoop())
+	collection.GetDurationProperty(testGetDurationPropertyKey, time.Minute)
+
+	value, ok := DefaultValue(testGetDurationPropertyKey)
+	require.True(t, ok)
+	require.Equal(t, "1m0s", value)
+}
@@ -0,0 +1,100 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"reflect"
+	"sync/atomic"
+
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+// UpdateNotifier is implemented by clients which know when their values change.
+// Collections created with such a client cache the values read by property functions
+// and notify subscribers about changes instead of relying on every read to reach the client.
+type UpdateNotifier interface {
+	// AddUpdateCallback registers a callback invoked after the client values are updated
+	AddUpdateCallback(callback func())
+}
+
+type subscription struct {
+	key      Key
+	filters  map[Filter]interface{}
+	value    interface{}
+	onChange func()
+}
+
+// Subscribe registers onChange to be called whenever the effective value of the key for the given
+// filters changes. onChange is not called for the current value, it should read the new value through
+// the corresponding property function. The returned function cancels the subscription.
+// Subscriptions only fire for clients implementing UpdateNotifier.
+func (c *Collection) Subscribe(key Key, onChange func(), opts ...FilterOption) func() {
+	sub := &subscription{
+		key:      key,
+		filters:  getFilterMap(opts...),
+		onChange: onChange,
+	}
+	sub.value, _ = c.client.GetValueWithFilters(key, sub.filters, nil)
+
+	c.subscriptionLock.Lock()
+	defer c.subscriptionLock.Unlock()
+	c.subscriptions[sub] = struct{}{}
+	return func() {
+		c.subscriptionLock.Lock()
+		defer c.subscriptionLock.Unlock()
+		delete(c.subscriptions, sub)
+	}
+}
+
+// SubscribeFnWithTaskQueueInfoFilters subscribes to changes of a property with three filters: namespace, taskQueue, taskType
+type SubscribeFnWithTaskQueueInfoFilters func(namespace string, taskQueue string, taskType enumspb.TaskQueueType, onChange func()) func()
+
+// GetSubscriptionFilteredByTaskQueueInfo returns a function to subscribe to changes of a property with taskQueueInfo as filters
+func (c *Collection) GetSubscriptionFilteredByTaskQueueInfo(key Key) SubscribeFnWithTaskQueueInfoFilters {
+	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType, onChange func()) func() {
+		return c.Subscribe(key, onChange, NamespaceFilter(namespace), TaskQueueFilter(taskQueue), TaskTypeFilter(taskType))
+	}
+}
+
+func (c *Collection) onClientUpdate() {
+	// values must be stored by the client before the version is bumped,
+	// otherwise property caches may keep stale values under the new version
+	atomic.AddInt64(&c.version, 1)
+
+	var changed []func()
+	c.subscriptionLock.Lock()
+	for sub := range c.subscriptions {
+		value, _ := c.client.GetValueWithFilters(sub.key, sub.filters, nil)
+		if !reflect.DeepEqual(value, sub.value) {
+			sub.value = value
+			changed = append(changed, sub.onChange)
+		}
+	}
+	c.subscriptionLock.Unlock()
+
+	for _, onChange := range changed {
+		onChange()
+	}
+}
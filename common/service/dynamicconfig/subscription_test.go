@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	subscriptionSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		provider   *testProvider
+		collection *Collection
+		doneCh     chan struct{}
+	}
+
+	testProvider struct {
+		update func(content []byte) error
+	}
+)
+
+func TestSubscriptionSuite(t *testing.T) {
+	s := new(subscriptionSuite)
+	suite.Run(t, s)
+}
+
+func (p *testProvider) Start(update func(content []byte) error) error {
+	p.update = update
+	return update([]byte(""))
+}
+
+func (p *testProvider) Stop() {}
+
+func (s *subscriptionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.provider = &testProvider{}
+	s.doneCh = make(chan struct{})
+	client, err := NewProviderClient(s.provider, log.NewNoop(), s.doneCh)
+	s.NoError(err)
+	s.collection = NewCollection(client, log.NewNoop())
+}
+
+func (s *subscriptionSuite) TearDownTest() {
+	close(s.doneCh)
+}
+
+func (s *subscriptionSuite) TestSubscribe() {
+	notified := 0
+	unsubscribe := s.collection.GetSubscriptionFilteredByTaskQueueInfo(testGetIntPropertyFilteredByTaskQueueInfoKey)(
+		"samples-namespace", "samples-taskqueue", enumspb.TASK_QUEUE_TYPE_WORKFLOW, func() { notified++ })
+
+	s.NoError(s.provider.update([]byte(`
+testGetIntPropertyFilteredByTaskQueueInfoKey:
+- value: 10
+`)))
+	s.Equal(1, notified)
+
+	// value of another task queue doesn't change the effective value
+	s.NoError(s.provider.update([]byte(`
+testGetIntPropertyFilteredByTaskQueueInfoKey:
+- value: 10
+- value: 20
+  constraints:
+    namespace: samples-namespace
+    taskQueueName: other-taskqueue
+    taskType: 1
+`)))
+	s.Equal(1, notified)
+
+	s.NoError(s.provider.update([]byte(`
+testGetIntPropertyFilteredByTaskQueueInfoKey:
+- value: 10
+- value: 30
+  constraints:
+    namespace: samples-namespace
+`)))
+	s.Equal(1, notified)
+
+	unsubscribe()
+	s.NoError(s.provider.update([]byte(`
+testGetIntPropertyFilteredByTaskQueueInfoKey:
+- value: 40
+`)))
+	s.Equal(1, notified)
+}
+
+func (s *subscriptionSuite) TestPropertyCache() {
+	value := s.collection.GetIntPropertyFilteredByNamespace(testGetIntPropertyFilteredByNamespaceKey, 10)
+	s.Equal(10, value("samples-namespace"))
+
+	s.NoError(s.provider.update([]byte(`
+testGetIntPropertyFilteredByNamespaceKey:
+- value: 20
+  constraints:
+    namespace: samples-namespace
+`)))
+	s.Equal(20, value("samples-namespace"))
+	s.Equal(10, value("other-namespace"))
+
+	duration := s.collection.GetDurationProperty(testGetDurationPropertyKey, time.Second)
+	s.Equal(time.Second, duration())
+	s.NoError(s.provider.update([]byte(`
+testGetDurationPropertyKey:
+- value: 1m
+`)))
+	s.Equal(time.Minute, duration())
+}
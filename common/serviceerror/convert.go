@@ -49,6 +49,11 @@ func FromStatus(st *status.Status) error {
 		case *errordetails.TaskAlreadyStartedFailure:
 			return newTaskAlreadyStarted(st)
 		}
+	case codes.FailedPrecondition:
+		switch errDetails.(type) {
+		case *errordetails.FailedPreconditionFailure:
+			return newFailedPrecondition(st)
+		}
 	case codes.Aborted:
 		switch errDetails := errDetails.(type) {
 		case *errordetails.ShardOwnershipLostFailure:
@@ -46,3 +46,15 @@ func TestFromToStatus(t *testing.T) {
 	assert.Equal(t, err.Message, solErr.Message)
 	assert.Equal(t, err.OwnerHost, solErr.OwnerHost)
 }
+
+func TestFromToStatus_FailedPrecondition(t *testing.T) {
+	err := NewFailedPrecondition("mess")
+
+	st := serviceerror.ToStatus(err)
+	err1 := FromStatus(st)
+	var fpErr *FailedPrecondition
+	if !errors.As(err1, &fpErr) {
+		assert.Fail(t, "Returned error is not of type *FailedPrecondition")
+	}
+	assert.Equal(t, err.Message, fpErr.Message)
+}
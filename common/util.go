@@ -43,6 +43,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	workflowspb "go.temporal.io/server/api/workflow/v1"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common/backoff"
@@ -350,6 +351,8 @@ func CreateMatchingPollWorkflowTaskQueueResponse(historyResponse *historyservice
 		ScheduledTime:              historyResponse.ScheduledTime,
 		StartedTime:                historyResponse.StartedTime,
 		Queries:                    historyResponse.Queries,
+		HistorySizeBytes:           historyResponse.HistorySizeBytes,
+		SuggestContinueAsNew:       historyResponse.SuggestContinueAsNew,
 	}
 
 	return matchingResp
@@ -484,6 +487,45 @@ func ValidateRetryPolicy(policy *commonpb.RetryPolicy) error {
 	return nil
 }
 
+// ValidateDeadlineRules validates the deadline rules of a workflow
+func ValidateDeadlineRules(rules []*workflowspb.DeadlineRule) error {
+	names := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		if rule.GetName() == "" {
+			return serviceerror.NewInvalidArgument("Name is not set on deadline rule.")
+		}
+		if _, ok := names[rule.GetName()]; ok {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("Deadline rule %v is defined more than once.", rule.GetName()))
+		}
+		names[rule.GetName()] = struct{}{}
+
+		if rule.GetEventType() == enumspb.EVENT_TYPE_UNSPECIFIED {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("EventType is not set on deadline rule %v.", rule.GetName()))
+		}
+		if rule.GetSignalName() != "" && rule.GetEventType() != enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("SignalName is set on deadline rule %v which is not waiting for a signal.", rule.GetName()))
+		}
+		if timestamp.DurationValue(rule.GetTimeout()) <= 0 {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("Timeout must be positive on deadline rule %v.", rule.GetName()))
+		}
+		if rule.GetAction() == enumsspb.DEADLINE_ACTION_UNSPECIFIED {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("Action is not set on deadline rule %v.", rule.GetName()))
+		}
+	}
+	return nil
+}
+
+// IsReservedSignalName returns true if the signals with the name are only recorded by the server
+func IsReservedSignalName(name string) bool {
+	switch name {
+	case ContinueAsNewSuggestedSignalName, WorkflowPausedSignalName, WorkflowUnpausedSignalName,
+		ActivityOptionsUpdatedSignalName, DeadlineExceededSignalName:
+		return true
+	default:
+		return false
+	}
+}
+
 func GetDefaultRetryPolicyConfigOptions() map[string]interface{} {
 	return map[string]interface{}{
 		initialIntervalInSecondsConfigKey:   int(defaultInitialInterval.Seconds()),
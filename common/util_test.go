@@ -9,8 +9,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
@@ -95,6 +98,85 @@ func TestValidateRetryPolicy(t *testing.T) {
 	}
 }
 
+func TestValidateDeadlineRules(t *testing.T) {
+	newRule := func(name string) *workflowspb.DeadlineRule {
+		return &workflowspb.DeadlineRule{
+			Name:      name,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Timeout:   timestamp.DurationPtr(time.Hour),
+			Action:    enumsspb.DEADLINE_ACTION_TERMINATE,
+		}
+	}
+
+	noName := newRule("")
+	noEventType := newRule("approval")
+	noEventType.EventType = enumspb.EVENT_TYPE_UNSPECIFIED
+	signalNameNotSignal := newRule("approval")
+	signalNameNotSignal.EventType = enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED
+	signalNameNotSignal.SignalName = "approve"
+	noTimeout := newRule("approval")
+	noTimeout.Timeout = nil
+	noAction := newRule("approval")
+	noAction.Action = enumsspb.DEADLINE_ACTION_UNSPECIFIED
+
+	testCases := []struct {
+		name          string
+		input         []*workflowspb.DeadlineRule
+		wantErrString string
+	}{
+		{
+			name:  "no rules is okay",
+			input: nil,
+		},
+		{
+			name:  "valid rules",
+			input: []*workflowspb.DeadlineRule{newRule("approval"), newRule("review")},
+		},
+		{
+			name:          "name not set",
+			input:         []*workflowspb.DeadlineRule{noName},
+			wantErrString: "Name is not set on deadline rule.",
+		},
+		{
+			name:          "duplicate name",
+			input:         []*workflowspb.DeadlineRule{newRule("approval"), newRule("approval")},
+			wantErrString: "Deadline rule approval is defined more than once.",
+		},
+		{
+			name:          "event type not set",
+			input:         []*workflowspb.DeadlineRule{noEventType},
+			wantErrString: "EventType is not set on deadline rule approval.",
+		},
+		{
+			name:          "signal name on other event type",
+			input:         []*workflowspb.DeadlineRule{signalNameNotSignal},
+			wantErrString: "SignalName is set on deadline rule approval which is not waiting for a signal.",
+		},
+		{
+			name:          "timeout not set",
+			input:         []*workflowspb.DeadlineRule{noTimeout},
+			wantErrString: "Timeout must be positive on deadline rule approval.",
+		},
+		{
+			name:          "action not set",
+			input:         []*workflowspb.DeadlineRule{noAction},
+			wantErrString: "Action is not set on deadline rule approval.",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDeadlineRules(tt.input)
+			if tt.wantErrString != "" {
+				assert.NotNil(t, err, "expected error - did not get one")
+				assert.Equal(t, tt.wantErrString, err.Error(), "unexpected error message")
+			} else {
+				assert.Nil(t, err, "unexpected error")
+			}
+		})
+	}
+}
+
 func TestEnsureRetryPolicyDefaults(t *testing.T) {
 	defaultRetrySettings := DefaultRetrySettings{
 		InitialInterval:            time.Second,
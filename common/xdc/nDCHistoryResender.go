@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/server/api/adminservice/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/collection"
@@ -81,6 +82,7 @@ type (
 	historyBatch struct {
 		versionHistory *historyspb.VersionHistory
 		rawEventBatch  *commonpb.DataBlob
+		deadlineRules  *workflowspb.DeadlineRules
 	}
 )
 
@@ -156,7 +158,8 @@ func (n *NDCHistoryResenderImpl) SendSingleWorkflowHistory(
 			workflowID,
 			runID,
 			historyBatch.rawEventBatch,
-			historyBatch.versionHistory.GetItems())
+			historyBatch.versionHistory.GetItems(),
+			historyBatch.deadlineRules)
 
 		err = n.sendReplicationRawRequest(ctx, replicationRequest)
 		if err != nil {
@@ -206,6 +209,7 @@ func (n *NDCHistoryResenderImpl) getPaginationFn(
 			batch := &historyBatch{
 				versionHistory: versionHistory,
 				rawEventBatch:  history,
+				deadlineRules:  response.GetDeadlineRules(),
 			}
 			paginateItems = append(paginateItems, batch)
 		}
@@ -219,6 +223,7 @@ func (n *NDCHistoryResenderImpl) createReplicationRawRequest(
 	runID string,
 	historyBlob *commonpb.DataBlob,
 	versionHistoryItems []*historyspb.VersionHistoryItem,
+	deadlineRules *workflowspb.DeadlineRules,
 ) *historyservice.ReplicateEventsV2Request {
 
 	request := &historyservice.ReplicateEventsV2Request{
@@ -229,6 +234,7 @@ func (n *NDCHistoryResenderImpl) createReplicationRawRequest(
 		},
 		Events:              historyBlob,
 		VersionHistoryItems: versionHistoryItems,
+		DeadlineRules:       deadlineRules,
 	}
 	return request
 }
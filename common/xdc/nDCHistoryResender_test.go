@@ -43,6 +43,7 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/cluster"
@@ -247,6 +248,7 @@ func (s *nDCHistoryResenderSuite) TestCreateReplicateRawEventsRequest() {
 			Version: 1,
 		},
 	}
+	deadlineRules := &workflowspb.DeadlineRules{Rules: []*workflowspb.DeadlineRule{{Name: "approval"}}}
 
 	s.Equal(&historyservice.ReplicateEventsV2Request{
 		NamespaceId: s.namespaceID,
@@ -256,12 +258,14 @@ func (s *nDCHistoryResenderSuite) TestCreateReplicateRawEventsRequest() {
 		},
 		VersionHistoryItems: versionHistoryItems,
 		Events:              blob,
+		DeadlineRules:       deadlineRules,
 	}, s.rereplicator.createReplicationRawRequest(
 		s.namespaceID,
 		workflowID,
 		runID,
 		blob,
-		versionHistoryItems))
+		versionHistoryItems,
+		deadlineRules))
 }
 
 func (s *nDCHistoryResenderSuite) TestSendReplicationRawRequest() {
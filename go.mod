@@ -4,6 +4,7 @@ go 1.14
 
 require (
 	cloud.google.com/go/storage v1.9.0
+	github.com/Azure/azure-storage-blob-go v0.10.0
 	github.com/Shopify/sarama v1.26.4
 	github.com/apache/thrift v0.0.0-20161221203622-b2a4d4ae21c7 // indirect
 	github.com/aws/aws-sdk-go v1.31.12
@@ -70,6 +71,7 @@ require (
 	google.golang.org/api v0.26.0
 	google.golang.org/grpc v1.32.0
 	google.golang.org/grpc/examples v0.0.0-20200625174016-7a808837ae92
+	gopkg.in/natefinch/lumberjack.v2 v2.0.0
 	gopkg.in/validator.v2 v2.0.0-20200605151824-2b28d334fa05
 	gopkg.in/yaml.v2 v2.3.0
 	gopkg.in/yaml.v3 v3.0.0-20200615113413-eeeca48fe776 // indirect
@@ -65,6 +65,17 @@ CREATE TABLE current_executions(
   PRIMARY KEY (shard_id, namespace_id, workflow_id)
 );
 
+CREATE TABLE start_request_ids(
+  shard_id INT NOT NULL,
+  namespace_id BINARY(16) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  request_id VARCHAR(64) NOT NULL,
+  --
+  run_id BINARY(16) NOT NULL,
+  expiry_time DATETIME(6) NOT NULL,
+  PRIMARY KEY (shard_id, namespace_id, workflow_id, request_id)
+);
+
 CREATE TABLE buffered_events (
   shard_id INT NOT NULL,
   namespace_id BINARY(16) NOT NULL,
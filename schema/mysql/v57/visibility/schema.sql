@@ -11,6 +11,17 @@ CREATE TABLE executions_visibility (
   memo                 BLOB,
   encoding             VARCHAR(64) NOT NULL,
   task_queue           VARCHAR(255) DEFAULT '' NOT NULL,
+  search_attributes    JSON NULL,
+  -- the scalar values of the predefined custom search attributes, the JSON column can not be indexed
+  custom_keyword_field VARCHAR(255) GENERATED ALWAYS AS (
+    IF(JSON_TYPE(search_attributes->'$.CustomKeywordField') = 'STRING' AND CHAR_LENGTH(search_attributes->>'$.CustomKeywordField') <= 255,
+      search_attributes->>'$.CustomKeywordField', NULL)),
+  custom_int_field     BIGINT GENERATED ALWAYS AS (
+    IF(JSON_TYPE(search_attributes->'$.CustomIntField') = 'INTEGER', CAST(search_attributes->>'$.CustomIntField' AS SIGNED), NULL)),
+  custom_double_field  DOUBLE GENERATED ALWAYS AS (
+    IF(JSON_TYPE(search_attributes->'$.CustomDoubleField') IN ('INTEGER', 'DOUBLE', 'DECIMAL'), search_attributes->>'$.CustomDoubleField' + 0, NULL)),
+  custom_bool_field    BOOLEAN GENERATED ALWAYS AS (
+    IF(JSON_TYPE(search_attributes->'$.CustomBoolField') = 'BOOLEAN', search_attributes->>'$.CustomBoolField' = 'true', NULL)),
 
   PRIMARY KEY  (namespace_id, run_id)
 );
@@ -21,3 +32,10 @@ CREATE INDEX by_status_by_start_time ON executions_visibility (namespace_id, sta
 CREATE INDEX by_type_close_time ON executions_visibility (namespace_id, workflow_type_name, status, close_time DESC, run_id);
 CREATE INDEX by_workflow_id_close_time ON executions_visibility (namespace_id, workflow_id, status, close_time DESC, run_id);
 CREATE INDEX by_status_by_close_time ON executions_visibility (namespace_id, status, close_time DESC, run_id);
+CREATE INDEX by_start_time ON executions_visibility (namespace_id, start_time DESC, run_id);
+CREATE INDEX by_execution_time ON executions_visibility (namespace_id, execution_time DESC, run_id);
+CREATE INDEX by_close_time ON executions_visibility (namespace_id, close_time DESC, run_id);
+CREATE INDEX by_custom_keyword_field ON executions_visibility (namespace_id, custom_keyword_field);
+CREATE INDEX by_custom_int_field ON executions_visibility (namespace_id, custom_int_field);
+CREATE INDEX by_custom_double_field ON executions_visibility (namespace_id, custom_double_field);
+CREATE INDEX by_custom_bool_field ON executions_visibility (namespace_id, custom_bool_field);
@@ -0,0 +1,17 @@
+ALTER TABLE executions_visibility ADD search_attributes JSON NULL,
+  ADD custom_keyword_field VARCHAR(255) GENERATED ALWAYS AS (
+    IF(JSON_TYPE(search_attributes->'$.CustomKeywordField') = 'STRING' AND CHAR_LENGTH(search_attributes->>'$.CustomKeywordField') <= 255,
+      search_attributes->>'$.CustomKeywordField', NULL)),
+  ADD custom_int_field     BIGINT GENERATED ALWAYS AS (
+    IF(JSON_TYPE(search_attributes->'$.CustomIntField') = 'INTEGER', CAST(search_attributes->>'$.CustomIntField' AS SIGNED), NULL)),
+  ADD custom_double_field  DOUBLE GENERATED ALWAYS AS (
+    IF(JSON_TYPE(search_attributes->'$.CustomDoubleField') IN ('INTEGER', 'DOUBLE', 'DECIMAL'), search_attributes->>'$.CustomDoubleField' + 0, NULL)),
+  ADD custom_bool_field    BOOLEAN GENERATED ALWAYS AS (
+    IF(JSON_TYPE(search_attributes->'$.CustomBoolField') = 'BOOLEAN', search_attributes->>'$.CustomBoolField' = 'true', NULL));
+CREATE INDEX by_start_time ON executions_visibility (namespace_id, start_time DESC, run_id);
+CREATE INDEX by_execution_time ON executions_visibility (namespace_id, execution_time DESC, run_id);
+CREATE INDEX by_close_time ON executions_visibility (namespace_id, close_time DESC, run_id);
+CREATE INDEX by_custom_keyword_field ON executions_visibility (namespace_id, custom_keyword_field);
+CREATE INDEX by_custom_int_field ON executions_visibility (namespace_id, custom_int_field);
+CREATE INDEX by_custom_double_field ON executions_visibility (namespace_id, custom_double_field);
+CREATE INDEX by_custom_bool_field ON executions_visibility (namespace_id, custom_bool_field);
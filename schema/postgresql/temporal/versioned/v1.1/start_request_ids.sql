@@ -0,0 +1,10 @@
+CREATE TABLE start_request_ids(
+  shard_id INTEGER NOT NULL,
+  namespace_id BYTEA NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  request_id VARCHAR(64) NOT NULL,
+  --
+  run_id BYTEA NOT NULL,
+  expiry_time TIMESTAMP NOT NULL,
+  PRIMARY KEY (shard_id, namespace_id, workflow_id, request_id)
+);
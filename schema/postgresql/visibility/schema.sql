@@ -11,6 +11,7 @@ CREATE TABLE executions_visibility (
   memo                 BYTEA,
   encoding             VARCHAR(64) NOT NULL,
   task_queue           VARCHAR(255) DEFAULT '' NOT NULL,
+  search_attributes    JSONB NULL,
 
   PRIMARY KEY  (namespace_id, run_id)
 );
@@ -21,5 +22,12 @@ CREATE INDEX by_status_by_start_time ON executions_visibility (namespace_id, sta
 CREATE INDEX by_type_close_time ON executions_visibility (namespace_id, workflow_type_name, status, close_time DESC, run_id);
 CREATE INDEX by_workflow_id_close_time ON executions_visibility (namespace_id, workflow_id, status, close_time DESC, run_id);
 CREATE INDEX by_status_by_close_time ON executions_visibility (namespace_id, status, close_time DESC, run_id);
+CREATE INDEX by_search_attributes ON executions_visibility USING GIN (search_attributes jsonb_path_ops);
+CREATE INDEX by_start_time ON executions_visibility (namespace_id, start_time DESC, run_id);
+CREATE INDEX by_execution_time ON executions_visibility (namespace_id, execution_time DESC, run_id);
+CREATE INDEX by_close_time ON executions_visibility (namespace_id, close_time DESC, run_id);
+CREATE INDEX by_custom_int_field ON executions_visibility (namespace_id, (search_attributes->'CustomIntField'));
+CREATE INDEX by_custom_double_field ON executions_visibility (namespace_id, (search_attributes->'CustomDoubleField'));
+CREATE INDEX by_custom_datetime_field ON executions_visibility (namespace_id, (search_attributes->'CustomDatetimeField'));
 
 
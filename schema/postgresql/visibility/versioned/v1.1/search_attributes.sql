@@ -0,0 +1,8 @@
+ALTER TABLE executions_visibility ADD search_attributes JSONB NULL;
+CREATE INDEX by_search_attributes ON executions_visibility USING GIN (search_attributes jsonb_path_ops);
+CREATE INDEX by_start_time ON executions_visibility (namespace_id, start_time DESC, run_id);
+CREATE INDEX by_execution_time ON executions_visibility (namespace_id, execution_time DESC, run_id);
+CREATE INDEX by_close_time ON executions_visibility (namespace_id, close_time DESC, run_id);
+CREATE INDEX by_custom_int_field ON executions_visibility (namespace_id, (search_attributes->'CustomIntField'));
+CREATE INDEX by_custom_double_field ON executions_visibility (namespace_id, (search_attributes->'CustomDoubleField'));
+CREATE INDEX by_custom_datetime_field ON executions_visibility (namespace_id, (search_attributes->'CustomDatetimeField'));
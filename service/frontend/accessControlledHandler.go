@@ -27,9 +27,12 @@ package frontend
 import (
 	"context"
 
+	commandpb "go.temporal.io/api/command/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/resource"
@@ -39,21 +42,32 @@ import (
 
 // AccessControlledWorkflowHandler frontend handler wrapper for authentication and authorization
 type AccessControlledWorkflowHandler struct {
-	frontendHandler Handler
-	authorizer      authorization.Authorizer
+	frontendHandler   Handler
+	authorizer        authorization.Authorizer
+	identityExtractor authorization.CertIdentityExtractor
 }
 
 var _ Handler = (*AccessControlledWorkflowHandler)(nil)
 
-// NewAccessControlledHandlerImpl creates frontend handler with authentication support
-func NewAccessControlledHandlerImpl(wfHandler Handler, authorizer authorization.Authorizer) *AccessControlledWorkflowHandler {
+// NewAccessControlledHandlerImpl creates frontend handler with authentication support.
+// The actor of each request is extracted from the caller's client certificate using identityExtractor,
+// which defaults to the certificate subject common name.
+func NewAccessControlledHandlerImpl(
+	wfHandler Handler,
+	authorizer authorization.Authorizer,
+	identityExtractor authorization.CertIdentityExtractor,
+) *AccessControlledWorkflowHandler {
 	if authorizer == nil {
 		authorizer = authorization.NewNopAuthorizer()
 	}
+	if identityExtractor == nil {
+		identityExtractor = authorization.CommonNameIdentityExtractor
+	}
 
 	return &AccessControlledWorkflowHandler{
-		frontendHandler: wfHandler,
-		authorizer:      authorizer,
+		frontendHandler:   wfHandler,
+		authorizer:        authorizer,
+		identityExtractor: identityExtractor,
 	}
 }
 
@@ -591,6 +605,30 @@ func (a *AccessControlledWorkflowHandler) RespondWorkflowTaskCompleted(
 	ctx context.Context,
 	request *workflowservice.RespondWorkflowTaskCompletedRequest,
 ) (*workflowservice.RespondWorkflowTaskCompletedResponse, error) {
+
+	// commands targeting another namespace act on behalf of the caller in that namespace
+	namespace := a.taskTokenNamespace(request.GetTaskToken())
+	for _, command := range request.GetCommands() {
+		apiName, targetNamespace := crossNamespaceCommandTarget(command)
+		if targetNamespace == "" || a.canonicalNamespace(targetNamespace) == namespace {
+			continue
+		}
+
+		scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondWorkflowTaskCompletedScope, targetNamespace)
+
+		attr := &authorization.Attributes{
+			APIName:   apiName,
+			Namespace: targetNamespace,
+		}
+		isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+		if err != nil {
+			return nil, err
+		}
+		if !isAuthorized {
+			return nil, errUnauthorized
+		}
+	}
+
 	return a.frontendHandler.RespondWorkflowTaskCompleted(ctx, request)
 }
 
@@ -779,6 +817,11 @@ func (a *AccessControlledWorkflowHandler) isAuthorized(
 	sw := scope.StartTimer(metrics.ServiceAuthorizationLatency)
 	defer sw.Stop()
 
+	if attr.Actor == "" {
+		attr.Actor = authorization.GetActorFromContext(ctx, a.identityExtractor)
+	}
+	// authorize against the canonical namespace name so that an alias carries the same permissions
+	attr.Namespace = a.canonicalNamespace(attr.Namespace)
 	result, err := a.authorizer.Authorize(ctx, attr)
 	if err != nil {
 		scope.IncCounter(metrics.ServiceErrAuthorizeFailedCounter)
@@ -791,6 +834,50 @@ func (a *AccessControlledWorkflowHandler) isAuthorized(
 	return isAuth, nil
 }
 
+// canonicalNamespace resolves a namespace alias to the current namespace name,
+// the name is returned unchanged if it cannot be resolved
+func (a *AccessControlledWorkflowHandler) canonicalNamespace(namespace string) string {
+	if namespace == "" {
+		return namespace
+	}
+	entry, err := a.GetResource().GetNamespaceCache().GetNamespace(namespace)
+	if err != nil {
+		return namespace
+	}
+	return entry.GetInfo().GetName()
+}
+
+// taskTokenNamespace returns the name of the namespace a workflow task token belongs to,
+// an empty string is returned if the token or its namespace cannot be resolved
+func (a *AccessControlledWorkflowHandler) taskTokenNamespace(token []byte) string {
+	taskToken, err := common.NewProtoTaskTokenSerializer().Deserialize(token)
+	if err != nil {
+		return ""
+	}
+	entry, err := a.GetResource().GetNamespaceCache().GetNamespaceByID(taskToken.GetNamespaceId())
+	if err != nil {
+		return ""
+	}
+	return entry.GetInfo().GetName()
+}
+
+// crossNamespaceCommandTarget returns the API name and the target namespace of a command
+// which is able to act on another namespace, the namespace is empty for any other command
+func crossNamespaceCommandTarget(command *commandpb.Command) (string, string) {
+	switch command.GetCommandType() {
+	case enumspb.COMMAND_TYPE_SCHEDULE_ACTIVITY_TASK:
+		return "ScheduleActivityTask", command.GetScheduleActivityTaskCommandAttributes().GetNamespace()
+	case enumspb.COMMAND_TYPE_START_CHILD_WORKFLOW_EXECUTION:
+		return "StartChildWorkflowExecution", command.GetStartChildWorkflowExecutionCommandAttributes().GetNamespace()
+	case enumspb.COMMAND_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION:
+		return "SignalExternalWorkflowExecution", command.GetSignalExternalWorkflowExecutionCommandAttributes().GetNamespace()
+	case enumspb.COMMAND_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION:
+		return "RequestCancelExternalWorkflowExecution", command.GetRequestCancelExternalWorkflowExecutionCommandAttributes().GetNamespace()
+	default:
+		return "", ""
+	}
+}
+
 // getMetricsScopeWithNamespace return metrics scope with namespace tag
 func (a *AccessControlledWorkflowHandler) getMetricsScopeWithNamespace(
 	scope int,
@@ -26,15 +26,27 @@ package frontend
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commandpb "go.temporal.io/api/command/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/api/workflowservicemock/v1"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/metrics/mocks"
 	"go.temporal.io/server/common/resource"
@@ -50,6 +62,7 @@ type (
 		mockFrontendHandler *workflowservicemock.MockWorkflowServiceServer
 		mockAuthorizer      *authorization.MockAuthorizer
 		mockMetricsScope    *mocks.Scope
+		mockNamespaceCache  *cache.MockNamespaceCache
 
 		handler *AccessControlledWorkflowHandler
 	}
@@ -65,13 +78,14 @@ func (s *accessControlledHandlerSuite) SetupTest() {
 	s.controller = gomock.NewController(s.T())
 
 	mockResource := resource.NewTest(s.controller, metrics.Frontend)
+	s.mockNamespaceCache = mockResource.NamespaceCache
 	config := NewConfig(dynamicconfig.NewCollection(dynamicconfig.NewNopClient(), mockResource.GetLogger()), 0, false)
 
 	frontendHandlerGRPC := NewWorkflowHandler(mockResource, config, nil)
 	s.mockFrontendHandler = workflowservicemock.NewMockWorkflowServiceServer(s.controller)
 	s.mockAuthorizer = authorization.NewMockAuthorizer(s.controller)
 	s.mockMetricsScope = &mocks.Scope{}
-	s.handler = NewAccessControlledHandlerImpl(frontendHandlerGRPC, s.mockAuthorizer)
+	s.handler = NewAccessControlledHandlerImpl(frontendHandlerGRPC, s.mockAuthorizer, nil)
 }
 
 func (s *accessControlledHandlerSuite) TearDownTest() {
@@ -124,3 +138,80 @@ func (s *accessControlledHandlerSuite) TestIsAuthorized_Unauthorized() {
 	s.False(res)
 	s.NoError(err)
 }
+
+func (s *accessControlledHandlerSuite) TestIsAuthorized_ActorFromClientCert() {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "test-client"}}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		},
+	})
+	attr := &authorization.Attributes{}
+
+	s.mockMetricsScope.On("StartTimer", metrics.ServiceAuthorizationLatency).
+		Return(metrics.Stopwatch{}).Once()
+	s.mockAuthorizer.EXPECT().Authorize(ctx, &authorization.Attributes{Actor: "test-client"}).
+		Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
+
+	res, err := s.handler.isAuthorized(ctx, attr, s.mockMetricsScope)
+	s.True(res)
+	s.NoError(err)
+}
+
+func (s *accessControlledHandlerSuite) TestIsAuthorized_NamespaceAlias() {
+	ctx := context.Background()
+	attr := &authorization.Attributes{Actor: "test-actor", Namespace: "old-name"}
+
+	s.mockNamespaceCache.EXPECT().GetNamespace("old-name").Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Name: "new-name"},
+		&persistenceblobs.NamespaceConfig{},
+		"",
+		nil,
+	), nil)
+	s.mockMetricsScope.On("StartTimer", metrics.ServiceAuthorizationLatency).
+		Return(metrics.Stopwatch{}).Once()
+	s.mockAuthorizer.EXPECT().Authorize(ctx, &authorization.Attributes{Actor: "test-actor", Namespace: "new-name"}).
+		Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
+
+	res, err := s.handler.isAuthorized(ctx, attr, s.mockMetricsScope)
+	s.True(res)
+	s.NoError(err)
+}
+
+func (s *accessControlledHandlerSuite) TestRespondWorkflowTaskCompleted_CrossNamespaceUnauthorized() {
+	ctx := context.Background()
+	taskToken, err := common.NewProtoTaskTokenSerializer().Serialize(&tokenspb.Task{NamespaceId: "source-id"})
+	s.NoError(err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID("source-id").Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Id: "source-id", Name: "source"},
+		&persistenceblobs.NamespaceConfig{},
+		"",
+		nil,
+	), nil).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespace("target").Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Id: "target-id", Name: "target"},
+		&persistenceblobs.NamespaceConfig{},
+		"",
+		nil,
+	), nil).AnyTimes()
+	s.mockAuthorizer.EXPECT().Authorize(ctx, &authorization.Attributes{
+		APIName:   "SignalExternalWorkflowExecution",
+		Namespace: "target",
+	}).Return(authorization.Result{Decision: authorization.DecisionDeny}, nil).Times(1)
+
+	_, err = s.handler.RespondWorkflowTaskCompleted(ctx, &workflowservice.RespondWorkflowTaskCompletedRequest{
+		TaskToken: taskToken,
+		Commands: []*commandpb.Command{
+			{
+				CommandType: enumspb.COMMAND_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION,
+				Attributes: &commandpb.Command_SignalExternalWorkflowExecutionCommandAttributes{
+					SignalExternalWorkflowExecutionCommandAttributes: &commandpb.SignalExternalWorkflowExecutionCommandAttributes{
+						Namespace: "target",
+					},
+				},
+			},
+		},
+	})
+	s.Equal(errUnauthorized, err)
+}
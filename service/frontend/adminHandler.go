@@ -26,7 +26,9 @@ package frontend
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
 	"time"
 
 	"go.temporal.io/server/common/convert"
@@ -39,22 +41,27 @@ import (
 
 	"go.temporal.io/server/api/adminservice/v1"
 	clusterspb "go.temporal.io/server/api/cluster/v1"
+	dynamicconfigspb "go.temporal.io/server/api/dynamicconfig/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/largepayload"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/dynamicconfig"
 	"go.temporal.io/server/common/xdc"
+	"go.temporal.io/server/service/worker/deletenamespace"
 )
 
 const (
@@ -71,7 +78,10 @@ type (
 		params                *resource.BootstrapParams
 		config                *Config
 		namespaceDLQHandler   namespace.DLQMessageHandler
+		namespaceHandler      namespace.Handler
+		namespaceDeleter      deletenamespace.Client
 		eventSerializder      persistence.PayloadSerializer
+		workflowHandler       *WorkflowHandler
 	}
 )
 
@@ -87,6 +97,7 @@ func NewAdminHandler(
 	resource resource.Resource,
 	params *resource.BootstrapParams,
 	config *Config,
+	workflowHandler *WorkflowHandler,
 ) *AdminHandler {
 
 	namespaceReplicationTaskExecutor := namespace.NewReplicationTaskExecutor(
@@ -103,7 +114,19 @@ func NewAdminHandler(
 			resource.GetNamespaceReplicationQueue(),
 			resource.GetLogger(),
 		),
+		namespaceHandler: namespace.NewHandler(
+			config.MinRetentionDays(),
+			config.MaxBadBinaries,
+			resource.GetLogger(),
+			resource.GetMetadataManager(),
+			resource.GetClusterMetadata(),
+			namespace.NewNamespaceReplicator(resource.GetNamespaceReplicationQueue(), resource.GetLogger()),
+			resource.GetArchivalMetadata(),
+			resource.GetArchiverProvider(),
+		),
+		namespaceDeleter: deletenamespace.NewClient(resource.GetSDKClient()),
 		eventSerializder: persistence.NewPayloadSerializer(),
+		workflowHandler:  workflowHandler,
 	}
 }
 
@@ -139,7 +162,9 @@ func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admins
 	if len(request.GetSearchAttribute()) == 0 {
 		return nil, adh.error(errSearchAttributesNotSet, scope)
 	}
-	if err := adh.validateConfigForAdvanceVisibility(); err != nil {
+	// custom search attributes are indexed by Elasticsearch or kept by the SQL visibility store
+	isESConfigured := adh.validateConfigForAdvanceVisibility() == nil
+	if !isESConfigured && !adh.params.PersistenceConfig.IsSQLVisibilityStore() {
 		return nil, adh.error(errAdvancedVisibilityStoreIsNotConfigured, scope)
 	}
 
@@ -163,6 +188,11 @@ func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admins
 		return nil, adh.error(errFailedUpdateDynamicConfig.MessageArgs(err), scope)
 	}
 
+	// SQL visibility store keeps search attributes in a JSON column, which needs no schema change
+	if !isESConfigured {
+		return &adminservice.AddSearchAttributeResponse{}, nil
+	}
+
 	// update elasticsearch mapping, new added field will not be able to remove or update
 	index := adh.params.ESConfig.GetVisibilityIndex()
 	for k, v := range searchAttr {
@@ -328,6 +358,7 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, r
 	execution := request.Execution
 	var pageToken *tokenspb.RawHistoryContinuation
 	var targetVersionHistory *persistence.VersionHistory
+	var deadlineRules *workflowspb.DeadlineRules
 	if request.NextPageToken == nil {
 		response, err := adh.GetHistoryClient().GetMutableState(ctx, &historyservice.GetMutableStateRequest{
 			NamespaceId: namespaceID,
@@ -349,6 +380,8 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, r
 		}
 
 		pageToken = generatePaginationToken(request, versionHistories)
+		// the deadline rules are not part of the started event, they are resent with the first page
+		deadlineRules = response.GetDeadlineRules()
 	} else {
 		pageToken, err = deserializeRawHistoryToken(request.NextPageToken)
 		if err != nil {
@@ -421,12 +454,18 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, r
 	rawBlobs := rawHistoryResponse.HistoryEventBlobs
 	var blobs []*commonpb.DataBlob
 	for _, blob := range rawBlobs {
+		// the blob store of this cluster may not be reachable from the cluster resending the history
+		blob, err = largepayload.RehydrateEventsBlob(ctx, adh.GetLargePayloadOffloader(), adh.GetPayloadSerializer(), blob)
+		if err != nil {
+			return nil, err
+		}
 		blobs = append(blobs, blob.ToProto())
 	}
 
 	result := &adminservice.GetWorkflowExecutionRawHistoryV2Response{
 		HistoryBatches: blobs,
 		VersionHistory: targetVersionHistory.ToProto(),
+		DeadlineRules:  deadlineRules,
 	}
 	if len(pageToken.PersistenceToken) == 0 {
 		result.NextPageToken = nil
@@ -846,6 +885,415 @@ func (adh *AdminHandler) RefreshWorkflowTasks(
 	return &adminservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+// RestoreWorkflowExecution restores a closed workflow execution from the history archival of its namespace
+func (adh *AdminHandler) RestoreWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RestoreWorkflowExecutionRequest,
+) (_ *adminservice.RestoreWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminRestoreWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.Execution.GetRunId() == "" {
+		return nil, adh.error(errRunIDNotSet, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().RestoreWorkflowExecution(ctx, &historyservice.RestoreWorkflowExecutionRequest{
+		NamespaceId: namespaceEntry.GetInfo().Id,
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.RestoreWorkflowExecutionResponse{}, nil
+}
+
+// PauseWorkflowExecution stops dispatching the workflow and activity tasks of a running workflow execution
+func (adh *AdminHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+) (_ *adminservice.PauseWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminPauseWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().PauseWorkflowExecution(ctx, &historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId: namespaceEntry.GetInfo().Id,
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.PauseWorkflowExecutionResponse{}, nil
+}
+
+// UnpauseWorkflowExecution resumes dispatching the workflow and activity tasks of a paused workflow execution
+func (adh *AdminHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+) (_ *adminservice.UnpauseWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUnpauseWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().UnpauseWorkflowExecution(ctx, &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId: namespaceEntry.GetInfo().Id,
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.UnpauseWorkflowExecutionResponse{}, nil
+}
+
+// PauseActivity stops dispatching the tasks of a pending activity
+func (adh *AdminHandler) PauseActivity(
+	ctx context.Context,
+	request *adminservice.PauseActivityRequest,
+) (_ *adminservice.PauseActivityResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminPauseActivityScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetActivityId() == "" {
+		return nil, adh.error(errActivityIDNotSet, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().PauseActivity(ctx, &historyservice.PauseActivityRequest{
+		NamespaceId: namespaceEntry.GetInfo().Id,
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.PauseActivityResponse{}, nil
+}
+
+// UnpauseActivity resumes dispatching the tasks of a paused activity
+func (adh *AdminHandler) UnpauseActivity(
+	ctx context.Context,
+	request *adminservice.UnpauseActivityRequest,
+) (_ *adminservice.UnpauseActivityResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUnpauseActivityScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetActivityId() == "" {
+		return nil, adh.error(errActivityIDNotSet, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().UnpauseActivity(ctx, &historyservice.UnpauseActivityRequest{
+		NamespaceId: namespaceEntry.GetInfo().Id,
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.UnpauseActivityResponse{}, nil
+}
+
+// ResetActivity resets the attempt counter and the retry backoff of a pending activity
+func (adh *AdminHandler) ResetActivity(
+	ctx context.Context,
+	request *adminservice.ResetActivityRequest,
+) (_ *adminservice.ResetActivityResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminResetActivityScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetActivityId() == "" {
+		return nil, adh.error(errActivityIDNotSet, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().ResetActivity(ctx, &historyservice.ResetActivityRequest{
+		NamespaceId: namespaceEntry.GetInfo().Id,
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.ResetActivityResponse{}, nil
+}
+
+// UpdateActivityOptions updates the retry policy and the timeouts of a pending activity in place
+func (adh *AdminHandler) UpdateActivityOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityOptionsRequest,
+) (_ *adminservice.UpdateActivityOptionsResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateActivityOptionsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetActivityId() == "" {
+		return nil, adh.error(errActivityIDNotSet, scope)
+	}
+	if request.RetryPolicy == nil && request.ScheduleToCloseTimeout == nil && request.ScheduleToStartTimeout == nil &&
+		request.StartToCloseTimeout == nil && request.HeartbeatTimeout == nil {
+		return nil, adh.error(errActivityOptionsNotSet, scope)
+	}
+	if timestamp.DurationValue(request.GetScheduleToCloseTimeout()) < 0 ||
+		timestamp.DurationValue(request.GetScheduleToStartTimeout()) < 0 ||
+		timestamp.DurationValue(request.GetStartToCloseTimeout()) < 0 ||
+		timestamp.DurationValue(request.GetHeartbeatTimeout()) < 0 {
+		return nil, adh.error(errInvalidActivityTimeout, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().UpdateActivityOptions(ctx, &historyservice.UpdateActivityOptionsRequest{
+		NamespaceId: namespaceEntry.GetInfo().Id,
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.UpdateActivityOptionsResponse{}, nil
+}
+
+// StreamWorkflowExecutionHistory streams the history events of a workflow execution as they are committed
+func (adh *AdminHandler) StreamWorkflowExecutionHistory(
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+	server adminservice.AdminService_StreamWorkflowExecutionHistoryServer,
+) (err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminStreamWorkflowExecutionHistoryScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.GetFirstEventId() < 0 {
+		return adh.error(errInvalidFirstEventID, scope)
+	}
+	if request.GetMaximumPageSize() <= 0 {
+		request.MaximumPageSize = int32(adh.config.HistoryMaxPageSize(request.GetNamespace()))
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	stream, err := adh.GetHistoryClient().StreamWorkflowExecutionHistory(server.Context(), &historyservice.StreamWorkflowExecutionHistoryRequest{
+		NamespaceId: namespaceEntry.GetInfo().Id,
+		Request:     request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return adh.error(err, scope)
+		}
+		if err := server.Send(resp.GetResponse()); err != nil {
+			return err
+		}
+	}
+}
+
+// DeleteNamespace marks a namespace as deleted and starts the system workflow deleting its resources
+func (adh *AdminHandler) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+) (_ *adminservice.DeleteNamespaceResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDeleteNamespaceScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.checkPermission(adh.config, request.SecurityToken); err != nil {
+		return nil, adh.error(errNoPermission, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+
+	resp, err := adh.namespaceHandler.DeleteNamespace(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	resp.WorkflowId, err = adh.namespaceDeleter.StartDeleteNamespaceWorkflow(ctx, deletenamespace.Params{
+		Namespace:   request.GetNamespace(),
+		NamespaceID: resp.GetNamespaceId(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// UpdateNamespace updates a namespace with the options the public UpdateNamespace API doesn't carry, a renamed
+// namespace remains addressable by its previous name for the requested alias retention
+func (adh *AdminHandler) UpdateNamespace(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceRequest,
+) (_ *adminservice.UpdateNamespaceResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateNamespaceScope)
+	defer sw.Stop()
+
+	if request == nil || request.Request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.checkPermission(adh.config, request.Request.SecurityToken); err != nil {
+		return nil, adh.error(errNoPermission, scope)
+	}
+	if request.Request.GetName() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetNewName() != "" && request.AliasRetention == nil {
+		aliasRetention := adh.config.NamespaceAliasRetention()
+		request.AliasRetention = &aliasRetention
+	}
+
+	resp, err := adh.namespaceHandler.AdminUpdateNamespace(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// DescribeNamespaceAliases returns the aliases a namespace can still be addressed by
+func (adh *AdminHandler) DescribeNamespaceAliases(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceAliasesRequest,
+) (_ *adminservice.DescribeNamespaceAliasesResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeNamespaceAliasesScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+
+	resp, err := adh.namespaceHandler.DescribeNamespaceAliases(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// StartWorkflowExecution starts a workflow with the deadline rules of the request
+func (adh *AdminHandler) StartWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.StartWorkflowExecutionRequest,
+) (_ *adminservice.StartWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminStartWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil || request.Request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	resp, err := adh.workflowHandler.startWorkflowExecution(ctx, request.Request, request.DeadlineRules)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.StartWorkflowExecutionResponse{Response: resp}, nil
+}
+
+// SignalWithStartWorkflowExecution signals or starts a workflow with the deadline rules of the request
+func (adh *AdminHandler) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.SignalWithStartWorkflowExecutionRequest,
+) (_ *adminservice.SignalWithStartWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminSignalWithStartWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil || request.Request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	resp, err := adh.workflowHandler.signalWithStartWorkflowExecution(ctx, request.Request, request.DeadlineRules)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.SignalWithStartWorkflowExecutionResponse{Response: resp}, nil
+}
+
 // ResendReplicationTasks requests replication task from remote cluster
 func (adh *AdminHandler) ResendReplicationTasks(
 	ctx context.Context,
@@ -881,6 +1329,136 @@ func (adh *AdminHandler) ResendReplicationTasks(
 	)
 }
 
+// GetDynamicConfig returns the effective value of a dynamic config key for the given filters
+func (adh *AdminHandler) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+) (_ *adminservice.GetDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminGetDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	key, ok := dynamicconfig.KeyFromName(request.GetName())
+	if !ok {
+		return nil, adh.error(errUnknownDynamicConfigKey.MessageArgs(request.GetName()), scope)
+	}
+
+	entry, err := adh.getDynamicConfigEntry(key, request.GetFilters())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.GetDynamicConfigResponse{Entry: entry}, nil
+}
+
+// UpdateDynamicConfig updates the value of a dynamic config key, only the dynamic config of this host is changed
+func (adh *AdminHandler) UpdateDynamicConfig(
+	ctx context.Context,
+	request *adminservice.UpdateDynamicConfigRequest,
+) (_ *adminservice.UpdateDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.checkPermission(adh.config, request.SecurityToken); err != nil {
+		return nil, adh.error(errNoPermission, scope)
+	}
+	key, ok := dynamicconfig.KeyFromName(request.GetName())
+	if !ok {
+		return nil, adh.error(errUnknownDynamicConfigKey.MessageArgs(request.GetName()), scope)
+	}
+	value, err := dynamicconfig.DecodeValue(key, []byte(request.GetValue()))
+	if err != nil {
+		return nil, adh.error(errInvalidDynamicConfigValue.MessageArgs(err), scope)
+	}
+
+	if err := adh.params.DynamicConfig.UpdateValue(key, value); err != nil {
+		return nil, adh.error(errFailedUpdateDynamicConfig.MessageArgs(err), scope)
+	}
+	return &adminservice.UpdateDynamicConfigResponse{}, nil
+}
+
+// ListDynamicConfig returns the effective values of all dynamic config keys which accept the given filters
+func (adh *AdminHandler) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+) (_ *adminservice.ListDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminListDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	var entries []*dynamicconfigspb.DynamicConfigEntry
+	for _, key := range dynamicconfig.Keys() {
+		if _, err := dynamicconfig.ParseFilters(key, request.GetFilters()); err != nil {
+			// key is not configurable by the requested filters
+			continue
+		}
+		entry, err := adh.getDynamicConfigEntry(key, request.GetFilters())
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		entries = append(entries, entry)
+	}
+	return &adminservice.ListDynamicConfigResponse{Entries: entries}, nil
+}
+
+func (adh *AdminHandler) getDynamicConfigEntry(
+	key dynamicconfig.Key,
+	filterValues map[string]string,
+) (*dynamicconfigspb.DynamicConfigEntry, error) {
+
+	filters, err := dynamicconfig.ParseFilters(key, filterValues)
+	if err != nil {
+		return nil, errInvalidDynamicConfigFilters.MessageArgs(err)
+	}
+
+	var allowedFilters []string
+	for _, filter := range key.AllowedFilters() {
+		allowedFilters = append(allowedFilters, filter.String())
+	}
+	entry := &dynamicconfigspb.DynamicConfigEntry{
+		Name:           key.String(),
+		ValueType:      key.ValueType().String(),
+		AllowedFilters: allowedFilters,
+	}
+
+	defaultValue, ok := dynamicconfig.DefaultValue(key)
+	if ok {
+		if entry.DefaultValue, err = encodeDynamicConfigValue(defaultValue); err != nil {
+			return nil, err
+		}
+	}
+	// error is returned when the key is not configured, the default value is used in that case
+	value, _ := adh.params.DynamicConfig.GetValueWithFilters(key, filters, defaultValue)
+	if entry.Value, err = encodeDynamicConfigValue(value); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func encodeDynamicConfigValue(value interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", errFailedEncodeDynamicConfig.MessageArgs(err)
+	}
+	return string(data), nil
+}
+
 func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
 	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
 ) error {
@@ -901,13 +1479,7 @@ func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
 		return errInvalidPageSize
 	}
 
-	if request.GetStartEventId() == common.EmptyEventID &&
-		request.GetStartEventVersion() == common.EmptyVersion &&
-		request.GetEndEventId() == common.EmptyEventID &&
-		request.GetEndEventVersion() == common.EmptyVersion {
-		return errInvalidEventQueryRange
-	}
-
+	// an empty event range returns the full history of the current branch, e.g. for exporting a workflow
 	if (request.GetStartEventId() != common.EmptyEventID && request.GetStartEventVersion() == common.EmptyVersion) ||
 		(request.GetStartEventId() == common.EmptyEventID && request.GetStartEventVersion() != common.EmptyVersion) {
 		return errInvalidStartEventCombination
@@ -29,6 +29,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
 
@@ -40,11 +41,14 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/definition"
@@ -52,10 +56,12 @@ import (
 	esmock "go.temporal.io/server/common/elasticsearch/mocks"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/mocks"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/service/worker/deletenamespace"
 )
 
 type (
@@ -102,8 +108,11 @@ func (s *adminHandlerSuite) SetupTest() {
 	config := &Config{
 		EnableAdminProtection:        dynamicconfig.GetBoolPropertyFn(false),
 		EnableCleanupReplicationTask: dynamicconfig.GetBoolPropertyFn(false),
+		MinRetentionDays:             dynamicconfig.GetIntPropertyFn(1),
+		MaxBadBinaries:               dynamicconfig.GetIntPropertyFilteredByNamespace(10),
+		NamespaceAliasRetention:      dynamicconfig.GetDurationPropertyFn(time.Hour),
 	}
-	s.handler = NewAdminHandler(s.mockResource, params, config)
+	s.handler = NewAdminHandler(s.mockResource, params, config, nil)
 	s.handler.Start()
 }
 
@@ -269,6 +278,42 @@ func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2() {
 	s.NoError(err)
 }
 
+func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2_FullHistory() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	branchToken := []byte{1}
+	versionHistory := persistence.NewVersionHistory(branchToken, []*persistence.VersionHistoryItem{
+		persistence.NewVersionHistoryItem(int64(10), common.EmptyVersion),
+	})
+	rawVersionHistories := persistence.NewVersionHistories(versionHistory)
+	versionHistories := rawVersionHistories.ToProto()
+	mState := &historyservice.GetMutableStateResponse{
+		NextEventId:        11,
+		CurrentBranchToken: branchToken,
+		VersionHistories:   versionHistories,
+	}
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(mState, nil).AnyTimes()
+
+	s.mockHistoryV2Mgr.On("ReadRawHistoryBranch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == common.FirstEventID && request.MaxEventID == 11
+	})).Return(&persistence.ReadRawHistoryBranchResponse{
+		HistoryEventBlobs: []*serialization.DataBlob{},
+		NextPageToken:     []byte{},
+		Size:              0,
+	}, nil)
+	_, err := s.handler.GetWorkflowExecutionRawHistoryV2(ctx,
+		&adminservice.GetWorkflowExecutionRawHistoryV2Request{
+			Namespace: s.namespace,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: "workflowID",
+				RunId:      uuid.New(),
+			},
+			MaximumPageSize: 10,
+			NextPageToken:   nil,
+		})
+	s.NoError(err)
+}
+
 func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2_SameStartIDAndEndID() {
 	ctx := context.Background()
 	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
@@ -568,6 +613,34 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Validate() {
 	s.Nil(resp)
 }
 
+func (s *adminHandlerSuite) Test_AddSearchAttribute_SQLVisibility() {
+	handler := s.handler
+	dynamicConfig := dynamicconfig.NewMockClient(s.controller)
+	handler.params = &resource.BootstrapParams{
+		DynamicConfig: dynamicConfig,
+		PersistenceConfig: config.Persistence{
+			VisibilityStore: "sql-visibility",
+			DataStores: map[string]config.DataStore{
+				"sql-visibility": {SQL: &config.SQL{}},
+			},
+		},
+	}
+
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.ValidSearchAttributes, nil, definition.GetDefaultIndexedKeys()).
+		Return(map[string]interface{}{}, nil)
+	dynamicConfig.EXPECT().UpdateValue(dynamicconfig.ValidSearchAttributes, map[string]interface{}{
+		"testkey": int(enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+	}).Return(nil)
+
+	resp, err := handler.AddSearchAttribute(context.Background(), &adminservice.AddSearchAttributeRequest{
+		SearchAttribute: map[string]enumspb.IndexedValueType{
+			"testkey": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		},
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+}
+
 func (s *adminHandlerSuite) Test_AddSearchAttribute_Permission() {
 	ctx := context.Background()
 	handler := s.handler
@@ -604,3 +677,158 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Permission() {
 		s.Nil(resp)
 	}
 }
+
+func (s *adminHandlerSuite) Test_GetDynamicConfig() {
+	ctx := context.Background()
+	handler := s.handler
+	dynamicConfig := dynamicconfig.NewMockClient(s.controller)
+	handler.params.DynamicConfig = dynamicConfig
+
+	resp, err := handler.GetDynamicConfig(ctx, &adminservice.GetDynamicConfigRequest{Name: "history.unknownKey"})
+	s.Equal(&serviceerror.InvalidArgument{Message: "Unknown dynamic config key [history.unknownKey]."}, err)
+	s.Nil(resp)
+
+	resp, err = handler.GetDynamicConfig(ctx, &adminservice.GetDynamicConfigRequest{
+		Name:    dynamicconfig.HistoryPersistenceMaxQPS.String(),
+		Filters: map[string]string{"namespace": s.namespace},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Nil(resp)
+
+	dynamicConfig.EXPECT().GetValueWithFilters(
+		dynamicconfig.FrontendVisibilityListMaxQPS,
+		map[dynamicconfig.Filter]interface{}{dynamicconfig.Namespace: s.namespace},
+		gomock.Any(),
+	).Return(20, nil)
+	resp, err = handler.GetDynamicConfig(ctx, &adminservice.GetDynamicConfigRequest{
+		Name:    dynamicconfig.FrontendVisibilityListMaxQPS.String(),
+		Filters: map[string]string{"namespace": s.namespace},
+	})
+	s.NoError(err)
+	s.Equal(dynamicconfig.FrontendVisibilityListMaxQPS.String(), resp.Entry.GetName())
+	s.Equal("int", resp.Entry.GetValueType())
+	s.Equal([]string{"namespace"}, resp.Entry.GetAllowedFilters())
+	s.Equal("20", resp.Entry.GetValue())
+}
+
+func (s *adminHandlerSuite) Test_UpdateDynamicConfig() {
+	ctx := context.Background()
+	handler := s.handler
+	dynamicConfig := dynamicconfig.NewMockClient(s.controller)
+	handler.params.DynamicConfig = dynamicConfig
+
+	resp, err := handler.UpdateDynamicConfig(ctx, &adminservice.UpdateDynamicConfigRequest{
+		Name:  dynamicconfig.HistoryPersistenceMaxQPS.String(),
+		Value: `"3000"`,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Nil(resp)
+
+	dynamicConfig.EXPECT().UpdateValue(dynamicconfig.HistoryPersistenceMaxQPS, 3000).Return(nil)
+	resp, err = handler.UpdateDynamicConfig(ctx, &adminservice.UpdateDynamicConfigRequest{
+		Name:  dynamicconfig.HistoryPersistenceMaxQPS.String(),
+		Value: "3000",
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+}
+
+func (s *adminHandlerSuite) Test_ListDynamicConfig() {
+	ctx := context.Background()
+	handler := s.handler
+	dynamicConfig := dynamicconfig.NewMockClient(s.controller)
+	handler.params.DynamicConfig = dynamicConfig
+
+	dynamicConfig.EXPECT().GetValueWithFilters(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ dynamicconfig.Key, _ map[dynamicconfig.Filter]interface{}, defaultValue interface{}) (interface{}, error) {
+			return defaultValue, nil
+		}).AnyTimes()
+	resp, err := handler.ListDynamicConfig(ctx, &adminservice.ListDynamicConfigRequest{
+		Filters: map[string]string{"shardID": "1"},
+	})
+	s.NoError(err)
+	s.NotEmpty(resp.Entries)
+	for _, entry := range resp.Entries {
+		s.Equal([]string{"shardID"}, entry.GetAllowedFilters())
+	}
+}
+
+func (s *adminHandlerSuite) Test_DeleteNamespace() {
+	ctx := context.Background()
+	handler := s.handler
+	namespaceHandler := namespace.NewMockHandler(s.controller)
+	namespaceDeleter := deletenamespace.NewMockClient(s.controller)
+	handler.namespaceHandler = namespaceHandler
+	handler.namespaceDeleter = namespaceDeleter
+
+	request := &adminservice.DeleteNamespaceRequest{Namespace: s.namespace}
+	namespaceHandler.EXPECT().DeleteNamespace(ctx, request).
+		Return(&adminservice.DeleteNamespaceResponse{NamespaceId: s.namespaceID}, nil)
+	namespaceDeleter.EXPECT().StartDeleteNamespaceWorkflow(ctx, deletenamespace.Params{
+		Namespace:   s.namespace,
+		NamespaceID: s.namespaceID,
+	}).Return("some-workflow-id", nil)
+
+	resp, err := handler.DeleteNamespace(ctx, request)
+	s.NoError(err)
+	s.Equal(s.namespaceID, resp.GetNamespaceId())
+	s.Equal("some-workflow-id", resp.GetWorkflowId())
+}
+
+func (s *adminHandlerSuite) Test_DeleteNamespace_NamespaceNotSet() {
+	resp, err := s.handler.DeleteNamespace(context.Background(), &adminservice.DeleteNamespaceRequest{})
+	s.Equal(errNamespaceNotSet, err)
+	s.Nil(resp)
+}
+
+func (s *adminHandlerSuite) Test_RenameNamespace_DefaultAliasRetention() {
+	ctx := context.Background()
+	handler := s.handler
+	namespaceHandler := namespace.NewMockHandler(s.controller)
+	handler.namespaceHandler = namespaceHandler
+
+	aliasRetention := time.Hour
+	namespaceHandler.EXPECT().AdminUpdateNamespace(ctx, &adminservice.UpdateNamespaceRequest{
+		Request:        &workflowservice.UpdateNamespaceRequest{Name: s.namespace},
+		NewName:        "new-name",
+		AliasRetention: &aliasRetention,
+	}).Return(&adminservice.UpdateNamespaceResponse{
+		Response: &workflowservice.UpdateNamespaceResponse{NamespaceInfo: &namespacepb.NamespaceInfo{Id: s.namespaceID}},
+	}, nil)
+
+	resp, err := handler.UpdateNamespace(ctx, &adminservice.UpdateNamespaceRequest{
+		Request: &workflowservice.UpdateNamespaceRequest{Name: s.namespace},
+		NewName: "new-name",
+	})
+	s.NoError(err)
+	s.Equal(s.namespaceID, resp.GetResponse().GetNamespaceInfo().GetId())
+}
+
+func (s *adminHandlerSuite) Test_RenameNamespace_NamespaceNotSet() {
+	resp, err := s.handler.UpdateNamespace(context.Background(), &adminservice.UpdateNamespaceRequest{
+		Request: &workflowservice.UpdateNamespaceRequest{},
+		NewName: "new-name",
+	})
+	s.Equal(errNamespaceNotSet, err)
+	s.Nil(resp)
+
+	resp, err = s.handler.UpdateNamespace(context.Background(), &adminservice.UpdateNamespaceRequest{NewName: "new-name"})
+	s.Equal(errRequestNotSet, err)
+	s.Nil(resp)
+}
+
+func (s *adminHandlerSuite) Test_StartWorkflowExecution_RequestNotSet() {
+	resp, err := s.handler.StartWorkflowExecution(context.Background(), &adminservice.StartWorkflowExecutionRequest{
+		DeadlineRules: &workflowspb.DeadlineRules{},
+	})
+	s.Equal(errRequestNotSet, err)
+	s.Nil(resp)
+}
+
+func (s *adminHandlerSuite) Test_SignalWithStartWorkflowExecution_RequestNotSet() {
+	resp, err := s.handler.SignalWithStartWorkflowExecution(context.Background(), &adminservice.SignalWithStartWorkflowExecutionRequest{
+		DeadlineRules: &workflowspb.DeadlineRules{},
+	})
+	s.Equal(errRequestNotSet, err)
+	s.Nil(resp)
+}
@@ -189,6 +189,119 @@ func (adh *AdminNilCheckHandler) RefreshWorkflowTasks(ctx context.Context, reque
 	return resp, err
 }
 
+// RestoreWorkflowExecution restores a closed workflow execution from archival
+func (adh *AdminNilCheckHandler) RestoreWorkflowExecution(ctx context.Context, request *adminservice.RestoreWorkflowExecutionRequest) (*adminservice.RestoreWorkflowExecutionResponse, error) {
+	resp, err := adh.parentHandler.RestoreWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.RestoreWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+// PauseWorkflowExecution stops dispatching the tasks of a workflow execution
+func (adh *AdminNilCheckHandler) PauseWorkflowExecution(ctx context.Context, request *adminservice.PauseWorkflowExecutionRequest) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	resp, err := adh.parentHandler.PauseWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.PauseWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+// UnpauseWorkflowExecution resumes dispatching the tasks of a workflow execution
+func (adh *AdminNilCheckHandler) UnpauseWorkflowExecution(ctx context.Context, request *adminservice.UnpauseWorkflowExecutionRequest) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	resp, err := adh.parentHandler.UnpauseWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.UnpauseWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+// PauseActivity stops dispatching the tasks of a pending activity
+func (adh *AdminNilCheckHandler) PauseActivity(ctx context.Context, request *adminservice.PauseActivityRequest) (*adminservice.PauseActivityResponse, error) {
+	resp, err := adh.parentHandler.PauseActivity(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.PauseActivityResponse{}
+	}
+	return resp, err
+}
+
+// UnpauseActivity resumes dispatching the tasks of a paused activity
+func (adh *AdminNilCheckHandler) UnpauseActivity(ctx context.Context, request *adminservice.UnpauseActivityRequest) (*adminservice.UnpauseActivityResponse, error) {
+	resp, err := adh.parentHandler.UnpauseActivity(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.UnpauseActivityResponse{}
+	}
+	return resp, err
+}
+
+// ResetActivity resets the attempt counter and the retry backoff of a pending activity
+func (adh *AdminNilCheckHandler) ResetActivity(ctx context.Context, request *adminservice.ResetActivityRequest) (*adminservice.ResetActivityResponse, error) {
+	resp, err := adh.parentHandler.ResetActivity(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.ResetActivityResponse{}
+	}
+	return resp, err
+}
+
+// UpdateActivityOptions updates the retry policy and the timeouts of a pending activity
+func (adh *AdminNilCheckHandler) UpdateActivityOptions(ctx context.Context, request *adminservice.UpdateActivityOptionsRequest) (*adminservice.UpdateActivityOptionsResponse, error) {
+	resp, err := adh.parentHandler.UpdateActivityOptions(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.UpdateActivityOptionsResponse{}
+	}
+	return resp, err
+}
+
+// StreamWorkflowExecutionHistory streams the history events of a workflow execution as they are committed
+func (adh *AdminNilCheckHandler) StreamWorkflowExecutionHistory(request *adminservice.StreamWorkflowExecutionHistoryRequest, server adminservice.AdminService_StreamWorkflowExecutionHistoryServer) error {
+	return adh.parentHandler.StreamWorkflowExecutionHistory(request, server)
+}
+
+// DeleteNamespace deletes a namespace and all of its resources
+func (adh *AdminNilCheckHandler) DeleteNamespace(ctx context.Context, request *adminservice.DeleteNamespaceRequest) (*adminservice.DeleteNamespaceResponse, error) {
+	resp, err := adh.parentHandler.DeleteNamespace(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.DeleteNamespaceResponse{}
+	}
+	return resp, err
+}
+
+// UpdateNamespace updates a namespace with the options the public UpdateNamespace API doesn't carry
+func (adh *AdminNilCheckHandler) UpdateNamespace(ctx context.Context, request *adminservice.UpdateNamespaceRequest) (*adminservice.UpdateNamespaceResponse, error) {
+	resp, err := adh.parentHandler.UpdateNamespace(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.UpdateNamespaceResponse{}
+	}
+	return resp, err
+}
+
+// DescribeNamespaceAliases returns the aliases of a namespace
+func (adh *AdminNilCheckHandler) DescribeNamespaceAliases(ctx context.Context, request *adminservice.DescribeNamespaceAliasesRequest) (*adminservice.DescribeNamespaceAliasesResponse, error) {
+	resp, err := adh.parentHandler.DescribeNamespaceAliases(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.DescribeNamespaceAliasesResponse{}
+	}
+	return resp, err
+}
+
+// StartWorkflowExecution starts a workflow with deadline rules
+func (adh *AdminNilCheckHandler) StartWorkflowExecution(ctx context.Context, request *adminservice.StartWorkflowExecutionRequest) (*adminservice.StartWorkflowExecutionResponse, error) {
+	resp, err := adh.parentHandler.StartWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.StartWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+// SignalWithStartWorkflowExecution signals or starts a workflow with deadline rules
+func (adh *AdminNilCheckHandler) SignalWithStartWorkflowExecution(ctx context.Context, request *adminservice.SignalWithStartWorkflowExecutionRequest) (*adminservice.SignalWithStartWorkflowExecutionResponse, error) {
+	resp, err := adh.parentHandler.SignalWithStartWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.SignalWithStartWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
 // ResendReplicationTasks requests replication task from remote cluster
 func (adh *AdminNilCheckHandler) ResendReplicationTasks(ctx context.Context, request *adminservice.ResendReplicationTasksRequest) (_ *adminservice.ResendReplicationTasksResponse, err error) {
 	resp, err := adh.parentHandler.ResendReplicationTasks(ctx, request)
@@ -197,3 +310,30 @@ func (adh *AdminNilCheckHandler) ResendReplicationTasks(ctx context.Context, req
 	}
 	return resp, err
 }
+
+// GetDynamicConfig returns the effective value of a dynamic config key
+func (adh *AdminNilCheckHandler) GetDynamicConfig(ctx context.Context, request *adminservice.GetDynamicConfigRequest) (_ *adminservice.GetDynamicConfigResponse, err error) {
+	resp, err := adh.parentHandler.GetDynamicConfig(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.GetDynamicConfigResponse{}
+	}
+	return resp, err
+}
+
+// UpdateDynamicConfig updates the value of a dynamic config key
+func (adh *AdminNilCheckHandler) UpdateDynamicConfig(ctx context.Context, request *adminservice.UpdateDynamicConfigRequest) (_ *adminservice.UpdateDynamicConfigResponse, err error) {
+	resp, err := adh.parentHandler.UpdateDynamicConfig(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.UpdateDynamicConfigResponse{}
+	}
+	return resp, err
+}
+
+// ListDynamicConfig returns the effective values of dynamic config keys
+func (adh *AdminNilCheckHandler) ListDynamicConfig(ctx context.Context, request *adminservice.ListDynamicConfigRequest) (_ *adminservice.ListDynamicConfigResponse, err error) {
+	resp, err := adh.parentHandler.ListDynamicConfig(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.ListDynamicConfigResponse{}
+	}
+	return resp, err
+}
@@ -36,10 +36,12 @@ var (
 	errActivityIDNotSet                                   = serviceerror.NewInvalidArgument("ActivityId is not set on request.")
 	errSignalNameNotSet                                   = serviceerror.NewInvalidArgument("SignalName is not set on request.")
 	errInvalidRunID                                       = serviceerror.NewInvalidArgument("Invalid RunId.")
+	errRunIDNotSet                                        = serviceerror.NewInvalidArgument("RunId is not set on request.")
 	errInvalidNextPageToken                               = serviceerror.NewInvalidArgument("Invalid NextPageToken.")
 	errNextPageTokenRunIDMismatch                         = serviceerror.NewInvalidArgument("RunId in the request does not match the NextPageToken.")
 	errQueryNotSet                                        = serviceerror.NewInvalidArgument("WorkflowQuery is not set on request.")
 	errQueryTypeNotSet                                    = serviceerror.NewInvalidArgument("QueryType is not set on request.")
+	errInvalidQueryConsistencyLevel                       = serviceerror.NewInvalidArgument("Invalid query consistency level, expected eventual or strong.")
 	errRequestNotSet                                      = serviceerror.NewInvalidArgument("Request is nil.")
 	errRequestIDNotSet                                    = serviceerror.NewInvalidArgument("RequestId is not set on request.")
 	errWorkflowTypeNotSet                                 = serviceerror.NewInvalidArgument("WorkflowType is not set on request.")
@@ -47,14 +49,18 @@ var (
 	errInvalidWorkflowExecutionTimeoutSeconds             = serviceerror.NewInvalidArgument("An invalid WorkflowExecutionTimeoutSeconds is set on request.")
 	errInvalidWorkflowRunTimeoutSeconds                   = serviceerror.NewInvalidArgument("An invalid WorkflowRunTimeoutSeconds is set on request.")
 	errInvalidWorkflowTaskTimeoutSeconds                  = serviceerror.NewInvalidArgument("An invalid WorkflowTaskTimeoutSeconds is set on request.")
+	errInvalidActivityTimeout                             = serviceerror.NewInvalidArgument("An invalid activity timeout is set on request.")
+	errActivityOptionsNotSet                              = serviceerror.NewInvalidArgument("Neither RetryPolicy nor a timeout is set on request.")
 	errQueryDisallowedForNamespace                        = serviceerror.NewInvalidArgument("Namespace is not allowed to query, please contact temporal team to re-enable queries.")
 	errClusterNameNotSet                                  = serviceerror.NewInvalidArgument("Cluster name is not set.")
 	errEmptyReplicationInfo                               = serviceerror.NewInvalidArgument("Replication task info is not set.")
 	errHistoryNotFound                                    = serviceerror.NewInvalidArgument("Requested workflow history not found, may have passed retention period.")
 	errNamespaceTooLong                                   = serviceerror.NewInvalidArgument("Namespace length exceeds limit.")
+	errNamespaceDeleted                                   = serviceerror.NewInvalidArgument("Namespace is deleted.")
 	errWorkflowTypeTooLong                                = serviceerror.NewInvalidArgument("WorkflowType length exceeds limit.")
 	errWorkflowIDTooLong                                  = serviceerror.NewInvalidArgument("WorkflowId length exceeds limit.")
 	errSignalNameTooLong                                  = serviceerror.NewInvalidArgument("SignalName length exceeds limit.")
+	errSignalNameIsReserved                               = serviceerror.NewInvalidArgument("SignalName is reserved by system.")
 	errTaskQueueTooLong                                   = serviceerror.NewInvalidArgument("TaskQueue length exceeds limit.")
 	errRequestIDTooLong                                   = serviceerror.NewInvalidArgument("RequestId length exceeds limit.")
 	errIdentityTooLong                                    = serviceerror.NewInvalidArgument("Identity length exceeds limit.")
@@ -69,18 +75,24 @@ var (
 	errKeyIsAlreadyWhitelisted                            = serviceerror.NewInvalidArgument("Key [%s] is already whitelist.")
 	errInvalidPageSize                                    = serviceerror.NewInvalidArgument("Invalid PageSize.")
 	errInvalidPaginationToken                             = serviceerror.NewInvalidArgument("Invalid pagination token.")
+	errInvalidFirstEventID                                = serviceerror.NewInvalidArgument("Invalid FirstEventId.")
 	errInvalidFirstNextEventCombination                   = serviceerror.NewInvalidArgument("Invalid FirstEventId and NextEventId combination.")
 	errInvalidStartEventCombination                       = serviceerror.NewInvalidArgument("Invalid StartEventId and StartEventVersion combination.")
 	errInvalidEndEventCombination                         = serviceerror.NewInvalidArgument("Invalid EndEventId and EndEventVersion combination.")
 	errInvalidVersionHistories                            = serviceerror.NewInvalidArgument("Invalid version histories.")
-	errInvalidEventQueryRange                             = serviceerror.NewInvalidArgument("Invalid event query range.")
 	errUnknownValueType                                   = serviceerror.NewInvalidArgument("Unknown value type, %v.")
 	errDLQTypeIsNotSupported                              = serviceerror.NewInvalidArgument("The DLQ type is not supported.")
 	errFailureMustHaveApplicationFailureInfo              = serviceerror.NewInvalidArgument("Failure must have ApplicationFailureInfo.")
 	errStatusFilterMustBeNotRunning                       = serviceerror.NewInvalidArgument("StatusFilter must be specified and must be not Running.")
+	errUnknownDynamicConfigKey                            = serviceerror.NewInvalidArgument("Unknown dynamic config key [%s].")
+	errInvalidDynamicConfigValue                          = serviceerror.NewInvalidArgument("Invalid dynamic config value, err: %v.")
+	errInvalidDynamicConfigFilters                        = serviceerror.NewInvalidArgument("Invalid dynamic config filters, err: %v.")
+	errReservedPayloadMetadata                            = serviceerror.NewInvalidArgument("Payload metadata key %v is reserved for the server.")
+	errReservedPayloadEncoding                            = serviceerror.NewInvalidArgument("Payload encoding %v is reserved for the server.")
 	errShuttingDown                                       = serviceerror.NewInternal("Shutting down")
 
 	errFailedUpdateDynamicConfig = serviceerror.NewInternal("Failed to update dynamic config, err: %v.")
+	errFailedEncodeDynamicConfig = serviceerror.NewInternal("Failed to encode dynamic config value, err: %v.")
 	errFailedToCreateESIndex     = serviceerror.NewInternal("Failed to create ES index, err: %v.")
 	errFailedToUpdateESMapping   = serviceerror.NewInternal("Failed to update ES mapping, err: %v.")
 
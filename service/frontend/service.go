@@ -76,6 +76,8 @@ type Config struct {
 	HistoryMgrNumConns dynamicconfig.IntPropertyFn
 
 	MaxBadBinaries dynamicconfig.IntPropertyFnWithNamespaceFilter
+	// NamespaceAliasRetention is the default time a renamed namespace remains reachable by its previous name
+	NamespaceAliasRetention dynamicconfig.DurationPropertyFn
 
 	// security protection settings
 	EnableAdminProtection         dynamicconfig.BoolPropertyFn
@@ -85,6 +87,8 @@ type Config struct {
 	// size limit system protection
 	BlobSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
 	BlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
+	// LargePayloadThreshold is the history offloading threshold, offloaded payloads don't count toward the blob size limits
+	LargePayloadThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 
@@ -142,11 +146,13 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		MaxIDLengthLimit:                       dc.GetIntProperty(dynamicconfig.MaxIDLengthLimit, 1000),
 		HistoryMgrNumConns:                     dc.GetIntProperty(dynamicconfig.FrontendHistoryMgrNumConns, 10),
 		MaxBadBinaries:                         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxBadBinaries, namespace.MaxBadBinaries),
+		NamespaceAliasRetention:                dc.GetDurationProperty(dynamicconfig.FrontendNamespaceAliasRetention, namespace.DefaultAliasRetention),
 		EnableAdminProtection:                  dc.GetBoolProperty(dynamicconfig.EnableAdminProtection, false),
 		AdminOperationToken:                    dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
 		DisableListVisibilityByFilter:          dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.DisableListVisibilityByFilter, false),
 		BlobSizeLimitError:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:                      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, 256*1024),
+		LargePayloadThreshold:                  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.LargePayloadThreshold, 0),
 		ThrottledLogRPS:                        dc.GetIntProperty(dynamicconfig.FrontendThrottledLogRPS, 20),
 		ShutdownDrainDuration:                  dc.GetDurationProperty(dynamicconfig.FrontendShutdownDrainDuration, 0),
 		EnableNamespaceNotActiveAutoForwarding: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableNamespaceNotActiveAutoForwarding, true),
@@ -193,8 +199,9 @@ func NewService(
 
 	params.PersistenceConfig.HistoryMaxConns = serviceConfig.HistoryMgrNumConns()
 	params.PersistenceConfig.VisibilityConfig = &config.VisibilityConfig{
-		VisibilityListMaxQPS: serviceConfig.VisibilityListMaxQPS,
-		EnableSampling:       serviceConfig.EnableVisibilitySampling,
+		VisibilityListMaxQPS:  serviceConfig.VisibilityListMaxQPS,
+		EnableSampling:        serviceConfig.EnableVisibilitySampling,
+		ValidSearchAttributes: serviceConfig.ValidSearchAttributes,
 	}
 
 	visibilityManagerInitializer := func(
@@ -277,20 +284,20 @@ func (s *Service) Start() {
 	if err != nil {
 		logger.Fatal("creating grpc server options failed", tag.Error(err))
 	}
-	opts = append(opts, grpc.UnaryInterceptor(interceptor))
+	opts = append(opts, grpc.UnaryInterceptor(interceptor), grpc.StreamInterceptor(streamInterceptor))
 	s.server = grpc.NewServer(opts...)
 
 	wfHandler := NewWorkflowHandler(s, s.config, replicationMessageSink)
 	s.handler = NewDCRedirectionHandler(wfHandler, s.params.DCRedirectionPolicy)
 	if s.params.Authorizer != nil {
-		s.handler = NewAccessControlledHandlerImpl(s.handler, s.params.Authorizer)
+		s.handler = NewAccessControlledHandlerImpl(s.handler, s.params.Authorizer, s.params.CertIdentityExtractor)
 	}
 	workflowNilCheckHandler := NewWorkflowNilCheckHandler(s.handler)
 
 	workflowservice.RegisterWorkflowServiceServer(s.server, workflowNilCheckHandler)
 	healthpb.RegisterHealthServer(s.server, s.handler)
 
-	s.adminHandler = NewAdminHandler(s, s.params, s.config)
+	s.adminHandler = NewAdminHandler(s, s.params, s.config, wfHandler.(*WorkflowHandler))
 	adminNilCheckHandler := NewAdminNilCheckHandler(s.adminHandler)
 
 	adminservice.RegisterAdminServiceServer(s.server, adminNilCheckHandler)
@@ -340,6 +347,14 @@ func (s *Service) Stop() {
 }
 
 func interceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := validatePayloads(req); err != nil {
+		return nil, serviceerror.ToStatus(err).Err()
+	}
 	resp, err := handler(ctx, req)
 	return resp, serviceerror.ToStatus(err).Err()
 }
+
+func streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, ss)
+	return serviceerror.ToStatus(err).Err()
+}
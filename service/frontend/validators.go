@@ -25,8 +25,12 @@
 package frontend
 
 import (
+	"github.com/gogo/protobuf/proto"
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/largepayload"
+	"go.temporal.io/server/common/persistence/serialization"
 )
 
 func validateExecution(w *commonpb.WorkflowExecution) error {
@@ -41,3 +45,24 @@ func validateExecution(w *commonpb.WorkflowExecution) error {
 	}
 	return nil
 }
+
+// validatePayloads rejects requests whose payloads carry metadata reserved for the server, e.g. the
+// metadata of the payload codec or the encoding of large payload references, which would otherwise be
+// trusted when the payload is read back
+func validatePayloads(request interface{}) error {
+	m, ok := request.(proto.Message)
+	if !ok {
+		return nil
+	}
+	return serialization.VisitPayloads(m, func(payload *commonpb.Payload) error {
+		for key := range payload.GetMetadata() {
+			if serialization.IsReservedMetadataKey(key) {
+				return errReservedPayloadMetadata.MessageArgs(key)
+			}
+		}
+		if largepayload.IsReference(payload) {
+			return errReservedPayloadEncoding.MessageArgs(largepayload.ReferenceEncoding)
+		}
+		return nil
+	})
+}
@@ -27,6 +27,7 @@ package frontend
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -40,10 +41,12 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/backoff"
@@ -53,6 +56,7 @@ import (
 	"go.temporal.io/server/common/enums"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/largepayload"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/messaging"
@@ -389,6 +393,16 @@ func (wh *WorkflowHandler) DeprecateNamespace(ctx context.Context, request *work
 // first workflow task for this instance.  It will return 'WorkflowExecutionAlreadyStartedError', if an instance already
 // exists with same workflowId.
 func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *workflowservice.StartWorkflowExecutionRequest) (_ *workflowservice.StartWorkflowExecutionResponse, retError error) {
+	return wh.startWorkflowExecution(ctx, request, nil)
+}
+
+// startWorkflowExecution starts a new workflow instance with the deadline rules, the instance gets the default
+// deadline rules of its namespace if the rules are not set.
+func (wh *WorkflowHandler) startWorkflowExecution(
+	ctx context.Context,
+	request *workflowservice.StartWorkflowExecutionRequest,
+	deadlineRules *workflowspb.DeadlineRules,
+) (_ *workflowservice.StartWorkflowExecutionResponse, retError error) {
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendStartWorkflowExecutionScope, request.GetNamespace())
@@ -470,7 +484,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 	enums.SetDefaultWorkflowIdReusePolicy(&request.WorkflowIdReusePolicy)
 
 	wh.GetLogger().Debug("Start workflow execution request namespace", tag.WorkflowNamespace(namespace))
-	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(namespace)
+	namespaceID, err := wh.getUndeletedNamespaceID(namespace)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -481,7 +495,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 	sizeLimitError := wh.config.BlobSizeLimitError(namespace)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespace)
 
-	actualSize := request.GetInput().Size()
+	actualSize := wh.persistedBlobSize(namespace, request.GetInput())
 	actualSize += request.GetMemo().Size()
 
 	if err := common.CheckEventBlobSizeLimit(
@@ -498,8 +512,14 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 		return nil, wh.error(err, scope)
 	}
 
+	if err := common.ValidateDeadlineRules(deadlineRules.GetRules()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	wh.GetLogger().Debug("Start workflow execution request namespaceID", tag.WorkflowNamespaceID(namespaceID))
-	resp, err := wh.GetHistoryClient().StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID, request, nil, time.Now().UTC()))
+	histRequest := common.CreateHistoryStartWorkflowRequest(namespaceID, request, nil, time.Now().UTC())
+	histRequest.DeadlineRules = deadlineRules
+	resp, err := wh.GetHistoryClient().StartWorkflowExecution(ctx, histRequest)
 
 	if err != nil {
 		return nil, wh.error(err, scope)
@@ -682,6 +702,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 				historyBlob = historyBlob[len(historyBlob)-1 : len(historyBlob)]
 			} else {
 				history, _, err = wh.getHistory(
+					ctx,
 					scope,
 					namespaceID,
 					*execution,
@@ -728,6 +749,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 				)
 			} else {
 				history, continuationToken.PersistenceToken, err = wh.getHistory(
+					ctx,
 					scope,
 					namespaceID,
 					*execution,
@@ -812,7 +834,7 @@ func (wh *WorkflowHandler) PollWorkflowTaskQueue(ctx context.Context, request *w
 	}
 
 	namespace := request.GetNamespace()
-	namespaceEntry, err := wh.GetNamespaceCache().GetNamespace(namespace)
+	namespaceEntry, err := wh.getUndeletedNamespace(namespace)
 	if err != nil {
 		return nil, wh.error(err, scope, tagsForErrorLog...)
 	}
@@ -1005,7 +1027,7 @@ func (wh *WorkflowHandler) RespondWorkflowTaskFailed(ctx context.Context, reques
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetFailure().Size(),
+		wh.persistedBlobSize(namespaceEntry.GetInfo().Name, request.GetFailure()),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceId,
@@ -1078,7 +1100,7 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 		return nil, wh.error(errIdentityTooLong, scope)
 	}
 
-	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	namespaceID, err := wh.getUndeletedNamespaceID(request.GetNamespace())
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -1117,7 +1139,7 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 		return nil, nil
 	}
 
-	return &workflowservice.PollActivityTaskQueueResponse{
+	response := &workflowservice.PollActivityTaskQueueResponse{
 		TaskToken:                   matchingResponse.TaskToken,
 		WorkflowExecution:           matchingResponse.WorkflowExecution,
 		ActivityId:                  matchingResponse.ActivityId,
@@ -1134,7 +1156,11 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 		WorkflowType:                matchingResponse.WorkflowType,
 		WorkflowNamespace:           matchingResponse.WorkflowNamespace,
 		Header:                      matchingResponse.Header,
-	}, nil
+	}
+	if err := wh.rehydrateLargePayloads(ctx, response); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return response, nil
 }
 
 // RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails
@@ -1392,7 +1418,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(ctx context.Context, req
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetResult().Size(),
+		wh.persistedBlobSize(namespaceEntry.GetInfo().Name, request.GetResult()),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceId,
@@ -1501,7 +1527,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedById(ctx context.Context,
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetResult().Size(),
+		wh.persistedBlobSize(namespaceEntry.GetInfo().Name, request.GetResult()),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceID,
@@ -1602,7 +1628,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(ctx context.Context, reques
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetFailure().Size(),
+		wh.persistedBlobSize(namespaceEntry.GetInfo().Name, request.GetFailure()),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceID,
@@ -1699,7 +1725,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedById(ctx context.Context, re
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetFailure().Size(),
+		wh.persistedBlobSize(namespaceEntry.GetInfo().Name, request.GetFailure()),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceID,
@@ -1787,7 +1813,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(ctx context.Context, requ
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetDetails().Size(),
+		wh.persistedBlobSize(namespaceEntry.GetInfo().Name, request.GetDetails()),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceID,
@@ -1895,7 +1921,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledById(ctx context.Context,
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
 
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetDetails().Size(),
+		wh.persistedBlobSize(namespaceEntry.GetInfo().Name, request.GetDetails()),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceID,
@@ -2031,11 +2057,15 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context, request
 		return nil, wh.error(errSignalNameTooLong, scope)
 	}
 
+	if common.IsReservedSignalName(request.GetSignalName()) {
+		return nil, wh.error(errSignalNameIsReserved, scope)
+	}
+
 	if len(request.GetRequestId()) > wh.config.MaxIDLengthLimit() {
 		return nil, wh.error(errRequestIDTooLong, scope)
 	}
 
-	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	namespaceID, err := wh.getUndeletedNamespaceID(request.GetNamespace())
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2043,7 +2073,7 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context, request
 	sizeLimitError := wh.config.BlobSizeLimitError(request.GetNamespace())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(request.GetNamespace())
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetInput().Size(),
+		wh.persistedBlobSize(request.GetNamespace(), request.GetInput()),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceID,
@@ -2073,6 +2103,16 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context, request
 // If the workflow is not running or not found, this results in WorkflowExecutionStarted and WorkflowExecutionSignaled
 // events being recorded in history, and a workflow task being created for the execution
 func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context, request *workflowservice.SignalWithStartWorkflowExecutionRequest) (_ *workflowservice.SignalWithStartWorkflowExecutionResponse, retError error) {
+	return wh.signalWithStartWorkflowExecution(ctx, request, nil)
+}
+
+// signalWithStartWorkflowExecution signals or starts a workflow with the deadline rules, a started workflow gets
+// the default deadline rules of its namespace if the rules are not set.
+func (wh *WorkflowHandler) signalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *workflowservice.SignalWithStartWorkflowExecutionRequest,
+	deadlineRules *workflowspb.DeadlineRules,
+) (_ *workflowservice.SignalWithStartWorkflowExecutionResponse, retError error) {
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendSignalWithStartWorkflowExecutionScope, request.GetNamespace())
@@ -2119,6 +2159,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(errSignalNameTooLong, scope)
 	}
 
+	if common.IsReservedSignalName(request.GetSignalName()) {
+		return nil, wh.error(errSignalNameIsReserved, scope)
+	}
+
 	if request.WorkflowType == nil || request.WorkflowType.GetName() == "" {
 		return nil, wh.error(errWorkflowTypeNotSet, scope)
 	}
@@ -2153,7 +2197,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 
 	enums.SetDefaultWorkflowIdReusePolicy(&request.WorkflowIdReusePolicy)
 
-	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(namespace)
+	namespaceID, err := wh.getUndeletedNamespaceID(namespace)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2161,7 +2205,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 	sizeLimitError := wh.config.BlobSizeLimitError(namespace)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespace)
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetSignalInput().Size(),
+		wh.persistedBlobSize(namespace, request.GetSignalInput()),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceID,
@@ -2173,7 +2217,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 	); err != nil {
 		return nil, wh.error(err, scope)
 	}
-	actualSize := request.GetInput().Size() + request.GetMemo().Size()
+	actualSize := wh.persistedBlobSize(namespace, request.GetInput()) + request.GetMemo().Size()
 	if err := common.CheckEventBlobSizeLimit(
 		actualSize,
 		sizeLimitWarn,
@@ -2188,12 +2232,17 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(err, scope)
 	}
 
+	if err := common.ValidateDeadlineRules(deadlineRules.GetRules()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	var runId string
 	op := func() error {
 		var err error
 		resp, err := wh.GetHistoryClient().SignalWithStartWorkflowExecution(ctx, &historyservice.SignalWithStartWorkflowExecutionRequest{
 			NamespaceId:            namespaceID,
 			SignalWithStartRequest: request,
+			DeadlineRules:          deadlineRules,
 		})
 		runId = resp.GetRunId()
 		return err
@@ -2239,7 +2288,7 @@ func (wh *WorkflowHandler) ResetWorkflowExecution(ctx context.Context, request *
 		return nil, err
 	}
 
-	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	namespaceID, err := wh.getUndeletedNamespaceID(request.GetNamespace())
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2976,6 +3025,11 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context, request *workflows
 
 	enums.SetDefaultQueryRejectCondition(&request.QueryRejectCondition)
 
+	consistencyLevel, err := getQueryConsistencyLevel(ctx)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
 	if err != nil {
 		return nil, wh.error(err, scope)
@@ -2998,8 +3052,9 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context, request *workflows
 	}
 
 	req := &historyservice.QueryWorkflowRequest{
-		NamespaceId: namespaceID,
-		Request:     request,
+		NamespaceId:           namespaceID,
+		Request:               request,
+		QueryConsistencyLevel: consistencyLevel,
 	}
 	hResponse, err := wh.GetHistoryClient().QueryWorkflow(ctx, req)
 	if err != nil {
@@ -3240,6 +3295,7 @@ func (wh *WorkflowHandler) getRawHistory(
 }
 
 func (wh *WorkflowHandler) getHistory(
+	ctx context.Context,
 	scope metrics.Scope,
 	namespaceID string,
 	execution commonpb.WorkflowExecution,
@@ -3300,6 +3356,10 @@ func (wh *WorkflowHandler) getHistory(
 		historyEvents = append(historyEvents, transientWorkflowTaskInfo.ScheduledEvent, transientWorkflowTaskInfo.StartedEvent)
 	}
 
+	if err := wh.rehydrateLargePayloads(ctx, historyEvents); err != nil {
+		return nil, nil, err
+	}
+
 	executionHistory := &historypb.History{}
 	executionHistory.Events = historyEvents
 	return executionHistory, nextPageToken, nil
@@ -3457,6 +3517,7 @@ func (wh *WorkflowHandler) createPollWorkflowTaskQueueResponse(
 		}
 		scope = scope.Tagged(metrics.NamespaceTag(namespace.GetInfo().Name))
 		history, persistenceToken, err = wh.getHistory(
+			ctx,
 			scope,
 			namespaceID,
 			*matchingResp.GetWorkflowExecution(),
@@ -3506,6 +3567,25 @@ func (wh *WorkflowHandler) createPollWorkflowTaskQueueResponse(
 	return resp, nil
 }
 
+// rehydrateLargePayloads replaces the large payload references found in value by the payloads they refer to.
+// Without a large payload store, e.g. after it was removed from the config, the references are returned as is
+// so that the rest of the history can still be read.
+func (wh *WorkflowHandler) rehydrateLargePayloads(ctx context.Context, value interface{}) error {
+	err := wh.GetLargePayloadOffloader().Rehydrate(ctx, value)
+	if err == largepayload.ErrStoreNotConfigured {
+		wh.GetLogger().Warn("Large payload references are returned unresolved because no large payload store is configured.")
+		return nil
+	}
+	return err
+}
+
+// persistedBlobSize returns the size value takes in the history, the payloads offloaded to the large payload store
+// are not counted so that the blob size limits don't reject them
+func (wh *WorkflowHandler) persistedBlobSize(namespace string, value interface{ Size() int }) int {
+	threshold := wh.config.LargePayloadThreshold(namespace)
+	return value.Size() - wh.GetLargePayloadOffloader().OffloadedSize(value, threshold)
+}
+
 func (wh *WorkflowHandler) verifyHistoryIsComplete(
 	events []*historypb.HistoryEvent,
 	expectedFirstEventID int64,
@@ -3631,6 +3711,10 @@ func (wh *WorkflowHandler) getArchivedHistory(
 	for _, batch := range resp.HistoryBatches {
 		history.Events = append(history.Events, batch.Events...)
 	}
+	// the large payloads of archived histories are kept in the large payload store
+	if err := wh.rehydrateLargePayloads(ctx, history.Events); err != nil {
+		return nil, wh.error(err, scope)
+	}
 	return &workflowservice.GetWorkflowExecutionHistoryResponse{
 		History:       history,
 		NextPageToken: resp.NextPageToken,
@@ -3652,8 +3736,15 @@ func (wh *WorkflowHandler) isListRequestPageSizeTooLarge(pageSize int32, namespa
 }
 
 func (wh *WorkflowHandler) allow(namespace string) bool {
+	// rate limit an alias against the quota of the namespace it resolves to
+	if namespace != "" {
+		if entry, err := wh.GetNamespaceCache().GetNamespace(namespace); err == nil {
+			namespace = entry.GetInfo().GetName()
+		}
+	}
 	return wh.rateLimiter.Allow(quotas.Info{Namespace: namespace})
 }
+
 func (wh *WorkflowHandler) checkPermission(
 	config *Config,
 	securityToken string,
@@ -3695,6 +3786,27 @@ func (wh *WorkflowHandler) cancelOutstandingPoll(ctx context.Context, err error,
 	return err
 }
 
+// getUndeletedNamespace returns the cache entry of a namespace, deleted namespaces are rejected
+// as they no longer accept new workflows, signals or polls
+func (wh *WorkflowHandler) getUndeletedNamespace(namespace string) (*cache.NamespaceCacheEntry, error) {
+	namespaceEntry, err := wh.GetNamespaceCache().GetNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	if namespaceEntry.GetInfo().State == enumspb.NAMESPACE_STATE_DELETED {
+		return nil, errNamespaceDeleted
+	}
+	return namespaceEntry, nil
+}
+
+func (wh *WorkflowHandler) getUndeletedNamespaceID(namespace string) (string, error) {
+	namespaceEntry, err := wh.getUndeletedNamespace(namespace)
+	if err != nil {
+		return "", err
+	}
+	return namespaceEntry.GetInfo().Id, nil
+}
+
 func (wh *WorkflowHandler) checkBadBinary(namespaceEntry *cache.NamespaceCacheEntry, binaryChecksum string) error {
 	if namespaceEntry.GetConfig().BadBinaries.Binaries != nil {
 		badBinaries := namespaceEntry.GetConfig().BadBinaries.Binaries
@@ -3818,3 +3930,18 @@ func (wh *WorkflowHandler) validateSignalWithStartWorkflowTimeouts(
 
 	return nil
 }
+
+// getQueryConsistencyLevel reads the consistency level a query is asking for from the request headers.
+// Queries which don't set it are strongly consistent, which is the behaviour of all queries before the header.
+func getQueryConsistencyLevel(ctx context.Context) (enumsspb.QueryConsistencyLevel, error) {
+	switch strings.ToLower(headers.GetValues(ctx, headers.QueryConsistencyLevelHeaderName)[0]) {
+	case "":
+		return enumsspb.QUERY_CONSISTENCY_LEVEL_UNSPECIFIED, nil
+	case "eventual":
+		return enumsspb.QUERY_CONSISTENCY_LEVEL_EVENTUAL, nil
+	case "strong":
+		return enumsspb.QUERY_CONSISTENCY_LEVEL_STRONG, nil
+	default:
+		return enumsspb.QUERY_CONSISTENCY_LEVEL_UNSPECIFIED, errInvalidQueryConsistencyLevel
+	}
+}
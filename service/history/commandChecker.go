@@ -42,6 +42,7 @@ import (
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/elasticsearch/validator"
 	"go.temporal.io/server/common/failure"
+	"go.temporal.io/server/common/largepayload"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -70,6 +71,9 @@ type (
 		historyCountLimitWarn  int
 		historyCountLimitError int
 
+		largePayloadOffloader largepayload.Offloader
+		largePayloadThreshold int
+
 		completedID    int64
 		mutableState   mutableState
 		executionStats *persistenceblobs.ExecutionStats
@@ -110,6 +114,8 @@ func newWorkflowSizeChecker(
 	historySizeLimitError int,
 	historyCountLimitWarn int,
 	historyCountLimitError int,
+	largePayloadOffloader largepayload.Offloader,
+	largePayloadThreshold int,
 	completedID int64,
 	mutableState mutableState,
 	executionStats *persistenceblobs.ExecutionStats,
@@ -123,6 +129,8 @@ func newWorkflowSizeChecker(
 		historySizeLimitError:  historySizeLimitError,
 		historyCountLimitWarn:  historyCountLimitWarn,
 		historyCountLimitError: historyCountLimitError,
+		largePayloadOffloader:  largePayloadOffloader,
+		largePayloadThreshold:  largePayloadThreshold,
 		completedID:            completedID,
 		mutableState:           mutableState,
 		executionStats:         executionStats,
@@ -131,6 +139,12 @@ func newWorkflowSizeChecker(
 	}
 }
 
+// persistedPayloadSize returns size, the size of value, without the payloads offloaded to the large payload store
+// when the command event is appended to the history, blob size limits don't apply to them
+func (c *workflowSizeChecker) persistedPayloadSize(size int, value interface{}) int {
+	return size - c.largePayloadOffloader.OffloadedSize(value, c.largePayloadThreshold)
+}
+
 func (c *workflowSizeChecker) failWorkflowIfPayloadSizeExceedsLimit(
 	commandTypeTag metrics.Tag,
 	payloadSize int,
@@ -174,6 +188,7 @@ func (v *commandAttrValidator) validateActivityScheduleAttributes(
 	if err := v.validateCrossNamespaceCall(
 		namespaceID,
 		targetNamespaceID,
+		true,
 	); err != nil {
 		return err
 	}
@@ -372,6 +387,7 @@ func (v *commandAttrValidator) validateCancelExternalWorkflowExecutionAttributes
 	if err := v.validateCrossNamespaceCall(
 		namespaceID,
 		targetNamespaceID,
+		false,
 	); err != nil {
 		return err
 	}
@@ -408,6 +424,7 @@ func (v *commandAttrValidator) validateSignalExternalWorkflowExecutionAttributes
 	if err := v.validateCrossNamespaceCall(
 		namespaceID,
 		targetNamespaceID,
+		false,
 	); err != nil {
 		return err
 	}
@@ -514,6 +531,9 @@ func (v *commandAttrValidator) validateContinueAsNewWorkflowExecutionAttributes(
 	if err != nil {
 		return err
 	}
+	if err := v.validateNamespaceNotDeleted(namespaceEntry); err != nil {
+		return err
+	}
 	return v.searchAttributesValidator.ValidateSearchAttributes(attributes.GetSearchAttributes(), namespaceEntry.GetInfo().Name)
 }
 
@@ -528,10 +548,19 @@ func (v *commandAttrValidator) validateStartChildExecutionAttributes(
 	if err := v.validateCrossNamespaceCall(
 		namespaceID,
 		targetNamespaceID,
+		true,
 	); err != nil {
 		return err
 	}
 
+	targetNamespaceEntry, err := v.namespaceCache.GetNamespaceByID(targetNamespaceID)
+	if err != nil {
+		return err
+	}
+	if err := v.validateNamespaceNotDeleted(targetNamespaceEntry); err != nil {
+		return err
+	}
+
 	if attributes == nil {
 		return serviceerror.NewInvalidArgument("StartChildWorkflowExecutionCommandAttributes is not set on command.")
 	}
@@ -585,6 +614,17 @@ func (v *commandAttrValidator) validateStartChildExecutionAttributes(
 	return nil
 }
 
+// validateNamespaceNotDeleted rejects the commands starting new runs in a deleted namespace
+func (v *commandAttrValidator) validateNamespaceNotDeleted(
+	namespaceEntry *cache.NamespaceCacheEntry,
+) error {
+
+	if namespaceEntry.GetInfo().GetState() == enumspb.NAMESPACE_STATE_DELETED {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Namespace %v is deleted.", namespaceEntry.GetInfo().Name))
+	}
+	return nil
+}
+
 func (v *commandAttrValidator) validateTaskQueue(
 	taskQueue *taskqueuepb.TaskQueue,
 	defaultVal string,
@@ -638,9 +678,13 @@ func (v *commandAttrValidator) validateWorkflowRetryPolicy(attributes *commandpb
 	return common.ValidateRetryPolicy(attributes.RetryPolicy)
 }
 
+// validateCrossNamespaceCall checks that the workflows of the namespace are allowed to make calls to the target namespace.
+// Child workflows and activities are bound to their parent workflow, so the target namespace also has to be active in the
+// current cluster, while signals and cancellations to a target namespace active in another cluster are forwarded to it.
 func (v *commandAttrValidator) validateCrossNamespaceCall(
 	namespaceID string,
 	targetNamespaceID string,
+	targetMustBeActive bool,
 ) error {
 
 	// same name, no check needed
@@ -658,23 +702,35 @@ func (v *commandAttrValidator) validateCrossNamespaceCall(
 		return err
 	}
 
-	// both local namespace
-	if !namespaceEntry.IsGlobalNamespace() && !targetNamespaceEntry.IsGlobalNamespace() {
-		return nil
+	if !v.isCrossNamespaceCallAllowed(namespaceID, targetNamespaceID) {
+		return v.createCrossNamespaceCallError(namespaceEntry, targetNamespaceEntry)
+	}
+
+	if targetMustBeActive && !targetNamespaceEntry.IsNamespaceActive() {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"cannot make cross namespace call between %v and %v, %v is active in cluster %v",
+			namespaceEntry.GetInfo().Name,
+			targetNamespaceEntry.GetInfo().Name,
+			targetNamespaceEntry.GetInfo().Name,
+			targetNamespaceEntry.GetReplicationConfig().ActiveClusterName,
+		))
 	}
+	return nil
+}
 
-	namespaceClusters := namespaceEntry.GetReplicationConfig().Clusters
-	targetNamespaceClusters := targetNamespaceEntry.GetReplicationConfig().Clusters
+// isCrossNamespaceCallAllowed looks the target namespace ID up in the allow list of the namespace,
+// an entry for the target namespace takes precedence over the "*" entry, calls are allowed without both
+func (v *commandAttrValidator) isCrossNamespaceCallAllowed(
+	namespaceID string,
+	targetNamespaceID string,
+) bool {
 
-	// one is local namespace, another one is global namespace or both global namespace
-	// treat global namespace with one replication cluster as local namespace
-	if len(namespaceClusters) == 1 && len(targetNamespaceClusters) == 1 {
-		if namespaceClusters[0] == targetNamespaceClusters[0] {
-			return nil
-		}
-		return v.createCrossNamespaceCallError(namespaceEntry, targetNamespaceEntry)
+	allowList := v.config.CrossNamespaceCallAllowList(namespaceID)
+	if allowed, ok := allowList[targetNamespaceID]; ok {
+		return allowed == true
 	}
-	return v.createCrossNamespaceCallError(namespaceEntry, targetNamespaceEntry)
+	allowed, ok := allowList["*"].(bool)
+	return !ok || allowed
 }
 
 func (v *commandAttrValidator) createCrossNamespaceCallError(
@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/service/dynamicconfig"
 )
@@ -58,7 +59,9 @@ type (
 		controller         *gomock.Controller
 		mockNamespaceCache *cache.MockNamespaceCache
 
-		validator *commandAttrValidator
+		config          *Config
+		clusterMetadata cluster.Metadata
+		validator       *commandAttrValidator
 
 		testNamespaceID       string
 		testTargetNamespaceID string
@@ -83,7 +86,8 @@ func (s *commandAttrValidatorSuite) SetupTest() {
 
 	s.controller = gomock.NewController(s.T())
 	s.mockNamespaceCache = cache.NewMockNamespaceCache(s.controller)
-	config := &Config{
+	s.clusterMetadata = cluster.GetTestClusterMetadata(true, true)
+	s.config = &Config{
 		MaxIDLengthLimit:                  dynamicconfig.GetIntPropertyFn(1000),
 		ValidSearchAttributes:             dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
 		SearchAttributesNumberOfKeysLimit: dynamicconfig.GetIntPropertyFilteredByNamespace(100),
@@ -91,10 +95,11 @@ func (s *commandAttrValidatorSuite) SetupTest() {
 		SearchAttributesTotalSizeLimit:    dynamicconfig.GetIntPropertyFilteredByNamespace(40 * 1024),
 		DefaultActivityRetryPolicy:        dynamicconfig.GetMapPropertyFnWithNamespaceFilter(common.GetDefaultRetryPolicyConfigOptions()),
 		DefaultWorkflowRetryPolicy:        dynamicconfig.GetMapPropertyFnWithNamespaceFilter(common.GetDefaultRetryPolicyConfigOptions()),
+		CrossNamespaceCallAllowList:       dynamicconfig.GetMapPropertyFnWithNamespaceIDFilter(map[string]interface{}{"*": true}),
 	}
 	s.validator = newCommandAttrValidator(
 		s.mockNamespaceCache,
-		config,
+		s.config,
 		log.NewNoop(),
 	)
 }
@@ -185,60 +190,10 @@ func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_LocalToLocal(
 	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(namespaceEntry, nil).Times(1)
 	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testTargetNamespaceID).Return(targetNamespaceEntry, nil).Times(1)
 
-	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID)
+	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID, true)
 	s.Nil(err)
 }
 
-func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_LocalToEffectiveLocal_SameCluster() {
-	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
-		&persistenceblobs.NamespaceInfo{Name: s.testNamespaceID},
-		nil,
-		cluster.TestCurrentClusterName,
-		nil,
-	)
-	targetNamespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
-		&persistenceblobs.NamespaceInfo{Name: s.testTargetNamespaceID},
-		nil,
-		&persistenceblobs.NamespaceReplicationConfig{
-			ActiveClusterName: cluster.TestCurrentClusterName,
-			Clusters:          []string{cluster.TestCurrentClusterName},
-		},
-		1234,
-		nil,
-	)
-
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(namespaceEntry, nil).Times(1)
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testTargetNamespaceID).Return(targetNamespaceEntry, nil).Times(1)
-
-	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID)
-	s.Nil(err)
-}
-
-func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_LocalToEffectiveLocal_DiffCluster() {
-	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
-		&persistenceblobs.NamespaceInfo{Name: s.testNamespaceID},
-		nil,
-		cluster.TestCurrentClusterName,
-		nil,
-	)
-	targetNamespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
-		&persistenceblobs.NamespaceInfo{Name: s.testTargetNamespaceID},
-		nil,
-		&persistenceblobs.NamespaceReplicationConfig{
-			ActiveClusterName: cluster.TestAlternativeClusterName,
-			Clusters:          []string{cluster.TestAlternativeClusterName},
-		},
-		1234,
-		nil,
-	)
-
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(namespaceEntry, nil).Times(1)
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testTargetNamespaceID).Return(targetNamespaceEntry, nil).Times(1)
-
-	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID)
-	s.IsType(&serviceerror.InvalidArgument{}, err)
-}
-
 func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_LocalToGlobal() {
 	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
 		&persistenceblobs.NamespaceInfo{Name: s.testNamespaceID},
@@ -257,170 +212,96 @@ func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_LocalToGlobal
 			},
 		},
 		1234,
-		nil,
-	)
-
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(namespaceEntry, nil).Times(1)
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testTargetNamespaceID).Return(targetNamespaceEntry, nil).Times(1)
-
-	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID)
-	s.IsType(&serviceerror.InvalidArgument{}, err)
-}
-
-func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_EffectiveLocalToLocal_SameCluster() {
-	namespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
-		&persistenceblobs.NamespaceInfo{Name: s.testNamespaceID},
-		nil,
-		&persistenceblobs.NamespaceReplicationConfig{
-			ActiveClusterName: cluster.TestCurrentClusterName,
-			Clusters:          []string{cluster.TestCurrentClusterName},
-		},
-		1234,
-		nil,
-	)
-	targetNamespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
-		&persistenceblobs.NamespaceInfo{Name: s.testTargetNamespaceID},
-		nil,
-		cluster.TestCurrentClusterName,
-		nil,
+		s.clusterMetadata,
 	)
 
 	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(namespaceEntry, nil).Times(1)
 	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testTargetNamespaceID).Return(targetNamespaceEntry, nil).Times(1)
 
-	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID)
+	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID, true)
 	s.Nil(err)
 }
 
-func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_EffectiveLocalToLocal_DiffCluster() {
-	namespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
-		&persistenceblobs.NamespaceInfo{Name: s.testNamespaceID},
-		nil,
-		&persistenceblobs.NamespaceReplicationConfig{
-			ActiveClusterName: cluster.TestAlternativeClusterName,
-			Clusters:          []string{cluster.TestAlternativeClusterName},
-		},
-		1234,
-		nil,
-	)
-	targetNamespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
-		&persistenceblobs.NamespaceInfo{Name: s.testTargetNamespaceID},
-		nil,
-		cluster.TestCurrentClusterName,
-		nil,
-	)
-
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(namespaceEntry, nil).Times(1)
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testTargetNamespaceID).Return(targetNamespaceEntry, nil).Times(1)
-
-	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID)
-	s.IsType(&serviceerror.InvalidArgument{}, err)
-}
-
-func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_EffectiveLocalToEffectiveLocal_SameCluster() {
+func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_GlobalToGlobal_DiffNamespace() {
 	namespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
 		&persistenceblobs.NamespaceInfo{Name: s.testNamespaceID},
 		nil,
 		&persistenceblobs.NamespaceReplicationConfig{
 			ActiveClusterName: cluster.TestCurrentClusterName,
-			Clusters:          []string{cluster.TestCurrentClusterName},
+			Clusters: []string{
+				cluster.TestAlternativeClusterName,
+				cluster.TestCurrentClusterName,
+			},
 		},
 		1234,
-		nil,
+		s.clusterMetadata,
 	)
 	targetNamespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
 		&persistenceblobs.NamespaceInfo{Name: s.testTargetNamespaceID},
 		nil,
 		&persistenceblobs.NamespaceReplicationConfig{
 			ActiveClusterName: cluster.TestCurrentClusterName,
-			Clusters:          []string{cluster.TestCurrentClusterName},
+			Clusters: []string{
+				cluster.TestCurrentClusterName,
+				cluster.TestAlternativeClusterName,
+			},
 		},
-		5678,
-		nil,
+		1234,
+		s.clusterMetadata,
 	)
 
 	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(namespaceEntry, nil).Times(1)
 	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testTargetNamespaceID).Return(targetNamespaceEntry, nil).Times(1)
 
-	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID)
+	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID, true)
 	s.Nil(err)
 }
 
-func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_EffectiveLocalToEffectiveLocal_DiffCluster() {
-	namespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
-		&persistenceblobs.NamespaceInfo{Name: s.testNamespaceID},
-		nil,
-		&persistenceblobs.NamespaceReplicationConfig{
-			ActiveClusterName: cluster.TestCurrentClusterName,
-			Clusters:          []string{cluster.TestCurrentClusterName},
-		},
-		1234,
-		nil,
-	)
-	targetNamespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
-		&persistenceblobs.NamespaceInfo{Name: s.testTargetNamespaceID},
-		nil,
-		&persistenceblobs.NamespaceReplicationConfig{
-			ActiveClusterName: cluster.TestAlternativeClusterName,
-			Clusters:          []string{cluster.TestAlternativeClusterName},
-		},
-		5678,
-		nil,
-	)
-
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(namespaceEntry, nil).Times(1)
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testTargetNamespaceID).Return(targetNamespaceEntry, nil).Times(1)
+func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_GlobalToGlobal_SameNamespace() {
+	targetNamespaceID := s.testNamespaceID
 
-	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID)
-	s.IsType(&serviceerror.InvalidArgument{}, err)
+	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, targetNamespaceID, true)
+	s.Nil(err)
 }
 
-func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_EffectiveLocalToGlobal() {
-	namespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
+func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_TargetActiveInOtherCluster() {
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
 		&persistenceblobs.NamespaceInfo{Name: s.testNamespaceID},
 		nil,
-		&persistenceblobs.NamespaceReplicationConfig{
-			ActiveClusterName: cluster.TestCurrentClusterName,
-			Clusters: []string{
-				cluster.TestCurrentClusterName,
-			},
-		},
-		5678,
+		cluster.TestCurrentClusterName,
 		nil,
 	)
 	targetNamespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
 		&persistenceblobs.NamespaceInfo{Name: s.testTargetNamespaceID},
 		nil,
 		&persistenceblobs.NamespaceReplicationConfig{
-			ActiveClusterName: cluster.TestCurrentClusterName,
+			ActiveClusterName: cluster.TestAlternativeClusterName,
 			Clusters: []string{
 				cluster.TestCurrentClusterName,
 				cluster.TestAlternativeClusterName,
 			},
 		},
 		1234,
-		nil,
+		s.clusterMetadata,
 	)
 
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(namespaceEntry, nil).Times(1)
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testTargetNamespaceID).Return(targetNamespaceEntry, nil).Times(1)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(namespaceEntry, nil).Times(2)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testTargetNamespaceID).Return(targetNamespaceEntry, nil).Times(2)
 
-	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID)
+	// child workflows and activities have to be started in the current cluster
+	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID, true)
 	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	// signals and cancellations are forwarded to the active cluster of the target namespace
+	err = s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID, false)
+	s.Nil(err)
 }
 
-func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_GlobalToLocal() {
-	namespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
+func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_AllowList() {
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
 		&persistenceblobs.NamespaceInfo{Name: s.testNamespaceID},
 		nil,
-		&persistenceblobs.NamespaceReplicationConfig{
-			ActiveClusterName: cluster.TestCurrentClusterName,
-			Clusters: []string{
-				cluster.TestCurrentClusterName,
-				cluster.TestAlternativeClusterName,
-			},
-		},
-		1234,
+		cluster.TestCurrentClusterName,
 		nil,
 	)
 	targetNamespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
@@ -430,89 +311,55 @@ func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_GlobalToLocal
 		nil,
 	)
 
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(namespaceEntry, nil).Times(1)
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testTargetNamespaceID).Return(targetNamespaceEntry, nil).Times(1)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(namespaceEntry, nil).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testTargetNamespaceID).Return(targetNamespaceEntry, nil).AnyTimes()
 
-	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID)
-	s.IsType(&serviceerror.InvalidArgument{}, err)
+	testCases := []struct {
+		allowList map[string]interface{}
+		allowed   bool
+	}{
+		{allowList: map[string]interface{}{}, allowed: true},
+		{allowList: map[string]interface{}{"*": false}, allowed: false},
+		{allowList: map[string]interface{}{"*": true}, allowed: true},
+		{allowList: map[string]interface{}{s.testTargetNamespaceID: true}, allowed: true},
+		{allowList: map[string]interface{}{s.testTargetNamespaceID: false}, allowed: false},
+		{allowList: map[string]interface{}{"*": true, s.testTargetNamespaceID: false}, allowed: false},
+		{allowList: map[string]interface{}{"*": false, s.testTargetNamespaceID: true}, allowed: true},
+	}
+
+	for _, tc := range testCases {
+		s.config.CrossNamespaceCallAllowList = dynamicconfig.GetMapPropertyFnWithNamespaceIDFilter(tc.allowList)
+		err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID, false)
+		if tc.allowed {
+			s.Nil(err)
+		} else {
+			s.IsType(&serviceerror.InvalidArgument{}, err)
+		}
+	}
 }
 
-func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_GlobalToEffectiveLocal() {
-	namespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
-		&persistenceblobs.NamespaceInfo{Name: s.testNamespaceID},
-		nil,
-		&persistenceblobs.NamespaceReplicationConfig{
-			ActiveClusterName: cluster.TestCurrentClusterName,
-			Clusters: []string{
-				cluster.TestCurrentClusterName,
-				cluster.TestAlternativeClusterName,
-			},
-		},
-		5678,
-		nil,
-	)
-	targetNamespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
-		&persistenceblobs.NamespaceInfo{Name: s.testTargetNamespaceID},
+func (s *commandAttrValidatorSuite) TestValidateStartChildExecutionAttributes_DeletedNamespace() {
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Name: s.testNamespaceID, State: enumspb.NAMESPACE_STATE_DELETED},
 		nil,
-		&persistenceblobs.NamespaceReplicationConfig{
-			ActiveClusterName: cluster.TestCurrentClusterName,
-			Clusters: []string{
-				cluster.TestCurrentClusterName,
-			},
-		},
-		1234,
+		cluster.TestCurrentClusterName,
 		nil,
 	)
-
 	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(namespaceEntry, nil).Times(1)
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testTargetNamespaceID).Return(targetNamespaceEntry, nil).Times(1)
-
-	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID)
-	s.IsType(&serviceerror.InvalidArgument{}, err)
-}
 
-func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_GlobalToGlobal_DiffNamespace() {
-	namespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
-		&persistenceblobs.NamespaceInfo{Name: s.testNamespaceID},
-		nil,
-		&persistenceblobs.NamespaceReplicationConfig{
-			ActiveClusterName: cluster.TestCurrentClusterName,
-			Clusters: []string{
-				cluster.TestAlternativeClusterName,
-				cluster.TestCurrentClusterName,
-			},
-		},
-		1234,
-		nil,
-	)
-	targetNamespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
-		&persistenceblobs.NamespaceInfo{Name: s.testTargetNamespaceID},
-		nil,
-		&persistenceblobs.NamespaceReplicationConfig{
-			ActiveClusterName: cluster.TestCurrentClusterName,
-			Clusters: []string{
-				cluster.TestCurrentClusterName,
-				cluster.TestAlternativeClusterName,
-			},
+	err := s.validator.validateStartChildExecutionAttributes(
+		s.testNamespaceID,
+		s.testNamespaceID,
+		s.testNamespaceID,
+		&commandpb.StartChildWorkflowExecutionCommandAttributes{
+			WorkflowId:   "child-workflow-id",
+			WorkflowType: &commonpb.WorkflowType{Name: "child-workflow-type"},
 		},
-		1234,
-		nil,
+		&persistence.WorkflowExecutionInfo{TaskQueue: "task-queue"},
 	)
-
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testNamespaceID).Return(namespaceEntry, nil).Times(1)
-	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.testTargetNamespaceID).Return(targetNamespaceEntry, nil).Times(1)
-
-	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, s.testTargetNamespaceID)
 	s.IsType(&serviceerror.InvalidArgument{}, err)
 }
 
-func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_GlobalToGlobal_SameNamespace() {
-	targetNamespaceID := s.testNamespaceID
-
-	err := s.validator.validateCrossNamespaceCall(s.testNamespaceID, targetNamespaceID)
-	s.Nil(err)
-}
-
 func (s *commandAttrValidatorSuite) TestValidateTaskQueueName() {
 	newTaskQueue := func(name string) *taskqueuepb.TaskQueue {
 		return &taskqueuepb.TaskQueue{
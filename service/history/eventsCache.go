@@ -50,6 +50,12 @@ type (
 			eventID int64,
 			branchToken []byte,
 		) (*historypb.HistoryEvent, error)
+		peekEvent(
+			namespaceID string,
+			workflowID string,
+			runID string,
+			eventID int64,
+		) (*historypb.HistoryEvent, bool)
 		putEvent(
 			namespaceID string,
 			workflowID string,
@@ -152,6 +158,20 @@ func (e *eventsCacheImpl) getEvent(namespaceID, workflowID, runID string, firstE
 	return event, nil
 }
 
+// peekEvent returns the event only if it is in the cache, it never reads the event from the store
+func (e *eventsCacheImpl) peekEvent(namespaceID, workflowID, runID string, eventID int64) (*historypb.HistoryEvent, bool) {
+	if e.disabled {
+		return nil, false
+	}
+	e.metricsClient.IncCounter(metrics.EventsCacheGetEventScope, metrics.CacheRequests)
+	key := newEventKey(namespaceID, workflowID, runID, eventID)
+	event, cacheHit := e.Cache.Get(key).(*historypb.HistoryEvent)
+	if !cacheHit {
+		e.metricsClient.IncCounter(metrics.EventsCacheGetEventScope, metrics.CacheMissCounter)
+	}
+	return event, cacheHit
+}
+
 func (e *eventsCacheImpl) putEvent(namespaceID, workflowID, runID string, eventID int64, event *historypb.HistoryEvent) {
 	e.metricsClient.IncCounter(metrics.EventsCachePutEventScope, metrics.CacheRequests)
 	sw := e.metricsClient.StartTimer(metrics.EventsCachePutEventScope, metrics.CacheLatency)
@@ -73,6 +73,21 @@ func (mr *MockeventsCacheMockRecorder) getEvent(namespaceID, workflowID, runID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getEvent", reflect.TypeOf((*MockeventsCache)(nil).getEvent), namespaceID, workflowID, runID, firstEventID, eventID, branchToken)
 }
 
+// peekEvent mocks base method.
+func (m *MockeventsCache) peekEvent(namespaceID, workflowID, runID string, eventID int64) (*history.HistoryEvent, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "peekEvent", namespaceID, workflowID, runID, eventID)
+	ret0, _ := ret[0].(*history.HistoryEvent)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// peekEvent indicates an expected call of peekEvent.
+func (mr *MockeventsCacheMockRecorder) peekEvent(namespaceID, workflowID, runID, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "peekEvent", reflect.TypeOf((*MockeventsCache)(nil).peekEvent), namespaceID, workflowID, runID, eventID)
+}
+
 // putEvent mocks base method.
 func (m *MockeventsCache) putEvent(namespaceID, workflowID, runID string, eventID int64, event *history.HistoryEvent) {
 	m.ctrl.T.Helper()
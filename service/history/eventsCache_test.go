@@ -105,6 +105,27 @@ func (s *eventsCacheSuite) TestEventsCacheHitSuccess() {
 	s.Equal(event, actualEvent)
 }
 
+func (s *eventsCacheSuite) TestEventsCachePeekEvent() {
+	namespaceID := "events-cache-peek-event-namespace"
+	workflowID := "events-cache-peek-event-workflow-id"
+	runID := "events-cache-peek-event-run-id"
+	eventID := int64(23)
+	event := &historypb.HistoryEvent{
+		EventId:    eventID,
+		EventType:  enumspb.EVENT_TYPE_ACTIVITY_TASK_STARTED,
+		Attributes: &historypb.HistoryEvent_ActivityTaskStartedEventAttributes{ActivityTaskStartedEventAttributes: &historypb.ActivityTaskStartedEventAttributes{}},
+	}
+
+	// a miss does not read the event from the store
+	_, ok := s.cache.peekEvent(namespaceID, workflowID, runID, eventID)
+	s.False(ok)
+
+	s.cache.putEvent(namespaceID, workflowID, runID, eventID, event)
+	actualEvent, ok := s.cache.peekEvent(namespaceID, workflowID, runID, eventID)
+	s.True(ok)
+	s.Equal(event, actualEvent)
+}
+
 func (s *eventsCacheSuite) TestEventsCacheMissMultiEventsBatchV2Success() {
 	namespaceID := "events-cache-miss-multi-events-batch-v2-success-namespace"
 	workflowID := "events-cache-miss-multi-events-batch-v2-success-workflow-id"
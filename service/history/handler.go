@@ -1725,6 +1725,274 @@ func (h *Handler) RefreshWorkflowTasks(ctx context.Context, request *historyserv
 	return &historyservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+func (h *Handler) RestoreWorkflowExecution(ctx context.Context, request *historyservice.RestoreWorkflowExecutionRequest) (_ *historyservice.RestoreWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRestoreWorkflowExecutionScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	execution := request.GetRequest().GetExecution()
+	workflowID := execution.GetWorkflowId()
+	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	err = engine.RestoreWorkflowExecution(
+		ctx,
+		namespaceID,
+		commonpb.WorkflowExecution{
+			WorkflowId: execution.WorkflowId,
+			RunId:      execution.RunId,
+		},
+	)
+
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	return &historyservice.RestoreWorkflowExecutionResponse{}, nil
+}
+
+func (h *Handler) PauseWorkflowExecution(ctx context.Context, request *historyservice.PauseWorkflowExecutionRequest) (_ *historyservice.PauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPauseWorkflowExecutionScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	execution := request.GetRequest().GetExecution()
+	workflowID := execution.GetWorkflowId()
+	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	err = engine.PauseWorkflowExecution(ctx, request)
+
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	return &historyservice.PauseWorkflowExecutionResponse{}, nil
+}
+
+func (h *Handler) UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) (_ *historyservice.UnpauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUnpauseWorkflowExecutionScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	execution := request.GetRequest().GetExecution()
+	workflowID := execution.GetWorkflowId()
+	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	err = engine.UnpauseWorkflowExecution(ctx, request)
+
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	return &historyservice.UnpauseWorkflowExecutionResponse{}, nil
+}
+
+func (h *Handler) PauseActivity(ctx context.Context, request *historyservice.PauseActivityRequest) (_ *historyservice.PauseActivityResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPauseActivityScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	execution := request.GetRequest().GetExecution()
+	workflowID := execution.GetWorkflowId()
+	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	err = engine.PauseActivity(ctx, request)
+
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	return &historyservice.PauseActivityResponse{}, nil
+}
+
+func (h *Handler) UnpauseActivity(ctx context.Context, request *historyservice.UnpauseActivityRequest) (_ *historyservice.UnpauseActivityResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUnpauseActivityScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	execution := request.GetRequest().GetExecution()
+	workflowID := execution.GetWorkflowId()
+	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	err = engine.UnpauseActivity(ctx, request)
+
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	return &historyservice.UnpauseActivityResponse{}, nil
+}
+
+func (h *Handler) ResetActivity(ctx context.Context, request *historyservice.ResetActivityRequest) (_ *historyservice.ResetActivityResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryResetActivityScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	execution := request.GetRequest().GetExecution()
+	workflowID := execution.GetWorkflowId()
+	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	err = engine.ResetActivity(ctx, request)
+
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	return &historyservice.ResetActivityResponse{}, nil
+}
+
+func (h *Handler) UpdateActivityOptions(ctx context.Context, request *historyservice.UpdateActivityOptionsRequest) (_ *historyservice.UpdateActivityOptionsResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUpdateActivityOptionsScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	execution := request.GetRequest().GetExecution()
+	workflowID := execution.GetWorkflowId()
+	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	err = engine.UpdateActivityOptions(ctx, request)
+
+	if err != nil {
+		err = h.error(err, scope, namespaceID, workflowID)
+		return nil, err
+	}
+
+	return &historyservice.UpdateActivityOptionsResponse{}, nil
+}
+
+// StreamWorkflowExecutionHistory streams the history events of a workflow execution as they are committed.
+func (h *Handler) StreamWorkflowExecutionHistory(request *historyservice.StreamWorkflowExecutionHistoryRequest, server historyservice.HistoryService_StreamWorkflowExecutionHistoryServer) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryStreamWorkflowExecutionHistoryScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	execution := request.GetRequest().GetExecution()
+	workflowID := execution.GetWorkflowId()
+	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	if err != nil {
+		return h.error(err, scope, namespaceID, workflowID)
+	}
+
+	err = engine.StreamWorkflowExecutionHistory(server.Context(), request, server.Send)
+
+	if err != nil {
+		return h.error(err, scope, namespaceID, workflowID)
+	}
+
+	return nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
@@ -44,6 +44,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	sdkclient "go.temporal.io/sdk/client"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -57,12 +58,14 @@ import (
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/messaging"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/service/config"
@@ -118,6 +121,14 @@ type (
 		PurgeDLQMessages(ctx context.Context, messagesRequest *historyservice.PurgeDLQMessagesRequest) error
 		MergeDLQMessages(ctx context.Context, messagesRequest *historyservice.MergeDLQMessagesRequest) (*historyservice.MergeDLQMessagesResponse, error)
 		RefreshWorkflowTasks(ctx context.Context, namespaceUUID string, execution commonpb.WorkflowExecution) error
+		RestoreWorkflowExecution(ctx context.Context, namespaceUUID string, execution commonpb.WorkflowExecution) error
+		PauseWorkflowExecution(ctx context.Context, request *historyservice.PauseWorkflowExecutionRequest) error
+		UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) error
+		PauseActivity(ctx context.Context, request *historyservice.PauseActivityRequest) error
+		UnpauseActivity(ctx context.Context, request *historyservice.UnpauseActivityRequest) error
+		ResetActivity(ctx context.Context, request *historyservice.ResetActivityRequest) error
+		UpdateActivityOptions(ctx context.Context, request *historyservice.UpdateActivityOptionsRequest) error
+		StreamWorkflowExecutionHistory(ctx context.Context, request *historyservice.StreamWorkflowExecutionHistoryRequest, send func(*historyservice.StreamWorkflowExecutionHistoryResponse) error) error
 
 		NotifyNewHistoryEvent(event *historyEventNotification)
 		NotifyNewTransferTasks(tasks []persistence.Task)
@@ -148,6 +159,7 @@ type (
 		config                    *Config
 		archivalClient            archiver.Client
 		workflowResetter          workflowResetter
+		workflowRestorer          workflowRestorer
 		queueTaskProcessor        queueTaskProcessor
 		replicationTaskProcessors []ReplicationTaskProcessor
 		publicClient              sdkclient.Client
@@ -179,6 +191,10 @@ var (
 	ErrWorkflowCompleted = serviceerror.NewNotFound("workflow execution already completed")
 	// ErrWorkflowExecutionNotFound is the error to indicate workflow execution does not exist
 	ErrWorkflowExecutionNotFound = serviceerror.NewNotFound("workflow execution not found")
+	// ErrWorkflowPaused is the error to indicate tasks are not dispatched as workflow execution is paused
+	ErrWorkflowPaused = serviceerrors.NewFailedPrecondition("workflow execution is paused")
+	// ErrActivityPaused is the error to indicate activity tasks are not dispatched as activity is paused
+	ErrActivityPaused = serviceerrors.NewFailedPrecondition("activity is paused")
 	// ErrWorkflowParent is the error to parent execution is given and mismatch
 	ErrWorkflowParent = serviceerror.NewNotFound("workflow parent does not match")
 	// ErrDeserializingToken is the error to indicate task token is invalid
@@ -288,6 +304,11 @@ func NewEngineWithShardContext(
 		historyCache,
 		logger,
 	)
+	historyEngImpl.workflowRestorer = newWorkflowRestorer(
+		shard,
+		historyCache,
+		logger,
+	)
 	historyEngImpl.workflowTaskHandler = newWorkflowTaskHandlerCallback(historyEngImpl)
 
 	var replicationTaskProcessors []ReplicationTaskProcessor
@@ -603,12 +624,27 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	if err != nil {
 		if t, ok := err.(*persistence.WorkflowExecutionAlreadyStartedError); ok {
 			if t.StartRequestID == request.GetRequestId() {
+				// the run may have been started by a call which failed to record the request
+				if err := e.createStartRequestID(namespaceEntry, workflowID, request.GetRequestId(), t.RunID); err != nil {
+					return nil, err
+				}
 				return &historyservice.StartWorkflowExecutionResponse{
 					RunId: t.RunID,
 				}, nil
 				// delete history is expected here because duplicate start request will create history with different rid
 			}
 
+			// the request may have started a run which is no longer the current run
+			startedRunID, lookupErr := e.getStartRequestRunID(namespaceEntry, workflowID, request.GetRequestId())
+			if lookupErr != nil {
+				return nil, lookupErr
+			}
+			if startedRunID != "" {
+				return &historyservice.StartWorkflowExecutionResponse{
+					RunId: startedRunID,
+				}, nil
+			}
+
 			if mutableState.GetCurrentVersion() < t.LastWriteVersion {
 				return nil, serviceerror.NewNamespaceNotActive(
 					request.GetNamespace(),
@@ -642,6 +678,9 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	if err := e.createStartRequestID(namespaceEntry, workflowID, request.GetRequestId(), execution.GetRunId()); err != nil {
+		return nil, err
+	}
 	return &historyservice.StartWorkflowExecutionResponse{
 		RunId: execution.GetRunId(),
 	}, nil
@@ -790,31 +829,225 @@ func (e *historyEngineImpl) getMutableStateOrPolling(
 	return response, nil
 }
 
+// StreamWorkflowExecutionHistory sends the history events of a workflow execution as they are committed, it returns
+// once all the events of the closed workflow execution have been sent. Events are read one page at a time and the next
+// page is read only after the previous one is sent, so a slow receiver holds back the reads instead of piling up events.
+func (e *historyEngineImpl) StreamWorkflowExecutionHistory(
+	ctx context.Context,
+	request *historyservice.StreamWorkflowExecutionHistoryRequest,
+	send func(*historyservice.StreamWorkflowExecutionHistoryResponse) error,
+) error {
+
+	namespaceID, err := validateNamespaceUUID(request.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceEntry, err := e.shard.GetNamespaceCache().GetNamespaceByID(namespaceID)
+	if err != nil {
+		return err
+	}
+	req := request.GetRequest()
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: req.GetExecution().GetWorkflowId(),
+		RunId:      req.GetExecution().GetRunId(),
+	}
+	response, err := e.getMutableState(ctx, namespaceID, execution)
+	if err != nil {
+		return e.updateEntityNotExistsErrorOnPassiveCluster(err, namespaceID)
+	}
+	// stick to the run which is current now, the stream ends when it is closed or continued as new
+	execution.RunId = response.Execution.RunId
+	branchToken := response.CurrentBranchToken
+	identifier := definition.NewWorkflowIdentifier(namespaceID, execution.GetWorkflowId(), execution.GetRunId())
+
+	// watch before reading the events, so events committed while the already committed ones are sent are not missed
+	subscriberID, channel, err := e.historyEventNotifier.WatchHistoryEvent(identifier)
+	if err != nil {
+		return err
+	}
+	defer e.historyEventNotifier.UnwatchHistoryEvent(identifier, subscriberID) // nolint:errcheck
+
+	eventTypes := make(map[enumspb.EventType]struct{}, len(req.GetEventTypes()))
+	for _, eventType := range req.GetEventTypes() {
+		eventTypes[eventType] = struct{}{}
+	}
+	pageSize := int(req.GetMaximumPageSize())
+	if pageSize <= 0 {
+		pageSize = common.GetHistoryMaxPageSize
+	}
+	nextEventID := req.GetFirstEventId()
+	if nextEventID < common.FirstEventID {
+		nextEventID = common.FirstEventID
+	}
+
+	timer := time.NewTimer(e.shard.GetConfig().LongPollExpirationInterval(namespaceEntry.GetInfo().Name))
+	defer timer.Stop()
+	for {
+		for nextEventID < response.GetNextEventId() {
+			events, err := e.readHistoryForStream(namespaceID, execution, branchToken, nextEventID, response.GetNextEventId(), pageSize)
+			if err != nil {
+				return err
+			}
+			nextEventID = events[len(events)-1].GetEventId() + 1
+
+			if len(eventTypes) > 0 {
+				filtered := make([]*historypb.HistoryEvent, 0, len(events))
+				for _, event := range events {
+					if _, ok := eventTypes[event.GetEventType()]; ok {
+						filtered = append(filtered, event)
+					}
+				}
+				events = filtered
+			}
+			if len(events) == 0 {
+				continue
+			}
+			if err := send(&historyservice.StreamWorkflowExecutionHistoryResponse{
+				Response: &adminservice.StreamWorkflowExecutionHistoryResponse{
+					History:     &historypb.History{Events: events},
+					NextEventId: nextEventID,
+				},
+			}); err != nil {
+				return err
+			}
+		}
+		if response.GetWorkflowStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			return nil
+		}
+
+		// notifications are dropped when the channel is full, so they only tell that something changed
+		// and the mutable state is reloaded to find out what, the timer covers any notification lost upstream
+		select {
+		case <-channel:
+		case <-timer.C:
+			timer.Reset(e.shard.GetConfig().LongPollExpirationInterval(namespaceEntry.GetInfo().Name))
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		response, err = e.getMutableState(ctx, namespaceID, execution)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(branchToken, response.CurrentBranchToken) {
+			return serviceerrors.NewCurrentBranchChanged(response.CurrentBranchToken, branchToken)
+		}
+	}
+}
+
+// readHistoryForStream returns up to a page of the events in [firstEventID, nextEventID). The page ends at a batch
+// boundary, so that a stream resumed from the next event ID of a response starts at the first event of a batch.
+func (e *historyEngineImpl) readHistoryForStream(
+	namespaceID string,
+	execution commonpb.WorkflowExecution,
+	branchToken []byte,
+	firstEventID int64,
+	nextEventID int64,
+	pageSize int,
+) ([]*historypb.HistoryEvent, error) {
+
+	if nextEventID-firstEventID <= int64(pageSize) {
+		// the last committed event ends a batch, the events are served from the events cache if they are all there
+		eventsCache := e.shard.GetEventsCache()
+		cachedEvents := make([]*historypb.HistoryEvent, 0, nextEventID-firstEventID)
+		for eventID := firstEventID; eventID < nextEventID; eventID++ {
+			event, ok := eventsCache.peekEvent(namespaceID, execution.GetWorkflowId(), execution.GetRunId(), eventID)
+			if !ok {
+				break
+			}
+			cachedEvents = append(cachedEvents, event)
+		}
+		if int64(len(cachedEvents)) == nextEventID-firstEventID {
+			return cachedEvents, nil
+		}
+	}
+
+	// batches are read as a whole, the last one may go past the page size
+	maxEventID := nextEventID
+	if maxEventID-firstEventID > int64(pageSize) {
+		maxEventID = firstEventID + int64(pageSize)
+	}
+	events, _, err := e.readHistoryBranchForStream(branchToken, firstEventID, maxEventID, pageSize, nil)
+	if err == nil && len(events) > 0 && events[0].GetEventId() == firstEventID {
+		return events, nil
+	}
+	if _, ok := err.(*serviceerror.NotFound); err != nil && !ok {
+		return nil, err
+	}
+	if firstEventID == common.FirstEventID {
+		return nil, serviceerror.NewInternal("workflow execution history is missing events")
+	}
+
+	// batches are stored under their first event ID, the first event to send is in the middle of a batch when the
+	// stream starts from an arbitrary event. The history is paged from its start until the batch holding it.
+	var pageToken []byte
+	for {
+		events, pageToken, err = e.readHistoryBranchForStream(branchToken, common.FirstEventID, nextEventID, pageSize, pageToken)
+		if err != nil {
+			return nil, err
+		}
+		for i, event := range events {
+			if event.GetEventId() == firstEventID {
+				return events[i:], nil
+			}
+		}
+		if len(pageToken) == 0 {
+			return nil, serviceerror.NewInternal("workflow execution history is missing events")
+		}
+	}
+}
+
+func (e *historyEngineImpl) readHistoryBranchForStream(
+	branchToken []byte,
+	firstEventID int64,
+	nextEventID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*historypb.HistoryEvent, []byte, error) {
+
+	events, _, pageToken, err := persistence.ReadFullPageV2Events(e.historyV2Mgr, &persistence.ReadHistoryBranchRequest{
+		BranchToken:   branchToken,
+		MinEventID:    firstEventID,
+		MaxEventID:    nextEventID,
+		PageSize:      pageSize,
+		NextPageToken: pageToken,
+		ShardID:       convert.IntPtr(e.shard.GetShardID()),
+	})
+	return events, pageToken, err
+}
+
 func (e *historyEngineImpl) QueryWorkflow(
 	ctx context.Context,
 	request *historyservice.QueryWorkflowRequest,
 ) (retResp *historyservice.QueryWorkflowResponse, retErr error) {
 
-	scope := e.metricsClient.Scope(metrics.HistoryQueryWorkflowScope)
+	req := request.GetRequest()
+	scope := e.metricsClient.Scope(metrics.HistoryQueryWorkflowScope).Tagged(
+		metrics.NamespaceTag(req.GetNamespace()),
+		metrics.QueryTypeTag(req.GetQuery().GetQueryType()),
+	)
+	defer func() {
+		switch {
+		case retErr == nil && retResp.GetResponse().GetQueryRejected() != nil:
+			scope.IncCounter(metrics.QueryRejectedCount)
+		case retErr == nil:
+			scope.IncCounter(metrics.QueryAnsweredCount)
+		default:
+			if _, ok := retErr.(*serviceerror.QueryFailed); ok {
+				scope.IncCounter(metrics.QueryFailedCount)
+			}
+		}
+	}()
 
-	mutableStateResp, err := e.getMutableState(ctx, request.GetNamespaceId(), *request.GetRequest().GetExecution())
+	mutableStateResp, err := e.getMutableState(ctx, request.GetNamespaceId(), *req.GetExecution())
 	if err != nil {
 		return nil, err
 	}
-	req := request.GetRequest()
-	if mutableStateResp.GetWorkflowStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING && req.QueryRejectCondition != enumspb.QUERY_REJECT_CONDITION_NONE {
-		notOpenReject := req.GetQueryRejectCondition() == enumspb.QUERY_REJECT_CONDITION_NOT_OPEN
-		status := mutableStateResp.GetWorkflowStatus()
-		notCompletedCleanlyReject := req.GetQueryRejectCondition() == enumspb.QUERY_REJECT_CONDITION_NOT_COMPLETED_CLEANLY && status != enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
-		if notOpenReject || notCompletedCleanlyReject {
-			return &historyservice.QueryWorkflowResponse{
-				Response: &workflowservice.QueryWorkflowResponse{
-					QueryRejected: &querypb.QueryRejected{
-						Status: status,
-					},
-				},
-			}, nil
-		}
+	if rejected := queryRejected(mutableStateResp.GetWorkflowStatus(), req.GetQueryRejectCondition()); rejected != nil {
+		return &historyservice.QueryWorkflowResponse{
+			Response: &workflowservice.QueryWorkflowResponse{
+				QueryRejected: rejected,
+			},
+		}, nil
 	}
 
 	de, err := e.shard.GetNamespaceCache().GetNamespaceByID(request.GetNamespaceId())
@@ -842,17 +1075,16 @@ func (e *historyEngineImpl) QueryWorkflow(
 	// 1. the namespace is not active, in this case history is immutable so a query dispatched at any time is consistent
 	// 2. the workflow is not running, whenever a workflow is not running dispatching query directly is consistent
 	// 3. if there is no pending or started workflow tasks it means no events came before query arrived, so its safe to dispatch directly
-	safeToDispatchDirectly := !de.IsNamespaceActive() ||
+	//
+	// Callers asking for eventual consistency accept an answer which does not reflect the outstanding events, so their queries
+	// are always dispatched directly.
+	safeToDispatchDirectly := request.GetQueryConsistencyLevel() == enumsspb.QUERY_CONSISTENCY_LEVEL_EVENTUAL ||
+		!de.IsNamespaceActive() ||
 		!mutableState.IsWorkflowExecutionRunning() ||
 		(!mutableState.HasPendingWorkflowTask() && !mutableState.HasInFlightWorkflowTask())
 	if safeToDispatchDirectly {
 		release(nil)
-		msResp, err := e.getMutableState(ctx, request.GetNamespaceId(), *request.GetRequest().GetExecution())
-		if err != nil {
-			return nil, err
-		}
-		req.Execution.RunId = msResp.Execution.RunId
-		return e.queryDirectlyThroughMatching(ctx, msResp, request.GetNamespaceId(), req, scope)
+		return e.queryDirectlyThroughMatchingAfterReload(ctx, request.GetNamespaceId(), req, scope)
 	}
 
 	// If we get here it means query could not be dispatched through matching directly, so it must block
@@ -891,12 +1123,7 @@ func (e *historyEngineImpl) QueryWorkflow(
 				return nil, ErrQueryEnteredInvalidState
 			}
 		case queryTerminationTypeUnblocked:
-			msResp, err := e.getMutableState(ctx, request.GetNamespaceId(), *request.GetRequest().GetExecution())
-			if err != nil {
-				return nil, err
-			}
-			req.Execution.RunId = msResp.Execution.RunId
-			return e.queryDirectlyThroughMatching(ctx, msResp, request.GetNamespaceId(), req, scope)
+			return e.queryDirectlyThroughMatchingAfterReload(ctx, request.GetNamespaceId(), req, scope)
 		case queryTerminationTypeFailed:
 			return nil, state.failure
 		default:
@@ -909,6 +1136,54 @@ func (e *historyEngineImpl) QueryWorkflow(
 	}
 }
 
+// queryDirectlyThroughMatchingAfterReload reloads the mutable state, since the workflow might have been closed
+// or continued as new while the query was waiting, and checks the reject condition again before dispatching.
+func (e *historyEngineImpl) queryDirectlyThroughMatchingAfterReload(
+	ctx context.Context,
+	namespaceID string,
+	queryRequest *workflowservice.QueryWorkflowRequest,
+	scope metrics.Scope,
+) (*historyservice.QueryWorkflowResponse, error) {
+
+	msResp, err := e.getMutableState(ctx, namespaceID, *queryRequest.GetExecution())
+	if err != nil {
+		return nil, err
+	}
+	if rejected := queryRejected(msResp.GetWorkflowStatus(), queryRequest.GetQueryRejectCondition()); rejected != nil {
+		return &historyservice.QueryWorkflowResponse{
+			Response: &workflowservice.QueryWorkflowResponse{
+				QueryRejected: rejected,
+			},
+		}, nil
+	}
+	queryRequest.Execution.RunId = msResp.Execution.RunId
+	return e.queryDirectlyThroughMatching(ctx, msResp, namespaceID, queryRequest, scope)
+}
+
+// queryRejected returns the rejection of a query against a workflow in the given status, or nil if the query
+// is not rejected by the condition.
+func queryRejected(
+	status enumspb.WorkflowExecutionStatus,
+	condition enumspb.QueryRejectCondition,
+) *querypb.QueryRejected {
+
+	switch condition {
+	case enumspb.QUERY_REJECT_CONDITION_NOT_OPEN:
+		if status == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			return nil
+		}
+	case enumspb.QUERY_REJECT_CONDITION_NOT_COMPLETED_CLEANLY:
+		if status == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING || status == enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED {
+			return nil
+		}
+	default:
+		return nil
+	}
+	return &querypb.QueryRejected{
+		Status: status,
+	}
+}
+
 func (e *historyEngineImpl) queryDirectlyThroughMatching(
 	ctx context.Context,
 	msResp *historyservice.GetMutableStateResponse,
@@ -1063,6 +1338,7 @@ func (e *historyEngineImpl) getMutableState(
 		WorkflowState:                         workflowState,
 		WorkflowStatus:                        workflowStatus,
 		IsStickyTaskQueueEnabled:              mutableState.IsStickyTaskQueueEnabled(),
+		DeadlineRules:                         &workflowspb.DeadlineRules{Rules: executionInfo.DeadlineRules},
 	}
 	versionHistories := mutableState.GetVersionHistories()
 	if versionHistories != nil {
@@ -1163,6 +1439,40 @@ func (e *historyEngineImpl) ResetStickyTaskQueue(
 	return &historyservice.ResetStickyTaskQueueResponse{}, nil
 }
 
+// getHistorySizeSearchAttributes returns a copy of the search attributes of a running workflow, with the current
+// history size, event count and continue-as-new suggestion which are only refreshed on workflow task completion
+func (e *historyEngineImpl) getHistorySizeSearchAttributes(
+	namespaceID string,
+	context workflowExecutionContext,
+	mutableState mutableState,
+) (map[string]*commonpb.Payload, error) {
+
+	namespace, err := e.shard.GetNamespaceCache().GetNamespaceName(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	historySize := context.getHistorySize()
+	historyCount := mutableState.GetNextEventID() - 1
+	attributes := map[string]interface{}{
+		definition.HistorySizeBytes:       historySize,
+		definition.HistoryEventCount:      historyCount,
+		definition.ContinueAsNewSuggested: suggestContinueAsNew(e.config, namespace, historySize, historyCount),
+	}
+
+	searchAttributes := make(map[string]*commonpb.Payload, len(mutableState.GetExecutionInfo().SearchAttributes)+len(attributes))
+	for key, value := range mutableState.GetExecutionInfo().SearchAttributes {
+		searchAttributes[key] = value
+	}
+	for key, value := range attributes {
+		p, err := payload.Encode(value)
+		if err != nil {
+			return nil, err
+		}
+		searchAttributes[key] = p
+	}
+	return searchAttributes, nil
+}
+
 // DescribeWorkflowExecution returns information about the specified workflow execution.
 func (e *historyEngineImpl) DescribeWorkflowExecution(
 	ctx context.Context,
@@ -1211,6 +1521,13 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			Status:           executionInfo.ExecutionState.Status,
 		},
 	}
+	if mutableState.IsWorkflowExecutionRunning() {
+		searchAttributes, err := e.getHistorySizeSearchAttributes(namespaceID, context, mutableState)
+		if err != nil {
+			return nil, err
+		}
+		result.WorkflowExecutionInfo.SearchAttributes = &commonpb.SearchAttributes{IndexedFields: searchAttributes}
+	}
 
 	// TODO: we need to consider adding execution time to mutable state
 	// For now execution time will be calculated based on start time and cron schedule/retry policy
@@ -1327,6 +1644,9 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return ErrWorkflowCompleted
 			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				return ErrWorkflowPaused
+			}
 
 			scheduleID := request.GetScheduleId()
 			requestID := request.GetRequestId()
@@ -1369,6 +1689,10 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return serviceerrors.NewTaskAlreadyStarted("Activity")
 			}
 
+			if ai.Paused {
+				return ErrActivityPaused
+			}
+
 			if _, err := mutableState.AddActivityTaskStartedEvent(
 				ai, scheduleID, requestID, request.PollRequest.GetIdentity(),
 			); err != nil {
@@ -1472,10 +1796,22 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 				return ErrStaleState
 			}
 
-			if !isRunning || ai.StartedId == common.EmptyEventID ||
-				(token.GetScheduleId() != common.EmptyEventID && token.ScheduleAttempt != ai.Attempt) {
+			if !isRunning || (token.GetScheduleId() != common.EmptyEventID &&
+				(ai.StartedId == common.EmptyEventID || token.ScheduleAttempt != ai.Attempt)) {
 				return ErrActivityTaskNotFound
 			}
+			if ai.StartedId == common.EmptyEventID && !e.config.EnableCompleteUnstartedActivityByID(namespace) {
+				return ErrActivityTaskNotFound
+			}
+
+			if ai.StartedId == common.EmptyEventID {
+				// activity completed by ID, e.g. by an operator, before an attempt is picked up by a worker
+				if _, err := mutableState.AddActivityTaskStartedEvent(
+					ai, scheduleID, uuid.New(), request.GetIdentity(),
+				); err != nil {
+					return err
+				}
+			}
 
 			if _, err := mutableState.AddActivityTaskCompletedEvent(scheduleID, ai.StartedId, request); err != nil {
 				// Unable to add ActivityTaskCompleted event to history
@@ -1546,10 +1882,22 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 				return nil, ErrStaleState
 			}
 
-			if !isRunning || ai.StartedId == common.EmptyEventID ||
-				(token.GetScheduleId() != common.EmptyEventID && token.ScheduleAttempt != ai.Attempt) {
+			if !isRunning || (token.GetScheduleId() != common.EmptyEventID &&
+				(ai.StartedId == common.EmptyEventID || token.ScheduleAttempt != ai.Attempt)) {
 				return nil, ErrActivityTaskNotFound
 			}
+			if ai.StartedId == common.EmptyEventID && !e.config.EnableCompleteUnstartedActivityByID(namespace) {
+				return nil, ErrActivityTaskNotFound
+			}
+
+			if ai.StartedId == common.EmptyEventID {
+				// activity failed by ID, e.g. by an operator, before an attempt is picked up by a worker
+				if _, err := mutableState.AddActivityTaskStartedEvent(
+					ai, scheduleID, uuid.New(), request.GetIdentity(),
+				); err != nil {
+					return nil, err
+				}
+			}
 
 			postActions := &updateWorkflowAction{}
 			failure := request.GetFailure()
@@ -1967,6 +2315,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 
 	// Start workflow and signal
 	startRequest := e.getStartRequest(namespaceID, sRequest)
+	startRequest.DeadlineRules = signalWithStartRequest.GetDeadlineRules()
 	request := startRequest.StartRequest
 	err = validateStartWorkflowExecutionRequest(request, e.config.MaxIDLengthLimit())
 	if err != nil {
@@ -1998,6 +2347,23 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	}
 
 	if prevMutableState != nil {
+		// the request may have started the previous run, or a run before that
+		prevExecutionInfo := prevMutableState.GetExecutionInfo()
+		if prevExecutionInfo.GetExecutionState().GetCreateRequestId() == request.GetRequestId() {
+			return &historyservice.SignalWithStartWorkflowExecutionResponse{
+				RunId: prevExecutionInfo.GetRunId(),
+			}, nil
+		}
+		startedRunID, err := e.getStartRequestRunID(namespaceEntry, workflowID, request.GetRequestId())
+		if err != nil {
+			return nil, err
+		}
+		if startedRunID != "" {
+			return &historyservice.SignalWithStartWorkflowExecutionResponse{
+				RunId: startedRunID,
+			}, nil
+		}
+
 		prevLastWriteVersion, err := prevMutableState.GetLastWriteVersion()
 		if err != nil {
 			return nil, err
@@ -2074,6 +2440,10 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 
 	if t, ok := err.(*persistence.WorkflowExecutionAlreadyStartedError); ok {
 		if t.StartRequestID == request.GetRequestId() {
+			// the run may have been started by a call which failed to record the request
+			if err := e.createStartRequestID(namespaceEntry, workflowID, request.GetRequestId(), t.RunID); err != nil {
+				return nil, err
+			}
 			return &historyservice.SignalWithStartWorkflowExecutionResponse{
 				RunId: t.RunID,
 			}, nil
@@ -2085,6 +2455,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	if err := e.createStartRequestID(namespaceEntry, workflowID, request.GetRequestId(), execution.GetRunId()); err != nil {
+		return nil, err
+	}
 	return &historyservice.SignalWithStartWorkflowExecutionResponse{
 		RunId: execution.RunId,
 	}, nil
@@ -2823,6 +3196,56 @@ func (e *historyEngineImpl) applyWorkflowIDReusePolicyHelper(
 	return nil
 }
 
+// getStartRequestRunID returns the run started by the start request, if the request is recorded in the
+// start request ID index of the namespace
+func (e *historyEngineImpl) getStartRequestRunID(
+	namespaceEntry *cache.NamespaceCacheEntry,
+	workflowID string,
+	requestID string,
+) (string, error) {
+
+	if requestID == "" || e.config.StartRequestIDTTL(namespaceEntry.GetInfo().Name) <= 0 {
+		return "", nil
+	}
+
+	resp, err := e.executionManager.GetStartRequestID(&persistence.GetStartRequestIDRequest{
+		NamespaceID: namespaceEntry.GetInfo().Id,
+		WorkflowID:  workflowID,
+		RequestID:   requestID,
+	})
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return "", nil
+		}
+		return "", err
+	}
+	return resp.RunID, nil
+}
+
+// createStartRequestID records the run started by the start request in the start request ID index of the namespace.
+// The index lives outside of the execution partition, so a failure fails the start call and the retry of the
+// request, which is deduplicated against the current run, records it again.
+func (e *historyEngineImpl) createStartRequestID(
+	namespaceEntry *cache.NamespaceCacheEntry,
+	workflowID string,
+	requestID string,
+	runID string,
+) error {
+
+	ttl := e.config.StartRequestIDTTL(namespaceEntry.GetInfo().Name)
+	if requestID == "" || ttl <= 0 {
+		return nil
+	}
+
+	return e.executionManager.CreateStartRequestID(&persistence.CreateStartRequestIDRequest{
+		NamespaceID: namespaceEntry.GetInfo().Id,
+		WorkflowID:  workflowID,
+		RequestID:   requestID,
+		RunID:       runID,
+		TTL:         ttl,
+	})
+}
+
 func getWorkflowAlreadyStartedError(errMsg string, createRequestID string, workflowID string, runID string) error {
 	return serviceerror.NewWorkflowExecutionAlreadyStarted(
 		fmt.Sprintf(errMsg, workflowID, runID),
@@ -3118,6 +3541,253 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+func (e *historyEngineImpl) RestoreWorkflowExecution(
+	ctx context.Context,
+	namespaceUUID string,
+	execution commonpb.WorkflowExecution,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(namespaceUUID)
+	if err != nil {
+		return err
+	}
+
+	return e.workflowRestorer.restoreWorkflow(ctx, namespaceEntry, execution)
+}
+
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(request.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+
+	pauseRequest := request.GetRequest()
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: pauseRequest.GetExecution().GetWorkflowId(),
+		RunId:      pauseRequest.GetExecution().GetRunId(),
+	}
+
+	return e.updateWorkflow(ctx, namespaceID, execution,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			if _, err := mutableState.AddWorkflowExecutionPausedEvent(
+				true,
+				pauseRequest.GetIdentity(),
+				pauseRequest.GetReason(),
+			); err != nil {
+				return nil, err
+			}
+			e.logger.Info("Workflow execution paused.",
+				tag.WorkflowNamespaceID(namespaceID),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(mutableState.GetExecutionInfo().GetRunId()),
+				tag.WorkflowOperationReason(pauseRequest.GetReason()),
+				tag.WorkflowOperationIdentity(pauseRequest.GetIdentity()))
+			return updateWorkflowWithoutWorkflowTask, nil
+		})
+}
+
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(request.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+
+	unpauseRequest := request.GetRequest()
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: unpauseRequest.GetExecution().GetWorkflowId(),
+		RunId:      unpauseRequest.GetExecution().GetRunId(),
+	}
+
+	return e.updateWorkflow(ctx, namespaceID, execution,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if !mutableState.IsWorkflowExecutionPaused() {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			if _, err := mutableState.AddWorkflowExecutionPausedEvent(
+				false,
+				unpauseRequest.GetIdentity(),
+				unpauseRequest.GetReason(),
+			); err != nil {
+				return nil, err
+			}
+			// the workflow and activity tasks dropped and the user timers held while paused are dispatched again
+			now := e.shard.GetTimeSource().Now()
+			mutableStateTaskRefresher := newMutableStateTaskRefresher(
+				e.shard.GetConfig(),
+				e.shard.GetNamespaceCache(),
+				e.shard.GetEventsCache(),
+				e.shard.GetLogger(),
+			)
+			if err := mutableStateTaskRefresher.refreshTasks(now, mutableState); err != nil {
+				return nil, err
+			}
+			e.logger.Info("Workflow execution unpaused.",
+				tag.WorkflowNamespaceID(namespaceID),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(mutableState.GetExecutionInfo().GetRunId()),
+				tag.WorkflowOperationReason(unpauseRequest.GetReason()),
+				tag.WorkflowOperationIdentity(unpauseRequest.GetIdentity()))
+			// the workflow gets a workflow task to handle the signals recorded by pause and unpause
+			return updateWorkflowWithNewWorkflowTask, nil
+		})
+}
+
+func (e *historyEngineImpl) PauseActivity(
+	ctx context.Context,
+	request *historyservice.PauseActivityRequest,
+) error {
+
+	pauseRequest := request.GetRequest()
+	return e.updatePendingActivity(ctx, request.GetNamespaceId(), pauseRequest.GetExecution(), pauseRequest.GetActivityId(),
+		func(mutableState mutableState, ai *persistenceblobs.ActivityInfo) (*updateWorkflowAction, error) {
+			if ai.Paused {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			if err := mutableState.SetActivityPaused(ai, true); err != nil {
+				return nil, err
+			}
+			e.logger.Info("Activity paused.",
+				tag.WorkflowNamespaceID(request.GetNamespaceId()),
+				tag.WorkflowID(pauseRequest.GetExecution().GetWorkflowId()),
+				tag.WorkflowRunID(mutableState.GetExecutionInfo().GetRunId()),
+				tag.WorkflowActivityID(ai.ActivityId),
+				tag.WorkflowOperationReason(pauseRequest.GetReason()),
+				tag.WorkflowOperationIdentity(pauseRequest.GetIdentity()))
+			return updateWorkflowWithoutWorkflowTask, nil
+		})
+}
+
+func (e *historyEngineImpl) UnpauseActivity(
+	ctx context.Context,
+	request *historyservice.UnpauseActivityRequest,
+) error {
+
+	unpauseRequest := request.GetRequest()
+	return e.updatePendingActivity(ctx, request.GetNamespaceId(), unpauseRequest.GetExecution(), unpauseRequest.GetActivityId(),
+		func(mutableState mutableState, ai *persistenceblobs.ActivityInfo) (*updateWorkflowAction, error) {
+			if !ai.Paused {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			if err := mutableState.SetActivityPaused(ai, false); err != nil {
+				return nil, err
+			}
+			e.logger.Info("Activity unpaused.",
+				tag.WorkflowNamespaceID(request.GetNamespaceId()),
+				tag.WorkflowID(unpauseRequest.GetExecution().GetWorkflowId()),
+				tag.WorkflowRunID(mutableState.GetExecutionInfo().GetRunId()),
+				tag.WorkflowActivityID(ai.ActivityId),
+				tag.WorkflowOperationReason(unpauseRequest.GetReason()),
+				tag.WorkflowOperationIdentity(unpauseRequest.GetIdentity()))
+			return updateWorkflowWithoutWorkflowTask, nil
+		})
+}
+
+func (e *historyEngineImpl) ResetActivity(
+	ctx context.Context,
+	request *historyservice.ResetActivityRequest,
+) error {
+
+	resetRequest := request.GetRequest()
+	return e.updatePendingActivity(ctx, request.GetNamespaceId(), resetRequest.GetExecution(), resetRequest.GetActivityId(),
+		func(mutableState mutableState, ai *persistenceblobs.ActivityInfo) (*updateWorkflowAction, error) {
+			if err := mutableState.ResetActivityAttempt(ai, resetRequest.GetResetHeartbeatDetails()); err != nil {
+				return nil, err
+			}
+			e.logger.Info("Activity attempt reset.",
+				tag.WorkflowNamespaceID(request.GetNamespaceId()),
+				tag.WorkflowID(resetRequest.GetExecution().GetWorkflowId()),
+				tag.WorkflowRunID(mutableState.GetExecutionInfo().GetRunId()),
+				tag.WorkflowActivityID(ai.ActivityId),
+				tag.WorkflowOperationIdentity(resetRequest.GetIdentity()))
+			return updateWorkflowWithoutWorkflowTask, nil
+		})
+}
+
+func (e *historyEngineImpl) UpdateActivityOptions(
+	ctx context.Context,
+	request *historyservice.UpdateActivityOptionsRequest,
+) error {
+
+	updateRequest := request.GetRequest()
+	return e.updatePendingActivity(ctx, request.GetNamespaceId(), updateRequest.GetExecution(), updateRequest.GetActivityId(),
+		func(mutableState mutableState, ai *persistenceblobs.ActivityInfo) (*updateWorkflowAction, error) {
+			if retryPolicy := updateRequest.GetRetryPolicy(); retryPolicy != nil {
+				namespace := mutableState.GetNamespaceEntry().GetInfo().Name
+				defaultActivityRetrySettings := common.FromConfigToDefaultRetrySettings(e.config.DefaultActivityRetryPolicy(namespace))
+				common.EnsureRetryPolicyDefaults(retryPolicy, defaultActivityRetrySettings)
+				if err := common.ValidateRetryPolicy(retryPolicy); err != nil {
+					return nil, err
+				}
+			}
+
+			if err := mutableState.UpdateActivityOptions(ai, updateRequest); err != nil {
+				return nil, err
+			}
+			e.logger.Info("Activity options updated.",
+				tag.WorkflowNamespaceID(request.GetNamespaceId()),
+				tag.WorkflowID(updateRequest.GetExecution().GetWorkflowId()),
+				tag.WorkflowRunID(mutableState.GetExecutionInfo().GetRunId()),
+				tag.WorkflowActivityID(ai.ActivityId),
+				tag.WorkflowOperationIdentity(updateRequest.GetIdentity()))
+			return updateWorkflowWithoutWorkflowTask, nil
+		})
+}
+
+// updatePendingActivity applies an operator action to a pending activity of a running workflow execution
+func (e *historyEngineImpl) updatePendingActivity(
+	ctx context.Context,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+	activityID string,
+	action func(mutableState mutableState, ai *persistenceblobs.ActivityInfo) (*updateWorkflowAction, error),
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(namespaceID)
+	if err != nil {
+		return err
+	}
+
+	return e.updateWorkflow(ctx, namespaceEntry.GetInfo().Id,
+		commonpb.WorkflowExecution{
+			WorkflowId: execution.GetWorkflowId(),
+			RunId:      execution.GetRunId(),
+		},
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			ai, ok := mutableState.GetActivityByActivityID(activityID)
+			if !ok {
+				return nil, ErrActivityTaskNotFound
+			}
+			return action(mutableState, ai)
+		})
+}
+
 func (e *historyEngineImpl) loadWorkflowOnce(
 	ctx context.Context,
 	namespaceID string,
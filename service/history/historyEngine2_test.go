@@ -39,11 +39,13 @@ import (
 	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	querypb "go.temporal.io/api/query/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
@@ -237,6 +239,48 @@ func (s *engine2Suite) TestRecordWorkflowTaskStartedSuccessStickyExpired() {
 	s.Equal(&expectedResponse, response)
 }
 
+func (s *engine2Suite) TestRecordWorkflowTaskStartedSuggestContinueAsNew() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	tl := "testTaskQueue"
+	identity := "testIdentity"
+	historyCountSuggestContinueAsNew := s.config.HistoryCountSuggestContinueAsNew
+	s.config.HistoryCountSuggestContinueAsNew = func(namespace string) int { return 2 }
+	defer func() { s.config.HistoryCountSuggestContinueAsNew = historyCountSuggestContinueAsNew }()
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.historyEngine.shard, s.mockEventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionStats = &persistenceblobs.ExecutionStats{HistorySize: 1024}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&p.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+
+	response, err := s.historyEngine.RecordWorkflowTaskStarted(context.Background(), &historyservice.RecordWorkflowTaskStartedRequest{
+		NamespaceId:       testNamespaceID,
+		WorkflowExecution: &we,
+		ScheduleId:        di.ScheduleID,
+		TaskId:            100,
+		RequestId:         "reqId",
+		PollRequest: &workflowservice.PollWorkflowTaskQueueRequest{
+			TaskQueue: &taskqueuepb.TaskQueue{
+				Name: tl,
+			},
+			Identity: identity,
+		},
+	})
+	s.NoError(err)
+	s.Equal(int64(1024), response.GetHistorySizeBytes())
+	s.True(response.GetSuggestContinueAsNew())
+}
+
 func (s *engine2Suite) TestRecordWorkflowTaskStartedSuccessStickyEnabled() {
 	namespaceID := testNamespaceID
 	we := commonpb.WorkflowExecution{
@@ -760,6 +804,291 @@ func (s *engine2Suite) TestRecordActivityTaskStartedSuccess() {
 	s.Equal(scheduledEvent, response.ScheduledEvent)
 }
 
+func (s *engine2Suite) TestRecordActivityTaskStartedPaused() {
+	namespaceID := testNamespaceID
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskQueue"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	workflowTaskCompletedEvent := addWorkflowTaskCompletedEvent(msBuilder, int64(2), int64(3), identity)
+	addActivityTaskScheduledEvent(msBuilder, workflowTaskCompletedEvent.EventId, "activity1_id", "activity_type1", tl, payloads.EncodeString("input1"), 100*time.Second, 10*time.Second, 1*time.Second, 5*time.Second)
+	msBuilder.GetExecutionInfo().Paused = true
+
+	ms1 := createMutableState(msBuilder)
+	gwmsResponse1 := &p.GetWorkflowExecutionResponse{State: ms1}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
+
+	response, err := s.historyEngine.RecordActivityTaskStarted(context.Background(), &historyservice.RecordActivityTaskStartedRequest{
+		NamespaceId:       namespaceID,
+		WorkflowExecution: &workflowExecution,
+		ScheduleId:        5,
+		TaskId:            100,
+		RequestId:         "reqId",
+		PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+			TaskQueue: &taskqueuepb.TaskQueue{
+				Name: tl,
+			},
+			Identity: identity,
+		},
+	})
+	s.Equal(ErrWorkflowPaused, err)
+	s.Nil(response)
+}
+
+func (s *engine2Suite) TestPauseWorkflowExecution() {
+	namespaceID := testNamespaceID
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskQueue"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, false)
+	ms1 := createMutableState(msBuilder)
+	gwmsResponse1 := &p.GetWorkflowExecutionResponse{State: ms1}
+
+	var updateRequest *p.UpdateWorkflowExecutionRequest
+	var appendRequest *p.AppendHistoryNodesRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Run(func(args mock.Arguments) {
+		appendRequest = args.Get(0).(*p.AppendHistoryNodesRequest)
+	}).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(0).(*p.UpdateWorkflowExecutionRequest)
+	}).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+
+	err := s.historyEngine.PauseWorkflowExecution(context.Background(), &historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId: namespaceID,
+		Request: &adminservice.PauseWorkflowExecutionRequest{
+			Namespace: testNamespace,
+			Execution: &workflowExecution,
+			Reason:    "test",
+			Identity:  identity,
+		},
+	})
+	s.NoError(err)
+	s.NotNil(updateRequest)
+	s.True(updateRequest.UpdateWorkflowMutation.ExecutionInfo.Paused)
+	s.NotNil(appendRequest)
+	s.Len(appendRequest.Events, 1)
+	s.True(isHistoryServiceSignal(appendRequest.Events[0]))
+	s.Equal(common.WorkflowPausedSignalName, appendRequest.Events[0].GetWorkflowExecutionSignaledEventAttributes().GetSignalName())
+}
+
+func (s *engine2Suite) TestUnpauseWorkflowExecution() {
+	namespaceID := testNamespaceID
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskQueue"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	workflowTaskCompletedEvent := addWorkflowTaskCompletedEvent(msBuilder, int64(2), int64(3), identity)
+	addActivityTaskScheduledEvent(msBuilder, workflowTaskCompletedEvent.EventId, "activity1_id", "activity_type1", tl, payloads.EncodeString("input1"), 100*time.Second, 10*time.Second, 1*time.Second, 5*time.Second)
+	events := make(map[int64]*historypb.HistoryEvent)
+	for _, event := range msBuilder.GetHistoryBuilder().GetHistory().GetEvents() {
+		events[event.GetEventId()] = event
+	}
+	msBuilder.GetExecutionInfo().Paused = true
+
+	ms1 := createMutableState(msBuilder)
+	gwmsResponse1 := &p.GetWorkflowExecutionResponse{State: ms1}
+
+	var updateRequest *p.UpdateWorkflowExecutionRequest
+	var appendRequest *p.AppendHistoryNodesRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Run(func(args mock.Arguments) {
+		appendRequest = args.Get(0).(*p.AppendHistoryNodesRequest)
+	}).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(0).(*p.UpdateWorkflowExecutionRequest)
+	}).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+	s.mockEventsCache.EXPECT().getEvent(
+		namespaceID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(),
+		gomock.Any(), gomock.Any(), gomock.Any(),
+	).DoAndReturn(func(_, _, _ string, _, eventID int64, _ []byte) (*historypb.HistoryEvent, error) {
+		return events[eventID], nil
+	}).AnyTimes()
+
+	err := s.historyEngine.UnpauseWorkflowExecution(context.Background(), &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId: namespaceID,
+		Request: &adminservice.UnpauseWorkflowExecutionRequest{
+			Namespace: testNamespace,
+			Execution: &workflowExecution,
+			Reason:    "test",
+			Identity:  identity,
+		},
+	})
+	s.NoError(err)
+	s.NotNil(updateRequest)
+	s.False(updateRequest.UpdateWorkflowMutation.ExecutionInfo.Paused)
+	s.NotNil(appendRequest)
+	s.Len(appendRequest.Events, 2)
+	s.True(isHistoryServiceSignal(appendRequest.Events[0]))
+	s.Equal(common.WorkflowUnpausedSignalName, appendRequest.Events[0].GetWorkflowExecutionSignaledEventAttributes().GetSignalName())
+	s.Equal(enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED, appendRequest.Events[1].GetEventType())
+	var activityTasks int
+	for _, task := range updateRequest.UpdateWorkflowMutation.TransferTasks {
+		if _, ok := task.(*p.ActivityTask); ok {
+			activityTasks++
+		}
+	}
+	s.Equal(1, activityTasks)
+}
+
+func (s *engine2Suite) TestRecordActivityTaskStartedActivityPaused() {
+	namespaceID := testNamespaceID
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskQueue"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	workflowTaskCompletedEvent := addWorkflowTaskCompletedEvent(msBuilder, int64(2), int64(3), identity)
+	scheduledEvent, ai := addActivityTaskScheduledEvent(msBuilder, workflowTaskCompletedEvent.EventId, "activity1_id", "activity_type1", tl, payloads.EncodeString("input1"), 100*time.Second, 10*time.Second, 1*time.Second, 5*time.Second)
+	ai.Paused = true
+
+	ms1 := createMutableState(msBuilder)
+	gwmsResponse1 := &p.GetWorkflowExecutionResponse{State: ms1}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockEventsCache.EXPECT().getEvent(
+		namespaceID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(),
+		workflowTaskCompletedEvent.GetEventId(), scheduledEvent.GetEventId(), gomock.Any(),
+	).Return(scheduledEvent, nil)
+
+	response, err := s.historyEngine.RecordActivityTaskStarted(context.Background(), &historyservice.RecordActivityTaskStartedRequest{
+		NamespaceId:       namespaceID,
+		WorkflowExecution: &workflowExecution,
+		ScheduleId:        scheduledEvent.GetEventId(),
+		TaskId:            100,
+		RequestId:         "reqId",
+		PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+			TaskQueue: &taskqueuepb.TaskQueue{
+				Name: tl,
+			},
+			Identity: identity,
+		},
+	})
+	s.Equal(ErrActivityPaused, err)
+	s.Nil(response)
+}
+
+func (s *engine2Suite) TestPauseActivity() {
+	namespaceID := testNamespaceID
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskQueue"
+	activityID := "activity1_id"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	workflowTaskCompletedEvent := addWorkflowTaskCompletedEvent(msBuilder, int64(2), int64(3), identity)
+	addActivityTaskScheduledEvent(msBuilder, workflowTaskCompletedEvent.EventId, activityID, "activity_type1", tl, payloads.EncodeString("input1"), 100*time.Second, 10*time.Second, 1*time.Second, 5*time.Second)
+
+	ms1 := createMutableState(msBuilder)
+	gwmsResponse1 := &p.GetWorkflowExecutionResponse{State: ms1}
+
+	var updateRequest *p.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(0).(*p.UpdateWorkflowExecutionRequest)
+	}).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+
+	err := s.historyEngine.PauseActivity(context.Background(), &historyservice.PauseActivityRequest{
+		NamespaceId: namespaceID,
+		Request: &adminservice.PauseActivityRequest{
+			Namespace:  testNamespace,
+			Execution:  &workflowExecution,
+			ActivityId: activityID,
+			Reason:     "test",
+			Identity:   identity,
+		},
+	})
+	s.NoError(err)
+	s.NotNil(updateRequest)
+	s.Len(updateRequest.UpdateWorkflowMutation.UpsertActivityInfos, 1)
+	s.True(updateRequest.UpdateWorkflowMutation.UpsertActivityInfos[0].Paused)
+}
+
+func (s *engine2Suite) TestResetActivity() {
+	namespaceID := testNamespaceID
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskQueue"
+	activityID := "activity1_id"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	workflowTaskCompletedEvent := addWorkflowTaskCompletedEvent(msBuilder, int64(2), int64(3), identity)
+	_, ai := addActivityTaskScheduledEvent(msBuilder, workflowTaskCompletedEvent.EventId, activityID, "activity_type1", tl, payloads.EncodeString("input1"), 100*time.Second, 10*time.Second, 1*time.Second, 5*time.Second)
+	// the activity is waiting for the backoff of its fifth attempt
+	ai.Attempt = 5
+	ai.ScheduledTime = timestamp.TimePtr(time.Now().Add(time.Hour))
+
+	ms1 := createMutableState(msBuilder)
+	gwmsResponse1 := &p.GetWorkflowExecutionResponse{State: ms1}
+
+	var updateRequest *p.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(0).(*p.UpdateWorkflowExecutionRequest)
+	}).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+
+	err := s.historyEngine.ResetActivity(context.Background(), &historyservice.ResetActivityRequest{
+		NamespaceId: namespaceID,
+		Request: &adminservice.ResetActivityRequest{
+			Namespace:  testNamespace,
+			Execution:  &workflowExecution,
+			ActivityId: activityID,
+			Identity:   identity,
+		},
+	})
+	s.NoError(err)
+	s.NotNil(updateRequest)
+	s.Len(updateRequest.UpdateWorkflowMutation.UpsertActivityInfos, 1)
+	s.Equal(int32(1), updateRequest.UpdateWorkflowMutation.UpsertActivityInfos[0].Attempt)
+	var retryTasks []*p.ActivityRetryTimerTask
+	for _, task := range updateRequest.UpdateWorkflowMutation.TimerTasks {
+		if retryTask, ok := task.(*p.ActivityRetryTimerTask); ok {
+			retryTasks = append(retryTasks, retryTask)
+		}
+	}
+	s.Len(retryTasks, 1)
+	s.Equal(int32(1), retryTasks[0].Attempt)
+	// the retry timers of the previous attempts are invalidated
+	s.Equal(int32(1), retryTasks[0].Stamp)
+	s.False(retryTasks[0].VisibilityTimestamp.After(time.Now()))
+}
+
 func (s *engine2Suite) TestRequestCancelWorkflowExecutionSuccess() {
 	namespaceID := testNamespaceID
 	workflowExecution := commonpb.WorkflowExecution{
@@ -1083,6 +1412,147 @@ func (s *engine2Suite) TestStartWorkflowExecution_NotRunning_PrevSuccess() {
 	}
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_NotRunning_StartRequestDedup() {
+	namespaceID := testNamespaceID
+	workflowID := "workflowID"
+	runID := "runID"
+	startedRunID := "startedRunID"
+	workflowType := "workflowType"
+	taskQueue := "testTaskQueue"
+	identity := "testIdentity"
+	requestID := "requestID"
+	lastWriteVersion := common.EmptyVersion
+
+	s.config.StartRequestIDTTL = func(namespace string) time.Duration { return time.Hour }
+	defer func() { s.config.StartRequestIDTTL = NewDynamicConfigForTest().StartRequestIDTTL }()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(nil, &p.WorkflowExecutionAlreadyStartedError{
+		Msg:              "random message",
+		StartRequestID:   "oldRequestID",
+		RunID:            runID,
+		State:            enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		LastWriteVersion: lastWriteVersion,
+	}).Once()
+	s.mockExecutionMgr.On("GetStartRequestID", &p.GetStartRequestIDRequest{
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RequestID:   requestID,
+	}).Return(&p.GetStartRequestIDResponse{RunID: startedRunID}, nil).Once()
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &historyservice.StartWorkflowExecutionRequest{
+		Attempt:     1,
+		NamespaceId: namespaceID,
+		StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+			Namespace:                namespaceID,
+			WorkflowId:               workflowID,
+			WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+			TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueue},
+			WorkflowExecutionTimeout: timestamp.DurationPtr(1 * time.Second),
+			WorkflowTaskTimeout:      timestamp.DurationPtr(2 * time.Second),
+			Identity:                 identity,
+			RequestId:                requestID,
+			WorkflowIdReusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		},
+	})
+	s.Nil(err)
+	s.Equal(startedRunID, resp.GetRunId())
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_NotRunning_CreateStartRequestID() {
+	namespaceID := testNamespaceID
+	workflowID := "workflowID"
+	runID := "runID"
+	workflowType := "workflowType"
+	taskQueue := "testTaskQueue"
+	identity := "testIdentity"
+	requestID := "requestID"
+	lastWriteVersion := common.EmptyVersion
+
+	s.config.StartRequestIDTTL = func(namespace string) time.Duration { return time.Hour }
+	defer func() { s.config.StartRequestIDTTL = NewDynamicConfigForTest().StartRequestIDTTL }()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On(
+		"CreateWorkflowExecution",
+		mock.MatchedBy(func(request *p.CreateWorkflowExecutionRequest) bool {
+			return request.Mode == p.CreateWorkflowModeBrandNew
+		}),
+	).Return(nil, &p.WorkflowExecutionAlreadyStartedError{
+		Msg:              "random message",
+		StartRequestID:   "oldRequestID",
+		RunID:            runID,
+		State:            enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		LastWriteVersion: lastWriteVersion,
+	}).Once()
+	s.mockExecutionMgr.On("GetStartRequestID", mock.Anything).Return(nil, serviceerror.NewNotFound("")).Once()
+	s.mockExecutionMgr.On(
+		"CreateWorkflowExecution",
+		mock.MatchedBy(func(request *p.CreateWorkflowExecutionRequest) bool {
+			return request.Mode == p.CreateWorkflowModeWorkflowIDReuse
+		}),
+	).Return(&p.CreateWorkflowExecutionResponse{}, nil).Once()
+	s.mockExecutionMgr.On(
+		"CreateStartRequestID",
+		mock.MatchedBy(func(request *p.CreateStartRequestIDRequest) bool {
+			return request.NamespaceID == namespaceID &&
+				request.WorkflowID == workflowID &&
+				request.RequestID == requestID &&
+				request.TTL == time.Hour
+		}),
+	).Return(nil).Once()
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &historyservice.StartWorkflowExecutionRequest{
+		Attempt:     1,
+		NamespaceId: namespaceID,
+		StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+			Namespace:                namespaceID,
+			WorkflowId:               workflowID,
+			WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+			TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueue},
+			WorkflowExecutionTimeout: timestamp.DurationPtr(1 * time.Second),
+			WorkflowTaskTimeout:      timestamp.DurationPtr(2 * time.Second),
+			Identity:                 identity,
+			RequestId:                requestID,
+			WorkflowIdReusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		},
+	})
+	s.Nil(err)
+	s.NotEqual(runID, resp.GetRunId())
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_CreateStartRequestIDFailed() {
+	namespaceID := testNamespaceID
+	workflowID := "workflowID"
+	workflowType := "workflowType"
+	taskQueue := "testTaskQueue"
+	identity := "testIdentity"
+	requestID := "requestID"
+
+	s.config.StartRequestIDTTL = func(namespace string) time.Duration { return time.Hour }
+	defer func() { s.config.StartRequestIDTTL = NewDynamicConfigForTest().StartRequestIDTTL }()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(&p.CreateWorkflowExecutionResponse{}, nil).Once()
+	s.mockExecutionMgr.On("CreateStartRequestID", mock.Anything).Return(serviceerror.NewUnavailable("")).Once()
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &historyservice.StartWorkflowExecutionRequest{
+		Attempt:     1,
+		NamespaceId: namespaceID,
+		StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+			Namespace:                namespaceID,
+			WorkflowId:               workflowID,
+			WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+			TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueue},
+			WorkflowExecutionTimeout: timestamp.DurationPtr(1 * time.Second),
+			WorkflowTaskTimeout:      timestamp.DurationPtr(2 * time.Second),
+			Identity:                 identity,
+			RequestId:                requestID,
+		},
+	})
+	s.IsType(&serviceerror.Unavailable{}, err)
+	s.Nil(resp)
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_NotRunning_PrevFail() {
 	namespaceID := testNamespaceID
 	workflowID := "workflowID"
@@ -1351,6 +1821,57 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotRunning()
 	s.NotEqual(runID, resp.GetRunId())
 }
 
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotRunning_Dedup() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	tl := "testTaskQueue"
+
+	namespaceID := testNamespaceID
+	workflowID := "wId"
+	runID := testRunID
+	workflowType := "workflowType"
+	taskQueue := "testTaskQueue"
+	identity := "testIdentity"
+	signalName := "my signal name"
+	input := payloads.EncodeString("test input")
+	requestID := uuid.New()
+	sRequest := &historyservice.SignalWithStartWorkflowExecutionRequest{
+		NamespaceId: namespaceID,
+		SignalWithStartRequest: &workflowservice.SignalWithStartWorkflowExecutionRequest{
+			Namespace:                namespaceID,
+			WorkflowId:               workflowID,
+			WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+			TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueue},
+			Input:                    input,
+			WorkflowExecutionTimeout: timestamp.DurationPtr(1 * time.Second),
+			WorkflowTaskTimeout:      timestamp.DurationPtr(2 * time.Second),
+			Identity:                 identity,
+			RequestId:                requestID,
+			WorkflowIdReusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+			SignalName:               signalName,
+		},
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.historyEngine.shard, s.mockEventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), runID)
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
+	// the request is a retry of the one which started the previous run
+	ms.ExecutionInfo.ExecutionState.CreateRequestId = requestID
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &p.GetCurrentExecutionResponse{RunID: runID}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
+	s.Nil(err)
+	s.Equal(runID, resp.GetRunId())
+}
+
 func (s *engine2Suite) TestSignalWithStartWorkflowExecution_Start_DuplicateRequests() {
 	namespaceID := testNamespaceID
 	workflowID := "wId"
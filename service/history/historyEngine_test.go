@@ -25,6 +25,7 @@
 package history
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -50,6 +51,7 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
@@ -62,6 +64,7 @@ import (
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/loggerimpl"
@@ -512,6 +515,171 @@ func (s *engineSuite) TestGetMutableStateLongPollTimeout() {
 	s.Equal(int64(4), response.GetNextEventId())
 }
 
+func (s *engineSuite) TestStreamWorkflowExecutionHistory_ClosedWorkflow() {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "TestStreamWorkflowExecutionHistory_ClosedWorkflow",
+		RunId:      testRunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache, loggerimpl.NewDevelopmentForTest(s.Suite), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	event := addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, taskqueue, identity)
+	event = addWorkflowTaskCompletedEvent(msBuilder, di.ScheduleID, event.GetEventId(), identity)
+	addCompleteWorkflowEvent(msBuilder, event.GetEventId(), nil)
+	events := msBuilder.GetHistoryBuilder().GetHistory().GetEvents()
+	ms := createMutableState(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gweResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == common.FirstEventID
+	})).Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: events}, nil).Once()
+
+	var responses []*historyservice.StreamWorkflowExecutionHistoryResponse
+	err := s.mockHistoryEngine.StreamWorkflowExecutionHistory(context.Background(), &historyservice.StreamWorkflowExecutionHistoryRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.StreamWorkflowExecutionHistoryRequest{
+			Execution:  &execution,
+			EventTypes: []enumspb.EventType{enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED},
+		},
+	}, func(response *historyservice.StreamWorkflowExecutionHistoryResponse) error {
+		responses = append(responses, response)
+		return nil
+	})
+	s.NoError(err)
+	s.Len(responses, 1)
+	s.Equal(ms.ExecutionInfo.NextEventId, responses[0].GetResponse().GetNextEventId())
+	streamed := responses[0].GetResponse().GetHistory().GetEvents()
+	s.Len(streamed, 2)
+	s.Equal(enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED, streamed[0].GetEventType())
+	s.Equal(enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED, streamed[1].GetEventType())
+}
+
+func (s *engineSuite) TestStreamWorkflowExecutionHistory_ResumeInTheMiddleOfBatch() {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "TestStreamWorkflowExecutionHistory_ResumeInTheMiddleOfBatch",
+		RunId:      testRunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache, loggerimpl.NewDevelopmentForTest(s.Suite), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	event := addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, taskqueue, identity)
+	event = addWorkflowTaskCompletedEvent(msBuilder, di.ScheduleID, event.GetEventId(), identity)
+	addCompleteWorkflowEvent(msBuilder, event.GetEventId(), nil)
+	events := msBuilder.GetHistoryBuilder().GetHistory().GetEvents()
+	ms := createMutableState(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gweResponse, nil).Once()
+	// event 2 is in the middle of the batch starting at event 1, reading from it skips to the next batch
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == 2
+	})).Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: events[2:]}, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == common.FirstEventID
+	})).Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: events}, nil).Once()
+
+	var streamed []*historypb.HistoryEvent
+	err := s.mockHistoryEngine.StreamWorkflowExecutionHistory(context.Background(), &historyservice.StreamWorkflowExecutionHistoryRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.StreamWorkflowExecutionHistoryRequest{
+			Execution:    &execution,
+			FirstEventId: 2,
+		},
+	}, func(response *historyservice.StreamWorkflowExecutionHistoryResponse) error {
+		streamed = append(streamed, response.GetResponse().GetHistory().GetEvents()...)
+		return nil
+	})
+	s.NoError(err)
+	s.Equal(events[1:], streamed)
+}
+
+func (s *engineSuite) TestStreamWorkflowExecutionHistory_StartInTheMiddleOfBatchPagesHistory() {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "TestStreamWorkflowExecutionHistory_StartInTheMiddleOfBatchPagesHistory",
+		RunId:      testRunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache, loggerimpl.NewDevelopmentForTest(s.Suite), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	event := addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, taskqueue, identity)
+	event = addWorkflowTaskCompletedEvent(msBuilder, di.ScheduleID, event.GetEventId(), identity)
+	addCompleteWorkflowEvent(msBuilder, event.GetEventId(), nil)
+	events := msBuilder.GetHistoryBuilder().GetHistory().GetEvents()
+	ms := createMutableState(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gweResponse, nil).Once()
+	// event 4 is in the middle of the batch starting at event 3, reading from it skips to the next batch
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == 4
+	})).Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: events[4:]}, nil).Once()
+	// the history is paged from its start, one page at a time
+	pageToken := []byte("page token")
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == common.FirstEventID && len(request.NextPageToken) == 0
+	})).Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: events[:2], NextPageToken: pageToken}, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == common.FirstEventID && bytes.Equal(pageToken, request.NextPageToken)
+	})).Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: events[2:]}, nil).Once()
+
+	var streamed []*historypb.HistoryEvent
+	err := s.mockHistoryEngine.StreamWorkflowExecutionHistory(context.Background(), &historyservice.StreamWorkflowExecutionHistoryRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.StreamWorkflowExecutionHistoryRequest{
+			Execution:       &execution,
+			FirstEventId:    4,
+			MaximumPageSize: 2,
+		},
+	}, func(response *historyservice.StreamWorkflowExecutionHistoryResponse) error {
+		streamed = append(streamed, response.GetResponse().GetHistory().GetEvents()...)
+		return nil
+	})
+	s.NoError(err)
+	s.Equal(events[3:], streamed)
+}
+
+func (s *engineSuite) TestStreamWorkflowExecutionHistory_RunningWorkflowWaitsForEvents() {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "TestStreamWorkflowExecutionHistory_RunningWorkflowWaitsForEvents",
+		RunId:      testRunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache, loggerimpl.NewDevelopmentForTest(s.Suite), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	addWorkflowTaskScheduledEvent(msBuilder)
+	events := msBuilder.GetHistoryBuilder().GetHistory().GetEvents()
+	ms := createMutableState(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gweResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything).Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: events}, nil).Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var streamed []*historypb.HistoryEvent
+	err := s.mockHistoryEngine.StreamWorkflowExecutionHistory(ctx, &historyservice.StreamWorkflowExecutionHistoryRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.StreamWorkflowExecutionHistoryRequest{
+			Execution: &execution,
+		},
+	}, func(response *historyservice.StreamWorkflowExecutionHistoryResponse) error {
+		streamed = append(streamed, response.GetResponse().GetHistory().GetEvents()...)
+		// the stream keeps waiting for new events of the running workflow until the receiver goes away
+		cancel()
+		return nil
+	})
+	s.Equal(context.Canceled, err)
+	s.Equal(events, streamed)
+}
+
 func (s *engineSuite) TestQueryWorkflow_RejectBasedOnCompleted() {
 	execution := commonpb.WorkflowExecution{
 		WorkflowId: "TestQueryWorkflow_RejectBasedOnCompleted",
@@ -633,6 +801,67 @@ func (s *engineSuite) TestQueryWorkflow_DirectlyThroughMatching() {
 	s.Equal([]byte{1, 2, 3}, queryResult)
 }
 
+func (s *engineSuite) TestQueryWorkflow_EventualConsistency() {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "TestQueryWorkflow_EventualConsistency",
+		RunId:      testRunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache, loggerimpl.NewDevelopmentForTest(s.Suite), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	startedEvent := addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, taskqueue, identity)
+	addWorkflowTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.EventId, identity)
+	// a pending workflow task would make a strongly consistent query wait for it
+	addWorkflowTaskScheduledEvent(msBuilder)
+
+	ms := createMutableState(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gweResponse, nil).Once()
+	s.mockMatchingClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(&matchingservice.QueryWorkflowResponse{QueryResult: payloads.EncodeBytes([]byte{1, 2, 3})}, nil)
+	s.mockHistoryEngine.matchingClient = s.mockMatchingClient
+	request := &historyservice.QueryWorkflowRequest{
+		NamespaceId: testNamespaceID,
+		Request: &workflowservice.QueryWorkflowRequest{
+			Execution:            &execution,
+			Query:                &querypb.WorkflowQuery{},
+			QueryRejectCondition: enumspb.QUERY_REJECT_CONDITION_NONE,
+		},
+		QueryConsistencyLevel: enumsspb.QUERY_CONSISTENCY_LEVEL_EVENTUAL,
+	}
+	resp, err := s.mockHistoryEngine.QueryWorkflow(context.Background(), request)
+	s.NoError(err)
+	s.NotNil(resp.GetResponse().QueryResult)
+	s.Nil(resp.GetResponse().QueryRejected)
+}
+
+func (s *engineSuite) TestQueryRejected() {
+	testCases := []struct {
+		status    enumspb.WorkflowExecutionStatus
+		condition enumspb.QueryRejectCondition
+		rejected  bool
+	}{
+		{enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED, enumspb.QUERY_REJECT_CONDITION_NONE, false},
+		{enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, enumspb.QUERY_REJECT_CONDITION_NOT_OPEN, false},
+		{enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, enumspb.QUERY_REJECT_CONDITION_NOT_OPEN, true},
+		{enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, enumspb.QUERY_REJECT_CONDITION_NOT_COMPLETED_CLEANLY, false},
+		{enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, enumspb.QUERY_REJECT_CONDITION_NOT_COMPLETED_CLEANLY, false},
+		{enumspb.WORKFLOW_EXECUTION_STATUS_FAILED, enumspb.QUERY_REJECT_CONDITION_NOT_COMPLETED_CLEANLY, true},
+		{enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW, enumspb.QUERY_REJECT_CONDITION_NOT_COMPLETED_CLEANLY, true},
+	}
+	for _, tc := range testCases {
+		rejected := queryRejected(tc.status, tc.condition)
+		if tc.rejected {
+			s.NotNil(rejected, "%v %v", tc.status, tc.condition)
+			s.Equal(tc.status, rejected.GetStatus())
+		} else {
+			s.Nil(rejected, "%v %v", tc.status, tc.condition)
+		}
+	}
+}
+
 func (s *engineSuite) TestQueryWorkflow_WorkflowTaskDispatch_Timeout() {
 	execution := commonpb.WorkflowExecution{
 		WorkflowId: "TestQueryWorkflow_WorkflowTaskDispatch_Timeout",
@@ -1726,6 +1955,67 @@ func (s *engineSuite) TestRespondWorkflowTaskCompletedSingleActivityScheduledWor
 	s.Equal(5*time.Second, timestamp.DurationValue(activity1Attributes.HeartbeatTimeout))
 }
 
+func (s *engineSuite) TestRespondWorkflowTaskCompleted_ContinueAsNewSuggested() {
+
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	tl := "testTaskQueue"
+	tt := &tokenspb.Task{
+		ScheduleAttempt: 1,
+		WorkflowId:      "wId",
+		RunId:           we.GetRunId(),
+		ScheduleId:      2,
+	}
+	taskToken, _ := tt.Marshal()
+	identity := "testIdentity"
+	s.mockHistoryEngine.config.HistoryCountSuggestContinueAsNew = func(namespace string) int { return 4 }
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 90*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var signalEvent *historypb.HistoryEvent
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.MatchedBy(func(request *persistence.AppendHistoryNodesRequest) bool {
+		for _, event := range request.Events {
+			if event.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED {
+				signalEvent = event
+			}
+		}
+		return true
+	})).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	_, err := s.mockHistoryEngine.RespondWorkflowTaskCompleted(context.Background(), &historyservice.RespondWorkflowTaskCompletedRequest{
+		NamespaceId: testNamespaceID,
+		CompleteRequest: &workflowservice.RespondWorkflowTaskCompletedRequest{
+			TaskToken: taskToken,
+			Identity:  identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(testNamespaceID, we)
+	s.Equal(int64(7), executionBuilder.GetExecutionInfo().NextEventId)
+	s.True(executionBuilder.HasPendingWorkflowTask())
+
+	// the suggestion is recorded as a signal, a new workflow task delivers it to the workflow
+	s.NotNil(signalEvent)
+	s.Equal(int64(5), signalEvent.GetEventId())
+	signalAttributes := signalEvent.GetWorkflowExecutionSignaledEventAttributes()
+	s.Equal(common.ContinueAsNewSuggestedSignalName, signalAttributes.GetSignalName())
+	s.Equal(identityHistoryService, signalAttributes.GetIdentity())
+	var values map[string]int64
+	s.NoError(payloads.Decode(signalAttributes.GetInput(), &values))
+	s.Equal(int64(4), values[definition.HistoryEventCount])
+}
+
 func (s *engineSuite) TestRespondWorkflowTaskCompleted_WorkflowTaskHeartbeatTimeout() {
 
 	we := commonpb.WorkflowExecution{
@@ -2795,6 +3085,117 @@ func (s *engineSuite) TestRespondActivityTaskCompletedByIdSuccess() {
 	s.Equal(common.EmptyEventID, di.StartedID)
 }
 
+func (s *engineSuite) TestRespondActivityTaskCompletedByIdNotStarted() {
+	s.mockHistoryEngine.config.EnableCompleteUnstartedActivityByID = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	tl := "testTaskQueue"
+
+	identity := "testIdentity"
+	operatorIdentity := "testOperator"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := payloads.EncodeString("input1")
+	activityResult := payloads.EncodeString("activity result")
+	tt := &tokenspb.Task{
+		ScheduleAttempt: 1,
+		WorkflowId:      we.WorkflowId,
+		ScheduleId:      common.EmptyEventID,
+		ActivityId:      activityID,
+	}
+	taskToken, _ := tt.Marshal()
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 100*time.Second, 100*time.Second, identity)
+	workflowTaskScheduledEvent := addWorkflowTaskScheduledEvent(msBuilder)
+	workflowTaskStartedEvent := addWorkflowTaskStartedEvent(msBuilder, workflowTaskScheduledEvent.ScheduleID, tl, identity)
+	workflowTaskCompletedEvent := addWorkflowTaskCompletedEvent(msBuilder, workflowTaskScheduledEvent.ScheduleID, workflowTaskStartedEvent.EventId, identity)
+	addActivityTaskScheduledEvent(msBuilder, workflowTaskCompletedEvent.EventId, activityID, activityType, tl, activityInput, 100*time.Second, 10*time.Second, 1*time.Second, 5*time.Second)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: we.RunId}
+
+	var appendRequest *persistence.AppendHistoryNodesRequest
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Run(func(args mock.Arguments) {
+		appendRequest = args.Get(0).(*persistence.AppendHistoryNodesRequest)
+	}).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &historyservice.RespondActivityTaskCompletedRequest{
+		NamespaceId: testNamespaceID,
+		CompleteRequest: &workflowservice.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result:    activityResult,
+			Identity:  operatorIdentity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(testNamespaceID, we)
+	s.Equal(int64(9), executionBuilder.GetExecutionInfo().NextEventId)
+	s.Equal(enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, executionBuilder.GetExecutionInfo().GetExecutionState().State)
+	s.True(executionBuilder.HasPendingWorkflowTask())
+
+	s.NotNil(appendRequest)
+	s.Equal(enumspb.EVENT_TYPE_ACTIVITY_TASK_STARTED, appendRequest.Events[0].GetEventType())
+	s.Equal(operatorIdentity, appendRequest.Events[0].GetActivityTaskStartedEventAttributes().GetIdentity())
+	s.Equal(enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED, appendRequest.Events[1].GetEventType())
+}
+
+func (s *engineSuite) TestRespondActivityTaskCompletedByIdNotStartedDisabled() {
+
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	tl := "testTaskQueue"
+
+	identity := "testIdentity"
+	operatorIdentity := "testOperator"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := payloads.EncodeString("input1")
+	activityResult := payloads.EncodeString("activity result")
+	tt := &tokenspb.Task{
+		ScheduleAttempt: 1,
+		WorkflowId:      we.WorkflowId,
+		ScheduleId:      common.EmptyEventID,
+		ActivityId:      activityID,
+	}
+	taskToken, _ := tt.Marshal()
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 100*time.Second, 100*time.Second, identity)
+	workflowTaskScheduledEvent := addWorkflowTaskScheduledEvent(msBuilder)
+	workflowTaskStartedEvent := addWorkflowTaskStartedEvent(msBuilder, workflowTaskScheduledEvent.ScheduleID, tl, identity)
+	workflowTaskCompletedEvent := addWorkflowTaskCompletedEvent(msBuilder, workflowTaskScheduledEvent.ScheduleID, workflowTaskStartedEvent.EventId, identity)
+	addActivityTaskScheduledEvent(msBuilder, workflowTaskCompletedEvent.EventId, activityID, activityType, tl, activityInput, 100*time.Second, 10*time.Second, 1*time.Second, 5*time.Second)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: we.RunId}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &historyservice.RespondActivityTaskCompletedRequest{
+		NamespaceId: testNamespaceID,
+		CompleteRequest: &workflowservice.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result:    activityResult,
+			Identity:  operatorIdentity,
+		},
+	})
+	s.Equal(ErrActivityTaskNotFound, err)
+}
+
 func (s *engineSuite) TestRespondActivityTaskFailedInvalidToken() {
 
 	invalidToken, _ := json.Marshal("bad token")
@@ -5324,6 +5725,9 @@ func copyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		RetryMaximumAttempts:                   sourceInfo.RetryMaximumAttempts,
 		RetryNonRetryableErrorTypes:            sourceInfo.RetryNonRetryableErrorTypes,
 		EventBranchToken:                       sourceInfo.EventBranchToken,
+		Paused:                                 sourceInfo.Paused,
+		Deadlines:                              sourceInfo.Deadlines,
+		DeadlineRules:                          sourceInfo.DeadlineRules,
 	}
 }
 
@@ -5379,6 +5783,8 @@ func copyActivityInfo(sourceInfo *persistenceblobs.ActivityInfo) *persistenceblo
 		RetryNonRetryableErrorTypes: sourceInfo.RetryNonRetryableErrorTypes,
 		RetryLastFailure:            sourceInfo.RetryLastFailure,
 		RetryLastWorkerIdentity:     sourceInfo.RetryLastWorkerIdentity,
+		Paused:                      sourceInfo.Paused,
+		Stamp:                       sourceInfo.Stamp,
 	}
 }
 
@@ -37,6 +37,7 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
@@ -110,12 +111,16 @@ type (
 		AddWorkflowExecutionCancelRequestedEvent(string, *historyservice.RequestCancelWorkflowExecutionRequest) (*historypb.HistoryEvent, error)
 		AddWorkflowExecutionCanceledEvent(int64, *commandpb.CancelWorkflowExecutionCommandAttributes) (*historypb.HistoryEvent, error)
 		AddWorkflowExecutionSignaled(signalName string, input *commonpb.Payloads, identity string) (*historypb.HistoryEvent, error)
+		AddWorkflowExecutionPausedEvent(paused bool, identity string, reason string) (*historypb.HistoryEvent, error)
 		AddWorkflowExecutionStartedEvent(commonpb.WorkflowExecution, *historyservice.StartWorkflowExecutionRequest) (*historypb.HistoryEvent, error)
 		AddWorkflowExecutionTerminatedEvent(firstEventID int64, reason string, details *commonpb.Payloads, identity string) (*historypb.HistoryEvent, error)
 		ClearStickyness()
 		CheckResettable() error
 		CopyToPersistence() *persistence.WorkflowMutableState
 		RetryActivity(ai *persistenceblobs.ActivityInfo, failure *failurepb.Failure) (enumspb.RetryState, error)
+		ResetActivityAttempt(ai *persistenceblobs.ActivityInfo, resetHeartbeatDetails bool) error
+		SetActivityPaused(ai *persistenceblobs.ActivityInfo, paused bool) error
+		UpdateActivityOptions(ai *persistenceblobs.ActivityInfo, request *adminservice.UpdateActivityOptionsRequest) error
 		CreateNewHistoryEvent(eventType enumspb.EventType) *historypb.HistoryEvent
 		CreateNewHistoryEventWithTime(eventType enumspb.EventType, time time.Time) *historypb.HistoryEvent
 		CreateTransientWorkflowTaskEvents(di *workflowTaskInfo, identity string) (*historypb.HistoryEvent, *historypb.HistoryEvent)
@@ -146,6 +151,7 @@ type (
 		GetPreviousStartedEventID() int64
 		GetPendingActivityInfos() map[int64]*persistenceblobs.ActivityInfo
 		GetPendingTimerInfos() map[string]*persistenceblobs.TimerInfo
+		GetPendingDeadlines() []*persistenceblobs.DeadlineInfo
 		GetPendingChildExecutionInfos() map[int64]*persistenceblobs.ChildExecutionInfo
 		GetPendingRequestCancelExternalInfos() map[int64]*persistenceblobs.RequestCancelInfo
 		GetPendingSignalExternalInfos() map[int64]*persistenceblobs.SignalInfo
@@ -169,6 +175,7 @@ type (
 		IsSignalRequested(requestID string) bool
 		IsStickyTaskQueueEnabled() bool
 		IsWorkflowExecutionRunning() bool
+		IsWorkflowExecutionPaused() bool
 		IsResourceDuplicated(resourceDedupKey definition.DeduplicationID) bool
 		UpdateDuplicatedResource(resourceDedupKey definition.DeduplicationID)
 		Load(*persistence.WorkflowMutableState)
@@ -223,7 +230,12 @@ type (
 		UpdateWorkflowTask(*workflowTaskInfo)
 		UpdateUserTimer(*persistenceblobs.TimerInfo) error
 		UpdateCurrentVersion(version int64, forceUpdate bool) error
+		UpdateHistorySizeSearchAttributes(historySize int64, suggestContinueAsNew bool, now time.Time) (bool, error)
 		UpdateWorkflowStateStatus(state enumsspb.WorkflowExecutionState, status enumspb.WorkflowExecutionStatus) error
+		DeleteDeadline(name string)
+		SatisfyDeadlines(event *historypb.HistoryEvent)
+		SetDeadlineExceeded(name string, now time.Time) error
+		AddDeadlineExceededEvent(name string) (*historypb.HistoryEvent, error)
 
 		AddTransferTasks(transferTasks ...persistence.Task)
 		AddTimerTasks(timerTasks ...persistence.Task)
@@ -40,6 +40,7 @@ import (
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
@@ -56,6 +57,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
@@ -65,6 +67,9 @@ const (
 
 	mutableStateInvalidHistoryActionMsg         = "invalid history builder state for action"
 	mutableStateInvalidHistoryActionMsgTemplate = mutableStateInvalidHistoryActionMsg + ": %v"
+
+	workflowPausedIdentityKey = "Identity"
+	workflowPausedReasonKey   = "Reason"
 )
 
 var (
@@ -1035,6 +1040,9 @@ func (e *mutableStateBuilder) GetRetryBackoffDuration(
 	if !info.HasRetryPolicy {
 		return backoff.NoBackoff, enumspb.RETRY_STATE_RETRY_POLICY_NOT_SET
 	}
+	if e.isNamespaceDeleted() {
+		return backoff.NoBackoff, enumspb.RETRY_STATE_NON_RETRYABLE_FAILURE
+	}
 
 	return getBackoffInterval(
 		e.timeSource.Now(),
@@ -1051,7 +1059,7 @@ func (e *mutableStateBuilder) GetRetryBackoffDuration(
 
 func (e *mutableStateBuilder) GetCronBackoffDuration() (time.Duration, error) {
 	info := e.executionInfo
-	if len(info.CronSchedule) == 0 {
+	if len(info.CronSchedule) == 0 || e.isNamespaceDeleted() {
 		return backoff.NoBackoff, nil
 	}
 	// TODO: decide if we can add execution time in execution info.
@@ -1067,6 +1075,12 @@ func (e *mutableStateBuilder) GetCronBackoffDuration() (time.Duration, error) {
 	return backoff.GetBackoffForNextSchedule(info.CronSchedule, executionTime, e.timeSource.Now()), nil
 }
 
+// isNamespaceDeleted returns true if the namespace of the workflow is deleted, the workflows of a
+// deleted namespace are neither retried nor run again on their cron schedule
+func (e *mutableStateBuilder) isNamespaceDeleted() bool {
+	return e.namespaceEntry.GetInfo().GetState() == enumspb.NAMESPACE_STATE_DELETED
+}
+
 // GetSignalInfo get details about a signal request that is currently in progress.
 func (e *mutableStateBuilder) GetSignalInfo(
 	initiatedEventID int64,
@@ -1253,6 +1267,39 @@ func (e *mutableStateBuilder) ReplicateActivityInfo(
 	ai.Attempt = request.GetAttempt()
 	ai.RetryLastWorkerIdentity = request.GetLastWorkerIdentity()
 	ai.RetryLastFailure = request.GetLastFailure()
+	ai.Paused = request.GetPaused()
+	ai.Stamp = request.GetStamp()
+
+	// the options are not in the tasks of the clusters which don't replicate them yet
+	if timeout := request.GetScheduleToCloseTimeout(); timeout != nil {
+		resetActivityTimerTaskStatus = resetActivityTimerTaskStatus ||
+			timestamp.DurationValue(ai.ScheduleToCloseTimeout) != *timeout
+		ai.ScheduleToCloseTimeout = timeout
+	}
+	if timeout := request.GetScheduleToStartTimeout(); timeout != nil {
+		resetActivityTimerTaskStatus = resetActivityTimerTaskStatus ||
+			timestamp.DurationValue(ai.ScheduleToStartTimeout) != *timeout
+		ai.ScheduleToStartTimeout = timeout
+	}
+	if timeout := request.GetStartToCloseTimeout(); timeout != nil {
+		resetActivityTimerTaskStatus = resetActivityTimerTaskStatus ||
+			timestamp.DurationValue(ai.StartToCloseTimeout) != *timeout
+		ai.StartToCloseTimeout = timeout
+	}
+	if timeout := request.GetHeartbeatTimeout(); timeout != nil {
+		resetActivityTimerTaskStatus = resetActivityTimerTaskStatus ||
+			timestamp.DurationValue(ai.HeartbeatTimeout) != *timeout
+		ai.HeartbeatTimeout = timeout
+	}
+	if retryPolicy := request.GetRetryPolicy(); retryPolicy != nil {
+		ai.HasRetryPolicy = true
+		ai.RetryInitialInterval = retryPolicy.GetInitialInterval()
+		ai.RetryBackoffCoefficient = retryPolicy.GetBackoffCoefficient()
+		ai.RetryMaximumInterval = retryPolicy.GetMaximumInterval()
+		ai.RetryMaximumAttempts = retryPolicy.GetMaximumAttempts()
+		ai.RetryNonRetryableErrorTypes = retryPolicy.GetNonRetryableErrorTypes()
+		ai.RetryExpirationTime = request.GetRetryExpirationTime()
+	}
 
 	if resetActivityTimerTaskStatus {
 		ai.TimerTaskStatus = timerTaskStatusNone
@@ -1447,6 +1494,11 @@ func (e *mutableStateBuilder) GetPendingTimerInfos() map[string]*persistenceblob
 	return e.pendingTimerInfoIDs
 }
 
+// GetPendingDeadlines returns the deadlines which are neither satisfied nor exceeded yet
+func (e *mutableStateBuilder) GetPendingDeadlines() []*persistenceblobs.DeadlineInfo {
+	return e.executionInfo.Deadlines
+}
+
 func (e *mutableStateBuilder) GetPendingChildExecutionInfos() map[int64]*persistenceblobs.ChildExecutionInfo {
 	return e.pendingChildExecutionInfoIDs
 }
@@ -1549,6 +1601,38 @@ func (e *mutableStateBuilder) IsWorkflowExecutionRunning() bool {
 	}
 }
 
+func (e *mutableStateBuilder) IsWorkflowExecutionPaused() bool {
+	return e.executionInfo.Paused
+}
+
+// SetWorkflowExecutionPaused stops or resumes the dispatch of the workflow and activity tasks,
+// the state is recorded in the WorkflowPaused search attribute for describe and list
+func (e *mutableStateBuilder) SetWorkflowExecutionPaused(
+	paused bool,
+	now time.Time,
+) error {
+
+	exeInfo := e.executionInfo
+	if exeInfo.Paused == paused {
+		return nil
+	}
+	exeInfo.Paused = paused
+
+	p, err := payload.Encode(paused)
+	if err != nil {
+		return err
+	}
+	if exeInfo.SearchAttributes == nil {
+		exeInfo.SearchAttributes = make(map[string]*commonpb.Payload)
+	}
+	exeInfo.SearchAttributes[definition.WorkflowPaused] = p
+
+	if e.shard.GetConfig().AdvancedVisibilityWritingMode() != common.AdvancedVisibilityWritingModeOff {
+		return e.taskGenerator.generateWorkflowSearchAttrTasks(now)
+	}
+	return nil
+}
+
 func (e *mutableStateBuilder) IsCancelRequested() (bool, string) {
 	if e.executionInfo.CancelRequested {
 		return e.executionInfo.CancelRequested, e.executionInfo.GetExecutionState().CreateRequestId
@@ -1653,6 +1737,8 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		ContinueAsNewInitiator:   attributes.Initiator,
 		FirstWorkflowTaskBackoff: attributes.BackoffStartInterval,
 	}
+	// runs started by continue as new, retry and cron keep the deadline rules of the previous run
+	e.executionInfo.DeadlineRules = previousExecutionInfo.DeadlineRules
 	if attributes.GetInitiator() == enumspb.CONTINUE_AS_NEW_INITIATOR_RETRY {
 		req.Attempt = previousExecutionState.GetExecutionInfo().Attempt + 1
 	} else {
@@ -1725,6 +1811,11 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(
 		return nil, e.createInternalServerError(opTag)
 	}
 
+	// the run gets the default deadline rules of the namespace if the request doesn't set them
+	e.executionInfo.DeadlineRules = e.namespaceEntry.GetConfig().GetDeadlineRules()
+	if startRequest.DeadlineRules != nil {
+		e.executionInfo.DeadlineRules = startRequest.DeadlineRules.GetRules()
+	}
 	event := e.hBuilder.AddWorkflowExecutionStartedEvent(startRequest, nil, execution.GetRunId(), execution.GetRunId())
 
 	var parentNamespaceID string
@@ -1829,6 +1920,9 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 		e.executionInfo.SearchAttributes = event.SearchAttributes.GetIndexedFields()
 	}
 
+	// the deadline rules are set before the started event is applied, they are not part of the event
+	e.addDeadlines(startEvent)
+
 	e.writeEventToCache(startEvent)
 	return nil
 }
@@ -1978,6 +2072,188 @@ func (e *mutableStateBuilder) addBinaryCheckSumIfNotExists(
 	return nil
 }
 
+// UpdateHistorySizeSearchAttributes records the history size and event count of the workflow, and whether
+// continue-as-new is suggested, in its search attributes. A visibility update is only generated when the suggestion
+// changes, otherwise the values are refreshed with the next visibility record of the workflow. It returns true if
+// continue-as-new is suggested and was not before.
+func (e *mutableStateBuilder) UpdateHistorySizeSearchAttributes(
+	historySize int64,
+	suggestContinueAsNew bool,
+	now time.Time,
+) (bool, error) {
+
+	exeInfo := e.executionInfo
+	if exeInfo.SearchAttributes == nil {
+		exeInfo.SearchAttributes = make(map[string]*commonpb.Payload)
+	}
+
+	var suggested bool
+	if p, ok := exeInfo.SearchAttributes[definition.ContinueAsNewSuggested]; ok {
+		if err := payload.Decode(p, &suggested); err != nil {
+			return false, err
+		}
+	}
+
+	attributes := map[string]interface{}{
+		definition.HistorySizeBytes:       historySize,
+		definition.HistoryEventCount:      e.GetNextEventID() - 1,
+		definition.ContinueAsNewSuggested: suggestContinueAsNew,
+	}
+	for key, value := range attributes {
+		p, err := payload.Encode(value)
+		if err != nil {
+			return false, err
+		}
+		exeInfo.SearchAttributes[key] = p
+	}
+
+	if suggested != suggestContinueAsNew &&
+		e.shard.GetConfig().AdvancedVisibilityWritingMode() != common.AdvancedVisibilityWritingModeOff {
+		if err := e.taskGenerator.generateWorkflowSearchAttrTasks(now); err != nil {
+			return false, err
+		}
+	}
+	return suggestContinueAsNew && !suggested, nil
+}
+
+// DeleteDeadline removes a deadline from the pending deadlines
+func (e *mutableStateBuilder) DeleteDeadline(
+	name string,
+) {
+
+	pending := make([]*persistenceblobs.DeadlineInfo, 0, len(e.executionInfo.Deadlines))
+	for _, deadline := range e.executionInfo.Deadlines {
+		if deadline.GetRule().GetName() != name {
+			pending = append(pending, deadline)
+		}
+	}
+	e.executionInfo.Deadlines = pending
+}
+
+// AddDeadlineExceededEvent records the exceeded deadline of a rule with the flag action as a signal from history,
+// the deadline is recorded in the search attributes when the signal is replicated so standby clusters get it with
+// the history
+func (e *mutableStateBuilder) AddDeadlineExceededEvent(
+	name string,
+) (*historypb.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowSignaled
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	input, err := payloads.Encode(name)
+	if err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionSignaledEvent(common.DeadlineExceededSignalName, input, identityHistoryService)
+	if err := e.ReplicateWorkflowExecutionSignaled(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// SetDeadlineExceeded records an exceeded deadline in the DeadlinesExceeded search attribute
+func (e *mutableStateBuilder) SetDeadlineExceeded(
+	name string,
+	now time.Time,
+) error {
+
+	exeInfo := e.executionInfo
+	if exeInfo.SearchAttributes == nil {
+		exeInfo.SearchAttributes = make(map[string]*commonpb.Payload)
+	}
+
+	var exceeded []string
+	if p, ok := exeInfo.SearchAttributes[definition.DeadlinesExceeded]; ok {
+		if err := payload.Decode(p, &exceeded); err != nil {
+			return err
+		}
+	}
+	for _, exceededName := range exceeded {
+		if exceededName == name {
+			// a cluster taking over the workflow after a failover can exceed the deadline again
+			return nil
+		}
+	}
+	p, err := payload.Encode(append(exceeded, name))
+	if err != nil {
+		return err
+	}
+	exeInfo.SearchAttributes[definition.DeadlinesExceeded] = p
+
+	if e.shard.GetConfig().AdvancedVisibilityWritingMode() != common.AdvancedVisibilityWritingModeOff {
+		return e.taskGenerator.generateWorkflowSearchAttrTasks(now)
+	}
+	return nil
+}
+
+// addDeadlines sets up the deadlines of the deadline rules of a new run
+func (e *mutableStateBuilder) addDeadlines(
+	startEvent *historypb.HistoryEvent,
+) {
+
+	// deadlines are counted from the first workflow task of the run
+	attributes := startEvent.GetWorkflowExecutionStartedEventAttributes()
+	startTime := timestamp.TimeValue(startEvent.GetEventTime()).Add(timestamp.DurationValue(attributes.GetFirstWorkflowTaskBackoff()))
+	e.executionInfo.Deadlines = nil
+	for _, rule := range e.executionInfo.DeadlineRules {
+		e.executionInfo.Deadlines = append(e.executionInfo.Deadlines, &persistenceblobs.DeadlineInfo{
+			Rule:       rule,
+			ExpiryTime: timestamp.TimePtr(startTime.Add(timestamp.DurationValue(rule.GetTimeout()))),
+		})
+	}
+}
+
+// SatisfyDeadlines removes the pending deadlines which wait for the event, and the ones whose action
+// recorded the event. Standby clusters resolve the deadlines exceeded on the active cluster this way.
+func (e *mutableStateBuilder) SatisfyDeadlines(
+	event *historypb.HistoryEvent,
+) {
+
+	for _, deadline := range e.executionInfo.Deadlines {
+		rule := deadline.GetRule()
+		if isDeadlineActionEvent(rule, event) {
+			e.DeleteDeadline(rule.GetName())
+			continue
+		}
+		if rule.GetEventType() != event.GetEventType() {
+			continue
+		}
+		if rule.GetSignalName() != "" &&
+			rule.GetSignalName() != event.GetWorkflowExecutionSignaledEventAttributes().GetSignalName() {
+			continue
+		}
+		e.DeleteDeadline(rule.GetName())
+	}
+}
+
+// isDeadlineActionEvent tells if the event is the signal recorded by the action of the exceeded deadline
+func isDeadlineActionEvent(
+	rule *workflowspb.DeadlineRule,
+	event *historypb.HistoryEvent,
+) bool {
+
+	attributes := event.GetWorkflowExecutionSignaledEventAttributes()
+	if event.GetEventType() != enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED ||
+		attributes.GetIdentity() != identityHistoryService {
+		return false
+	}
+
+	switch rule.GetAction() {
+	case enumsspb.DEADLINE_ACTION_SIGNAL:
+		return attributes.GetSignalName() == rule.GetName()
+	case enumsspb.DEADLINE_ACTION_FLAG:
+		var name string
+		return attributes.GetSignalName() == common.DeadlineExceededSignalName &&
+			payloads.Decode(attributes.GetInput(), &name) == nil &&
+			name == rule.GetName()
+	default:
+		return false
+	}
+}
+
 // TODO: we will release the restriction when reset API allow those pending
 func (e *mutableStateBuilder) CheckResettable() error {
 	if len(e.GetPendingChildExecutionInfos()) > 0 {
@@ -3224,9 +3500,56 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionSignaled(
 
 	// Increment signal count in mutable state for this workflow execution
 	e.executionInfo.SignalCount++
+
+	if isHistoryServiceSignal(event) {
+		switch event.GetWorkflowExecutionSignaledEventAttributes().GetSignalName() {
+		case common.WorkflowPausedSignalName:
+			return e.SetWorkflowExecutionPaused(true, timestamp.TimeValue(event.GetEventTime()))
+		case common.WorkflowUnpausedSignalName:
+			return e.SetWorkflowExecutionPaused(false, timestamp.TimeValue(event.GetEventTime()))
+		case common.DeadlineExceededSignalName:
+			var name string
+			if err := payloads.Decode(event.GetWorkflowExecutionSignaledEventAttributes().GetInput(), &name); err != nil {
+				return err
+			}
+			return e.SetDeadlineExceeded(name, timestamp.TimeValue(event.GetEventTime()))
+		}
+	}
 	return nil
 }
 
+// AddWorkflowExecutionPausedEvent records the pause or unpause of the workflow as a signal from history,
+// the pause state is applied when the signal is replicated so standby clusters get it with the history
+func (e *mutableStateBuilder) AddWorkflowExecutionPausedEvent(
+	paused bool,
+	identity string,
+	reason string,
+) (*historypb.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowSignaled
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	input, err := payloads.Encode(map[string]string{
+		workflowPausedIdentityKey: identity,
+		workflowPausedReasonKey:   reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	signalName := common.WorkflowUnpausedSignalName
+	if paused {
+		signalName = common.WorkflowPausedSignalName
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionSignaledEvent(signalName, input, identityHistoryService)
+	if err := e.ReplicateWorkflowExecutionSignaled(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
 func (e *mutableStateBuilder) AddContinueAsNewEvent(
 	firstEventID int64,
 	workflowTaskCompletedEventID int64,
@@ -3762,6 +4085,125 @@ func (e *mutableStateBuilder) RetryActivity(
 	return enumspb.RETRY_STATE_IN_PROGRESS, nil
 }
 
+// SetActivityPaused stops or resumes the dispatch of the tasks of a pending activity,
+// an activity which is not running on a worker is dispatched again once it is unpaused
+func (e *mutableStateBuilder) SetActivityPaused(
+	ai *persistenceblobs.ActivityInfo,
+	paused bool,
+) error {
+
+	if ai.Paused == paused {
+		return nil
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.Paused = paused
+	if !paused && ai.StartedId == common.EmptyEventID {
+		// the activity task is dropped by the task processing while the activity is paused,
+		// the retry timer dispatches the activity once the backoff, if any, is over
+		if err := e.taskGenerator.generateActivityRetryTasks(
+			ai.ScheduleId,
+		); err != nil {
+			return err
+		}
+	}
+
+	e.updateActivityInfos[ai] = struct{}{}
+	e.syncActivityTasks[ai.ScheduleId] = struct{}{}
+	return nil
+}
+
+// ResetActivityAttempt resets the attempt counter of a pending activity which is not running on a worker,
+// an activity waiting for its retry backoff is dispatched right away unless it is paused
+func (e *mutableStateBuilder) ResetActivityAttempt(
+	ai *persistenceblobs.ActivityInfo,
+	resetHeartbeatDetails bool,
+) error {
+
+	if ai.StartedId != common.EmptyEventID {
+		return serviceerror.NewInvalidArgument("Activity attempt is running on a worker.")
+	}
+
+	now := e.timeSource.Now()
+	ai.Version = e.GetCurrentVersion()
+	ai.Attempt = 1
+	// the attempts count again from 1, the retry timer tasks of the previous attempts must not dispatch the activity
+	ai.Stamp++
+	if resetHeartbeatDetails {
+		ai.LastHeartbeatDetails = nil
+	}
+	if timestamp.TimeValue(ai.ScheduledTime).After(now) {
+		ai.ScheduledTime = timestamp.TimePtr(now)
+		ai.TimerTaskStatus = timerTaskStatusNone
+		if !ai.Paused {
+			if err := e.taskGenerator.generateActivityRetryTasks(
+				ai.ScheduleId,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	e.updateActivityInfos[ai] = struct{}{}
+	e.syncActivityTasks[ai.ScheduleId] = struct{}{}
+	return nil
+}
+
+// UpdateActivityOptions updates the retry policy and the timeouts of a pending activity,
+// the retry expiration time follows the updated schedule to close timeout and the update is recorded as a signal from history
+func (e *mutableStateBuilder) UpdateActivityOptions(
+	ai *persistenceblobs.ActivityInfo,
+	request *adminservice.UpdateActivityOptionsRequest,
+) error {
+
+	retryPolicy := request.GetRetryPolicy()
+	if retryPolicy != nil && !ai.HasRetryPolicy && ai.StartedId != common.EmptyEventID {
+		// the started event of an activity without retry policy is already in the history
+		return serviceerror.NewInvalidArgument("Retry policy cannot be set while the activity attempt is running on a worker.")
+	}
+
+	if timeout := request.GetScheduleToCloseTimeout(); timeout != nil {
+		if ai.HasRetryPolicy {
+			ai.RetryExpirationTime = timestamp.TimePtr(timestamp.TimeValue(ai.RetryExpirationTime).
+				Add(*timeout - timestamp.DurationValue(ai.ScheduleToCloseTimeout)))
+		}
+		ai.ScheduleToCloseTimeout = timeout
+	}
+	if timeout := request.GetScheduleToStartTimeout(); timeout != nil {
+		ai.ScheduleToStartTimeout = timeout
+	}
+	if timeout := request.GetStartToCloseTimeout(); timeout != nil {
+		ai.StartToCloseTimeout = timeout
+	}
+	if timeout := request.GetHeartbeatTimeout(); timeout != nil {
+		ai.HeartbeatTimeout = timeout
+	}
+	if retryPolicy != nil {
+		if !ai.HasRetryPolicy {
+			ai.RetryExpirationTime = timestamp.TimePtr(timestamp.TimeValue(ai.ScheduledTime).Add(timestamp.DurationValue(ai.ScheduleToCloseTimeout)))
+		}
+		ai.HasRetryPolicy = true
+		ai.RetryInitialInterval = retryPolicy.GetInitialInterval()
+		ai.RetryBackoffCoefficient = retryPolicy.GetBackoffCoefficient()
+		ai.RetryMaximumInterval = retryPolicy.GetMaximumInterval()
+		ai.RetryMaximumAttempts = retryPolicy.GetMaximumAttempts()
+		ai.RetryNonRetryableErrorTypes = retryPolicy.GetNonRetryableErrorTypes()
+	}
+
+	// timers are created again for the updated timeouts when the transaction is closed
+	ai.Version = e.GetCurrentVersion()
+	ai.TimerTaskStatus = timerTaskStatusNone
+	e.updateActivityInfos[ai] = struct{}{}
+	e.syncActivityTasks[ai.ScheduleId] = struct{}{}
+
+	input, err := payloads.Encode(request)
+	if err != nil {
+		return err
+	}
+	event := e.hBuilder.AddWorkflowExecutionSignaledEvent(common.ActivityOptionsUpdatedSignalName, input, identityHistoryService)
+	return e.ReplicateWorkflowExecutionSignaled(event)
+}
+
 // TODO mutable state should generate corresponding transfer / timer tasks according to
 //  updates accumulated, while currently all transfer / timer tasks are managed manually
 
@@ -4034,6 +4476,10 @@ func (e *mutableStateBuilder) prepareCloseTransaction(
 		}
 	}
 
+	e.closeTransactionHandleDeadlines(
+		transactionPolicy,
+	)
+
 	// TODO merge active & passive task generation
 	// NOTE: this function must be the last call
 	//  since we only generate at most one activity & user timer,
@@ -4470,6 +4916,23 @@ func (e *mutableStateBuilder) closeTransactionHandleWorkflowReset(
 	return nil
 }
 
+func (e *mutableStateBuilder) closeTransactionHandleDeadlines(
+	transactionPolicy transactionPolicy,
+) {
+
+	if transactionPolicy == transactionPolicyPassive ||
+		len(e.executionInfo.Deadlines) == 0 {
+		return
+	}
+
+	// buffered events count as well, the deadline is met once the event reaches the server
+	for _, events := range [][]*historypb.HistoryEvent{e.hBuilder.history, e.updateBufferedEvents} {
+		for _, event := range events {
+			e.SatisfyDeadlines(event)
+		}
+	}
+}
+
 func (e *mutableStateBuilder) closeTransactionHandleActivityUserTimerTasks(
 	now time.Time,
 	transactionPolicy transactionPolicy,
@@ -4486,7 +4949,13 @@ func (e *mutableStateBuilder) closeTransactionHandleActivityUserTimerTasks(
 		return err
 	}
 
-	return e.taskGenerator.generateUserTimerTasks(
+	if err := e.taskGenerator.generateUserTimerTasks(
+		e.unixNanoToTime(now.UnixNano()),
+	); err != nil {
+		return err
+	}
+
+	return e.taskGenerator.generateDeadlineTimerTasks(
 		e.unixNanoToTime(now.UnixNano()),
 	)
 }
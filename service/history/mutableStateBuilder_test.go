@@ -33,16 +33,23 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
+	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/checksum"
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
@@ -545,6 +552,307 @@ func (s *mutableStateSuite) TestEventReapplied() {
 	s.True(isReapplied)
 }
 
+func (s *mutableStateSuite) TestUpdateHistorySizeSearchAttributes() {
+	s.mockShard.config.AdvancedVisibilityWritingMode = dynamicconfig.GetStringPropertyFn(common.AdvancedVisibilityWritingModeOn)
+	now := time.Now().UTC()
+
+	newlySuggested, err := s.msBuilder.UpdateHistorySizeSearchAttributes(1024, false, now)
+	s.NoError(err)
+	s.False(newlySuggested)
+	s.Empty(s.msBuilder.insertTransferTasks)
+
+	var historySize int64
+	s.NoError(payload.Decode(s.msBuilder.GetExecutionInfo().SearchAttributes[definition.HistorySizeBytes], &historySize))
+	s.Equal(int64(1024), historySize)
+	var historyCount int64
+	s.NoError(payload.Decode(s.msBuilder.GetExecutionInfo().SearchAttributes[definition.HistoryEventCount], &historyCount))
+	s.Equal(s.msBuilder.GetNextEventID()-1, historyCount)
+
+	// the visibility record is only updated when the suggestion changes
+	newlySuggested, err = s.msBuilder.UpdateHistorySizeSearchAttributes(2048, true, now)
+	s.NoError(err)
+	s.True(newlySuggested)
+	s.Len(s.msBuilder.insertTransferTasks, 1)
+	newlySuggested, err = s.msBuilder.UpdateHistorySizeSearchAttributes(4096, true, now)
+	s.NoError(err)
+	s.False(newlySuggested)
+	s.Len(s.msBuilder.insertTransferTasks, 1)
+
+	var suggested bool
+	s.NoError(payload.Decode(s.msBuilder.GetExecutionInfo().SearchAttributes[definition.ContinueAsNewSuggested], &suggested))
+	s.True(suggested)
+}
+
+func (s *mutableStateSuite) TestSetWorkflowExecutionPaused() {
+	s.mockShard.config.AdvancedVisibilityWritingMode = dynamicconfig.GetStringPropertyFn(common.AdvancedVisibilityWritingModeOn)
+	now := time.Now().UTC()
+	s.False(s.msBuilder.IsWorkflowExecutionPaused())
+
+	err := s.msBuilder.SetWorkflowExecutionPaused(true, now)
+	s.NoError(err)
+	s.True(s.msBuilder.IsWorkflowExecutionPaused())
+	s.Len(s.msBuilder.insertTransferTasks, 1)
+	var paused bool
+	s.NoError(payload.Decode(s.msBuilder.GetExecutionInfo().SearchAttributes[definition.WorkflowPaused], &paused))
+	s.True(paused)
+
+	// pausing a paused workflow doesn't update the visibility record
+	err = s.msBuilder.SetWorkflowExecutionPaused(true, now)
+	s.NoError(err)
+	s.Len(s.msBuilder.insertTransferTasks, 1)
+
+	err = s.msBuilder.SetWorkflowExecutionPaused(false, now)
+	s.NoError(err)
+	s.False(s.msBuilder.IsWorkflowExecutionPaused())
+	s.Len(s.msBuilder.insertTransferTasks, 2)
+	s.NoError(payload.Decode(s.msBuilder.GetExecutionInfo().SearchAttributes[definition.WorkflowPaused], &paused))
+	s.False(paused)
+}
+
+func (s *mutableStateSuite) TestAddWorkflowExecutionPausedEvent() {
+	event, err := s.msBuilder.AddWorkflowExecutionPausedEvent(true, "testIdentity", "testReason")
+	s.NoError(err)
+	s.True(s.msBuilder.IsWorkflowExecutionPaused())
+	s.True(isHistoryServiceSignal(event))
+	attributes := event.GetWorkflowExecutionSignaledEventAttributes()
+	s.Equal(common.WorkflowPausedSignalName, attributes.GetSignalName())
+	var input map[string]string
+	s.NoError(payloads.Decode(attributes.GetInput(), &input))
+	s.Equal("testIdentity", input[workflowPausedIdentityKey])
+	s.Equal("testReason", input[workflowPausedReasonKey])
+
+	// the standby cluster gets the pause state from the replicated event
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionSignaled(&historypb.HistoryEvent{
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+			WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+				SignalName: common.WorkflowUnpausedSignalName,
+				Identity:   identityHistoryService,
+			},
+		},
+	}))
+	s.False(s.msBuilder.IsWorkflowExecutionPaused())
+
+	// a signal sent by a client with the same name doesn't change the pause state
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionSignaled(&historypb.HistoryEvent{
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+			WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+				SignalName: common.WorkflowPausedSignalName,
+				Identity:   "testIdentity",
+			},
+		},
+	}))
+	s.False(s.msBuilder.IsWorkflowExecutionPaused())
+}
+
+func (s *mutableStateSuite) TestUpdateActivityOptions() {
+	now := time.Now().UTC()
+	ai := &persistenceblobs.ActivityInfo{
+		ScheduleId:             5,
+		StartedId:              common.EmptyEventID,
+		ScheduledTime:          timestamp.TimePtr(now),
+		ScheduleToCloseTimeout: timestamp.DurationPtr(10 * time.Minute),
+		HasRetryPolicy:         true,
+		RetryExpirationTime:    timestamp.TimePtr(now.Add(10 * time.Minute)),
+		TimerTaskStatus:        timerTaskStatusCreatedScheduleToClose,
+	}
+
+	err := s.msBuilder.UpdateActivityOptions(ai, &adminservice.UpdateActivityOptionsRequest{
+		ScheduleToCloseTimeout: timestamp.DurationPtr(time.Hour),
+		HeartbeatTimeout:       timestamp.DurationPtr(time.Minute),
+	})
+	s.NoError(err)
+	s.Equal(time.Hour, *ai.ScheduleToCloseTimeout)
+	s.Equal(time.Minute, *ai.HeartbeatTimeout)
+	s.Equal(now.Add(time.Hour), *ai.RetryExpirationTime)
+	s.Equal(int32(timerTaskStatusNone), ai.TimerTaskStatus)
+	s.Contains(s.msBuilder.updateActivityInfos, ai)
+	events := s.msBuilder.GetHistoryBuilder().GetHistory().GetEvents()
+	s.Len(events, 1)
+	s.True(isHistoryServiceSignal(events[0]))
+	attributes := events[0].GetWorkflowExecutionSignaledEventAttributes()
+	s.Equal(common.ActivityOptionsUpdatedSignalName, attributes.GetSignalName())
+	var recorded *adminservice.UpdateActivityOptionsRequest
+	s.NoError(payloads.Decode(attributes.GetInput(), &recorded))
+	s.Equal(time.Hour, timestamp.DurationValue(recorded.GetScheduleToCloseTimeout()))
+
+	// the started event of an attempt without retry policy is already in the history
+	ai = &persistenceblobs.ActivityInfo{
+		ScheduleId:     7,
+		StartedId:      8,
+		ScheduledTime:  timestamp.TimePtr(now),
+		HasRetryPolicy: false,
+	}
+	err = s.msBuilder.UpdateActivityOptions(ai, &adminservice.UpdateActivityOptionsRequest{
+		RetryPolicy: &commonpb.RetryPolicy{
+			InitialInterval:    timestamp.DurationPtr(time.Second),
+			BackoffCoefficient: 2,
+		},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.False(ai.HasRetryPolicy)
+}
+
+func (s *mutableStateSuite) TestReplicateActivityInfo_Options() {
+	now := time.Now().UTC()
+	ai := &persistenceblobs.ActivityInfo{
+		ScheduleId:             5,
+		StartedId:              common.EmptyEventID,
+		ScheduledTime:          timestamp.TimePtr(now),
+		ScheduleToCloseTimeout: timestamp.DurationPtr(10 * time.Minute),
+		StartToCloseTimeout:    timestamp.DurationPtr(time.Minute),
+		TimerTaskStatus:        timerTaskStatusCreatedScheduleToClose,
+	}
+	s.msBuilder.pendingActivityInfoIDs[ai.ScheduleId] = ai
+
+	retryPolicy := &commonpb.RetryPolicy{
+		InitialInterval:    timestamp.DurationPtr(time.Second),
+		BackoffCoefficient: 2,
+		MaximumAttempts:    3,
+	}
+	err := s.msBuilder.ReplicateActivityInfo(&historyservice.SyncActivityRequest{
+		ScheduledId:            ai.ScheduleId,
+		ScheduledTime:          timestamp.TimePtr(now),
+		StartedId:              common.EmptyEventID,
+		Attempt:                1,
+		ScheduleToCloseTimeout: timestamp.DurationPtr(time.Hour),
+		StartToCloseTimeout:    timestamp.DurationPtr(time.Minute),
+		RetryPolicy:            retryPolicy,
+		RetryExpirationTime:    timestamp.TimePtr(now.Add(time.Hour)),
+	}, false)
+	s.NoError(err)
+	s.Equal(time.Hour, *ai.ScheduleToCloseTimeout)
+	s.True(ai.HasRetryPolicy)
+	s.Equal(int32(3), ai.RetryMaximumAttempts)
+	s.Equal(now.Add(time.Hour), *ai.RetryExpirationTime)
+	// the timers are created again for the updated timeout
+	s.Equal(int32(timerTaskStatusNone), ai.TimerTaskStatus)
+
+	// the tasks of the clusters which don't replicate the options leave them unchanged
+	ai.TimerTaskStatus = timerTaskStatusCreatedScheduleToClose
+	err = s.msBuilder.ReplicateActivityInfo(&historyservice.SyncActivityRequest{
+		ScheduledId:   ai.ScheduleId,
+		ScheduledTime: timestamp.TimePtr(now),
+		StartedId:     common.EmptyEventID,
+		Attempt:       1,
+	}, false)
+	s.NoError(err)
+	s.Equal(time.Hour, *ai.ScheduleToCloseTimeout)
+	s.Equal(time.Minute, *ai.StartToCloseTimeout)
+	s.True(ai.HasRetryPolicy)
+	s.Equal(int32(timerTaskStatusCreatedScheduleToClose), ai.TimerTaskStatus)
+}
+
+func (s *mutableStateSuite) TestDeadlineRules() {
+	defaultRule := &workflowspb.DeadlineRule{
+		Name:      "approval",
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Timeout:   timestamp.DurationPtr(time.Hour),
+		Action:    enumsspb.DEADLINE_ACTION_TERMINATE,
+	}
+	runRule := &workflowspb.DeadlineRule{
+		Name:      "review",
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+		Timeout:   timestamp.DurationPtr(time.Minute),
+		Action:    enumsspb.DEADLINE_ACTION_FLAG,
+	}
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Id: testNamespaceID, Name: testNamespace},
+		&persistenceblobs.NamespaceConfig{Retention: timestamp.DurationFromDays(1), DeadlineRules: []*workflowspb.DeadlineRule{defaultRule}},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+	startRequest := func(rules *workflowspb.DeadlineRules) *historyservice.StartWorkflowExecutionRequest {
+		return &historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: testNamespaceID,
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:        &commonpb.WorkflowType{Name: "wType"},
+				TaskQueue:           &taskqueuepb.TaskQueue{Name: "testTaskQueue"},
+				WorkflowRunTimeout:  timestamp.DurationPtr(time.Hour),
+				WorkflowTaskTimeout: timestamp.DurationPtr(time.Second),
+			},
+			DeadlineRules: rules,
+		}
+	}
+	newRun := func(namespaceEntry *cache.NamespaceCacheEntry) (*mutableStateBuilder, commonpb.WorkflowExecution) {
+		execution := commonpb.WorkflowExecution{WorkflowId: "wId", RunId: uuid.New()}
+		msBuilder := newMutableStateBuilderWithVersionHistories(s.mockShard, s.mockEventsCache, s.logger, namespaceEntry)
+		s.NoError(msBuilder.SetHistoryTree(execution.GetRunId()))
+		return msBuilder, execution
+	}
+	s.mockEventsCache.EXPECT().putEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	// the defaults of the namespace are used if the run sets no rules
+	msBuilder, execution := newRun(namespaceEntry)
+	startEvent, err := msBuilder.AddWorkflowExecutionStartedEvent(execution, startRequest(nil))
+	s.NoError(err)
+	s.Nil(startEvent.GetWorkflowExecutionStartedEventAttributes().GetHeader())
+	s.Equal([]*workflowspb.DeadlineRule{defaultRule}, msBuilder.GetExecutionInfo().DeadlineRules)
+	s.Len(msBuilder.GetPendingDeadlines(), 1)
+
+	// an empty list of rules clears the defaults
+	msBuilder, execution = newRun(namespaceEntry)
+	_, err = msBuilder.AddWorkflowExecutionStartedEvent(execution, startRequest(&workflowspb.DeadlineRules{}))
+	s.NoError(err)
+	s.Empty(msBuilder.GetExecutionInfo().DeadlineRules)
+	s.Empty(msBuilder.GetPendingDeadlines())
+
+	msBuilder, execution = newRun(namespaceEntry)
+	startEvent, err = msBuilder.AddWorkflowExecutionStartedEvent(execution, startRequest(&workflowspb.DeadlineRules{Rules: []*workflowspb.DeadlineRule{runRule}}))
+	s.NoError(err)
+	s.Equal([]*workflowspb.DeadlineRule{runRule}, msBuilder.GetExecutionInfo().DeadlineRules)
+
+	// a standby cluster gets the rules replicated with the started event rather than from the namespace
+	replicated, replicatedExecution := newRun(testLocalNamespaceEntry)
+	replicated.GetExecutionInfo().DeadlineRules = []*workflowspb.DeadlineRule{runRule}
+	s.NoError(replicated.ReplicateWorkflowExecutionStartedEvent("", replicatedExecution, uuid.New(), startEvent))
+	s.Equal([]*workflowspb.DeadlineRule{runRule}, replicated.GetExecutionInfo().DeadlineRules)
+	s.Len(replicated.GetPendingDeadlines(), 1)
+
+	// a run started by continue as new keeps the rules of the previous run
+	newRunBuilder, newExecution := newRun(namespaceEntry)
+	newStartEvent, err := newRunBuilder.addWorkflowExecutionStartedEventForContinueAsNew(
+		nil,
+		newExecution,
+		msBuilder,
+		&commandpb.ContinueAsNewWorkflowExecutionCommandAttributes{
+			WorkflowRunTimeout: timestamp.DurationPtr(time.Hour),
+		},
+		execution.GetRunId(),
+	)
+	s.NoError(err)
+	s.Nil(newStartEvent.GetWorkflowExecutionStartedEventAttributes().GetHeader())
+	s.Equal([]*workflowspb.DeadlineRule{runRule}, newRunBuilder.GetExecutionInfo().DeadlineRules)
+}
+
+func (s *mutableStateSuite) TestAddDeadlineExceededEvent() {
+	rule := &workflowspb.DeadlineRule{
+		Name:      "review",
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+		Timeout:   timestamp.DurationPtr(time.Minute),
+		Action:    enumsspb.DEADLINE_ACTION_FLAG,
+	}
+	s.msBuilder.GetExecutionInfo().Deadlines = []*persistenceblobs.DeadlineInfo{{
+		Rule:       rule,
+		ExpiryTime: timestamp.TimePtr(time.Now().UTC()),
+	}}
+
+	event, err := s.msBuilder.AddDeadlineExceededEvent(rule.GetName())
+	s.NoError(err)
+	attributes := event.GetWorkflowExecutionSignaledEventAttributes()
+	s.Equal(common.DeadlineExceededSignalName, attributes.GetSignalName())
+	s.Equal(identityHistoryService, attributes.GetIdentity())
+	var exceeded []string
+	s.NoError(payload.Decode(s.msBuilder.GetExecutionInfo().SearchAttributes[definition.DeadlinesExceeded], &exceeded))
+	s.Equal([]string{rule.GetName()}, exceeded)
+
+	// a standby cluster resolves the deadline with the replicated event
+	s.msBuilder.SatisfyDeadlines(event)
+	s.Empty(s.msBuilder.GetPendingDeadlines())
+}
+
 func (s *mutableStateSuite) prepareTransientWorkflowTaskCompletionFirstBatchReplicated(version int64, runID string) (*historypb.HistoryEvent, *historypb.HistoryEvent) {
 	namespaceID := testNamespaceID
 	execution := commonpb.WorkflowExecution{
@@ -100,6 +100,9 @@ type (
 		generateUserTimerTasks(
 			now time.Time,
 		) error
+		generateDeadlineTimerTasks(
+			now time.Time,
+		) error
 	}
 
 	mutableStateTaskGeneratorImpl struct {
@@ -358,6 +361,7 @@ func (r *mutableStateTaskGeneratorImpl) generateActivityRetryTasks(
 		VisibilityTimestamp: *ai.ScheduledTime,
 		EventID:             ai.ScheduleId,
 		Attempt:             ai.Attempt,
+		Stamp:               ai.Stamp,
 	})
 	return nil
 }
@@ -513,6 +517,14 @@ func (r *mutableStateTaskGeneratorImpl) generateUserTimerTasks(
 	return err
 }
 
+func (r *mutableStateTaskGeneratorImpl) generateDeadlineTimerTasks(
+	now time.Time,
+) error {
+
+	_, err := r.getTimerSequence(now).createNextDeadlineTimer()
+	return err
+}
+
 func (r *mutableStateTaskGeneratorImpl) getTimerSequence(now time.Time) timerSequence {
 	timeSource := clock.NewEventTimeSource()
 	timeSource.Update(now)
@@ -268,3 +268,17 @@ func (mr *MockmutableStateTaskGeneratorMockRecorder) generateUserTimerTasks(now
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "generateUserTimerTasks", reflect.TypeOf((*MockmutableStateTaskGenerator)(nil).generateUserTimerTasks), now)
 }
+
+// generateDeadlineTimerTasks mocks base method.
+func (m *MockmutableStateTaskGenerator) generateDeadlineTimerTasks(now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "generateDeadlineTimerTasks", now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// generateDeadlineTimerTasks indicates an expected call of generateDeadlineTimerTasks.
+func (mr *MockmutableStateTaskGeneratorMockRecorder) generateDeadlineTimerTasks(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "generateDeadlineTimerTasks", reflect.TypeOf((*MockmutableStateTaskGenerator)(nil).generateDeadlineTimerTasks), now)
+}
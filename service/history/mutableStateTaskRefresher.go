@@ -129,6 +129,13 @@ func (r *mutableStateTaskRefresherImpl) refreshTasks(
 		return err
 	}
 
+	if err := r.refreshTasksForDeadline(
+		now,
+		mutableState,
+	); err != nil {
+		return err
+	}
+
 	if err := r.refreshTasksForChildWorkflow(
 		now,
 		mutableState,
@@ -358,6 +365,26 @@ func (r *mutableStateTaskRefresherImpl) refreshTasksForTimer(
 	return nil
 }
 
+func (r *mutableStateTaskRefresherImpl) refreshTasksForDeadline(
+	now time.Time,
+	mutableState mutableState,
+) error {
+
+	for _, deadline := range mutableState.GetPendingDeadlines() {
+		// clear all deadline timer mask for later deadline timer task re-generation
+		deadline.TimerCreated = false
+	}
+
+	if _, err := newTimerSequence(
+		r.getTimeSource(now),
+		mutableState,
+	).createNextDeadlineTimer(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (r *mutableStateTaskRefresherImpl) refreshTasksForChildWorkflow(
 	now time.Time,
 	mutableState mutableState,
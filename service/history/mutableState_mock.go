@@ -40,6 +40,7 @@ import (
 	history "go.temporal.io/api/history/v1"
 	taskqueue "go.temporal.io/api/taskqueue/v1"
 	workflowservice "go.temporal.io/api/workflowservice/v1"
+	adminservice "go.temporal.io/server/api/adminservice/v1"
 	enums0 "go.temporal.io/server/api/enums/v1"
 	historyservice "go.temporal.io/server/api/historyservice/v1"
 	persistenceblobs "go.temporal.io/server/api/persistenceblobs/v1"
@@ -705,6 +706,21 @@ func (mr *MockmutableStateMockRecorder) AddWorkflowExecutionSignaled(signalName,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionSignaled", reflect.TypeOf((*MockmutableState)(nil).AddWorkflowExecutionSignaled), signalName, input, identity)
 }
 
+// AddWorkflowExecutionPausedEvent mocks base method.
+func (m *MockmutableState) AddWorkflowExecutionPausedEvent(paused bool, identity, reason string) (*history.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionPausedEvent", paused, identity, reason)
+	ret0, _ := ret[0].(*history.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionPausedEvent indicates an expected call of AddWorkflowExecutionPausedEvent.
+func (mr *MockmutableStateMockRecorder) AddWorkflowExecutionPausedEvent(paused, identity, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionPausedEvent", reflect.TypeOf((*MockmutableState)(nil).AddWorkflowExecutionPausedEvent), paused, identity, reason)
+}
+
 // AddWorkflowExecutionStartedEvent mocks base method.
 func (m *MockmutableState) AddWorkflowExecutionStartedEvent(arg0 common.WorkflowExecution, arg1 *historyservice.StartWorkflowExecutionRequest) (*history.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -790,6 +806,48 @@ func (mr *MockmutableStateMockRecorder) RetryActivity(ai, failure interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryActivity", reflect.TypeOf((*MockmutableState)(nil).RetryActivity), ai, failure)
 }
 
+// ResetActivityAttempt mocks base method.
+func (m *MockmutableState) ResetActivityAttempt(ai *persistenceblobs.ActivityInfo, resetHeartbeatDetails bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivityAttempt", ai, resetHeartbeatDetails)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivityAttempt indicates an expected call of ResetActivityAttempt.
+func (mr *MockmutableStateMockRecorder) ResetActivityAttempt(ai, resetHeartbeatDetails interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityAttempt", reflect.TypeOf((*MockmutableState)(nil).ResetActivityAttempt), ai, resetHeartbeatDetails)
+}
+
+// SetActivityPaused mocks base method.
+func (m *MockmutableState) SetActivityPaused(ai *persistenceblobs.ActivityInfo, paused bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetActivityPaused", ai, paused)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetActivityPaused indicates an expected call of SetActivityPaused.
+func (mr *MockmutableStateMockRecorder) SetActivityPaused(ai, paused interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetActivityPaused", reflect.TypeOf((*MockmutableState)(nil).SetActivityPaused), ai, paused)
+}
+
+// UpdateActivityOptions mocks base method.
+func (m *MockmutableState) UpdateActivityOptions(ai *persistenceblobs.ActivityInfo, request *adminservice.UpdateActivityOptionsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", ai, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockmutableStateMockRecorder) UpdateActivityOptions(ai, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockmutableState)(nil).UpdateActivityOptions), ai, request)
+}
+
 // CreateNewHistoryEvent mocks base method.
 func (m *MockmutableState) CreateNewHistoryEvent(eventType enums.EventType) *history.HistoryEvent {
 	m.ctrl.T.Helper()
@@ -1219,6 +1277,20 @@ func (mr *MockmutableStateMockRecorder) GetPendingTimerInfos() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTimerInfos", reflect.TypeOf((*MockmutableState)(nil).GetPendingTimerInfos))
 }
 
+// GetPendingDeadlines mocks base method.
+func (m *MockmutableState) GetPendingDeadlines() []*persistenceblobs.DeadlineInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingDeadlines")
+	ret0, _ := ret[0].([]*persistenceblobs.DeadlineInfo)
+	return ret0
+}
+
+// GetPendingDeadlines indicates an expected call of GetPendingDeadlines.
+func (mr *MockmutableStateMockRecorder) GetPendingDeadlines() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingDeadlines", reflect.TypeOf((*MockmutableState)(nil).GetPendingDeadlines))
+}
+
 // GetPendingChildExecutionInfos mocks base method.
 func (m *MockmutableState) GetPendingChildExecutionInfos() map[int64]*persistenceblobs.ChildExecutionInfo {
 	m.ctrl.T.Helper()
@@ -1550,6 +1622,20 @@ func (mr *MockmutableStateMockRecorder) IsWorkflowExecutionRunning() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionRunning", reflect.TypeOf((*MockmutableState)(nil).IsWorkflowExecutionRunning))
 }
 
+// IsWorkflowExecutionPaused mocks base method.
+func (m *MockmutableState) IsWorkflowExecutionPaused() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorkflowExecutionPaused")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsWorkflowExecutionPaused indicates an expected call of IsWorkflowExecutionPaused.
+func (mr *MockmutableStateMockRecorder) IsWorkflowExecutionPaused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionPaused", reflect.TypeOf((*MockmutableState)(nil).IsWorkflowExecutionPaused))
+}
+
 // IsResourceDuplicated mocks base method.
 func (m *MockmutableState) IsResourceDuplicated(resourceDedupKey definition.DeduplicationID) bool {
 	m.ctrl.T.Helper()
@@ -2302,6 +2388,21 @@ func (mr *MockmutableStateMockRecorder) UpdateCurrentVersion(version, forceUpdat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCurrentVersion", reflect.TypeOf((*MockmutableState)(nil).UpdateCurrentVersion), version, forceUpdate)
 }
 
+// UpdateHistorySizeSearchAttributes mocks base method.
+func (m *MockmutableState) UpdateHistorySizeSearchAttributes(historySize int64, suggestContinueAsNew bool, now time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHistorySizeSearchAttributes", historySize, suggestContinueAsNew, now)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHistorySizeSearchAttributes indicates an expected call of UpdateHistorySizeSearchAttributes.
+func (mr *MockmutableStateMockRecorder) UpdateHistorySizeSearchAttributes(historySize, suggestContinueAsNew, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHistorySizeSearchAttributes", reflect.TypeOf((*MockmutableState)(nil).UpdateHistorySizeSearchAttributes), historySize, suggestContinueAsNew, now)
+}
+
 // UpdateWorkflowStateStatus mocks base method.
 func (m *MockmutableState) UpdateWorkflowStateStatus(state enums0.WorkflowExecutionState, status enums.WorkflowExecutionStatus) error {
 	m.ctrl.T.Helper()
@@ -2316,6 +2417,59 @@ func (mr *MockmutableStateMockRecorder) UpdateWorkflowStateStatus(state, status
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowStateStatus", reflect.TypeOf((*MockmutableState)(nil).UpdateWorkflowStateStatus), state, status)
 }
 
+// DeleteDeadline mocks base method.
+func (m *MockmutableState) DeleteDeadline(name string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteDeadline", name)
+}
+
+// DeleteDeadline indicates an expected call of DeleteDeadline.
+func (mr *MockmutableStateMockRecorder) DeleteDeadline(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeadline", reflect.TypeOf((*MockmutableState)(nil).DeleteDeadline), name)
+}
+
+// SatisfyDeadlines mocks base method.
+func (m *MockmutableState) SatisfyDeadlines(event *history.HistoryEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SatisfyDeadlines", event)
+}
+
+// SatisfyDeadlines indicates an expected call of SatisfyDeadlines.
+func (mr *MockmutableStateMockRecorder) SatisfyDeadlines(event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SatisfyDeadlines", reflect.TypeOf((*MockmutableState)(nil).SatisfyDeadlines), event)
+}
+
+// SetDeadlineExceeded mocks base method.
+func (m *MockmutableState) SetDeadlineExceeded(name string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDeadlineExceeded", name, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDeadlineExceeded indicates an expected call of SetDeadlineExceeded.
+func (mr *MockmutableStateMockRecorder) SetDeadlineExceeded(name, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeadlineExceeded", reflect.TypeOf((*MockmutableState)(nil).SetDeadlineExceeded), name, now)
+}
+
+// AddDeadlineExceededEvent mocks base method.
+func (m *MockmutableState) AddDeadlineExceededEvent(name string) (*history.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDeadlineExceededEvent", name)
+	ret0, _ := ret[0].(*history.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDeadlineExceededEvent indicates an expected call of AddDeadlineExceededEvent.
+func (mr *MockmutableStateMockRecorder) AddDeadlineExceededEvent(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeadlineExceededEvent", reflect.TypeOf((*MockmutableState)(nil).AddDeadlineExceededEvent), name)
+}
+
 // AddTransferTasks mocks base method.
 func (m *MockmutableState) AddTransferTasks(transferTasks ...persistence.Task) {
 	m.ctrl.T.Helper()
@@ -145,7 +145,12 @@ func (r *nDCActivityReplicatorImpl) SyncActivity(
 		return nil
 	}
 
-	if ai.Version == request.GetVersion() {
+	if ai.Version == request.GetVersion() && ai.Stamp > request.GetStamp() {
+		// this should not retry, can be caused by out of order delivery of an attempt reset
+		return nil
+	}
+
+	if ai.Version == request.GetVersion() && ai.Stamp == request.GetStamp() {
 		if ai.Attempt > request.GetAttempt() {
 			// this should not retry, can be caused by failover or reset
 			return nil
@@ -161,6 +166,7 @@ func (r *nDCActivityReplicatorImpl) SyncActivity(
 		}
 		// version equal & attempt larger then existing, should update activity
 	}
+	// version equal & stamp larger then existing, the attempt was reset, should update activity
 	// version larger then existing, should update activity
 
 	// calculate whether to reset the activity timer task status bits
@@ -170,7 +176,7 @@ func (r *nDCActivityReplicatorImpl) SyncActivity(
 	resetActivityTimerTaskStatus := false
 	if !r.clusterMetadata.IsVersionFromSameCluster(request.GetVersion(), ai.Version) {
 		resetActivityTimerTaskStatus = true
-	} else if ai.Attempt < request.GetAttempt() {
+	} else if ai.Attempt != request.GetAttempt() || ai.Stamp != request.GetStamp() {
 		resetActivityTimerTaskStatus = true
 	}
 	err = mutableState.ReplicateActivityInfo(request, resetActivityTimerTaskStatus)
@@ -594,6 +594,89 @@ func (s *activityReplicatorSuite) TestSyncActivity_VersionHistories_SameSchedule
 	s.Nil(err)
 }
 
+func (s *activityReplicatorSuite) TestSyncActivity_VersionHistories_SmallerStamp_DiscardTask() {
+	namespace := "some random namespace name"
+	namespaceID := testNamespaceID
+	workflowID := "some random workflow ID"
+	runID := uuid.New()
+	scheduleID := int64(99)
+	version := int64(100)
+
+	lastWriteVersion := version - 100
+	incomingVersionHistory := persistence.VersionHistory{
+		BranchToken: []byte{},
+		Items: []*persistence.VersionHistoryItem{
+			{
+				EventID: 50,
+				Version: 2,
+			},
+			{
+				EventID: scheduleID,
+				Version: version,
+			},
+		},
+	}
+	key := definition.NewWorkflowIdentifier(namespaceID, workflowID, runID)
+	weContext := NewMockworkflowExecutionContext(s.controller)
+	weContext.EXPECT().loadWorkflowExecution().Return(s.mockMutableState, nil).Times(1)
+	weContext.EXPECT().lock(gomock.Any()).Return(nil)
+	weContext.EXPECT().unlock().Times(1)
+	weContext.EXPECT().clear().AnyTimes()
+	_, err := s.historyCache.PutIfNotExist(key, weContext)
+	s.NoError(err)
+
+	request := &historyservice.SyncActivityRequest{
+		NamespaceId:    namespaceID,
+		WorkflowId:     workflowID,
+		RunId:          runID,
+		Version:        version,
+		ScheduledId:    scheduleID,
+		VersionHistory: incomingVersionHistory.ToProto(),
+		Attempt:        5,
+		Stamp:          1,
+	}
+	localVersionHistories := &persistence.VersionHistories{
+		CurrentVersionHistoryIndex: 0,
+		Histories: []*persistence.VersionHistory{
+			{
+				BranchToken: []byte{},
+				Items: []*persistence.VersionHistoryItem{
+					{
+						EventID: scheduleID,
+						Version: version,
+					},
+				},
+			},
+		},
+	}
+	s.mockMutableState.EXPECT().GetVersionHistories().Return(localVersionHistories).AnyTimes()
+	// the attempt was reset after the incoming activity info was sent
+	s.mockMutableState.EXPECT().GetActivityInfo(scheduleID).Return(&persistenceblobs.ActivityInfo{
+		Version: version,
+		Attempt: 1,
+		Stamp:   2,
+	}, true).AnyTimes()
+	s.mockMutableState.EXPECT().GetWorkflowStateStatus().Return(enumsspb.WORKFLOW_EXECUTION_STATE_CREATED, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(namespaceID).Return(
+		cache.NewGlobalNamespaceCacheEntryForTest(
+			&persistenceblobs.NamespaceInfo{Id: namespaceID, Name: namespace},
+			&persistenceblobs.NamespaceConfig{Retention: timestamp.DurationFromDays(1)},
+			&persistenceblobs.NamespaceReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []string{
+					cluster.TestCurrentClusterName,
+					cluster.TestAlternativeClusterName,
+				},
+			},
+			lastWriteVersion,
+			nil,
+		), nil,
+	).AnyTimes()
+
+	err = s.nDCActivityReplicator.SyncActivity(context.Background(), request)
+	s.Nil(err)
+}
+
 func (s *activityReplicatorSuite) TestSyncActivity_VersionHistories_LocalVersionHistoryWin() {
 	namespace := "some random namespace name"
 	namespaceID := testNamespaceID
@@ -76,6 +76,9 @@ func (r *nDCEventsReapplierImpl) reapplyEvents(
 	for _, event := range historyEvents {
 		switch event.GetEventType() {
 		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
+			if isHistoryServiceSignal(event) {
+				continue
+			}
 			dedupResource := definition.NewEventReappliedID(runID, event.GetEventId(), event.GetVersion())
 			if msBuilder.IsResourceDuplicated(dedupResource) {
 				// skip already applied event
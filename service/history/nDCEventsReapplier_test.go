@@ -37,6 +37,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log/loggerimpl"
 	"go.temporal.io/server/common/metrics"
@@ -138,6 +139,28 @@ func (s *nDCEventReapplicationSuite) TestReapplyEvents_Noop() {
 	s.Equal(0, len(appliedEvent))
 }
 
+func (s *nDCEventReapplicationSuite) TestReapplyEvents_ContinueAsNewSuggestedSignal() {
+	runID := uuid.New()
+	event := &historypb.HistoryEvent{
+		EventId:   1,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+			Identity:   identityHistoryService,
+			SignalName: common.ContinueAsNewSuggestedSignalName,
+			Input:      payloads.EncodeBytes([]byte{}),
+		}},
+	}
+
+	msBuilderCurrent := NewMockmutableState(s.controller)
+	events := []*historypb.HistoryEvent{
+		{EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+		event,
+	}
+	appliedEvent, err := s.nDCReapplication.reapplyEvents(context.Background(), msBuilderCurrent, events, runID)
+	s.NoError(err)
+	s.Equal(0, len(appliedEvent))
+}
+
 func (s *nDCEventReapplicationSuite) TestReapplyEvents_PartialAppliedEvent() {
 	runID := uuid.New()
 	execution := &persistence.WorkflowExecutionInfo{
@@ -308,6 +308,8 @@ func (r *nDCHistoryReplicatorImpl) applyStartEvents(
 	}
 	requestID := uuid.New() // requestID used for start workflow execution request.  This is not on the history event.
 	mutableState := r.newMutableState(namespaceEntry, task.getLogger())
+	// the deadline rules are not part of the started event, they are set before the event is applied
+	mutableState.GetExecutionInfo().DeadlineRules = task.getDeadlineRules()
 	stateBuilder := r.newStateBuilder(mutableState, task.getLogger())
 
 	// use state builder for workflow mutable state mutation
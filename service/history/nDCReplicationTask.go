@@ -35,6 +35,7 @@ import (
 
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -58,6 +59,7 @@ type (
 		getNewEvents() []*historypb.HistoryEvent
 		getLogger() log.Logger
 		getVersionHistory() *persistence.VersionHistory
+		getDeadlineRules() []*workflowspb.DeadlineRule
 		isWorkflowReset() bool
 
 		splitTask(taskStartTime time.Time) (nDCReplicationTask, nDCReplicationTask, error)
@@ -74,6 +76,7 @@ type (
 		events         []*historypb.HistoryEvent
 		newEvents      []*historypb.HistoryEvent
 		versionHistory *persistence.VersionHistory
+		deadlineRules  []*workflowspb.DeadlineRule
 
 		startTime time.Time
 		logger    log.Logger
@@ -158,6 +161,7 @@ func newNDCReplicationTask(
 		events:         events,
 		newEvents:      newEvents,
 		versionHistory: persistence.NewVersionHistoryFromProto(versionHistory),
+		deadlineRules:  request.GetDeadlineRules().GetRules(),
 
 		startTime: taskStartTime,
 		logger:    logger,
@@ -216,6 +220,10 @@ func (t *nDCReplicationTaskImpl) getVersionHistory() *persistence.VersionHistory
 	return t.versionHistory
 }
 
+func (t *nDCReplicationTaskImpl) getDeadlineRules() []*workflowspb.DeadlineRule {
+	return t.deadlineRules
+}
+
 func (t *nDCReplicationTaskImpl) isWorkflowReset() bool {
 	switch t.getFirstEvent().GetEventType() {
 	case enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED:
@@ -288,6 +296,8 @@ func (t *nDCReplicationTaskImpl) splitTask(
 		events:         newHistoryEvents,
 		newEvents:      []*historypb.HistoryEvent{},
 		versionHistory: newVersionHistory,
+		// the new run keeps the deadline rules of the run
+		deadlineRules: t.deadlineRules,
 
 		startTime: taskStartTime,
 		logger:    logger,
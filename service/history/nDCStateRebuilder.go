@@ -35,6 +35,7 @@ import (
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/cluster"
@@ -132,6 +133,12 @@ func (r *nDCStateRebuilderImpl) rebuild(
 	rebuiltMutableState, stateBuilder := r.initializeBuilders(
 		namespaceEntry,
 	)
+	// the deadline rules are not part of the started event, the rebuilt run keeps the rules of the base run
+	deadlineRules, err := r.getDeadlineRules(baseWorkflowIdentifier)
+	if err != nil {
+		return nil, 0, err
+	}
+	rebuiltMutableState.GetExecutionInfo().DeadlineRules = deadlineRules
 	if err := r.applyEvents(targetWorkflowIdentifier, stateBuilder, firstEventBatch, requestID); err != nil {
 		return nil, 0, err
 	}
@@ -202,6 +209,24 @@ func (r *nDCStateRebuilderImpl) initializeBuilders(
 	return resetMutableStateBuilder, stateBuilder
 }
 
+func (r *nDCStateRebuilderImpl) getDeadlineRules(
+	workflowIdentifier definition.WorkflowIdentifier,
+) ([]*workflowspb.DeadlineRule, error) {
+
+	// the deadline rules of a run never change, no need to lock the run
+	response, err := r.shard.GetExecutionManager().GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		NamespaceID: workflowIdentifier.NamespaceID,
+		Execution: commonpb.WorkflowExecution{
+			WorkflowId: workflowIdentifier.WorkflowID,
+			RunId:      workflowIdentifier.RunID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.State.ExecutionInfo.DeadlineRules, nil
+}
+
 func (r *nDCStateRebuilderImpl) applyEvents(
 	workflowIdentifier definition.WorkflowIdentifier,
 	stateBuilder stateBuilder,
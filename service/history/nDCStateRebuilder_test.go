@@ -38,7 +38,9 @@ import (
 	historypb "go.temporal.io/api/history/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/cluster"
@@ -319,6 +321,21 @@ func (s *nDCStateRebuilderSuite) TestRebuild() {
 		s.mockClusterMetadata,
 	), nil).AnyTimes()
 	s.mockTaskRefresher.EXPECT().refreshTasks(*now, gomock.Any()).Return(nil).Times(1)
+	deadlineRules := []*workflowspb.DeadlineRule{{
+		Name:      "approval",
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+		Timeout:   timestamp.DurationPtr(time.Hour),
+		Action:    enumsspb.DEADLINE_ACTION_FLAG,
+	}}
+	s.mockShard.resource.ExecutionMgr.On("GetWorkflowExecution", &persistence.GetWorkflowExecutionRequest{
+		NamespaceID: s.namespaceID,
+		Execution: commonpb.WorkflowExecution{
+			WorkflowId: s.workflowID,
+			RunId:      s.runID,
+		},
+	}).Return(&persistence.GetWorkflowExecutionResponse{State: &persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{DeadlineRules: deadlineRules},
+	}}, nil).Once()
 
 	rebuildMutableState, rebuiltHistorySize, err := s.nDCStateRebuilder.rebuild(
 		context.Background(),
@@ -337,6 +354,8 @@ func (s *nDCStateRebuilderSuite) TestRebuild() {
 	s.Equal(targetNamespaceID, rebuildExecutionInfo.NamespaceId)
 	s.Equal(targetWorkflowID, rebuildExecutionInfo.WorkflowId)
 	s.Equal(targetRunID, rebuildExecutionInfo.ExecutionState.RunId)
+	s.Equal(deadlineRules, rebuildExecutionInfo.DeadlineRules)
+	s.Len(rebuildMutableState.GetPendingDeadlines(), 1)
 	s.Equal(int64(historySize1+historySize2), rebuiltHistorySize)
 	s.Equal(persistence.NewVersionHistories(
 		persistence.NewVersionHistory(
@@ -340,3 +340,63 @@ func (h *NilCheckHandler) RefreshWorkflowTasks(ctx context.Context, request *his
 	}
 	return resp, err
 }
+
+func (h *NilCheckHandler) RestoreWorkflowExecution(ctx context.Context, request *historyservice.RestoreWorkflowExecutionRequest) (*historyservice.RestoreWorkflowExecutionResponse, error) {
+	resp, err := h.parentHandler.RestoreWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.RestoreWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) PauseWorkflowExecution(ctx context.Context, request *historyservice.PauseWorkflowExecutionRequest) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	resp, err := h.parentHandler.PauseWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.PauseWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	resp, err := h.parentHandler.UnpauseWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.UnpauseWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) PauseActivity(ctx context.Context, request *historyservice.PauseActivityRequest) (*historyservice.PauseActivityResponse, error) {
+	resp, err := h.parentHandler.PauseActivity(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.PauseActivityResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) UnpauseActivity(ctx context.Context, request *historyservice.UnpauseActivityRequest) (*historyservice.UnpauseActivityResponse, error) {
+	resp, err := h.parentHandler.UnpauseActivity(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.UnpauseActivityResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) ResetActivity(ctx context.Context, request *historyservice.ResetActivityRequest) (*historyservice.ResetActivityResponse, error) {
+	resp, err := h.parentHandler.ResetActivity(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.ResetActivityResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) UpdateActivityOptions(ctx context.Context, request *historyservice.UpdateActivityOptionsRequest) (*historyservice.UpdateActivityOptionsResponse, error) {
+	resp, err := h.parentHandler.UpdateActivityOptions(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.UpdateActivityOptionsResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) StreamWorkflowExecutionHistory(request *historyservice.StreamWorkflowExecutionHistoryRequest, server historyservice.HistoryService_StreamWorkflowExecutionHistoryServer) error {
+	return h.parentHandler.StreamWorkflowExecutionHistory(request, server)
+}
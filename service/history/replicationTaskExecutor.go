@@ -126,20 +126,28 @@ func (e *replicationTaskExecutorImpl) handleActivityTask(
 	replicationStopWatch := e.metricsClient.StartTimer(metrics.SyncActivityTaskScope, metrics.ServiceLatency)
 	defer replicationStopWatch.Stop()
 	request := &historyservice.SyncActivityRequest{
-		NamespaceId:        attr.NamespaceId,
-		WorkflowId:         attr.WorkflowId,
-		RunId:              attr.RunId,
-		Version:            attr.Version,
-		ScheduledId:        attr.ScheduledId,
-		ScheduledTime:      attr.ScheduledTime,
-		StartedId:          attr.StartedId,
-		StartedTime:        attr.StartedTime,
-		LastHeartbeatTime:  attr.LastHeartbeatTime,
-		Details:            attr.Details,
-		Attempt:            attr.Attempt,
-		LastFailure:        attr.LastFailure,
-		LastWorkerIdentity: attr.LastWorkerIdentity,
-		VersionHistory:     attr.GetVersionHistory(),
+		NamespaceId:            attr.NamespaceId,
+		WorkflowId:             attr.WorkflowId,
+		RunId:                  attr.RunId,
+		Version:                attr.Version,
+		ScheduledId:            attr.ScheduledId,
+		ScheduledTime:          attr.ScheduledTime,
+		StartedId:              attr.StartedId,
+		StartedTime:            attr.StartedTime,
+		LastHeartbeatTime:      attr.LastHeartbeatTime,
+		Details:                attr.Details,
+		Attempt:                attr.Attempt,
+		LastFailure:            attr.LastFailure,
+		LastWorkerIdentity:     attr.LastWorkerIdentity,
+		VersionHistory:         attr.GetVersionHistory(),
+		Paused:                 attr.GetPaused(),
+		Stamp:                  attr.GetStamp(),
+		ScheduleToCloseTimeout: attr.GetScheduleToCloseTimeout(),
+		ScheduleToStartTimeout: attr.GetScheduleToStartTimeout(),
+		StartToCloseTimeout:    attr.GetStartToCloseTimeout(),
+		HeartbeatTimeout:       attr.GetHeartbeatTimeout(),
+		RetryPolicy:            attr.GetRetryPolicy(),
+		RetryExpirationTime:    attr.GetRetryExpirationTime(),
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
 	defer cancel()
@@ -194,7 +202,8 @@ func (e *replicationTaskExecutorImpl) handleHistoryReplicationTaskV2(
 		VersionHistoryItems: attr.VersionHistoryItems,
 		Events:              attr.Events,
 		// new run events does not need version history since there is no prior events
-		NewRunEvents: attr.NewRunEvents,
+		NewRunEvents:  attr.NewRunEvents,
+		DeadlineRules: attr.DeadlineRules,
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
 	defer cancel()
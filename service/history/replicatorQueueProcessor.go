@@ -37,10 +37,12 @@ import (
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/largepayload"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/messaging"
@@ -573,24 +575,44 @@ func (p *replicatorQueueProcessorImpl) generateSyncActivityTask(
 				versionHistory = rawVersionHistory.ToProto()
 			}
 
+			// the timeouts and retry policy may be updated while the activity is pending
+			var retryPolicy *commonpb.RetryPolicy
+			if activityInfo.HasRetryPolicy {
+				retryPolicy = &commonpb.RetryPolicy{
+					InitialInterval:        activityInfo.RetryInitialInterval,
+					BackoffCoefficient:     activityInfo.RetryBackoffCoefficient,
+					MaximumInterval:        activityInfo.RetryMaximumInterval,
+					MaximumAttempts:        activityInfo.RetryMaximumAttempts,
+					NonRetryableErrorTypes: activityInfo.RetryNonRetryableErrorTypes,
+				}
+			}
+
 			return &replicationspb.ReplicationTask{
 				TaskType: enumsspb.REPLICATION_TASK_TYPE_SYNC_ACTIVITY_TASK,
 				Attributes: &replicationspb.ReplicationTask_SyncActivityTaskAttributes{
 					SyncActivityTaskAttributes: &replicationspb.SyncActivityTaskAttributes{
-						NamespaceId:        namespaceID,
-						WorkflowId:         taskInfo.GetWorkflowId(),
-						RunId:              runID,
-						Version:            activityInfo.Version,
-						ScheduledId:        activityInfo.ScheduleId,
-						ScheduledTime:      scheduledTime,
-						StartedId:          activityInfo.StartedId,
-						StartedTime:        startedTime,
-						LastHeartbeatTime:  heartbeatTime,
-						Details:            activityInfo.LastHeartbeatDetails,
-						Attempt:            activityInfo.Attempt,
-						LastFailure:        activityInfo.RetryLastFailure,
-						LastWorkerIdentity: activityInfo.RetryLastWorkerIdentity,
-						VersionHistory:     versionHistory,
+						NamespaceId:            namespaceID,
+						WorkflowId:             taskInfo.GetWorkflowId(),
+						RunId:                  runID,
+						Version:                activityInfo.Version,
+						ScheduledId:            activityInfo.ScheduleId,
+						ScheduledTime:          scheduledTime,
+						StartedId:              activityInfo.StartedId,
+						StartedTime:            startedTime,
+						LastHeartbeatTime:      heartbeatTime,
+						Details:                activityInfo.LastHeartbeatDetails,
+						Attempt:                activityInfo.Attempt,
+						LastFailure:            activityInfo.RetryLastFailure,
+						LastWorkerIdentity:     activityInfo.RetryLastWorkerIdentity,
+						VersionHistory:         versionHistory,
+						Paused:                 activityInfo.Paused,
+						Stamp:                  activityInfo.Stamp,
+						ScheduleToCloseTimeout: activityInfo.ScheduleToCloseTimeout,
+						ScheduleToStartTimeout: activityInfo.ScheduleToStartTimeout,
+						StartToCloseTimeout:    activityInfo.StartToCloseTimeout,
+						HeartbeatTimeout:       activityInfo.HeartbeatTimeout,
+						RetryPolicy:            retryPolicy,
+						RetryExpirationTime:    activityInfo.RetryExpirationTime,
 					},
 				},
 			}, nil
@@ -663,6 +685,13 @@ func (p *replicatorQueueProcessorImpl) generateHistoryReplicationTask(
 					},
 				},
 			}
+			if task.GetFirstEventId() == common.FirstEventID || newRunEventsBlob != nil {
+				// the deadline rules are not part of the started event, they are sent with the events starting a run,
+				// a new run keeps the deadline rules of the run
+				replicationTask.GetHistoryTaskV2Attributes().DeadlineRules = &workflowspb.DeadlineRules{
+					Rules: mutableState.GetExecutionInfo().DeadlineRules,
+				}
+			}
 			return replicationTask, nil
 		},
 	)
@@ -703,7 +732,16 @@ func (p *replicatorQueueProcessorImpl) getEventsBlob(
 		return nil, serviceerror.NewInternal("replicatorQueueProcessor encounter more than 1 NDC raw event batch")
 	}
 
-	return eventBatchBlobs[0].ToProto(), nil
+	eventBatchBlob, err := largepayload.RehydrateEventsBlob(
+		context.Background(),
+		p.shard.GetService().GetLargePayloadOffloader(),
+		p.shard.GetService().GetPayloadSerializer(),
+		eventBatchBlobs[0],
+	)
+	if err != nil {
+		return nil, err
+	}
+	return eventBatchBlob.ToProto(), nil
 }
 
 func (p *replicatorQueueProcessorImpl) getVersionHistoryItems(
@@ -25,6 +25,7 @@
 package history
 
 import (
+	"context"
 	"errors"
 	"strconv"
 	"sync"
@@ -812,6 +813,10 @@ func (s *shardContextImpl) AppendHistoryV2Events(
 	request.ShardID = convert.IntPtr(s.shardID)
 	request.TransactionID = transactionID
 
+	if err := s.offloadLargePayloads(request, namespaceID, execution); err != nil {
+		return 0, err
+	}
+
 	size := 0
 	defer func() {
 		// N.B. - Dual emit here makes sense so that we can see aggregate timer stats across all
@@ -835,6 +840,27 @@ func (s *shardContextImpl) AppendHistoryV2Events(
 	return size, err0
 }
 
+// offloadLargePayloads replaces the payloads of the events to append larger than the namespace threshold by references
+// to the large payload store, the events held by the caller are not changed
+func (s *shardContextImpl) offloadLargePayloads(
+	request *persistence.AppendHistoryNodesRequest, namespaceID string, execution commonpb.WorkflowExecution) error {
+
+	entry, err := s.GetNamespaceCache().GetNamespaceByID(namespaceID)
+	if err != nil {
+		return err
+	}
+	threshold := s.config.LargePayloadThreshold(entry.GetInfo().GetName())
+	if threshold <= 0 {
+		return nil
+	}
+	events, err := s.GetLargePayloadOffloader().Offload(context.Background(), namespaceID, execution, request.Events, threshold)
+	if err != nil {
+		return err
+	}
+	request.Events = events
+	return nil
+}
+
 func (s *shardContextImpl) GetConfig() *Config {
 	return s.config
 }
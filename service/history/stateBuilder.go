@@ -598,6 +598,8 @@ func (b *stateBuilderImpl) applyEvents(
 					b.logger,
 					b.mutableState.GetNamespaceEntry(),
 				)
+				// the new run keeps the deadline rules of the run
+				newRunMutableStateBuilder.GetExecutionInfo().DeadlineRules = b.mutableState.GetExecutionInfo().DeadlineRules
 
 				newRunStateBuilder := newStateBuilder(b.shard, b.logger, newRunMutableStateBuilder, b.taskGeneratorProvider)
 
@@ -636,6 +638,8 @@ func (b *stateBuilderImpl) applyEvents(
 		default:
 			return nil, serviceerror.NewInvalidArgument("Unknown event type: %v").MessageArgs(event.GetEventType())
 		}
+
+		b.mutableState.SatisfyDeadlines(event)
 	}
 
 	// must generate the activity timer / user timer at the very end
@@ -649,6 +653,11 @@ func (b *stateBuilderImpl) applyEvents(
 	); err != nil {
 		return nil, err
 	}
+	if err := taskGenerator.generateDeadlineTimerTasks(
+		timestamp.TimeValue(lastEvent.GetEventTime()),
+	); err != nil {
+		return nil, err
+	}
 
 	b.mutableState.GetExecutionInfo().SetLastFirstEventID(firstEvent.GetEventId())
 	b.mutableState.GetExecutionInfo().SetNextEventID(lastEvent.GetEventId() + 1)
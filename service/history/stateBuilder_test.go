@@ -135,6 +135,7 @@ func (s *stateBuilderSuite) TearDownTest() {
 func (s *stateBuilderSuite) mockUpdateVersion(events ...*historypb.HistoryEvent) {
 	for _, event := range events {
 		s.mockMutableState.EXPECT().UpdateCurrentVersion(event.GetVersion(), true).Times(1)
+		s.mockMutableState.EXPECT().SatisfyDeadlines(event).Times(1)
 	}
 	s.mockTaskGenerator.EXPECT().generateActivityTimerTasks(
 		timestamp.TimeValue(events[len(events)-1].GetEventTime()),
@@ -142,6 +143,9 @@ func (s *stateBuilderSuite) mockUpdateVersion(events ...*historypb.HistoryEvent)
 	s.mockTaskGenerator.EXPECT().generateUserTimerTasks(
 		timestamp.TimeValue(events[len(events)-1].GetEventTime()),
 	).Return(nil).Times(1)
+	s.mockTaskGenerator.EXPECT().generateDeadlineTimerTasks(
+		timestamp.TimeValue(events[len(events)-1].GetEventTime()),
+	).Return(nil).Times(1)
 	s.mockMutableState.EXPECT().SetHistoryBuilder(newHistoryBuilderFromEvents(events, s.logger)).Times(1)
 }
 
@@ -510,6 +514,9 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionContinuedA
 	s.mockTaskGeneratorForNew.EXPECT().generateUserTimerTasks(
 		timestamp.TimeValue(newRunEvents[len(newRunEvents)-1].GetEventTime()),
 	).Return(nil).Times(1)
+	s.mockTaskGeneratorForNew.EXPECT().generateDeadlineTimerTasks(
+		timestamp.TimeValue(newRunEvents[len(newRunEvents)-1].GetEventTime()),
+	).Return(nil).Times(1)
 
 	newRunStateBuilder, err := s.stateBuilder.applyEvents(
 		testNamespaceID, requestID, execution, s.toHistory(continueAsNewEvent), newRunEvents,
@@ -33,10 +33,13 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/failure"
@@ -100,6 +103,8 @@ func (t *timerQueueActiveTaskExecutor) execute(
 		return t.executeActivityRetryTimerTask(timerTask)
 	case enumsspb.TASK_TYPE_WORKFLOW_BACKOFF_TIMER:
 		return t.executeWorkflowBackoffTimerTask(timerTask)
+	case enumsspb.TASK_TYPE_WORKFLOW_DEADLINE_TIMER:
+		return t.executeWorkflowDeadlineTimerTask(timerTask)
 	case enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT:
 		return t.executeDeleteHistoryEventTask(timerTask)
 	default:
@@ -126,6 +131,10 @@ func (t *timerQueueActiveTaskExecutor) executeUserTimerTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the timer fired events are held back, the timer task is created again when the workflow is unpaused
+		return nil
+	}
 
 	timerSequence := t.getTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
@@ -368,6 +377,94 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 	return t.updateWorkflowExecution(weContext, mutableState, true)
 }
 
+func (t *timerQueueActiveTaskExecutor) executeWorkflowDeadlineTimerTask(
+	task *persistenceblobs.TimerTaskInfo,
+) (retError error) {
+
+	weContext, release, err := t.cache.getOrCreateWorkflowExecutionForBackground(
+		t.getNamespaceIDAndWorkflowExecution(task),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableStateForTimerTask(weContext, task, t.metricsClient, t.logger)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	referenceTime := t.shard.GetTimeSource().Now()
+	var exceeded []*workflowspb.DeadlineRule
+	for _, deadline := range mutableState.GetPendingDeadlines() {
+		if !deadline.GetExpiryTime().After(referenceTime) {
+			exceeded = append(exceeded, deadline.GetRule())
+		}
+	}
+	if len(exceeded) == 0 {
+		// deadlines are satisfied already
+		return nil
+	}
+
+	scheduleWorkflowTask := false
+	for _, rule := range exceeded {
+		mutableState.DeleteDeadline(rule.GetName())
+
+		switch rule.GetAction() {
+		case enumsspb.DEADLINE_ACTION_TERMINATE:
+			if err := terminateWorkflow(
+				mutableState,
+				mutableState.GetNextEventID(),
+				fmt.Sprintf("deadline %v exceeded", rule.GetName()),
+				nil,
+				identityHistoryService,
+			); err != nil {
+				return err
+			}
+			return t.updateWorkflowExecution(weContext, mutableState, false)
+		case enumsspb.DEADLINE_ACTION_CANCEL:
+			if cancelRequested, _ := mutableState.IsCancelRequested(); cancelRequested {
+				continue
+			}
+			executionInfo := mutableState.GetExecutionInfo()
+			if _, err := mutableState.AddWorkflowExecutionCancelRequestedEvent(
+				fmt.Sprintf("deadline %v exceeded", rule.GetName()),
+				&historyservice.RequestCancelWorkflowExecutionRequest{
+					NamespaceId: executionInfo.NamespaceId,
+					CancelRequest: &workflowservice.RequestCancelWorkflowExecutionRequest{
+						WorkflowExecution: &commonpb.WorkflowExecution{
+							WorkflowId: executionInfo.WorkflowId,
+							RunId:      executionInfo.ExecutionState.RunId,
+						},
+						Identity: identityHistoryService,
+					},
+				},
+			); err != nil {
+				return err
+			}
+			scheduleWorkflowTask = true
+		case enumsspb.DEADLINE_ACTION_SIGNAL:
+			if _, err := mutableState.AddWorkflowExecutionSignaled(
+				rule.GetName(),
+				nil,
+				identityHistoryService,
+			); err != nil {
+				return err
+			}
+			scheduleWorkflowTask = true
+		case enumsspb.DEADLINE_ACTION_FLAG:
+			if _, err := mutableState.AddDeadlineExceededEvent(rule.GetName()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return t.updateWorkflowExecution(weContext, mutableState, scheduleWorkflowTask)
+}
+
 func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 	task *persistenceblobs.TimerTaskInfo,
 ) (retError error) {
@@ -387,11 +484,16 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the activity task is dispatched again when the workflow is unpaused
+		return nil
+	}
 
 	// generate activity task
 	scheduledID := task.GetEventId()
 	activityInfo, ok := mutableState.GetActivityInfo(scheduledID)
-	if !ok || task.ScheduleAttempt < activityInfo.Attempt || activityInfo.StartedId != common.EmptyEventID {
+	if !ok || task.ScheduleAttempt < activityInfo.Attempt || task.GetStamp() != activityInfo.Stamp ||
+		activityInfo.StartedId != common.EmptyEventID {
 		if ok {
 			t.logger.Info("Duplicate activity retry timer task",
 				tag.WorkflowID(mutableState.GetExecutionInfo().WorkflowId),
@@ -409,6 +511,10 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 	if err != nil || !ok {
 		return err
 	}
+	if activityInfo.Paused {
+		// the activity task is dispatched again when the activity is unpaused
+		return nil
+	}
 
 	namespaceID := task.GetNamespaceId()
 	targetNamespaceID := namespaceID
@@ -454,6 +454,8 @@ func (t *timerQueueProcessorBase) getTimerTaskType(
 		return "ActivityRetryTimerTask"
 	case enumsspb.TASK_TYPE_WORKFLOW_BACKOFF_TIMER:
 		return "WorkflowBackoffTimerTask"
+	case enumsspb.TASK_TYPE_WORKFLOW_DEADLINE_TIMER:
+		return "WorkflowDeadlineTimerTask"
 	}
 	return "UnKnown"
 }
@@ -498,6 +500,11 @@ func getTimerTaskMetricScope(
 			return metrics.TimerActiveTaskWorkflowBackoffTimerScope
 		}
 		return metrics.TimerStandbyTaskWorkflowBackoffTimerScope
+	case enumsspb.TASK_TYPE_WORKFLOW_DEADLINE_TIMER:
+		if isActive {
+			return metrics.TimerActiveTaskWorkflowDeadlineTimerScope
+		}
+		return metrics.TimerStandbyTaskWorkflowDeadlineTimerScope
 	default:
 		if isActive {
 			return metrics.TimerActiveQueueProcessorScope
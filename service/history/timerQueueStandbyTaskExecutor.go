@@ -33,6 +33,7 @@ import (
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
@@ -105,6 +106,8 @@ func (t *timerQueueStandbyTaskExecutor) execute(
 		return nil
 	case enumsspb.TASK_TYPE_WORKFLOW_BACKOFF_TIMER:
 		return t.executeWorkflowBackoffTimerTask(timerTask)
+	case enumsspb.TASK_TYPE_WORKFLOW_DEADLINE_TIMER:
+		return t.executeWorkflowDeadlineTimerTask(timerTask)
 	case enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT:
 		return t.executeDeleteHistoryEventTask(timerTask)
 	default:
@@ -118,6 +121,11 @@ func (t *timerQueueStandbyTaskExecutor) executeUserTimerTimeoutTask(
 
 	actionFn := func(context workflowExecutionContext, mutableState mutableState) (interface{}, error) {
 
+		if mutableState.IsWorkflowExecutionPaused() {
+			// the active cluster does not fire user timers while the workflow is paused
+			return nil, nil
+		}
+
 		timerSequence := t.getTimerSequence(mutableState)
 
 	Loop:
@@ -339,6 +347,81 @@ func (t *timerQueueStandbyTaskExecutor) executeWorkflowBackoffTimerTask(
 	)
 }
 
+func (t *timerQueueStandbyTaskExecutor) executeWorkflowDeadlineTimerTask(
+	timerTask *persistenceblobs.TimerTaskInfo,
+) error {
+
+	// deadlines are resolved on the standby cluster by the events recorded for them on the active cluster,
+	// the cancel deadlines whose action records no new event are resolved here instead.
+	// NOTE: like the activity heartbeat timer, mutable state can be updated here
+
+	actionFn := func(context workflowExecutionContext, mutableState mutableState) (interface{}, error) {
+
+		taskTime := timestamp.TimeValue(timerTask.VisibilityTime)
+		cancelRequested, _ := mutableState.IsCancelRequested()
+		var resolved []*workflowspb.DeadlineRule
+		waitForHistory := false
+		for _, deadline := range mutableState.GetPendingDeadlines() {
+			if deadline.GetExpiryTime().After(taskTime) {
+				continue
+			}
+
+			rule := deadline.GetRule()
+			switch rule.GetAction() {
+			case enumsspb.DEADLINE_ACTION_CANCEL:
+				// the active cluster doesn't request the cancellation again if it is requested already
+				if cancelRequested {
+					resolved = append(resolved, rule)
+				} else {
+					waitForHistory = true
+				}
+			default:
+				waitForHistory = true
+			}
+		}
+
+		if len(resolved) != 0 {
+			lastWriteVersion, err := mutableState.GetLastWriteVersion()
+			if err != nil {
+				return nil, err
+			}
+
+			for _, rule := range resolved {
+				mutableState.DeleteDeadline(rule.GetName())
+			}
+
+			// passive logic need to explicitly call create timer
+			if _, err := t.getTimerSequence(mutableState).createNextDeadlineTimer(); err != nil {
+				return nil, err
+			}
+			if err := mutableState.UpdateCurrentVersion(lastWriteVersion, true); err != nil {
+				return nil, err
+			}
+			if err := context.updateWorkflowExecutionAsPassive(t.getStandbyClusterTime()); err != nil {
+				return nil, err
+			}
+		}
+
+		if waitForHistory {
+			return getHistoryResendInfo(mutableState)
+		}
+		return nil, nil
+	}
+
+	return t.processTimer(
+		timerTask,
+		actionFn,
+		getStandbyPostActionFn(
+			timerTask,
+			t.getCurrentTime,
+			t.config.StandbyTaskMissingEventsResendDelay(),
+			t.config.StandbyTaskMissingEventsDiscardDelay(),
+			t.fetchHistoryFromRemote,
+			standbyTimerTaskPostActionTaskDiscarded,
+		),
+	)
+}
+
 func (t *timerQueueStandbyTaskExecutor) executeWorkflowTimeoutTask(
 	timerTask *persistenceblobs.TimerTaskInfo,
 ) error {
@@ -42,10 +42,12 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/mocks"
@@ -1138,6 +1140,133 @@ func (s *timerQueueStandbyTaskExecutorSuite) TestProcessWorkflowTimeout_Success(
 	s.Nil(err)
 }
 
+func (s *timerQueueStandbyTaskExecutorSuite) TestProcessWorkflowDeadlineTimer_Pending() {
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+	deadlineTimeout := 1 * time.Second
+
+	mutableState := newMutableStateBuilderWithVersionHistoriesForTest(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	event, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID,
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(2 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(1 * time.Second),
+			},
+			DeadlineRules: &workflowspb.DeadlineRules{Rules: []*workflowspb.DeadlineRule{{
+				Name:      "approval",
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+				Timeout:   &deadlineTimeout,
+				Action:    enumsspb.DEADLINE_ACTION_SIGNAL,
+			}}},
+		},
+	)
+	s.Nil(err)
+	nextEventID := event.GetEventId()
+
+	protoTaskTime := s.now.Add(deadlineTimeout)
+	timerTask := &persistenceblobs.TimerTaskInfo{
+		Version:        s.version,
+		NamespaceId:    s.namespaceID,
+		WorkflowId:     execution.GetWorkflowId(),
+		RunId:          execution.GetRunId(),
+		TaskId:         int64(100),
+		TaskType:       enumsspb.TASK_TYPE_WORKFLOW_DEADLINE_TIMER,
+		VisibilityTime: &protoTaskTime,
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).Once()
+
+	s.mockShard.SetCurrentTime(s.clusterName, protoTaskTime)
+	err = s.timerQueueStandbyTaskExecutor.execute(timerTask, true)
+	s.Equal(ErrTaskRetry, err)
+
+	s.mockShard.SetCurrentTime(s.clusterName, protoTaskTime.Add(s.fetchHistoryDuration))
+	s.mockNDCHistoryResender.EXPECT().SendSingleWorkflowHistory(
+		timerTask.GetNamespaceId(),
+		timerTask.GetWorkflowId(),
+		timerTask.GetRunId(),
+		nextEventID,
+		s.version,
+		int64(0),
+		int64(0),
+	).Return(nil).Times(1)
+	err = s.timerQueueStandbyTaskExecutor.execute(timerTask, true)
+	s.Equal(ErrTaskRetry, err)
+
+	s.mockShard.SetCurrentTime(s.clusterName, protoTaskTime.Add(s.discardDuration))
+	err = s.timerQueueStandbyTaskExecutor.execute(timerTask, true)
+	s.Equal(ErrTaskDiscarded, err)
+}
+
+func (s *timerQueueStandbyTaskExecutorSuite) TestProcessWorkflowDeadlineTimer_Flag() {
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+	deadlineTimeout := 1 * time.Second
+
+	mutableState := newMutableStateBuilderWithVersionHistoriesForTest(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	event, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID,
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(2 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(1 * time.Second),
+			},
+			DeadlineRules: &workflowspb.DeadlineRules{Rules: []*workflowspb.DeadlineRule{{
+				Name:      "approval",
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+				Timeout:   &deadlineTimeout,
+				Action:    enumsspb.DEADLINE_ACTION_FLAG,
+			}}},
+		},
+	)
+	s.Nil(err)
+
+	protoTaskTime := s.now.Add(deadlineTimeout)
+	timerTask := &persistenceblobs.TimerTaskInfo{
+		Version:        s.version,
+		NamespaceId:    s.namespaceID,
+		WorkflowId:     execution.GetWorkflowId(),
+		RunId:          execution.GetRunId(),
+		TaskId:         int64(100),
+		TaskType:       enumsspb.TASK_TYPE_WORKFLOW_DEADLINE_TIMER,
+		VisibilityTime: &protoTaskTime,
+	}
+
+	// the deadline is resolved by the event recorded by the active cluster, the timer has nothing left to do
+	flagEvent, err := mutableState.AddDeadlineExceededEvent("approval")
+	s.Nil(err)
+	mutableState.SatisfyDeadlines(flagEvent)
+	s.Empty(mutableState.GetPendingDeadlines())
+	s.Contains(mutableState.GetExecutionInfo().SearchAttributes, definition.DeadlinesExceeded)
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).Once()
+
+	s.mockShard.SetCurrentTime(s.clusterName, protoTaskTime)
+	err = s.timerQueueStandbyTaskExecutor.execute(timerTask, true)
+	s.Nil(err)
+}
+
 func (s *timerQueueStandbyTaskExecutorSuite) TestProcessRetryTimeout() {
 
 	execution := commonpb.WorkflowExecution{
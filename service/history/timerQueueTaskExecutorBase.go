@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/worker/archiver"
 )
 
@@ -132,6 +133,10 @@ func (t *timerQueueTaskExecutorBase) deleteWorkflow(
 		return err
 	}
 
+	if err := t.deleteLargePayloads(task); err != nil {
+		return err
+	}
+
 	if err := t.deleteWorkflowVisibility(task); err != nil {
 		return err
 	}
@@ -172,8 +177,25 @@ func (t *timerQueueTaskExecutorBase) archiveWorkflow(
 		CallerService:        common.HistoryServiceName,
 		AttemptArchiveInline: false, // archive in workflow by default
 	}
+	archiveBundle := t.config.TimerProcessorArchiveWorkflowBundle(namespaceCacheEntry.GetInfo().Name)
+	if archiveBundle {
+		completionEvent, err := msBuilder.GetCompletionEvent()
+		if err != nil {
+			return err
+		}
+		executionInfo := msBuilder.GetExecutionInfo()
+		req.ArchiveRequest.ArchiveBundle = true
+		req.ArchiveRequest.MutableState = workflowMutableStateToJSON(msBuilder.CopyToPersistence())
+		req.ArchiveRequest.WorkflowTypeName = executionInfo.WorkflowTypeName
+		req.ArchiveRequest.StartTime = timestamp.TimeValue(executionInfo.StartTime)
+		req.ArchiveRequest.CloseTime = timestamp.TimeValue(completionEvent.GetEventTime())
+		req.ArchiveRequest.Status = executionInfo.ExecutionState.Status
+		req.ArchiveRequest.Memo = &commonpb.Memo{Fields: executionInfo.Memo}
+		req.ArchiveRequest.SearchAttributes = executionInfo.SearchAttributes
+	}
+	// workflow bundles are only built by the archival workflow, as the history must not be deleted before it is bundled
 	executionStats, err := workflowContext.loadExecutionStats()
-	if err == nil && executionStats.HistorySize < int64(t.config.TimerProcessorHistoryArchivalSizeLimit()) {
+	if !archiveBundle && err == nil && executionStats.HistorySize < int64(t.config.TimerProcessorHistoryArchivalSizeLimit()) {
 		req.AttemptArchiveInline = true
 	}
 
@@ -196,6 +218,9 @@ func (t *timerQueueTaskExecutorBase) archiveWorkflow(
 		if err := t.deleteWorkflowHistory(task, msBuilder); err != nil {
 			return err
 		}
+		if err := t.deleteLargePayloads(task); err != nil {
+			return err
+		}
 	}
 	// delete visibility record here regardless if it's been archived inline or not
 	// since the entire record is included as part of the archive request.
@@ -255,6 +280,19 @@ func (t *timerQueueTaskExecutorBase) deleteWorkflowHistory(
 	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 }
 
+// deleteLargePayloads deletes the payloads offloaded from the workflow history, archived histories
+// hold the payloads themselves so the blobs go with the history once it is archived
+func (t *timerQueueTaskExecutorBase) deleteLargePayloads(
+	task *persistenceblobs.TimerTaskInfo,
+) error {
+
+	namespaceID, execution := t.getNamespaceIDAndWorkflowExecution(task)
+	op := func() error {
+		return t.shard.GetService().GetLargePayloadOffloader().DeleteExecution(context.Background(), namespaceID, execution)
+	}
+	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+}
+
 func (t *timerQueueTaskExecutorBase) deleteWorkflowVisibility(
 	task *persistenceblobs.TimerTaskInfo,
 ) error {
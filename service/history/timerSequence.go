@@ -72,6 +72,7 @@ type (
 
 		createNextUserTimer() (bool, error)
 		createNextActivityTimer() (bool, error)
+		createNextDeadlineTimer() (bool, error)
 
 		loadAndSortUserTimers() []timerSequenceID
 		loadAndSortActivityTimers() []timerSequenceID
@@ -179,6 +180,38 @@ func (t *timerSequenceImpl) createNextActivityTimer() (bool, error) {
 	return true, nil
 }
 
+func (t *timerSequenceImpl) createNextDeadlineTimer() (bool, error) {
+
+	var firstDeadline *persistenceblobs.DeadlineInfo
+	for _, deadline := range t.mutableState.GetPendingDeadlines() {
+		if firstDeadline == nil || deadline.GetExpiryTime().Before(*firstDeadline.GetExpiryTime()) {
+			firstDeadline = deadline
+		}
+	}
+	if firstDeadline == nil {
+		return false, nil
+	}
+
+	// timer has already been created
+	if firstDeadline.TimerCreated {
+		return false, nil
+	}
+
+	// deadlines expiring at the same time share one timer task
+	expiryTime := timestamp.TimeValue(firstDeadline.GetExpiryTime())
+	for _, deadline := range t.mutableState.GetPendingDeadlines() {
+		if deadline.GetExpiryTime().Equal(expiryTime) {
+			deadline.TimerCreated = true
+		}
+	}
+	t.mutableState.AddTimerTasks(&persistence.WorkflowDeadlineTimerTask{
+		// TaskID is set by shard
+		VisibilityTimestamp: expiryTime,
+		Version:             t.mutableState.GetCurrentVersion(),
+	})
+	return true, nil
+}
+
 func (t *timerSequenceImpl) loadAndSortUserTimers() []timerSequenceID {
 
 	pendingTimers := t.mutableState.GetPendingTimerInfos()
@@ -102,6 +102,21 @@ func (mr *MocktimerSequenceMockRecorder) createNextActivityTimer() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "createNextActivityTimer", reflect.TypeOf((*MocktimerSequence)(nil).createNextActivityTimer))
 }
 
+// createNextDeadlineTimer mocks base method.
+func (m *MocktimerSequence) createNextDeadlineTimer() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "createNextDeadlineTimer")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// createNextDeadlineTimer indicates an expected call of createNextDeadlineTimer.
+func (mr *MocktimerSequenceMockRecorder) createNextDeadlineTimer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "createNextDeadlineTimer", reflect.TypeOf((*MocktimerSequence)(nil).createNextDeadlineTimer))
+}
+
 // loadAndSortUserTimers mocks base method.
 func (m *MocktimerSequence) loadAndSortUserTimers() []timerSequenceID {
 	m.ctrl.T.Helper()
@@ -34,6 +34,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 
 	"go.temporal.io/server/api/persistenceblobs/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/persistence"
@@ -98,6 +99,43 @@ func (s *timerSequenceSuite) TestCreateNextUserTimer_AlreadyCreated() {
 	s.False(modified)
 }
 
+func (s *timerSequenceSuite) TestCreateNextDeadlineTimer_AlreadyCreated() {
+	now := timestamp.TimeNowPtrUtc()
+	deadlines := []*persistenceblobs.DeadlineInfo{
+		{Rule: &workflowspb.DeadlineRule{Name: "first"}, ExpiryTime: now, TimerCreated: true},
+		{Rule: &workflowspb.DeadlineRule{Name: "second"}, ExpiryTime: timestamp.TimePtr(now.Add(time.Minute))},
+	}
+	s.mockMutableState.EXPECT().GetPendingDeadlines().Return(deadlines).AnyTimes()
+
+	modified, err := s.timerSequence.createNextDeadlineTimer()
+	s.NoError(err)
+	s.False(modified)
+}
+
+func (s *timerSequenceSuite) TestCreateNextDeadlineTimer_NotCreated() {
+	now := timestamp.TimeNowPtrUtc()
+	currentVersion := int64(999)
+	deadlines := []*persistenceblobs.DeadlineInfo{
+		{Rule: &workflowspb.DeadlineRule{Name: "later"}, ExpiryTime: timestamp.TimePtr(now.Add(time.Minute))},
+		{Rule: &workflowspb.DeadlineRule{Name: "first"}, ExpiryTime: now},
+		{Rule: &workflowspb.DeadlineRule{Name: "also first"}, ExpiryTime: timestamp.TimePtr(*now)},
+	}
+	s.mockMutableState.EXPECT().GetPendingDeadlines().Return(deadlines).AnyTimes()
+	s.mockMutableState.EXPECT().GetCurrentVersion().Return(currentVersion).Times(1)
+	s.mockMutableState.EXPECT().AddTimerTasks(&persistence.WorkflowDeadlineTimerTask{
+		// TaskID is set by shard
+		VisibilityTimestamp: *now,
+		Version:             currentVersion,
+	}).Times(1)
+
+	modified, err := s.timerSequence.createNextDeadlineTimer()
+	s.NoError(err)
+	s.True(modified)
+	s.False(deadlines[0].TimerCreated)
+	s.True(deadlines[1].TimerCreated)
+	s.True(deadlines[2].TimerCreated)
+}
+
 func (s *timerSequenceSuite) TestCreateNextUserTimer_NotCreated() {
 	now := timestamp.TimeNowPtrUtc()
 	now = timestamp.TimePtr(now.Add(100))
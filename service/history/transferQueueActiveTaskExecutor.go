@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -145,6 +146,10 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the activity task is dispatched again when the workflow is unpaused
+		return nil
+	}
 
 	ai, ok := mutableState.GetActivityInfo(task.GetScheduleId())
 	if !ok {
@@ -155,6 +160,10 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 	if err != nil || !ok {
 		return err
 	}
+	if ai.Paused {
+		// the activity task is dispatched again when the activity is unpaused
+		return nil
+	}
 
 	timeout := timestamp.MinDuration(timestamp.DurationValue(ai.ScheduleToStartTimeout), common.MaxTaskTimeout)
 	// release the context lock since we no longer need mutable state builder and
@@ -182,6 +191,10 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the workflow task is dispatched again when the workflow is unpaused
+		return nil
+	}
 
 	workflowTask, found := mutableState.GetWorkflowTaskInfo(task.GetScheduleId())
 	if !found {
@@ -379,7 +392,7 @@ func (t *transferQueueActiveTaskExecutor) processCancelExecution(
 
 	if err = t.requestCancelExternalExecutionWithRetry(
 		task,
-		targetNamespace,
+		targetNamespaceEntry,
 		requestCancelInfo,
 	); err != nil {
 		t.logger.Debug(fmt.Sprintf("Failed to cancel external workflow execution. Error: %v", err))
@@ -468,7 +481,7 @@ func (t *transferQueueActiveTaskExecutor) processSignalExecution(
 
 	if err = t.signalExternalExecutionWithRetry(
 		task,
-		targetNamespace,
+		targetNamespaceEntry,
 		signalInfo,
 	); err != nil {
 		t.logger.Debug("Failed to signal external workflow execution", tag.Error(err))
@@ -509,6 +522,10 @@ func (t *transferQueueActiveTaskExecutor) processSignalExecution(
 	// release the weContext lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(retError)
+	if _, forwarded := t.remoteFrontendClient(task, targetNamespaceEntry); forwarded {
+		// the signal request ID of a forwarded signal is owned by the active cluster of the target namespace
+		return nil
+	}
 	// remove signalRequestedID from target workflow, after Signal detail is removed from source workflow
 	ctx, cancel := context.WithTimeout(context.Background(), transferActiveTaskDefaultTimeout)
 	defer cancel()
@@ -557,6 +574,7 @@ func (t *transferQueueActiveTaskExecutor) processStartChildExecution(
 
 	// Get target namespace name
 	var targetNamespace string
+	targetNamespaceDeleted := false
 	if namespaceEntry, err := t.shard.GetNamespaceCache().GetNamespaceByID(task.GetTargetNamespaceId()); err != nil {
 		if _, ok := err.(*serviceerror.NotFound); !ok {
 			return err
@@ -565,6 +583,7 @@ func (t *transferQueueActiveTaskExecutor) processStartChildExecution(
 		targetNamespace = task.GetNamespaceId()
 	} else {
 		targetNamespace = namespaceEntry.GetInfo().Name
+		targetNamespaceDeleted = namespaceEntry.GetInfo().State == enumspb.NAMESPACE_STATE_DELETED
 	}
 
 	initiatedEventID := task.GetScheduleId()
@@ -592,6 +611,11 @@ func (t *transferQueueActiveTaskExecutor) processStartChildExecution(
 	}
 
 	attributes := initiatedEvent.GetStartChildWorkflowExecutionInitiatedEventAttributes()
+	if targetNamespaceDeleted {
+		// a deleted namespace doesn't accept new runs, fail the start so that the parent doesn't wait for the child,
+		// the only failure cause SDKs understand is the already started one
+		return t.recordStartChildExecutionFailed(task, context, attributes)
+	}
 	childRunID, err := t.startWorkflowWithRetry(
 		task,
 		namespace,
@@ -1109,10 +1133,11 @@ func (t *transferQueueActiveTaskExecutor) updateWorkflowExecution(
 
 func (t *transferQueueActiveTaskExecutor) requestCancelExternalExecutionWithRetry(
 	task *persistenceblobs.TransferTaskInfo,
-	targetNamespace string,
+	targetNamespaceEntry *cache.NamespaceCacheEntry,
 	requestCancelInfo *persistenceblobs.RequestCancelInfo,
 ) error {
 
+	targetNamespace := targetNamespaceEntry.GetInfo().Name
 	request := &historyservice.RequestCancelWorkflowExecutionRequest{
 		NamespaceId: task.GetTargetNamespaceId(),
 		CancelRequest: &workflowservice.RequestCancelWorkflowExecutionRequest{
@@ -1139,6 +1164,12 @@ func (t *transferQueueActiveTaskExecutor) requestCancelExternalExecutionWithRetr
 		_, err := t.historyClient.RequestCancelWorkflowExecution(ctx, request)
 		return err
 	}
+	if remoteClient, ok := t.remoteFrontendClient(task, targetNamespaceEntry); ok {
+		op = func() error {
+			_, err := remoteClient.RequestCancelWorkflowExecution(ctx, request.CancelRequest)
+			return err
+		}
+	}
 
 	err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 
@@ -1153,10 +1184,11 @@ func (t *transferQueueActiveTaskExecutor) requestCancelExternalExecutionWithRetr
 
 func (t *transferQueueActiveTaskExecutor) signalExternalExecutionWithRetry(
 	task *persistenceblobs.TransferTaskInfo,
-	targetNamespace string,
+	targetNamespaceEntry *cache.NamespaceCacheEntry,
 	signalInfo *persistenceblobs.SignalInfo,
 ) error {
 
+	targetNamespace := targetNamespaceEntry.GetInfo().Name
 	request := &historyservice.SignalWorkflowExecutionRequest{
 		NamespaceId: task.GetTargetNamespaceId(),
 		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
@@ -1185,10 +1217,34 @@ func (t *transferQueueActiveTaskExecutor) signalExternalExecutionWithRetry(
 		_, err := t.historyClient.SignalWorkflowExecution(ctx, request)
 		return err
 	}
+	if remoteClient, ok := t.remoteFrontendClient(task, targetNamespaceEntry); ok {
+		op = func() error {
+			_, err := remoteClient.SignalWorkflowExecution(ctx, request.SignalRequest)
+			return err
+		}
+	}
 
 	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 }
 
+// remoteFrontendClient returns the frontend client of the active cluster of the target namespace
+// if the request of the task has to be forwarded to it. Requests which are only valid against
+// a child workflow are never forwarded, since the parent and child always live in the same cluster.
+func (t *transferQueueActiveTaskExecutor) remoteFrontendClient(
+	task *persistenceblobs.TransferTaskInfo,
+	targetNamespaceEntry *cache.NamespaceCacheEntry,
+) (workflowservice.WorkflowServiceClient, bool) {
+
+	if task.TargetChildWorkflowOnly || !targetNamespaceEntry.IsGlobalNamespace() {
+		return nil, false
+	}
+	activeCluster := targetNamespaceEntry.GetReplicationConfig().ActiveClusterName
+	if activeCluster == t.shard.GetService().GetClusterMetadata().GetCurrentClusterName() {
+		return nil, false
+	}
+	return t.shard.GetService().GetRemoteFrontendClient(activeCluster), true
+}
+
 func (t *transferQueueActiveTaskExecutor) startWorkflowWithRetry(
 	task *persistenceblobs.TransferTaskInfo,
 	namespace string,
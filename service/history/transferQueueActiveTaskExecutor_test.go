@@ -1331,6 +1331,93 @@ func (s *transferQueueActiveTaskExecutorSuite) TestProcessSignalExecution_Succes
 	s.Nil(err)
 }
 
+func (s *transferQueueActiveTaskExecutorSuite) TestProcessSignalExecution_Forwarded() {
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	remoteNamespaceID := uuid.New()
+	remoteNamespace := "some random remote namespace name"
+	remoteNamespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Id: remoteNamespaceID, Name: remoteNamespace},
+		&persistenceblobs.NamespaceConfig{Retention: timestamp.DurationFromDays(1)},
+		&persistenceblobs.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestAlternativeClusterName,
+			Clusters: []string{
+				cluster.TestCurrentClusterName,
+				cluster.TestAlternativeClusterName,
+			},
+		},
+		s.version,
+		nil,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(remoteNamespaceID).Return(remoteNamespaceEntry, nil).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespace(remoteNamespace).Return(remoteNamespaceEntry, nil).AnyTimes()
+
+	targetExecution := commonpb.WorkflowExecution{
+		WorkflowId: "some random target workflow ID",
+		RunId:      uuid.New(),
+	}
+	signalName := "some random signal name"
+	signalInput := payloads.EncodeString("some random signal input")
+	signalControl := "some random signal control"
+
+	mutableState := newMutableStateBuilderWithVersionHistoriesForTest(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID,
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(2 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(1 * time.Second),
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, di.ScheduleID, taskQueueName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(mutableState, di.ScheduleID, di.StartedID, "some random identity")
+
+	taskID := int64(59)
+	event, si := addRequestSignalInitiatedEvent(mutableState, event.GetEventId(), uuid.New(),
+		remoteNamespace, targetExecution.GetWorkflowId(), targetExecution.GetRunId(), signalName, signalInput, signalControl)
+
+	transferTask := &persistenceblobs.TransferTaskInfo{
+		Version:           s.version,
+		NamespaceId:       s.namespaceID,
+		WorkflowId:        execution.GetWorkflowId(),
+		RunId:             execution.GetRunId(),
+		TargetNamespaceId: remoteNamespaceID,
+		TargetWorkflowId:  targetExecution.GetWorkflowId(),
+		TargetRunId:       targetExecution.GetRunId(),
+		TaskId:            taskID,
+		TaskQueue:         taskQueueName,
+		TaskType:          enumsspb.TASK_TYPE_TRANSFER_SIGNAL_EXECUTION,
+		ScheduleId:        event.GetEventId(),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockShard.resource.RemoteFrontendClient.EXPECT().SignalWorkflowExecution(
+		gomock.Any(),
+		s.createSignalWorkflowExecutionRequest(remoteNamespace, transferTask, si).SignalRequest,
+	).Return(nil, nil).Times(1)
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err = s.transferQueueActiveTaskExecutor.execute(transferTask, true)
+	s.Nil(err)
+}
+
 func (s *transferQueueActiveTaskExecutorSuite) TestProcessSignalExecution_Failure() {
 
 	execution := commonpb.WorkflowExecution{
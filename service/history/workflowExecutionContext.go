@@ -1097,7 +1097,9 @@ func (c *workflowExecutionContextImpl) reapplyEvents(
 			event := e
 			switch event.GetEventType() {
 			case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
-				reapplyEvents = append(reapplyEvents, event)
+				if !isHistoryServiceSignal(event) {
+					reapplyEvents = append(reapplyEvents, event)
+				}
 			}
 		}
 	}
@@ -1160,6 +1162,20 @@ func (c *workflowExecutionContextImpl) reapplyEvents(
 	return err
 }
 
+// suggestContinueAsNew returns true if the history of the workflow is large enough for the worker to be told
+// to continue it as new, well before the history reaches its warn and error limits
+func suggestContinueAsNew(
+	config *Config,
+	namespace string,
+	historySize int64,
+	historyCount int64,
+) bool {
+	sizeThreshold := int64(config.HistorySizeSuggestContinueAsNew(namespace))
+	countThreshold := int64(config.HistoryCountSuggestContinueAsNew(namespace))
+	return (sizeThreshold > 0 && historySize >= sizeThreshold) ||
+		(countThreshold > 0 && historyCount >= countThreshold)
+}
+
 // Returns true if execution is forced terminated
 func (c *workflowExecutionContextImpl) enforceSizeCheck() (bool, error) {
 	historySizeLimitWarn := c.config.HistorySizeLimitWarn(c.getNamespace())
@@ -563,6 +563,9 @@ func (r *workflowResetterImpl) reapplyEvents(
 	for _, event := range events {
 		switch event.GetEventType() {
 		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
+			if isHistoryServiceSignal(event) {
+				continue
+			}
 			attr := event.GetWorkflowExecutionSignaledEventAttributes()
 			if _, err := mutableState.AddWorkflowExecutionSignaled(
 				attr.GetSignalName(),
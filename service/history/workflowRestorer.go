@@ -0,0 +1,361 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination workflowRestorer_mock.go
+
+package history
+
+import (
+	"context"
+
+	"github.com/pborman/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	restoreHistoryPageSize = 100
+)
+
+var (
+	errRestoreNamespaceNotArchived = serviceerror.NewInvalidArgument("Namespace has never enabled history archival.")
+	errRestoreHistoryEmpty         = serviceerror.NewInvalidArgument("Archived history of workflow execution is empty.")
+	errRestoreHistoryNotStarted    = serviceerror.NewInvalidArgument("Archived history does not start with the workflow execution started event.")
+	errRestoreHistoryNotClosed     = serviceerror.NewInvalidArgument("Archived history does not close the workflow execution.")
+)
+
+type (
+	workflowRestorer interface {
+		// restoreWorkflow reads the archived history of a closed workflow execution and
+		// writes the execution back in closed state
+		restoreWorkflow(
+			ctx context.Context,
+			namespaceEntry *cache.NamespaceCacheEntry,
+			execution commonpb.WorkflowExecution,
+		) error
+	}
+
+	workflowRestorerImpl struct {
+		shard            ShardContext
+		executionMgr     persistence.ExecutionManager
+		historyCache     *historyCache
+		archiverProvider provider.ArchiverProvider
+		taskRefresher    mutableStateTaskRefresher
+		newStateBuilder  stateBuilderProvider
+		newMutableState  mutableStateProvider
+		logger           log.Logger
+	}
+)
+
+var _ workflowRestorer = (*workflowRestorerImpl)(nil)
+
+func newWorkflowRestorer(
+	shard ShardContext,
+	historyCache *historyCache,
+	logger log.Logger,
+) *workflowRestorerImpl {
+
+	return &workflowRestorerImpl{
+		shard:            shard,
+		executionMgr:     shard.GetExecutionManager(),
+		historyCache:     historyCache,
+		archiverProvider: shard.GetService().GetArchiverProvider(),
+		taskRefresher: newMutableStateTaskRefresher(
+			shard.GetConfig(),
+			shard.GetNamespaceCache(),
+			shard.GetEventsCache(),
+			logger,
+		),
+		newStateBuilder: func(
+			state mutableState,
+			logger log.Logger,
+		) stateBuilder {
+
+			return newStateBuilder(
+				shard,
+				logger,
+				state,
+				func(mutableState mutableState) mutableStateTaskGenerator {
+					return newMutableStateTaskGenerator(shard.GetNamespaceCache(), logger, mutableState)
+				},
+			)
+		},
+		newMutableState: func(
+			namespaceEntry *cache.NamespaceCacheEntry,
+			logger log.Logger,
+		) mutableState {
+			return newMutableStateBuilderWithVersionHistories(
+				shard,
+				shard.GetEventsCache(),
+				logger,
+				namespaceEntry,
+			)
+		},
+		logger: logger,
+	}
+}
+
+func (r *workflowRestorerImpl) restoreWorkflow(
+	ctx context.Context,
+	namespaceEntry *cache.NamespaceCacheEntry,
+	execution commonpb.WorkflowExecution,
+) (retError error) {
+
+	namespaceID := namespaceEntry.GetInfo().Id
+	logger := r.logger.WithTags(
+		tag.WorkflowNamespaceID(namespaceID),
+		tag.WorkflowID(execution.GetWorkflowId()),
+		tag.WorkflowRunID(execution.GetRunId()),
+	)
+
+	context, release, err := r.historyCache.getOrCreateWorkflowExecution(ctx, namespaceID, execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	switch _, err := context.loadWorkflowExecution(); err.(type) {
+	case nil:
+		return serviceerror.NewWorkflowExecutionAlreadyStarted(
+			"Workflow execution to restore already exists.",
+			"",
+			execution.GetRunId(),
+		)
+	case *serviceerror.NotFound:
+		// workflow execution is not in the live cluster, proceed to restore it
+	default:
+		return err
+	}
+
+	historyBatches, err := r.readArchivedHistory(ctx, namespaceEntry, execution)
+	if err != nil {
+		return err
+	}
+	if len(historyBatches) == 0 || len(historyBatches[0].Events) == 0 {
+		return errRestoreHistoryEmpty
+	}
+	if historyBatches[0].Events[0].GetEventType() != enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED {
+		return errRestoreHistoryNotStarted
+	}
+
+	// persistence only creates running workflow executions, so the execution is first
+	// created from the start events and then overwritten by the state of the whole history
+	requestID := uuid.New()
+	startMutableState := r.newMutableState(namespaceEntry, logger)
+	if err := r.applyEvents(startMutableState, namespaceID, requestID, execution, historyBatches[:1], logger); err != nil {
+		return err
+	}
+	restoredMutableState := r.newMutableState(namespaceEntry, logger)
+	if err := r.applyEvents(restoredMutableState, namespaceID, requestID, execution, historyBatches, logger); err != nil {
+		return err
+	}
+	if !startMutableState.IsWorkflowExecutionRunning() || restoredMutableState.IsWorkflowExecutionRunning() {
+		return errRestoreHistoryNotClosed
+	}
+
+	// an existing current run of the workflow stays current, the restored run is written as zombie
+	createMode := persistence.CreateWorkflowModeBrandNew
+	conflictResolveMode := persistence.ConflictResolveWorkflowModeUpdateCurrent
+	_, err = r.executionMgr.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		NamespaceID: namespaceID,
+		WorkflowID:  execution.GetWorkflowId(),
+	})
+	switch err.(type) {
+	case nil:
+		createMode = persistence.CreateWorkflowModeZombie
+		conflictResolveMode = persistence.ConflictResolveWorkflowModeBypassCurrent
+		if err := startMutableState.UpdateWorkflowStateStatus(
+			enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE,
+			enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		); err != nil {
+			return err
+		}
+	case *serviceerror.NotFound:
+		// no current run, the restored run becomes current
+	default:
+		return err
+	}
+
+	historySize, err := r.persistHistory(context, restoredMutableState, namespaceID, execution, historyBatches)
+	if err != nil {
+		return err
+	}
+
+	now := r.shard.GetTimeSource().Now()
+	startSnapshot, _, err := startMutableState.CloseTransactionAsSnapshot(now, transactionPolicyPassive)
+	if err != nil {
+		return err
+	}
+	// tasks are generated from the restored state below, the start events must not
+	// dispatch workflow tasks or start timers
+	startSnapshot.TransferTasks = nil
+	startSnapshot.TimerTasks = nil
+	startSnapshot.ReplicationTasks = nil
+	if err := context.createWorkflowExecution(
+		startSnapshot,
+		historySize,
+		now,
+		createMode,
+		"",
+		common.EmptyVersion,
+	); err != nil {
+		return err
+	}
+
+	// discard the tasks generated while applying the history, close tasks are regenerated
+	// with the current time so retention of the restored execution starts now
+	if _, _, err := restoredMutableState.CloseTransactionAsSnapshot(now, transactionPolicyPassive); err != nil {
+		return err
+	}
+	restoredMutableState.SetUpdateCondition(startMutableState.GetUpdateCondition())
+	if err := r.taskRefresher.refreshTasks(now, restoredMutableState); err != nil {
+		return err
+	}
+
+	if err := context.conflictResolveWorkflowExecution(
+		now,
+		conflictResolveMode,
+		restoredMutableState,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	); err != nil {
+		logger.Error("workflowRestorer unable to write restored workflow execution", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *workflowRestorerImpl) readArchivedHistory(
+	ctx context.Context,
+	namespaceEntry *cache.NamespaceCacheEntry,
+	execution commonpb.WorkflowExecution,
+) ([]*historypb.History, error) {
+
+	URIString := namespaceEntry.GetConfig().HistoryArchivalUri
+	if URIString == "" {
+		return nil, errRestoreNamespaceNotArchived
+	}
+	URI, err := archiver.NewURI(URIString)
+	if err != nil {
+		return nil, err
+	}
+	historyArchiver, err := r.archiverProvider.GetHistoryArchiver(URI.Scheme(), common.HistoryServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &archiver.GetHistoryRequest{
+		NamespaceID: namespaceEntry.GetInfo().Id,
+		WorkflowID:  execution.GetWorkflowId(),
+		RunID:       execution.GetRunId(),
+		PageSize:    restoreHistoryPageSize,
+	}
+	var historyBatches []*historypb.History
+	for {
+		resp, err := historyArchiver.Get(ctx, URI, request)
+		if err != nil {
+			return nil, err
+		}
+		historyBatches = append(historyBatches, resp.HistoryBatches...)
+		if len(resp.NextPageToken) == 0 {
+			return historyBatches, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+func (r *workflowRestorerImpl) applyEvents(
+	mutableState mutableState,
+	namespaceID string,
+	requestID string,
+	execution commonpb.WorkflowExecution,
+	historyBatches []*historypb.History,
+	logger log.Logger,
+) error {
+
+	stateBuilder := r.newStateBuilder(mutableState, logger)
+	for _, batch := range historyBatches {
+		if _, err := stateBuilder.applyEvents(
+			namespaceID,
+			requestID,
+			execution,
+			batch.Events,
+			nil, // the new run of a continued as new workflow is not restored
+		); err != nil {
+			logger.Error("workflowRestorer unable to apply archived history.", tag.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *workflowRestorerImpl) persistHistory(
+	context workflowExecutionContext,
+	mutableState mutableState,
+	namespaceID string,
+	execution commonpb.WorkflowExecution,
+	historyBatches []*historypb.History,
+) (int64, error) {
+
+	branchToken, err := mutableState.GetCurrentBranchToken()
+	if err != nil {
+		return 0, err
+	}
+
+	historySize := int64(0)
+	for i, batch := range historyBatches {
+		workflowEvents := &persistence.WorkflowEvents{
+			NamespaceID: namespaceID,
+			WorkflowID:  execution.GetWorkflowId(),
+			RunID:       execution.GetRunId(),
+			BranchToken: branchToken,
+			Events:      batch.Events,
+		}
+		var size int64
+		if i == 0 {
+			size, err = context.persistFirstWorkflowEvents(workflowEvents)
+		} else {
+			size, err = context.persistNonFirstWorkflowEvents(workflowEvents)
+		}
+		if err != nil {
+			return 0, err
+		}
+		historySize += size
+	}
+	return historySize, nil
+}
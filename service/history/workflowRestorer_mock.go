@@ -23,51 +23,53 @@
 // THE SOFTWARE.
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: queryParser.go
+// Source: workflowRestorer.go
 
-// Package filestore is a generated GoMock package.
-package filestore
+// Package history is a generated GoMock package.
+package history
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	common "go.temporal.io/api/common/v1"
+	cache "go.temporal.io/server/common/cache"
 )
 
-// MockQueryParser is a mock of QueryParser interface.
-type MockQueryParser struct {
+// MockworkflowRestorer is a mock of workflowRestorer interface.
+type MockworkflowRestorer struct {
 	ctrl     *gomock.Controller
-	recorder *MockQueryParserMockRecorder
+	recorder *MockworkflowRestorerMockRecorder
 }
 
-// MockQueryParserMockRecorder is the mock recorder for MockQueryParser.
-type MockQueryParserMockRecorder struct {
-	mock *MockQueryParser
+// MockworkflowRestorerMockRecorder is the mock recorder for MockworkflowRestorer.
+type MockworkflowRestorerMockRecorder struct {
+	mock *MockworkflowRestorer
 }
 
-// NewMockQueryParser creates a new mock instance.
-func NewMockQueryParser(ctrl *gomock.Controller) *MockQueryParser {
-	mock := &MockQueryParser{ctrl: ctrl}
-	mock.recorder = &MockQueryParserMockRecorder{mock}
+// NewMockworkflowRestorer creates a new mock instance.
+func NewMockworkflowRestorer(ctrl *gomock.Controller) *MockworkflowRestorer {
+	mock := &MockworkflowRestorer{ctrl: ctrl}
+	mock.recorder = &MockworkflowRestorerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockQueryParser) EXPECT() *MockQueryParserMockRecorder {
+func (m *MockworkflowRestorer) EXPECT() *MockworkflowRestorerMockRecorder {
 	return m.recorder
 }
 
-// Parse mocks base method.
-func (m *MockQueryParser) Parse(query string) (*parsedQuery, error) {
+// restoreWorkflow mocks base method.
+func (m *MockworkflowRestorer) restoreWorkflow(ctx context.Context, namespaceEntry *cache.NamespaceCacheEntry, execution common.WorkflowExecution) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Parse", query)
-	ret0, _ := ret[0].(*parsedQuery)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "restoreWorkflow", ctx, namespaceEntry, execution)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Parse indicates an expected call of Parse.
-func (mr *MockQueryParserMockRecorder) Parse(query interface{}) *gomock.Call {
+// restoreWorkflow indicates an expected call of restoreWorkflow.
+func (mr *MockworkflowRestorerMockRecorder) restoreWorkflow(ctx, namespaceEntry, execution interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockQueryParser)(nil).Parse), query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "restoreWorkflow", reflect.TypeOf((*MockworkflowRestorer)(nil).restoreWorkflow), ctx, namespaceEntry, execution)
 }
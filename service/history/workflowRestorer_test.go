@@ -0,0 +1,322 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/mocks"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	workflowRestorerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller            *gomock.Controller
+		mockShard             *shardContextTest
+		mockEngine            *MockEngine
+		mockNamespaceCache    *cache.MockNamespaceCache
+		mockStateBuilder      *MockstateBuilder
+		mockTaskRefresher     *MockmutableStateTaskRefresher
+		mockStartMutableState *MockmutableState
+		mockMutableState      *MockmutableState
+
+		mockExecutionMgr    *mocks.ExecutionManager
+		mockHistoryV2Mgr    *mocks.HistoryV2Manager
+		mockHistoryArchiver *archiver.HistoryArchiverMock
+
+		namespaceEntry *cache.NamespaceCacheEntry
+		execution      commonpb.WorkflowExecution
+		historyBatches []*historypb.History
+
+		workflowRestorer *workflowRestorerImpl
+	}
+)
+
+func TestWorkflowRestorerSuite(t *testing.T) {
+	s := new(workflowRestorerSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowRestorerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockEngine = NewMockEngine(s.controller)
+	s.mockStateBuilder = NewMockstateBuilder(s.controller)
+	s.mockTaskRefresher = NewMockmutableStateTaskRefresher(s.controller)
+	s.mockStartMutableState = NewMockmutableState(s.controller)
+	s.mockMutableState = NewMockmutableState(s.controller)
+
+	s.mockShard = newTestShardContext(
+		s.controller,
+		&persistence.ShardInfoWithFailover{
+			ShardInfo: &persistenceblobs.ShardInfo{
+				ShardId:          0,
+				RangeId:          1,
+				TransferAckLevel: 0,
+			}},
+		NewDynamicConfigForTest(),
+	)
+	s.mockShard.SetEngine(s.mockEngine)
+	s.mockNamespaceCache = s.mockShard.resource.NamespaceCache
+	s.mockExecutionMgr = s.mockShard.resource.ExecutionMgr
+	s.mockHistoryV2Mgr = s.mockShard.resource.HistoryMgr
+	s.mockHistoryArchiver = &archiver.HistoryArchiverMock{}
+	s.mockShard.resource.ArchiverProvider.On("GetHistoryArchiver", "test", common.HistoryServiceName).Return(s.mockHistoryArchiver, nil).Maybe()
+
+	s.namespaceEntry = cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Id: testNamespaceID, Name: testNamespace},
+		&persistenceblobs.NamespaceConfig{
+			Retention:            timestamp.DurationFromDays(1),
+			HistoryArchivalState: enumspb.ARCHIVAL_STATE_ENABLED,
+			HistoryArchivalUri:   "test:///history/archival",
+		},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(testNamespaceID).Return(s.namespaceEntry, nil).AnyTimes()
+	s.mockShard.resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+
+	s.execution = commonpb.WorkflowExecution{
+		WorkflowId: testWorkflowID,
+		RunId:      testRunID,
+	}
+	s.historyBatches = []*historypb.History{
+		{Events: []*historypb.HistoryEvent{
+			{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+			{EventId: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+		}},
+		{Events: []*historypb.HistoryEvent{
+			{EventId: 3, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED},
+		}},
+	}
+
+	s.workflowRestorer = newWorkflowRestorer(
+		s.mockShard,
+		newHistoryCache(s.mockShard),
+		s.mockShard.GetLogger(),
+	)
+	s.workflowRestorer.taskRefresher = s.mockTaskRefresher
+	s.workflowRestorer.newStateBuilder = func(mutableState, log.Logger) stateBuilder {
+		return s.mockStateBuilder
+	}
+	mutableStates := []mutableState{s.mockStartMutableState, s.mockMutableState}
+	s.workflowRestorer.newMutableState = func(*cache.NamespaceCacheEntry, log.Logger) mutableState {
+		next := mutableStates[0]
+		mutableStates = mutableStates[1:]
+		return next
+	}
+}
+
+func (s *workflowRestorerSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockShard.Finish(s.T())
+	s.mockHistoryArchiver.AssertExpectations(s.T())
+}
+
+func (s *workflowRestorerSuite) TestRestoreWorkflow_AlreadyExists() {
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				NamespaceId: testNamespaceID,
+				WorkflowId:  testWorkflowID,
+				ExecutionState: &persistenceblobs.WorkflowExecutionState{
+					RunId:  testRunID,
+					State:  enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+					Status: enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED,
+				},
+			},
+			ExecutionStats: &persistenceblobs.ExecutionStats{},
+		},
+		MutableStateStats: &persistence.MutableStateStats{},
+	}, nil).Once()
+
+	err := s.workflowRestorer.restoreWorkflow(context.Background(), s.namespaceEntry, s.execution)
+	s.IsType(&serviceerror.WorkflowExecutionAlreadyStarted{}, err)
+}
+
+func (s *workflowRestorerSuite) TestRestoreWorkflow_NamespaceNotArchived() {
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, serviceerror.NewNotFound("")).Once()
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Id: testNamespaceID, Name: testNamespace},
+		&persistenceblobs.NamespaceConfig{Retention: timestamp.DurationFromDays(1)},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+
+	err := s.workflowRestorer.restoreWorkflow(context.Background(), namespaceEntry, s.execution)
+	s.Equal(errRestoreNamespaceNotArchived, err)
+}
+
+func (s *workflowRestorerSuite) TestRestoreWorkflow_HistoryNotStarted() {
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, serviceerror.NewNotFound("")).Once()
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: s.historyBatches[1:],
+	}, nil).Once()
+
+	err := s.workflowRestorer.restoreWorkflow(context.Background(), s.namespaceEntry, s.execution)
+	s.Equal(errRestoreHistoryNotStarted, err)
+}
+
+func (s *workflowRestorerSuite) TestRestoreWorkflow_HistoryNotClosed() {
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, serviceerror.NewNotFound("")).Once()
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: s.historyBatches[:1],
+	}, nil).Once()
+	s.mockStateBuilder.EXPECT().applyEvents(testNamespaceID, gomock.Any(), s.execution, s.historyBatches[0].Events, nil).Return(nil, nil).Times(2)
+	s.mockStartMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+
+	err := s.workflowRestorer.restoreWorkflow(context.Background(), s.namespaceEntry, s.execution)
+	s.Equal(errRestoreHistoryNotClosed, err)
+}
+
+func (s *workflowRestorerSuite) TestRestoreWorkflow_Success_NoCurrentRun() {
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, serviceerror.NewNotFound("")).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(nil, serviceerror.NewNotFound("")).Once()
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: s.historyBatches[:1],
+		NextPageToken:  []byte("some random next page token"),
+	}, nil).Once()
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.GetHistoryRequest) bool {
+		return string(request.NextPageToken) == "some random next page token"
+	})).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: s.historyBatches[1:],
+	}, nil).Once()
+
+	s.expectRestore(persistence.CreateWorkflowModeBrandNew, persistence.ConflictResolveWorkflowModeUpdateCurrent)
+
+	err := s.workflowRestorer.restoreWorkflow(context.Background(), s.namespaceEntry, s.execution)
+	s.NoError(err)
+}
+
+func (s *workflowRestorerSuite) TestRestoreWorkflow_Success_CurrentRunExists() {
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, serviceerror.NewNotFound("")).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+		RunID: "some random current run ID",
+	}, nil).Once()
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: s.historyBatches,
+	}, nil).Once()
+	s.mockStartMutableState.EXPECT().UpdateWorkflowStateStatus(
+		enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE,
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	).Return(nil).Times(1)
+
+	s.expectRestore(persistence.CreateWorkflowModeZombie, persistence.ConflictResolveWorkflowModeBypassCurrent)
+
+	err := s.workflowRestorer.restoreWorkflow(context.Background(), s.namespaceEntry, s.execution)
+	s.NoError(err)
+}
+
+func (s *workflowRestorerSuite) expectRestore(
+	createMode persistence.CreateWorkflowMode,
+	conflictResolveMode persistence.ConflictResolveWorkflowMode,
+) {
+	branchToken := []byte("some random branch token")
+	updateCondition := int64(3)
+
+	gomock.InOrder(
+		s.mockStateBuilder.EXPECT().applyEvents(testNamespaceID, gomock.Any(), s.execution, s.historyBatches[0].Events, nil).Return(nil, nil),
+		s.mockStateBuilder.EXPECT().applyEvents(testNamespaceID, gomock.Any(), s.execution, s.historyBatches[0].Events, nil).Return(nil, nil),
+		s.mockStateBuilder.EXPECT().applyEvents(testNamespaceID, gomock.Any(), s.execution, s.historyBatches[1].Events, nil).Return(nil, nil),
+	)
+	s.mockStartMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(false).AnyTimes()
+	s.mockMutableState.EXPECT().GetCurrentBranchToken().Return(branchToken, nil).AnyTimes()
+
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.MatchedBy(func(request *persistence.AppendHistoryNodesRequest) bool {
+		return request.IsNewBranch && len(request.Events) == 2
+	})).Return(&persistence.AppendHistoryNodesResponse{Size: 20}, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.MatchedBy(func(request *persistence.AppendHistoryNodesRequest) bool {
+		return !request.IsNewBranch && len(request.Events) == 1
+	})).Return(&persistence.AppendHistoryNodesResponse{Size: 10}, nil).Once()
+
+	executionInfo := &persistence.WorkflowExecutionInfo{
+		NamespaceId: testNamespaceID,
+		WorkflowId:  testWorkflowID,
+		ExecutionState: &persistenceblobs.WorkflowExecutionState{
+			RunId: testRunID,
+		},
+	}
+	s.mockStartMutableState.EXPECT().CloseTransactionAsSnapshot(gomock.Any(), transactionPolicyPassive).Return(&persistence.WorkflowSnapshot{
+		ExecutionInfo: executionInfo,
+		TransferTasks: []persistence.Task{&persistence.WorkflowTask{}},
+		TimerTasks:    []persistence.Task{&persistence.WorkflowTimeoutTask{}},
+	}, nil, nil).Times(1)
+	s.mockStartMutableState.EXPECT().GetUpdateCondition().Return(updateCondition).Times(1)
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(request *persistence.CreateWorkflowExecutionRequest) bool {
+		return request.Mode == createMode &&
+			len(request.NewWorkflowSnapshot.TransferTasks) == 0 &&
+			len(request.NewWorkflowSnapshot.TimerTasks) == 0 &&
+			request.NewWorkflowSnapshot.ExecutionStats.HistorySize == 30
+	})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
+
+	closeTask := &persistence.CloseExecutionTask{}
+	gomock.InOrder(
+		s.mockMutableState.EXPECT().CloseTransactionAsSnapshot(gomock.Any(), transactionPolicyPassive).Return(&persistence.WorkflowSnapshot{}, nil, nil),
+		s.mockMutableState.EXPECT().SetUpdateCondition(updateCondition),
+		s.mockTaskRefresher.EXPECT().refreshTasks(gomock.Any(), s.mockMutableState).Return(nil),
+		s.mockMutableState.EXPECT().CloseTransactionAsSnapshot(gomock.Any(), transactionPolicyPassive).Return(&persistence.WorkflowSnapshot{
+			ExecutionInfo: executionInfo,
+			TransferTasks: []persistence.Task{closeTask},
+		}, nil, nil),
+	)
+	s.mockExecutionMgr.On("ConflictResolveWorkflowExecution", mock.MatchedBy(func(request *persistence.ConflictResolveWorkflowExecutionRequest) bool {
+		return request.Mode == conflictResolveMode &&
+			len(request.ResetWorkflowSnapshot.TransferTasks) == 1 &&
+			request.ResetWorkflowSnapshot.ExecutionStats.HistorySize == 30
+	})).Return(nil).Once()
+
+	s.mockMutableState.EXPECT().GetWorkflowStateStatus().Return(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED).AnyTimes()
+	s.mockMutableState.EXPECT().GetLastFirstEventID().Return(int64(3)).AnyTimes()
+	s.mockMutableState.EXPECT().GetNextEventID().Return(int64(4)).AnyTimes()
+	s.mockMutableState.EXPECT().GetPreviousStartedEventID().Return(common.EmptyEventID).AnyTimes()
+	s.mockEngine.EXPECT().NotifyNewHistoryEvent(gomock.Any()).AnyTimes()
+	s.mockEngine.EXPECT().NotifyNewTransferTasks(gomock.Any()).AnyTimes()
+	s.mockEngine.EXPECT().NotifyNewReplicationTasks(gomock.Any()).AnyTimes()
+	s.mockEngine.EXPECT().NotifyNewTimerTasks(gomock.Any()).AnyTimes()
+}
@@ -217,7 +217,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandScheduleActivity(
 
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_SCHEDULE_ACTIVITY_TASK.String()),
-		attr.GetInput().Size(),
+		handler.sizeLimitChecker.persistedPayloadSize(attr.GetInput().Size(), attr.GetInput()),
 		"ScheduleActivityTaskCommandAttributes.Input exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -345,7 +345,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandCompleteWorkflow(
 
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_COMPLETE_WORKFLOW_EXECUTION.String()),
-		attr.GetResult().Size(),
+		handler.sizeLimitChecker.persistedPayloadSize(attr.GetResult().Size(), attr.GetResult()),
 		"CompleteWorkflowExecutionCommandAttributes.Result exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -420,7 +420,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandFailWorkflow(
 
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_FAIL_WORKFLOW_EXECUTION.String()),
-		attr.GetFailure().Size(),
+		handler.sizeLimitChecker.persistedPayloadSize(attr.GetFailure().Size(), attr.GetFailure()),
 		"FailWorkflowExecutionCommandAttributes.Failure exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -616,7 +616,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandRecordMarker(
 
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_RECORD_MARKER.String()),
-		common.GetPayloadsMapSize(attr.GetDetails()),
+		handler.sizeLimitChecker.persistedPayloadSize(common.GetPayloadsMapSize(attr.GetDetails()), attr.GetDetails()),
 		"RecordMarkerCommandAttributes.Details exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -657,7 +657,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandContinueAsNewWorkflow(
 
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_CONTINUE_AS_NEW_WORKFLOW_EXECUTION.String()),
-		attr.GetInput().Size(),
+		handler.sizeLimitChecker.persistedPayloadSize(attr.GetInput().Size(), attr.GetInput()),
 		"ContinueAsNewWorkflowExecutionCommandAttributes. Input exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -754,7 +754,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandStartChildWorkflow(
 
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_START_CHILD_WORKFLOW_EXECUTION.String()),
-		attr.GetInput().Size(),
+		handler.sizeLimitChecker.persistedPayloadSize(attr.GetInput().Size(), attr.GetInput()),
 		"StartChildWorkflowExecutionCommandAttributes.Input exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
@@ -817,7 +817,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandSignalExternalWorkflow(
 
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION.String()),
-		attr.GetInput().Size(),
+		handler.sizeLimitChecker.persistedPayloadSize(attr.GetInput().Size(), attr.GetInput()),
 		"SignalExternalWorkflowExecutionCommandAttributes.Input exceeds size limit.",
 	)
 	if err != nil || failWorkflow {
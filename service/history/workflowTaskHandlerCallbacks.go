@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -171,6 +172,9 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
 			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				return nil, ErrWorkflowPaused
+			}
 
 			workflowTask, isRunning := mutableState.GetWorkflowTaskInfo(scheduleID)
 
@@ -196,7 +200,7 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 			if workflowTask.StartedID != common.EmptyEventID {
 				// If workflow task is started as part of the current request scope then return a positive response
 				if workflowTask.RequestID == requestID {
-					resp, err = handler.createRecordWorkflowTaskStartedResponse(namespaceID, mutableState, workflowTask, req.PollRequest.GetIdentity())
+					resp, err = handler.createRecordWorkflowTaskStartedResponse(namespaceEntry, mutableState, context.getHistorySize(), workflowTask, req.PollRequest.GetIdentity())
 					if err != nil {
 						return nil, err
 					}
@@ -215,7 +219,7 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 				return nil, serviceerror.NewInternal("Unable to add WorkflowTaskStarted event to history.")
 			}
 
-			resp, err = handler.createRecordWorkflowTaskStartedResponse(namespaceID, mutableState, workflowTask, req.PollRequest.GetIdentity())
+			resp, err = handler.createRecordWorkflowTaskStartedResponse(namespaceEntry, mutableState, context.getHistorySize(), workflowTask, req.PollRequest.GetIdentity())
 			if err != nil {
 				return nil, err
 			}
@@ -395,6 +399,8 @@ Update_History_Loop:
 				handler.config.HistorySizeLimitError(namespace),
 				handler.config.HistoryCountLimitWarn(namespace),
 				handler.config.HistoryCountLimitError(namespace),
+				handler.shard.GetService().GetLargePayloadOffloader(),
+				handler.config.LargePayloadThreshold(namespace),
 				completedEvent.GetEventId(),
 				msBuilder,
 				executionStats,
@@ -449,6 +455,26 @@ Update_History_Loop:
 			continueAsNewBuilder = nil
 		}
 
+		if msBuilder.IsWorkflowExecutionRunning() {
+			historySize := weContext.getHistorySize()
+			historyCount := msBuilder.GetNextEventID() - 1
+			newlySuggested, err := msBuilder.UpdateHistorySizeSearchAttributes(
+				historySize,
+				suggestContinueAsNew(handler.config, namespaceEntry.GetInfo().Name, historySize, historyCount),
+				handler.shard.GetTimeSource().Now(),
+			)
+			if err != nil {
+				return nil, err
+			}
+			if newlySuggested {
+				// the suggestion is recorded in history, so the workflow reads the same values when it is replayed
+				if err := addContinueAsNewSuggestedSignal(msBuilder, historySize, historyCount); err != nil {
+					return nil, err
+				}
+				hasUnhandledEvents = true
+			}
+		}
+
 		createNewWorkflowTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewWorkflowTask() || activityNotStartedCancelled)
 		var newWorkflowTaskScheduledID int64
 		if createNewWorkflowTask {
@@ -556,7 +582,7 @@ Update_History_Loop:
 		resp = &historyservice.RespondWorkflowTaskCompletedResponse{}
 		if request.GetReturnNewWorkflowTask() && createNewWorkflowTask {
 			workflowTask, _ := msBuilder.GetWorkflowTaskInfo(newWorkflowTaskScheduledID)
-			resp.StartedResponse, err = handler.createRecordWorkflowTaskStartedResponse(namespaceID, msBuilder, workflowTask, request.GetIdentity())
+			resp.StartedResponse, err = handler.createRecordWorkflowTaskStartedResponse(namespaceEntry, msBuilder, weContext.getHistorySize(), workflowTask, request.GetIdentity())
 			if err != nil {
 				return nil, err
 			}
@@ -571,8 +597,9 @@ Update_History_Loop:
 }
 
 func (handler *workflowTaskHandlerCallbacksImpl) createRecordWorkflowTaskStartedResponse(
-	namespaceID string,
+	namespaceEntry *cache.NamespaceCacheEntry,
 	msBuilder mutableState,
+	historySize int64,
 	workflowTask *workflowTaskInfo,
 	identity string,
 ) (*historyservice.RecordWorkflowTaskStartedResponse, error) {
@@ -597,6 +624,13 @@ func (handler *workflowTaskHandlerCallbacksImpl) createRecordWorkflowTaskStarted
 	}
 	response.ScheduledTime = workflowTask.ScheduledTimestamp
 	response.StartedTime = workflowTask.StartedTimestamp
+	response.HistorySizeBytes = historySize
+	response.SuggestContinueAsNew = suggestContinueAsNew(
+		handler.config,
+		namespaceEntry.GetInfo().Name,
+		historySize,
+		msBuilder.GetNextEventID()-1,
+	)
 
 	if workflowTask.Attempt > 1 {
 		// This workflowTask is retried from mutable state
@@ -723,3 +757,35 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleBufferedQueries(msBuilder
 		}
 	}
 }
+
+// addContinueAsNewSuggestedSignal records the signal telling the workflow that continue-as-new is suggested,
+// together with the history size and event count the suggestion is based on
+func addContinueAsNewSuggestedSignal(
+	msBuilder mutableState,
+	historySize int64,
+	historyCount int64,
+) error {
+
+	input, err := payloads.Encode(map[string]int64{
+		definition.HistorySizeBytes:  historySize,
+		definition.HistoryEventCount: historyCount,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = msBuilder.AddWorkflowExecutionSignaled(common.ContinueAsNewSuggestedSignalName, input, identityHistoryService)
+	return err
+}
+
+// isHistoryServiceSignal tells if the event is a signal recorded by history itself, like the one recorded
+// when continue-as-new is suggested or the workflow is paused, it is specific to its run and never reapplied
+// to another one
+func isHistoryServiceSignal(
+	event *historypb.HistoryEvent,
+) bool {
+
+	attributes := event.GetWorkflowExecutionSignaledEventAttributes()
+	return event.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED &&
+		common.IsReservedSignalName(attributes.GetSignalName()) &&
+		attributes.GetIdentity() == identityHistoryService
+}
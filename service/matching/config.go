@@ -54,6 +54,9 @@ type (
 		ForwarderMaxRatePerSecond    dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		ForwarderMaxChildrenPerNode  dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 
+		// SubscribeNumTaskqueueReadPartitions notifies about changes of NumTaskqueueReadPartitions
+		SubscribeNumTaskqueueReadPartitions dynamicconfig.SubscribeFnWithTaskQueueInfoFilters
+
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 		MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
@@ -90,6 +93,7 @@ type (
 		MaxTaskBatchSize                func() int
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
+		SubscribeNumReadPartitions      func(onChange func()) func()
 	}
 )
 
@@ -118,6 +122,8 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		ForwarderMaxRatePerSecond:       dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond, 10),
 		ForwarderMaxChildrenPerNode:     dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode, 20),
 		ShutdownDrainDuration:           dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration, 0),
+
+		SubscribeNumTaskqueueReadPartitions: dc.GetSubscriptionFilteredByTaskQueueInfo(dynamicconfig.MatchingNumTaskqueueReadPartitions),
 	}
 }
 
@@ -168,6 +174,9 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespaceCache cache.Na
 		NumReadPartitions: func() int {
 			return common.MaxInt(1, config.NumTaskqueueReadPartitions(namespace, taskQueueName, taskType))
 		},
+		SubscribeNumReadPartitions: func(onChange func()) func() {
+			return config.SubscribeNumTaskqueueReadPartitions(namespace, taskQueueName, taskType, onChange)
+		},
 		forwarderConfig: forwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return config.ForwarderMaxOutstandingPolls(namespace, taskQueueName, taskType)
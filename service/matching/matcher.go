@@ -27,6 +27,7 @@ package matching
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/time/rate"
@@ -54,6 +55,7 @@ type TaskMatcher struct {
 	fwdr          *Forwarder
 	scope         func() metrics.Scope // namespace metric scope
 	numPartitions func() int           // number of task queue partitions
+	rps           atomic.Value         // last task queue dispatch rate set by pollers
 }
 
 const (
@@ -300,7 +302,18 @@ func (tm *TaskMatcher) UpdateRatelimit(rps *float64) {
 	if rps == nil {
 		return
 	}
-	rate := *rps
+	tm.rps.Store(*rps)
+	tm.updateRatelimit(*rps)
+}
+
+// RefreshRatelimit reapplies the last task dispatch rate, e.g. after the number of partitions changed
+func (tm *TaskMatcher) RefreshRatelimit() {
+	if rps, ok := tm.rps.Load().(float64); ok {
+		tm.updateRatelimit(rps)
+	}
+}
+
+func (tm *TaskMatcher) updateRatelimit(rate float64) {
 	nPartitions := tm.numPartitions()
 	if rate > float64(nPartitions) {
 		// divide the rate equally across all partitions
@@ -474,6 +474,21 @@ func (t *MatcherTestSuite) TestRemotePollForQuery() {
 	t.True(task.isStarted())
 }
 
+func (t *MatcherTestSuite) TestRefreshRatelimit() {
+	// nothing to refresh before pollers set a rate
+	t.matcher.RefreshRatelimit()
+	t.Equal(_defaultTaskDispatchRPS, t.matcher.Rate())
+
+	rps := 100.0
+	t.matcher.UpdateRatelimit(&rps)
+	t.Equal(rps/float64(dynamicconfig.DefaultNumTaskQueuePartitions), t.matcher.Rate())
+
+	// more partitions lower the per partition rate, which the limiter applies immediately
+	t.matcher.numPartitions = func() int { return 5 }
+	t.matcher.RefreshRatelimit()
+	t.Equal(rps/5, t.matcher.Rate())
+}
+
 func (t *MatcherTestSuite) newNamespaceCache() cache.NamespaceCache {
 	entry := cache.NewLocalNamespaceCacheEntryForTest(
 		&persistenceblobs.NamespaceInfo{Name: "test-namespace"},
@@ -389,6 +389,11 @@ pollLoop:
 				e.logger.Debug(fmt.Sprintf("Duplicated workflow task taskQueue=%v, taskID=%v",
 					taskQueueName, task.event.GetTaskId()))
 				task.finish(nil)
+			case *serviceerrors.FailedPrecondition:
+				// the workflow is paused, history dispatches the task again when it is unpaused
+				e.logger.Debug(fmt.Sprintf("Workflow task of paused workflow taskQueue=%v, taskID=%v",
+					taskQueueName, task.event.GetTaskId()))
+				task.finish(nil)
 			default:
 				task.finish(err)
 			}
@@ -455,6 +460,10 @@ pollLoop:
 			case *serviceerror.NotFound, *serviceerrors.TaskAlreadyStarted:
 				e.logger.Debug("Duplicated activity task", tag.Name(taskQueueName), tag.TaskID(task.event.GetTaskId()))
 				task.finish(nil)
+			case *serviceerrors.FailedPrecondition:
+				// the workflow or activity is paused, history dispatches the task again when it is unpaused
+				e.logger.Debug("Activity task of paused activity", tag.Name(taskQueueName), tag.TaskID(task.event.GetTaskId()))
+				task.finish(nil)
 			default:
 				task.finish(err)
 			}
@@ -808,7 +817,7 @@ func (e *matchingEngineImpl) recordWorkflowTaskStarted(
 	}
 	err := backoff.Retry(op, historyServiceOperationRetryPolicy, func(err error) bool {
 		switch err.(type) {
-		case *serviceerror.NotFound, *serviceerrors.TaskAlreadyStarted:
+		case *serviceerror.NotFound, *serviceerrors.TaskAlreadyStarted, *serviceerrors.FailedPrecondition:
 			return false
 		}
 		return true
@@ -837,7 +846,7 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 	}
 	err := backoff.Retry(op, historyServiceOperationRetryPolicy, func(err error) bool {
 		switch err.(type) {
-		case *serviceerror.NotFound, *serviceerrors.TaskAlreadyStarted:
+		case *serviceerror.NotFound, *serviceerrors.TaskAlreadyStarted, *serviceerrors.FailedPrecondition:
 			return false
 		}
 		return true
@@ -121,6 +121,8 @@ type (
 		shutdownCh chan struct{}  // Delivers stop to the pump that populates taskBuffer
 		startWG    sync.WaitGroup // ensures that background processes do not start until setup is ready
 		stopped    int32
+		// unsubscribeConfig cancels the subscriptions to dynamic config changes
+		unsubscribeConfig func()
 	}
 )
 
@@ -183,6 +185,8 @@ func newTaskQueueManager(
 		fwdr = newForwarder(&taskQueueConfig.forwarderConfig, taskQueue, taskQueueKind, e.matchingClient)
 	}
 	tlMgr.matcher = newTaskMatcher(taskQueueConfig, fwdr, tlMgr.metricScope)
+	// rate limit is divided across partitions, apply it as soon as the number of partitions changes
+	tlMgr.unsubscribeConfig = taskQueueConfig.SubscribeNumReadPartitions(tlMgr.matcher.RefreshRatelimit)
 	tlMgr.startWG.Add(1)
 	return tlMgr, nil
 }
@@ -212,6 +216,7 @@ func (c *taskQueueManagerImpl) Stop() {
 		return
 	}
 	close(c.shutdownCh)
+	c.unsubscribeConfig()
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
 	c.engine.removeTaskQueueManager(c.taskQueueID)
@@ -27,6 +27,8 @@ package archiver
 import (
 	"context"
 
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/temporal"
 
@@ -34,6 +36,7 @@ import (
 	"go.temporal.io/server/common"
 	carchiver "go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
@@ -44,6 +47,8 @@ const (
 	uploadHistoryActivityFnName     = "uploadHistoryActivity"
 	deleteHistoryActivityFnName     = "deleteHistoryActivity"
 	archiveVisibilityActivityFnName = "archiveVisibilityActivity"
+
+	bundleHistoryBlobSize = 2 * 1024 * 1024 // 2MB
 )
 
 var (
@@ -76,7 +81,7 @@ func uploadHistoryActivity(ctx context.Context, request ArchiveRequest) (err err
 		logger.Error(carchiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason("failed to get history archiver"), tag.Error(err))
 		return errUploadNonRetryable
 	}
-	err = historyArchiver.Archive(ctx, URI, &carchiver.ArchiveHistoryRequest{
+	historyRequest := &carchiver.ArchiveHistoryRequest{
 		ShardID:              request.ShardID,
 		NamespaceID:          request.NamespaceID,
 		Namespace:            request.Namespace,
@@ -85,7 +90,11 @@ func uploadHistoryActivity(ctx context.Context, request ArchiveRequest) (err err
 		BranchToken:          request.BranchToken,
 		NextEventID:          request.NextEventID,
 		CloseFailoverVersion: request.CloseFailoverVersion,
-	}, carchiver.GetHeartbeatArchiveOption(), carchiver.GetNonRetryableErrorOption(errUploadNonRetryable))
+	}
+	err = historyArchiver.Archive(ctx, URI, historyRequest, carchiver.GetHeartbeatArchiveOption(), carchiver.GetNonRetryableErrorOption(errUploadNonRetryable))
+	if err == nil && request.ArchiveBundle {
+		err = uploadBundle(ctx, container, historyArchiver, URI, historyRequest, &request, logger)
+	}
 	if err == nil {
 		return nil
 	}
@@ -97,6 +106,54 @@ func uploadHistoryActivity(ctx context.Context, request ArchiveRequest) (err err
 	return err
 }
 
+// uploadBundle archives the workflow bundle once its history has been archived. The history is
+// read again rather than kept from the history upload, which is free to stream it in parts.
+func uploadBundle(
+	ctx context.Context,
+	container *BootstrapContainer,
+	historyArchiver carchiver.HistoryArchiver,
+	URI carchiver.URI,
+	historyRequest *carchiver.ArchiveHistoryRequest,
+	request *ArchiveRequest,
+	logger log.Logger,
+) error {
+	bundleArchiver, ok := historyArchiver.(carchiver.BundleArchiver)
+	if !ok {
+		logger.Warn("history archiver does not support workflow bundles, skipping bundle archival", tag.ArchivalURI(request.HistoryURI))
+		return nil
+	}
+
+	var historyBatches []*historypb.History
+	historyIterator := carchiver.NewHistoryIterator(historyRequest, container.HistoryV2Manager, bundleHistoryBlobSize)
+	for historyIterator.HasNext() {
+		historyBlob, err := historyIterator.Next()
+		if err != nil {
+			if !common.IsPersistenceTransientError(err) {
+				logger.Error(carchiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason("failed to read history for workflow bundle"), tag.Error(err))
+				return errUploadNonRetryable
+			}
+			return err
+		}
+		historyBatches = append(historyBatches, historyBlob.Body...)
+	}
+
+	bundle, err := carchiver.NewWorkflowBundle(&carchiver.ArchiveBundleRequest{
+		ArchiveHistoryRequest: *historyRequest,
+		WorkflowTypeName:      request.WorkflowTypeName,
+		Status:                request.Status,
+		StartTime:             request.StartTime,
+		CloseTime:             request.CloseTime,
+		MutableState:          request.MutableState,
+		Memo:                  request.Memo,
+		SearchAttributes:      &commonpb.SearchAttributes{IndexedFields: request.SearchAttributes},
+	}, historyBatches, container.ClusterMetadata.GetCurrentClusterName())
+	if err != nil {
+		logger.Error(carchiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason("failed to build workflow bundle"), tag.Error(err))
+		return errUploadNonRetryable
+	}
+	return bundleArchiver.ArchiveBundle(ctx, URI, bundle, carchiver.GetNonRetryableErrorOption(errUploadNonRetryable))
+}
+
 func deleteHistoryActivity(ctx context.Context, request ArchiveRequest) (err error) {
 	container := ctx.Value(bootstrapContainerKey).(*BootstrapContainer)
 	scope := container.MetricsClient.Scope(metrics.ArchiverDeleteHistoryActivityScope, metrics.NamespaceTag(request.Namespace))
@@ -114,15 +171,24 @@ func deleteHistoryActivity(ctx context.Context, request ArchiveRequest) (err err
 		BranchToken: request.BranchToken,
 		ShardID:     convert.IntPtr(request.ShardID),
 	})
-	if err == nil {
+	logger := tagLoggerWithHistoryRequest(tagLoggerWithActivityInfo(container.Logger, activity.GetInfo(ctx)), &request)
+	if err != nil {
+		logger.Error("failed to delete history events", tag.Error(err))
+		if !common.IsPersistenceTransientError(err) {
+			return errDeleteNonRetryable
+		}
+		return err
+	}
+	if container.LargePayloadOffloader == nil {
 		return nil
 	}
-	logger := tagLoggerWithHistoryRequest(tagLoggerWithActivityInfo(container.Logger, activity.GetInfo(ctx)), &request)
-	logger.Error("failed to delete history events", tag.Error(err))
-	if !common.IsPersistenceTransientError(err) {
-		return errDeleteNonRetryable
+	// the archived history holds the offloaded payloads, their blobs are deleted with the history
+	execution := commonpb.WorkflowExecution{WorkflowId: request.WorkflowID, RunId: request.RunID}
+	if err := container.LargePayloadOffloader.DeleteExecution(ctx, request.NamespaceID, execution); err != nil {
+		logger.Error("failed to delete large payloads", tag.Error(err))
+		return err
 	}
-	return err
+	return nil
 }
 
 func archiveVisibilityActivity(ctx context.Context, request ArchiveRequest) (err error) {
@@ -31,20 +31,25 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/activity"
 	"go.uber.org/zap"
 
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/worker"
 
+	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common"
 	carchiver "go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/loggerimpl"
 	"go.temporal.io/server/common/metrics"
 	mmocks "go.temporal.io/server/common/metrics/mocks"
 	"go.temporal.io/server/common/mocks"
+	"go.temporal.io/server/common/persistence"
 )
 
 const (
@@ -64,6 +69,11 @@ var (
 	errPersistenceNonRetryable = errors.New("persistence non-retryable error")
 )
 
+type bundleHistoryArchiverMock struct {
+	*carchiver.HistoryArchiverMock
+	*carchiver.BundleArchiverMock
+}
+
 type activitiesSuite struct {
 	suite.Suite
 	testsuite.WorkflowTestSuite
@@ -240,6 +250,86 @@ func (s *activitiesSuite) TestUploadHistory_Success() {
 	s.NoError(err)
 }
 
+func (s *activitiesSuite) TestUploadHistory_Success_WithBundle() {
+	s.metricsClient.On("Scope", metrics.ArchiverUploadHistoryActivityScope, []metrics.Tag{metrics.NamespaceTag(testNamespace)}).Return(s.metricsScope).Once()
+	historyBatches := []*historypb.History{
+		{Events: []*historypb.HistoryEvent{{EventId: 1, Version: testCloseFailoverVersion}, {EventId: 2, Version: testCloseFailoverVersion}}},
+	}
+	mockHistoryV2Manager := &mocks.HistoryV2Manager{}
+	mockHistoryV2Manager.On("ReadHistoryBranchByBatch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == common.FirstEventID
+	})).Return(&persistence.ReadHistoryBranchByBatchResponse{History: historyBatches}, nil)
+	mockHistoryV2Manager.On("ReadHistoryBranchByBatch", mock.Anything).Return(nil, serviceerror.NewNotFound("no more history"))
+	bundleArchiver := &carchiver.BundleArchiverMock{}
+	bundleArchiver.On("ArchiveBundle", mock.Anything, mock.Anything, mock.MatchedBy(func(bundle *archiverspb.WorkflowBundle) bool {
+		return bundle.Header.GetRunId() == testRunID &&
+			bundle.Header.GetSourceCluster() == cluster.TestCurrentClusterName &&
+			bundle.GetMutableState() == "{}" &&
+			s.Equal(historyBatches, bundle.GetHistory())
+	}), mock.Anything).Return(nil).Once()
+	s.historyArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, common.WorkerServiceName).Return(&bundleHistoryArchiverMock{
+		HistoryArchiverMock: s.historyArchiver,
+		BundleArchiverMock:  bundleArchiver,
+	}, nil)
+	container := &BootstrapContainer{
+		Logger:           s.logger,
+		MetricsClient:    s.metricsClient,
+		HistoryV2Manager: mockHistoryV2Manager,
+		ClusterMetadata:  cluster.GetTestClusterMetadata(false, true),
+		ArchiverProvider: s.archiverProvider,
+	}
+	env := s.NewTestActivityEnvironment()
+	s.registerWorkflows(env)
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	request := ArchiveRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          3,
+		CloseFailoverVersion: testCloseFailoverVersion,
+		HistoryURI:           testArchivalURI,
+		ArchiveBundle:        true,
+		MutableState:         "{}",
+	}
+	_, err := env.ExecuteActivity(uploadHistoryActivity, request)
+	s.NoError(err)
+	bundleArchiver.AssertExpectations(s.T())
+}
+
+func (s *activitiesSuite) TestUploadHistory_Success_BundleNotSupported() {
+	s.metricsClient.On("Scope", metrics.ArchiverUploadHistoryActivityScope, []metrics.Tag{metrics.NamespaceTag(testNamespace)}).Return(s.metricsScope).Once()
+	s.historyArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, common.WorkerServiceName).Return(s.historyArchiver, nil)
+	container := &BootstrapContainer{
+		Logger:           s.logger,
+		MetricsClient:    s.metricsClient,
+		ArchiverProvider: s.archiverProvider,
+	}
+	env := s.NewTestActivityEnvironment()
+	s.registerWorkflows(env)
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	request := ArchiveRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+		HistoryURI:           testArchivalURI,
+		ArchiveBundle:        true,
+	}
+	_, err := env.ExecuteActivity(uploadHistoryActivity, request)
+	s.NoError(err)
+}
+
 func (s *activitiesSuite) TestDeleteHistoryActivity_Fail_DeleteFromV2NonRetryableError() {
 	s.metricsClient.On("Scope", metrics.ArchiverDeleteHistoryActivityScope, []metrics.Tag{metrics.NamespaceTag(testNamespace)}).Return(s.metricsScope).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverNonRetryableErrorCount).Once()
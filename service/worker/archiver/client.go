@@ -84,6 +84,11 @@ type (
 		SearchAttributes map[string]*commonpb.Payload
 		VisibilityURI    string
 
+		// workflow bundle archival, the bundle is archived by the history upload activity
+		// and uses the workflow type, times, status, memo and search attributes above
+		ArchiveBundle bool
+		MutableState  string
+
 		// archival targets: history and/or visibility
 		Targets []ArchivalTarget
 	}
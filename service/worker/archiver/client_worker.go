@@ -36,6 +36,8 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/largepayload"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -62,8 +64,12 @@ type (
 		Logger           log.Logger
 		HistoryV2Manager persistence.HistoryManager
 		NamespaceCache   cache.NamespaceCache
+		ClusterMetadata  cluster.Metadata
 		Config           *Config
 		ArchiverProvider provider.ArchiverProvider
+
+		// LargePayloadOffloader deletes the payloads offloaded from the archived histories, nil skips the deletion
+		LargePayloadOffloader largepayload.Offloader
 	}
 
 	// Config for ClientWorker
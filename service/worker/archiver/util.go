@@ -100,11 +100,15 @@ func tagLoggerWithActivityInfo(logger log.Logger, activityInfo activity.Info) lo
 		tag.Attempt(activityInfo.Attempt))
 }
 
+// convertSearchAttributesToString decodes string search attributes, values of other types
+// are kept in their JSON encoding so archived visibility queries can still compare them
 func convertSearchAttributesToString(searchAttr map[string]*commonpb.Payload) map[string]string {
 	searchAttrStr := make(map[string]string)
 	for k, v := range searchAttr {
 		var s string
-		_ = payload.Decode(v, &s)
+		if err := payload.Decode(v, &s); err != nil {
+			s = string(v.GetData())
+		}
 		searchAttrStr[k] = s
 	}
 	return searchAttrStr
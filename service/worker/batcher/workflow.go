@@ -38,6 +38,8 @@ import (
 	"go.temporal.io/sdk/workflow"
 	"golang.org/x/time/rate"
 
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log"
@@ -73,10 +75,14 @@ const (
 	BatchTypeCancel = "cancel"
 	// BatchTypeSignal is batch type for signaling workflows
 	BatchTypeSignal = "signal"
+	// BatchTypePause is batch type for pausing workflows
+	BatchTypePause = "pause"
+	// BatchTypeUnpause is batch type for unpausing workflows
+	BatchTypeUnpause = "unpause"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal}
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypePause, BatchTypeUnpause}
 
 type (
 	// TerminateParams is the parameters for terminating workflow
@@ -109,7 +115,7 @@ type (
 		Query string
 		// Reason for the operation
 		Reason string
-		// Supporting: signal,cancel,terminate
+		// Supporting: signal,cancel,terminate,pause,unpause
 		BatchType string
 
 		// Below are all optional
@@ -195,7 +201,7 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
-	case BatchTypeCancel, BatchTypeTerminate:
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypePause, BatchTypeUnpause:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
@@ -231,6 +237,7 @@ func setDefaultParams(params BatchParams) BatchParams {
 func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetails, error) {
 	batcher := ctx.Value(batcherContextKey).(*Batcher)
 	client := batcher.clientBean.GetFrontendClient()
+	adminClient := batcher.clientBean.GetRemoteAdminClient(batcher.cfg.ClusterMetadata.GetCurrentClusterName())
 
 	hbd := HeartBeatDetails{}
 	startOver := true
@@ -258,7 +265,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	taskCh := make(chan taskDetail, pageSize)
 	respCh := make(chan error, pageSize)
 	for i := 0; i < batchParams.Concurrency; i++ {
-		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, client)
+		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, client, adminClient)
 	}
 
 	for {
@@ -329,6 +336,7 @@ func startTaskProcessor(
 	respCh chan error,
 	limiter *rate.Limiter,
 	client frontend.Client,
+	adminClient admin.Client,
 ) {
 	batcher := ctx.Value(batcherContextKey).(*Batcher)
 	for {
@@ -389,6 +397,34 @@ func startTaskProcessor(
 						})
 						return err
 					})
+			case BatchTypePause:
+				err = processTask(ctx, limiter, task, batchParams, client, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						_, err := adminClient.PauseWorkflowExecution(ctx, &adminservice.PauseWorkflowExecutionRequest{
+							Namespace: batchParams.Namespace,
+							Execution: &commonpb.WorkflowExecution{
+								WorkflowId: workflowID,
+								RunId:      runID,
+							},
+							Reason:   batchParams.Reason,
+							Identity: BatchWFTypeName,
+						})
+						return err
+					})
+			case BatchTypeUnpause:
+				err = processTask(ctx, limiter, task, batchParams, client, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						_, err := adminClient.UnpauseWorkflowExecution(ctx, &adminservice.UnpauseWorkflowExecutionRequest{
+							Namespace: batchParams.Namespace,
+							Execution: &commonpb.WorkflowExecution{
+								WorkflowId: workflowID,
+								RunId:      runID,
+							},
+							Reason:   batchParams.Reason,
+							Identity: BatchWFTypeName,
+						})
+						return err
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
@@ -0,0 +1,418 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"context"
+	"math"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"golang.org/x/time/rate"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/config"
+)
+
+type (
+	// heartbeatDetails is the progress of an activity paging through executions or task queues
+	heartbeatDetails struct {
+		NextPageToken []byte
+		Count         int
+	}
+)
+
+const (
+	executionsPageSize = 100
+	taskQueuesPageSize = 100
+	tasksBatchSize     = 1000
+
+	terminateReason   = "namespace is deleted"
+	terminateIdentity = "temporal-sys-delete-namespace-workflow"
+
+	errNamespaceNotDeletedType = "NamespaceNotDeleted"
+
+	// esDeleteVersion is the version of the deletions of the records indexed by Elasticsearch. Records are
+	// versioned by the ids of the tasks which wrote them, a deletion is only applied with a greater version.
+	esDeleteVersion = math.MaxInt64
+)
+
+// TerminateExecutionsActivity terminates the open workflow executions of a deleted namespace,
+// it returns the number of executions it terminated
+func TerminateExecutionsActivity(
+	activityCtx context.Context,
+	params Params,
+) (int, error) {
+
+	ctx := activityCtx.Value(deleterContextKey).(deleterContext)
+	scope := ctx.GetMetricsClient().Scope(metrics.NamespaceDeleterScope, metrics.NamespaceTag(params.Namespace))
+	logger := ctx.GetLogger().WithTags(tag.WorkflowNamespace(params.Namespace))
+	frontendClient := ctx.GetFrontendClient()
+
+	hbd := getHeartbeatDetails(activityCtx, logger)
+	for {
+		resp, err := frontendClient.ListOpenWorkflowExecutions(activityCtx, &workflowservice.ListOpenWorkflowExecutionsRequest{
+			Namespace:       params.Namespace,
+			MaximumPageSize: executionsPageSize,
+			NextPageToken:   hbd.NextPageToken,
+		})
+		if err != nil {
+			scope.IncCounter(metrics.NamespaceDeleterFailures)
+			return 0, err
+		}
+		for _, info := range resp.Executions {
+			_, err := frontendClient.TerminateWorkflowExecution(activityCtx, &workflowservice.TerminateWorkflowExecutionRequest{
+				Namespace:         params.Namespace,
+				WorkflowExecution: info.Execution,
+				Reason:            terminateReason,
+				Identity:          terminateIdentity,
+			})
+			if err != nil {
+				// NotFound means the execution is already closed or deleted
+				if _, ok := err.(*serviceerror.NotFound); ok {
+					continue
+				}
+				scope.IncCounter(metrics.NamespaceDeleterFailures)
+				return 0, err
+			}
+			hbd.Count++
+			scope.IncCounter(metrics.NamespaceDeleterExecutionsTerminatedCount)
+		}
+
+		hbd.NextPageToken = resp.NextPageToken
+		activity.RecordHeartbeat(activityCtx, hbd)
+		if len(hbd.NextPageToken) == 0 {
+			break
+		}
+	}
+
+	logger.Info("Terminated workflow executions of deleted namespace", tag.Counter(hbd.Count))
+	return hbd.Count, nil
+}
+
+// DeleteExecutionsActivity deletes the closed workflow executions of a deleted namespace, along with their
+// history and visibility records, it returns the number of executions it deleted
+func DeleteExecutionsActivity(
+	activityCtx context.Context,
+	params Params,
+) (int, error) {
+
+	ctx := activityCtx.Value(deleterContextKey).(deleterContext)
+	scope := ctx.GetMetricsClient().Scope(metrics.NamespaceDeleterScope, metrics.NamespaceTag(params.Namespace))
+	logger := ctx.GetLogger().WithTags(tag.WorkflowNamespace(params.Namespace))
+	frontendClient := ctx.GetFrontendClient()
+	limiter := ctx.newLimiter()
+
+	hbd := getHeartbeatDetails(activityCtx, logger)
+	for {
+		resp, err := frontendClient.ListClosedWorkflowExecutions(activityCtx, &workflowservice.ListClosedWorkflowExecutionsRequest{
+			Namespace:       params.Namespace,
+			MaximumPageSize: executionsPageSize,
+			NextPageToken:   hbd.NextPageToken,
+		})
+		if err != nil {
+			scope.IncCounter(metrics.NamespaceDeleterFailures)
+			return 0, err
+		}
+		for _, info := range resp.Executions {
+			deleted, err := ctx.deleteExecution(activityCtx, limiter, params, info.Execution)
+			if err != nil {
+				scope.IncCounter(metrics.NamespaceDeleterFailures)
+				logger.Error("Failed to delete workflow execution",
+					tag.WorkflowID(info.Execution.GetWorkflowId()),
+					tag.WorkflowRunID(info.Execution.GetRunId()),
+					tag.Error(err),
+				)
+				return 0, err
+			}
+			if deleted {
+				hbd.Count++
+				scope.IncCounter(metrics.NamespaceDeleterExecutionsDeletedCount)
+			}
+		}
+
+		hbd.NextPageToken = resp.NextPageToken
+		activity.RecordHeartbeat(activityCtx, hbd)
+		if len(hbd.NextPageToken) == 0 {
+			break
+		}
+	}
+
+	logger.Info("Deleted workflow executions of deleted namespace", tag.Counter(hbd.Count))
+	return hbd.Count, nil
+}
+
+// DeleteTaskQueuesActivity deletes the task queues of a deleted namespace along with their tasks,
+// it returns the number of task queues it deleted. Task queues can only be listed from a SQL store,
+// on other stores the task queues are left in place.
+func DeleteTaskQueuesActivity(
+	activityCtx context.Context,
+	params Params,
+) (int, error) {
+
+	ctx := activityCtx.Value(deleterContextKey).(deleterContext)
+	scope := ctx.GetMetricsClient().Scope(metrics.NamespaceDeleterScope, metrics.NamespaceTag(params.Namespace))
+	logger := ctx.GetLogger().WithTags(tag.WorkflowNamespace(params.Namespace))
+	if ctx.cfg.Persistence.DefaultStoreType() != config.StoreTypeSQL {
+		logger.Info("Task queues can only be listed from a SQL store, skipping deletion of task queues")
+		return 0, nil
+	}
+	taskManager := ctx.GetTaskManager()
+	limiter := ctx.newLimiter()
+
+	hbd := getHeartbeatDetails(activityCtx, logger)
+	for {
+		if err := limiter.Wait(activityCtx); err != nil {
+			return 0, err
+		}
+		resp, err := taskManager.ListTaskQueue(&persistence.ListTaskQueueRequest{
+			PageSize:  taskQueuesPageSize,
+			PageToken: hbd.NextPageToken,
+		})
+		if err != nil {
+			scope.IncCounter(metrics.NamespaceDeleterFailures)
+			return 0, err
+		}
+		for _, item := range resp.Items {
+			if item.Data.GetNamespaceId() != params.NamespaceID {
+				continue
+			}
+			key := &persistence.TaskQueueKey{
+				NamespaceID: item.Data.GetNamespaceId(),
+				Name:        item.Data.GetName(),
+				TaskType:    item.Data.GetTaskType(),
+			}
+			if err := ctx.deleteTaskQueue(activityCtx, limiter, key, item.RangeID); err != nil {
+				scope.IncCounter(metrics.NamespaceDeleterFailures)
+				logger.Error("Failed to delete task queue",
+					tag.WorkflowTaskQueueName(key.Name),
+					tag.WorkflowTaskQueueType(key.TaskType),
+					tag.Error(err),
+				)
+				return 0, err
+			}
+			hbd.Count++
+			scope.IncCounter(metrics.NamespaceDeleterTaskQueuesDeletedCount)
+		}
+
+		hbd.NextPageToken = resp.NextPageToken
+		activity.RecordHeartbeat(activityCtx, hbd)
+		if len(hbd.NextPageToken) == 0 {
+			break
+		}
+	}
+
+	logger.Info("Deleted task queues of deleted namespace", tag.Counter(hbd.Count))
+	return hbd.Count, nil
+}
+
+// DeleteNamespaceActivity removes the metadata of a deleted namespace
+func DeleteNamespaceActivity(
+	activityCtx context.Context,
+	params Params,
+) error {
+
+	ctx := activityCtx.Value(deleterContextKey).(deleterContext)
+	logger := ctx.GetLogger().WithTags(tag.WorkflowNamespace(params.Namespace), tag.WorkflowNamespaceID(params.NamespaceID))
+	metadataManager := ctx.GetMetadataManager()
+
+	resp, err := metadataManager.GetNamespace(&persistence.GetNamespaceRequest{ID: params.NamespaceID})
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return nil
+		}
+		return err
+	}
+	// never remove a namespace which has not been marked as deleted
+	if resp.Namespace.GetInfo().GetState() != enumspb.NAMESPACE_STATE_DELETED {
+		return temporal.NewNonRetryableApplicationError("namespace is not deleted", errNamespaceNotDeletedType, nil)
+	}
+	if err := metadataManager.DeleteNamespace(&persistence.DeleteNamespaceRequest{ID: params.NamespaceID}); err != nil {
+		return err
+	}
+
+	logger.Info("Deleted namespace metadata")
+	return nil
+}
+
+// deleteExecution deletes a closed workflow execution with its history and its visibility record,
+// it returns false if the execution was already deleted
+func (c deleterContext) deleteExecution(
+	ctx context.Context,
+	limiter *rate.Limiter,
+	params Params,
+	execution *commonpb.WorkflowExecution,
+) (bool, error) {
+
+	shardID := common.WorkflowIDToHistoryShard(params.NamespaceID, execution.GetWorkflowId(), c.cfg.Persistence.NumHistoryShards)
+	executionManager, err := c.GetExecutionManager(shardID)
+	if err != nil {
+		return false, err
+	}
+	if err := limiter.Wait(ctx); err != nil {
+		return false, err
+	}
+	resp, err := executionManager.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		NamespaceID: params.NamespaceID,
+		Execution:   *execution,
+	})
+
+	deleted := false
+	switch err.(type) {
+	case nil:
+		branchToken := resp.State.ExecutionInfo.EventBranchToken
+		if resp.State.VersionHistories != nil {
+			currentVersionHistory, err := resp.State.VersionHistories.GetCurrentVersionHistory()
+			if err != nil {
+				return false, err
+			}
+			branchToken = currentVersionHistory.GetBranchToken()
+		}
+
+		// the mutable state is deleted last, so an attempt which fails half way is retried with its branch token
+		if err := limiter.Wait(ctx); err != nil {
+			return false, err
+		}
+		if err := executionManager.DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+			NamespaceID: params.NamespaceID,
+			WorkflowID:  execution.GetWorkflowId(),
+			RunID:       execution.GetRunId(),
+		}); err != nil {
+			return false, err
+		}
+		if err := limiter.Wait(ctx); err != nil {
+			return false, err
+		}
+		if err := c.GetHistoryManager().DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
+			BranchToken: branchToken,
+			ShardID:     convert.IntPtr(shardID),
+		}); err != nil {
+			return false, err
+		}
+		if err := limiter.Wait(ctx); err != nil {
+			return false, err
+		}
+		if err := executionManager.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+			NamespaceID: params.NamespaceID,
+			WorkflowID:  execution.GetWorkflowId(),
+			RunID:       execution.GetRunId(),
+		}); err != nil {
+			return false, err
+		}
+		deleted = true
+	case *serviceerror.NotFound:
+		// the execution has been deleted by retention or by an earlier attempt
+	default:
+		return false, err
+	}
+
+	if err := limiter.Wait(ctx); err != nil {
+		return false, err
+	}
+	if err := c.GetVisibilityManager().DeleteWorkflowExecution(&persistence.VisibilityDeleteWorkflowExecutionRequest{
+		NamespaceID: params.NamespaceID,
+		WorkflowID:  execution.GetWorkflowId(),
+		RunID:       execution.GetRunId(),
+	}); err != nil {
+		return false, err
+	}
+	if c.advancedVisibilityMgr != nil {
+		if err := c.advancedVisibilityMgr.DeleteWorkflowExecution(&persistence.VisibilityDeleteWorkflowExecutionRequest{
+			NamespaceID: params.NamespaceID,
+			WorkflowID:  execution.GetWorkflowId(),
+			RunID:       execution.GetRunId(),
+			TaskID:      esDeleteVersion,
+		}); err != nil {
+			return false, err
+		}
+	}
+	return deleted, nil
+}
+
+// deleteTaskQueue deletes all of the tasks of a task queue, then the task queue itself
+func (c deleterContext) deleteTaskQueue(
+	ctx context.Context,
+	limiter *rate.Limiter,
+	key *persistence.TaskQueueKey,
+	rangeID int64,
+) error {
+
+	taskManager := c.GetTaskManager()
+	for {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+		n, err := taskManager.CompleteTasksLessThan(&persistence.CompleteTasksLessThanRequest{
+			NamespaceID:   key.NamespaceID,
+			TaskQueueName: key.Name,
+			TaskType:      key.TaskType,
+			TaskID:        math.MaxInt64,
+			Limit:         tasksBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+		if n == persistence.UnknownNumRowsAffected || n < tasksBatchSize {
+			break
+		}
+	}
+
+	if err := limiter.Wait(ctx); err != nil {
+		return err
+	}
+	return taskManager.DeleteTaskQueue(&persistence.DeleteTaskQueueRequest{
+		TaskQueue: key,
+		RangeID:   rangeID,
+	})
+}
+
+func (c deleterContext) newLimiter() *rate.Limiter {
+	rps := c.cfg.PersistenceMaxQPS()
+	return rate.NewLimiter(rate.Limit(rps), rps)
+}
+
+func getHeartbeatDetails(
+	activityCtx context.Context,
+	logger log.Logger,
+) heartbeatDetails {
+
+	hbd := heartbeatDetails{}
+	if activity.HasHeartbeatDetails(activityCtx) {
+		if err := activity.GetHeartbeatDetails(activityCtx, &hbd); err != nil {
+			logger.Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+			return heartbeatDetails{}
+		}
+	}
+	return hbd
+}
@@ -0,0 +1,77 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../../LICENSE -package $GOPACKAGE -source $GOFILE -destination client_mock.go
+
+package deletenamespace
+
+import (
+	"context"
+	"fmt"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	sdkclient "go.temporal.io/sdk/client"
+)
+
+type (
+	// Client is used to start the namespace deleter workflow
+	Client interface {
+		StartDeleteNamespaceWorkflow(ctx context.Context, params Params) (string, error)
+	}
+
+	clientImpl struct {
+		temporalClient sdkclient.Client
+	}
+)
+
+var _ Client = (*clientImpl)(nil)
+
+// NewClient creates a new Client
+func NewClient(
+	publicClient sdkclient.Client,
+) Client {
+	return &clientImpl{
+		temporalClient: publicClient,
+	}
+}
+
+// StartDeleteNamespaceWorkflow starts the namespace deleter workflow of a namespace and returns its workflow ID,
+// a workflow which is already running for the namespace is left running
+func (c *clientImpl) StartDeleteNamespaceWorkflow(ctx context.Context, params Params) (string, error) {
+	workflowID := fmt.Sprintf("%v-%v", workflowIDPrefix, params.NamespaceID)
+	workflowOptions := sdkclient.StartWorkflowOptions{
+		ID:                    workflowID,
+		TaskQueue:             TaskQueueName,
+		WorkflowRunTimeout:    infiniteDuration,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY,
+	}
+	_, err := c.temporalClient.ExecuteWorkflow(ctx, workflowOptions, WorkflowTypeName, params)
+	if err != nil {
+		if _, ok := err.(*serviceerror.WorkflowExecutionAlreadyStarted); !ok {
+			return "", err
+		}
+	}
+	return workflowID, nil
+}
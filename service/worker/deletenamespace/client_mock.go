@@ -23,51 +23,52 @@
 // THE SOFTWARE.
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: queryParser.go
+// Source: client.go
 
-// Package gcloud is a generated GoMock package.
-package gcloud
+// Package deletenamespace is a generated GoMock package.
+package deletenamespace
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
 )
 
-// MockQueryParser is a mock of QueryParser interface.
-type MockQueryParser struct {
+// MockClient is a mock of Client interface.
+type MockClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockQueryParserMockRecorder
+	recorder *MockClientMockRecorder
 }
 
-// MockQueryParserMockRecorder is the mock recorder for MockQueryParser.
-type MockQueryParserMockRecorder struct {
-	mock *MockQueryParser
+// MockClientMockRecorder is the mock recorder for MockClient.
+type MockClientMockRecorder struct {
+	mock *MockClient
 }
 
-// NewMockQueryParser creates a new mock instance.
-func NewMockQueryParser(ctrl *gomock.Controller) *MockQueryParser {
-	mock := &MockQueryParser{ctrl: ctrl}
-	mock.recorder = &MockQueryParserMockRecorder{mock}
+// NewMockClient creates a new mock instance.
+func NewMockClient(ctrl *gomock.Controller) *MockClient {
+	mock := &MockClient{ctrl: ctrl}
+	mock.recorder = &MockClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockQueryParser) EXPECT() *MockQueryParserMockRecorder {
+func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
-// Parse mocks base method.
-func (m *MockQueryParser) Parse(query string) (*parsedQuery, error) {
+// StartDeleteNamespaceWorkflow mocks base method.
+func (m *MockClient) StartDeleteNamespaceWorkflow(ctx context.Context, params Params) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Parse", query)
-	ret0, _ := ret[0].(*parsedQuery)
+	ret := m.ctrl.Call(m, "StartDeleteNamespaceWorkflow", ctx, params)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Parse indicates an expected call of Parse.
-func (mr *MockQueryParserMockRecorder) Parse(query interface{}) *gomock.Call {
+// StartDeleteNamespaceWorkflow indicates an expected call of StartDeleteNamespaceWorkflow.
+func (mr *MockClientMockRecorder) StartDeleteNamespaceWorkflow(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockQueryParser)(nil).Parse), query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartDeleteNamespaceWorkflow", reflect.TypeOf((*MockClient)(nil).StartDeleteNamespaceWorkflow), ctx, params)
 }
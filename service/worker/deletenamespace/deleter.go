@@ -0,0 +1,103 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/service/config"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	// Config defines the configuration for the namespace deleter
+	Config struct {
+		// PersistenceMaxQPS the max rate of calls to persistence
+		PersistenceMaxQPS dynamicconfig.IntPropertyFn
+		// Persistence contains the persistence configuration
+		Persistence *config.Persistence
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the namespace deleter sub-system
+	BootstrapParams struct {
+		// Config contains the configuration for the namespace deleter
+		Config Config
+		// AdvancedVisibilityManager deletes the visibility records indexed by Elasticsearch,
+		// it is nil if advanced visibility is not configured
+		AdvancedVisibilityManager persistence.VisibilityManager
+	}
+
+	// deleterContext is the context object that get's
+	// passed around within the namespace deleter activities
+	deleterContext struct {
+		resource.Resource
+		cfg                   Config
+		advancedVisibilityMgr persistence.VisibilityManager
+	}
+
+	// Deleter is the background sub-system that executes the workflows
+	// deleting the resources of deleted namespaces
+	Deleter struct {
+		context deleterContext
+	}
+)
+
+// New returns a new instance of the namespace deleter daemon
+func New(
+	resource resource.Resource,
+	params *BootstrapParams,
+) *Deleter {
+
+	return &Deleter{
+		context: deleterContext{
+			Resource:              resource,
+			cfg:                   params.Config,
+			advancedVisibilityMgr: params.AdvancedVisibilityManager,
+		},
+	}
+}
+
+// Start starts the namespace deleter
+func (d *Deleter) Start() error {
+	workerOpts := worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), deleterContextKey, d.context),
+	}
+	deleterWorker := worker.New(d.context.GetSDKClient(), TaskQueueName, workerOpts)
+
+	deleterWorker.RegisterWorkflowWithOptions(DeleteNamespaceWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	deleterWorker.RegisterActivityWithOptions(TerminateExecutionsActivity, activity.RegisterOptions{Name: terminateExecutionsActivityName})
+	deleterWorker.RegisterActivityWithOptions(DeleteExecutionsActivity, activity.RegisterOptions{Name: deleteExecutionsActivityName})
+	deleterWorker.RegisterActivityWithOptions(DeleteTaskQueuesActivity, activity.RegisterOptions{Name: deleteTaskQueuesActivityName})
+	deleterWorker.RegisterActivityWithOptions(DeleteNamespaceActivity, activity.RegisterOptions{Name: deleteNamespaceActivityName})
+
+	return deleterWorker.Start()
+}
@@ -0,0 +1,126 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+type (
+	contextKey int
+
+	// Params are the parameters of the namespace deleter workflow
+	Params struct {
+		// Namespace is the name of the namespace to delete
+		Namespace string
+		// NamespaceID is the ID of the namespace to delete
+		NamespaceID string
+	}
+
+	// Report is the result of the namespace deleter workflow
+	Report struct {
+		ExecutionsTerminated int
+		ExecutionsDeleted    int
+		TaskQueuesDeleted    int
+	}
+)
+
+const (
+	deleterContextKey = contextKey(0)
+
+	// TaskQueueName is the task queue of the namespace deleter workflow
+	TaskQueueName = "temporal-sys-delete-namespace-taskqueue"
+	// WorkflowTypeName is the workflow type of the namespace deleter workflow
+	WorkflowTypeName = "temporal-sys-delete-namespace-workflow"
+
+	workflowIDPrefix                = "temporal-sys-delete-namespace"
+	terminateExecutionsActivityName = "temporal-sys-delete-namespace-terminate-executions-activity"
+	deleteExecutionsActivityName    = "temporal-sys-delete-namespace-delete-executions-activity"
+	deleteTaskQueuesActivityName    = "temporal-sys-delete-namespace-delete-task-queues-activity"
+	deleteNamespaceActivityName     = "temporal-sys-delete-namespace-delete-namespace-activity"
+
+	infiniteDuration = 20 * 365 * 24 * time.Hour
+)
+
+var (
+	activityRetryPolicy = temporal.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 1.7,
+		MaximumInterval:    5 * time.Minute,
+	}
+	activityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    infiniteDuration,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy:            &activityRetryPolicy,
+	}
+)
+
+// DeleteNamespaceWorkflow is the workflow which deletes all of the resources of a deleted namespace,
+// in order:
+//  - terminates the open workflow executions of the namespace, until none is left
+//  - deletes the closed workflow executions, with their history and visibility records, until none is left
+//  - deletes the task queues of the namespace and their tasks
+//  - removes the namespace metadata
+// Executions and visibility records are listed through the frontend, so they are found in either the
+// standard or the advanced visibility store, and visibility records are deleted from both stores.
+func DeleteNamespaceWorkflow(
+	ctx workflow.Context,
+	params Params,
+) (Report, error) {
+
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+	report := Report{}
+	for {
+		var terminated int
+		if err := workflow.ExecuteActivity(ctx, terminateExecutionsActivityName, params).Get(ctx, &terminated); err != nil {
+			return report, err
+		}
+		report.ExecutionsTerminated += terminated
+		if terminated == 0 {
+			break
+		}
+	}
+	for {
+		var deleted int
+		if err := workflow.ExecuteActivity(ctx, deleteExecutionsActivityName, params).Get(ctx, &deleted); err != nil {
+			return report, err
+		}
+		report.ExecutionsDeleted += deleted
+		if deleted == 0 {
+			break
+		}
+	}
+	if err := workflow.ExecuteActivity(ctx, deleteTaskQueuesActivityName, params).Get(ctx, &report.TaskQueuesDeleted); err != nil {
+		return report, err
+	}
+	if err := workflow.ExecuteActivity(ctx, deleteNamespaceActivityName, params).Get(ctx, nil); err != nil {
+		return report, err
+	}
+	return report, nil
+}
@@ -0,0 +1,117 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package deletenamespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common/metrics"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/resource"
+)
+
+type deleterWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestDeleterWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(deleterWorkflowTestSuite))
+}
+
+func (s *deleterWorkflowTestSuite) registerWorkflows(env *testsuite.TestWorkflowEnvironment) {
+	env.RegisterWorkflowWithOptions(DeleteNamespaceWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	env.RegisterActivityWithOptions(TerminateExecutionsActivity, activity.RegisterOptions{Name: terminateExecutionsActivityName})
+	env.RegisterActivityWithOptions(DeleteExecutionsActivity, activity.RegisterOptions{Name: deleteExecutionsActivityName})
+	env.RegisterActivityWithOptions(DeleteTaskQueuesActivity, activity.RegisterOptions{Name: deleteTaskQueuesActivityName})
+	env.RegisterActivityWithOptions(DeleteNamespaceActivity, activity.RegisterOptions{Name: deleteNamespaceActivityName})
+}
+
+func (s *deleterWorkflowTestSuite) TestWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	s.registerWorkflows(env)
+	params := Params{Namespace: "test-namespace", NamespaceID: "test-namespace-id"}
+	env.OnActivity(terminateExecutionsActivityName, mock.Anything, params).Return(3, nil).Once()
+	env.OnActivity(terminateExecutionsActivityName, mock.Anything, params).Return(0, nil).Once()
+	env.OnActivity(deleteExecutionsActivityName, mock.Anything, params).Return(5, nil).Once()
+	env.OnActivity(deleteExecutionsActivityName, mock.Anything, params).Return(2, nil).Once()
+	env.OnActivity(deleteExecutionsActivityName, mock.Anything, params).Return(0, nil).Once()
+	env.OnActivity(deleteTaskQueuesActivityName, mock.Anything, params).Return(4, nil).Once()
+	env.OnActivity(deleteNamespaceActivityName, mock.Anything, params).Return(nil).Once()
+	env.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var report Report
+	s.NoError(env.GetWorkflowResult(&report))
+	s.Equal(Report{ExecutionsTerminated: 3, ExecutionsDeleted: 7, TaskQueuesDeleted: 4}, report)
+	env.AssertExpectations(s.T())
+}
+
+func (s *deleterWorkflowTestSuite) TestDeleteNamespaceActivity() {
+	s.testDeleteNamespaceActivity(enumspb.NAMESPACE_STATE_DELETED, true)
+}
+
+func (s *deleterWorkflowTestSuite) TestDeleteNamespaceActivity_NamespaceNotDeleted() {
+	s.testDeleteNamespaceActivity(enumspb.NAMESPACE_STATE_REGISTERED, false)
+}
+
+func (s *deleterWorkflowTestSuite) testDeleteNamespaceActivity(state enumspb.NamespaceState, deleted bool) {
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(DeleteNamespaceActivity, activity.RegisterOptions{Name: deleteNamespaceActivityName})
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	mockResource := resource.NewTest(controller, metrics.Worker)
+	defer mockResource.Finish(s.T())
+
+	params := Params{Namespace: "test-namespace", NamespaceID: "test-namespace-id"}
+	mockResource.MetadataMgr.On("GetNamespace", &p.GetNamespaceRequest{ID: params.NamespaceID}).Return(&p.GetNamespaceResponse{
+		Namespace: &persistenceblobs.NamespaceDetail{
+			Info: &persistenceblobs.NamespaceInfo{Id: params.NamespaceID, Name: params.Namespace, State: state},
+		},
+	}, nil)
+	if deleted {
+		mockResource.MetadataMgr.On("DeleteNamespace", &p.DeleteNamespaceRequest{ID: params.NamespaceID}).Return(nil)
+	}
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), deleterContextKey, deleterContext{Resource: mockResource}),
+	})
+	_, err := env.ExecuteActivity(deleteNamespaceActivityName, params)
+	if deleted {
+		s.NoError(err)
+	} else {
+		s.Error(err)
+		mockResource.MetadataMgr.AssertNotCalled(s.T(), "DeleteNamespace", mock.Anything)
+	}
+}
@@ -127,20 +127,28 @@ func newActivityReplicationTask(
 			metricsClient: metricsClient,
 		},
 		req: &historyservice.SyncActivityRequest{
-			NamespaceId:        attr.NamespaceId,
-			WorkflowId:         attr.WorkflowId,
-			RunId:              attr.RunId,
-			Version:            attr.Version,
-			ScheduledId:        attr.ScheduledId,
-			ScheduledTime:      attr.ScheduledTime,
-			StartedId:          attr.StartedId,
-			StartedTime:        attr.StartedTime,
-			LastHeartbeatTime:  attr.LastHeartbeatTime,
-			Details:            attr.Details,
-			Attempt:            attr.Attempt,
-			LastFailure:        attr.LastFailure,
-			LastWorkerIdentity: attr.LastWorkerIdentity,
-			VersionHistory:     attr.VersionHistory,
+			NamespaceId:            attr.NamespaceId,
+			WorkflowId:             attr.WorkflowId,
+			RunId:                  attr.RunId,
+			Version:                attr.Version,
+			ScheduledId:            attr.ScheduledId,
+			ScheduledTime:          attr.ScheduledTime,
+			StartedId:              attr.StartedId,
+			StartedTime:            attr.StartedTime,
+			LastHeartbeatTime:      attr.LastHeartbeatTime,
+			Details:                attr.Details,
+			Attempt:                attr.Attempt,
+			LastFailure:            attr.LastFailure,
+			LastWorkerIdentity:     attr.LastWorkerIdentity,
+			VersionHistory:         attr.VersionHistory,
+			Paused:                 attr.Paused,
+			Stamp:                  attr.Stamp,
+			ScheduleToCloseTimeout: attr.ScheduleToCloseTimeout,
+			ScheduleToStartTimeout: attr.ScheduleToStartTimeout,
+			StartToCloseTimeout:    attr.StartToCloseTimeout,
+			HeartbeatTimeout:       attr.HeartbeatTimeout,
+			RetryPolicy:            attr.RetryPolicy,
+			RetryExpirationTime:    attr.RetryExpirationTime,
 		},
 		nDCHistoryResender: nDCHistoryResender,
 	}
@@ -232,6 +240,7 @@ func newHistoryReplicationV2Task(
 			VersionHistoryItems: attr.VersionHistoryItems,
 			Events:              attr.Events,
 			NewRunEvents:        attr.NewRunEvents,
+			DeadlineRules:       attr.DeadlineRules,
 		},
 		nDCHistoryResender: nDCHistoryResender,
 	}
@@ -0,0 +1,462 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package archival
+
+import (
+	"context"
+	"math/rand"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"golang.org/x/time/rate"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// Scavenger is the type that holds the state for archival scavenger daemon
+	Scavenger struct {
+		params                   ScannerWorkflowParams
+		numShards                int
+		metadataManager          persistence.MetadataManager
+		archiverProvider         provider.ArchiverProvider
+		executionManagerProvider ExecutionManagerProvider
+		limiter                  *rate.Limiter
+		metrics                  metrics.Client
+		logger                   log.Logger
+		report                   ScavengerReport
+		isInTest                 bool
+	}
+
+	// ExecutionManagerProvider returns the execution manager of the given history shard
+	ExecutionManagerProvider func(shardID int) (persistence.ExecutionManager, error)
+
+	// ScannerWorkflowParams are the parameters passed to the archival scanner workflow
+	ScannerWorkflowParams struct {
+		// SampleRate is the fraction of archived workflows to verify, all workflows are verified if not in (0, 1)
+		SampleRate float64
+		// ReArchiveEnabled indicates if missing or corrupted histories should be archived again
+		// from the history store, when the workflow execution still exists
+		ReArchiveEnabled bool
+	}
+
+	// ScavengerReport is the result of a scan, broken down by namespace
+	ScavengerReport struct {
+		Namespaces map[string]*NamespaceReport
+	}
+
+	// NamespaceReport is the result of a scan for a single namespace
+	NamespaceReport struct {
+		VisibilityRecordsScanned   int64
+		VisibilityRecordsCorrupted int64
+		HistoriesVerified          int64
+		HistoriesMissing           int64
+		HistoriesCorrupted         int64
+		HistoriesReArchived        int64
+		ReArchiveSkipped           int64
+		ReArchiveFailed            int64
+		Errors                     int64
+	}
+
+	historyStatus int
+)
+
+const (
+	historyStatusVerified historyStatus = iota
+	historyStatusMissing
+	historyStatusCorrupted
+	historyStatusError
+)
+
+const (
+	namespacePageSize  = 100
+	visibilityPageSize = 100
+	historyPageSize    = 250
+)
+
+// NewScavenger returns an instance of archival scavenger daemon
+// The Scavenger can be started by calling the Run() method on the
+// returned object. Calling the Run() method will result in one
+// complete iteration over the archived visibility records of all
+// namespaces which have a visibility archival URI. For each sampled
+// record, the scavenger will
+//  - read the archived history, checking it decodes and matches its checksum
+//  - compare the number of archived events with the visibility record
+//  - optionally archive the history again, if the workflow execution still exists
+func NewScavenger(
+	params ScannerWorkflowParams,
+	numShards int,
+	rps int,
+	metadataManager persistence.MetadataManager,
+	archiverProvider provider.ArchiverProvider,
+	executionManagerProvider ExecutionManagerProvider,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *Scavenger {
+
+	return &Scavenger{
+		params:                   params,
+		numShards:                numShards,
+		metadataManager:          metadataManager,
+		archiverProvider:         archiverProvider,
+		executionManagerProvider: executionManagerProvider,
+		limiter:                  rate.NewLimiter(rate.Limit(rps), rps),
+		metrics:                  metricsClient,
+		logger:                   logger,
+		report:                   ScavengerReport{Namespaces: make(map[string]*NamespaceReport)},
+	}
+}
+
+// Run runs the scavenger
+func (s *Scavenger) Run(ctx context.Context) (ScavengerReport, error) {
+	var nextPageToken []byte
+	for {
+		if err := s.limiter.Wait(ctx); err != nil {
+			return s.report, err
+		}
+		resp, err := s.metadataManager.ListNamespaces(&persistence.ListNamespacesRequest{
+			PageSize:      namespacePageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return s.report, err
+		}
+		for _, ns := range resp.Namespaces {
+			if err := s.scanNamespace(ctx, ns.Namespace); err != nil {
+				return s.report, err
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		nextPageToken = resp.NextPageToken
+	}
+	return s.report, nil
+}
+
+// scanNamespace walks all of the archived visibility records of a namespace. Failures are recorded in
+// the report and only an error of the context, which stops the whole scan, is returned.
+func (s *Scavenger) scanNamespace(ctx context.Context, ns *persistenceblobs.NamespaceDetail) error {
+	visibilityURI := ns.GetConfig().GetVisibilityArchivalUri()
+	if visibilityURI == "" {
+		return nil
+	}
+
+	name := ns.GetInfo().GetName()
+	scope := s.metrics.Scope(metrics.ArchivalScavengerScope, metrics.NamespaceTag(name))
+	logger := s.logger.WithTags(tag.WorkflowNamespace(name), tag.ArchivalURI(visibilityURI))
+
+	URI, err := archiver.NewURI(visibilityURI)
+	if err != nil {
+		s.recordError(s.namespaceReport(name), scope, logger, "failed to parse visibility archival URI", err)
+		return nil
+	}
+	visibilityArchiver, err := s.archiverProvider.GetVisibilityArchiver(URI.Scheme(), common.WorkerServiceName)
+	if err != nil {
+		s.recordError(s.namespaceReport(name), scope, logger, "failed to get visibility archiver", err)
+		return nil
+	}
+	lister, ok := visibilityArchiver.(archiver.VisibilityLister)
+	if !ok {
+		logger.Warn("Visibility archiver does not support listing archived records, skipping namespace")
+		return nil
+	}
+
+	report := s.namespaceReport(name)
+	request := &archiver.ListVisibilityRequest{
+		NamespaceID: ns.GetInfo().GetId(),
+		PageSize:    visibilityPageSize,
+	}
+	for {
+		if err := s.limiter.Wait(ctx); err != nil {
+			return err
+		}
+		resp, err := lister.List(ctx, URI, request)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			s.recordError(report, scope, logger, "failed to list archived visibility records", err)
+			return nil
+		}
+
+		for _, record := range resp.CorruptedRecords {
+			report.VisibilityRecordsCorrupted++
+			scope.IncCounter(metrics.ArchivalVisibilityCorruptedCount)
+			logger.Error("Archived visibility record is corrupted", tag.ArchivalBlobKey(record))
+		}
+		for _, record := range resp.Records {
+			report.VisibilityRecordsScanned++
+			scope.IncCounter(metrics.ArchivalVisibilityScannedCount)
+			if !s.sampled() {
+				continue
+			}
+			if err := s.verifyHistory(ctx, ns, record, report, scope); err != nil {
+				return err
+			}
+		}
+		if !s.isInTest {
+			activity.RecordHeartbeat(ctx, s.report)
+		}
+
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+// verifyHistory reads back the archived history of a visibility record and, if it is missing or
+// corrupted, optionally archives it again.
+func (s *Scavenger) verifyHistory(
+	ctx context.Context,
+	ns *persistenceblobs.NamespaceDetail,
+	record *archiverspb.ArchiveVisibilityRequest,
+	report *NamespaceReport,
+	scope metrics.Scope,
+) error {
+
+	historyURI := record.GetHistoryArchivalUri()
+	if historyURI == "" {
+		historyURI = ns.GetConfig().GetHistoryArchivalUri()
+	}
+	if historyURI == "" {
+		return nil
+	}
+
+	logger := s.logger.WithTags(
+		tag.WorkflowNamespace(ns.GetInfo().GetName()),
+		tag.WorkflowID(record.GetWorkflowId()),
+		tag.WorkflowRunID(record.GetRunId()),
+		tag.ArchivalURI(historyURI),
+	)
+	URI, err := archiver.NewURI(historyURI)
+	if err != nil {
+		s.recordError(report, scope, logger, "failed to parse history archival URI", err)
+		return nil
+	}
+	historyArchiver, err := s.archiverProvider.GetHistoryArchiver(URI.Scheme(), common.WorkerServiceName)
+	if err != nil {
+		s.recordError(report, scope, logger, "failed to get history archiver", err)
+		return nil
+	}
+
+	status, err := s.readHistory(ctx, historyArchiver, URI, record)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	switch status {
+	case historyStatusVerified:
+		report.HistoriesVerified++
+		scope.IncCounter(metrics.ArchivalHistoryVerifiedCount)
+		return nil
+	case historyStatusMissing:
+		report.HistoriesMissing++
+		scope.IncCounter(metrics.ArchivalHistoryMissingCount)
+		logger.Error("Archived history is missing", tag.Error(err))
+	case historyStatusCorrupted:
+		report.HistoriesCorrupted++
+		scope.IncCounter(metrics.ArchivalHistoryCorruptedCount)
+		logger.Error("Archived history is corrupted", tag.Error(err))
+	default:
+		s.recordError(report, scope, logger, "failed to read archived history", err)
+		return nil
+	}
+
+	if s.params.ReArchiveEnabled {
+		s.reArchive(ctx, historyArchiver, URI, record, report, scope, logger)
+	}
+	return nil
+}
+
+func (s *Scavenger) readHistory(
+	ctx context.Context,
+	historyArchiver archiver.HistoryArchiver,
+	URI archiver.URI,
+	record *archiverspb.ArchiveVisibilityRequest,
+) (historyStatus, error) {
+
+	request := &archiver.GetHistoryRequest{
+		NamespaceID: record.GetNamespaceId(),
+		WorkflowID:  record.GetWorkflowId(),
+		RunID:       record.GetRunId(),
+		PageSize:    historyPageSize,
+	}
+	var eventCount int64
+	for {
+		if err := s.limiter.Wait(ctx); err != nil {
+			return historyStatusError, err
+		}
+		resp, err := historyArchiver.Get(ctx, URI, request)
+		if err != nil {
+			switch err.(type) {
+			case *serviceerror.NotFound:
+				return historyStatusMissing, err
+			case *serviceerror.InvalidArgument:
+				if err.Error() == archiver.ErrHistoryNotExist.Error() {
+					return historyStatusMissing, err
+				}
+			case *serviceerror.DataLoss:
+				return historyStatusCorrupted, err
+			}
+			return historyStatusError, err
+		}
+		for _, batch := range resp.HistoryBatches {
+			eventCount += int64(len(batch.Events))
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+
+	if record.GetHistoryLength() > 0 && eventCount != record.GetHistoryLength() {
+		return historyStatusCorrupted, serviceerror.NewDataLoss("archived history length does not match visibility record")
+	}
+	return historyStatusVerified, nil
+}
+
+// reArchive archives the history of a workflow execution again, which is only possible while
+// the execution and its history have not been deleted by retention yet.
+func (s *Scavenger) reArchive(
+	ctx context.Context,
+	historyArchiver archiver.HistoryArchiver,
+	URI archiver.URI,
+	record *archiverspb.ArchiveVisibilityRequest,
+	report *NamespaceReport,
+	scope metrics.Scope,
+	logger log.Logger,
+) {
+
+	shardID := common.WorkflowIDToHistoryShard(record.GetNamespaceId(), record.GetWorkflowId(), s.numShards)
+	executionManager, err := s.executionManagerProvider(shardID)
+	if err != nil {
+		s.recordReArchiveFailure(report, scope, logger, err)
+		return
+	}
+	if err := s.limiter.Wait(ctx); err != nil {
+		s.recordReArchiveFailure(report, scope, logger, err)
+		return
+	}
+	resp, err := executionManager.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		NamespaceID: record.GetNamespaceId(),
+		Execution: commonpb.WorkflowExecution{
+			WorkflowId: record.GetWorkflowId(),
+			RunId:      record.GetRunId(),
+		},
+	})
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			report.ReArchiveSkipped++
+			scope.IncCounter(metrics.ArchivalHistoryReArchiveSkippedCount)
+			logger.Warn("Workflow execution no longer exists, unable to re-archive history")
+			return
+		}
+		s.recordReArchiveFailure(report, scope, logger, err)
+		return
+	}
+
+	branchToken := resp.State.ExecutionInfo.EventBranchToken
+	closeFailoverVersion := common.EmptyVersion
+	if resp.State.VersionHistories != nil {
+		currentVersionHistory, err := resp.State.VersionHistories.GetCurrentVersionHistory()
+		if err != nil {
+			s.recordReArchiveFailure(report, scope, logger, err)
+			return
+		}
+		lastItem, err := currentVersionHistory.GetLastItem()
+		if err != nil {
+			s.recordReArchiveFailure(report, scope, logger, err)
+			return
+		}
+		branchToken = currentVersionHistory.GetBranchToken()
+		closeFailoverVersion = lastItem.GetVersion()
+	}
+
+	err = historyArchiver.Archive(ctx, URI, &archiver.ArchiveHistoryRequest{
+		ShardID:              shardID,
+		NamespaceID:          record.GetNamespaceId(),
+		Namespace:            record.GetNamespace(),
+		WorkflowID:           record.GetWorkflowId(),
+		RunID:                record.GetRunId(),
+		BranchToken:          branchToken,
+		NextEventID:          resp.State.ExecutionInfo.NextEventId,
+		CloseFailoverVersion: closeFailoverVersion,
+	})
+	if err != nil {
+		s.recordReArchiveFailure(report, scope, logger, err)
+		return
+	}
+	report.HistoriesReArchived++
+	scope.IncCounter(metrics.ArchivalHistoryReArchivedCount)
+	logger.Info("Re-archived workflow history")
+}
+
+func (s *Scavenger) sampled() bool {
+	if s.params.SampleRate <= 0 || s.params.SampleRate >= 1 {
+		return true
+	}
+	return rand.Float64() < s.params.SampleRate
+}
+
+func (s *Scavenger) namespaceReport(name string) *NamespaceReport {
+	report, ok := s.report.Namespaces[name]
+	if !ok {
+		report = &NamespaceReport{}
+		s.report.Namespaces[name] = report
+	}
+	return report
+}
+
+func (s *Scavenger) recordError(
+	report *NamespaceReport,
+	scope metrics.Scope,
+	logger log.Logger,
+	msg string,
+	err error,
+) {
+	report.Errors++
+	scope.IncCounter(metrics.ArchivalScanErrorCount)
+	logger.Error(msg, tag.Error(err))
+}
+
+func (s *Scavenger) recordReArchiveFailure(
+	report *NamespaceReport,
+	scope metrics.Scope,
+	logger log.Logger,
+	err error,
+) {
+	report.ReArchiveFailed++
+	scope.IncCounter(metrics.ArchivalHistoryReArchiveFailedCount)
+	logger.Error("Failed to re-archive workflow history", tag.Error(err))
+}
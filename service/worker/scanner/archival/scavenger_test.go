@@ -0,0 +1,265 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package archival
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/mocks"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	ScavengerTestSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		metadataMgr        *mocks.MetadataManager
+		executionMgr       *mocks.ExecutionManager
+		archiverProvider   *provider.MockArchiverProvider
+		historyArchiver    *archiver.HistoryArchiverMock
+		visibilityArchiver *visibilityArchiverLister
+	}
+
+	visibilityArchiverLister struct {
+		*archiver.VisibilityArchiverMock
+		*archiver.VisibilityListerMock
+	}
+)
+
+const (
+	testNamespaceID   = "test-namespace-id"
+	testNamespace     = "test-namespace"
+	testVisibilityURI = "file:///tmp/visibility"
+	testHistoryURI    = "file:///tmp/history"
+	testNumShards     = 4
+)
+
+var testBranchToken = []byte("test-branch-token")
+
+func TestScavengerTestSuite(t *testing.T) {
+	suite.Run(t, new(ScavengerTestSuite))
+}
+
+func (s *ScavengerTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.metadataMgr = &mocks.MetadataManager{}
+	s.executionMgr = &mocks.ExecutionManager{}
+	s.archiverProvider = &provider.MockArchiverProvider{}
+	s.historyArchiver = &archiver.HistoryArchiverMock{}
+	s.visibilityArchiver = &visibilityArchiverLister{
+		VisibilityArchiverMock: &archiver.VisibilityArchiverMock{},
+		VisibilityListerMock:   &archiver.VisibilityListerMock{},
+	}
+	s.archiverProvider.On("GetHistoryArchiver", "file", common.WorkerServiceName).Return(s.historyArchiver, nil)
+}
+
+func (s *ScavengerTestSuite) TearDownTest() {
+	s.metadataMgr.AssertExpectations(s.T())
+	s.executionMgr.AssertExpectations(s.T())
+	s.historyArchiver.AssertExpectations(s.T())
+	s.visibilityArchiver.VisibilityListerMock.AssertExpectations(s.T())
+}
+
+func (s *ScavengerTestSuite) TestRun_VerifyAndReArchive() {
+	s.archiverProvider.On("GetVisibilityArchiver", "file", common.WorkerServiceName).Return(s.visibilityArchiver, nil)
+	s.metadataMgr.On("ListNamespaces", mock.Anything).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{s.namespace(testNamespaceID, testNamespace, testVisibilityURI)},
+	}, nil).Once()
+
+	s.visibilityArchiver.VisibilityListerMock.On("List", mock.Anything, mock.Anything, &archiver.ListVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    visibilityPageSize,
+	}).Return(&archiver.ListVisibilityResponse{
+		Records: []*archiverspb.ArchiveVisibilityRequest{
+			s.visibilityRecord("verified", 2),
+			s.visibilityRecord("missing", 2),
+		},
+		CorruptedRecords: []string{"corrupted-record"},
+		NextPageToken:    []byte("token"),
+	}, nil).Once()
+	s.visibilityArchiver.VisibilityListerMock.On("List", mock.Anything, mock.Anything, &archiver.ListVisibilityRequest{
+		NamespaceID:   testNamespaceID,
+		PageSize:      visibilityPageSize,
+		NextPageToken: []byte("token"),
+	}).Return(&archiver.ListVisibilityResponse{
+		Records: []*archiverspb.ArchiveVisibilityRequest{
+			s.visibilityRecord("corrupted", 2),
+			s.visibilityRecord("truncated", 3),
+		},
+	}, nil).Once()
+
+	s.expectGetHistory("verified").Return(s.historyResponse(2), nil).Once()
+	s.expectGetHistory("missing").Return(nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())).Once()
+	s.expectGetHistory("corrupted").Return(nil, serviceerror.NewDataLoss("corrupted")).Once()
+	s.expectGetHistory("truncated").Return(s.historyResponse(2), nil).Once()
+
+	s.expectGetWorkflowExecution("missing").Return(nil, serviceerror.NewNotFound("not found")).Once()
+	s.expectGetWorkflowExecution("corrupted").Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventId: 3},
+			VersionHistories: persistence.NewVersionHistories(persistence.NewVersionHistory(
+				testBranchToken,
+				[]*persistence.VersionHistoryItem{persistence.NewVersionHistoryItem(2, 10)},
+			)),
+		},
+	}, nil).Once()
+	s.expectGetWorkflowExecution("truncated").Return(nil, errors.New("persistence error")).Once()
+	s.historyArchiver.On("Archive", mock.Anything, mock.Anything, &archiver.ArchiveHistoryRequest{
+		ShardID:              common.WorkflowIDToHistoryShard(testNamespaceID, "corrupted", testNumShards),
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           "corrupted",
+		RunID:                "corrupted-run",
+		BranchToken:          testBranchToken,
+		NextEventID:          3,
+		CloseFailoverVersion: 10,
+	}).Return(nil).Once()
+
+	report, err := s.newScavenger(ScannerWorkflowParams{ReArchiveEnabled: true}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(map[string]*NamespaceReport{
+		testNamespace: {
+			VisibilityRecordsScanned:   4,
+			VisibilityRecordsCorrupted: 1,
+			HistoriesVerified:          1,
+			HistoriesMissing:           1,
+			HistoriesCorrupted:         2,
+			HistoriesReArchived:        1,
+			ReArchiveSkipped:           1,
+			ReArchiveFailed:            1,
+		},
+	}, report.Namespaces)
+}
+
+func (s *ScavengerTestSuite) TestRun_SkipNamespaces() {
+	s.archiverProvider.On("GetVisibilityArchiver", "file", common.WorkerServiceName).Return(s.visibilityArchiver, nil)
+	s.archiverProvider.On("GetVisibilityArchiver", "s3", common.WorkerServiceName).Return(&archiver.VisibilityArchiverMock{}, nil)
+	s.metadataMgr.On("ListNamespaces", &persistence.ListNamespacesRequest{
+		PageSize: namespacePageSize,
+	}).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{
+			s.namespace("no-archival-id", "no-archival", ""),
+			s.namespace("no-lister-id", "no-lister", "s3://bucket/visibility"),
+		},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	s.metadataMgr.On("ListNamespaces", &persistence.ListNamespacesRequest{
+		PageSize:      namespacePageSize,
+		NextPageToken: []byte("token"),
+	}).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{s.namespace(testNamespaceID, testNamespace, testVisibilityURI)},
+	}, nil).Once()
+
+	s.visibilityArchiver.VisibilityListerMock.On("List", mock.Anything, mock.Anything, mock.Anything).Return(&archiver.ListVisibilityResponse{
+		Records: []*archiverspb.ArchiveVisibilityRequest{s.visibilityRecord("unavailable", 2)},
+	}, nil).Once()
+	s.expectGetHistory("unavailable").Return(nil, serviceerror.NewUnavailable("unavailable")).Once()
+
+	report, err := s.newScavenger(ScannerWorkflowParams{ReArchiveEnabled: true}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(map[string]*NamespaceReport{
+		testNamespace: {
+			VisibilityRecordsScanned: 1,
+			Errors:                   1,
+		},
+	}, report.Namespaces)
+}
+
+func (s *ScavengerTestSuite) newScavenger(params ScannerWorkflowParams) *Scavenger {
+	scvg := NewScavenger(
+		params,
+		testNumShards,
+		1000,
+		s.metadataMgr,
+		s.archiverProvider,
+		func(shardID int) (persistence.ExecutionManager, error) {
+			return s.executionMgr, nil
+		},
+		metrics.NewClient(tally.NoopScope, metrics.Worker),
+		loggerimpl.NewNopLogger(),
+	)
+	scvg.isInTest = true
+	return scvg
+}
+
+func (s *ScavengerTestSuite) namespace(id string, name string, visibilityURI string) *persistence.GetNamespaceResponse {
+	return &persistence.GetNamespaceResponse{
+		Namespace: &persistenceblobs.NamespaceDetail{
+			Info: &persistenceblobs.NamespaceInfo{Id: id, Name: name},
+			Config: &persistenceblobs.NamespaceConfig{
+				HistoryArchivalUri:    testHistoryURI,
+				VisibilityArchivalUri: visibilityURI,
+			},
+		},
+	}
+}
+
+func (s *ScavengerTestSuite) visibilityRecord(workflowID string, historyLength int64) *archiverspb.ArchiveVisibilityRequest {
+	return &archiverspb.ArchiveVisibilityRequest{
+		NamespaceId:   testNamespaceID,
+		Namespace:     testNamespace,
+		WorkflowId:    workflowID,
+		RunId:         workflowID + "-run",
+		HistoryLength: historyLength,
+	}
+}
+
+func (s *ScavengerTestSuite) historyResponse(eventCount int) *archiver.GetHistoryResponse {
+	events := make([]*historypb.HistoryEvent, eventCount)
+	for i := range events {
+		events[i] = &historypb.HistoryEvent{EventId: int64(i + 1)}
+	}
+	return &archiver.GetHistoryResponse{
+		HistoryBatches: []*historypb.History{{Events: events}},
+	}
+}
+
+func (s *ScavengerTestSuite) expectGetHistory(workflowID string) *mock.Call {
+	return s.historyArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.GetHistoryRequest) bool {
+		return request.WorkflowID == workflowID
+	}))
+}
+
+func (s *ScavengerTestSuite) expectGetWorkflowExecution(workflowID string) *mock.Call {
+	return s.executionMgr.On("GetWorkflowExecution", mock.MatchedBy(func(request *persistence.GetWorkflowExecutionRequest) bool {
+		return request.Execution.GetWorkflowId() == workflowID
+	}))
+}
@@ -0,0 +1,102 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/backoff"
+	p "go.temporal.io/server/common/persistence"
+)
+
+var retryForeverPolicy = newRetryForeverPolicy()
+
+func (s *Scavenger) listExecutions(executionManager p.ExecutionManager, pageToken []byte) (*p.ListConcreteExecutionsResponse, error) {
+	var err error
+	var resp *p.ListConcreteExecutionsResponse
+	err = s.retryForever(func() error {
+		resp, err = executionManager.ListConcreteExecutions(&p.ListConcreteExecutionsRequest{
+			PageSize:  executionsPageSize,
+			PageToken: pageToken,
+		})
+		return err
+	})
+	return resp, err
+}
+
+// getExecution reads the mutable state of the execution, nil is returned if the execution no longer exists
+func (s *Scavenger) getExecution(key *executionKey) (*execution, error) {
+	executionManager, err := s.executionManagerProvider(key.shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *p.GetWorkflowExecutionResponse
+	err = s.retryForever(func() error {
+		resp, err = executionManager.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+			NamespaceID: key.namespaceID,
+			Execution: commonpb.WorkflowExecution{
+				WorkflowId: key.workflowID,
+				RunId:      key.runID,
+			},
+		})
+		return err
+	})
+	switch err.(type) {
+	case nil:
+		return &execution{
+			shardID:          key.shardID,
+			executionManager: executionManager,
+			state:            resp.State,
+		}, nil
+	case *serviceerror.NotFound:
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
+func (s *Scavenger) retryForever(op func() error) error {
+	return backoff.Retry(op, retryForeverPolicy, s.isRetryable)
+}
+
+func newRetryForeverPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(250 * time.Millisecond)
+	policy.SetExpirationInterval(backoff.NoInterval)
+	policy.SetMaximumInterval(30 * time.Second)
+	return policy
+}
+
+func (s *Scavenger) isRetryable(err error) bool {
+	switch err.(type) {
+	case *serviceerror.NotFound, *serviceerror.Unimplemented:
+		return false
+	default:
+		return s.Alive()
+	}
+}
@@ -24,7 +24,14 @@
 
 package executions
 
-import "go.temporal.io/server/service/worker/scanner/executor"
+import (
+	"context"
+	"sync/atomic"
+
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/service/worker/scanner/executor"
+)
 
 type handlerStatus = executor.TaskStatus
 
@@ -37,11 +44,106 @@ const (
 const scannerTaskQueuePrefix = "temporal-sys-executions-scanner"
 
 // validateHandler validates a single execution.
-// It operates in two phases: collection step and validation step.
+// It operates in three phases: collection step, validation step and fix step.
 // During collection step information from persistence is read for this workflow execution.
 // During validation step invariants are asserted over everything that was read.
-// In the future its possible to add a third step here which will additionally take automatic recovery actions if validation failed.
+// During fix step, which only runs if enabled, the first violated invariant is given the chance
+// to repair the execution. The execution is collected and validated again right
+// before fixing it, so that a stale read never results in an execution being modified.
+// Executions are fixed at most once per run, any remaining corruption is picked up by the next run.
 func (s *Scavenger) validateHandler(key *executionKey) handlerStatus {
-	// TODO: implement this
+	if err := s.limiter.Wait(context.Background()); err != nil {
+		return handlerStatusDefer
+	}
+
+	exec, err := s.getExecution(key)
+	if err != nil {
+		atomic.AddInt64(&s.stats.executions.nErrors, 1)
+		s.logger.Error("unable to collect execution", getTaskLoggingTags(key, err)...)
+		return handlerStatusErr
+	}
+	if exec == nil {
+		return handlerStatusDone // execution was deleted after it was listed
+	}
+
+	atomic.AddInt64(&s.stats.executions.nScanned, 1)
+	s.metrics.IncCounter(metrics.ExecutionsScavengerScope, metrics.ExecutionsScannedCount)
+
+	for _, inv := range s.invariants {
+		result := inv.check(exec)
+		s.recordCheckResult(key, inv.invariantType(), result)
+		if result.resultType != checkResultCorrupted || !s.params.FixEnabled {
+			continue
+		}
+
+		s.recordFixResult(key, inv.invariantType(), s.fix(key, inv))
+		return handlerStatusDone
+	}
 	return handlerStatusDone
 }
+
+func (s *Scavenger) fix(key *executionKey, inv invariant) fixResult {
+	exec, err := s.getExecution(key)
+	if err != nil {
+		return fixResult{resultType: fixResultFailed, info: "unable to collect execution", err: err}
+	}
+	if exec == nil {
+		return fixResult{resultType: fixResultSkipped, info: "execution no longer exists"}
+	}
+	if result := inv.check(exec); result.resultType != checkResultCorrupted {
+		return fixResult{resultType: fixResultSkipped, info: "execution is no longer corrupted"}
+	}
+	return inv.fix(exec)
+}
+
+func (s *Scavenger) recordCheckResult(key *executionKey, t invariantType, result checkResult) {
+	st := s.stats.invariants[t]
+	scope := s.metrics.Scope(metrics.ExecutionsScavengerScope, metrics.InvariantTypeTag(string(t)))
+	switch result.resultType {
+	case checkResultCorrupted:
+		atomic.AddInt64(&st.nCorrupted, 1)
+		scope.IncCounter(metrics.ExecutionsCorruptedCount)
+		s.logger.Warn("corrupted execution",
+			append(getTaskLoggingTags(key, nil), tag.Key(string(t)), tag.DetailInfo(result.info))...)
+	case checkResultFailed:
+		atomic.AddInt64(&st.nCheckErrs, 1)
+		scope.IncCounter(metrics.ExecutionsCheckFailedCount)
+		s.logger.Error("unable to check execution",
+			append(getTaskLoggingTags(key, result.err), tag.Key(string(t)), tag.DetailInfo(result.info))...)
+	}
+}
+
+func (s *Scavenger) recordFixResult(key *executionKey, t invariantType, result fixResult) {
+	st := s.stats.invariants[t]
+	scope := s.metrics.Scope(metrics.ExecutionsScavengerScope, metrics.InvariantTypeTag(string(t)))
+	switch result.resultType {
+	case fixResultFixed:
+		atomic.AddInt64(&st.nFixed, 1)
+		scope.IncCounter(metrics.ExecutionsFixedCount)
+		s.logger.Info("fixed corrupted execution",
+			append(getTaskLoggingTags(key, nil), tag.Key(string(t)), tag.DetailInfo(result.info))...)
+	case fixResultSkipped:
+		atomic.AddInt64(&st.nFixSkipped, 1)
+		scope.IncCounter(metrics.ExecutionsFixSkippedCount)
+		s.logger.Info("skipped fixing corrupted execution",
+			append(getTaskLoggingTags(key, nil), tag.Key(string(t)), tag.DetailInfo(result.info))...)
+	case fixResultFailed:
+		atomic.AddInt64(&st.nFixErrs, 1)
+		scope.IncCounter(metrics.ExecutionsFixFailedCount)
+		s.logger.Error("unable to fix corrupted execution",
+			append(getTaskLoggingTags(key, result.err), tag.Key(string(t)), tag.DetailInfo(result.info))...)
+	}
+}
+
+func getTaskLoggingTags(key *executionKey, err error) []tag.Tag {
+	tags := []tag.Tag{
+		tag.ShardID(key.shardID),
+		tag.WorkflowNamespaceID(key.namespaceID),
+		tag.WorkflowID(key.workflowID),
+		tag.WorkflowRunID(key.runID),
+	}
+	if err != nil {
+		tags = append(tags, tag.Error(err))
+	}
+	return tags
+}
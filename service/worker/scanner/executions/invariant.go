@@ -0,0 +1,363 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/convert"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	// invariantType identifies an invariant, it is used to select invariants
+	// through ScannerWorkflowParams and to tag the metrics / report of the scan
+	invariantType string
+
+	checkResultType int
+	fixResultType   int
+
+	// checkResult is the outcome of asserting an invariant over an execution
+	checkResult struct {
+		resultType checkResultType
+		info       string
+		err        error
+	}
+
+	// fixResult is the outcome of attempting to fix a corrupted execution
+	fixResult struct {
+		resultType fixResultType
+		info       string
+		err        error
+	}
+
+	// execution is everything read from persistence during the collection phase
+	execution struct {
+		shardID          int
+		executionManager p.ExecutionManager
+		state            *p.WorkflowMutableState
+	}
+
+	// invariant is a property every execution in the system must satisfy.
+	// check must never modify the execution, fix is only invoked on executions
+	// for which check returned checkResultCorrupted and is expected to either
+	// repair the execution or skip it when only a manual repair is safe.
+	invariant interface {
+		invariantType() invariantType
+		check(exec *execution) checkResult
+		fix(exec *execution) fixResult
+	}
+
+	// historyExistsInvariant asserts that the current history branch of the execution exists
+	historyExistsInvariant struct {
+		historyDB p.HistoryManager
+	}
+
+	// nextEventIDInvariant asserts that the last event batch of the history ends right
+	// before the next event ID of the execution
+	nextEventIDInvariant struct {
+		historyDB p.HistoryManager
+	}
+
+	// currentExecutionInvariant asserts that an open execution is pointed to by the
+	// current execution record and that the current execution record of a workflow
+	// points to a concrete execution that exists
+	currentExecutionInvariant struct{}
+)
+
+const (
+	invariantTypeHistoryExists    invariantType = "history_exists"
+	invariantTypeNextEventID      invariantType = "next_event_id"
+	invariantTypeCurrentExecution invariantType = "current_execution"
+)
+
+const (
+	checkResultHealthy checkResultType = iota
+	checkResultCorrupted
+	checkResultFailed
+)
+
+const (
+	fixResultFixed fixResultType = iota
+	fixResultSkipped
+	fixResultFailed
+)
+
+// newInvariants returns the invariants selected by the given types, all known invariants
+// are returned if types is empty
+func newInvariants(
+	types []string,
+	historyDB p.HistoryManager,
+) ([]invariant, error) {
+
+	all := []invariant{
+		&historyExistsInvariant{historyDB: historyDB},
+		&nextEventIDInvariant{historyDB: historyDB},
+		&currentExecutionInvariant{},
+	}
+	if len(types) == 0 {
+		return all, nil
+	}
+
+	known := make(map[invariantType]invariant, len(all))
+	for _, inv := range all {
+		known[inv.invariantType()] = inv
+	}
+	var result []invariant
+	for _, t := range types {
+		inv, ok := known[invariantType(t)]
+		if !ok {
+			return nil, fmt.Errorf("unknown invariant type: %v", t)
+		}
+		result = append(result, inv)
+	}
+	return result, nil
+}
+
+func (e *execution) executionInfo() *p.WorkflowExecutionInfo {
+	return e.state.ExecutionInfo
+}
+
+func (e *execution) isOpen() bool {
+	switch e.executionInfo().ExecutionState.GetState() {
+	case enumsspb.WORKFLOW_EXECUTION_STATE_CREATED, enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING:
+		return true
+	default:
+		return false
+	}
+}
+
+func (e *execution) branchToken() ([]byte, error) {
+	if e.state.VersionHistories == nil {
+		return e.executionInfo().EventBranchToken, nil
+	}
+	currentVersionHistory, err := e.state.VersionHistories.GetCurrentVersionHistory()
+	if err != nil {
+		return nil, err
+	}
+	return currentVersionHistory.GetBranchToken(), nil
+}
+
+// deleteExecution removes the concrete execution together with the current execution
+// record, if the latter still points to this execution
+func (e *execution) deleteExecution() error {
+	info := e.executionInfo()
+	if err := e.executionManager.DeleteCurrentWorkflowExecution(&p.DeleteCurrentWorkflowExecutionRequest{
+		NamespaceID: info.NamespaceId,
+		WorkflowID:  info.WorkflowId,
+		RunID:       info.ExecutionState.GetRunId(),
+	}); err != nil {
+		return err
+	}
+	return e.executionManager.DeleteWorkflowExecution(&p.DeleteWorkflowExecutionRequest{
+		NamespaceID: info.NamespaceId,
+		WorkflowID:  info.WorkflowId,
+		RunID:       info.ExecutionState.GetRunId(),
+	})
+}
+
+func (i *historyExistsInvariant) invariantType() invariantType {
+	return invariantTypeHistoryExists
+}
+
+func (i *historyExistsInvariant) check(exec *execution) checkResult {
+	branchToken, err := exec.branchToken()
+	if err != nil {
+		return checkResult{resultType: checkResultFailed, info: "failed to get current branch token", err: err}
+	}
+	if len(branchToken) == 0 {
+		return checkResult{resultType: checkResultCorrupted, info: "execution has no history branch token"}
+	}
+
+	resp, err := i.historyDB.ReadHistoryBranch(&p.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  common.FirstEventID + 1,
+		PageSize:    1,
+		ShardID:     convert.IntPtr(exec.shardID),
+	})
+	switch err.(type) {
+	case nil:
+		if len(resp.HistoryEvents) == 0 {
+			return checkResult{resultType: checkResultCorrupted, info: "history branch is empty"}
+		}
+		return checkResult{resultType: checkResultHealthy}
+	case *serviceerror.NotFound:
+		return checkResult{resultType: checkResultCorrupted, info: "history branch does not exist"}
+	default:
+		return checkResult{resultType: checkResultFailed, info: "failed to read history branch", err: err}
+	}
+}
+
+// fix deletes the execution, without its history an execution can neither make
+// progress nor be read by the user
+func (i *historyExistsInvariant) fix(exec *execution) fixResult {
+	if err := exec.deleteExecution(); err != nil {
+		return fixResult{resultType: fixResultFailed, info: "failed to delete execution", err: err}
+	}
+	return fixResult{resultType: fixResultFixed, info: "deleted execution"}
+}
+
+func (i *nextEventIDInvariant) invariantType() invariantType {
+	return invariantTypeNextEventID
+}
+
+func (i *nextEventIDInvariant) check(exec *execution) checkResult {
+	branchToken, err := exec.branchToken()
+	if err != nil {
+		return checkResult{resultType: checkResultFailed, info: "failed to get current branch token", err: err}
+	}
+	if len(branchToken) == 0 {
+		// reported by historyExistsInvariant
+		return checkResult{resultType: checkResultHealthy}
+	}
+
+	info := exec.executionInfo()
+	resp, err := i.historyDB.ReadHistoryBranch(&p.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  info.LastFirstEventId,
+		MaxEventID:  info.NextEventId,
+		PageSize:    1,
+		ShardID:     convert.IntPtr(exec.shardID),
+	})
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		return checkResult{
+			resultType: checkResultCorrupted,
+			info:       fmt.Sprintf("history has no event batch starting at last first event ID %v", info.LastFirstEventId),
+		}
+	default:
+		return checkResult{resultType: checkResultFailed, info: "failed to read last history event batch", err: err}
+	}
+
+	if len(resp.HistoryEvents) == 0 {
+		return checkResult{
+			resultType: checkResultCorrupted,
+			info:       fmt.Sprintf("history has no event batch starting at last first event ID %v", info.LastFirstEventId),
+		}
+	}
+	if lastEventID := resp.HistoryEvents[len(resp.HistoryEvents)-1].GetEventId(); lastEventID != info.NextEventId-1 {
+		return checkResult{
+			resultType: checkResultCorrupted,
+			info:       fmt.Sprintf("last history event ID %v does not match next event ID %v", lastEventID, info.NextEventId),
+		}
+	}
+	return checkResult{resultType: checkResultHealthy}
+}
+
+// fix only reports the execution, history and mutable state disagree on which events
+// happened and there is no way to tell which one is right
+func (i *nextEventIDInvariant) fix(_ *execution) fixResult {
+	return fixResult{resultType: fixResultSkipped, info: "execution with mismatched next event ID requires manual repair"}
+}
+
+func (i *currentExecutionInvariant) invariantType() invariantType {
+	return invariantTypeCurrentExecution
+}
+
+func (i *currentExecutionInvariant) check(exec *execution) checkResult {
+	result, _ := i.checkCurrentRunID(exec)
+	return result
+}
+
+// fix deletes orphaned current execution records, an open execution that is not
+// pointed to by the current execution record can't be repaired without knowing
+// which run is supposed to be current, so it is left for manual inspection
+func (i *currentExecutionInvariant) fix(exec *execution) fixResult {
+	if exec.isOpen() {
+		return fixResult{resultType: fixResultSkipped, info: "open execution requires manual repair"}
+	}
+
+	// the current execution record may have moved on since the check, only delete it
+	// if it still points to a missing run
+	result, currentRunID := i.checkCurrentRunID(exec)
+	switch result.resultType {
+	case checkResultHealthy:
+		return fixResult{resultType: fixResultSkipped, info: "current execution record is no longer orphaned"}
+	case checkResultFailed:
+		return fixResult{resultType: fixResultFailed, info: result.info, err: result.err}
+	}
+
+	info := exec.executionInfo()
+	if err := exec.executionManager.DeleteCurrentWorkflowExecution(&p.DeleteCurrentWorkflowExecutionRequest{
+		NamespaceID: info.NamespaceId,
+		WorkflowID:  info.WorkflowId,
+		RunID:       currentRunID,
+	}); err != nil {
+		return fixResult{resultType: fixResultFailed, info: "failed to delete current execution record", err: err}
+	}
+	return fixResult{resultType: fixResultFixed, info: "deleted orphaned current execution record"}
+}
+
+// checkCurrentRunID checks the current execution record of the workflow, it also returns
+// the run id the record points to, if any
+func (i *currentExecutionInvariant) checkCurrentRunID(exec *execution) (checkResult, string) {
+	info := exec.executionInfo()
+	current, err := exec.executionManager.GetCurrentExecution(&p.GetCurrentExecutionRequest{
+		NamespaceID: info.NamespaceId,
+		WorkflowID:  info.WorkflowId,
+	})
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		if exec.isOpen() {
+			return checkResult{resultType: checkResultCorrupted, info: "open execution has no current execution record"}, ""
+		}
+		return checkResult{resultType: checkResultHealthy}, ""
+	default:
+		return checkResult{resultType: checkResultFailed, info: "failed to get current execution", err: err}, ""
+	}
+
+	if current.RunID == info.ExecutionState.GetRunId() {
+		return checkResult{resultType: checkResultHealthy}, current.RunID
+	}
+	if exec.isOpen() {
+		return checkResult{resultType: checkResultCorrupted, info: fmt.Sprintf("open execution is not current, current run is %v", current.RunID)}, current.RunID
+	}
+
+	// this execution is closed and was superseded by another run, the current
+	// execution record is orphaned if the run it points to does not exist
+	_, err = exec.executionManager.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+		NamespaceID: info.NamespaceId,
+		Execution: commonpb.WorkflowExecution{
+			WorkflowId: info.WorkflowId,
+			RunId:      current.RunID,
+		},
+	})
+	switch err.(type) {
+	case nil:
+		return checkResult{resultType: checkResultHealthy}, current.RunID
+	case *serviceerror.NotFound:
+		return checkResult{resultType: checkResultCorrupted, info: fmt.Sprintf("current execution record points to missing run %v", current.RunID)}, current.RunID
+	default:
+		return checkResult{resultType: checkResultFailed, info: "failed to get execution pointed to by current execution record", err: err}, current.RunID
+	}
+}
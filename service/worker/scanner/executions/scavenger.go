@@ -29,48 +29,81 @@ import (
 	"sync/atomic"
 	"time"
 
-	"go.temporal.io/server/client/frontend"
+	"golang.org/x/time/rate"
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/worker/scanner/executor"
 )
 
-type ()
-
 type (
 	// Scavenger is the type that holds the state for executions scavenger daemon
 	Scavenger struct {
-		params ScannerWorkflowParams
-		// TODO: currently frontend client does not support scan visibility records without namespace filter (need to chat with Bowei to understand if querying without namespace filter is an issue)? Maybe we go directly to elasticSearch?
-		frontendClient frontend.Client // used to query visibility
-		historyDB      p.HistoryManager
-		executor       executor.Executor
-		metrics        metrics.Client
-		logger         log.Logger
-		stats          stats
-		status         int32
-		stopC          chan struct{}
-		stopWG         sync.WaitGroup
+		params                   ScannerWorkflowParams
+		numShards                int
+		executionManagerProvider ExecutionManagerProvider
+		invariants               []invariant
+		limiter                  *rate.Limiter
+		executor                 executor.Executor
+		metrics                  metrics.Client
+		logger                   log.Logger
+		stats                    stats
+		status                   int32
+		stopC                    chan struct{}
+		stopWG                   sync.WaitGroup
 	}
 
+	// ExecutionManagerProvider returns the execution manager of the given history shard
+	ExecutionManagerProvider func(shardID int) (p.ExecutionManager, error)
+
 	// ScannerWorkflowParams are the parameters passed to the executions scanner workflow
 	ScannerWorkflowParams struct {
-		VisibilityQuery string // optionally can be provided to limit the scope of the scan
+		// InvariantTypes optionally limits the scan to a subset of invariants, all invariants are checked if empty
+		InvariantTypes []string
+		// FixEnabled indicates if corrupted executions should be repaired, otherwise they are only reported
+		FixEnabled bool
+	}
+
+	// ScavengerReport is the result of a scan, broken down by invariant type
+	ScavengerReport struct {
+		ExecutionsScanned int64
+		ErrorCount        int64
+		Invariants        map[string]InvariantReport
+	}
 
-		// add other fields here such as: bool generateReport, string outputLocation, bool runInDryMode etc...
+	// InvariantReport is the result of a scan for a single invariant type
+	InvariantReport struct {
+		CorruptedCount  int64
+		CheckErrorCount int64
+		FixedCount      int64
+		FixSkippedCount int64
+		FixErrorCount   int64
 	}
 
 	executionKey struct {
+		shardID     int
 		namespaceID string
 		workflowID  string
 		runID       string
 	}
 
 	stats struct {
-		// TODO: include stats here that should be tracked throughout execution of scavenger
+		executions struct {
+			nScanned int64
+			nErrors  int64
+		}
+		invariants map[invariantType]*invariantStats
+	}
+
+	invariantStats struct {
+		nCorrupted  int64
+		nCheckErrs  int64
+		nFixed      int64
+		nFixSkipped int64
+		nFixErrs    int64
 	}
 
 	// executorTask is a runnable task that adheres to the executor.Task interface
@@ -83,7 +116,7 @@ type (
 
 var (
 	executionsBatchSize      = 32   // maximum number of executions we process concurrently
-	executionsPageSize       = 1000 // page size of executions read from visibility manager
+	executionsPageSize       = 1000 // page size of executions read from execution manager
 	executorPollInterval     = time.Minute
 	executorMaxDeferredTasks = 10000
 )
@@ -91,8 +124,11 @@ var (
 // NewScavenger returns an instance of executions scavenger daemon
 // The Scavenger can be started by calling the Start() method on the
 // returned object. Calling the Start() method will result in one
-// complete iteration over all of the open workflow executions in the system. For
-// each executions, will attempt to validate the workflow execution and emit metrics/logs on validation failures.
+// complete iteration over all of the workflow executions of all history
+// shards in the system. For each execution, the scavenger will
+//  - collect the mutable state of the execution from persistence
+//  - validate the configured invariants against it, emitting metrics/logs on failures
+//  - optionally repair the execution if an invariant is violated
 //
 // The scavenger will retry on all persistence errors infinitely and will only stop under
 // two conditions
@@ -100,23 +136,37 @@ var (
 //  - Stop() method is called to stop the scavenger
 func NewScavenger(
 	params ScannerWorkflowParams,
-	frontendClient frontend.Client,
+	numShards int,
+	rps int,
+	executionManagerProvider ExecutionManagerProvider,
 	historyDB p.HistoryManager,
 	metricsClient metrics.Client,
 	logger log.Logger,
-) *Scavenger {
+) (*Scavenger, error) {
+	invariants, err := newInvariants(params.InvariantTypes, historyDB)
+	if err != nil {
+		return nil, err
+	}
+
 	stopC := make(chan struct{})
 	taskExecutor := executor.NewFixedSizePoolExecutor(
 		executionsBatchSize, executorMaxDeferredTasks, metricsClient, metrics.ExecutionsScavengerScope)
-	return &Scavenger{
-		params:         params,
-		frontendClient: frontendClient,
-		historyDB:      historyDB,
-		metrics:        metricsClient,
-		logger:         logger,
-		stopC:          stopC,
-		executor:       taskExecutor,
+	scvg := &Scavenger{
+		params:                   params,
+		numShards:                numShards,
+		executionManagerProvider: executionManagerProvider,
+		invariants:               invariants,
+		limiter:                  rate.NewLimiter(rate.Limit(rps), rps),
+		metrics:                  metricsClient,
+		logger:                   logger,
+		stopC:                    stopC,
+		executor:                 taskExecutor,
 	}
+	scvg.stats.invariants = make(map[invariantType]*invariantStats, len(invariants))
+	for _, inv := range invariants {
+		scvg.stats.invariants[inv.invariantType()] = &invariantStats{}
+	}
+	return scvg, nil
 }
 
 // Start starts the scavenger
@@ -152,12 +202,48 @@ func (s *Scavenger) Alive() bool {
 
 // run does a single run over all executions and validates them
 func (s *Scavenger) run() {
-	// TODO: implement this
-	// 1. read from visibility records from frontend.Client
-	// 2. create executionTasks
-	// 3. pass them off to the executor to run
-	// 4. wait until the executor is done
-	// 5. emit metrics on the run of scavenger
+	defer func() {
+		s.emitStats()
+		go s.Stop()
+		s.stopWG.Done()
+	}()
+
+	for shardID := 1; shardID <= s.numShards; shardID++ {
+		if !s.scanShard(shardID) {
+			return
+		}
+	}
+
+	s.awaitExecutor()
+}
+
+// scanShard submits a task for every execution of the shard, false is returned if the scan should stop
+func (s *Scavenger) scanShard(shardID int) bool {
+	executionManager, err := s.executionManagerProvider(shardID)
+	if err != nil {
+		s.logger.Error("unable to get execution manager", tag.ShardID(shardID), tag.Error(err))
+		return false
+	}
+
+	var pageToken []byte
+	for {
+		resp, err := s.listExecutions(executionManager, pageToken)
+		if err != nil {
+			s.logger.Error("listExecutions error", tag.ShardID(shardID), tag.Error(err))
+			return false
+		}
+
+		for _, info := range resp.ExecutionInfos {
+			if !s.executor.Submit(s.newTask(shardID, info)) {
+				return false
+			}
+		}
+
+		pageToken = resp.PageToken
+		if len(pageToken) == 0 {
+			return true
+		}
+	}
 }
 
 func (s *Scavenger) awaitExecutor() {
@@ -174,16 +260,36 @@ func (s *Scavenger) awaitExecutor() {
 }
 
 func (s *Scavenger) emitStats() {
-	// TODO: implement this, this will emit metrics after a full run of executor scavenger is finished
+	s.logger.Info("Executions scavenger run finished", tag.Value(s.Report()))
+}
+
+// Report returns the results of the scan so far, broken down by invariant type
+func (s *Scavenger) Report() ScavengerReport {
+	report := ScavengerReport{
+		ExecutionsScanned: atomic.LoadInt64(&s.stats.executions.nScanned),
+		ErrorCount:        atomic.LoadInt64(&s.stats.executions.nErrors),
+		Invariants:        make(map[string]InvariantReport, len(s.stats.invariants)),
+	}
+	for t, st := range s.stats.invariants {
+		report.Invariants[string(t)] = InvariantReport{
+			CorruptedCount:  atomic.LoadInt64(&st.nCorrupted),
+			CheckErrorCount: atomic.LoadInt64(&st.nCheckErrs),
+			FixedCount:      atomic.LoadInt64(&st.nFixed),
+			FixSkippedCount: atomic.LoadInt64(&st.nFixSkipped),
+			FixErrorCount:   atomic.LoadInt64(&st.nFixErrs),
+		}
+	}
+	return report
 }
 
 // newTask returns a new instance of an executable task which will process a single execution
-func (s *Scavenger) newTask(namespaceID, workflowID, runID string) executor.Task {
+func (s *Scavenger) newTask(shardID int, info *p.WorkflowExecutionInfo) executor.Task {
 	return &executorTask{
 		executionKey: executionKey{
-			namespaceID: namespaceID,
-			workflowID:  workflowID,
-			runID:       runID,
+			shardID:     shardID,
+			namespaceID: info.NamespaceId,
+			workflowID:  info.WorkflowId,
+			runID:       info.GetRunId(),
 		},
 		scvg: s,
 	}
@@ -23,3 +23,246 @@
 // THE SOFTWARE.
 
 package executions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/mocks"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	ScavengerTestSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		executionMgr *mocks.ExecutionManager
+		historyMgr   *mocks.HistoryV2Manager
+	}
+)
+
+const (
+	testNamespaceID = "test-namespace-id"
+	testWorkflowID  = "test-workflow-id"
+	testRunID       = "test-run-id"
+)
+
+var testBranchToken = []byte("test-branch-token")
+
+func TestScavengerTestSuite(t *testing.T) {
+	suite.Run(t, new(ScavengerTestSuite))
+}
+
+func (s *ScavengerTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.executionMgr = &mocks.ExecutionManager{}
+	s.historyMgr = &mocks.HistoryV2Manager{}
+	executorPollInterval = time.Millisecond * 50
+}
+
+func (s *ScavengerTestSuite) TearDownTest() {
+	s.executionMgr.AssertExpectations(s.T())
+	s.historyMgr.AssertExpectations(s.T())
+}
+
+func (s *ScavengerTestSuite) TestHealthyExecution() {
+	state := s.newMutableState(enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, 5)
+	s.expectList(state)
+	s.expectGet(state, 1)
+	s.expectHistory(nil)
+	s.expectHistory(nil)
+	s.expectCurrent(testRunID, nil)
+
+	report := s.runScavenger(ScannerWorkflowParams{FixEnabled: true})
+	s.Equal(int64(1), report.ExecutionsScanned)
+	s.Len(report.Invariants, 3)
+	for _, r := range report.Invariants {
+		s.Equal(InvariantReport{}, r)
+	}
+}
+
+func (s *ScavengerTestSuite) TestMissingHistory_FixDisabled() {
+	state := s.newMutableState(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, 0)
+	s.expectList(state)
+	s.expectGet(state, 1)
+	s.expectHistory(serviceerror.NewNotFound("history not found"))
+
+	report := s.runScavenger(ScannerWorkflowParams{
+		InvariantTypes: []string{string(invariantTypeHistoryExists)},
+	})
+	s.Equal(int64(1), report.ExecutionsScanned)
+	s.Equal(InvariantReport{CorruptedCount: 1}, report.Invariants[string(invariantTypeHistoryExists)])
+}
+
+func (s *ScavengerTestSuite) TestMissingHistory_FixEnabled() {
+	state := s.newMutableState(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, 0)
+	s.expectList(state)
+	s.expectGet(state, 2)
+	s.historyMgr.On("ReadHistoryBranch", mock.Anything).Return(nil, serviceerror.NewNotFound("history not found")).Times(2)
+	s.executionMgr.On("DeleteCurrentWorkflowExecution", &p.DeleteCurrentWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+	}).Return(nil).Once()
+	s.executionMgr.On("DeleteWorkflowExecution", &p.DeleteWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+	}).Return(nil).Once()
+
+	report := s.runScavenger(ScannerWorkflowParams{
+		InvariantTypes: []string{string(invariantTypeHistoryExists)},
+		FixEnabled:     true,
+	})
+	s.Equal(InvariantReport{CorruptedCount: 1, FixedCount: 1}, report.Invariants[string(invariantTypeHistoryExists)])
+}
+
+func (s *ScavengerTestSuite) TestOpenExecutionWithoutPendingWork_Healthy() {
+	// e.g. a workflow waiting for a signal, it has no pending workflow task, timer or activity
+	state := s.newMutableState(enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, 0)
+	s.expectList(state)
+	s.expectGet(state, 1)
+	s.expectHistory(nil)
+
+	report := s.runScavenger(ScannerWorkflowParams{
+		InvariantTypes: []string{string(invariantTypeNextEventID)},
+		FixEnabled:     true,
+	})
+	s.Equal(InvariantReport{}, report.Invariants[string(invariantTypeNextEventID)])
+}
+
+func (s *ScavengerTestSuite) TestNextEventIDMismatch_NotFixed() {
+	state := s.newMutableState(enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, 0)
+	state.ExecutionInfo.NextEventId = 5
+	s.expectList(state)
+	s.expectGet(state, 2)
+	s.historyMgr.On("ReadHistoryBranch", mock.Anything).Return(&p.ReadHistoryBranchResponse{
+		HistoryEvents: []*historypb.HistoryEvent{{EventId: 1}},
+	}, nil).Times(2)
+
+	report := s.runScavenger(ScannerWorkflowParams{
+		InvariantTypes: []string{string(invariantTypeNextEventID)},
+		FixEnabled:     true,
+	})
+	s.Equal(InvariantReport{CorruptedCount: 1, FixSkippedCount: 1}, report.Invariants[string(invariantTypeNextEventID)])
+}
+
+func (s *ScavengerTestSuite) TestOrphanedCurrentExecution_Fixed() {
+	orphanRunID := "orphan-run-id"
+	state := s.newMutableState(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, 0)
+	s.expectList(state)
+	s.expectGet(state, 2)
+	s.executionMgr.On("GetCurrentExecution", mock.Anything).Return(&p.GetCurrentExecutionResponse{RunID: orphanRunID}, nil).Times(3)
+	s.executionMgr.On("GetWorkflowExecution", mock.MatchedBy(func(req *p.GetWorkflowExecutionRequest) bool {
+		return req.Execution.GetRunId() == orphanRunID
+	})).Return(nil, serviceerror.NewNotFound("execution not found")).Times(3)
+	s.executionMgr.On("DeleteCurrentWorkflowExecution", &p.DeleteCurrentWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       orphanRunID,
+	}).Return(nil).Once()
+
+	report := s.runScavenger(ScannerWorkflowParams{
+		InvariantTypes: []string{string(invariantTypeCurrentExecution)},
+		FixEnabled:     true,
+	})
+	s.Equal(InvariantReport{CorruptedCount: 1, FixedCount: 1}, report.Invariants[string(invariantTypeCurrentExecution)])
+}
+
+func (s *ScavengerTestSuite) TestCheckError() {
+	state := s.newMutableState(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, 0)
+	s.expectList(state)
+	s.expectGet(state, 1)
+	s.expectHistory(errors.New("transient error"))
+
+	report := s.runScavenger(ScannerWorkflowParams{
+		InvariantTypes: []string{string(invariantTypeHistoryExists)},
+		FixEnabled:     true,
+	})
+	s.Equal(InvariantReport{CheckErrorCount: 1}, report.Invariants[string(invariantTypeHistoryExists)])
+}
+
+func (s *ScavengerTestSuite) TestUnknownInvariantType() {
+	_, err := s.newScavenger(ScannerWorkflowParams{InvariantTypes: []string{"unknown"}})
+	s.Error(err)
+}
+
+func (s *ScavengerTestSuite) newScavenger(params ScannerWorkflowParams) (*Scavenger, error) {
+	return NewScavenger(
+		params,
+		1,
+		1000,
+		func(shardID int) (p.ExecutionManager, error) { return s.executionMgr, nil },
+		s.historyMgr,
+		metrics.NewClient(tally.NoopScope, metrics.Worker),
+		loggerimpl.NewNopLogger(),
+	)
+}
+
+func (s *ScavengerTestSuite) runScavenger(params ScannerWorkflowParams) ScavengerReport {
+	scvg, err := s.newScavenger(params)
+	s.NoError(err)
+	scvg.Start()
+	s.Eventually(func() bool { return !scvg.Alive() }, 5*time.Second, 10*time.Millisecond)
+	return scvg.Report()
+}
+
+func (s *ScavengerTestSuite) newMutableState(state enumsspb.WorkflowExecutionState, workflowTaskScheduleID int64) *p.WorkflowMutableState {
+	return &p.WorkflowMutableState{
+		ExecutionInfo: &p.WorkflowExecutionInfo{
+			ExecutionState: &persistenceblobs.WorkflowExecutionState{
+				RunId: testRunID,
+				State: state,
+			},
+			NamespaceId:            testNamespaceID,
+			WorkflowId:             testWorkflowID,
+			WorkflowTaskScheduleId: workflowTaskScheduleID,
+			EventBranchToken:       testBranchToken,
+			LastFirstEventId:       1,
+			NextEventId:            2,
+		},
+	}
+}
+
+func (s *ScavengerTestSuite) expectList(state *p.WorkflowMutableState) {
+	s.executionMgr.On("ListConcreteExecutions", mock.Anything).Return(&p.ListConcreteExecutionsResponse{
+		ExecutionInfos: []*p.WorkflowExecutionInfo{state.ExecutionInfo},
+	}, nil).Once()
+}
+
+func (s *ScavengerTestSuite) expectGet(state *p.WorkflowMutableState, times int) {
+	s.executionMgr.On("GetWorkflowExecution", mock.MatchedBy(func(req *p.GetWorkflowExecutionRequest) bool {
+		return req.Execution.GetRunId() == testRunID
+	})).Return(&p.GetWorkflowExecutionResponse{State: state}, nil).Times(times)
+}
+
+func (s *ScavengerTestSuite) expectHistory(err error) {
+	if err != nil {
+		s.historyMgr.On("ReadHistoryBranch", mock.Anything).Return(nil, err).Once()
+		return
+	}
+	s.historyMgr.On("ReadHistoryBranch", mock.Anything).Return(&p.ReadHistoryBranchResponse{
+		HistoryEvents: []*historypb.HistoryEvent{{EventId: 1}},
+	}, nil).Once()
+}
+
+func (s *ScavengerTestSuite) expectCurrent(runID string, err error) {
+	if err != nil {
+		s.executionMgr.On("GetCurrentExecution", mock.Anything).Return(nil, err).Once()
+		return
+	}
+	s.executionMgr.On("GetCurrentExecution", mock.Anything).Return(&p.GetCurrentExecutionResponse{RunID: runID}, nil).Once()
+}
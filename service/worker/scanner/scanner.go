@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/service/worker/scanner/archival"
 	"go.temporal.io/server/service/worker/scanner/executions"
 )
 
@@ -48,13 +49,6 @@ const (
 	scannerStartUpDelay = time.Second * 4
 )
 
-var (
-	defaultExecutionsScannerParams = executions.ScannerWorkflowParams{
-		// fullExecutionsScanDefaultQuery indicates the visibility scanner should scan through all open workflows
-		VisibilityQuery: "SELECT * from elasticSearch.executions WHERE state IS open", // TODO: depending on if we go straight to ES or through frontend this query will look different
-	}
-)
-
 type (
 	// Config defines the configuration for scanner
 	Config struct {
@@ -70,6 +64,14 @@ type (
 		HistoryScannerEnabled dynamicconfig.BoolPropertyFn
 		// ExecutionsScannerEnabled indicates if executions scanner should be started as part of scanner
 		ExecutionsScannerEnabled dynamicconfig.BoolPropertyFn
+		// ExecutionsScannerFixEnabled indicates if executions scanner should repair corrupted executions
+		ExecutionsScannerFixEnabled dynamicconfig.BoolPropertyFn
+		// ArchivalScannerEnabled indicates if archival scanner should be started as part of scanner
+		ArchivalScannerEnabled dynamicconfig.BoolPropertyFn
+		// ArchivalScannerSampleRate is the fraction of archived workflows verified by each archival scanner run
+		ArchivalScannerSampleRate dynamicconfig.FloatPropertyFn
+		// ArchivalScannerReArchiveEnabled indicates if archival scanner should re-archive histories whose source still exists
+		ArchivalScannerReArchiveEnabled dynamicconfig.BoolPropertyFn
 	}
 
 	// BootstrapParams contains the set of params needed to bootstrap
@@ -125,7 +127,19 @@ func (s *Scanner) Start() error {
 	var workerTaskQueueNames []string
 	if s.context.cfg.ExecutionsScannerEnabled() {
 		workerTaskQueueNames = append(workerTaskQueueNames, executionsScannerTaskQueueName)
-		go s.startWorkflowWithRetry(executionsScannerWFStartOptions, executionsScannerWFTypeName, defaultExecutionsScannerParams)
+		executionsScannerParams := executions.ScannerWorkflowParams{
+			FixEnabled: s.context.cfg.ExecutionsScannerFixEnabled(),
+		}
+		go s.startWorkflowWithRetry(executionsScannerWFStartOptions, executionsScannerWFTypeName, executionsScannerParams)
+	}
+
+	if s.context.cfg.ArchivalScannerEnabled() {
+		workerTaskQueueNames = append(workerTaskQueueNames, archivalScannerTaskQueueName)
+		archivalScannerParams := archival.ScannerWorkflowParams{
+			SampleRate:       s.context.cfg.ArchivalScannerSampleRate(),
+			ReArchiveEnabled: s.context.cfg.ArchivalScannerReArchiveEnabled(),
+		}
+		go s.startWorkflowWithRetry(archivalScannerWFStartOptions, archivalScannerWFTypeName, archivalScannerParams)
 	}
 
 	if s.context.cfg.Persistence.DefaultStoreType() == config.StoreTypeSQL && s.context.cfg.TaskQueueScannerEnabled() {
@@ -142,9 +156,11 @@ func (s *Scanner) Start() error {
 		work.RegisterWorkflowWithOptions(TaskQueueScannerWorkflow, workflow.RegisterOptions{Name: tqScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(ExecutionsScannerWorkflow, workflow.RegisterOptions{Name: executionsScannerWFTypeName})
+		work.RegisterWorkflowWithOptions(ArchivalScannerWorkflow, workflow.RegisterOptions{Name: archivalScannerWFTypeName})
 		work.RegisterActivityWithOptions(TaskQueueScavengerActivity, activity.RegisterOptions{Name: taskQueueScavengerActivityName})
 		work.RegisterActivityWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 		work.RegisterActivityWithOptions(ExecutionsScavengerActivity, activity.RegisterOptions{Name: executionsScavengerActivityName})
+		work.RegisterActivityWithOptions(ArchivalScavengerActivity, activity.RegisterOptions{Name: archivalScavengerActivityName})
 
 		if err := work.Start(); err != nil {
 			return err
@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/sdk/workflow"
 
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/service/worker/scanner/archival"
 	"go.temporal.io/server/service/worker/scanner/executions"
 	"go.temporal.io/server/service/worker/scanner/history"
 	"go.temporal.io/server/service/worker/scanner/taskqueue"
@@ -65,6 +66,12 @@ const (
 	executionsScannerWFTypeName     = "temporal-sys-executions-scanner-workflow"
 	executionsScannerTaskQueueName  = "temporal-sys-executions-scanner-taskqueue-0"
 	executionsScavengerActivityName = "temporal-sys-executions-scanner-scvg-activity"
+
+	// ArchivalScannerWFID is the workflow ID of the archival scanner, its results can be read from its workflow history
+	ArchivalScannerWFID           = "temporal-sys-archival-scanner"
+	archivalScannerWFTypeName     = "temporal-sys-archival-scanner-workflow"
+	archivalScannerTaskQueueName  = "temporal-sys-archival-scanner-taskqueue-0"
+	archivalScavengerActivityName = "temporal-sys-archival-scanner-scvg-activity"
 )
 
 var (
@@ -101,6 +108,12 @@ var (
 		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
 		CronSchedule:          "0 */12 * * *",
 	}
+	archivalScannerWFStartOptions = client.StartWorkflowOptions{
+		ID:                    ArchivalScannerWFID,
+		TaskQueue:             archivalScannerTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 0 * * *",
+	}
 )
 
 // TaskQueueScannerWorkflow is the workflow that runs the task queue scanner background daemon
@@ -134,6 +147,18 @@ func ExecutionsScannerWorkflow(
 	return future.Get(ctx, nil)
 }
 
+// ArchivalScannerWorkflow is the workflow that runs the archival scanner background daemon
+func ArchivalScannerWorkflow(
+	ctx workflow.Context,
+	archivalScannerWorkflowParams archival.ScannerWorkflowParams,
+) (archival.ScavengerReport, error) {
+
+	var report archival.ScavengerReport
+	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), archivalScavengerActivityName, archivalScannerWorkflowParams)
+	err := future.Get(ctx, &report)
+	return report, err
+}
+
 // HistoryScavengerActivity is the activity that runs history scavenger
 func HistoryScavengerActivity(
 	activityCtx context.Context,
@@ -185,20 +210,57 @@ func TaskQueueScavengerActivity(
 func ExecutionsScavengerActivity(
 	activityCtx context.Context,
 	executionsScannerWorkflowParams executions.ScannerWorkflowParams,
-) error {
+) (executions.ScavengerReport, error) {
 
 	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
-	scavenger := executions.NewScavenger(executionsScannerWorkflowParams, ctx.GetFrontendClient(), ctx.GetHistoryManager(), ctx.GetMetricsClient(), ctx.GetLogger())
+	scavenger, err := executions.NewScavenger(
+		executionsScannerWorkflowParams,
+		ctx.cfg.Persistence.NumHistoryShards,
+		ctx.cfg.PersistenceMaxQPS(),
+		ctx.GetExecutionManager,
+		ctx.GetHistoryManager(),
+		ctx.GetMetricsClient(),
+		ctx.GetLogger(),
+	)
+	if err != nil {
+		return executions.ScavengerReport{}, temporal.NewNonRetryableApplicationError(err.Error(), "", nil)
+	}
 	ctx.GetLogger().Info("Starting executions scavenger")
 	scavenger.Start()
 	for scavenger.Alive() {
-		activity.RecordHeartbeat(activityCtx)
+		activity.RecordHeartbeat(activityCtx, scavenger.Report())
 		if activityCtx.Err() != nil {
 			ctx.GetLogger().Info("activity context error, stopping scavenger", tag.Error(activityCtx.Err()))
 			scavenger.Stop()
-			return activityCtx.Err()
+			return scavenger.Report(), activityCtx.Err()
 		}
 		time.Sleep(executionsScavengerHBInterval)
 	}
-	return nil
+	return scavenger.Report(), nil
+}
+
+// ArchivalScavengerActivity is the activity that runs archival scavenger
+func ArchivalScavengerActivity(
+	activityCtx context.Context,
+	archivalScannerWorkflowParams archival.ScannerWorkflowParams,
+) (archival.ScavengerReport, error) {
+
+	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
+	if !ctx.GetArchivalMetadata().GetVisibilityConfig().ClusterConfiguredForArchival() {
+		ctx.GetLogger().Info("Visibility archival is not enabled for the cluster, skipping archival scavenger")
+		return archival.ScavengerReport{}, nil
+	}
+
+	scavenger := archival.NewScavenger(
+		archivalScannerWorkflowParams,
+		ctx.cfg.Persistence.NumHistoryShards,
+		ctx.cfg.PersistenceMaxQPS(),
+		ctx.GetMetadataManager(),
+		ctx.GetArchiverProvider(),
+		ctx.GetExecutionManager,
+		ctx.GetMetricsClient(),
+		ctx.GetLogger(),
+	)
+	ctx.GetLogger().Info("Starting archival scavenger")
+	return scavenger.Run(activityCtx)
 }
@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/service/worker/scanner/archival"
 )
 
 type scannerWorkflowTestSuite struct {
@@ -72,6 +73,23 @@ func (s *scannerWorkflowTestSuite) TestWorkflow() {
 	s.True(env.IsWorkflowCompleted())
 }
 
+func (s *scannerWorkflowTestSuite) TestArchivalScannerWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(ArchivalScannerWorkflow, workflow.RegisterOptions{Name: archivalScannerWFTypeName})
+	env.RegisterActivityWithOptions(ArchivalScavengerActivity, activity.RegisterOptions{Name: archivalScavengerActivityName})
+	params := archival.ScannerWorkflowParams{SampleRate: 0.5, ReArchiveEnabled: true}
+	report := archival.ScavengerReport{
+		Namespaces: map[string]*archival.NamespaceReport{"test-namespace": {HistoriesVerified: 1}},
+	}
+	env.OnActivity(archivalScavengerActivityName, mock.Anything, params).Return(report, nil)
+	env.ExecuteWorkflow(archivalScannerWFTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result archival.ScavengerReport
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(report, result)
+}
+
 func (s *scannerWorkflowTestSuite) TestScavengerActivity() {
 	env := s.NewTestActivityEnvironment()
 	s.registerActivities(env)
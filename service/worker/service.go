@@ -32,15 +32,18 @@ import (
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/largepayload"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	espersistence "go.temporal.io/server/common/persistence/elasticsearch"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/dynamicconfig"
 	"go.temporal.io/server/service/worker/archiver"
 	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/deletenamespace"
 	"go.temporal.io/server/service/worker/indexer"
 	"go.temporal.io/server/service/worker/parentclosepolicy"
 	"go.temporal.io/server/service/worker/replicator"
@@ -68,10 +71,12 @@ type (
 		IndexerCfg                    *indexer.Config
 		ScannerCfg                    *scanner.Config
 		BatcherCfg                    *batcher.Config
+		NamespaceDeleterCfg           *deletenamespace.Config
 		ThrottledLogRPS               dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS       dynamicconfig.IntPropertyFn
 		EnableBatcher                 dynamicconfig.BoolPropertyFn
 		EnableParentClosePolicyWorker dynamicconfig.BoolPropertyFn
+		EnableNamespaceDeleter        dynamicconfig.BoolPropertyFn
 	}
 )
 
@@ -132,19 +137,28 @@ func NewConfig(params *resource.BootstrapParams) *Config {
 			TimeLimitPerArchivalIteration: dc.GetDurationProperty(dynamicconfig.WorkerTimeLimitPerArchivalIteration, archiver.MaxArchivalIterationTimeout()),
 		},
 		ScannerCfg: &scanner.Config{
-			PersistenceMaxQPS:        dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 100),
-			Persistence:              &params.PersistenceConfig,
-			ClusterMetadata:          params.ClusterMetadata,
-			TaskQueueScannerEnabled:  dc.GetBoolProperty(dynamicconfig.TaskQueueScannerEnabled, true),
-			HistoryScannerEnabled:    dc.GetBoolProperty(dynamicconfig.HistoryScannerEnabled, true),
-			ExecutionsScannerEnabled: dc.GetBoolProperty(dynamicconfig.ExecutionsScannerEnabled, false),
+			PersistenceMaxQPS:               dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 100),
+			Persistence:                     &params.PersistenceConfig,
+			ClusterMetadata:                 params.ClusterMetadata,
+			TaskQueueScannerEnabled:         dc.GetBoolProperty(dynamicconfig.TaskQueueScannerEnabled, true),
+			HistoryScannerEnabled:           dc.GetBoolProperty(dynamicconfig.HistoryScannerEnabled, true),
+			ExecutionsScannerEnabled:        dc.GetBoolProperty(dynamicconfig.ExecutionsScannerEnabled, false),
+			ExecutionsScannerFixEnabled:     dc.GetBoolProperty(dynamicconfig.ExecutionsScannerFixEnabled, false),
+			ArchivalScannerEnabled:          dc.GetBoolProperty(dynamicconfig.ArchivalScannerEnabled, false),
+			ArchivalScannerSampleRate:       dc.GetFloat64Property(dynamicconfig.ArchivalScannerSampleRate, 1),
+			ArchivalScannerReArchiveEnabled: dc.GetBoolProperty(dynamicconfig.ArchivalScannerReArchiveEnabled, false),
 		},
 		BatcherCfg: &batcher.Config{
 			AdminOperationToken: dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
 			ClusterMetadata:     params.ClusterMetadata,
 		},
+		NamespaceDeleterCfg: &deletenamespace.Config{
+			PersistenceMaxQPS: dc.GetIntProperty(dynamicconfig.NamespaceDeleterPersistenceMaxQPS, 100),
+			Persistence:       &params.PersistenceConfig,
+		},
 		EnableBatcher:                 dc.GetBoolProperty(dynamicconfig.EnableBatcher, true),
 		EnableParentClosePolicyWorker: dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
+		EnableNamespaceDeleter:        dc.GetBoolProperty(dynamicconfig.EnableNamespaceDeleter, true),
 		ThrottledLogRPS:               dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
 		PersistenceGlobalMaxQPS:       dc.GetIntProperty(dynamicconfig.WorkerPersistenceGlobalMaxQPS, 0),
 	}
@@ -194,6 +208,9 @@ func (s *Service) Start() {
 	if s.config.EnableParentClosePolicyWorker() {
 		s.startParentClosePolicyProcessor()
 	}
+	if s.config.EnableNamespaceDeleter() {
+		s.startNamespaceDeleter()
+	}
 
 	logger.Info("worker started", tag.ComponentWorker)
 	<-s.stopC
@@ -238,6 +255,23 @@ func (s *Service) startBatcher() {
 	}
 }
 
+func (s *Service) startNamespaceDeleter() {
+	params := &deletenamespace.BootstrapParams{
+		Config: *s.config.NamespaceDeleterCfg,
+	}
+	if s.params.ESConfig != nil {
+		visibilityProducer, err := s.GetMessagingClient().NewProducer(common.VisibilityAppName)
+		if err != nil {
+			s.GetLogger().Fatal("error creating namespace deleter visibility producer", tag.Error(err))
+		}
+		params.AdvancedVisibilityManager = espersistence.NewESVisibilityManager("", nil, nil, visibilityProducer,
+			s.GetMetricsClient(), s.GetLogger())
+	}
+	if err := deletenamespace.New(s.Resource, params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting namespace deleter", tag.Error(err))
+	}
+}
+
 func (s *Service) startScanner() {
 	params := &scanner.BootstrapParams{
 		Config: *s.config.ScannerCfg,
@@ -292,10 +326,13 @@ func (s *Service) startArchiver() {
 		PublicClient:     s.GetSDKClient(),
 		MetricsClient:    s.GetMetricsClient(),
 		Logger:           s.GetLogger(),
-		HistoryV2Manager: s.GetHistoryManager(),
+		HistoryV2Manager: largepayload.NewRehydratingHistoryManager(s.GetHistoryManager(), s.GetLargePayloadOffloader()),
 		NamespaceCache:   s.GetNamespaceCache(),
+		ClusterMetadata:  s.GetClusterMetadata(),
 		Config:           s.config.ArchiverConfig,
 		ArchiverProvider: s.GetArchiverProvider(),
+
+		LargePayloadOffloader: s.GetLargePayloadOffloader(),
 	}
 	clientWorker := archiver.NewClientWorker(bc)
 	if err := clientWorker.Start(); err != nil {
@@ -93,5 +93,137 @@ func newActivityCommands() []cli.Command {
 				FailActivity(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "pause an activity, its tasks are not dispatched until it is unpaused",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityId to operate on",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason to pause the activity",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseActivity(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "unpause a paused activity",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityId to operate on",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason to unpause the activity",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UnpauseActivity(c)
+			},
+		},
+		{
+			Name:  "reset",
+			Usage: "reset the attempt counter and the retry backoff of an activity which is not running on a worker",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityId to operate on",
+				},
+				cli.BoolFlag{
+					Name:  FlagResetHeartbeatDetails,
+					Usage: "Clear the heartbeat details recorded by the previous attempts",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ResetActivity(c)
+			},
+		},
+		{
+			Name:  "update-options",
+			Usage: "update the retry policy and the timeouts of a pending activity, options which are not set are left unchanged",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityId to operate on",
+				},
+				cli.IntFlag{
+					Name:  FlagScheduleToCloseTimeout,
+					Usage: "Schedule to close timeout in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagScheduleToStartTimeout,
+					Usage: "Schedule to start timeout in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagStartToCloseTimeout,
+					Usage: "Start to close timeout in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagHeartbeatTimeout,
+					Usage: "Heartbeat timeout in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagRetryInitialInterval,
+					Usage: "Retry initial interval in seconds, setting any retry option replaces the retry policy",
+				},
+				cli.Float64Flag{
+					Name:  FlagRetryBackoffCoefficient,
+					Usage: "Retry backoff coefficient",
+				},
+				cli.IntFlag{
+					Name:  FlagRetryMaximumInterval,
+					Usage: "Retry maximum interval in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagRetryMaximumAttempts,
+					Usage: "Retry maximum attempts, 0 means unlimited",
+				},
+				cli.StringSliceFlag{
+					Name:  FlagRetryNonRetryableErrorTypes,
+					Usage: "Error types which are not retried, can be passed multiple times",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UpdateActivityOptions(c)
+			},
+		},
 	}
 }
@@ -91,6 +91,54 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminRefreshWorkflowTasks(c)
 			},
 		},
+		{
+			Name:    "stream",
+			Aliases: []string{"st"},
+			Usage:   "Stream workflow history events as they are committed",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.Int64Flag{
+					Name:  FlagEventIDWithAlias,
+					Usage: "The first event to stream, the stream starts from the beginning of the history if it is not set",
+				},
+				cli.StringSliceFlag{
+					Name:  FlagEventTypeWithAlias,
+					Usage: "Only stream events of this type, e.g. ActivityTaskScheduled, can be passed multiple times",
+				},
+				cli.IntFlag{
+					Name:  FlagMaxFieldLengthWithAlias,
+					Usage: "Maximum length for each attribute field",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminStreamWorkflowHistory(c)
+			},
+		},
+		{
+			Name:    "restore",
+			Aliases: []string{"rs"},
+			Usage:   "Restore a closed workflow execution from the history archival of its namespace",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRestoreWorkflow(c)
+			},
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
@@ -384,6 +432,68 @@ func newAdminNamespaceCommands() []cli.Command {
 				newNamespaceCLI(c, true).DescribeNamespace(c)
 			},
 		},
+		{
+			Name:    "delete",
+			Aliases: []string{"del"},
+			Usage:   "Delete existing workflow namespace and all of its workflow executions",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSecurityTokenWithAlias,
+					Usage: "Optional token for security check",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDeleteNamespace(c)
+			},
+		},
+		{
+			Name:    "rename",
+			Aliases: []string{"rn"},
+			Usage:   "Rename existing workflow namespace, the previous name is kept as an alias",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagNewNameWithAlias,
+					Usage: "New name of the namespace",
+				},
+				cli.IntFlag{
+					Name:  FlagAliasRetentionDaysWithAlias,
+					Usage: "Optional number of days the previous name remains usable as an alias, 0 drops it immediately",
+				},
+				cli.StringFlag{
+					Name:  FlagSecurityTokenWithAlias,
+					Usage: "Optional token for security check",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRenameNamespace(c)
+			},
+		},
+		{
+			Name:    "deadline_rules",
+			Aliases: []string{"dr"},
+			Usage:   "Show or update the default deadline rules of the runs of existing workflow namespace",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagInputWithAlias,
+					Usage: "Optional deadline rules in JSON format, e.g. {\"rules\":[...]}, {} clears the rules",
+				},
+				cli.StringFlag{
+					Name:  FlagSecurityTokenWithAlias,
+					Usage: "Optional token for security check",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminUpdateNamespaceDeadlineRules(c)
+			},
+		},
+		{
+			Name:    "aliases",
+			Aliases: []string{"al"},
+			Usage:   "List the aliases of existing workflow namespace",
+			Action: func(c *cli.Context) {
+				AdminDescribeNamespaceAliases(c)
+			},
+		},
 		{
 			Name:    "get_namespaceidorname",
 			Aliases: []string{"getdn"},
@@ -826,6 +936,88 @@ func newAdminClusterCommands() []cli.Command {
 	}
 }
 
+func newAdminDynamicConfigCommands() []cli.Command {
+	filterFlags := []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagNamespace,
+			Usage: "Optional namespace filter",
+		},
+		cli.StringFlag{
+			Name:  FlagNamespaceID,
+			Usage: "Optional namespace id filter",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskQueueWithAlias,
+			Usage: "Optional task queue name filter",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskQueueTypeWithAlias,
+			Usage: "Optional task queue type filter [workflow|activity]",
+		},
+		cli.IntFlag{
+			Name:  FlagShardIDWithAlias,
+			Usage: "Optional shard id filter",
+		},
+	}
+	return []cli.Command{
+		{
+			Name:  "get",
+			Usage: "Get the effective value of a dynamic config key",
+			Flags: append([]cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDynamicConfigName,
+					Usage: "Dynamic config key, e.g. history.persistenceMaxQPS",
+				},
+			}, filterFlags...),
+			Action: func(c *cli.Context) {
+				AdminGetDynamicConfig(c)
+			},
+		},
+		{
+			Name:  "set",
+			Usage: "Set the value of a dynamic config key without filters on the frontend host serving the request only",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDynamicConfigName,
+					Usage: "Dynamic config key, e.g. history.persistenceMaxQPS",
+				},
+				cli.StringFlag{
+					Name:  FlagDynamicConfigValue,
+					Usage: "Value in json or yaml format, e.g. 3000, 10s or {\"key\": 1}",
+				},
+				cli.StringFlag{
+					Name:  FlagSecurityTokenWithAlias,
+					Usage: "Optional token for security check",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminUpdateDynamicConfig(c)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List dynamic config keys accepting the given filters with their effective values",
+			Flags: filterFlags,
+			Action: func(c *cli.Context) {
+				AdminListDynamicConfig(c)
+			},
+		},
+	}
+}
+
+func newAdminArchivalCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "report",
+			Aliases: []string{"rep"},
+			Usage:   "Show the progress and the last report of the archival scanner",
+			Action: func(c *cli.Context) {
+				AdminArchivalReport(c)
+			},
+		},
+	}
+}
+
 func newAdminDLQCommands() []cli.Command {
 	return []cli.Command{
 		{
@@ -0,0 +1,74 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package cli
+
+import (
+	"github.com/urfave/cli"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/service/worker/scanner"
+	"go.temporal.io/server/service/worker/scanner/archival"
+)
+
+// AdminArchivalReport prints the results of the archival scanner
+func AdminArchivalReport(c *cli.Context) {
+	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	wf, err := client.DescribeWorkflowExecution(ctx, scanner.ArchivalScannerWFID, "")
+	if err != nil {
+		ErrorAndExit("Failed to describe archival scanner", err)
+	}
+
+	output := map[string]interface{}{
+		"status": wf.WorkflowExecutionInfo.GetStatus().String(),
+	}
+	if len(wf.PendingActivities) > 0 && wf.PendingActivities[0].HeartbeatDetails != nil {
+		var progress archival.ScavengerReport
+		if err := payloads.Decode(wf.PendingActivities[0].HeartbeatDetails, &progress); err != nil {
+			ErrorAndExit("Failed to decode archival scanner progress", err)
+		}
+		output["progress"] = progress
+	}
+
+	// the scanner is a cron workflow, the result of the last completed scan is carried over to the current run
+	iter := client.GetWorkflowHistory(ctx, scanner.ArchivalScannerWFID, wf.WorkflowExecutionInfo.Execution.GetRunId(), false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+	if iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			ErrorAndExit("Failed to read archival scanner history", err)
+		}
+		lastResult := event.GetWorkflowExecutionStartedEventAttributes().GetLastCompletionResult()
+		if lastResult != nil {
+			var report archival.ScavengerReport
+			if err := payloads.Decode(lastResult, &report); err != nil {
+				ErrorAndExit("Failed to decode archival scanner report", err)
+			}
+			output["lastReport"] = report
+		}
+	}
+	prettyPrintJSONObject(output)
+}
@@ -28,6 +28,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"strconv"
 	"time"
@@ -37,9 +38,11 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/workflowservice/v1"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/auth"
 	"go.temporal.io/server/common/codec"
@@ -435,6 +438,97 @@ func AdminGetNamespaceIDOrName(c *cli.Context) {
 	}
 }
 
+// AdminDeleteNamespace deletes a namespace and starts the deletion of all of its resources
+func AdminDeleteNamespace(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	prompt(fmt.Sprintf("Will delete namespace %s and all of its workflow executions, continue? Y/N", namespace), c.GlobalBool(FlagAutoConfirm))
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DeleteNamespace(ctx, &adminservice.DeleteNamespaceRequest{
+		Namespace:     namespace,
+		SecurityToken: c.String(FlagSecurityToken),
+	})
+	if err != nil {
+		ErrorAndExit("Delete namespace failed", err)
+	}
+	fmt.Printf("Namespace %s is deleted, its resources are deleted by workflow %s.\n", namespace, resp.GetWorkflowId())
+}
+
+// AdminRenameNamespace renames a namespace
+func AdminRenameNamespace(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	newName := getRequiredOption(c, FlagNewName)
+
+	request := &adminservice.UpdateNamespaceRequest{
+		Request: &workflowservice.UpdateNamespaceRequest{
+			Name:          namespace,
+			SecurityToken: c.String(FlagSecurityToken),
+		},
+		NewName: newName,
+	}
+	if c.IsSet(FlagAliasRetentionDays) {
+		aliasRetention := time.Duration(c.Int(FlagAliasRetentionDays)) * day
+		request.AliasRetention = &aliasRetention
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	if _, err := adminClient.UpdateNamespace(ctx, request); err != nil {
+		ErrorAndExit("Rename namespace failed", err)
+	}
+	fmt.Printf("Namespace %s is renamed to %s.\n", namespace, newName)
+}
+
+// AdminUpdateNamespaceDeadlineRules updates the default deadline rules of a namespace and prints them
+func AdminUpdateNamespaceDeadlineRules(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+
+	request := &adminservice.UpdateNamespaceRequest{
+		Request: &workflowservice.UpdateNamespaceRequest{
+			Name:          namespace,
+			SecurityToken: c.String(FlagSecurityToken),
+		},
+	}
+	if c.IsSet(FlagInput) {
+		request.DeadlineRules = &workflowspb.DeadlineRules{}
+		if err := codec.NewJSONPBEncoder().Decode([]byte(c.String(FlagInput)), request.DeadlineRules); err != nil {
+			ErrorAndExit("Invalid deadline rules", err)
+		}
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.UpdateNamespace(ctx, request)
+	if err != nil {
+		ErrorAndExit("Update namespace deadline rules failed", err)
+	}
+	prettyPrintJSONObject(resp.GetDeadlineRules())
+}
+
+// AdminDescribeNamespaceAliases prints the aliases of a namespace
+func AdminDescribeNamespaceAliases(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeNamespaceAliases(ctx, &adminservice.DescribeNamespaceAliasesRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		ErrorAndExit("Describe namespace aliases failed", err)
+	}
+	prettyPrintJSONObject(resp)
+}
+
 // AdminGetShardID get shardID
 func AdminGetShardID(c *cli.Context) {
 	namespaceID := getRequiredOption(c, FlagNamespaceID)
@@ -759,3 +853,73 @@ func AdminRefreshWorkflowTasks(c *cli.Context) {
 		fmt.Println("Refresh workflow task succeeded.")
 	}
 }
+
+// AdminStreamWorkflowHistory prints the history events of a workflow execution as they are committed
+func AdminStreamWorkflowHistory(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	var eventTypes []enumspb.EventType
+	for _, name := range c.StringSlice(FlagEventType) {
+		eventType, ok := enumspb.EventType_value[name]
+		if !ok {
+			ErrorAndExit(fmt.Sprintf("Unknown event type %v.", name), nil)
+		}
+		eventTypes = append(eventTypes, enumspb.EventType(eventType))
+	}
+
+	ctx, cancel := newIndefiniteContext(c)
+	defer cancel()
+
+	stream, err := adminClient.StreamWorkflowExecutionHistory(ctx, &adminservice.StreamWorkflowExecutionHistoryRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		FirstEventId: c.Int64(FlagEventID),
+		EventTypes:   eventTypes,
+	})
+	if err != nil {
+		ErrorAndExit("Stream workflow history failed", err)
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			ErrorAndExit("Stream workflow history failed", err)
+		}
+		for _, e := range resp.GetHistory().GetEvents() {
+			fmt.Println(anyToString(e, true, c.Int(FlagMaxFieldLength)))
+		}
+	}
+}
+
+// AdminRestoreWorkflow restores a closed workflow execution from archival
+func AdminRestoreWorkflow(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := getRequiredOption(c, FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := adminClient.RestoreWorkflowExecution(ctx, &adminservice.RestoreWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Restore workflow failed", err)
+	} else {
+		fmt.Println("Restore workflow succeeded.")
+	}
+}
@@ -0,0 +1,142 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	dynamicconfigspb "go.temporal.io/server/api/dynamicconfig/v1"
+)
+
+// AdminGetDynamicConfig prints the effective value of a dynamic config key
+func AdminGetDynamicConfig(c *cli.Context) {
+	name := getRequiredOption(c, FlagDynamicConfigName)
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.GetDynamicConfig(ctx, &adminservice.GetDynamicConfigRequest{
+		Name:    name,
+		Filters: getDynamicConfigFilters(c),
+	})
+	if err != nil {
+		ErrorAndExit("Operation GetDynamicConfig failed.", err)
+	}
+
+	prettyPrintJSONObject(response.GetEntry())
+}
+
+// AdminUpdateDynamicConfig updates the value of a dynamic config key
+func AdminUpdateDynamicConfig(c *cli.Context) {
+	name := getRequiredOption(c, FlagDynamicConfigName)
+	value := getRequiredOption(c, FlagDynamicConfigValue)
+
+	promptMsg := fmt.Sprintf(
+		"Are you trying to set [%s] to [%s]? Y/N",
+		color.YellowString(name),
+		color.YellowString(value),
+	)
+	prompt(promptMsg, c.GlobalBool(FlagAutoConfirm))
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.UpdateDynamicConfig(ctx, &adminservice.UpdateDynamicConfigRequest{
+		Name:          name,
+		Value:         value,
+		SecurityToken: c.String(FlagSecurityToken),
+	})
+	if err != nil {
+		ErrorAndExit("Operation UpdateDynamicConfig failed.", err)
+	}
+	fmt.Println("Success")
+}
+
+// AdminListDynamicConfig prints the effective values of all dynamic config keys which accept the given filters
+func AdminListDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.ListDynamicConfig(ctx, &adminservice.ListDynamicConfigRequest{
+		Filters: getDynamicConfigFilters(c),
+	})
+	if err != nil {
+		ErrorAndExit("Operation ListDynamicConfig failed.", err)
+	}
+
+	printDynamicConfigEntries(response.GetEntries())
+}
+
+func getDynamicConfigFilters(c *cli.Context) map[string]string {
+	filters := make(map[string]string)
+	if c.IsSet(FlagNamespace) {
+		filters["namespace"] = c.String(FlagNamespace)
+	}
+	if c.IsSet(FlagNamespaceID) {
+		filters["namespaceID"] = c.String(FlagNamespaceID)
+	}
+	if c.IsSet(FlagTaskQueue) {
+		filters["taskQueueName"] = c.String(FlagTaskQueue)
+	}
+	if c.IsSet(FlagTaskQueueType) {
+		taskQueueType, err := stringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+		if err != nil {
+			ErrorAndExit("Failed to parse TaskQueue Type", err)
+		}
+		filters["taskType"] = enumspb.TaskQueueType_name[taskQueueType]
+	}
+	if c.IsSet(FlagShardID) {
+		filters["shardID"] = strconv.Itoa(c.Int(FlagShardID))
+	}
+	return filters
+}
+
+func printDynamicConfigEntries(entries []*dynamicconfigspb.DynamicConfigEntry) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Name", "Type", "Filters", "Value", "Default Value"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, entry := range entries {
+		table.Append([]string{
+			entry.GetName(),
+			entry.GetValueType(),
+			strings.Join(entry.GetAllowedFilters(), ","),
+			entry.GetValue(),
+			entry.GetDefaultValue(),
+		})
+	}
+	table.Render()
+}
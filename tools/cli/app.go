@@ -166,6 +166,18 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operations on database",
 					Subcommands: newDBCommands(),
 				},
+				{
+					Name:        "config",
+					Aliases:     []string{"c"},
+					Usage:       "Run admin operation on dynamic config",
+					Subcommands: newAdminDynamicConfigCommands(),
+				},
+				{
+					Name:        "archival",
+					Aliases:     []string{"arc"},
+					Usage:       "Run admin operation on archival",
+					Subcommands: newAdminArchivalCommands(),
+				},
 			},
 		},
 		{
@@ -25,6 +25,9 @@
 package cli
 
 import (
+	"io/ioutil"
+	"os"
+	"path"
 	"strings"
 	"testing"
 	"time"
@@ -50,8 +53,11 @@ import (
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
@@ -539,6 +545,69 @@ func (s *cliAppSuite) TestAdminDescribeWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestExportImportWorkflow() {
+	dir, err := ioutil.TempDir("", "TestExportImportWorkflow")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	runID := uuid.New()
+	closeTime := time.Date(2020, 8, 22, 1, 2, 3, 0, time.UTC)
+	events := []*historypb.HistoryEvent{
+		{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED, Version: 1},
+		{EventId: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED, Version: 1},
+	}
+	blob, err := persistence.NewPayloadSerializer().SerializeBatchEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	namespaceResp := &workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo:     &namespacepb.NamespaceInfo{Name: cliTestNamespace, Id: "test-namespace-id"},
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{ActiveClusterName: "active"},
+	}
+	s.frontendClient.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).Return(namespaceResp, nil).Times(3)
+	s.frontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: "test-wf-id", RunId: runID},
+			Type:      &commonpb.WorkflowType{Name: "test-workflow-type"},
+			StartTime: timestamp.TimePtr(closeTime.Add(-time.Hour)),
+			CloseTime: timestamp.TimePtr(closeTime),
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+	}, nil)
+	s.serverAdminClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&adminservice.DescribeWorkflowExecutionResponse{
+		DatabaseMutableState: `{"ExecutionInfo":{"WorkflowId":"test-wf-id"}}`,
+	}, nil)
+	s.serverAdminClient.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), gomock.Any()).Return(&adminservice.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: []*commonpb.DataBlob{blob.ToProto()},
+	}, nil)
+
+	bundleFile := path.Join(dir, "bundle.json")
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "export", "-w", "test-wf-id", "--of", bundleFile})
+	s.Equal(0, errorCode)
+
+	historyFile := path.Join(dir, "history.json")
+	archivalURI := "file://" + path.Join(dir, "archival")
+	errorCode = s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "import", "--if", bundleFile, "--of", historyFile, "--history_uri", archivalURI})
+	s.Equal(0, errorCode)
+	data, err := ioutil.ReadFile(historyFile)
+	s.NoError(err)
+	history := &historypb.History{}
+	s.NoError(codec.NewJSONPBEncoder().Decode(data, history))
+	s.Equal(events, history.Events)
+
+	archivedBundleFile := path.Join(dir, "archived.json")
+	errorCode = s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "export", "-w", "test-wf-id", "-r", runID, "--of", archivedBundleFile, "--history_uri", archivalURI})
+	s.Equal(0, errorCode)
+	data, err = ioutil.ReadFile(archivedBundleFile)
+	s.NoError(err)
+	bundle, err := archiver.DecodeWorkflowBundle(data)
+	s.NoError(err)
+	s.NoError(archiver.ValidateWorkflowBundle(bundle))
+	s.Equal(runID, bundle.Header.GetRunId())
+	s.Equal("test-workflow-type", bundle.Header.GetWorkflowTypeName())
+	s.Equal(int64(1), bundle.Header.GetCloseFailoverVersion())
+	s.Equal("active", bundle.Header.GetSourceCluster())
+	s.Equal(`{"ExecutionInfo":{"WorkflowId":"test-wf-id"}}`, bundle.GetMutableState())
+}
+
 func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	request := &adminservice.AddSearchAttributeRequest{
 		SearchAttribute: map[string]enumspb.IndexedValueType{
@@ -551,6 +620,43 @@ func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminGetDynamicConfig() {
+	request := &adminservice.GetDynamicConfigRequest{
+		Name: "matching.numTaskqueueReadPartitions",
+		Filters: map[string]string{
+			"namespace":     cliTestNamespace,
+			"taskQueueName": "test-taskQueue",
+			"taskType":      "Activity",
+		},
+	}
+	s.serverAdminClient.EXPECT().GetDynamicConfig(gomock.Any(), request).Return(&adminservice.GetDynamicConfigResponse{}, nil).Times(1)
+
+	err := s.app.Run([]string{"", "admin", "config", "get", "--config_name", "matching.numTaskqueueReadPartitions",
+		"--namespace", cliTestNamespace, "-tq", "test-taskQueue", "-tqt", "activity"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminUpdateDynamicConfig() {
+	request := &adminservice.UpdateDynamicConfigRequest{
+		Name:  "history.persistenceMaxQPS",
+		Value: "3000",
+	}
+	s.serverAdminClient.EXPECT().UpdateDynamicConfig(gomock.Any(), request).Return(&adminservice.UpdateDynamicConfigResponse{}, nil).Times(1)
+
+	err := s.app.Run([]string{"", "--auto_confirm", "admin", "config", "set", "--config_name", "history.persistenceMaxQPS", "--config_value", "3000"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminListDynamicConfig() {
+	request := &adminservice.ListDynamicConfigRequest{
+		Filters: map[string]string{"shardID": "1"},
+	}
+	s.serverAdminClient.EXPECT().ListDynamicConfig(gomock.Any(), request).Return(&adminservice.ListDynamicConfigResponse{}, nil).Times(1)
+
+	err := s.app.Run([]string{"", "admin", "config", "list", "--shard_id", "1"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDescribeTaskQueue() {
 	s.sdkClient.On("DescribeTaskQueue", mock.Anything, mock.Anything, mock.Anything).Return(describeTaskQueueResponse, nil).Once()
 	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "taskqueue", "describe", "-tq", "test-taskQueue"})
@@ -129,6 +129,10 @@ const (
 	FlagOwnerEmailWithAlias              = FlagOwnerEmail + ", oe"
 	FlagRetentionDays                    = "retention"
 	FlagRetentionDaysWithAlias           = FlagRetentionDays + ", rd"
+	FlagNewName                          = "new_name"
+	FlagNewNameWithAlias                 = FlagNewName + ", nn"
+	FlagAliasRetentionDays               = "alias_retention"
+	FlagAliasRetentionDaysWithAlias      = FlagAliasRetentionDays + ", ard"
 	FlagHistoryArchivalState             = "history_archival_state"
 	FlagHistoryArchivalStateWithAlias    = FlagHistoryArchivalState + ", has"
 	FlagHistoryArchivalURI               = "history_uri"
@@ -160,8 +164,20 @@ const (
 	FlagNamespaceDataWithAlias           = FlagNamespaceData + ", dmd"
 	FlagEventID                          = "event_id"
 	FlagEventIDWithAlias                 = FlagEventID + ", eid"
+	FlagEventType                        = "event_type"
+	FlagEventTypeWithAlias               = FlagEventType + ", et"
 	FlagActivityID                       = "activity_id"
 	FlagActivityIDWithAlias              = FlagActivityID + ", aid"
+	FlagResetHeartbeatDetails            = "reset_heartbeat_details"
+	FlagScheduleToCloseTimeout           = "schedule_to_close_timeout"
+	FlagScheduleToStartTimeout           = "schedule_to_start_timeout"
+	FlagStartToCloseTimeout              = "start_to_close_timeout"
+	FlagHeartbeatTimeout                 = "heartbeat_timeout"
+	FlagRetryInitialInterval             = "retry_initial_interval"
+	FlagRetryBackoffCoefficient          = "retry_backoff_coefficient"
+	FlagRetryMaximumInterval             = "retry_maximum_interval"
+	FlagRetryMaximumAttempts             = "retry_maximum_attempts"
+	FlagRetryNonRetryableErrorTypes      = "retry_non_retryable_error_types"
 	FlagMaxFieldLength                   = "max_field_length"
 	FlagMaxFieldLengthWithAlias          = FlagMaxFieldLength + ", maxl"
 	FlagSecurityToken                    = "security_token"
@@ -182,6 +198,8 @@ const (
 	FlagSearchAttributesKey              = "search_attr_key"
 	FlagSearchAttributesVal              = "search_attr_value"
 	FlagSearchAttributesType             = "search_attr_type"
+	FlagDynamicConfigName                = "config_name"
+	FlagDynamicConfigValue               = "config_value"
 	FlagAddBadBinary                     = "add_bad_binary"
 	FlagRemoveBadBinary                  = "remove_bad_binary"
 	FlagResetType                        = "reset_type"
@@ -565,6 +583,36 @@ func getFlagsForDescribeID() []cli.Flag {
 	}
 }
 
+func getFlagsForExport() []cli.Flag {
+	return append(flagsForExecution,
+		cli.StringFlag{
+			Name:  FlagOutputFilenameWithAlias,
+			Usage: "Workflow bundle file to write",
+		},
+		cli.StringFlag{
+			Name:  FlagHistoryArchivalURIWithAlias,
+			Usage: "Read the bundle from this history archival URI instead of the live workflow, only file:// URIs are supported",
+		},
+	)
+}
+
+func getFlagsForImport() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagInputFileWithAlias,
+			Usage: "Workflow bundle file to import",
+		},
+		cli.StringFlag{
+			Name:  FlagHistoryArchivalURIWithAlias,
+			Usage: "Archive the bundle into this history archival URI of the namespace, only file:// URIs are supported",
+		},
+		cli.StringFlag{
+			Name:  FlagOutputFilenameWithAlias,
+			Usage: "Write the bundled history to this file, in the format replayed by the SDK workflow replayer",
+		},
+	}
+}
+
 func getFlagsForObserve() []cli.Flag {
 	return append(flagsForExecution, getFlagsForObserveID()...)
 }
@@ -126,6 +126,48 @@ func newWorkflowCommands() []cli.Command {
 				TerminateWorkflow(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "stop dispatching the workflow and activity tasks of a workflow execution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to pause the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "resume dispatching the workflow and activity tasks of a paused workflow execution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to unpause the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UnpauseWorkflow(c)
+			},
+		},
 		{
 			Name:        "list",
 			Aliases:     []string{"l"},
@@ -323,5 +365,21 @@ func newWorkflowCommands() []cli.Command {
 				ResetInBatch(c)
 			},
 		},
+		{
+			Name:  "export",
+			Usage: "export a closed workflow as a bundle of its final mutable state, memo, search attributes and history",
+			Flags: getFlagsForExport(),
+			Action: func(c *cli.Context) {
+				ExportWorkflow(c)
+			},
+		},
+		{
+			Name:  "import",
+			Usage: "import a workflow bundle into the history archival store of a namespace or as a history file for replay",
+			Flags: getFlagsForImport(),
+			Action: func(c *cli.Context) {
+				ImportWorkflow(c)
+			},
+		},
 	}
 }
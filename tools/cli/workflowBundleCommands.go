@@ -0,0 +1,288 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/urfave/cli"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/filestore"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	defaultPageSizeForExport = 1000
+
+	bundleFileMode = "0666"
+	bundleDirMode  = "0766"
+)
+
+// ExportWorkflow exports a closed workflow as a workflow bundle file. The bundle is built from the
+// live workflow, or read from the history archival store of the namespace if a history URI is given.
+func ExportWorkflow(c *cli.Context) {
+	outputFileName := getRequiredOption(c, FlagOutputFilename)
+
+	var bundle *archiverspb.WorkflowBundle
+	if c.IsSet(FlagHistoryArchivalURI) {
+		bundle = getArchivedWorkflowBundle(c)
+	} else {
+		bundle = buildWorkflowBundle(c)
+	}
+
+	data, err := archiver.EncodeWorkflowBundle(bundle)
+	if err != nil {
+		ErrorAndExit("Failed to encode workflow bundle.", err)
+	}
+	if err := ioutil.WriteFile(outputFileName, data, 0666); err != nil {
+		ErrorAndExit("Failed to write workflow bundle file.", err)
+	}
+	header := bundle.GetHeader()
+	fmt.Printf("Exported workflow %s, run %s with %d events to %s.\n", header.GetWorkflowId(), header.GetRunId(), header.GetEventCount(), outputFileName)
+}
+
+// ImportWorkflow imports a workflow bundle file. The bundle is either archived into the history
+// archival store of the namespace or its history is written to a file which can be replayed locally.
+func ImportWorkflow(c *cli.Context) {
+	inputFileName := getRequiredOption(c, FlagInputFile)
+	if !c.IsSet(FlagHistoryArchivalURI) && !c.IsSet(FlagOutputFilename) {
+		ErrorAndExit(fmt.Sprintf("Option %s or %s is required.", FlagHistoryArchivalURI, FlagOutputFilename), nil)
+	}
+
+	data, err := ioutil.ReadFile(inputFileName)
+	if err != nil {
+		ErrorAndExit("Failed to read workflow bundle file.", err)
+	}
+	bundle, err := archiver.DecodeWorkflowBundle(data)
+	if err != nil {
+		ErrorAndExit("Failed to decode workflow bundle.", err)
+	}
+	if err := archiver.ValidateWorkflowBundle(bundle); err != nil {
+		ErrorAndExit("Invalid workflow bundle.", err)
+	}
+
+	if c.IsSet(FlagOutputFilename) {
+		outputFileName := c.String(FlagOutputFilename)
+		if err := writeReplayHistory(bundle, outputFileName); err != nil {
+			ErrorAndExit("Failed to write workflow history file.", err)
+		}
+		fmt.Printf("Wrote history of workflow %s, run %s to %s.\n", bundle.Header.GetWorkflowId(), bundle.Header.GetRunId(), outputFileName)
+	}
+
+	if c.IsSet(FlagHistoryArchivalURI) {
+		namespace := getRequiredGlobalOption(c, FlagNamespace)
+		ctx, cancel := newContext(c)
+		defer cancel()
+		namespaceInfo := describeNamespaceForBundle(ctx, c, namespace)
+
+		// the bundle is re-homed into the target namespace, which in general has a different id
+		bundle.Header.Namespace = namespace
+		bundle.Header.NamespaceId = namespaceInfo.GetNamespaceInfo().GetId()
+		bundleArchiver, URI := getBundleArchiver(c.String(FlagHistoryArchivalURI))
+		if err := bundleArchiver.ArchiveBundle(ctx, URI, bundle); err != nil {
+			ErrorAndExit("Failed to archive workflow bundle.", err)
+		}
+		fmt.Printf("Imported workflow %s, run %s into namespace %s.\n", bundle.Header.GetWorkflowId(), bundle.Header.GetRunId(), namespace)
+	}
+}
+
+func buildWorkflowBundle(c *cli.Context) *archiverspb.WorkflowBundle {
+	frontendClient := cFactory.FrontendClient(c)
+	adminClient := cFactory.AdminClient(c)
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	describeResp, err := frontendClient.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Describe workflow execution failed", err)
+	}
+	executionInfo := describeResp.GetWorkflowExecutionInfo()
+	if executionInfo.GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		ErrorAndExit("Only closed workflows can be exported.", nil)
+	}
+	execution := executionInfo.GetExecution()
+
+	mutableStateResp, err := adminClient.DescribeWorkflowExecution(ctx, &adminservice.DescribeWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: execution,
+	})
+	if err != nil {
+		ErrorAndExit("Get workflow mutableState failed", err)
+	}
+
+	historyBatches, err := getRawHistoryBatches(ctx, adminClient, namespace, execution)
+	if err != nil {
+		ErrorAndExit("Get workflow raw history failed", err)
+	}
+	if len(historyBatches) == 0 {
+		ErrorAndExit("Workflow has no history events.", nil)
+	}
+	lastBatch := historyBatches[len(historyBatches)-1].Events
+	lastEvent := lastBatch[len(lastBatch)-1]
+
+	namespaceInfo := describeNamespaceForBundle(ctx, c, namespace)
+	bundle, err := archiver.NewWorkflowBundle(&archiver.ArchiveBundleRequest{
+		ArchiveHistoryRequest: archiver.ArchiveHistoryRequest{
+			NamespaceID:          namespaceInfo.GetNamespaceInfo().GetId(),
+			Namespace:            namespace,
+			WorkflowID:           execution.GetWorkflowId(),
+			RunID:                execution.GetRunId(),
+			NextEventID:          lastEvent.GetEventId() + 1,
+			CloseFailoverVersion: lastEvent.GetVersion(),
+		},
+		WorkflowTypeName: executionInfo.GetType().GetName(),
+		Status:           executionInfo.GetStatus(),
+		StartTime:        timestamp.TimeValue(executionInfo.GetStartTime()),
+		CloseTime:        timestamp.TimeValue(executionInfo.GetCloseTime()),
+		MutableState:     mutableStateResp.GetDatabaseMutableState(),
+		Memo:             executionInfo.GetMemo(),
+		SearchAttributes: executionInfo.GetSearchAttributes(),
+	}, historyBatches, namespaceInfo.GetReplicationConfig().GetActiveClusterName())
+	if err != nil {
+		ErrorAndExit("Failed to build workflow bundle.", err)
+	}
+	return bundle
+}
+
+func getArchivedWorkflowBundle(c *cli.Context) *archiverspb.WorkflowBundle {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := getRequiredOption(c, FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	namespaceInfo := describeNamespaceForBundle(ctx, c, namespace)
+	bundleArchiver, URI := getBundleArchiver(c.String(FlagHistoryArchivalURI))
+	bundle, err := bundleArchiver.GetBundle(ctx, URI, &archiver.GetBundleRequest{
+		NamespaceID: namespaceInfo.GetNamespaceInfo().GetId(),
+		WorkflowID:  wid,
+		RunID:       rid,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to get archived workflow bundle.", err)
+	}
+	return bundle
+}
+
+func getRawHistoryBatches(
+	ctx context.Context,
+	adminClient adminservice.AdminServiceClient,
+	namespace string,
+	execution *commonpb.WorkflowExecution,
+) ([]*historypb.History, error) {
+	serializer := persistence.NewPayloadSerializer()
+	var historyBatches []*historypb.History
+	var nextPageToken []byte
+	for {
+		// an empty event range returns the full history of the current branch
+		resp, err := adminClient.GetWorkflowExecutionRawHistoryV2(ctx, &adminservice.GetWorkflowExecutionRawHistoryV2Request{
+			Namespace:       namespace,
+			Execution:       execution,
+			MaximumPageSize: defaultPageSizeForExport,
+			NextPageToken:   nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, blob := range resp.GetHistoryBatches() {
+			events, err := serializer.DeserializeBatchEvents(persistence.NewDataBlobFromProto(blob))
+			if err != nil {
+				return nil, err
+			}
+			historyBatches = append(historyBatches, &historypb.History{Events: events})
+		}
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			return historyBatches, nil
+		}
+	}
+}
+
+func describeNamespaceForBundle(ctx context.Context, c *cli.Context, namespace string) *workflowservice.DescribeNamespaceResponse {
+	resp, err := cFactory.FrontendClient(c).DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Name: namespace,
+	})
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Namespace %s does not exist.", namespace), err)
+	}
+	return resp
+}
+
+// getBundleArchiver returns the archiver used to read and write workflow bundles, only the filestore
+// archiver is supported as the archivers of other stores need the credentials of the server.
+func getBundleArchiver(uri string) (archiver.BundleArchiver, archiver.URI) {
+	URI, err := archiver.NewURI(uri)
+	if err != nil {
+		ErrorAndExit("Invalid history archival URI.", err)
+	}
+	if URI.Scheme() != filestore.URIScheme {
+		ErrorAndExit(fmt.Sprintf("Workflow bundles can only be accessed in %s:// archival stores.", filestore.URIScheme), nil)
+	}
+	historyArchiver, err := filestore.NewHistoryArchiver(
+		&archiver.HistoryBootstrapContainer{Logger: loggerimpl.NewNopLogger()},
+		&config.FilestoreArchiver{FileMode: bundleFileMode, DirMode: bundleDirMode},
+	)
+	if err != nil {
+		ErrorAndExit("Failed to create history archiver.", err)
+	}
+	return historyArchiver.(archiver.BundleArchiver), URI
+}
+
+// writeReplayHistory writes the history of the bundle in the same format as `workflow show --output_filename`,
+// which can be replayed by the SDK workflow replayer.
+func writeReplayHistory(bundle *archiverspb.WorkflowBundle, outputFileName string) error {
+	history := &historypb.History{}
+	for _, batch := range bundle.GetHistory() {
+		history.Events = append(history.Events, batch.Events...)
+	}
+	data, err := codec.NewJSONPBIndentEncoder(" ").Encode(history)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(outputFileName, data, 0666)
+}
@@ -58,6 +58,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	clispb "go.temporal.io/server/api/cli/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
@@ -457,6 +458,60 @@ func TerminateWorkflow(c *cli.Context) {
 	}
 }
 
+// PauseWorkflow pauses a workflow execution
+func PauseWorkflow(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.PauseWorkflowExecution(ctx, &adminservice.PauseWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Reason:   c.String(FlagReason),
+		Identity: getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Pause workflow failed.", err)
+	} else {
+		fmt.Println("Pause workflow succeeded.")
+	}
+}
+
+// UnpauseWorkflow unpauses a workflow execution
+func UnpauseWorkflow(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.UnpauseWorkflowExecution(ctx, &adminservice.UnpauseWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Reason:   c.String(FlagReason),
+		Identity: getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Unpause workflow failed.", err)
+	} else {
+		fmt.Println("Unpause workflow succeeded.")
+	}
+}
+
 // CancelWorkflow cancels a workflow execution
 func CancelWorkflow(c *cli.Context) {
 	wfClient := getWorkflowClient(c)
@@ -1985,6 +2040,150 @@ func FailActivity(c *cli.Context) {
 	}
 }
 
+// PauseActivity pauses a pending activity
+func PauseActivity(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	activityID := getRequiredOption(c, FlagActivityID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.PauseActivity(ctx, &adminservice.PauseActivityRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		ActivityId: activityID,
+		Reason:     c.String(FlagReason),
+		Identity:   getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Pause activity failed.", err)
+	} else {
+		fmt.Println("Pause activity succeeded.")
+	}
+}
+
+// UnpauseActivity unpauses a paused activity
+func UnpauseActivity(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	activityID := getRequiredOption(c, FlagActivityID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.UnpauseActivity(ctx, &adminservice.UnpauseActivityRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		ActivityId: activityID,
+		Reason:     c.String(FlagReason),
+		Identity:   getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Unpause activity failed.", err)
+	} else {
+		fmt.Println("Unpause activity succeeded.")
+	}
+}
+
+// ResetActivity resets the attempt counter and the retry backoff of a pending activity
+func ResetActivity(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	activityID := getRequiredOption(c, FlagActivityID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.ResetActivity(ctx, &adminservice.ResetActivityRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		ActivityId:            activityID,
+		ResetHeartbeatDetails: c.Bool(FlagResetHeartbeatDetails),
+		Identity:              getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Reset activity failed.", err)
+	} else {
+		fmt.Println("Reset activity succeeded.")
+	}
+}
+
+// UpdateActivityOptions updates the retry policy and the timeouts of a pending activity
+func UpdateActivityOptions(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	activityID := getRequiredOption(c, FlagActivityID)
+
+	request := &adminservice.UpdateActivityOptionsRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		ActivityId: activityID,
+		Identity:   getCliIdentity(),
+	}
+	if c.IsSet(FlagScheduleToCloseTimeout) {
+		request.ScheduleToCloseTimeout = timestamp.DurationPtr(time.Duration(c.Int(FlagScheduleToCloseTimeout)) * time.Second)
+	}
+	if c.IsSet(FlagScheduleToStartTimeout) {
+		request.ScheduleToStartTimeout = timestamp.DurationPtr(time.Duration(c.Int(FlagScheduleToStartTimeout)) * time.Second)
+	}
+	if c.IsSet(FlagStartToCloseTimeout) {
+		request.StartToCloseTimeout = timestamp.DurationPtr(time.Duration(c.Int(FlagStartToCloseTimeout)) * time.Second)
+	}
+	if c.IsSet(FlagHeartbeatTimeout) {
+		request.HeartbeatTimeout = timestamp.DurationPtr(time.Duration(c.Int(FlagHeartbeatTimeout)) * time.Second)
+	}
+	if c.IsSet(FlagRetryInitialInterval) || c.IsSet(FlagRetryBackoffCoefficient) || c.IsSet(FlagRetryMaximumInterval) ||
+		c.IsSet(FlagRetryMaximumAttempts) || c.IsSet(FlagRetryNonRetryableErrorTypes) {
+		// unset fields of the retry policy are filled with the defaults of the namespace
+		request.RetryPolicy = &commonpb.RetryPolicy{
+			BackoffCoefficient:     c.Float64(FlagRetryBackoffCoefficient),
+			MaximumAttempts:        int32(c.Int(FlagRetryMaximumAttempts)),
+			NonRetryableErrorTypes: c.StringSlice(FlagRetryNonRetryableErrorTypes),
+		}
+		if c.IsSet(FlagRetryInitialInterval) {
+			request.RetryPolicy.InitialInterval = timestamp.DurationPtr(time.Duration(c.Int(FlagRetryInitialInterval)) * time.Second)
+		}
+		if c.IsSet(FlagRetryMaximumInterval) {
+			request.RetryPolicy.MaximumInterval = timestamp.DurationPtr(time.Duration(c.Int(FlagRetryMaximumInterval)) * time.Second)
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.UpdateActivityOptions(ctx, request)
+
+	if err != nil {
+		ErrorAndExit("Update activity options failed.", err)
+	} else {
+		fmt.Println("Update activity options succeeded.")
+	}
+}
+
 // ObserveHistoryWithID show the process of running workflow
 func ObserveHistoryWithID(c *cli.Context) {
 	if !c.Args().Present() {